@@ -519,7 +519,16 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 	// We have more than the minimum retention count, so we could afford to
 	// prune some. See if any are beyond the minimum retention time.
 	// ListBackups returns them sorted by oldest first.
+	// Incremental backups can't be restored without the backup they're taken
+	// on top of, so a backup is only removed along with all its incremental
+	// backups, once the newest of them is beyond the minimum retention time.
+	chains := backupChains(ctx, backups)
 	for _, backup := range backups {
+		chain, ok := chains[backup.Name()]
+		if !ok {
+			// An incremental backup is removed with the chain it belongs to.
+			continue
+		}
 		backupTime, err := parseBackupTime(backup.Name())
 		if err != nil {
 			return err
@@ -529,21 +538,63 @@ func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage
 			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
 			break
 		}
-		// Remove the backup.
-		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
-		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
-			return fmt.Errorf("couldn't remove backup %v from %v: %v", backup.Name(), backupDir, err)
+		newest := chain[len(chain)-1]
+		newestTime, err := parseBackupTime(newest)
+		if err != nil {
+			return err
 		}
-		// We successfully removed one backup. Can we afford to prune any more?
-		numBackups--
+		if time.Since(newestTime) < *minRetentionTime {
+			log.Infof("Keeping old backup %v, since its incremental backup %v taken at %v has not reached min_retention_time of %v.", backup.Name(), newest, newestTime, *minRetentionTime)
+			continue
+		}
+		if numBackups-len(chain) < *minRetentionCount {
+			log.Infof("Keeping old backup %v and its %v incremental backups, since removing them would go below the min_retention_count of %v.", backup.Name(), len(chain)-1, *minRetentionCount)
+			break
+		}
+		// Remove the backup, and its incremental backups first.
+		for i := len(chain) - 1; i >= 0; i-- {
+			log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", chain[i], backupDir, *minRetentionTime)
+			if err := mysqlctl.RemoveBackup(ctx, backupStorage, backupDir, chain[i]); err != nil {
+				return fmt.Errorf("couldn't remove backup %v from %v: %v", chain[i], backupDir, err)
+			}
+		}
+		// We successfully removed some backups. Can we afford to prune any more?
+		numBackups -= len(chain)
 		if numBackups == *minRetentionCount {
 			log.Infof("Successfully pruned backup count to min_retention_count of %v.", *minRetentionCount)
 			break
 		}
 	}
+	// The archived binary logs are only useful on top of a backup.
+	if _, err := mysqlctl.PruneBinlogArchive(ctx, backupStorage, *initKeyspace, *initShard, logutil.NewConsoleLogger()); err != nil {
+		return fmt.Errorf("couldn't prune archived binary logs: %v", err)
+	}
 	return nil
 }
 
+// backupChains returns the backups that are not taken on top of another
+// backup, with the names of the backups of their chain: the backup itself
+// followed by its incremental backups, transitively, oldest first.
+// The backups must be sorted by oldest first.
+func backupChains(ctx context.Context, backups []backupstorage.BackupHandle) map[string][]string {
+	chains := make(map[string][]string)
+	// roots maps every backup to the first backup of its chain.
+	roots := make(map[string]string)
+	for _, backup := range backups {
+		name := backup.Name()
+		// Incomplete backups have no MANIFEST, and no parent.
+		parent, err := mysqlctl.GetParentBackup(ctx, backup)
+		if root, ok := roots[parent]; ok && err == nil {
+			roots[name] = root
+			chains[root] = append(chains[root], name)
+			continue
+		}
+		roots[name] = name
+		chains[name] = []string{name}
+	}
+	return chains
+}
+
 func parseBackupTime(name string) (time.Time, error) {
 	// Backup names are formatted as "date.time.tablet-alias".
 	parts := strings.Split(name, ".")
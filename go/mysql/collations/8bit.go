@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import "bytes"
+
+func init() {
+	register(&collationBinary{})
+	register(&collation8bitBin{id: 47, name: "latin1_bin"})
+	register(&collation8bitSimpleCI{id: 8, name: "latin1_swedish_ci", sort: sortOrderLatin1SwedishCI})
+}
+
+// collationBinary is the collation for binary strings: bytes are compared
+// by their numeric value and trailing spaces are significant.
+type collationBinary struct{}
+
+func (c *collationBinary) ID() ID {
+	return Binary
+}
+
+func (c *collationBinary) Name() string {
+	return "binary"
+}
+
+func (c *collationBinary) Collate(left, right []byte) int {
+	return bytes.Compare(left, right)
+}
+
+func (c *collationBinary) WeightString(dst, src []byte) []byte {
+	return append(dst, src...)
+}
+
+// collation8bitBin is the _bin collation for single-byte character sets.
+// It compares bytes by their numeric value, ignoring trailing spaces.
+type collation8bitBin struct {
+	id   ID
+	name string
+}
+
+func (c *collation8bitBin) ID() ID {
+	return c.id
+}
+
+func (c *collation8bitBin) Name() string {
+	return c.name
+}
+
+func (c *collation8bitBin) Collate(left, right []byte) int {
+	return bytes.Compare(trimPadding(left), trimPadding(right))
+}
+
+func (c *collation8bitBin) WeightString(dst, src []byte) []byte {
+	return append(dst, trimPadding(src)...)
+}
+
+// collation8bitSimpleCI is a case insensitive collation for single-byte character sets,
+// where every byte maps to a single weight through a sort table.
+type collation8bitSimpleCI struct {
+	id   ID
+	name string
+	sort *[256]byte
+}
+
+func (c *collation8bitSimpleCI) ID() ID {
+	return c.id
+}
+
+func (c *collation8bitSimpleCI) Name() string {
+	return c.name
+}
+
+func (c *collation8bitSimpleCI) Collate(left, right []byte) int {
+	left, right = trimPadding(left), trimPadding(right)
+	sort := c.sort
+	for i := 0; i < len(left) && i < len(right); i++ {
+		if l, r := sort[left[i]], sort[right[i]]; l != r {
+			return sign(int(l) - int(r))
+		}
+	}
+	return sign(len(left) - len(right))
+}
+
+func (c *collation8bitSimpleCI) WeightString(dst, src []byte) []byte {
+	for _, ch := range trimPadding(src) {
+		dst = append(dst, c.sort[ch])
+	}
+	return dst
+}
+
+// sortOrderLatin1SwedishCI is the sort table for latin1_swedish_ci, which maps lowercase
+// letters to uppercase and most accented letters to their base letter. As in Swedish,
+// Å, Ä and Ö sort after Z.
+var sortOrderLatin1SwedishCI = &[256]byte{
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+	0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+	0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f,
+	0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f,
+	0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f,
+	0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5d, 0x5e, 0x5f,
+	0x60, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f,
+	0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5a, 0x7b, 0x7c, 0x7d, 0x7e, 0x7f,
+	0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8a, 0x8b, 0x8c, 0x8d, 0x8e, 0x8f,
+	0x90, 0x91, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98, 0x99, 0x9a, 0x9b, 0x9c, 0x9d, 0x9e, 0x9f,
+	0xa0, 0xa1, 0xa2, 0xa3, 0xa4, 0xa5, 0xa6, 0xa7, 0xa8, 0xa9, 0xaa, 0xab, 0xac, 0xad, 0xae, 0xaf,
+	0xb0, 0xb1, 0xb2, 0xb3, 0xb4, 0xb5, 0xb6, 0xb7, 0xb8, 0xb9, 0xba, 0xbb, 0xbc, 0xbd, 0xbe, 0xbf,
+	0x41, 0x41, 0x41, 0x41, 0x5c, 0x5b, 0x5c, 0x43, 0x45, 0x45, 0x45, 0x45, 0x49, 0x49, 0x49, 0x49,
+	0x44, 0x4e, 0x4f, 0x4f, 0x4f, 0x4f, 0x5d, 0xd7, 0xd8, 0x55, 0x55, 0x55, 0x59, 0x59, 0xde, 0xdf,
+	0x41, 0x41, 0x41, 0x41, 0x5c, 0x5b, 0x5c, 0x43, 0x45, 0x45, 0x45, 0x45, 0x49, 0x49, 0x49, 0x49,
+	0x44, 0x4e, 0x4f, 0x4f, 0x4f, 0x4f, 0x5d, 0xf7, 0xd8, 0x55, 0x55, 0x55, 0x59, 0x59, 0xde, 0xff,
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package collations implements the MySQL collations that vtgate needs
+// to compare and sort string values the same way MySQL does.
+package collations
+
+import (
+	"bytes"
+	"strings"
+)
+
+// ID is the numeric identifier of a collation. These are the same
+// identifiers that MySQL uses in the wire protocol (e.g. in the
+// character set of a column definition) and in information_schema.COLLATIONS.
+type ID uint16
+
+// Unknown is the ID for values whose collation is not known.
+// Such values cannot be compared with a collation.
+const Unknown ID = 0
+
+// Binary is the ID of the binary collation of binary strings.
+const Binary ID = 63
+
+// Collation is the interface that all the collations implement.
+type Collation interface {
+	// ID returns the numeric identifier of this collation
+	ID() ID
+
+	// Name returns the MySQL name of this collation, e.g. utf8mb4_0900_ai_ci
+	Name() string
+
+	// Collate compares two strings using this collation. It returns 0 if the
+	// strings are equal, -1 if left sorts before right and 1 otherwise.
+	Collate(left, right []byte) int
+
+	// WeightString appends the weight string for src to dst and returns the
+	// updated slice. Two strings are equal under this collation if and only if
+	// their weight strings are equal, and comparing two weight strings with
+	// bytes.Compare gives the same result as Collate.
+	WeightString(dst, src []byte) []byte
+}
+
+var (
+	collationsByID   = map[ID]Collation{}
+	collationsByName = map[string]Collation{}
+)
+
+func register(c Collation) {
+	collationsByID[c.ID()] = c
+	collationsByName[c.Name()] = c
+}
+
+// LookupByID returns the collation with the given ID, or nil if the
+// collation is unknown or not supported.
+func LookupByID(id ID) Collation {
+	return collationsByID[id]
+}
+
+// LookupByName returns the collation with the given name, or nil if the
+// collation is unknown or not supported.
+func LookupByName(name string) Collation {
+	return collationsByName[strings.ToLower(name)]
+}
+
+// All returns all the supported collations
+func All() []Collation {
+	all := make([]Collation, 0, len(collationsByID))
+	for _, c := range collationsByID {
+		all = append(all, c)
+	}
+	return all
+}
+
+// trimPadding removes the trailing spaces from str. Collations with
+// the PAD SPACE attribute ignore trailing spaces in comparisons.
+func trimPadding(str []byte) []byte {
+	return bytes.TrimRight(str, " ")
+}
+
+// sign normalizes the result of a comparison to -1, 0 or 1
+func sign(cmp int) int {
+	switch {
+	case cmp < 0:
+		return -1
+	case cmp > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	for _, c := range All() {
+		assert.Equal(t, c, LookupByID(c.ID()))
+		assert.Equal(t, c, LookupByName(c.Name()))
+	}
+	assert.Equal(t, ID(45), LookupByName("UTF8MB4_GENERAL_CI").ID())
+	assert.Nil(t, LookupByID(Unknown))
+	// the UCA collations are not supported
+	assert.Nil(t, LookupByName("utf8mb4_unicode_ci"))
+	assert.Nil(t, LookupByName("utf8mb4_0900_ai_ci"))
+	assert.Nil(t, LookupByName("nope"))
+}
+
+func TestCollate(t *testing.T) {
+	tests := []struct {
+		collation   string
+		left, right string
+		cmp         int
+	}{
+		{"binary", "a", "A", 1},
+		{"binary", "a", "a ", -1},
+		{"latin1_bin", "a", "a ", 0},
+		{"latin1_bin", "a", "B", 1},
+		{"latin1_swedish_ci", "a", "A", 0},
+		{"latin1_swedish_ci", "a", "B", -1},
+		{"latin1_swedish_ci", "abc ", "ABC", 0},
+		{"utf8mb4_bin", "a", "A", 1},
+		{"utf8mb4_bin", "a", "a  ", 0},
+		{"utf8mb4_0900_bin", "a", "a ", -1},
+		{"utf8mb4_general_ci", "straße", "STRASSE", -1},
+		{"utf8mb4_general_ci", "résumé", "RESUME", 0},
+		{"utf8mb4_general_ci", "a", "b", -1},
+		{"utf8mb4_general_ci", "ab", "a", 1},
+		{"utf8mb4_general_ci", "a ", "a", 0},
+		{"utf8mb4_general_ci", "ß", "s", 0},
+		{"utf8mb4_general_ci", "Ä", "a", 0},
+		{"utf8mb4_general_ci", "😀", "😺", 0},
+		{"utf8_general_ci", "Résumé", "resume", 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.collation+"/"+tc.left+"/"+tc.right, func(t *testing.T) {
+			coll := LookupByName(tc.collation)
+			require.NotNil(t, coll)
+			assert.Equal(t, tc.cmp, coll.Collate([]byte(tc.left), []byte(tc.right)))
+			assert.Equal(t, -tc.cmp, coll.Collate([]byte(tc.right), []byte(tc.left)))
+
+			// weight strings must sort the same way as the strings themselves
+			left := coll.WeightString(nil, []byte(tc.left))
+			right := coll.WeightString(nil, []byte(tc.right))
+			assert.Equal(t, tc.cmp, bytes.Compare(left, right))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	register(&collationUnicodeBin{id: 83, name: "utf8_bin", pad: true})
+	register(&collationUnicodeBin{id: 46, name: "utf8mb4_bin", pad: true})
+	register(&collationUnicodeBin{id: 309, name: "utf8mb4_0900_bin", pad: false})
+	register(&collationGeneralCI{id: 33, name: "utf8_general_ci"})
+	register(&collationGeneralCI{id: 45, name: "utf8mb4_general_ci"})
+	// The UCA collations (utf8_unicode_ci, utf8mb4_0900_ai_ci...) are not registered:
+	// their weights and contractions differ from the ones in golang.org/x/text/collate,
+	// so the text values that use them can't be compared in vtgate.
+}
+
+// collationUnicodeBin is the _bin collation for UTF-8 strings. Comparing UTF-8
+// bytes gives the same result as comparing the code points they encode.
+type collationUnicodeBin struct {
+	id   ID
+	name string
+	pad  bool
+}
+
+func (c *collationUnicodeBin) ID() ID {
+	return c.id
+}
+
+func (c *collationUnicodeBin) Name() string {
+	return c.name
+}
+
+func (c *collationUnicodeBin) Collate(left, right []byte) int {
+	if c.pad {
+		left, right = trimPadding(left), trimPadding(right)
+	}
+	return bytes.Compare(left, right)
+}
+
+func (c *collationUnicodeBin) WeightString(dst, src []byte) []byte {
+	if c.pad {
+		src = trimPadding(src)
+	}
+	return append(dst, src...)
+}
+
+// collationGeneralCI implements the utf8_general_ci family of collations. Every
+// character has a single weight: its uppercase form without accents. Characters
+// outside of the Basic Multilingual Plane all share the weight of U+FFFD.
+type collationGeneralCI struct {
+	id   ID
+	name string
+}
+
+func (c *collationGeneralCI) ID() ID {
+	return c.id
+}
+
+func (c *collationGeneralCI) Name() string {
+	return c.name
+}
+
+func (c *collationGeneralCI) Collate(left, right []byte) int {
+	left, right = trimPadding(left), trimPadding(right)
+	for len(left) > 0 && len(right) > 0 {
+		l, lsize := utf8.DecodeRune(left)
+		r, rsize := utf8.DecodeRune(right)
+		if lw, rw := generalWeight(l), generalWeight(r); lw != rw {
+			return sign(int(lw) - int(rw))
+		}
+		left, right = left[lsize:], right[rsize:]
+	}
+	return sign(len(left) - len(right))
+}
+
+func (c *collationGeneralCI) WeightString(dst, src []byte) []byte {
+	src = trimPadding(src)
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		w := generalWeight(r)
+		dst = append(dst, byte(w>>8), byte(w))
+		src = src[size:]
+	}
+	return dst
+}
+
+func generalWeight(r rune) uint16 {
+	if r > 0xFFFF {
+		return 0xFFFD
+	}
+	if r == 'ß' {
+		// general_ci gives the sharp s the weight of a single 'S'
+		return 'S'
+	}
+	if r >= 0x80 {
+		// use the base character of the canonical decomposition, i.e. drop the accents
+		var buf [utf8.UTFMax]byte
+		n := utf8.EncodeRune(buf[:], r)
+		if base, _ := utf8.DecodeRune(norm.NFD.Bytes(buf[:n])); base != utf8.RuneError {
+			r = base
+		}
+	}
+	return uint16(unicode.ToUpper(r))
+}
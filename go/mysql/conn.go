@@ -201,6 +201,13 @@ type PrepareData struct {
 	BindVars    map[string]*querypb.BindVariable
 	StatementID uint32
 	ParamsCount uint16
+	// CursorType has the cursor type flags of the current execution.
+	CursorType byte
+	// ClientData is the handler state of the prepared statement.
+	ClientData interface{}
+
+	// cursor is the open cursor of the statement, if any.
+	cursor *cursor
 }
 
 // execResult is an enum signifying the result of executing a query
@@ -864,6 +871,16 @@ func (c *Conn) handleNextCommand(handler Handler) bool {
 		return false
 	}
 
+	switch data[0] {
+	case ComInitDB, ComQuery, ComPrepare:
+		// The handler doesn't run concurrently with the executions
+		// of the open cursors.
+		if err := c.materializeCursors(); err != nil {
+			c.recycleReadPacket()
+			return c.writeErrorPacketFromErrorAndLog(err)
+		}
+	}
+
 	switch data[0] {
 	case ComQuit:
 		c.recycleReadPacket()
@@ -885,10 +902,13 @@ func (c *Conn) handleNextCommand(handler Handler) bool {
 		return c.handleComStmtExecute(handler, data)
 	case ComStmtSendLongData:
 		return c.handleComStmtSendLongData(data)
+	case ComStmtFetch:
+		return c.handleComStmtFetch(data)
 	case ComStmtClose:
 		stmtID, ok := c.parseComStmtClose(data)
 		c.recycleReadPacket()
-		if ok {
+		if prepare, found := c.PrepareData[stmtID]; ok && found {
+			c.closeCursor(prepare)
 			delete(c.PrepareData, stmtID)
 		}
 	case ComStmtReset:
@@ -911,6 +931,7 @@ func (c *Conn) handleNextCommand(handler Handler) bool {
 func (c *Conn) handleComResetConnection(handler Handler) {
 	// Clean up and reset the connection
 	c.recycleReadPacket()
+	c.closeCursors()
 	handler.ComResetConnection(c)
 	// Reset prepared statements
 	c.PrepareData = make(map[uint32]*PrepareData)
@@ -924,20 +945,18 @@ func (c *Conn) handleComStmtReset(data []byte) bool {
 	stmtID, ok := c.parseComStmtReset(data)
 	c.recycleReadPacket()
 	if !ok {
-		log.Error("Got unhandled packet from client %v, returning error: %v", c.ConnectionID, data)
-		if !c.writeErrorAndLog(ERUnknownComError, SSNetError, "error handling packet: %v", data) {
-			return false
-		}
+		log.Errorf("Got unhandled packet from client %v, returning error", c.ConnectionID)
+		return c.writeErrorAndLog(ERUnknownComError, SSNetError, "error handling statement reset packet")
 	}
 
 	prepare, ok := c.PrepareData[stmtID]
 	if !ok {
-		log.Error("Commands were executed in an improper order from client %v, packet: %v", c.ConnectionID, data)
-		if !c.writeErrorAndLog(CRCommandsOutOfSync, SSNetError, "commands were executed in an improper order: %v", data) {
-			return false
-		}
+		log.Errorf("Commands were executed in an improper order from client %v, statement ID: %v", c.ConnectionID, stmtID)
+		return c.writeErrorAndLog(CRCommandsOutOfSync, SSNetError, "commands were executed in an improper order: unknown statement ID %v", stmtID)
 	}
 
+	// Resetting a statement closes its cursor, and discards its long data.
+	c.closeCursor(prepare)
 	if prepare.BindVars != nil {
 		for k := range prepare.BindVars {
 			prepare.BindVars[k] = nil
@@ -993,7 +1012,7 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 		}
 	}()
 	queryStart := time.Now()
-	stmtID, _, err := c.parseComStmtExecute(c.PrepareData, data)
+	stmtID, cursorType, err := c.parseComStmtExecute(c.PrepareData, data)
 	c.recycleReadPacket()
 
 	if stmtID != uint32(0) {
@@ -1008,10 +1027,25 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 		return c.writeErrorPacketFromErrorAndLog(err)
 	}
 
+	prepare := c.PrepareData[stmtID]
+	// A new execution closes the open cursor of the statement, and the
+	// handler doesn't run concurrently with the other ones.
+	c.closeCursor(prepare)
+	if err := c.materializeCursors(); err != nil {
+		return c.writeErrorPacketFromErrorAndLog(err)
+	}
+	prepare.CursorType = cursorType
+	if cursorType != CursorTypeNoCursor {
+		if !c.executeWithCursor(handler, prepare) {
+			return false
+		}
+		timings.Record(queryTimingKey, queryStart)
+		return true
+	}
+
 	fieldSent := false
 	// sendFinished is set if the response should just be an OK packet.
 	sendFinished := false
-	prepare := c.PrepareData[stmtID]
 	err = handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
 		if sendFinished {
 			// Failsafe: Unreachable if server is well-behaved.
@@ -1074,6 +1108,101 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 	return true
 }
 
+// executeWithCursor executes prepare for a cursor. Only a result set
+// opens a cursor: the response has its metadata, and the client then
+// fetches its rows with COM_STMT_FETCH.
+func (c *Conn) executeWithCursor(handler Handler, prepare *PrepareData) bool {
+	cur := c.startCursor(handler, prepare)
+	qr := cur.first()
+	if qr == nil {
+		err := cur.err
+		if err == nil || err == io.EOF {
+			err = NewSQLErrorFromError(errors.New("unexpected: query ended without no results and no error"))
+		}
+		return c.writeErrorPacketFromErrorAndLog(err)
+	}
+
+	if len(qr.Fields) == 0 {
+		cur.close()
+		ok := PacketOK{
+			affectedRows:     qr.RowsAffected,
+			lastInsertID:     qr.InsertID,
+			statusFlags:      c.StatusFlags,
+			sessionStateData: qr.SessionStateChanges,
+		}
+		if err := c.writeOKPacket(&ok); err != nil {
+			log.Errorf("Error writing result to %s: %v", c, err)
+			return false
+		}
+		return true
+	}
+
+	prepare.cursor = cur
+	if err := c.sendColumnCount(uint64(len(qr.Fields))); err != nil {
+		log.Errorf("Error writing result to %s: %v", c, err)
+		return false
+	}
+	for _, field := range qr.Fields {
+		if err := c.writeColumnDefinition(field); err != nil {
+			log.Errorf("Error writing result to %s: %v", c, err)
+			return false
+		}
+	}
+	if err := c.writeCursorEndResult(c.StatusFlags | ServerStatusCursorExists); err != nil {
+		log.Errorf("Error writing result to %s: %v", c, err)
+		return false
+	}
+	return true
+}
+
+func (c *Conn) handleComStmtFetch(data []byte) (kontinue bool) {
+	c.startWriterBuffering()
+	defer func() {
+		if err := c.endWriterBuffering(); err != nil {
+			log.Errorf("conn %v: flush() failed: %v", c.ID(), err)
+			kontinue = false
+		}
+	}()
+	stmtID, numRows, ok := c.parseComStmtFetch(data)
+	c.recycleReadPacket()
+	if !ok {
+		return c.writeErrorAndLog(CRMalformedPacket, SSUnknownSQLState, "error parsing statement fetch packet")
+	}
+
+	prepare, ok := c.PrepareData[stmtID]
+	if !ok {
+		return c.writeErrorAndLog(ERUnknownStmtHandler, SSUnknownSQLState, "unknown prepared statement handler (%v) given to mysqld_stmt_fetch", stmtID)
+	}
+	cur := prepare.cursor
+	if cur == nil {
+		return c.writeErrorAndLog(ERStmtHasNoOpenCursor, SSUnknownSQLState, "the statement (%v) has no open cursor", stmtID)
+	}
+
+	rows, last, err := cur.fetch(int(numRows))
+	if err != nil {
+		c.closeCursor(prepare)
+		return c.writeErrorPacketFromErrorAndLog(err)
+	}
+	for _, row := range rows {
+		if err := c.writeBinaryRow(cur.fields, row); err != nil {
+			log.Errorf("Error writing row to %s: %v", c, err)
+			return false
+		}
+	}
+
+	flags := c.StatusFlags | ServerStatusCursorExists
+	if last {
+		// The cursor is closed once all its rows were sent.
+		flags |= ServerStatusLastRowSent
+		c.closeCursor(prepare)
+	}
+	if err := c.writeCursorEndResult(flags); err != nil {
+		log.Errorf("Error writing result to %s: %v", c, err)
+		return false
+	}
+	return true
+}
+
 func (c *Conn) handleComPrepare(handler Handler, data []byte) (kontinue bool) {
 	c.startWriterBuffering()
 	defer func() {
@@ -1112,9 +1241,7 @@ func (c *Conn) handleComPrepare(handler Handler, data []byte) (kontinue bool) {
 	statement, err := sqlparser.ParseStrictDDL(query)
 	if err != nil {
 		log.Errorf("Conn %v: Error parsing prepared statement: %v", c, err)
-		if !c.writeErrorPacketFromErrorAndLog(err) {
-			return false
-		}
+		return c.writeErrorPacketFromErrorAndLog(err)
 	}
 
 	paramsCount := uint16(0)
@@ -1145,6 +1272,7 @@ func (c *Conn) handleComPrepare(handler Handler, data []byte) (kontinue bool) {
 	fld, err := handler.ComPrepare(c, queries[0], bindVars)
 
 	if err != nil {
+		delete(c.PrepareData, c.StatementID)
 		return c.writeErrorPacketFromErrorAndLog(err)
 	}
 
@@ -246,6 +246,27 @@ const (
 	NullValue = 0xfb
 )
 
+// Cursor type flags of COM_STMT_EXECUTE.
+// Originally found in include/mysql/mysql_com.h
+const (
+	// CursorTypeNoCursor executes the statement without a cursor.
+	CursorTypeNoCursor byte = 0x00
+
+	// CursorTypeReadOnly opens a read-only cursor, whose rows are
+	// read with COM_STMT_FETCH.
+	CursorTypeReadOnly byte = 0x01
+
+	// CursorTypeForUpdate opens a cursor for update.
+	CursorTypeForUpdate byte = 0x02
+
+	// CursorTypeScrollable opens a scrollable cursor.
+	CursorTypeScrollable byte = 0x04
+)
+
+// stmtParamUnsigned is the flag of an unsigned parameter
+// type in COM_STMT_EXECUTE.
+const stmtParamUnsigned = 0x80
+
 // Auth packet types
 const (
 	// AuthMoreDataPacket is sent when server requires more data to authenticate
@@ -369,6 +390,7 @@ const (
 	ERNonExistingTableGrant = 1147
 	ERKeyDoesNotExist       = 1176
 	ERDbDropExists          = 1008
+	ERUnknownStmtHandler    = 1243
 
 	// permissions
 	ERDBAccessDenied            = 1044
@@ -407,6 +429,7 @@ const (
 	ERRowIsReferenced2              = 1451
 	ErNoReferencedRow2              = 1452
 	ErSPNotVarArg                   = 1414
+	ERStmtHasNoOpenCursor           = 1421
 	ERInnodbReadOnly                = 1874
 
 	// already exists
@@ -518,6 +541,7 @@ const (
 	ERDerivedMustHaveAlias         = 1248
 	ERTableNameNotAllowedHere      = 1250
 	ERQueryInterrupted             = 1317
+	ERViewWrongList                = 1353
 	ERTruncatedWrongValueForField  = 1366
 	ERDataTooLong                  = 1406
 	ERForbidSchemaChange           = 1450
@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"flag"
+	"io"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/vt/log"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var mysqlServerCursorBufferSize = flag.Int64("mysql_server_cursor_buffer_size", 64*1024*1024, "Maximum size, in bytes, of the rows of the open cursors of a connection that are buffered before another command is executed.")
+
+// cursor is the result set of a statement executed with a cursor,
+// which the client reads with COM_STMT_FETCH.
+//
+// The handler executes the statement in its own goroutine, and its
+// results are received only when the client fetches rows, so that
+// large result sets are streamed rather than buffered. Since the
+// handler must not run concurrently with itself on a connection, the
+// remaining results of the open cursors are received, and buffered,
+// before any other command calls the handler.
+type cursor struct {
+	results chan *sqltypes.Result
+	closing chan struct{}
+	// prepare is the statement of the cursor, and exec the copy of it
+	// that the handler executes, so that the connection can keep using
+	// prepare, e.g. to receive the long data of the next execution.
+	prepare *PrepareData
+	exec    *PrepareData
+	// err is the error of the execution. It's set before
+	// results is closed.
+	err error
+
+	fields []*querypb.Field
+	// rows are the rows received but not fetched yet, and size
+	// their size in bytes.
+	rows [][]sqltypes.Value
+	size int64
+	// finished is set once the execution has ended.
+	finished bool
+}
+
+// startCursor executes prepare with handler, for a cursor.
+func (c *Conn) startCursor(handler Handler, prepare *PrepareData) *cursor {
+	exec := *prepare
+	exec.BindVars = make(map[string]*querypb.BindVariable, len(prepare.BindVars))
+	for k, v := range prepare.BindVars {
+		exec.BindVars[k] = v
+	}
+	cur := &cursor{
+		results: make(chan *sqltypes.Result),
+		closing: make(chan struct{}),
+		prepare: prepare,
+		exec:    &exec,
+	}
+	go func() {
+		defer close(cur.results)
+		// A panic of the handler fails the cursor, and not the server.
+		defer func() {
+			if x := recover(); x != nil {
+				log.Errorf("mysql_server caught panic in cursor execution:\n%v\n%s", x, tb.Stack(4))
+				cur.err = NewSQLError(ERUnknownError, SSUnknownSQLState, "cursor execution failed: %v", x)
+			}
+		}()
+		cur.err = handler.ComStmtExecute(c, cur.exec, func(qr *sqltypes.Result) error {
+			select {
+			case cur.results <- qr:
+				return nil
+			case <-cur.closing:
+				return io.EOF
+			}
+		})
+	}()
+	return cur
+}
+
+// first waits for the first result of the execution. It returns
+// nil if the execution ended without any result.
+func (cur *cursor) first() *sqltypes.Result {
+	qr, ok := <-cur.results
+	if !ok {
+		cur.finish()
+		return nil
+	}
+	cur.fields = qr.Fields
+	cur.rows = qr.Rows
+	cur.size = rowsSize(qr.Rows)
+	return qr
+}
+
+// receive buffers the rows of the next result of the execution.
+// It returns false once the execution has ended.
+func (cur *cursor) receive() bool {
+	if cur.finished {
+		return false
+	}
+	qr, ok := <-cur.results
+	if !ok {
+		cur.finish()
+		return false
+	}
+	cur.rows = append(cur.rows, qr.Rows...)
+	cur.size += rowsSize(qr.Rows)
+	return true
+}
+
+// fetch returns the next n rows of the cursor at most, and whether
+// they are the last ones.
+func (cur *cursor) fetch(n int) ([][]sqltypes.Value, bool, error) {
+	// Receive one more row than requested, to know if they're the last.
+	for len(cur.rows) <= n && cur.receive() {
+	}
+	if cur.finished && cur.err != nil {
+		return nil, true, cur.err
+	}
+	if len(cur.rows) < n {
+		n = len(cur.rows)
+	}
+	rows := cur.rows[:n]
+	cur.rows = cur.rows[n:]
+	cur.size -= rowsSize(rows)
+	return rows, cur.finished && len(cur.rows) == 0, nil
+}
+
+// materialize buffers the remaining rows of the cursor. It returns an
+// error if their size exceeds maxSize, and the rest of the rows are
+// then left to be received.
+func (cur *cursor) materialize(maxSize int64) error {
+	for cur.receive() {
+		if cur.size > maxSize {
+			return NewSQLError(EROutOfResources, SSUnknownSQLState, "the open cursors buffer more than %d bytes, fetch their rows or close them first", *mysqlServerCursorBufferSize)
+		}
+	}
+	return nil
+}
+
+// close stops the execution, and waits for the handler to return.
+func (cur *cursor) close() {
+	close(cur.closing)
+	for range cur.results {
+	}
+	cur.finish()
+	cur.rows = nil
+	cur.size = 0
+}
+
+// finish marks the execution as ended, once the handler has returned.
+// The handler state of the execution is kept for the next ones.
+func (cur *cursor) finish() {
+	cur.finished = true
+	cur.prepare.ClientData = cur.exec.ClientData
+}
+
+// closeCursor closes the open cursor of prepare, if any.
+func (c *Conn) closeCursor(prepare *PrepareData) {
+	if prepare.cursor != nil {
+		prepare.cursor.close()
+		prepare.cursor = nil
+	}
+}
+
+// closeCursors closes all the open cursors of the connection.
+func (c *Conn) closeCursors() {
+	for _, prepare := range c.PrepareData {
+		c.closeCursor(prepare)
+	}
+}
+
+// materializeCursors buffers the remaining rows of all the open
+// cursors of the connection, before the handler executes another
+// command. It returns an error if the buffered rows exceed
+// mysql_server_cursor_buffer_size: the command must not be executed
+// then, and the cursors stay open.
+func (c *Conn) materializeCursors() error {
+	var size int64
+	for _, prepare := range c.PrepareData {
+		if prepare.cursor == nil {
+			continue
+		}
+		if err := prepare.cursor.materialize(*mysqlServerCursorBufferSize - size); err != nil {
+			return err
+		}
+		size += prepare.cursor.size
+	}
+	return nil
+}
+
+// rowsSize returns the size in bytes of the values of rows.
+func rowsSize(rows [][]sqltypes.Value) int64 {
+	var size int64
+	for _, row := range rows {
+		for _, v := range row {
+			size += int64(v.Len())
+		}
+	}
+	return size
+}
+
+// writeCursorEndResult ends the metadata of a cursor, or the rows of a
+// COM_STMT_FETCH, with the status flags.
+func (c *Conn) writeCursorEndResult(flags uint16) error {
+	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+		return c.writeEOFPacket(flags, 0)
+	}
+	return c.writeOKPacketWithEOFHeader(&PacketOK{statusFlags: flags})
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// cursorHandler streams a result set of rows rows, one row per
+// callback, to the executions of prepared statements.
+type cursorHandler struct {
+	testRun
+	rows int
+	// panicAfter makes the execution panic after that many rows.
+	panicAfter int
+
+	mu         sync.Mutex
+	executions int
+	sent       int
+	stopped    bool
+	// bindVars are the bind variables of the last execution.
+	bindVars map[string]*querypb.BindVariable
+}
+
+func (h *cursorHandler) ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error {
+	h.mu.Lock()
+	h.executions++
+	h.mu.Unlock()
+	if prepare.ClientData == nil {
+		prepare.ClientData = h.executions
+	}
+	fields := sqltypes.MakeTestFields("id", "int64")
+	if err := callback(&sqltypes.Result{Fields: fields}); err != nil {
+		return err
+	}
+	for i := 0; i < h.rows; i++ {
+		if h.panicAfter > 0 && i == h.panicAfter {
+			panic("test panic")
+		}
+		row := []sqltypes.Value{sqltypes.NewInt64(int64(i + 1))}
+		if err := callback(&sqltypes.Result{Rows: [][]sqltypes.Value{row}}); err != nil {
+			h.mu.Lock()
+			h.stopped = true
+			h.mu.Unlock()
+			return err
+		}
+		h.mu.Lock()
+		h.sent++
+		h.bindVars = make(map[string]*querypb.BindVariable, len(prepare.BindVars))
+		for k, v := range prepare.BindVars {
+			h.bindVars[k] = v
+		}
+		h.mu.Unlock()
+	}
+	return nil
+}
+
+func (h *cursorHandler) rowsSent() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.sent
+}
+
+func writeCursorCommand(t *testing.T, cConn *Conn, command byte, stmtID uint32, arg uint32, cursorType byte) {
+	cConn.sequence = 0
+	var data []byte
+	var pos int
+	switch command {
+	case ComStmtExecute:
+		data, pos = cConn.startEphemeralPacketWithHeader(10)
+		pos = writeByte(data, pos, command)
+		pos = writeUint32(data, pos, stmtID)
+		pos = writeByte(data, pos, cursorType)
+		writeUint32(data, pos, 1)
+	case ComStmtSendLongData:
+		data, pos = cConn.startEphemeralPacketWithHeader(8)
+		pos = writeByte(data, pos, command)
+		pos = writeUint32(data, pos, stmtID)
+		pos = writeUint16(data, pos, uint16(arg))
+		writeByte(data, pos, 'x')
+	case ComStmtFetch:
+		data, pos = cConn.startEphemeralPacketWithHeader(9)
+		pos = writeByte(data, pos, command)
+		pos = writeUint32(data, pos, stmtID)
+		writeUint32(data, pos, arg)
+	default:
+		data, pos = cConn.startEphemeralPacketWithHeader(5)
+		pos = writeByte(data, pos, command)
+		writeUint32(data, pos, stmtID)
+	}
+	require.NoError(t, cConn.writeEphemeralPacket())
+}
+
+// readCursorRows reads binary rows up to the end of a result, and
+// returns their count and the status flags of the end packet.
+func readCursorRows(t *testing.T, cConn *Conn) (int, uint16) {
+	rows := 0
+	for {
+		data, err := cConn.ReadPacket()
+		require.NoError(t, err)
+		if isEOFPacket(data) {
+			_, flags, err := parseEOFPacket(data)
+			require.NoError(t, err)
+			return rows, flags
+		}
+		require.EqualValues(t, OKPacket, data[0], "not a binary row: %v", data)
+		rows++
+	}
+}
+
+func newCursorTestConns(t *testing.T) (*Conn, *Conn, func()) {
+	listener, sConn, cConn := createSocketPair(t)
+	sConn.PrepareData = map[uint32]*PrepareData{
+		1: {
+			StatementID: 1,
+			PrepareStmt: "select id from t",
+			BindVars:    map[string]*querypb.BindVariable{},
+		},
+	}
+	return sConn, cConn, func() {
+		sConn.closeCursors()
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}
+}
+
+func TestComStmtFetch(t *testing.T) {
+	sConn, cConn, cleanup := newCursorTestConns(t)
+	defer cleanup()
+	handler := &cursorHandler{rows: 5}
+
+	writeCursorCommand(t, cConn, ComStmtExecute, 1, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+
+	// The response has the metadata of the result set only.
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, data[0])
+	_, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	rows, flags := readCursorRows(t, cConn)
+	assert.Equal(t, 0, rows)
+	assert.Equal(t, ServerStatusCursorExists, flags&ServerStatusCursorExists)
+	assert.Less(t, handler.rowsSent(), 5, "the rows are read on demand")
+
+	writeCursorCommand(t, cConn, ComStmtFetch, 1, 2, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows, flags = readCursorRows(t, cConn)
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, ServerStatusCursorExists, flags&ServerStatusCursorExists)
+	assert.Zero(t, flags&ServerStatusLastRowSent)
+
+	writeCursorCommand(t, cConn, ComStmtFetch, 1, 3, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows, flags = readCursorRows(t, cConn)
+	assert.Equal(t, 3, rows)
+	assert.Equal(t, ServerStatusLastRowSent, flags&ServerStatusLastRowSent)
+
+	// The cursor is closed after its last row.
+	writeCursorCommand(t, cConn, ComStmtFetch, 1, 1, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	data, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	require.EqualValues(t, ErrPacket, data[0])
+	assert.Contains(t, ParseErrorPacket(data).Error(), "has no open cursor (errno 1421)")
+
+	writeCursorCommand(t, cConn, ComStmtFetch, 2, 1, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	data, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	require.EqualValues(t, ErrPacket, data[0])
+	assert.Contains(t, ParseErrorPacket(data).Error(), "errno 1243")
+}
+
+func TestComStmtResetClosesCursor(t *testing.T) {
+	sConn, cConn, cleanup := newCursorTestConns(t)
+	defer cleanup()
+	handler := &cursorHandler{rows: 100}
+
+	writeCursorCommand(t, cConn, ComStmtExecute, 1, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+	_, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	_, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	readCursorRows(t, cConn)
+
+	writeCursorCommand(t, cConn, ComStmtReset, 1, 0, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	require.EqualValues(t, OKPacket, data[0])
+	handler.mu.Lock()
+	assert.True(t, handler.stopped, "the execution was stopped")
+	handler.mu.Unlock()
+	assert.Nil(t, sConn.PrepareData[1].cursor)
+
+	// An unknown statement is an error, not a crash.
+	writeCursorCommand(t, cConn, ComStmtReset, 2, 0, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	data, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	require.EqualValues(t, ErrPacket, data[0])
+}
+
+func TestCursorMaterializedBeforeCommands(t *testing.T) {
+	sConn, cConn, cleanup := newCursorTestConns(t)
+	defer cleanup()
+	sConn.PrepareData[2] = &PrepareData{
+		StatementID: 2,
+		PrepareStmt: "select id from t2",
+		BindVars:    map[string]*querypb.BindVariable{},
+	}
+	handler := &cursorHandler{rows: 10}
+
+	writeCursorCommand(t, cConn, ComStmtExecute, 1, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+	for i := 0; i < 2; i++ {
+		_, err := cConn.ReadPacket()
+		require.NoError(t, err)
+	}
+	readCursorRows(t, cConn)
+
+	// Executing another statement receives all the rows of the open
+	// cursor first, so that the handler doesn't run concurrently.
+	writeCursorCommand(t, cConn, ComStmtExecute, 2, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+	assert.Equal(t, 10, handler.rowsSent())
+	for i := 0; i < 2; i++ {
+		_, err := cConn.ReadPacket()
+		require.NoError(t, err)
+	}
+	readCursorRows(t, cConn)
+
+	writeCursorCommand(t, cConn, ComStmtFetch, 1, 100, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows, flags := readCursorRows(t, cConn)
+	assert.Equal(t, 10, rows)
+	assert.Equal(t, ServerStatusLastRowSent, flags&ServerStatusLastRowSent)
+	assert.Equal(t, 2, handler.executions)
+}
+
+func TestComStmtSendLongDataWithOpenCursor(t *testing.T) {
+	sConn, cConn, cleanup := newCursorTestConns(t)
+	defer cleanup()
+	sConn.PrepareData[1].BindVars["v1"] = sqltypes.Int64BindVariable(1)
+	handler := &cursorHandler{rows: 100}
+
+	writeCursorCommand(t, cConn, ComStmtExecute, 1, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+	for i := 0; i < 2; i++ {
+		_, err := cConn.ReadPacket()
+		require.NoError(t, err)
+	}
+	readCursorRows(t, cConn)
+
+	// The long data of the next execution doesn't change the bind
+	// variables of the open cursor, while its handler still runs.
+	sConn.PrepareData[1].ParamsCount = 1
+	writeCursorCommand(t, cConn, ComStmtSendLongData, 1, 0, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	assert.Equal(t, []byte("x"), sConn.PrepareData[1].BindVars["v1"].Value)
+
+	writeCursorCommand(t, cConn, ComStmtFetch, 1, 100, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows, _ := readCursorRows(t, cConn)
+	assert.Equal(t, 100, rows)
+	handler.mu.Lock()
+	assert.Equal(t, map[string]*querypb.BindVariable{"v1": sqltypes.Int64BindVariable(1)}, handler.bindVars)
+	handler.mu.Unlock()
+	// The handler state of the execution is kept.
+	assert.Equal(t, 1, sConn.PrepareData[1].ClientData)
+}
+
+func TestCursorExecutionPanic(t *testing.T) {
+	sConn, cConn, cleanup := newCursorTestConns(t)
+	defer cleanup()
+	handler := &cursorHandler{rows: 10, panicAfter: 2}
+
+	writeCursorCommand(t, cConn, ComStmtExecute, 1, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+	for i := 0; i < 2; i++ {
+		_, err := cConn.ReadPacket()
+		require.NoError(t, err)
+	}
+	readCursorRows(t, cConn)
+
+	// The panic of the execution is the error of the next fetch.
+	writeCursorCommand(t, cConn, ComStmtFetch, 1, 5, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	require.EqualValues(t, ErrPacket, data[0])
+	assert.Contains(t, ParseErrorPacket(data).Error(), "cursor execution failed: test panic")
+	assert.Nil(t, sConn.PrepareData[1].cursor)
+}
+
+func TestCursorBufferSizeLimit(t *testing.T) {
+	sConn, cConn, cleanup := newCursorTestConns(t)
+	defer cleanup()
+	sConn.PrepareData[2] = &PrepareData{
+		StatementID: 2,
+		PrepareStmt: "select id from t2",
+		BindVars:    map[string]*querypb.BindVariable{},
+	}
+	handler := &cursorHandler{rows: 10}
+	defer func(size int64) {
+		*mysqlServerCursorBufferSize = size
+	}(*mysqlServerCursorBufferSize)
+	*mysqlServerCursorBufferSize = 5
+
+	writeCursorCommand(t, cConn, ComStmtExecute, 1, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+	for i := 0; i < 2; i++ {
+		_, err := cConn.ReadPacket()
+		require.NoError(t, err)
+	}
+	readCursorRows(t, cConn)
+
+	// The rows of the open cursor don't fit in the buffer, so the other
+	// statement isn't executed.
+	writeCursorCommand(t, cConn, ComStmtExecute, 2, 0, CursorTypeReadOnly)
+	require.True(t, sConn.handleNextCommand(handler))
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	require.EqualValues(t, ErrPacket, data[0])
+	assert.Contains(t, ParseErrorPacket(data).Error(), "errno 1041")
+	assert.Equal(t, 1, handler.executions)
+
+	// The cursor is still open, with all its rows.
+	writeCursorCommand(t, cConn, ComStmtFetch, 1, 100, 0)
+	require.True(t, sConn.handleNextCommand(handler))
+	rows, flags := readCursorRows(t, cConn)
+	assert.Equal(t, 10, rows)
+	assert.Equal(t, ServerStatusLastRowSent, flags&ServerStatusLastRowSent)
+}
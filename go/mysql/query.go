@@ -216,11 +216,13 @@ func (c *Conn) readColumnDefinitionType(field *querypb.Field, index int) error {
 	// Skip length of fixed-length fields.
 	pos++
 
-	// characterSet is a uint16.
-	_, pos, ok = readUint16(colDef, pos)
+	// characterSet is a uint16. It is kept because vtgate
+	// needs the collation to compare text values.
+	characterSet, pos, ok := readUint16(colDef, pos)
 	if !ok {
 		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extracting col %v characterSet failed", index)
 	}
+	field.Charset = uint32(characterSet)
 
 	// columnLength is a uint32.
 	_, pos, ok = readUint32(colDef, pos)
@@ -594,11 +596,20 @@ func (c *Conn) parseComStmtExecute(prepareData map[uint32]*PrepareData, data []b
 				return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading parameter flags failed")
 			}
 
-			// convert MySQL type to internal type.
-			valType, err := sqltypes.MySQLToType(int64(mysqlType), int64(flags))
+			// convert MySQL type to internal type. The only flag
+			// of a parameter type is the unsigned flag.
+			var typeFlags int64
+			if flags&stmtParamUnsigned != 0 {
+				typeFlags = int64(querypb.MySqlFlag_UNSIGNED_FLAG)
+			}
+			valType, err := sqltypes.MySQLToType(int64(mysqlType), typeFlags)
 			if err != nil {
 				return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "MySQLToType(%v,%v) failed: %v", mysqlType, flags, err)
 			}
+			if valType == sqltypes.Year {
+				// MySQL reads YEAR parameters as strings.
+				valType = sqltypes.VarBinary
+			}
 
 			prepare.ParamsType[i] = int32(valType)
 		}
@@ -669,7 +680,10 @@ func (c *Conn) parseStmtArgs(data []byte, typ querypb.Type, pos int) (sqltypes.V
 		}
 		switch size {
 		case 0x00:
-			return sqltypes.NewVarChar(" "), pos, ok
+			if typ == sqltypes.Date {
+				return sqltypes.NewVarChar("0000-00-00"), pos, ok
+			}
+			return sqltypes.NewVarChar("0000-00-00 00:00:00"), pos, ok
 		case 0x0b:
 			year, pos, ok := readUint16(data, pos)
 			if !ok {
@@ -699,13 +713,7 @@ func (c *Conn) parseStmtArgs(data []byte, typ querypb.Type, pos int) (sqltypes.V
 			if !ok {
 				return sqltypes.NULL, 0, false
 			}
-			val := strconv.Itoa(int(year)) + "-" +
-				strconv.Itoa(int(month)) + "-" +
-				strconv.Itoa(int(day)) + " " +
-				strconv.Itoa(int(hour)) + ":" +
-				strconv.Itoa(int(minute)) + ":" +
-				strconv.Itoa(int(second)) + "." +
-				fmt.Sprintf("%06d", microSecond)
+			val := fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", year, month, day, hour, minute, second, microSecond)
 
 			return sqltypes.NewVarChar(val), pos, ok
 		case 0x07:
@@ -733,12 +741,7 @@ func (c *Conn) parseStmtArgs(data []byte, typ querypb.Type, pos int) (sqltypes.V
 			if !ok {
 				return sqltypes.NULL, 0, false
 			}
-			val := strconv.Itoa(int(year)) + "-" +
-				strconv.Itoa(int(month)) + "-" +
-				strconv.Itoa(int(day)) + " " +
-				strconv.Itoa(int(hour)) + ":" +
-				strconv.Itoa(int(minute)) + ":" +
-				strconv.Itoa(int(second))
+			val := fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, minute, second)
 
 			return sqltypes.NewVarChar(val), pos, ok
 		case 0x04:
@@ -754,9 +757,7 @@ func (c *Conn) parseStmtArgs(data []byte, typ querypb.Type, pos int) (sqltypes.V
 			if !ok {
 				return sqltypes.NULL, 0, false
 			}
-			val := strconv.Itoa(int(year)) + "-" +
-				strconv.Itoa(int(month)) + "-" +
-				strconv.Itoa(int(day))
+			val := fmt.Sprintf("%04d-%02d-%02d", year, month, day)
 
 			return sqltypes.NewVarChar(val), pos, ok
 		default:
@@ -803,10 +804,7 @@ func (c *Conn) parseStmtArgs(data []byte, typ querypb.Type, pos int) (sqltypes.V
 			if isNegative == 0x01 {
 				val += "-"
 			}
-			val += strconv.Itoa(int(hours)) + ":" +
-				strconv.Itoa(int(minute)) + ":" +
-				strconv.Itoa(int(second)) + "." +
-				fmt.Sprintf("%06d", microSecond)
+			val += fmt.Sprintf("%02d:%02d:%02d.%06d", hours, minute, second, microSecond)
 
 			return sqltypes.NewVarChar(val), pos, ok
 		case 0x08:
@@ -838,9 +836,7 @@ func (c *Conn) parseStmtArgs(data []byte, typ querypb.Type, pos int) (sqltypes.V
 			if isNegative == 0x01 {
 				val += "-"
 			}
-			val += strconv.Itoa(int(hours)) + ":" +
-				strconv.Itoa(int(minute)) + ":" +
-				strconv.Itoa(int(second))
+			val += fmt.Sprintf("%02d:%02d:%02d", hours, minute, second)
 
 			return sqltypes.NewVarChar(val), pos, ok
 		default:
@@ -880,6 +876,15 @@ func (c *Conn) parseComStmtReset(data []byte) (uint32, bool) {
 	return val, ok
 }
 
+func (c *Conn) parseComStmtFetch(data []byte) (uint32, uint32, bool) {
+	stmtID, pos, ok := readUint32(data, 1)
+	if !ok {
+		return 0, 0, false
+	}
+	numRows, _, ok := readUint32(data, pos)
+	return stmtID, numRows, ok
+}
+
 func (c *Conn) parseComInitDB(data []byte) string {
 	return string(data[1:])
 }
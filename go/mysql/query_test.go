@@ -314,15 +314,15 @@ func TestComStmtExecuteUpdStmt(t *testing.T) {
 	prepData := prepareDataMap[stmtID]
 	assert.EqualValues(t, querypb.Type_BIT, prepData.ParamsType[0], "got: %s", querypb.Type(prepData.ParamsType[0]))
 	assert.EqualValues(t, querypb.Type_INT8, prepData.ParamsType[1], "got: %s", querypb.Type(prepData.ParamsType[1]))
-	assert.EqualValues(t, querypb.Type_INT8, prepData.ParamsType[2], "got: %s", querypb.Type(prepData.ParamsType[2]))
+	assert.EqualValues(t, querypb.Type_UINT8, prepData.ParamsType[2], "got: %s", querypb.Type(prepData.ParamsType[2]))
 	assert.EqualValues(t, querypb.Type_INT16, prepData.ParamsType[3], "got: %s", querypb.Type(prepData.ParamsType[3]))
-	assert.EqualValues(t, querypb.Type_INT16, prepData.ParamsType[4], "got: %s", querypb.Type(prepData.ParamsType[4]))
+	assert.EqualValues(t, querypb.Type_UINT16, prepData.ParamsType[4], "got: %s", querypb.Type(prepData.ParamsType[4]))
 	assert.EqualValues(t, querypb.Type_INT32, prepData.ParamsType[5], "got: %s", querypb.Type(prepData.ParamsType[5]))
-	assert.EqualValues(t, querypb.Type_INT32, prepData.ParamsType[6], "got: %s", querypb.Type(prepData.ParamsType[6]))
+	assert.EqualValues(t, querypb.Type_UINT32, prepData.ParamsType[6], "got: %s", querypb.Type(prepData.ParamsType[6]))
 	assert.EqualValues(t, querypb.Type_INT32, prepData.ParamsType[7], "got: %s", querypb.Type(prepData.ParamsType[7]))
-	assert.EqualValues(t, querypb.Type_INT32, prepData.ParamsType[8], "got: %s", querypb.Type(prepData.ParamsType[8]))
+	assert.EqualValues(t, querypb.Type_UINT32, prepData.ParamsType[8], "got: %s", querypb.Type(prepData.ParamsType[8]))
 	assert.EqualValues(t, querypb.Type_INT64, prepData.ParamsType[9], "got: %s", querypb.Type(prepData.ParamsType[9]))
-	assert.EqualValues(t, querypb.Type_INT64, prepData.ParamsType[10], "got: %s", querypb.Type(prepData.ParamsType[10]))
+	assert.EqualValues(t, querypb.Type_UINT64, prepData.ParamsType[10], "got: %s", querypb.Type(prepData.ParamsType[10]))
 	assert.EqualValues(t, querypb.Type_DECIMAL, prepData.ParamsType[11], "got: %s", querypb.Type(prepData.ParamsType[11]))
 	assert.EqualValues(t, querypb.Type_FLOAT32, prepData.ParamsType[12], "got: %s", querypb.Type(prepData.ParamsType[12]))
 	assert.EqualValues(t, querypb.Type_FLOAT64, prepData.ParamsType[13], "got: %s", querypb.Type(prepData.ParamsType[13]))
@@ -331,7 +331,7 @@ func TestComStmtExecuteUpdStmt(t *testing.T) {
 	assert.EqualValues(t, querypb.Type_TIMESTAMP, prepData.ParamsType[16], "got: %s", querypb.Type(prepData.ParamsType[16]))
 	assert.EqualValues(t, querypb.Type_TIME, prepData.ParamsType[17], "got: %s", querypb.Type(prepData.ParamsType[17]))
 
-	// this is year but in binary it is changed to varbinary
+	// this is year, but MySQL reads it as a string
 	assert.EqualValues(t, querypb.Type_VARBINARY, prepData.ParamsType[18], "got: %s", querypb.Type(prepData.ParamsType[18]))
 
 	assert.EqualValues(t, querypb.Type_CHAR, prepData.ParamsType[19], "got: %s", querypb.Type(prepData.ParamsType[19]))
@@ -344,6 +344,15 @@ func TestComStmtExecuteUpdStmt(t *testing.T) {
 	assert.EqualValues(t, querypb.Type_CHAR, prepData.ParamsType[26], "got: %s", querypb.Type(prepData.ParamsType[26]))
 	assert.EqualValues(t, querypb.Type_CHAR, prepData.ParamsType[27], "got: %s", querypb.Type(prepData.ParamsType[27]))
 	assert.EqualValues(t, querypb.Type_CHAR, prepData.ParamsType[28], "got: %s", querypb.Type(prepData.ParamsType[28]))
+
+	// The unsigned parameters keep their values.
+	assert.Equal(t, sqltypes.Int64BindVariable(-128), prepData.BindVars["v2"])
+	assert.Equal(t, sqltypes.Uint64BindVariable(255), prepData.BindVars["v3"])
+	assert.Equal(t, sqltypes.Uint64BindVariable(18446744073709551615), prepData.BindVars["v11"])
+	assert.Equal(t, sqltypes.ValueBindVariable(sqltypes.NewVarChar("2016-08-08")), prepData.BindVars["v15"])
+	assert.Equal(t, sqltypes.ValueBindVariable(sqltypes.NewVarChar("2016-08-08 17:25:59.000000")), prepData.BindVars["v16"])
+	assert.Equal(t, sqltypes.ValueBindVariable(sqltypes.NewVarChar("-199:59:59.000000")), prepData.BindVars["v18"])
+	assert.Equal(t, sqltypes.BytesBindVariable([]byte("1999")), prepData.BindVars["v19"])
 }
 
 func TestComStmtClose(t *testing.T) {
@@ -39,9 +39,10 @@ func TestResult(t *testing.T) {
 		Type: Float64,
 	}}
 	sqlResult := &Result{
-		Fields:       fields,
-		InsertID:     1,
-		RowsAffected: 2,
+		Fields:              fields,
+		InsertID:            1,
+		RowsAffected:        2,
+		SessionStateChanges: "uuid:5",
 		Rows: [][]Value{{
 			TestValue(VarChar, "aa"),
 			TestValue(Int64, "1"),
@@ -53,9 +54,10 @@ func TestResult(t *testing.T) {
 		}},
 	}
 	p3Result := &querypb.QueryResult{
-		Fields:       fields,
-		InsertId:     1,
-		RowsAffected: 2,
+		Fields:              fields,
+		InsertId:            1,
+		RowsAffected:        2,
+		SessionStateChanges: "uuid:5",
 		Rows: []*querypb.Row{{
 			Lengths: []int64{2, 1, 1},
 			Values:  []byte("aa12"),
@@ -70,7 +72,7 @@ func TestResult(t *testing.T) {
 	}
 
 	reverse := Proto3ToResult(p3Result)
-	if !reverse.Equal(sqlResult) {
+	if !reverse.Equal(sqlResult) || reverse.SessionStateChanges != sqlResult.SessionStateChanges {
 		t.Errorf("reverse:\n%#v, want\n%#v", reverse, sqlResult)
 	}
 
@@ -224,6 +224,8 @@ func (result *Result) StripMetadata(incl querypb.ExecuteOptions_IncludedFields)
 	for i, f := range result.Fields {
 		r.Fields[i] = &newFieldsArray[i]
 		newFieldsArray[i].Type = f.Type
+		// the collation is needed to compare text values
+		newFieldsArray[i].Charset = f.Charset
 		if incl == querypb.ExecuteOptions_TYPE_AND_NAME {
 			newFieldsArray[i].Name = f.Name
 		}
@@ -189,9 +189,11 @@ func TestStripMetaData(t *testing.T) {
 		},
 		expected: &Result{
 			Fields: []*querypb.Field{{
-				Type: Int64,
+				Type:    Int64,
+				Charset: 63,
 			}, {
-				Type: VarChar,
+				Type:    VarChar,
+				Charset: 63,
 			}},
 		},
 	}, {
@@ -270,11 +272,13 @@ func TestStripMetaData(t *testing.T) {
 		},
 		expected: &Result{
 			Fields: []*querypb.Field{{
-				Name: "field1",
-				Type: Int64,
+				Name:    "field1",
+				Type:    Int64,
+				Charset: 63,
 			}, {
-				Name: "field2",
-				Type: VarChar,
+				Name:    "field2",
+				Type:    VarChar,
+				Charset: 63,
 			}},
 		},
 	}}
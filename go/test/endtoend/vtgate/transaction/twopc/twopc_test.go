@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twopc
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/test/endtoend/cluster"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	clusterInstance *cluster.LocalProcessCluster
+	vtParams        mysql.ConnParams
+	keyspaceName    = "ks"
+	cell            = "zone1"
+	hostname        = "localhost"
+	sqlSchema       = `
+	create table twopc_user (
+		user_id bigint,
+		name varchar(128),
+		primary key (user_id)
+	) Engine=InnoDB;`
+
+	vSchema = `
+	{
+		"sharded":true,
+		"vindexes": {
+			"hash_index": {
+				"type": "hash"
+			}
+		},
+		"tables": {
+			"twopc_user":{
+				"column_vindexes": [
+					{
+						"column": "user_id",
+						"name": "hash_index"
+					}
+				]
+			}
+		}
+	}
+	`
+)
+
+func TestMain(m *testing.M) {
+	defer cluster.PanicHandler(nil)
+	flag.Parse()
+
+	exitcode, err := func() (int, error) {
+		clusterInstance = cluster.NewCluster(cell, hostname)
+		defer clusterInstance.Teardown()
+
+		// Reserve vtGate port in order to pass it to vtTablet
+		clusterInstance.VtgateGrpcPort = clusterInstance.GetAndReservePort()
+		// Set extra tablet args for twopc
+		clusterInstance.VtTabletExtraArgs = []string{
+			"-twopc_enable",
+			"-twopc_coordinator_address", fmt.Sprintf("localhost:%d", clusterInstance.VtgateGrpcPort),
+			"-twopc_abandon_age", "3600",
+		}
+
+		// Start topo server
+		if err := clusterInstance.StartTopo(); err != nil {
+			return 1, err
+		}
+
+		// Start keyspace, with a replica per shard for the reparents.
+		keyspace := &cluster.Keyspace{
+			Name:      keyspaceName,
+			SchemaSQL: sqlSchema,
+			VSchema:   vSchema,
+		}
+		if err := clusterInstance.StartKeyspace(*keyspace, []string{"-80", "80-"}, 1, false); err != nil {
+			return 1, err
+		}
+
+		// Starting Vtgate in TWOPC transaction mode
+		clusterInstance.VtGateExtraArgs = []string{"-transaction_mode", "TWOPC"}
+		if err := clusterInstance.StartVtgate(); err != nil {
+			return 1, err
+		}
+		vtParams = mysql.ConnParams{
+			Host: clusterInstance.Hostname,
+			Port: clusterInstance.VtgateMySQLPort,
+		}
+
+		return m.Run(), nil
+	}()
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		os.Exit(1)
+	} else {
+		os.Exit(exitcode)
+	}
+}
+
+func exec(t *testing.T, conn *mysql.Conn, query string) {
+	t.Helper()
+	_, err := conn.ExecuteFetch(query, 1000, true)
+	require.NoError(t, err)
+}
+
+func distributedTransaction(t *testing.T, args ...string) string {
+	t.Helper()
+	out, err := clusterInstance.VtctlclientProcess.ExecuteCommandWithOutput(append([]string{"DistributedTransaction"}, args...)...)
+	require.NoError(t, err, out)
+	return out
+}
+
+func listTransactions(t *testing.T) []*wrangler.DistributedTransaction {
+	t.Helper()
+	var transactions []*wrangler.DistributedTransaction
+	require.NoError(t, json.Unmarshal([]byte(distributedTransaction(t, "-json", "list", keyspaceName)), &transactions))
+	return transactions
+}
+
+// TestDistributedTransaction commits a distributed transaction, and
+// concludes an unresolved one.
+func TestDistributedTransaction(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	conn, err := mysql.Connect(context.Background(), &vtParams)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	exec(t, conn, "begin")
+	exec(t, conn, "insert into twopc_user(user_id, name) values (1, 'john'), (4, 'dave')")
+	exec(t, conn, "commit")
+	assert.Empty(t, listTransactions(t))
+
+	// A transaction whose coordinator failed before the commit decision.
+	dtid := "ks:80-:1234"
+	mm := clusterInstance.Keyspaces[0].Shards[1].Vttablets[0]
+	_, err = mm.VttabletProcess.QueryTablet(fmt.Sprintf("insert into _vt.dt_state(dtid, state, time_created) values ('%s', 1, %d)", dtid, time.Now().UnixNano()), keyspaceName, false)
+	require.NoError(t, err)
+	_, err = mm.VttabletProcess.QueryTablet(fmt.Sprintf("insert into _vt.dt_participant(dtid, id, keyspace, shard) values ('%s', 1, 'ks', '-80')", dtid), keyspaceName, false)
+	require.NoError(t, err)
+
+	transactions := listTransactions(t)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, dtid, transactions[0].Dtid)
+	assert.Equal(t, "PREPARE", transactions[0].State)
+	require.Len(t, transactions[0].Participants, 1)
+	assert.Equal(t, "-80", transactions[0].Participants[0].Shard)
+
+	distributedTransaction(t, "conclude", dtid)
+	assert.Empty(t, listTransactions(t))
+}
+
+// TestPreparedTransactionReparent checks that a prepared transaction
+// survives a planned reparent, and is resolved on the new primary.
+func TestPreparedTransactionReparent(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	shard := clusterInstance.Keyspaces[0].Shards[0]
+	primary, replica := shard.Vttablets[0], shard.Vttablets[1]
+
+	dtid := "ks:80-:5678"
+	_, err := primary.VttabletProcess.QueryTablet(fmt.Sprintf("insert into _vt.redo_state(dtid, state, time_created) values ('%s', 1, %d)", dtid, time.Now().UnixNano()), keyspaceName, false)
+	require.NoError(t, err)
+	_, err = primary.VttabletProcess.QueryTablet(fmt.Sprintf("insert into _vt.redo_statement(dtid, id, statement) values ('%s', 1, 'insert into twopc_user(user_id, name) values (10, ''prs'')')", dtid), keyspaceName, false)
+	require.NoError(t, err)
+
+	out, err := clusterInstance.VtctlclientProcess.ExecuteCommandWithOutput(
+		"PlannedReparentShard", "-keyspace_shard", keyspaceName+"/"+shard.Name, "-new_master", replica.Alias)
+	require.NoError(t, err, out)
+	defer func() {
+		out, err := clusterInstance.VtctlclientProcess.ExecuteCommandWithOutput(
+			"PlannedReparentShard", "-keyspace_shard", keyspaceName+"/"+shard.Name, "-new_master", primary.Alias)
+		require.NoError(t, err, out)
+	}()
+
+	// The redo log of the transaction was replicated to the new primary.
+	var transaction wrangler.DistributedTransaction
+	require.NoError(t, json.Unmarshal([]byte(distributedTransaction(t, "-json", "read", dtid)), &transaction))
+	require.Len(t, transaction.Participants, 1)
+	assert.Equal(t, "-80", transaction.Participants[0].Shard)
+	assert.Equal(t, "Prepared", transaction.Participants[0].RedoState)
+
+	// The commit fails until the transaction was prepared again.
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		out, err := clusterInstance.VtctlclientProcess.ExecuteCommandWithOutput("DistributedTransaction", "force-resolve", dtid, "commit")
+		if err == nil {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), out)
+		time.Sleep(time.Second)
+	}
+	qr, err := replica.VttabletProcess.QueryTablet("select name from twopc_user where user_id = 10", keyspaceName, true)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARCHAR("prs")]]`, fmt.Sprintf("%v", qr.Rows))
+
+	require.NoError(t, json.Unmarshal([]byte(distributedTransaction(t, "-json", "read", dtid)), &transaction))
+	assert.Empty(t, transaction.Participants)
+}
@@ -89,9 +89,9 @@ func (c *cloneGen) sliceMethod(t types.Type, slice *types.Slice, spi generatorSP
 	c.addFunc(funcName,
 		//func (n Bytes) Clone() Bytes {
 		jen.Func().Id(funcName).Call(jen.Id("n").Id(typeString)).Id(typeString).Block(
-			//	res := make(Bytes, len(n))
-			jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n"))),
-			c.copySliceElement(slice.Elem(), spi),
+			//	if n == nil { return nil }
+			ifNilReturnNil("n"),
+			c.copySliceElement(t, slice.Elem(), spi),
 			//	return res
 			jen.Return(jen.Id("res")),
 		))
@@ -102,18 +102,23 @@ func (c *cloneGen) basicMethod(t types.Type, basic *types.Basic, spi generatorSP
 	return nil
 }
 
-func (c *cloneGen) copySliceElement(elType types.Type, spi generatorSPI) jen.Code {
+func (c *cloneGen) copySliceElement(t types.Type, elType types.Type, spi generatorSPI) jen.Code {
+	typeString := types.TypeString(t, noQualifier)
 	if isBasic(elType) {
+		//	res := make(Bytes, len(n))
 		//	copy(res, n)
-		return jen.Id("copy").Call(jen.Id("res"), jen.Id("n"))
+		return jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Id("len").Call(jen.Id("n"))).Line().
+			Id("copy").Call(jen.Id("res"), jen.Id("n"))
 	}
 
+	//	res := make(Bytes, 0, len(n))
 	//for _, x := range n {
 	//  res = append(res, CloneAST(x))
 	//}
 	spi.addType(elType)
 
-	return jen.For(jen.List(jen.Op("_"), jen.Id("x"))).Op(":=").Range().Id("n").Block(
+	return jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n"))).Line().
+		For(jen.List(jen.Op("_"), jen.Id("x"))).Op(":=").Range().Id("n").Block(
 		jen.Id("res").Op("=").Id("append").Call(jen.Id("res"), c.readValueOfType(elType, jen.Id("x"), spi)),
 	)
 }
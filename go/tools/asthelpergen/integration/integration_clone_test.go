@@ -64,3 +64,21 @@ func TestTypeException(t *testing.T) {
 
 	assert.Equal(t, expected, clone)
 }
+
+func TestCloneSlices(t *testing.T) {
+	// slices of basic types are copied
+	bytes := Bytes("abc")
+	clone := CloneBytes(bytes)
+	assert.Equal(t, Bytes("abc"), clone)
+	bytes[0] = 'x'
+	assert.Equal(t, Bytes("abc"), clone)
+	assert.Equal(t, []int{1, 2}, CloneSliceOfInt([]int{1, 2}))
+
+	// nil slices stay nil, and empty slices stay empty
+	assert.Nil(t, CloneBytes(nil))
+	assert.Nil(t, CloneSliceOfInt(nil))
+	assert.Nil(t, CloneLeafSlice(nil))
+	assert.Nil(t, CloneSliceOfAST(nil))
+	assert.Equal(t, LeafSlice{}, CloneLeafSlice(LeafSlice{}))
+	assert.Equal(t, []int{}, CloneSliceOfInt([]int{}))
+}
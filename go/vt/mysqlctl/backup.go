@@ -68,6 +68,10 @@ var (
 	// but none of them are complete.
 	ErrNoCompleteBackup = errors.New("backup(s) found but none are complete")
 
+	// ErrBackupHasChildren is returned when removing a backup that is the
+	// parent of incremental backups.
+	ErrBackupHasChildren = errors.New("backup is the parent of incremental backups")
+
 	// backupStorageHook contains the hook name to use to process
 	// backup files. If not set, we will not process the files. It is
 	// only used at backup time. Then it is put in the manifest,
@@ -174,6 +178,39 @@ func ParseBackupName(dir string, name string) (backupTime *time.Time, alias *top
 	return backupTime, alias, nil
 }
 
+// GetParentBackup returns the name of the backup a backup is incremental
+// to, or an empty string if it is a full backup.
+func GetParentBackup(ctx context.Context, bh backupstorage.BackupHandle) (string, error) {
+	var bm struct {
+		ParentBackup string
+	}
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return "", err
+	}
+	return bm.ParentBackup, nil
+}
+
+// RemoveBackup removes a backup from the BackupStorage. It returns
+// ErrBackupHasChildren if incremental backups are taken on top of it,
+// since they can't be restored without it.
+func RemoveBackup(ctx context.Context, bs backupstorage.BackupStorage, dir, name string) error {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return vterrors.Wrap(err, "ListBackups failed")
+	}
+	var children []string
+	for _, bh := range bhs {
+		// Incomplete backups have no MANIFEST, and no parent.
+		if parent, err := GetParentBackup(ctx, bh); err == nil && parent == name {
+			children = append(children, bh.Name())
+		}
+	}
+	if len(children) > 0 {
+		return vterrors.Wrapf(ErrBackupHasChildren, "cannot remove backup %v, remove the backups %v first", name, strings.Join(children, ", "))
+	}
+	return bs.RemoveBackup(ctx, dir, name)
+}
+
 // checkNoDB makes sure there is no user data already there.
 // Used by Restore, as we do not want to destroy an existing DB.
 // The user's database name must be given since we ignore all others.
@@ -284,6 +321,9 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 
 	metadataManager := &MetadataManager{}
 
+	if len(bhs) == 0 && params.isPointInTimeRestore() {
+		return nil, vterrors.Wrapf(ErrNoBackup, "cannot do a point-in-time restore without a backup in directory %v", backupDir)
+	}
 	if len(bhs) == 0 {
 		// There are no backups (not even broken/incomplete ones).
 		params.Logger.Errorf("no backup to restore on BackupStorage for directory %v. Starting up empty.", backupDir)
@@ -363,6 +403,19 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	if params.isPointInTimeRestore() {
+		params.Logger.Infof("Restore: replaying archived binary logs from %v", manifest.Position)
+		pos, err := replayBinlogArchive(ctx, params, manifest.Position)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "point-in-time restore failed")
+		}
+		manifest.Position = pos
+		err = metadataManager.UpsertLocalMetadata(params.Mysqld, map[string]string{"RestorePosition": mysql.EncodePosition(pos)}, params.DbName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err = removeStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
@@ -92,6 +92,13 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos: if non-zero, restore the most recent backup at or before
+	// this position, then replay the archived binary logs up to it.
+	RestoreToPos mysql.Position
+	// RestoreToTimestamp: if non-zero, restore the most recent backup that
+	// finished at or before this time, then replay the archived binary logs
+	// up to it.
+	RestoreToTimestamp time.Time
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -217,6 +224,23 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
+		if !params.RestoreToPos.IsZero() && !params.RestoreToPos.AtLeast(bm.Position) {
+			continue
+		}
+		if !params.RestoreToTimestamp.IsZero() {
+			finishedTime := bm.FinishedTime
+			if finishedTime == "" {
+				finishedTime = bm.BackupTime
+			}
+			t, err := time.Parse(time.RFC3339, finishedTime)
+			if err != nil {
+				params.Logger.Warningf("Restore: skipping backup %v/%v with invalid time %v: %v", backupDir, bh.Name(), finishedTime, err)
+				continue
+			}
+			if t.After(params.RestoreToTimestamp) {
+				continue
+			}
+		}
 		if !checkBackupTime /* not snapshot */ || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
 			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
 			break
@@ -226,6 +250,12 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 		if checkBackupTime {
 			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
 		}
+		if !params.RestoreToPos.IsZero() {
+			params.Logger.Errorf("No valid backup found before position %v", params.RestoreToPos)
+		}
+		if !params.RestoreToTimestamp.IsZero() {
+			params.Logger.Errorf("No valid backup found before time %v", params.RestoreToTimestamp.UTC().Format(time.RFC3339))
+		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
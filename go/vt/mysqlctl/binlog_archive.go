@@ -0,0 +1,635 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	vtenv "vitess.io/vitess/go/vt/env"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the archiving of the binary logs to the backup
+// storage, and their replay for point-in-time restores.
+//
+// Each archived binary log is stored like a backup, in the directory
+// returned by GetBinlogArchiveDir. It contains the binary log file and
+// a MANIFEST that records the GTIDs it contains.
+
+const (
+	// binlogArchiveFileName is the name of the binary log file
+	// in an archived binary log.
+	binlogArchiveFileName = "binlog"
+
+	// binlogEventHeaderSize is the size of the header of binlog events.
+	binlogEventHeaderSize = 19
+)
+
+var (
+	binlogArchiveFiles = stats.NewCounter("binlog_archive_files", "Number of binary log files archived to the backup storage")
+	binlogArchiveBytes = stats.NewCounter("binlog_archive_bytes", "Number of bytes of binary logs archived to the backup storage")
+
+	// binlogFileMagic starts every binary log file.
+	binlogFileMagic = []byte{0xfe, 'b', 'i', 'n'}
+)
+
+// BinlogArchiveManifest is the MANIFEST of an archived binary log.
+type BinlogArchiveManifest struct {
+	// BinlogFile is the name of the binary log on the tablet that archived it.
+	BinlogFile string
+
+	// TabletAlias is the tablet that archived the binary log.
+	TabletAlias string
+
+	// PreviousGTIDs is the position before the first transaction
+	// of the binary log.
+	PreviousGTIDs mysql.Position
+
+	// Position is the position after the last transaction
+	// of the binary log.
+	Position mysql.Position
+
+	// FirstTimestamp and LastTimestamp are the times (in RFC 3339 format, UTC)
+	// of the first and the last transactions of the binary log.
+	FirstTimestamp string
+	LastTimestamp  string
+
+	// Hash is the hash of the binary log file.
+	Hash string
+
+	// SkipCompress, CompressionEngine, ExternalDecompressor and Encryption
+	// have the same meaning as in the builtin backup MANIFEST.
+	SkipCompress         bool
+	CompressionEngine    string
+	ExternalDecompressor string
+	Encryption           *BackupEncryption `json:",omitempty"`
+}
+
+// BinlogArchiveParams is the struct that holds all params passed to ArchiveBinlogs.
+type BinlogArchiveParams struct {
+	Cnf    *Mycnf
+	Mysqld MysqlDaemon
+	Logger logutil.Logger
+	// Keyspace and Shard are used to infer the directory where the
+	// binary logs are archived.
+	Keyspace string
+	Shard    string
+	// TabletAlias is recorded in the name of the archived binary logs.
+	TabletAlias string
+}
+
+// GetBinlogArchiveDir returns the directory where the binary logs of
+// a shard are archived. It is next to the directory of the backups,
+// so the archived binary logs are not listed as backups.
+func GetBinlogArchiveDir(keyspace, shard string) string {
+	return fmt.Sprintf("%v/%v.binlogs", keyspace, shard)
+}
+
+// binlogArchiveName returns the name of an archived binary log. The
+// previous GTIDs are part of the name, since the binary log files
+// are numbered again after a RESET MASTER.
+func binlogArchiveName(tabletAlias, binlogFile string, previousGTIDs mysql.Position) string {
+	return fmt.Sprintf("%v.%v.%08x", tabletAlias, binlogFile, crc32.ChecksumIEEE([]byte(previousGTIDs.String())))
+}
+
+// ArchiveBinlogs uploads the binary logs of mysqld that were rotated and
+// are not archived yet. The binary log that is currently written is
+// never archived. Binary logs without any transaction are skipped.
+// It returns the number of archived binary logs.
+func ArchiveBinlogs(ctx context.Context, params BinlogArchiveParams) (int, error) {
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return 0, vterrors.Wrap(err, "cannot list binary logs")
+	}
+	if len(qr.Rows) < 2 {
+		return 0, nil
+	}
+	binlogFiles := make([]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		binlogFiles = append(binlogFiles, row[0].ToString())
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return 0, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+	archiveDir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, archiveDir)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "ListBackups failed")
+	}
+	archived := make(map[string]bool, len(bhs))
+	for _, bh := range bhs {
+		archived[bh.Name()] = true
+	}
+
+	count := 0
+	previousGTIDs, err := binlogPreviousGTIDs(ctx, params.Mysqld, binlogFiles[0])
+	if err != nil {
+		return 0, err
+	}
+	for i, binlogFile := range binlogFiles[:len(binlogFiles)-1] {
+		position, err := binlogPreviousGTIDs(ctx, params.Mysqld, binlogFiles[i+1])
+		if err != nil {
+			return count, err
+		}
+		name := binlogArchiveName(params.TabletAlias, binlogFile, previousGTIDs)
+		if !archived[name] && !position.Equal(previousGTIDs) {
+			manifest := &BinlogArchiveManifest{
+				BinlogFile:    binlogFile,
+				TabletAlias:   params.TabletAlias,
+				PreviousGTIDs: previousGTIDs,
+				Position:      position,
+			}
+			if err := archiveBinlogFile(ctx, params, bs, archiveDir, name, manifest); err != nil {
+				return count, vterrors.Wrapf(err, "cannot archive binary log %v", binlogFile)
+			}
+			count++
+		}
+		previousGTIDs = position
+	}
+	return count, nil
+}
+
+// binlogPreviousGTIDs returns the GTIDs that were executed before the
+// given binary log, as recorded by its Previous_gtids event.
+func binlogPreviousGTIDs(ctx context.Context, mysqld MysqlDaemon, binlogFile string) (mysql.Position, error) {
+	query := fmt.Sprintf("SHOW BINLOG EVENTS IN %s LIMIT 2", sqltypes.EncodeStringSQL(binlogFile))
+	qr, err := mysqld.FetchSuperQuery(ctx, query)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrapf(err, "cannot read the events of binary log %v", binlogFile)
+	}
+	for _, row := range qr.Named().Rows {
+		if row.AsString("Event_type", "") != "Previous_gtids" {
+			continue
+		}
+		// Sets with several server UUIDs are split on several lines.
+		gtids := strings.Join(strings.Fields(row.AsString("Info", "")), "")
+		return mysql.ParsePosition(mysql.Mysql56FlavorID, gtids)
+	}
+	return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary log %v has no Previous_gtids event, binary log archiving requires MySQL with GTIDs", binlogFile)
+}
+
+// archiveBinlogFile uploads a binary log with its MANIFEST.
+func archiveBinlogFile(ctx context.Context, params BinlogArchiveParams, bs backupstorage.BackupStorage, archiveDir, name string, manifest *BinlogArchiveManifest) (finalErr error) {
+	binlogPath := path.Join(path.Dir(params.Cnf.BinLogPath), manifest.BinlogFile)
+	first, last, err := readBinlogFileTimestamps(binlogPath)
+	if err != nil {
+		return err
+	}
+	manifest.FirstTimestamp = first.UTC().Format(time.RFC3339)
+	manifest.LastTimestamp = last.UTC().Format(time.RFC3339)
+
+	var compressor BackupCompressor
+	manifest.SkipCompress = !*backupStorageCompress
+	if *backupStorageCompress {
+		compressor, manifest.ExternalDecompressor, err = getBackupCompressor()
+		if err != nil {
+			return err
+		}
+		manifest.CompressionEngine = *compressionEngineName
+	}
+	encryption, bc, err := newBackupEncryption(ctx)
+	if err != nil {
+		return err
+	}
+	manifest.Encryption = encryption
+
+	source, err := os.Open(binlogPath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	fi, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	params.Logger.Infof("Archiving binary log %v as %v/%v", manifest.BinlogFile, archiveDir, name)
+	bh, err := bs.StartBackup(ctx, archiveDir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	defer func() {
+		if finalErr != nil {
+			if err := bh.AbortBackup(ctx); err != nil {
+				params.Logger.Errorf2(err, "failed to abort archive of binary log %v", manifest.BinlogFile)
+			}
+		}
+	}()
+
+	hasher := newHasher()
+	if err := writeArchiveFile(ctx, params.Logger, newEncryptedBackupHandle(bh, bc), compressor, io.TeeReader(source, hasher), fi.Size()); err != nil {
+		return err
+	}
+	manifest.Hash = hasher.HashString()
+
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to archive", backupManifestFileName)
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	if err := wc.Close(); err != nil {
+		return vterrors.Wrapf(err, "cannot close %v", backupManifestFileName)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		return err
+	}
+	binlogArchiveFiles.Add(1)
+	binlogArchiveBytes.Add(fi.Size())
+	return nil
+}
+
+// writeArchiveFile writes the binary log to the archive, through the
+// compressor if it is not nil.
+func writeArchiveFile(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, compressor BackupCompressor, source io.Reader, size int64) (finalErr error) {
+	wc, err := bh.AddFile(ctx, binlogArchiveFileName, size)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to archive", binlogArchiveFileName)
+	}
+	defer func() {
+		if err := wc.Close(); finalErr == nil {
+			finalErr = err
+		}
+	}()
+	dst := bufio.NewWriterSize(wc, writerBufferSize)
+	writer := io.Writer(dst)
+	var compressWriter io.WriteCloser
+	if compressor != nil {
+		compressWriter, err = compressor.Compress(ctx, writer, logger)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot create compressor")
+		}
+		writer = compressWriter
+	}
+	if _, err := io.Copy(writer, source); err != nil {
+		return vterrors.Wrap(err, "cannot copy data")
+	}
+	if compressWriter != nil {
+		if err := compressWriter.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
+		}
+	}
+	return dst.Flush()
+}
+
+// readBinlogFileTimestamps returns the times of the first and the last
+// transactions of a binary log file. Only the headers of the events
+// are read.
+func readBinlogFileTimestamps(binlogPath string) (first, last time.Time, err error) {
+	file, err := os.Open(binlogPath)
+	if err != nil {
+		return first, last, err
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+
+	magic := make([]byte, len(binlogFileMagic))
+	if _, err := io.ReadFull(reader, magic); err != nil || string(magic) != string(binlogFileMagic) {
+		return first, last, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v is not a binary log file", binlogPath)
+	}
+	header := make([]byte, binlogEventHeaderSize)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return first, last, vterrors.Wrapf(err, "cannot read binary log %v", binlogPath)
+		}
+		ev := mysql.NewMysql56BinlogEvent(header)
+		if ev.IsGTID() {
+			ts := time.Unix(int64(ev.Timestamp()), 0)
+			if first.IsZero() {
+				first = ts
+			}
+			last = ts
+		}
+		size := int(binary.LittleEndian.Uint32(header[9:13]))
+		if size < binlogEventHeaderSize {
+			return first, last, vterrors.Errorf(vtrpc.Code_DATA_LOSS, "invalid event size %v in binary log %v", size, binlogPath)
+		}
+		if _, err := reader.Discard(size - binlogEventHeaderSize); err != nil {
+			return first, last, vterrors.Wrapf(err, "cannot read binary log %v", binlogPath)
+		}
+	}
+	if first.IsZero() {
+		return first, last, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary log %v has no GTID event", binlogPath)
+	}
+	return first, last, nil
+}
+
+// archivedBinlog is an archived binary log and its MANIFEST.
+type archivedBinlog struct {
+	bh       backupstorage.BackupHandle
+	manifest *BinlogArchiveManifest
+}
+
+// listArchivedBinlogs returns the archived binary logs of a shard,
+// skipping the ones without a valid MANIFEST.
+func listArchivedBinlogs(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard string, logger logutil.Logger) ([]*archivedBinlog, error) {
+	archiveDir := GetBinlogArchiveDir(keyspace, shard)
+	bhs, err := bs.ListBackups(ctx, archiveDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	binlogs := make([]*archivedBinlog, 0, len(bhs))
+	for _, bh := range bhs {
+		manifest := &BinlogArchiveManifest{}
+		if err := getBackupManifestInto(ctx, bh, manifest); err != nil {
+			logger.Warningf("Possibly incomplete archived binary log %v in directory %v on BackupStorage: %v", bh.Name(), archiveDir, err)
+			continue
+		}
+		binlogs = append(binlogs, &archivedBinlog{bh: bh, manifest: manifest})
+	}
+	return binlogs, nil
+}
+
+// isPointInTimeRestore returns true if the archived binary logs
+// must be replayed after restoring the backup.
+func (params *RestoreParams) isPointInTimeRestore() bool {
+	return !params.RestoreToPos.IsZero() || !params.RestoreToTimestamp.IsZero()
+}
+
+// replayBinlogArchive replays the archived binary logs on top of a
+// restored backup, until params.RestoreToPos or params.RestoreToTimestamp.
+// It returns the position of mysqld when it is done.
+func replayBinlogArchive(ctx context.Context, params RestoreParams, pos mysql.Position) (mysql.Position, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return pos, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+	binlogs, err := listArchivedBinlogs(ctx, bs, params.Keyspace, params.Shard, params.Logger)
+	if err != nil {
+		return pos, err
+	}
+	if pos.IsZero() {
+		pos, _ = mysql.ParsePosition(mysql.Mysql56FlavorID, "")
+	}
+
+	for {
+		if !params.RestoreToPos.IsZero() && pos.AtLeast(params.RestoreToPos) {
+			return pos, nil
+		}
+		next := nextBinlogToReplay(binlogs, pos)
+		if next == nil {
+			if !params.RestoreToPos.IsZero() {
+				return pos, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the archived binary logs end at %v, which does not contain the requested position %v", pos, params.RestoreToPos)
+			}
+			return pos, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the archived binary logs end at %v, before the requested time %v", pos, params.RestoreToTimestamp.UTC().Format(time.RFC3339))
+		}
+		m := next.manifest
+		if !params.RestoreToTimestamp.IsZero() {
+			first, err := time.Parse(time.RFC3339, m.FirstTimestamp)
+			if err != nil {
+				return pos, vterrors.Wrapf(err, "invalid first timestamp in archived binary log %v", next.bh.Name())
+			}
+			if !first.Before(params.RestoreToTimestamp) {
+				// All the transactions before the requested time are applied.
+				return pos, nil
+			}
+		}
+
+		params.Logger.Infof("Restore: replaying archived binary log %v (%v to %v)", next.bh.Name(), m.FirstTimestamp, m.LastTimestamp)
+		if err := replayArchivedBinlog(ctx, params, next, pos); err != nil {
+			return pos, vterrors.Wrapf(err, "cannot replay archived binary log %v", next.bh.Name())
+		}
+		pos, err = params.Mysqld.PrimaryPosition()
+		if err != nil {
+			return pos, err
+		}
+
+		if !params.RestoreToTimestamp.IsZero() {
+			last, err := time.Parse(time.RFC3339, m.LastTimestamp)
+			if err != nil {
+				return pos, vterrors.Wrapf(err, "invalid last timestamp in archived binary log %v", next.bh.Name())
+			}
+			if !last.Before(params.RestoreToTimestamp) {
+				// The replay stopped in this binary log.
+				return pos, nil
+			}
+		}
+	}
+}
+
+// nextBinlogToReplay returns the archived binary log that follows pos
+// and adds the most transactions to it, or nil if there is none.
+func nextBinlogToReplay(binlogs []*archivedBinlog, pos mysql.Position) *archivedBinlog {
+	var next *archivedBinlog
+	for _, binlog := range binlogs {
+		m := binlog.manifest
+		if !pos.AtLeast(m.PreviousGTIDs) || pos.AtLeast(m.Position) {
+			continue
+		}
+		if next == nil || m.Position.AtLeast(next.manifest.Position) {
+			next = binlog
+		}
+	}
+	return next
+}
+
+// replayArchivedBinlog downloads an archived binary log and applies it.
+func replayArchivedBinlog(ctx context.Context, params RestoreParams, binlog *archivedBinlog, pos mysql.Position) error {
+	m := binlog.manifest
+	var compressor BackupCompressor
+	if !m.SkipCompress {
+		var err error
+		compressor, err = getRestoreCompressor(m.CompressionEngine, m.ExternalDecompressor)
+		if err != nil {
+			return err
+		}
+	}
+	bc, err := getRestoreCipher(ctx, m.Encryption)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(params.Cnf.TmpDir, "binlog-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if err := readArchiveFile(ctx, params.Logger, newEncryptedBackupHandle(binlog.bh, bc), compressor, m.Hash, tmpFile); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return params.Mysqld.ApplyBinlogFile(ctx, tmpFile.Name(), pos, params.RestoreToPos, params.RestoreToTimestamp)
+}
+
+// readArchiveFile reads the binary log of an archive into dst,
+// and checks its hash.
+func readArchiveFile(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, compressor BackupCompressor, hash string, dst io.Writer) error {
+	source, err := bh.ReadFile(ctx, binlogArchiveFileName)
+	if err != nil {
+		return vterrors.Wrap(err, "can't open source file for reading")
+	}
+	defer source.Close()
+	reader := io.Reader(source)
+	if compressor != nil {
+		decompressor, err := compressor.Decompress(ctx, reader, logger)
+		if err != nil {
+			return vterrors.Wrap(err, "can't open decompressor")
+		}
+		defer decompressor.Close()
+		reader = decompressor
+	}
+	hasher := newHasher()
+	if _, err := io.Copy(io.MultiWriter(dst, hasher), reader); err != nil {
+		return vterrors.Wrap(err, "failed to copy file contents")
+	}
+	if got := hasher.HashString(); got != hash {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "hash mismatch for %v, got %v expected %v", bh.Name(), got, hash)
+	}
+	return nil
+}
+
+// PruneBinlogArchive removes the archived binary logs that only contain
+// transactions that are in the oldest backup of the shard, since they
+// can't be replayed on top of any backup. Nothing is removed if the
+// shard has no complete backup. It returns the number of removed
+// archived binary logs.
+func PruneBinlogArchive(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard string, logger logutil.Logger) (int, error) {
+	bhs, err := bs.ListBackups(ctx, GetBackupDir(keyspace, shard))
+	if err != nil {
+		return 0, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var oldest *BackupManifest
+	for _, bh := range bhs {
+		if oldest, err = GetBackupManifest(ctx, bh); err == nil {
+			break
+		}
+	}
+	if oldest == nil || oldest.Position.IsZero() {
+		return 0, nil
+	}
+
+	binlogs, err := listArchivedBinlogs(ctx, bs, keyspace, shard, logger)
+	if err != nil {
+		return 0, err
+	}
+	archiveDir := GetBinlogArchiveDir(keyspace, shard)
+	count := 0
+	for _, binlog := range binlogs {
+		if !oldest.Position.AtLeast(binlog.manifest.Position) {
+			continue
+		}
+		logger.Infof("Removing archived binary log %v from %v, since it only contains transactions of the oldest backup", binlog.bh.Name(), archiveDir)
+		if err := bs.RemoveBackup(ctx, archiveDir, binlog.bh.Name()); err != nil {
+			return count, vterrors.Wrapf(err, "cannot remove archived binary log %v", binlog.bh.Name())
+		}
+		count++
+	}
+	return count, nil
+}
+
+// ApplyBinlogFile is part of the MysqlDaemon interface. It pipes the
+// output of mysqlbinlog to the mysql command line tool.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos, stopPos mysql.Position, stopTime time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogPath, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlPath, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	args := []string{}
+	if !restorePos.IsZero() && restorePos.GTIDSet.String() != "" {
+		args = append(args, "--exclude-gtids="+restorePos.GTIDSet.String())
+	}
+	if !stopPos.IsZero() && stopPos.GTIDSet.String() != "" {
+		args = append(args, "--include-gtids="+stopPos.GTIDSet.String())
+	}
+	if !stopTime.IsZero() {
+		args = append(args, "--stop-datetime="+stopTime.UTC().Format("2006-01-02 15:04:05"))
+	}
+	args = append(args, binlogFile)
+	binlogCmd := exec.CommandContext(ctx, mysqlbinlogPath, args...)
+	// mysqlbinlog reads --stop-datetime in the local time zone.
+	binlogCmd.Env = append(env, "TZ=UTC")
+	binlogStderr := &strings.Builder{}
+	binlogCmd.Stderr = binlogStderr
+
+	mysqlCmd := exec.CommandContext(ctx, mysqlPath, "--defaults-extra-file="+cnf, "--batch")
+	mysqlCmd.Env = env
+	mysqlOutput := &strings.Builder{}
+	mysqlCmd.Stdout = mysqlOutput
+	mysqlCmd.Stderr = mysqlOutput
+	mysqlCmd.Stdin, err = binlogCmd.StdoutPipe()
+	if err != nil {
+		return vterrors.Wrap(err, "cannot create stdout pipe")
+	}
+
+	if err := binlogCmd.Start(); err != nil {
+		return vterrors.Wrap(err, "cannot start mysqlbinlog")
+	}
+	if err := mysqlCmd.Run(); err != nil {
+		binlogCmd.Wait()
+		return vterrors.Wrapf(err, "mysql failed: %v", mysqlOutput.String())
+	}
+	if err := binlogCmd.Wait(); err != nil {
+		return vterrors.Wrapf(err, "mysqlbinlog failed: %v", binlogStderr.String())
+	}
+	return nil
+}
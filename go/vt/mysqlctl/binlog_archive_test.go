@@ -0,0 +1,394 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const testServerUUID = "00010203-0405-0607-0809-0a0b0c0d0e0f"
+
+func testPosition(t *testing.T, gtids string) mysql.Position {
+	if gtids != "" {
+		gtids = testServerUUID + ":" + gtids
+	}
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, gtids)
+	require.NoError(t, err)
+	return pos
+}
+
+// binlogEvent returns a binlog event with an empty body.
+func binlogEvent(eventType byte, timestamp uint32, bodySize int) []byte {
+	ev := make([]byte, binlogEventHeaderSize+bodySize)
+	binary.LittleEndian.PutUint32(ev[0:4], timestamp)
+	ev[4] = eventType
+	binary.LittleEndian.PutUint32(ev[9:13], uint32(len(ev)))
+	return ev
+}
+
+// writeTestBinlogFile writes a binary log file with a GTID event
+// and a query event for each timestamp.
+func writeTestBinlogFile(t *testing.T, file string, timestamps ...uint32) {
+	var buf bytes.Buffer
+	buf.Write(binlogFileMagic)
+	buf.Write(binlogEvent(0x0f /* FORMAT_DESCRIPTION_EVENT */, 0, 100))
+	for _, ts := range timestamps {
+		buf.Write(binlogEvent(0x21 /* GTID_LOG_EVENT */, ts, 42))
+		buf.Write(binlogEvent(0x02 /* QUERY_EVENT */, ts, 10))
+	}
+	require.NoError(t, os.WriteFile(file, buf.Bytes(), 0644))
+}
+
+// binlogTestMysqld is the MysqlDaemon used by the tests of this file.
+// ApplyBinlogFile moves the position to the one of the binary log
+// that is applied.
+type binlogTestMysqld struct {
+	MysqlDaemon
+
+	queries   map[string]*sqltypes.Result
+	position  mysql.Position
+	positions map[string]mysql.Position
+
+	applied    []string
+	restorePos []mysql.Position
+	stopPos    mysql.Position
+	stopTime   time.Time
+}
+
+func (m *binlogTestMysqld) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	qr, ok := m.queries[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %v", query)
+	}
+	return qr, nil
+}
+
+func (m *binlogTestMysqld) PrimaryPosition() (mysql.Position, error) {
+	return m.position, nil
+}
+
+func (m *binlogTestMysqld) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos, stopPos mysql.Position, stopTime time.Time) error {
+	data, err := os.ReadFile(binlogFile)
+	if err != nil {
+		return err
+	}
+	pos, ok := m.positions[string(data)]
+	if !ok {
+		return fmt.Errorf("unknown binary log %v", binlogFile)
+	}
+	m.applied = append(m.applied, string(data))
+	m.restorePos = append(m.restorePos, restorePos)
+	m.stopPos = stopPos
+	m.stopTime = stopTime
+	m.position = pos
+	return nil
+}
+
+func binlogEventsResult(previousGTIDs string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Log_name|Pos|Event_type|Info", "varchar|int64|varchar|varchar"),
+		"bin.000001|4|Format_desc|Server ver: 8.0.23, Binlog ver: 4",
+		"bin.000001|125|Previous_gtids|"+previousGTIDs,
+	)
+}
+
+func setupFileBackupStorage(t *testing.T) {
+	implementation, root := *backupstorage.BackupStorageImplementation, *filebackupstorage.FileBackupStorageRoot
+	t.Cleanup(func() {
+		*backupstorage.BackupStorageImplementation = implementation
+		*filebackupstorage.FileBackupStorageRoot = root
+	})
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = t.TempDir()
+}
+
+func TestBinlogArchiveName(t *testing.T) {
+	name := binlogArchiveName("zone1-0000000100", "bin.000001", testPosition(t, "1-5"))
+	assert.Regexp(t, `^zone1-0000000100\.bin\.000001\.[0-9a-f]{8}$`, name)
+	assert.Equal(t, name, binlogArchiveName("zone1-0000000100", "bin.000001", testPosition(t, "1-5")))
+	// After a RESET MASTER, the same file name has other GTIDs.
+	assert.NotEqual(t, name, binlogArchiveName("zone1-0000000100", "bin.000001", testPosition(t, "1-10")))
+}
+
+func TestBinlogPreviousGTIDs(t *testing.T) {
+	ctx := context.Background()
+	other := "10111213-1415-1617-1819-1a1b1c1d1e1f"
+	mysqld := &binlogTestMysqld{queries: map[string]*sqltypes.Result{
+		"SHOW BINLOG EVENTS IN 'bin.000001' LIMIT 2": binlogEventsResult(""),
+		// MySQL splits the sets with several server UUIDs on several lines.
+		"SHOW BINLOG EVENTS IN 'bin.000002' LIMIT 2": binlogEventsResult(testServerUUID + ":1-5,\n" + other + ":1-3"),
+		"SHOW BINLOG EVENTS IN 'bin.000003' LIMIT 2": sqltypes.MakeTestResult(sqltypes.MakeTestFields("Event_type|Info", "varchar|varchar"), "Format_desc|"),
+	}}
+
+	pos, err := binlogPreviousGTIDs(ctx, mysqld, "bin.000001")
+	require.NoError(t, err)
+	assert.True(t, pos.Equal(testPosition(t, "")))
+
+	pos, err = binlogPreviousGTIDs(ctx, mysqld, "bin.000002")
+	require.NoError(t, err)
+	want, err := mysql.ParsePosition(mysql.Mysql56FlavorID, testServerUUID+":1-5,"+other+":1-3")
+	require.NoError(t, err)
+	assert.True(t, pos.Equal(want), "got %v", pos)
+
+	_, err = binlogPreviousGTIDs(ctx, mysqld, "bin.000003")
+	assert.EqualError(t, err, "binary log bin.000003 has no Previous_gtids event, binary log archiving requires MySQL with GTIDs")
+}
+
+func TestReadBinlogFileTimestamps(t *testing.T) {
+	dir := t.TempDir()
+
+	file := path.Join(dir, "bin.000001")
+	writeTestBinlogFile(t, file, 1000, 1010, 1020)
+	first, last, err := readBinlogFileTimestamps(file)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1000, 0), first)
+	assert.Equal(t, time.Unix(1020, 0), last)
+
+	file = path.Join(dir, "bin.000002")
+	writeTestBinlogFile(t, file)
+	_, _, err = readBinlogFileTimestamps(file)
+	assert.EqualError(t, err, fmt.Sprintf("binary log %v has no GTID event", file))
+
+	file = path.Join(dir, "bin.000003")
+	require.NoError(t, os.WriteFile(file, []byte("not a binary log"), 0644))
+	_, _, err = readBinlogFileTimestamps(file)
+	assert.EqualError(t, err, fmt.Sprintf("%v is not a binary log file", file))
+}
+
+func TestArchiveAndReplayBinlogs(t *testing.T) {
+	setupFileBackupStorage(t)
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	dir := t.TempDir()
+	cnf := &Mycnf{BinLogPath: path.Join(dir, "bin"), TmpDir: dir}
+
+	// bin.000003 has no transaction, bin.000004 is the active binary log.
+	writeTestBinlogFile(t, path.Join(dir, "bin.000001"), 1000, 1100)
+	writeTestBinlogFile(t, path.Join(dir, "bin.000002"), 1200, 1300)
+	mysqld := &binlogTestMysqld{queries: map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"bin.000001|100", "bin.000002|100", "bin.000003|100", "bin.000004|100",
+		),
+		"SHOW BINLOG EVENTS IN 'bin.000001' LIMIT 2": binlogEventsResult(""),
+		"SHOW BINLOG EVENTS IN 'bin.000002' LIMIT 2": binlogEventsResult(testServerUUID + ":1-5"),
+		"SHOW BINLOG EVENTS IN 'bin.000003' LIMIT 2": binlogEventsResult(testServerUUID + ":1-10"),
+		"SHOW BINLOG EVENTS IN 'bin.000004' LIMIT 2": binlogEventsResult(testServerUUID + ":1-10"),
+	}}
+	archiveParams := BinlogArchiveParams{
+		Cnf:         cnf,
+		Mysqld:      mysqld,
+		Logger:      logger,
+		Keyspace:    "ks",
+		Shard:       "0",
+		TabletAlias: "zone1-0000000100",
+	}
+
+	count, err := ArchiveBinlogs(ctx, archiveParams)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	// The binary logs are only archived once.
+	count, err = ArchiveBinlogs(ctx, archiveParams)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+	binlogs, err := listArchivedBinlogs(ctx, bs, "ks", "0", logger)
+	require.NoError(t, err)
+	require.Len(t, binlogs, 2)
+	assert.Equal(t, "bin.000001", binlogs[0].manifest.BinlogFile)
+	assert.True(t, binlogs[0].manifest.PreviousGTIDs.Equal(testPosition(t, "")))
+	assert.True(t, binlogs[0].manifest.Position.Equal(testPosition(t, "1-5")))
+	assert.Equal(t, time.Unix(1000, 0).UTC().Format(time.RFC3339), binlogs[0].manifest.FirstTimestamp)
+	assert.Equal(t, time.Unix(1100, 0).UTC().Format(time.RFC3339), binlogs[0].manifest.LastTimestamp)
+	assert.Equal(t, "bin.000002", binlogs[1].manifest.BinlogFile)
+	// The backups of the shard don't list the archived binary logs.
+	bhs, err := bs.ListBackups(ctx, GetBackupDir("ks", "0"))
+	require.NoError(t, err)
+	assert.Empty(t, bhs)
+
+	data1, err := os.ReadFile(path.Join(dir, "bin.000001"))
+	require.NoError(t, err)
+	data2, err := os.ReadFile(path.Join(dir, "bin.000002"))
+	require.NoError(t, err)
+	positions := map[string]mysql.Position{
+		string(data1): testPosition(t, "1-5"),
+		string(data2): testPosition(t, "1-10"),
+	}
+	replay := func(restoreToPos mysql.Position, restoreToTimestamp time.Time) (*binlogTestMysqld, mysql.Position, error) {
+		m := &binlogTestMysqld{position: testPosition(t, "1-3"), positions: positions}
+		pos, err := replayBinlogArchive(ctx, RestoreParams{
+			Cnf:                cnf,
+			Mysqld:             m,
+			Logger:             logger,
+			Keyspace:           "ks",
+			Shard:              "0",
+			RestoreToPos:       restoreToPos,
+			RestoreToTimestamp: restoreToTimestamp,
+		}, testPosition(t, "1-3"))
+		return m, pos, err
+	}
+
+	m, pos, err := replay(testPosition(t, "1-8"), time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(data1), string(data2)}, m.applied)
+	assert.True(t, m.restorePos[0].Equal(testPosition(t, "1-3")))
+	assert.True(t, m.restorePos[1].Equal(testPosition(t, "1-5")))
+	assert.True(t, m.stopPos.Equal(testPosition(t, "1-8")))
+	assert.True(t, pos.Equal(testPosition(t, "1-10")))
+
+	m, _, err = replay(testPosition(t, "1-4"), time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(data1)}, m.applied)
+
+	_, _, err = replay(testPosition(t, "1-20"), time.Time{})
+	assert.Contains(t, err.Error(), "which does not contain the requested position")
+
+	// The requested time is between the two binary logs.
+	m, _, err = replay(mysql.Position{}, time.Unix(1150, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(data1)}, m.applied)
+
+	m, pos, err = replay(mysql.Position{}, time.Unix(1250, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(data1), string(data2)}, m.applied)
+	assert.Equal(t, time.Unix(1250, 0), m.stopTime)
+	assert.True(t, pos.Equal(testPosition(t, "1-10")))
+
+	_, _, err = replay(mysql.Position{}, time.Unix(2000, 0))
+	assert.Contains(t, err.Error(), "before the requested time")
+}
+
+func TestReplayBinlogsWithExternalDecompressor(t *testing.T) {
+	setupFileBackupStorage(t)
+	defer func(engine, ccmd, ext, dcmd string) {
+		*compressionEngineName = engine
+		*externalCompressorCmd = ccmd
+		*externalCompressorExt = ext
+		*externalDecompressorCmd = dcmd
+	}(*compressionEngineName, *externalCompressorCmd, *externalCompressorExt, *externalDecompressorCmd)
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	dir := t.TempDir()
+	cnf := &Mycnf{BinLogPath: path.Join(dir, "bin"), TmpDir: dir}
+
+	writeTestBinlogFile(t, path.Join(dir, "bin.000001"), 1000, 1100)
+	mysqld := &binlogTestMysqld{queries: map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+			"bin.000001|100", "bin.000002|100",
+		),
+		"SHOW BINLOG EVENTS IN 'bin.000001' LIMIT 2": binlogEventsResult(""),
+		"SHOW BINLOG EVENTS IN 'bin.000002' LIMIT 2": binlogEventsResult(testServerUUID + ":1-5"),
+	}}
+	*compressionEngineName = ExternalCompressor
+	*externalCompressorCmd = "gzip -c"
+	*externalCompressorExt = ".gz"
+	*externalDecompressorCmd = "gzip -d -c"
+	count, err := ArchiveBinlogs(ctx, BinlogArchiveParams{
+		Cnf:         cnf,
+		Mysqld:      mysqld,
+		Logger:      logger,
+		Keyspace:    "ks",
+		Shard:       "0",
+		TabletAlias: "zone1-0000000100",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	data, err := os.ReadFile(path.Join(dir, "bin.000001"))
+	require.NoError(t, err)
+	replay := func() (*binlogTestMysqld, error) {
+		m := &binlogTestMysqld{position: testPosition(t, ""), positions: map[string]mysql.Position{string(data): testPosition(t, "1-5")}}
+		_, err := replayBinlogArchive(ctx, RestoreParams{
+			Cnf:          cnf,
+			Mysqld:       m,
+			Logger:       logger,
+			Keyspace:     "ks",
+			Shard:        "0",
+			RestoreToPos: testPosition(t, "1-5"),
+		}, testPosition(t, ""))
+		return m, err
+	}
+
+	// The decompressor recorded in the MANIFEST is not run.
+	*externalDecompressorCmd = ""
+	_, err = replay()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup was compressed with an external compressor, external_decompressor must be set to restore it")
+
+	*externalDecompressorCmd = "gzip -d -c"
+	m, err := replay()
+	require.NoError(t, err)
+	assert.Equal(t, []string{string(data)}, m.applied)
+}
+
+func TestPruneBinlogArchive(t *testing.T) {
+	setupFileBackupStorage(t)
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+
+	addManifest := func(dir, name string, manifest interface{}) {
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(wc).Encode(manifest))
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	archiveDir := GetBinlogArchiveDir("ks", "0")
+	addManifest(archiveDir, "binlog1", &BinlogArchiveManifest{PreviousGTIDs: testPosition(t, ""), Position: testPosition(t, "1-5")})
+	addManifest(archiveDir, "binlog2", &BinlogArchiveManifest{PreviousGTIDs: testPosition(t, "1-5"), Position: testPosition(t, "1-10")})
+
+	// Nothing is pruned without a backup.
+	count, err := PruneBinlogArchive(ctx, bs, "ks", "0", logger)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	addManifest(GetBackupDir("ks", "0"), "2021-06-01.120000.zone1-0000000101", &BackupManifest{Position: testPosition(t, "1-7")})
+	count, err = PruneBinlogArchive(ctx, bs, "ks", "0", logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	binlogs, err := listArchivedBinlogs(ctx, bs, "ks", "0", logger)
+	require.NoError(t, err)
+	require.Len(t, binlogs, 1)
+	assert.Equal(t, "binlog2", binlogs[0].bh.Name())
+}
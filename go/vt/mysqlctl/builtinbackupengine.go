@@ -19,6 +19,8 @@ package mysqlctl
 import (
 	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -28,9 +30,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -55,6 +54,9 @@ var (
 	// It can later be extended for other calls to mysqld during backup functions.
 	// Exported for testing.
 	BuiltinBackupMysqldTimeout = flag.Duration("builtinbackup_mysqld_timeout", 10*time.Minute, "how long to wait for mysqld to shutdown at the start of the backup")
+
+	builtinBackupIncremental         = flag.Bool("builtinbackup_incremental", false, "if set, the builtin backup engine only uploads the files that changed since the previous builtin backup of the shard, and references that backup for the other files")
+	builtinBackupMaxIncrementalChain = flag.Int("builtinbackup_max_incremental_chain", 6, "maximum number of incremental backups on top of a full backup, a full backup is taken when it is reached")
 )
 
 // BuiltinBackupEngine encapsulates the logic of the builtin engine
@@ -82,6 +84,27 @@ type builtinBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the compressor that was used on the files.
+	// It is empty for backups created before the field existed, which
+	// were all compressed with gzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command that decompresses the files,
+	// if they were compressed with the external compressor.
+	ExternalDecompressor string
+
+	// Encryption describes how the files were encrypted.
+	// It is nil if they were not encrypted.
+	Encryption *BackupEncryption `json:",omitempty"`
+
+	// ParentBackup is the name of the backup this backup is incremental
+	// to, in the same directory. It is empty for full backups.
+	ParentBackup string `json:",omitempty"`
+
+	// IncrementalDepth is the number of backups between this backup
+	// and the full backup at the start of its chain.
+	IncrementalDepth int `json:",omitempty"`
 }
 
 // FileEntry is one file to backup
@@ -98,6 +121,21 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// SourceHash is the SHA-256 of the file on disk. It is used to find
+	// the files that didn't change since the parent of an incremental backup.
+	SourceHash string `json:",omitempty"`
+
+	// Backup and BackupFile are set when the file didn't change since a
+	// previous backup of the chain. The data is then stored in the file
+	// BackupFile of the backup Backup, in the same directory.
+	Backup     string `json:",omitempty"`
+	BackupFile string `json:",omitempty"`
+}
+
+// key identifies the file across the backups of a chain.
+func (fe *FileEntry) key() string {
+	return fe.Base + "/" + fe.Name
 }
 
 func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
@@ -138,7 +176,17 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Compression engine: %v, Encryption key provider: %v", *backupStorageHook, *backupStorageCompress, *compressionEngineName, *backupEncryptionKeyProvider)
+	if *backupStorageCompress {
+		// Fail early if the compressor is misconfigured.
+		if _, _, err := getBackupCompressor(); err != nil {
+			return false, err
+		}
+	}
+	encryption, bc, err := newBackupEncryption(ctx)
+	if err != nil {
+		return false, err
+	}
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -200,7 +248,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 
 	// Backup everything, capture the error.
-	backupErr := be.backupFiles(ctx, params, bh, replicationPosition)
+	backupErr := be.backupFiles(ctx, params, bh, replicationPosition, encryption, bc)
 	usable := backupErr == nil
 
 	// Try to restart mysqld, use background context in case we timed out the original context
@@ -276,7 +324,8 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+// The files are encrypted with bc, if it is not nil.
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position, encryption *BackupEncryption, bc *backupCipher) (finalErr error) {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -286,7 +335,26 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	// Find the backup this one is incremental to, if any.
+	parentName, parent, err := be.findIncrementalParent(ctx, params, bh)
+	if err != nil {
+		return err
+	}
+	parentEntries := make(map[string]*FileEntry)
+	if parent != nil {
+		params.Logger.Infof("taking an incremental backup on top of backup %v", parentName)
+		for i := range parent.FileEntries {
+			fe := parent.FileEntries[i]
+			if fe.Backup == "" {
+				fe.Backup = parentName
+				fe.BackupFile = fmt.Sprintf("%v", i)
+			}
+			parentEntries[fe.key()] = &fe
+		}
+	}
+
 	// Backup with the provided concurrency.
+	fbh := newEncryptedBackupHandle(bh, bc)
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
 	for i := range fes {
@@ -304,7 +372,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
+			bh.RecordError(be.backupFile(ctx, params, fbh, &fes[i], parentEntries[fes[i].key()], name))
 		}(i)
 	}
 
@@ -322,6 +390,12 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		return bh.Error()
 	}
 
+	var compressionEngine, externalDecompressor string
+	if *backupStorageCompress {
+		compressionEngine = *compressionEngineName
+		_, externalDecompressor, _ = getBackupCompressor()
+	}
+
 	// open the MANIFEST
 	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
 	if err != nil {
@@ -344,9 +418,16 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		},
 
 		// Builtin-specific fields
-		FileEntries:   fes,
-		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
+		FileEntries:          fes,
+		TransformHook:        *backupStorageHook,
+		SkipCompress:         !*backupStorageCompress,
+		CompressionEngine:    compressionEngine,
+		ExternalDecompressor: externalDecompressor,
+		Encryption:           encryption,
+	}
+	if parent != nil {
+		bm.ParentBackup = parentName
+		bm.IncrementalDepth = parent.IncrementalDepth + 1
 	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -359,8 +440,61 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	return nil
 }
 
-// backupFile backs up an individual file.
-func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
+// findIncrementalParent returns the backup an incremental backup
+// should be taken on top of, and its name. It returns a nil manifest
+// if a full backup should be taken.
+func (be *BuiltinBackupEngine) findIncrementalParent(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (string, *builtinBackupManifest, error) {
+	if !*builtinBackupIncremental {
+		return "", nil, nil
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return "", nil, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return "", nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	for i := len(bhs) - 1; i >= 0; i-- {
+		if bhs[i].Name() == bh.Name() {
+			continue
+		}
+		var bm builtinBackupManifest
+		if err := getBackupManifestInto(ctx, bhs[i], &bm); err != nil {
+			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v", bhs[i].Name(), bh.Directory(), err)
+			continue
+		}
+		switch {
+		case bm.BackupMethod != "" && bm.BackupMethod != builtinBackupEngineName:
+			params.Logger.Infof("the latest backup %v was taken with the %v engine, taking a full backup", bhs[i].Name(), bm.BackupMethod)
+			return "", nil, nil
+		case bm.IncrementalDepth >= *builtinBackupMaxIncrementalChain:
+			params.Logger.Infof("the latest backup %v is at the maximum incremental chain length of %v, taking a full backup", bhs[i].Name(), *builtinBackupMaxIncrementalChain)
+			return "", nil, nil
+		}
+		return bhs[i].Name(), &bm, nil
+	}
+	params.Logger.Infof("no previous backup, taking a full backup")
+	return "", nil, nil
+}
+
+// hashSourceFile returns the SHA-256 of a file, and rewinds it.
+func hashSourceFile(source *os.File) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, source); err != nil {
+		return "", vterrors.Wrap(err, "cannot hash source file")
+	}
+	if _, err := source.Seek(0, io.SeekStart); err != nil {
+		return "", vterrors.Wrap(err, "cannot rewind source file")
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// backupFile backs up an individual file. If parent is not nil, it is
+// the entry of the same file in the parent of an incremental backup,
+// which is referenced instead if the file didn't change.
+func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, parent *FileEntry, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := fe.open(params.Cnf, true)
 	if err != nil {
@@ -373,6 +507,21 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		return err
 	}
 
+	if parent != nil && parent.SourceHash != "" {
+		sourceHash, err := hashSourceFile(source)
+		if err != nil {
+			return err
+		}
+		if sourceHash == parent.SourceHash {
+			params.Logger.Infof("Skipping unchanged file: %v", fe.Name)
+			fe.SourceHash = sourceHash
+			fe.Hash = parent.Hash
+			fe.Backup = parent.Backup
+			fe.BackupFile = parent.BackupFile
+			return nil
+		}
+	}
+
 	params.Logger.Infof("Backing up file: %v", fe.Name)
 	// Open the destination file for writing, and a buffer.
 	wc, err := bh.AddFile(ctx, name, fi.Size())
@@ -408,27 +557,32 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pargzip.Writer
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip = pargzip.NewWriter(writer)
-		gzip.ChunkSize = *backupCompressBlockSize
-		gzip.Parallel = *backupCompressBlocks
-		gzip.CompressionLevel = pargzip.BestSpeed
-		writer = gzip
+		backupCompressor, _, err := getBackupCompressor()
+		if err != nil {
+			return err
+		}
+		compressor, err = backupCompressor.Compress(ctx, writer, params.Logger)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot create compressor")
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (optional compressor,
 	// optional pipe, tee, output file and hasher).
-	_, err = io.Copy(writer, source)
+	sourceHasher := sha256.New()
+	_, err = io.Copy(writer, io.TeeReader(source, sourceHasher))
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -451,8 +605,9 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		return vterrors.Wrapf(err, "cannot flush destination: %v", name)
 	}
 
-	// Save the hash.
+	// Save the hashes.
 	fe.Hash = hasher.HashString()
+	fe.SourceHash = hex.EncodeToString(sourceHasher.Sum(nil))
 	return nil
 }
 
@@ -487,10 +642,92 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 	return &bm.BackupManifest, nil
 }
 
+// backupFileSource is a backup to read files from during a restore,
+// with the settings to decode them.
+type backupFileSource struct {
+	bh            backupstorage.BackupHandle
+	transformHook string
+	compressor    BackupCompressor
+}
+
+func newBackupFileSource(ctx context.Context, bh backupstorage.BackupHandle, bm *builtinBackupManifest) (*backupFileSource, error) {
+	bc, err := getRestoreCipher(ctx, bm.Encryption)
+	if err != nil {
+		return nil, err
+	}
+	src := &backupFileSource{
+		bh:            newEncryptedBackupHandle(bh, bc),
+		transformHook: bm.TransformHook,
+	}
+	if !bm.SkipCompress {
+		src.compressor, err = getRestoreCompressor(bm.CompressionEngine, bm.ExternalDecompressor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return src, nil
+}
+
+// readBackupChain walks the chain of parents of an incremental backup,
+// and returns the sources of their files by backup name.
+func (be *BuiltinBackupEngine) readBackupChain(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm *builtinBackupManifest) (map[string]*backupFileSource, error) {
+	sources := make(map[string]*backupFileSource)
+	if bm.ParentBackup == "" {
+		return sources, nil
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	byName := make(map[string]backupstorage.BackupHandle, len(bhs))
+	for _, h := range bhs {
+		byName[h.Name()] = h
+	}
+
+	child, name := bh.Name(), bm.ParentBackup
+	for name != "" {
+		if _, ok := sources[name]; ok {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v is its own ancestor", name)
+		}
+		parentBh, ok := byName[name]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v is missing, it is the parent of incremental backup %v", name, child)
+		}
+		var parent builtinBackupManifest
+		if err := getBackupManifestInto(ctx, parentBh, &parent); err != nil {
+			return nil, vterrors.Wrapf(err, "cannot read MANIFEST of backup %v, it is the parent of incremental backup %v", name, child)
+		}
+		if sources[name], err = newBackupFileSource(ctx, parentBh, &parent); err != nil {
+			return nil, err
+		}
+		params.Logger.Infof("Restore: backup %v is incremental to backup %v", child, name)
+		child, name = name, parent.ParentBackup
+	}
+	return sources, nil
+}
+
 // restoreFiles will copy all the files from the BackupStorage to the
-// right place.
+// right place. The files of an incremental backup that didn't change
+// are copied from the backups of its chain.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
 	fes := bm.FileEntries
+	sources, err := be.readBackupChain(ctx, params, bh, &bm)
+	if err != nil {
+		return err
+	}
+	if sources[""], err = newBackupFileSource(ctx, bh, &bm); err != nil {
+		return err
+	}
+	for i := range fes {
+		if _, ok := sources[fes[i].Backup]; !ok {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "file %v is in backup %v, which is not in the chain of backup %v", fes[i].Name, fes[i].Backup, bh.Name())
+		}
+	}
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -509,8 +746,14 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
-			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			src := sources[fes[i].Backup]
+			if fes[i].Backup != "" {
+				name = fes[i].BackupFile
+				params.Logger.Infof("Copying file %v of backup %v: %v", name, fes[i].Backup, fes[i].Name)
+			} else {
+				params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
+			}
+			err := be.restoreFile(ctx, params, src.bh, &fes[i], src.transformHook, src.compressor, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -521,7 +764,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compressor BackupCompressor, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -552,7 +795,7 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
-	// and into the gunziper.
+	// and into the decompressor.
 	reader := io.TeeReader(source, hasher)
 
 	// Create the external read pipe, if any.
@@ -567,22 +810,22 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if compressor != nil {
+		decompressor, err := compressor.Decompress(ctx, reader, params.Logger)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return vterrors.Wrap(err, "can't open decompressor")
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+func testBackupCnf(t *testing.T) *Mycnf {
+	root := t.TempDir()
+	cnf := &Mycnf{
+		InnodbDataHomeDir:     path.Join(root, "innodb"),
+		InnodbLogGroupHomeDir: path.Join(root, "log"),
+		DataDir:               path.Join(root, "datadir"),
+	}
+	for _, dir := range []string{cnf.InnodbDataHomeDir, cnf.InnodbLogGroupHomeDir, path.Join(cnf.DataDir, "vt_db")} {
+		require.NoError(t, os.MkdirAll(dir, 0755))
+	}
+	return cnf
+}
+
+var testBackupFiles = []string{"innodb/ibdata1", "log/ib_logfile0", "datadir/vt_db/db.opt", "datadir/vt_db/t1.ibd"}
+
+func testBackupFile(cnf *Mycnf, file string) string {
+	return path.Join(path.Dir(cnf.InnodbDataHomeDir), file)
+}
+
+func writeBackupFile(t *testing.T, cnf *Mycnf, file, contents string) {
+	require.NoError(t, os.WriteFile(testBackupFile(cnf, file), []byte(contents), 0644))
+}
+
+func takeTestBackup(t *testing.T, bs backupstorage.BackupStorage, cnf *Mycnf, name string) *builtinBackupManifest {
+	ctx := context.Background()
+	bh, err := bs.StartBackup(ctx, "ks/0", name)
+	require.NoError(t, err)
+	be := &BuiltinBackupEngine{}
+	err = be.backupFiles(ctx, BackupParams{
+		Cnf:         cnf,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 2,
+		BackupTime:  time.Now(),
+	}, bh, testPosition(t, "1-10"), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, bh.EndBackup(ctx))
+	return readTestBackupManifest(t, bs, name)
+}
+
+func getTestBackupHandle(t *testing.T, bs backupstorage.BackupStorage, name string) backupstorage.BackupHandle {
+	bhs, err := bs.ListBackups(context.Background(), "ks/0")
+	require.NoError(t, err)
+	for _, bh := range bhs {
+		if bh.Name() == name {
+			return bh
+		}
+	}
+	require.FailNow(t, "backup not found", name)
+	return nil
+}
+
+func readTestBackupManifest(t *testing.T, bs backupstorage.BackupStorage, name string) *builtinBackupManifest {
+	var bm builtinBackupManifest
+	require.NoError(t, getBackupManifestInto(context.Background(), getTestBackupHandle(t, bs, name), &bm))
+	return &bm
+}
+
+// fileEntryBackups returns the backup each file is stored in, by file name.
+func fileEntryBackups(bm *builtinBackupManifest) map[string]string {
+	backups := make(map[string]string)
+	for _, fe := range bm.FileEntries {
+		backups[fe.Name] = fe.Backup
+	}
+	return backups
+}
+
+func TestIncrementalBackups(t *testing.T) {
+	setupFileBackupStorage(t)
+	defer func(incremental bool, maxChain int) {
+		*builtinBackupIncremental = incremental
+		*builtinBackupMaxIncrementalChain = maxChain
+	}(*builtinBackupIncremental, *builtinBackupMaxIncrementalChain)
+	*builtinBackupIncremental = true
+	*builtinBackupMaxIncrementalChain = 2
+	ctx := context.Background()
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	defer bs.Close()
+
+	cnf := testBackupCnf(t)
+	for _, file := range testBackupFiles {
+		writeBackupFile(t, cnf, file, "contents of "+file)
+	}
+
+	// Without a previous backup, a full backup is taken.
+	bm1 := takeTestBackup(t, bs, cnf, "b1")
+	assert.Equal(t, "", bm1.ParentBackup)
+	assert.Equal(t, 0, bm1.IncrementalDepth)
+	for _, fe := range bm1.FileEntries {
+		assert.NotEmpty(t, fe.SourceHash)
+		assert.Empty(t, fe.Backup)
+	}
+
+	writeBackupFile(t, cnf, "datadir/vt_db/t1.ibd", "new contents of t1")
+	bm2 := takeTestBackup(t, bs, cnf, "b2")
+	assert.Equal(t, "b1", bm2.ParentBackup)
+	assert.Equal(t, 1, bm2.IncrementalDepth)
+	assert.Equal(t, map[string]string{"ibdata1": "b1", "ib_logfile0": "b1", "vt_db/db.opt": "b1", "vt_db/t1.ibd": ""}, fileEntryBackups(bm2))
+
+	// The unchanged files reference the backup that stores them,
+	// not the parent.
+	writeBackupFile(t, cnf, "log/ib_logfile0", "new contents of ib_logfile0")
+	bm3 := takeTestBackup(t, bs, cnf, "b3")
+	assert.Equal(t, "b2", bm3.ParentBackup)
+	assert.Equal(t, 2, bm3.IncrementalDepth)
+	assert.Equal(t, map[string]string{"ibdata1": "b1", "ib_logfile0": "", "vt_db/db.opt": "b1", "vt_db/t1.ibd": "b2"}, fileEntryBackups(bm3))
+
+	// The chain is at its maximum length.
+	bm4 := takeTestBackup(t, bs, cnf, "b4")
+	assert.Equal(t, "", bm4.ParentBackup)
+
+	// Restoring walks the chain.
+	restoreCnf := testBackupCnf(t)
+	be := &BuiltinBackupEngine{}
+	restoreParams := RestoreParams{Cnf: restoreCnf, Logger: logutil.NewMemoryLogger(), Concurrency: 2}
+	require.NoError(t, be.restoreFiles(ctx, restoreParams, getTestBackupHandle(t, bs, "b3"), *bm3))
+	for _, file := range testBackupFiles {
+		want, err := os.ReadFile(testBackupFile(cnf, file))
+		require.NoError(t, err)
+		got, err := os.ReadFile(testBackupFile(restoreCnf, file))
+		require.NoError(t, err)
+		assert.Equal(t, string(want), string(got), file)
+	}
+
+	// The parents can't be removed before their children.
+	err = RemoveBackup(ctx, bs, "ks/0", "b1")
+	assert.Equal(t, ErrBackupHasChildren, vterrors.Cause(err))
+	assert.EqualError(t, err, "cannot remove backup b1, remove the backups b2 first: backup is the parent of incremental backups")
+	err = RemoveBackup(ctx, bs, "ks/0", "b2")
+	assert.Equal(t, ErrBackupHasChildren, vterrors.Cause(err))
+	parent, err := GetParentBackup(ctx, getTestBackupHandle(t, bs, "b3"))
+	require.NoError(t, err)
+	assert.Equal(t, "b2", parent)
+
+	// A chain with a missing backup can't be restored.
+	require.NoError(t, bs.RemoveBackup(ctx, "ks/0", "b1"))
+	err = be.restoreFiles(ctx, restoreParams, getTestBackupHandle(t, bs, "b3"), *bm3)
+	assert.EqualError(t, err, "backup b1 is missing, it is the parent of incremental backup b2")
+
+	require.NoError(t, RemoveBackup(ctx, bs, "ks/0", "b3"))
+	require.NoError(t, RemoveBackup(ctx, bs, "ks/0", "b2"))
+}
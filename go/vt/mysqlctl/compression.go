@@ -0,0 +1,345 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4/v4"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// PargzipCompressor writes gzip files with pargzip. It is the default,
+	// and the format of all the backups taken before the compression
+	// engine was configurable.
+	PargzipCompressor = "pargzip"
+	// PgzipCompressor writes gzip files with pgzip.
+	PgzipCompressor = "pgzip"
+	// ZstdCompressor writes Zstandard files.
+	ZstdCompressor = "zstd"
+	// Lz4Compressor writes LZ4 files.
+	Lz4Compressor = "lz4"
+	// ExternalCompressor pipes the files through the commands given by
+	// -external_compressor and -external_decompressor.
+	ExternalCompressor = "external"
+)
+
+var (
+	compressionEngineName = flag.String("compression_engine_name", PargzipCompressor, "compressor engine used to compress the backup files if backup_storage_compress is true: pargzip, pgzip, zstd, lz4 or external. Restores always use the engine that compressed a given backup.")
+	compressionLevel      = flag.Int("compression_level", 1, "compression level passed to the compressor engine. It is ignored by the external compressor.")
+
+	externalCompressorCmd   = flag.String("external_compressor", "", "command with arguments used to compress the backup files when compression_engine_name is external. It reads from stdin and writes to stdout.")
+	externalCompressorExt   = flag.String("external_compressor_extension", "", "file extension of the files written by the external compressor, e.g. '.bz2'")
+	externalDecompressorCmd = flag.String("external_decompressor", "", "command with arguments used to decompress the backup files. It overrides the decompressor recorded in the backup MANIFEST.")
+
+	externalDecompressorUseManifest = flag.Bool("external_decompressor_use_manifest", false, "run the external decompressor recorded in the backup MANIFEST when external_decompressor is not set. Its command must be listed in external_decompressor_commands.")
+	externalDecompressorCommands    []string
+)
+
+// BackupCompressor is the interface to compress and decompress
+// the files of a backup.
+type BackupCompressor interface {
+	// Compress returns a writer that compresses the data written to it
+	// into w. It must be closed to flush the compressed data, closing it
+	// does not close w.
+	Compress(ctx context.Context, w io.Writer, logger logutil.Logger) (io.WriteCloser, error)
+
+	// Decompress returns a reader that decompresses the data read from r.
+	Decompress(ctx context.Context, r io.Reader, logger logutil.Logger) (io.ReadCloser, error)
+
+	// Extension returns the extension of the compressed files, e.g. ".gz"
+	Extension() string
+}
+
+// BackupCompressorMap contains the registered implementations of
+// BackupCompressor. The external compressor is not part of it since
+// it is created from the flags or the backup MANIFEST.
+var BackupCompressorMap = make(map[string]BackupCompressor)
+
+// getBackupCompressor returns the compressor that should be used to take
+// new backups, and the command that can decompress them if it is external.
+//
+// This must only be called after flags have been parsed.
+func getBackupCompressor() (BackupCompressor, string, error) {
+	if *compressionEngineName == ExternalCompressor {
+		if *externalCompressorCmd == "" {
+			return nil, "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "external_compressor must be set to use the %v compression engine", ExternalCompressor)
+		}
+		return &externalCommandCompressor{
+			compressCmd: *externalCompressorCmd,
+			extension:   *externalCompressorExt,
+		}, *externalDecompressorCmd, nil
+	}
+	compressor, ok := BackupCompressorMap[*compressionEngineName]
+	if !ok {
+		return nil, "", vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown compression engine %q", *compressionEngineName)
+	}
+	return compressor, "", nil
+}
+
+// getRestoreCompressor returns the compressor that can decompress a backup,
+// given the compression engine and the external decompressor recorded in
+// its MANIFEST. The -external_decompressor flag takes precedence over both.
+//
+// Anyone who can write to the backup storage can edit the MANIFEST, so its
+// external decompressor is only run if -external_decompressor_use_manifest
+// is set and its command is listed in -external_decompressor_commands.
+func getRestoreCompressor(engine, externalDecompressor string) (BackupCompressor, error) {
+	if *externalDecompressorCmd != "" {
+		return &externalCommandCompressor{decompressCmd: *externalDecompressorCmd}, nil
+	}
+	if externalDecompressor != "" && *externalDecompressorUseManifest {
+		if err := checkExternalDecompressor(externalDecompressor); err != nil {
+			return nil, err
+		}
+		return &externalCommandCompressor{decompressCmd: externalDecompressor}, nil
+	}
+	if engine == "" {
+		// Backups taken before the engine was recorded were all gzip files.
+		engine = PargzipCompressor
+	}
+	if engine == ExternalCompressor {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup was compressed with an external compressor, external_decompressor must be set to restore it")
+	}
+	compressor, ok := BackupCompressorMap[engine]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown compression engine %q", engine)
+	}
+	return compressor, nil
+}
+
+// checkExternalDecompressor returns an error if the command of an external
+// decompressor recorded in a MANIFEST is not in -external_decompressor_commands.
+func checkExternalDecompressor(cmdStr string) error {
+	args := strings.Fields(cmdStr)
+	if len(args) == 0 {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "external command is empty")
+	}
+	for _, allowed := range externalDecompressorCommands {
+		if args[0] == allowed {
+			return nil
+		}
+	}
+	return vterrors.Errorf(vtrpc.Code_PERMISSION_DENIED, "external decompressor %s of the backup MANIFEST is not allowed by external_decompressor_commands", args[0])
+}
+
+// pargzipCompressor writes gzip files using pargzip.
+type pargzipCompressor struct{}
+
+func (pargzipCompressor) Compress(_ context.Context, w io.Writer, _ logutil.Logger) (io.WriteCloser, error) {
+	gzip := pargzip.NewWriter(w)
+	gzip.ChunkSize = *backupCompressBlockSize
+	gzip.Parallel = *backupCompressBlocks
+	gzip.CompressionLevel = *compressionLevel
+	return gzip, nil
+}
+
+func (pargzipCompressor) Decompress(_ context.Context, r io.Reader, _ logutil.Logger) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+func (pargzipCompressor) Extension() string { return ".gz" }
+
+// pgzipCompressor writes gzip files using pgzip.
+type pgzipCompressor struct{}
+
+func (pgzipCompressor) Compress(_ context.Context, w io.Writer, _ logutil.Logger) (io.WriteCloser, error) {
+	gzip, err := pgzip.NewWriterLevel(w, *compressionLevel)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create pgzip compressor")
+	}
+	if err := gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks); err != nil {
+		return nil, vterrors.Wrap(err, "cannot set pgzip concurrency")
+	}
+	return gzip, nil
+}
+
+func (pgzipCompressor) Decompress(_ context.Context, r io.Reader, _ logutil.Logger) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+func (pgzipCompressor) Extension() string { return ".gz" }
+
+// zstdCompressor writes Zstandard files.
+type zstdCompressor struct{}
+
+func (zstdCompressor) Compress(_ context.Context, w io.Writer, _ logutil.Logger) (io.WriteCloser, error) {
+	level := zstd.EncoderLevelFromZstd(*compressionLevel)
+	encoder, err := zstd.NewWriter(w, zstd.WithEncoderLevel(level), zstd.WithEncoderConcurrency(*backupCompressBlocks))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create zstd compressor")
+	}
+	return encoder, nil
+}
+
+func (zstdCompressor) Decompress(_ context.Context, r io.Reader, _ logutil.Logger) (io.ReadCloser, error) {
+	decoder, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create zstd decompressor")
+	}
+	return decoder.IOReadCloser(), nil
+}
+
+func (zstdCompressor) Extension() string { return ".zst" }
+
+// lz4Compressor writes LZ4 files.
+type lz4Compressor struct{}
+
+func (lz4Compressor) Compress(_ context.Context, w io.Writer, _ logutil.Logger) (io.WriteCloser, error) {
+	// Level 1 is the fast mode, higher levels use the high compression mode.
+	level := lz4.Fast
+	switch {
+	case *compressionLevel > 9:
+		level = lz4.Level9
+	case *compressionLevel > 1:
+		level = lz4.CompressionLevel(1 << (8 + *compressionLevel))
+	}
+	writer := lz4.NewWriter(w)
+	if err := writer.Apply(lz4.CompressionLevelOption(level), lz4.ConcurrencyOption(*backupCompressBlocks)); err != nil {
+		return nil, vterrors.Wrap(err, "cannot create lz4 compressor")
+	}
+	return writer, nil
+}
+
+func (lz4Compressor) Decompress(_ context.Context, r io.Reader, _ logutil.Logger) (io.ReadCloser, error) {
+	return ioutil.NopCloser(lz4.NewReader(r)), nil
+}
+
+func (lz4Compressor) Extension() string { return ".lz4" }
+
+// externalCommandCompressor pipes the data through external commands.
+// The commands read the data from their stdin and write the result
+// to their stdout.
+type externalCommandCompressor struct {
+	compressCmd   string
+	decompressCmd string
+	extension     string
+}
+
+func (ec *externalCommandCompressor) Compress(ctx context.Context, w io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	cmd, err := prepareExternalCommand(ctx, ec.compressCmd)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = w
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdin pipe")
+	}
+	stderr := &strings.Builder{}
+	cmd.Stderr = stderr
+	logger.Infof("Compressing using external command: %q", ec.compressCmd)
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start external compressor %q", ec.compressCmd)
+	}
+	return &externalCompressWriter{WriteCloser: stdin, cmd: cmd, stderr: stderr, logger: logger}, nil
+}
+
+func (ec *externalCommandCompressor) Decompress(ctx context.Context, r io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	cmd, err := prepareExternalCommand(ctx, ec.decompressCmd)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdin = r
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdout pipe")
+	}
+	stderr := &strings.Builder{}
+	cmd.Stderr = stderr
+	logger.Infof("Decompressing using external command: %q", ec.decompressCmd)
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start external decompressor %q", ec.decompressCmd)
+	}
+	return &externalDecompressReader{ReadCloser: stdout, cmd: cmd, stderr: stderr, logger: logger}, nil
+}
+
+func (ec *externalCommandCompressor) Extension() string { return ec.extension }
+
+func prepareExternalCommand(ctx context.Context, cmdStr string) (*exec.Cmd, error) {
+	args := strings.Fields(cmdStr)
+	if len(args) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "external command is empty")
+	}
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot find external command %q", args[0])
+	}
+	return exec.CommandContext(ctx, path, args[1:]...), nil
+}
+
+// waitExternalCommand waits for the command to exit, and logs its stderr.
+func waitExternalCommand(cmd *exec.Cmd, stderr *strings.Builder, logger logutil.Logger) error {
+	err := cmd.Wait()
+	if stderr.Len() > 0 {
+		logger.Infof("%q returned stderr: %v", cmd.String(), stderr.String())
+	}
+	if err != nil {
+		return vterrors.Wrapf(err, "%q failed", cmd.String())
+	}
+	return nil
+}
+
+// externalCompressWriter closes the stdin of the compressor,
+// and waits for it to write the remaining data when closed.
+type externalCompressWriter struct {
+	io.WriteCloser
+	cmd    *exec.Cmd
+	stderr *strings.Builder
+	logger logutil.Logger
+}
+
+func (w *externalCompressWriter) Close() error {
+	if err := w.WriteCloser.Close(); err != nil {
+		return vterrors.Wrap(err, "cannot close external compressor stdin")
+	}
+	return waitExternalCommand(w.cmd, w.stderr, w.logger)
+}
+
+// externalDecompressReader waits for the decompressor to exit when closed.
+type externalDecompressReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	stderr *strings.Builder
+	logger logutil.Logger
+}
+
+func (r *externalDecompressReader) Close() error {
+	return waitExternalCommand(r.cmd, r.stderr, r.logger)
+}
+
+func init() {
+	flagutil.StringListVar(&externalDecompressorCommands, "external_decompressor_commands", nil, "comma separated list of the commands the external decompressor recorded in a backup MANIFEST may run, if external_decompressor_use_manifest is set")
+
+	BackupCompressorMap[PargzipCompressor] = pargzipCompressor{}
+	BackupCompressorMap[PgzipCompressor] = pgzipCompressor{}
+	BackupCompressorMap[ZstdCompressor] = zstdCompressor{}
+	BackupCompressorMap[Lz4Compressor] = lz4Compressor{}
+}
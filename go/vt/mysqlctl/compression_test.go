@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"math/rand"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+)
+
+func compressionTestData() []byte {
+	// Random words, so the data is compressible but not trivially.
+	words := []string{"vitess", "mysql", "backup", "restore", "shard", "tablet"}
+	r := rand.New(rand.NewSource(1))
+	var buf bytes.Buffer
+	for buf.Len() < 1<<20 {
+		buf.WriteString(words[r.Intn(len(words))])
+		buf.WriteByte(' ')
+	}
+	return buf.Bytes()
+}
+
+func roundTrip(t *testing.T, compressor BackupCompressor, data []byte) []byte {
+	t.Helper()
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+
+	var compressed bytes.Buffer
+	writer, err := compressor.Compress(ctx, &compressed, logger)
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	assert.Less(t, compressed.Len(), len(data))
+	out := append([]byte(nil), compressed.Bytes()...)
+
+	reader, err := compressor.Decompress(ctx, &compressed, logger)
+	require.NoError(t, err)
+	got, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.True(t, bytes.Equal(data, got), "decompressed data does not match")
+	return out
+}
+
+func TestBuiltinCompressors(t *testing.T) {
+	data := compressionTestData()
+	for _, engine := range []string{PargzipCompressor, PgzipCompressor, ZstdCompressor, Lz4Compressor} {
+		t.Run(engine, func(t *testing.T) {
+			compressor, ok := BackupCompressorMap[engine]
+			require.True(t, ok)
+			roundTrip(t, compressor, data)
+		})
+	}
+}
+
+func TestCompressionLevels(t *testing.T) {
+	defer func(level int) { *compressionLevel = level }(*compressionLevel)
+	data := compressionTestData()
+	for _, engine := range []string{PgzipCompressor, ZstdCompressor, Lz4Compressor} {
+		for _, level := range []int{1, 5, 9} {
+			*compressionLevel = level
+			roundTrip(t, BackupCompressorMap[engine], data)
+		}
+	}
+}
+
+func TestGzipCompressorsAreCompatible(t *testing.T) {
+	data := compressionTestData()
+	compressed := roundTrip(t, BackupCompressorMap[PgzipCompressor], data)
+
+	// A file written by pgzip is a regular gzip file that can be
+	// restored with pargzip, which is the default for old backups.
+	compressor, err := getRestoreCompressor("", "")
+	require.NoError(t, err)
+	reader, err := compressor.Decompress(context.Background(), bytes.NewReader(compressed), logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	got, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(data, got))
+
+	// And with the standard library.
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	got, err = io.ReadAll(gz)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(data, got))
+}
+
+func TestExternalCompressor(t *testing.T) {
+	if _, err := exec.LookPath("gzip"); err != nil {
+		t.Skip("gzip is not available")
+	}
+	data := compressionTestData()
+	compressor := &externalCommandCompressor{
+		compressCmd:   "gzip -c -1",
+		decompressCmd: "gzip -d -c",
+		extension:     ".gz",
+	}
+	compressed := roundTrip(t, compressor, data)
+
+	// The external compressor writes regular gzip files.
+	reader, err := BackupCompressorMap[PgzipCompressor].Decompress(context.Background(), bytes.NewReader(compressed), logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	got, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(data, got))
+
+	// A decompressor that fails is reported when closing the reader.
+	compressor = &externalCommandCompressor{decompressCmd: "gzip -d -c"}
+	reader, err = compressor.Decompress(context.Background(), bytes.NewReader([]byte("not gzip")), logutil.NewMemoryLogger())
+	require.NoError(t, err)
+	_, _ = io.ReadAll(reader)
+	require.Error(t, reader.Close())
+}
+
+func TestGetBackupCompressor(t *testing.T) {
+	defer func(engine, cmd, ext, dcmd string) {
+		*compressionEngineName = engine
+		*externalCompressorCmd = cmd
+		*externalCompressorExt = ext
+		*externalDecompressorCmd = dcmd
+	}(*compressionEngineName, *externalCompressorCmd, *externalCompressorExt, *externalDecompressorCmd)
+
+	*compressionEngineName = ZstdCompressor
+	compressor, externalDecompressor, err := getBackupCompressor()
+	require.NoError(t, err)
+	assert.Equal(t, ".zst", compressor.Extension())
+	assert.Equal(t, "", externalDecompressor)
+
+	*compressionEngineName = "unknown"
+	_, _, err = getBackupCompressor()
+	assert.EqualError(t, err, `unknown compression engine "unknown"`)
+
+	*compressionEngineName = ExternalCompressor
+	_, _, err = getBackupCompressor()
+	assert.EqualError(t, err, "external_compressor must be set to use the external compression engine")
+
+	*externalCompressorCmd = "bzip2 -c"
+	*externalCompressorExt = ".bz2"
+	*externalDecompressorCmd = "bzip2 -d -c"
+	compressor, externalDecompressor, err = getBackupCompressor()
+	require.NoError(t, err)
+	assert.Equal(t, ".bz2", compressor.Extension())
+	assert.Equal(t, "bzip2 -d -c", externalDecompressor)
+}
+
+func TestGetRestoreCompressor(t *testing.T) {
+	defer func(dcmd string, useManifest bool, commands []string) {
+		*externalDecompressorCmd = dcmd
+		*externalDecompressorUseManifest = useManifest
+		externalDecompressorCommands = commands
+	}(*externalDecompressorCmd, *externalDecompressorUseManifest, externalDecompressorCommands)
+	*externalDecompressorCmd = ""
+	*externalDecompressorUseManifest = false
+	externalDecompressorCommands = nil
+
+	compressor, err := getRestoreCompressor("", "")
+	require.NoError(t, err)
+	assert.Equal(t, BackupCompressorMap[PargzipCompressor], compressor)
+
+	compressor, err = getRestoreCompressor(Lz4Compressor, "")
+	require.NoError(t, err)
+	assert.Equal(t, BackupCompressorMap[Lz4Compressor], compressor)
+
+	_, err = getRestoreCompressor("unknown", "")
+	assert.EqualError(t, err, `unknown compression engine "unknown"`)
+
+	_, err = getRestoreCompressor(ExternalCompressor, "")
+	assert.EqualError(t, err, "backup was compressed with an external compressor, external_decompressor must be set to restore it")
+
+	// The decompressor of the MANIFEST is not run unless it is allowed.
+	_, err = getRestoreCompressor(ExternalCompressor, "bzip2 -d -c")
+	assert.EqualError(t, err, "backup was compressed with an external compressor, external_decompressor must be set to restore it")
+
+	*externalDecompressorUseManifest = true
+	_, err = getRestoreCompressor(ExternalCompressor, "bzip2 -d -c")
+	assert.EqualError(t, err, "external decompressor bzip2 of the backup MANIFEST is not allowed by external_decompressor_commands")
+
+	externalDecompressorCommands = []string{"bzip2"}
+	compressor, err = getRestoreCompressor(ExternalCompressor, "bzip2 -d -c")
+	require.NoError(t, err)
+	assert.Equal(t, &externalCommandCompressor{decompressCmd: "bzip2 -d -c"}, compressor)
+	_, err = getRestoreCompressor(ExternalCompressor, "sh -c 'bzip2 -d -c'")
+	assert.EqualError(t, err, "external decompressor sh of the backup MANIFEST is not allowed by external_decompressor_commands")
+
+	// The flag overrides the MANIFEST.
+	*externalDecompressorCmd = "lbzip2 -d -c"
+	compressor, err = getRestoreCompressor(ZstdCompressor, "")
+	require.NoError(t, err)
+	assert.Equal(t, &externalCommandCompressor{decompressCmd: "lbzip2 -d -c"}, compressor)
+}
+
+func TestXtrabackupBackupFileName(t *testing.T) {
+	defer func(mode string) { *xtrabackupStreamMode = mode }(*xtrabackupStreamMode)
+	be := &XtrabackupEngine{}
+
+	*xtrabackupStreamMode = "xbstream"
+	assert.Equal(t, "backup.xbstream", be.backupFileName(nil))
+	assert.Equal(t, "backup.xbstream.gz", be.backupFileName(BackupCompressorMap[PargzipCompressor]))
+	assert.Equal(t, "backup.xbstream.zst", be.backupFileName(BackupCompressorMap[ZstdCompressor]))
+	assert.Equal(t, "backup.xbstream.lz4", be.backupFileName(BackupCompressorMap[Lz4Compressor]))
+
+	*xtrabackupStreamMode = ""
+	assert.Equal(t, "backup.gz", be.backupFileName(BackupCompressorMap[PgzipCompressor]))
+}
@@ -0,0 +1,470 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// LocalKeyProvider wraps the data keys with a key read from
+	// -backup_encryption_key_file.
+	LocalKeyProvider = "local"
+
+	// backupCipherAES256GCM is the only supported cipher. The files are
+	// split in segments that are sealed separately, so they can be
+	// encrypted and decrypted as a stream.
+	backupCipherAES256GCM = "aes-256-gcm"
+
+	// defaultEncryptionSegmentSize is the size of the plaintext segments.
+	defaultEncryptionSegmentSize = 64 * 1024
+
+	// dataKeySize is the size of the AES-256 keys.
+	dataKeySize = 32
+)
+
+var (
+	backupEncryptionKeyProvider = flag.String("backup_encryption_key_provider", "", "if set, the backup files are encrypted with a data key wrapped by this key provider. The local provider is built in, others can be registered in BackupKeyProviderMap. Restores always use the key provider recorded in the backup MANIFEST.")
+	backupEncryptionKeyFile     = flag.String("backup_encryption_key_file", "", "file containing the base64-encoded 256-bit key used by the local backup encryption key provider")
+)
+
+// BackupKeyProvider wraps and unwraps the data keys of the backups.
+// Each backup is encrypted with its own random data key, and only the
+// wrapped data key is stored in the MANIFEST.
+type BackupKeyProvider interface {
+	// WrapKey encrypts a data key. It returns the wrapped key, and the
+	// ID of the key that was used to wrap it.
+	WrapKey(ctx context.Context, dataKey []byte) (wrappedKey []byte, keyID string, err error)
+
+	// UnwrapKey decrypts a data key returned by WrapKey.
+	UnwrapKey(ctx context.Context, wrappedKey []byte, keyID string) ([]byte, error)
+}
+
+// BackupKeyProviderMap contains the registered implementations of
+// BackupKeyProvider.
+var BackupKeyProviderMap = make(map[string]BackupKeyProvider)
+
+// BackupEncryption is stored in the MANIFEST of encrypted backups.
+type BackupEncryption struct {
+	// Cipher is the cipher used to encrypt the files.
+	Cipher string
+
+	// SegmentSize is the size of the plaintext segments of the files.
+	SegmentSize int
+
+	// KeyProvider is the name of the BackupKeyProvider that wrapped
+	// the data key.
+	KeyProvider string
+
+	// KeyID identifies the key that wrapped the data key.
+	KeyID string
+
+	// WrappedKey is the data key of the backup, wrapped by the key provider.
+	WrappedKey []byte
+}
+
+// backupCipher encrypts and decrypts the files of a backup.
+type backupCipher struct {
+	aead        cipher.AEAD
+	segmentSize int
+}
+
+// newBackupEncryption creates a data key for a new backup, and wraps it
+// with the configured key provider. It returns nil if encryption is
+// not enabled.
+//
+// This must only be called after flags have been parsed.
+func newBackupEncryption(ctx context.Context) (*BackupEncryption, *backupCipher, error) {
+	if *backupEncryptionKeyProvider == "" {
+		return nil, nil, nil
+	}
+	provider, ok := BackupKeyProviderMap[*backupEncryptionKeyProvider]
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown backup encryption key provider %q", *backupEncryptionKeyProvider)
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, nil, vterrors.Wrap(err, "cannot generate data key")
+	}
+	wrappedKey, keyID, err := provider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, nil, vterrors.Wrapf(err, "cannot wrap data key with key provider %q", *backupEncryptionKeyProvider)
+	}
+	be := &BackupEncryption{
+		Cipher:      backupCipherAES256GCM,
+		SegmentSize: defaultEncryptionSegmentSize,
+		KeyProvider: *backupEncryptionKeyProvider,
+		KeyID:       keyID,
+		WrappedKey:  wrappedKey,
+	}
+	bc, err := newBackupCipher(dataKey, be.SegmentSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	return be, bc, nil
+}
+
+// getRestoreCipher unwraps the data key recorded in the MANIFEST of a
+// backup. It returns nil if the backup is not encrypted.
+func getRestoreCipher(ctx context.Context, be *BackupEncryption) (*backupCipher, error) {
+	if be == nil {
+		return nil, nil
+	}
+	if be.Cipher != backupCipherAES256GCM {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "unsupported backup cipher %q", be.Cipher)
+	}
+	provider, ok := BackupKeyProviderMap[be.KeyProvider]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "unknown backup encryption key provider %q", be.KeyProvider)
+	}
+	dataKey, err := provider.UnwrapKey(ctx, be.WrappedKey, be.KeyID)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot unwrap data key with key provider %q", be.KeyProvider)
+	}
+	return newBackupCipher(dataKey, be.SegmentSize)
+}
+
+func newBackupCipher(key []byte, segmentSize int) (*backupCipher, error) {
+	if segmentSize <= 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid encryption segment size %v", segmentSize)
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &backupCipher{aead: aead, segmentSize: segmentSize}, nil
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create AES cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create GCM cipher")
+	}
+	return aead, nil
+}
+
+// Each file starts with a random nonce. Segment i is sealed with that
+// nonce XOR'ed with i, and with a flag as additional data that is set
+// for the last segment only, so a truncated file can't be decrypted.
+var (
+	segmentAAD      = []byte{0}
+	finalSegmentAAD = []byte{1}
+)
+
+func segmentNonce(dst, base []byte, counter uint64) []byte {
+	dst = append(dst[:0], base...)
+	tail := dst[len(dst)-8:]
+	binary.BigEndian.PutUint64(tail, binary.BigEndian.Uint64(tail)^counter)
+	return dst
+}
+
+// Encrypt returns a writer that encrypts the data written to it into w.
+// It must be closed to write the last segment, closing it does not close w.
+func (bc *backupCipher) Encrypt(w io.Writer) (io.WriteCloser, error) {
+	base := make([]byte, bc.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, base); err != nil {
+		return nil, vterrors.Wrap(err, "cannot generate nonce")
+	}
+	if _, err := w.Write(base); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{
+		bc:    bc,
+		w:     w,
+		base:  base,
+		nonce: make([]byte, 0, len(base)),
+		buf:   make([]byte, 0, bc.segmentSize),
+		out:   make([]byte, 0, bc.segmentSize+bc.aead.Overhead()),
+	}, nil
+}
+
+// Decrypt returns a reader that decrypts the data read from r.
+// It returns an error if the data was modified or truncated.
+func (bc *backupCipher) Decrypt(r io.Reader) io.Reader {
+	return &decryptReader{
+		bc: bc,
+		r:  bufio.NewReaderSize(r, bc.segmentSize+bc.aead.Overhead()),
+		in: make([]byte, bc.segmentSize+bc.aead.Overhead()),
+	}
+}
+
+type encryptWriter struct {
+	bc      *backupCipher
+	w       io.Writer
+	base    []byte
+	nonce   []byte
+	counter uint64
+	buf     []byte
+	out     []byte
+}
+
+func (ew *encryptWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// Only write a full segment when more data comes,
+		// as the last one must be sealed by Close.
+		if len(ew.buf) == ew.bc.segmentSize {
+			if err := ew.writeSegment(segmentAAD); err != nil {
+				return written, err
+			}
+		}
+		n := copy(ew.buf[len(ew.buf):ew.bc.segmentSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (ew *encryptWriter) writeSegment(aad []byte) error {
+	ew.nonce = segmentNonce(ew.nonce, ew.base, ew.counter)
+	ew.out = ew.bc.aead.Seal(ew.out[:0], ew.nonce, ew.buf, aad)
+	ew.counter++
+	ew.buf = ew.buf[:0]
+	_, err := ew.w.Write(ew.out)
+	return err
+}
+
+// Close writes the last segment.
+func (ew *encryptWriter) Close() error {
+	return ew.writeSegment(finalSegmentAAD)
+}
+
+type decryptReader struct {
+	bc      *backupCipher
+	r       *bufio.Reader
+	base    []byte
+	nonce   []byte
+	counter uint64
+	in      []byte
+	plain   []byte
+	done    bool
+}
+
+func (dr *decryptReader) Read(p []byte) (int, error) {
+	for len(dr.plain) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.readSegment(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.plain)
+	dr.plain = dr.plain[n:]
+	return n, nil
+}
+
+func (dr *decryptReader) readSegment() error {
+	if dr.base == nil {
+		base := make([]byte, dr.bc.aead.NonceSize())
+		if _, err := io.ReadFull(dr.r, base); err != nil {
+			return truncatedEncryptedFile(err)
+		}
+		dr.base = base
+	}
+	n, err := io.ReadFull(dr.r, dr.in)
+	final := false
+	switch err {
+	case nil:
+		// A full segment is the last one if there is nothing after it.
+		if _, err := dr.r.Peek(1); err == io.EOF {
+			final = true
+		} else if err != nil {
+			return err
+		}
+	case io.EOF, io.ErrUnexpectedEOF:
+		final = true
+	default:
+		return err
+	}
+	if n < dr.bc.aead.Overhead() {
+		return truncatedEncryptedFile(io.ErrUnexpectedEOF)
+	}
+	aad := segmentAAD
+	if final {
+		aad = finalSegmentAAD
+	}
+	dr.nonce = segmentNonce(dr.nonce, dr.base, dr.counter)
+	dr.plain, err = dr.bc.aead.Open(dr.in[:0], dr.nonce, dr.in[:n], aad)
+	if err != nil {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "cannot decrypt segment %v of backup file: the file was modified or truncated", dr.counter)
+	}
+	dr.counter++
+	dr.done = final
+	return nil
+}
+
+func truncatedEncryptedFile(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return vterrors.Wrap(err, "encrypted backup file is truncated")
+}
+
+// encryptedBackupHandle encrypts the files written to a BackupHandle,
+// and decrypts the files read from it. The MANIFEST is not encrypted,
+// since it is needed to get the data key.
+type encryptedBackupHandle struct {
+	backupstorage.BackupHandle
+	bc *backupCipher
+}
+
+// newEncryptedBackupHandle returns bh if bc is nil.
+func newEncryptedBackupHandle(bh backupstorage.BackupHandle, bc *backupCipher) backupstorage.BackupHandle {
+	if bc == nil {
+		return bh
+	}
+	return &encryptedBackupHandle{BackupHandle: bh, bc: bc}
+}
+
+// AddFile is part of the BackupHandle interface.
+func (bh *encryptedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	wc, err := bh.BackupHandle.AddFile(ctx, filename, filesize)
+	if err != nil || filename == backupManifestFileName {
+		return wc, err
+	}
+	ew, err := bh.bc.Encrypt(wc)
+	if err != nil {
+		wc.Close()
+		return nil, err
+	}
+	return &encryptedFileWriter{encryptWriter: ew, file: wc}, nil
+}
+
+// ReadFile is part of the BackupHandle interface.
+func (bh *encryptedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := bh.BackupHandle.ReadFile(ctx, filename)
+	if err != nil || filename == backupManifestFileName {
+		return rc, err
+	}
+	return &encryptedFileReader{Reader: bh.bc.Decrypt(rc), file: rc}, nil
+}
+
+// encryptedFileWriter seals the last segment before closing the file.
+type encryptedFileWriter struct {
+	encryptWriter io.WriteCloser
+	file          io.WriteCloser
+}
+
+func (w *encryptedFileWriter) Write(p []byte) (int, error) {
+	return w.encryptWriter.Write(p)
+}
+
+func (w *encryptedFileWriter) Close() error {
+	if err := w.encryptWriter.Close(); err != nil {
+		w.file.Close()
+		return vterrors.Wrap(err, "cannot write last encrypted segment")
+	}
+	return w.file.Close()
+}
+
+type encryptedFileReader struct {
+	io.Reader
+	file io.Closer
+}
+
+func (r *encryptedFileReader) Close() error {
+	return r.file.Close()
+}
+
+// localKeyProvider wraps the data keys with AES-256-GCM, using the
+// key read from -backup_encryption_key_file. The key ID is derived
+// from the key, so a restore with the wrong key file fails early.
+type localKeyProvider struct{}
+
+func (localKeyProvider) readKey() ([]byte, string, error) {
+	if *backupEncryptionKeyFile == "" {
+		return nil, "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "backup_encryption_key_file must be set to use the %v key provider", LocalKeyProvider)
+	}
+	data, err := os.ReadFile(*backupEncryptionKeyFile)
+	if err != nil {
+		return nil, "", vterrors.Wrap(err, "cannot read backup encryption key file")
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, "", vterrors.Wrapf(err, "cannot decode backup encryption key file %v", *backupEncryptionKeyFile)
+	}
+	if len(key) != dataKeySize {
+		return nil, "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "backup encryption key in %v must be %v bytes long, got %v", *backupEncryptionKeyFile, dataKeySize, len(key))
+	}
+	sum := sha256.Sum256(key)
+	return key, "sha256:" + hex.EncodeToString(sum[:8]), nil
+}
+
+func (p localKeyProvider) WrapKey(_ context.Context, dataKey []byte) ([]byte, string, error) {
+	key, keyID, err := p.readKey()
+	if err != nil {
+		return nil, "", err
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, "", vterrors.Wrap(err, "cannot generate nonce")
+	}
+	return aead.Seal(nonce, nonce, dataKey, nil), keyID, nil
+}
+
+func (p localKeyProvider) UnwrapKey(_ context.Context, wrappedKey []byte, keyID string) ([]byte, error) {
+	key, localKeyID, err := p.readKey()
+	if err != nil {
+		return nil, err
+	}
+	if keyID != localKeyID {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "data key was wrapped with key %v, but %v contains key %v", keyID, *backupEncryptionKeyFile, localKeyID)
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "wrapped data key is too short")
+	}
+	nonce, ciphertext := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot decrypt data key")
+	}
+	return dataKey, nil
+}
+
+func init() {
+	BackupKeyProviderMap[LocalKeyProvider] = localKeyProvider{}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/concurrency"
+)
+
+func testBackupCipher(t *testing.T, segmentSize int) *backupCipher {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	bc, err := newBackupCipher(key, segmentSize)
+	require.NoError(t, err)
+	return bc
+}
+
+func encrypt(t *testing.T, bc *backupCipher, data []byte, writeSize int) []byte {
+	var buf bytes.Buffer
+	w, err := bc.Encrypt(&buf)
+	require.NoError(t, err)
+	for len(data) > 0 {
+		n := writeSize
+		if n > len(data) {
+			n = len(data)
+		}
+		_, err := w.Write(data[:n])
+		require.NoError(t, err)
+		data = data[n:]
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestBackupCipherRoundTrip(t *testing.T) {
+	bc := testBackupCipher(t, 16)
+	for _, size := range []int{0, 1, 15, 16, 17, 32, 100} {
+		for _, writeSize := range []int{1, 7, 16, 1000} {
+			data := make([]byte, size)
+			_, err := rand.Read(data)
+			require.NoError(t, err)
+
+			encrypted := encrypt(t, bc, data, writeSize)
+			segments := (size + 15) / 16
+			if segments == 0 {
+				segments = 1
+			}
+			assert.Equal(t, bc.aead.NonceSize()+size+segments*bc.aead.Overhead(), len(encrypted), "size %v", size)
+
+			got, err := io.ReadAll(bc.Decrypt(bytes.NewReader(encrypted)))
+			require.NoError(t, err, "size %v, write size %v", size, writeSize)
+			assert.True(t, bytes.Equal(data, got), "size %v, write size %v", size, writeSize)
+		}
+	}
+}
+
+func TestBackupCipherTampering(t *testing.T) {
+	bc := testBackupCipher(t, 16)
+	data := bytes.Repeat([]byte("0123456789"), 10)
+	encrypted := encrypt(t, bc, data, len(data))
+	segment := 16 + bc.aead.Overhead()
+	header := bc.aead.NonceSize()
+
+	// Truncated at a segment boundary.
+	_, err := io.ReadAll(bc.Decrypt(bytes.NewReader(encrypted[:header+2*segment])))
+	assert.Contains(t, err.Error(), "cannot decrypt segment 1 of backup file")
+
+	// Truncated in the middle of a segment.
+	_, err = io.ReadAll(bc.Decrypt(bytes.NewReader(encrypted[:header+segment+5])))
+	assert.Contains(t, err.Error(), "encrypted backup file is truncated")
+
+	// Truncated header.
+	_, err = io.ReadAll(bc.Decrypt(bytes.NewReader(encrypted[:5])))
+	assert.Contains(t, err.Error(), "encrypted backup file is truncated")
+
+	// Modified.
+	modified := append([]byte(nil), encrypted...)
+	modified[header+segment+3] ^= 1
+	_, err = io.ReadAll(bc.Decrypt(bytes.NewReader(modified)))
+	assert.Contains(t, err.Error(), "cannot decrypt segment 1 of backup file")
+
+	// Segments swapped.
+	swapped := append([]byte(nil), encrypted[:header]...)
+	swapped = append(swapped, encrypted[header+segment:header+2*segment]...)
+	swapped = append(swapped, encrypted[header:header+segment]...)
+	swapped = append(swapped, encrypted[header+2*segment:]...)
+	_, err = io.ReadAll(bc.Decrypt(bytes.NewReader(swapped)))
+	assert.Contains(t, err.Error(), "cannot decrypt segment 0 of backup file")
+
+	// Wrong key.
+	_, err = io.ReadAll(testBackupCipher(t, 16).Decrypt(bytes.NewReader(encrypted)))
+	assert.Contains(t, err.Error(), "cannot decrypt segment 0 of backup file")
+}
+
+func writeTestKeyFile(t *testing.T, dir, name string) string {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyFile := path.Join(dir, name)
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+	return keyFile
+}
+
+func TestBackupEncryptionLocalKeyProvider(t *testing.T) {
+	defer func(provider, keyFile string) {
+		*backupEncryptionKeyProvider = provider
+		*backupEncryptionKeyFile = keyFile
+	}(*backupEncryptionKeyProvider, *backupEncryptionKeyFile)
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	*backupEncryptionKeyProvider = ""
+	encryption, bc, err := newBackupEncryption(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, encryption)
+	assert.Nil(t, bc)
+	bc, err = getRestoreCipher(ctx, nil)
+	require.NoError(t, err)
+	assert.Nil(t, bc)
+
+	*backupEncryptionKeyProvider = "unknown"
+	_, _, err = newBackupEncryption(ctx)
+	assert.EqualError(t, err, `unknown backup encryption key provider "unknown"`)
+
+	*backupEncryptionKeyProvider = LocalKeyProvider
+	*backupEncryptionKeyFile = ""
+	_, _, err = newBackupEncryption(ctx)
+	assert.EqualError(t, err, `cannot wrap data key with key provider "local": backup_encryption_key_file must be set to use the local key provider`)
+
+	*backupEncryptionKeyFile = writeTestKeyFile(t, dir, "key1")
+	encryption, bc, err = newBackupEncryption(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, backupCipherAES256GCM, encryption.Cipher)
+	assert.Equal(t, LocalKeyProvider, encryption.KeyProvider)
+	assert.Contains(t, encryption.KeyID, "sha256:")
+
+	data := []byte("some backup data")
+	encrypted := encrypt(t, bc, data, len(data))
+
+	restoreCipher, err := getRestoreCipher(ctx, encryption)
+	require.NoError(t, err)
+	got, err := io.ReadAll(restoreCipher.Decrypt(bytes.NewReader(encrypted)))
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+
+	// Each backup has its own data key.
+	other, _, err := newBackupEncryption(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, encryption.KeyID, other.KeyID)
+	assert.NotEqual(t, encryption.WrappedKey, other.WrappedKey)
+
+	// Restoring with another key fails early.
+	*backupEncryptionKeyFile = writeTestKeyFile(t, dir, "key2")
+	_, err = getRestoreCipher(ctx, encryption)
+	assert.Contains(t, err.Error(), "data key was wrapped with key "+encryption.KeyID)
+
+	encryption.Cipher = "rot13"
+	_, err = getRestoreCipher(ctx, encryption)
+	assert.EqualError(t, err, `unsupported backup cipher "rot13"`)
+}
+
+// memoryBackupHandle is a BackupHandle that keeps the files in memory.
+type memoryBackupHandle struct {
+	concurrency.AllErrorRecorder
+	files map[string]*bytes.Buffer
+}
+
+func (bh *memoryBackupHandle) Directory() string                     { return "dir" }
+func (bh *memoryBackupHandle) Name() string                          { return "name" }
+func (bh *memoryBackupHandle) EndBackup(ctx context.Context) error   { return nil }
+func (bh *memoryBackupHandle) AbortBackup(ctx context.Context) error { return nil }
+
+func (bh *memoryBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	buf := &bytes.Buffer{}
+	bh.files[filename] = buf
+	return nopWriteCloser{buf}, nil
+}
+
+func (bh *memoryBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(bh.files[filename].Bytes())), nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func TestEncryptedBackupHandle(t *testing.T) {
+	ctx := context.Background()
+	bc := testBackupCipher(t, defaultEncryptionSegmentSize)
+	raw := &memoryBackupHandle{files: make(map[string]*bytes.Buffer)}
+	assert.Equal(t, raw, newEncryptedBackupHandle(raw, nil))
+	bh := newEncryptedBackupHandle(raw, bc)
+
+	data := bytes.Repeat([]byte("vitess"), defaultEncryptionSegmentSize)
+	for _, name := range []string{"0", backupManifestFileName} {
+		wc, err := bh.AddFile(ctx, name, int64(len(data)))
+		require.NoError(t, err)
+		_, err = wc.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+
+		rc, err := bh.ReadFile(ctx, name)
+		require.NoError(t, err)
+		got, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.True(t, bytes.Equal(data, got))
+	}
+
+	// The MANIFEST is not encrypted, the other files are.
+	assert.True(t, bytes.Equal(data, raw.files[backupManifestFileName].Bytes()))
+	assert.False(t, bytes.Contains(raw.files["0"].Bytes(), []byte("vitessvitess")))
+}
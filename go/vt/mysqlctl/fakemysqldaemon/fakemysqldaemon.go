@@ -165,6 +165,9 @@ type FakeMysqlDaemon struct {
 	// SemiSyncReplicaEnabled represents the state of rpl_semi_sync_slave_enabled.
 	SemiSyncReplicaEnabled bool
 
+	// ApplyBinlogFileFunc is called by ApplyBinlogFile, if set.
+	ApplyBinlogFileFunc func(binlogFile string, restorePos, stopPos mysql.Position, stopTime time.Time) error
+
 	// TimeoutHook is a func that can be called at the beginning of any method to fake a timeout.
 	// all a test needs to do is make it { return context.DeadlineExceeded }
 	TimeoutHook func() error
@@ -566,3 +569,11 @@ func (fmd *FakeMysqlDaemon) SemiSyncReplicationStatus() (bool, error) {
 	// The fake assumes the status worked.
 	return fmd.SemiSyncReplicaEnabled, nil
 }
+
+// ApplyBinlogFile is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos, stopPos mysql.Position, stopTime time.Time) error {
+	if fmd.ApplyBinlogFileFunc == nil {
+		return nil
+	}
+	return fmd.ApplyBinlogFileFunc(binlogFile, restorePos, stopPos, stopTime)
+}
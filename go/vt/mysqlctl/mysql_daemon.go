@@ -18,6 +18,7 @@ package mysqlctl
 
 import (
 	"context"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -52,6 +53,11 @@ type MysqlDaemon interface {
 	SemiSyncEnabled() (source, replica bool)
 	SemiSyncReplicationStatus() (bool, error)
 
+	// ApplyBinlogFile replays the transactions of a binary log file
+	// that are not in restorePos. It stops at stopPos or stopTime,
+	// if they are set.
+	ApplyBinlogFile(ctx context.Context, binlogFile string, restorePos, stopPos mysql.Position, stopTime time.Time) error
+
 	// reparenting related methods
 	ResetReplication(ctx context.Context) error
 	PrimaryPosition() (mysql.Position, error)
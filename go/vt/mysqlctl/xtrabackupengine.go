@@ -31,9 +31,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -99,16 +96,31 @@ type xtraBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the compressor that was used on the files.
+	// It is empty for backups created before the field existed, which
+	// were all compressed with gzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command that decompresses the files,
+	// if they were compressed with the external compressor.
+	ExternalDecompressor string
+
+	// Encryption describes how the files were encrypted.
+	// It is nil if they were not encrypted.
+	Encryption *BackupEncryption `json:",omitempty"`
 }
 
-func (be *XtrabackupEngine) backupFileName() string {
+// backupFileName returns the name of the backup file. The compressor
+// is nil if the file is not compressed.
+func (be *XtrabackupEngine) backupFileName(compressor BackupCompressor) string {
 	fileName := "backup"
 	if *xtrabackupStreamMode != "" {
 		fileName += "."
 		fileName += *xtrabackupStreamMode
 	}
-	if *backupStorageCompress {
-		fileName += ".gz"
+	if compressor != nil {
+		fileName += compressor.Extension()
 	}
 	return fileName
 }
@@ -130,6 +142,20 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	if *xtrabackupUser == "" {
 		return false, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "xtrabackupUser must be specified.")
 	}
+	var compressor BackupCompressor
+	var compressionEngine, externalDecompressor string
+	if *backupStorageCompress {
+		var err error
+		compressor, externalDecompressor, err = getBackupCompressor()
+		if err != nil {
+			return false, err
+		}
+		compressionEngine = *compressionEngineName
+	}
+	encryption, bc, err := newBackupEncryption(ctx)
+	if err != nil {
+		return false, err
+	}
 	// use a mysql connection to detect flavor at runtime
 	conn, err := params.Mysqld.GetDbaConnection(ctx)
 	if conn != nil && err == nil {
@@ -146,7 +172,7 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	flavor := pos.GTIDSet.Flavor()
 	params.Logger.Infof("Detected MySQL flavor: %v", flavor)
 
-	backupFileName := be.backupFileName()
+	backupFileName := be.backupFileName(compressor)
 	numStripes := int(*xtrabackupStripes)
 
 	// Perform backups in a separate function, so deferred calls to Close() are
@@ -155,7 +181,7 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	// maintaining the contract that a MANIFEST file should only exist if the
 	// backup was created successfully.
 	params.Logger.Infof("Starting backup with %v stripe(s)", numStripes)
-	replicationPosition, err := be.backupFiles(ctx, params, bh, backupFileName, numStripes, flavor)
+	replicationPosition, err := be.backupFiles(ctx, params, newEncryptedBackupHandle(bh, bc), backupFileName, numStripes, flavor, compressor)
 	if err != nil {
 		return false, err
 	}
@@ -179,12 +205,15 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 		},
 
 		// XtraBackup-specific fields
-		FileName:        backupFileName,
-		StreamMode:      *xtrabackupStreamMode,
-		SkipCompress:    !*backupStorageCompress,
-		Params:          *xtrabackupBackupFlags,
-		NumStripes:      int32(numStripes),
-		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
+		FileName:             backupFileName,
+		StreamMode:           *xtrabackupStreamMode,
+		SkipCompress:         !*backupStorageCompress,
+		CompressionEngine:    compressionEngine,
+		ExternalDecompressor: externalDecompressor,
+		Encryption:           encryption,
+		Params:               *xtrabackupBackupFlags,
+		NumStripes:           int32(numStripes),
+		StripeBlockSize:      int32(*xtrabackupStripeBlockSize),
 	}
 
 	data, err := json.MarshalIndent(bm, "", "  ")
@@ -199,7 +228,7 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	return true, nil
 }
 
-func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string) (replicationPosition mysql.Position, finalErr error) {
+func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, backupFileName string, numStripes int, flavor string, compressor BackupCompressor) (replicationPosition mysql.Position, finalErr error) {
 
 	backupProgram := path.Join(*xtrabackupEnginePath, xtrabackupBinaryName)
 	flagsToExec := []string{"--defaults-file=" + params.Cnf.path,
@@ -277,14 +306,14 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		destBuffers = append(destBuffers, buffer)
 		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
-		if *backupStorageCompress {
-			compressor := pargzip.NewWriter(writer)
-			compressor.ChunkSize = *backupCompressBlockSize
-			compressor.Parallel = *backupCompressBlocks
-			compressor.CompressionLevel = pargzip.BestSpeed
-			writer = compressor
-			destCompressors = append(destCompressors, compressor)
+		// Create the compression pipe, if necessary.
+		if compressor != nil {
+			compressWriter, err := compressor.Compress(ctx, writer, params.Logger)
+			if err != nil {
+				return replicationPosition, vterrors.Wrap(err, "cannot create compressor")
+			}
+			writer = compressWriter
+			destCompressors = append(destCompressors, compressWriter)
 		}
 
 		destWriters = append(destWriters, writer)
@@ -326,7 +355,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		}
 	}()
 
-	// Copy from the stream output to destination file (optional gzip)
+	// Copy from the stream output to destination file (optional compressor)
 	blockSize := int64(*xtrabackupStripeBlockSize)
 	if blockSize < 1024 {
 		// Enforce minimum block size.
@@ -343,7 +372,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -500,15 +529,27 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	// Pull details from the MANIFEST where available, so we can still restore
 	// backups taken with different flags. Some fields were not always present,
 	// so if necessary we default to the flag values.
-	compressed := !bm.SkipCompress
+	var compressor BackupCompressor
+	if !bm.SkipCompress {
+		var err error
+		compressor, err = getRestoreCompressor(bm.CompressionEngine, bm.ExternalDecompressor)
+		if err != nil {
+			return err
+		}
+	}
 	streamMode := bm.StreamMode
 	if streamMode == "" {
 		streamMode = *xtrabackupStreamMode
 	}
 	baseFileName := bm.FileName
 	if baseFileName == "" {
-		baseFileName = be.backupFileName()
+		baseFileName = be.backupFileName(compressor)
+	}
+	bc, err := getRestoreCipher(ctx, bm.Encryption)
+	if err != nil {
+		return err
 	}
+	bh = newEncryptedBackupHandle(bh, bc)
 
 	// Open the source files for reading.
 	srcFiles, err := readStripeFiles(ctx, bh, baseFileName, int(bm.NumStripes), logger)
@@ -527,10 +568,10 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 		reader := io.Reader(file)
 
 		// Create the decompressor if needed.
-		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
+		if compressor != nil {
+			decompressor, err := compressor.Decompress(ctx, reader, logger)
 			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
+				return vterrors.Wrap(err, "can't create decompressor")
 			}
 			srcDecompressors = append(srcDecompressors, decompressor)
 			reader = decompressor
@@ -541,7 +582,7 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close decompressor: %v", cerr)
 			}
 		}
 	}()
@@ -736,8 +777,8 @@ func copyToStripes(writers []io.Writer, reader io.Reader, blockSize int64) (writ
 	}
 
 	// Read blocks from source and round-robin them to destination writers.
-	// Since we put a buffer in front of the destination file, and pargzip has its
-	// own buffer as well, we are writing into a buffer either way (whether a
+	// Since we put a buffer in front of the destination file, and the compressors
+	// have their own buffer as well, we are writing into a buffer either way (whether a
 	// compressor is in the chain or not). That means these writes should not
 	// block often, so we shouldn't need separate goroutines here.
 	destIndex := 0
@@ -777,8 +818,8 @@ func stripeReader(readers []io.Reader, blockSize int64) io.Reader {
 
 	go func() {
 		// Read blocks from each source in round-robin and send them to the pipe.
-		// When using pgzip, there is already a read-ahead goroutine for every
-		// source, so we don't need to launch one for each source.
+		// When using pgzip or zstd, there is already a read-ahead goroutine for
+		// every source, so we don't need to launch one for each source.
 		// TODO: See if we need to add read-ahead goroutines for the case when
 		//   compression is not enabled in order to get any benefit to restore
 		//   parallelism from data striping.
@@ -1006,6 +1006,9 @@ type BinlogSource struct {
 	// ExternalCluster is the name of the mounted cluster which has the source keyspace/db for this workflow
 	// it is of the type <cluster_type.cluster_name>
 	ExternalCluster string `protobuf:"bytes,10,opt,name=external_cluster,json=externalCluster,proto3" json:"external_cluster,omitempty"`
+	// Sink is set if the rows are written to a sink instead of the tables
+	// of the target database.
+	Sink *Sink `protobuf:"bytes,11,opt,name=sink,proto3" json:"sink,omitempty"`
 }
 
 func (x *BinlogSource) Reset() {
@@ -1110,6 +1113,103 @@ func (x *BinlogSource) GetExternalCluster() string {
 	return ""
 }
 
+func (x *BinlogSource) GetSink() *Sink {
+	if x != nil {
+		return x.Sink
+	}
+	return nil
+}
+
+// Sink describes where vreplication writes the row changes of a stream
+// that doesn't target the local database. The positions of the stream
+// are still saved in _vt.vreplication.
+type Sink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the name of the sink implementation, like jsonl, avro or exec.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// directory is the directory the jsonl and avro sinks write files to.
+	Directory string `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	// max_file_size is the size in bytes after which the jsonl and avro
+	// sinks rotate their file. The default is 64MB.
+	MaxFileSize int64 `protobuf:"varint,3,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	// max_file_age_seconds is the age after which the jsonl and avro sinks
+	// rotate their file. The default is one hour.
+	MaxFileAgeSeconds int64 `protobuf:"varint,4,opt,name=max_file_age_seconds,json=maxFileAgeSeconds,proto3" json:"max_file_age_seconds,omitempty"`
+	// command is the command the exec sink runs with the row changes
+	// as JSON lines on its standard input.
+	Command []string `protobuf:"bytes,5,rep,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *Sink) Reset() {
+	*x = Sink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_binlogdata_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sink) ProtoMessage() {}
+
+func (x *Sink) ProtoReflect() protoreflect.Message {
+	mi := &file_binlogdata_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sink.ProtoReflect.Descriptor instead.
+func (*Sink) Descriptor() ([]byte, []int) {
+	return file_binlogdata_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Sink) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Sink) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *Sink) GetMaxFileSize() int64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *Sink) GetMaxFileAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxFileAgeSeconds
+	}
+	return 0
+}
+
+func (x *Sink) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
 // RowChange represents one row change.
 // If Before is set and not After, it's a delete.
 // If After is set and not Before, it's an insert.
@@ -1126,7 +1226,7 @@ type RowChange struct {
 func (x *RowChange) Reset() {
 	*x = RowChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[10]
+		mi := &file_binlogdata_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1139,7 +1239,7 @@ func (x *RowChange) String() string {
 func (*RowChange) ProtoMessage() {}
 
 func (x *RowChange) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[10]
+	mi := &file_binlogdata_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +1252,7 @@ func (x *RowChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowChange.ProtoReflect.Descriptor instead.
 func (*RowChange) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{10}
+	return file_binlogdata_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RowChange) GetBefore() *query.Row {
@@ -1182,7 +1282,7 @@ type RowEvent struct {
 func (x *RowEvent) Reset() {
 	*x = RowEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[11]
+		mi := &file_binlogdata_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1195,7 +1295,7 @@ func (x *RowEvent) String() string {
 func (*RowEvent) ProtoMessage() {}
 
 func (x *RowEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[11]
+	mi := &file_binlogdata_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,7 +1308,7 @@ func (x *RowEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowEvent.ProtoReflect.Descriptor instead.
 func (*RowEvent) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{11}
+	return file_binlogdata_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RowEvent) GetTableName() string {
@@ -1238,7 +1338,7 @@ type FieldEvent struct {
 func (x *FieldEvent) Reset() {
 	*x = FieldEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[12]
+		mi := &file_binlogdata_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1251,7 +1351,7 @@ func (x *FieldEvent) String() string {
 func (*FieldEvent) ProtoMessage() {}
 
 func (x *FieldEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[12]
+	mi := &file_binlogdata_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,7 +1364,7 @@ func (x *FieldEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldEvent.ProtoReflect.Descriptor instead.
 func (*FieldEvent) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{12}
+	return file_binlogdata_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FieldEvent) GetTableName() string {
@@ -1300,7 +1400,7 @@ type ShardGtid struct {
 func (x *ShardGtid) Reset() {
 	*x = ShardGtid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[13]
+		mi := &file_binlogdata_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1313,7 +1413,7 @@ func (x *ShardGtid) String() string {
 func (*ShardGtid) ProtoMessage() {}
 
 func (x *ShardGtid) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[13]
+	mi := &file_binlogdata_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1326,7 +1426,7 @@ func (x *ShardGtid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShardGtid.ProtoReflect.Descriptor instead.
 func (*ShardGtid) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{13}
+	return file_binlogdata_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ShardGtid) GetKeyspace() string {
@@ -1369,7 +1469,7 @@ type VGtid struct {
 func (x *VGtid) Reset() {
 	*x = VGtid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[14]
+		mi := &file_binlogdata_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1382,7 +1482,7 @@ func (x *VGtid) String() string {
 func (*VGtid) ProtoMessage() {}
 
 func (x *VGtid) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[14]
+	mi := &file_binlogdata_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,7 +1495,7 @@ func (x *VGtid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VGtid.ProtoReflect.Descriptor instead.
 func (*VGtid) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{14}
+	return file_binlogdata_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VGtid) GetShardGtids() []*ShardGtid {
@@ -1418,7 +1518,7 @@ type KeyspaceShard struct {
 func (x *KeyspaceShard) Reset() {
 	*x = KeyspaceShard{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[15]
+		mi := &file_binlogdata_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1431,7 +1531,7 @@ func (x *KeyspaceShard) String() string {
 func (*KeyspaceShard) ProtoMessage() {}
 
 func (x *KeyspaceShard) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[15]
+	mi := &file_binlogdata_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,7 +1544,7 @@ func (x *KeyspaceShard) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyspaceShard.ProtoReflect.Descriptor instead.
 func (*KeyspaceShard) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{15}
+	return file_binlogdata_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *KeyspaceShard) GetKeyspace() string {
@@ -1496,7 +1596,7 @@ type Journal struct {
 func (x *Journal) Reset() {
 	*x = Journal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[16]
+		mi := &file_binlogdata_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1509,7 +1609,7 @@ func (x *Journal) String() string {
 func (*Journal) ProtoMessage() {}
 
 func (x *Journal) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[16]
+	mi := &file_binlogdata_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1622,7 @@ func (x *Journal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Journal.ProtoReflect.Descriptor instead.
 func (*Journal) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{16}
+	return file_binlogdata_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Journal) GetId() int64 {
@@ -1616,7 +1716,7 @@ type VEvent struct {
 func (x *VEvent) Reset() {
 	*x = VEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[17]
+		mi := &file_binlogdata_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1629,7 +1729,7 @@ func (x *VEvent) String() string {
 func (*VEvent) ProtoMessage() {}
 
 func (x *VEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[17]
+	mi := &file_binlogdata_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1642,7 +1742,7 @@ func (x *VEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VEvent.ProtoReflect.Descriptor instead.
 func (*VEvent) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{17}
+	return file_binlogdata_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *VEvent) GetType() VEventType {
@@ -1735,7 +1835,7 @@ type MinimalTable struct {
 func (x *MinimalTable) Reset() {
 	*x = MinimalTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[18]
+		mi := &file_binlogdata_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1748,7 +1848,7 @@ func (x *MinimalTable) String() string {
 func (*MinimalTable) ProtoMessage() {}
 
 func (x *MinimalTable) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[18]
+	mi := &file_binlogdata_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1761,7 +1861,7 @@ func (x *MinimalTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MinimalTable.ProtoReflect.Descriptor instead.
 func (*MinimalTable) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{18}
+	return file_binlogdata_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MinimalTable) GetName() string {
@@ -1796,7 +1896,7 @@ type MinimalSchema struct {
 func (x *MinimalSchema) Reset() {
 	*x = MinimalSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[19]
+		mi := &file_binlogdata_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1809,7 +1909,7 @@ func (x *MinimalSchema) String() string {
 func (*MinimalSchema) ProtoMessage() {}
 
 func (x *MinimalSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[19]
+	mi := &file_binlogdata_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,7 +1922,7 @@ func (x *MinimalSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MinimalSchema.ProtoReflect.Descriptor instead.
 func (*MinimalSchema) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{19}
+	return file_binlogdata_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MinimalSchema) GetTables() []*MinimalTable {
@@ -1849,7 +1949,7 @@ type VStreamRequest struct {
 func (x *VStreamRequest) Reset() {
 	*x = VStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[20]
+		mi := &file_binlogdata_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1862,7 +1962,7 @@ func (x *VStreamRequest) String() string {
 func (*VStreamRequest) ProtoMessage() {}
 
 func (x *VStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[20]
+	mi := &file_binlogdata_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,7 +1975,7 @@ func (x *VStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamRequest.ProtoReflect.Descriptor instead.
 func (*VStreamRequest) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{20}
+	return file_binlogdata_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *VStreamRequest) GetEffectiveCallerId() *vtrpc.CallerID {
@@ -1932,7 +2032,7 @@ type VStreamResponse struct {
 func (x *VStreamResponse) Reset() {
 	*x = VStreamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[21]
+		mi := &file_binlogdata_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1945,7 +2045,7 @@ func (x *VStreamResponse) String() string {
 func (*VStreamResponse) ProtoMessage() {}
 
 func (x *VStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[21]
+	mi := &file_binlogdata_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1958,7 +2058,7 @@ func (x *VStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamResponse.ProtoReflect.Descriptor instead.
 func (*VStreamResponse) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{21}
+	return file_binlogdata_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *VStreamResponse) GetEvents() []*VEvent {
@@ -1984,7 +2084,7 @@ type VStreamRowsRequest struct {
 func (x *VStreamRowsRequest) Reset() {
 	*x = VStreamRowsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[22]
+		mi := &file_binlogdata_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1997,7 +2097,7 @@ func (x *VStreamRowsRequest) String() string {
 func (*VStreamRowsRequest) ProtoMessage() {}
 
 func (x *VStreamRowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[22]
+	mi := &file_binlogdata_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,7 +2110,7 @@ func (x *VStreamRowsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamRowsRequest.ProtoReflect.Descriptor instead.
 func (*VStreamRowsRequest) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{22}
+	return file_binlogdata_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *VStreamRowsRequest) GetEffectiveCallerId() *vtrpc.CallerID {
@@ -2064,7 +2164,7 @@ type VStreamRowsResponse struct {
 func (x *VStreamRowsResponse) Reset() {
 	*x = VStreamRowsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[23]
+		mi := &file_binlogdata_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2077,7 +2177,7 @@ func (x *VStreamRowsResponse) String() string {
 func (*VStreamRowsResponse) ProtoMessage() {}
 
 func (x *VStreamRowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[23]
+	mi := &file_binlogdata_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2190,7 @@ func (x *VStreamRowsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamRowsResponse.ProtoReflect.Descriptor instead.
 func (*VStreamRowsResponse) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{23}
+	return file_binlogdata_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *VStreamRowsResponse) GetFields() []*query.Field {
@@ -2140,7 +2240,7 @@ type LastPKEvent struct {
 func (x *LastPKEvent) Reset() {
 	*x = LastPKEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[24]
+		mi := &file_binlogdata_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2153,7 +2253,7 @@ func (x *LastPKEvent) String() string {
 func (*LastPKEvent) ProtoMessage() {}
 
 func (x *LastPKEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[24]
+	mi := &file_binlogdata_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,7 +2266,7 @@ func (x *LastPKEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LastPKEvent.ProtoReflect.Descriptor instead.
 func (*LastPKEvent) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{24}
+	return file_binlogdata_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *LastPKEvent) GetTableLastPK() *TableLastPK {
@@ -2195,7 +2295,7 @@ type TableLastPK struct {
 func (x *TableLastPK) Reset() {
 	*x = TableLastPK{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[25]
+		mi := &file_binlogdata_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2208,7 +2308,7 @@ func (x *TableLastPK) String() string {
 func (*TableLastPK) ProtoMessage() {}
 
 func (x *TableLastPK) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[25]
+	mi := &file_binlogdata_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2221,7 +2321,7 @@ func (x *TableLastPK) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableLastPK.ProtoReflect.Descriptor instead.
 func (*TableLastPK) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{25}
+	return file_binlogdata_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *TableLastPK) GetTableName() string {
@@ -2255,7 +2355,7 @@ type VStreamResultsRequest struct {
 func (x *VStreamResultsRequest) Reset() {
 	*x = VStreamResultsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[26]
+		mi := &file_binlogdata_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2268,7 +2368,7 @@ func (x *VStreamResultsRequest) String() string {
 func (*VStreamResultsRequest) ProtoMessage() {}
 
 func (x *VStreamResultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[26]
+	mi := &file_binlogdata_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,7 +2381,7 @@ func (x *VStreamResultsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamResultsRequest.ProtoReflect.Descriptor instead.
 func (*VStreamResultsRequest) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{26}
+	return file_binlogdata_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *VStreamResultsRequest) GetEffectiveCallerId() *vtrpc.CallerID {
@@ -2327,7 +2427,7 @@ type VStreamResultsResponse struct {
 func (x *VStreamResultsResponse) Reset() {
 	*x = VStreamResultsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[27]
+		mi := &file_binlogdata_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2340,7 +2440,7 @@ func (x *VStreamResultsResponse) String() string {
 func (*VStreamResultsResponse) ProtoMessage() {}
 
 func (x *VStreamResultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[27]
+	mi := &file_binlogdata_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,7 +2453,7 @@ func (x *VStreamResultsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VStreamResultsResponse.ProtoReflect.Descriptor instead.
 func (*VStreamResultsResponse) Descriptor() ([]byte, []int) {
-	return file_binlogdata_proto_rawDescGZIP(), []int{27}
+	return file_binlogdata_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *VStreamResultsResponse) GetFields() []*query.Field {
@@ -2393,7 +2493,7 @@ type BinlogTransaction_Statement struct {
 func (x *BinlogTransaction_Statement) Reset() {
 	*x = BinlogTransaction_Statement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_binlogdata_proto_msgTypes[28]
+		mi := &file_binlogdata_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2406,7 +2506,7 @@ func (x *BinlogTransaction_Statement) String() string {
 func (*BinlogTransaction_Statement) ProtoMessage() {}
 
 func (x *BinlogTransaction_Statement) ProtoReflect() protoreflect.Message {
-	mi := &file_binlogdata_proto_msgTypes[28]
+	mi := &file_binlogdata_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2569,7 +2669,7 @@ var file_binlogdata_proto_rawDesc = []byte{
 	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x52,
 	0x52, 0x5f, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x00, 0x12,
 	0x0f, 0x0a, 0x0b, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x46, 0x46, 0x4f, 0x52, 0x54, 0x10, 0x01,
-	0x22, 0x96, 0x03, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x22, 0xbc, 0x03, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a,
 	0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68,
@@ -2594,7 +2694,20 @@ var file_binlogdata_proto_rawDesc = []byte{
 	0x0d, 0x73, 0x74, 0x6f, 0x70, 0x41, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x29,
 	0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x09, 0x52, 0x6f, 0x77,
+	0x61, 0x6c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x6e,
+	0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x22,
+	0xa7, 0x01, 0x0a, 0x04, 0x53, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x51, 0x0a, 0x09, 0x52, 0x6f, 0x77,
 	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x52,
 	0x6f, 0x77, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x61, 0x66,
@@ -2814,7 +2927,7 @@ func file_binlogdata_proto_rawDescGZIP() []byte {
 }
 
 var file_binlogdata_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_binlogdata_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_binlogdata_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_binlogdata_proto_goTypes = []interface{}{
 	(OnDDLAction)(0),   // 0: binlogdata.OnDDLAction
 	(VEventType)(0),    // 1: binlogdata.VEventType
@@ -2831,99 +2944,101 @@ var file_binlogdata_proto_goTypes = []interface{}{
 	(*Rule)(nil),                              // 12: binlogdata.Rule
 	(*Filter)(nil),                            // 13: binlogdata.Filter
 	(*BinlogSource)(nil),                      // 14: binlogdata.BinlogSource
-	(*RowChange)(nil),                         // 15: binlogdata.RowChange
-	(*RowEvent)(nil),                          // 16: binlogdata.RowEvent
-	(*FieldEvent)(nil),                        // 17: binlogdata.FieldEvent
-	(*ShardGtid)(nil),                         // 18: binlogdata.ShardGtid
-	(*VGtid)(nil),                             // 19: binlogdata.VGtid
-	(*KeyspaceShard)(nil),                     // 20: binlogdata.KeyspaceShard
-	(*Journal)(nil),                           // 21: binlogdata.Journal
-	(*VEvent)(nil),                            // 22: binlogdata.VEvent
-	(*MinimalTable)(nil),                      // 23: binlogdata.MinimalTable
-	(*MinimalSchema)(nil),                     // 24: binlogdata.MinimalSchema
-	(*VStreamRequest)(nil),                    // 25: binlogdata.VStreamRequest
-	(*VStreamResponse)(nil),                   // 26: binlogdata.VStreamResponse
-	(*VStreamRowsRequest)(nil),                // 27: binlogdata.VStreamRowsRequest
-	(*VStreamRowsResponse)(nil),               // 28: binlogdata.VStreamRowsResponse
-	(*LastPKEvent)(nil),                       // 29: binlogdata.LastPKEvent
-	(*TableLastPK)(nil),                       // 30: binlogdata.TableLastPK
-	(*VStreamResultsRequest)(nil),             // 31: binlogdata.VStreamResultsRequest
-	(*VStreamResultsResponse)(nil),            // 32: binlogdata.VStreamResultsResponse
-	(*BinlogTransaction_Statement)(nil),       // 33: binlogdata.BinlogTransaction.Statement
-	nil,                                       // 34: binlogdata.Rule.ConvertEnumToTextEntry
-	nil,                                       // 35: binlogdata.Rule.ConvertCharsetEntry
-	(*query.EventToken)(nil),                  // 36: query.EventToken
-	(*topodata.KeyRange)(nil),                 // 37: topodata.KeyRange
-	(topodata.TabletType)(0),                  // 38: topodata.TabletType
-	(*query.Row)(nil),                         // 39: query.Row
-	(*query.Field)(nil),                       // 40: query.Field
-	(*vtrpc.CallerID)(nil),                    // 41: vtrpc.CallerID
-	(*query.VTGateCallerID)(nil),              // 42: query.VTGateCallerID
-	(*query.Target)(nil),                      // 43: query.Target
-	(*query.QueryResult)(nil),                 // 44: query.QueryResult
+	(*Sink)(nil),                              // 15: binlogdata.Sink
+	(*RowChange)(nil),                         // 16: binlogdata.RowChange
+	(*RowEvent)(nil),                          // 17: binlogdata.RowEvent
+	(*FieldEvent)(nil),                        // 18: binlogdata.FieldEvent
+	(*ShardGtid)(nil),                         // 19: binlogdata.ShardGtid
+	(*VGtid)(nil),                             // 20: binlogdata.VGtid
+	(*KeyspaceShard)(nil),                     // 21: binlogdata.KeyspaceShard
+	(*Journal)(nil),                           // 22: binlogdata.Journal
+	(*VEvent)(nil),                            // 23: binlogdata.VEvent
+	(*MinimalTable)(nil),                      // 24: binlogdata.MinimalTable
+	(*MinimalSchema)(nil),                     // 25: binlogdata.MinimalSchema
+	(*VStreamRequest)(nil),                    // 26: binlogdata.VStreamRequest
+	(*VStreamResponse)(nil),                   // 27: binlogdata.VStreamResponse
+	(*VStreamRowsRequest)(nil),                // 28: binlogdata.VStreamRowsRequest
+	(*VStreamRowsResponse)(nil),               // 29: binlogdata.VStreamRowsResponse
+	(*LastPKEvent)(nil),                       // 30: binlogdata.LastPKEvent
+	(*TableLastPK)(nil),                       // 31: binlogdata.TableLastPK
+	(*VStreamResultsRequest)(nil),             // 32: binlogdata.VStreamResultsRequest
+	(*VStreamResultsResponse)(nil),            // 33: binlogdata.VStreamResultsResponse
+	(*BinlogTransaction_Statement)(nil),       // 34: binlogdata.BinlogTransaction.Statement
+	nil,                                       // 35: binlogdata.Rule.ConvertEnumToTextEntry
+	nil,                                       // 36: binlogdata.Rule.ConvertCharsetEntry
+	(*query.EventToken)(nil),                  // 37: query.EventToken
+	(*topodata.KeyRange)(nil),                 // 38: topodata.KeyRange
+	(topodata.TabletType)(0),                  // 39: topodata.TabletType
+	(*query.Row)(nil),                         // 40: query.Row
+	(*query.Field)(nil),                       // 41: query.Field
+	(*vtrpc.CallerID)(nil),                    // 42: vtrpc.CallerID
+	(*query.VTGateCallerID)(nil),              // 43: query.VTGateCallerID
+	(*query.Target)(nil),                      // 44: query.Target
+	(*query.QueryResult)(nil),                 // 45: query.QueryResult
 }
 var file_binlogdata_proto_depIdxs = []int32{
-	33, // 0: binlogdata.BinlogTransaction.statements:type_name -> binlogdata.BinlogTransaction.Statement
-	36, // 1: binlogdata.BinlogTransaction.event_token:type_name -> query.EventToken
-	37, // 2: binlogdata.StreamKeyRangeRequest.key_range:type_name -> topodata.KeyRange
+	34, // 0: binlogdata.BinlogTransaction.statements:type_name -> binlogdata.BinlogTransaction.Statement
+	37, // 1: binlogdata.BinlogTransaction.event_token:type_name -> query.EventToken
+	38, // 2: binlogdata.StreamKeyRangeRequest.key_range:type_name -> topodata.KeyRange
 	5,  // 3: binlogdata.StreamKeyRangeRequest.charset:type_name -> binlogdata.Charset
 	6,  // 4: binlogdata.StreamKeyRangeResponse.binlog_transaction:type_name -> binlogdata.BinlogTransaction
 	5,  // 5: binlogdata.StreamTablesRequest.charset:type_name -> binlogdata.Charset
 	6,  // 6: binlogdata.StreamTablesResponse.binlog_transaction:type_name -> binlogdata.BinlogTransaction
-	34, // 7: binlogdata.Rule.convert_enum_to_text:type_name -> binlogdata.Rule.ConvertEnumToTextEntry
-	35, // 8: binlogdata.Rule.convert_charset:type_name -> binlogdata.Rule.ConvertCharsetEntry
+	35, // 7: binlogdata.Rule.convert_enum_to_text:type_name -> binlogdata.Rule.ConvertEnumToTextEntry
+	36, // 8: binlogdata.Rule.convert_charset:type_name -> binlogdata.Rule.ConvertCharsetEntry
 	12, // 9: binlogdata.Filter.rules:type_name -> binlogdata.Rule
 	4,  // 10: binlogdata.Filter.fieldEventMode:type_name -> binlogdata.Filter.FieldEventMode
-	38, // 11: binlogdata.BinlogSource.tablet_type:type_name -> topodata.TabletType
-	37, // 12: binlogdata.BinlogSource.key_range:type_name -> topodata.KeyRange
+	39, // 11: binlogdata.BinlogSource.tablet_type:type_name -> topodata.TabletType
+	38, // 12: binlogdata.BinlogSource.key_range:type_name -> topodata.KeyRange
 	13, // 13: binlogdata.BinlogSource.filter:type_name -> binlogdata.Filter
 	0,  // 14: binlogdata.BinlogSource.on_ddl:type_name -> binlogdata.OnDDLAction
-	39, // 15: binlogdata.RowChange.before:type_name -> query.Row
-	39, // 16: binlogdata.RowChange.after:type_name -> query.Row
-	15, // 17: binlogdata.RowEvent.row_changes:type_name -> binlogdata.RowChange
-	40, // 18: binlogdata.FieldEvent.fields:type_name -> query.Field
-	30, // 19: binlogdata.ShardGtid.table_p_ks:type_name -> binlogdata.TableLastPK
-	18, // 20: binlogdata.VGtid.shard_gtids:type_name -> binlogdata.ShardGtid
-	2,  // 21: binlogdata.Journal.migration_type:type_name -> binlogdata.MigrationType
-	18, // 22: binlogdata.Journal.shard_gtids:type_name -> binlogdata.ShardGtid
-	20, // 23: binlogdata.Journal.participants:type_name -> binlogdata.KeyspaceShard
-	1,  // 24: binlogdata.VEvent.type:type_name -> binlogdata.VEventType
-	16, // 25: binlogdata.VEvent.row_event:type_name -> binlogdata.RowEvent
-	17, // 26: binlogdata.VEvent.field_event:type_name -> binlogdata.FieldEvent
-	19, // 27: binlogdata.VEvent.vgtid:type_name -> binlogdata.VGtid
-	21, // 28: binlogdata.VEvent.journal:type_name -> binlogdata.Journal
-	29, // 29: binlogdata.VEvent.last_p_k_event:type_name -> binlogdata.LastPKEvent
-	40, // 30: binlogdata.MinimalTable.fields:type_name -> query.Field
-	23, // 31: binlogdata.MinimalSchema.tables:type_name -> binlogdata.MinimalTable
-	41, // 32: binlogdata.VStreamRequest.effective_caller_id:type_name -> vtrpc.CallerID
-	42, // 33: binlogdata.VStreamRequest.immediate_caller_id:type_name -> query.VTGateCallerID
-	43, // 34: binlogdata.VStreamRequest.target:type_name -> query.Target
-	13, // 35: binlogdata.VStreamRequest.filter:type_name -> binlogdata.Filter
-	30, // 36: binlogdata.VStreamRequest.table_last_p_ks:type_name -> binlogdata.TableLastPK
-	22, // 37: binlogdata.VStreamResponse.events:type_name -> binlogdata.VEvent
-	41, // 38: binlogdata.VStreamRowsRequest.effective_caller_id:type_name -> vtrpc.CallerID
-	42, // 39: binlogdata.VStreamRowsRequest.immediate_caller_id:type_name -> query.VTGateCallerID
-	43, // 40: binlogdata.VStreamRowsRequest.target:type_name -> query.Target
-	44, // 41: binlogdata.VStreamRowsRequest.lastpk:type_name -> query.QueryResult
-	40, // 42: binlogdata.VStreamRowsResponse.fields:type_name -> query.Field
-	40, // 43: binlogdata.VStreamRowsResponse.pkfields:type_name -> query.Field
-	39, // 44: binlogdata.VStreamRowsResponse.rows:type_name -> query.Row
-	39, // 45: binlogdata.VStreamRowsResponse.lastpk:type_name -> query.Row
-	30, // 46: binlogdata.LastPKEvent.table_last_p_k:type_name -> binlogdata.TableLastPK
-	44, // 47: binlogdata.TableLastPK.lastpk:type_name -> query.QueryResult
-	41, // 48: binlogdata.VStreamResultsRequest.effective_caller_id:type_name -> vtrpc.CallerID
-	42, // 49: binlogdata.VStreamResultsRequest.immediate_caller_id:type_name -> query.VTGateCallerID
-	43, // 50: binlogdata.VStreamResultsRequest.target:type_name -> query.Target
-	40, // 51: binlogdata.VStreamResultsResponse.fields:type_name -> query.Field
-	39, // 52: binlogdata.VStreamResultsResponse.rows:type_name -> query.Row
-	3,  // 53: binlogdata.BinlogTransaction.Statement.category:type_name -> binlogdata.BinlogTransaction.Statement.Category
-	5,  // 54: binlogdata.BinlogTransaction.Statement.charset:type_name -> binlogdata.Charset
-	11, // 55: binlogdata.Rule.ConvertCharsetEntry.value:type_name -> binlogdata.CharsetConversion
-	56, // [56:56] is the sub-list for method output_type
-	56, // [56:56] is the sub-list for method input_type
-	56, // [56:56] is the sub-list for extension type_name
-	56, // [56:56] is the sub-list for extension extendee
-	0,  // [0:56] is the sub-list for field type_name
+	15, // 15: binlogdata.BinlogSource.sink:type_name -> binlogdata.Sink
+	40, // 16: binlogdata.RowChange.before:type_name -> query.Row
+	40, // 17: binlogdata.RowChange.after:type_name -> query.Row
+	16, // 18: binlogdata.RowEvent.row_changes:type_name -> binlogdata.RowChange
+	41, // 19: binlogdata.FieldEvent.fields:type_name -> query.Field
+	31, // 20: binlogdata.ShardGtid.table_p_ks:type_name -> binlogdata.TableLastPK
+	19, // 21: binlogdata.VGtid.shard_gtids:type_name -> binlogdata.ShardGtid
+	2,  // 22: binlogdata.Journal.migration_type:type_name -> binlogdata.MigrationType
+	19, // 23: binlogdata.Journal.shard_gtids:type_name -> binlogdata.ShardGtid
+	21, // 24: binlogdata.Journal.participants:type_name -> binlogdata.KeyspaceShard
+	1,  // 25: binlogdata.VEvent.type:type_name -> binlogdata.VEventType
+	17, // 26: binlogdata.VEvent.row_event:type_name -> binlogdata.RowEvent
+	18, // 27: binlogdata.VEvent.field_event:type_name -> binlogdata.FieldEvent
+	20, // 28: binlogdata.VEvent.vgtid:type_name -> binlogdata.VGtid
+	22, // 29: binlogdata.VEvent.journal:type_name -> binlogdata.Journal
+	30, // 30: binlogdata.VEvent.last_p_k_event:type_name -> binlogdata.LastPKEvent
+	41, // 31: binlogdata.MinimalTable.fields:type_name -> query.Field
+	24, // 32: binlogdata.MinimalSchema.tables:type_name -> binlogdata.MinimalTable
+	42, // 33: binlogdata.VStreamRequest.effective_caller_id:type_name -> vtrpc.CallerID
+	43, // 34: binlogdata.VStreamRequest.immediate_caller_id:type_name -> query.VTGateCallerID
+	44, // 35: binlogdata.VStreamRequest.target:type_name -> query.Target
+	13, // 36: binlogdata.VStreamRequest.filter:type_name -> binlogdata.Filter
+	31, // 37: binlogdata.VStreamRequest.table_last_p_ks:type_name -> binlogdata.TableLastPK
+	23, // 38: binlogdata.VStreamResponse.events:type_name -> binlogdata.VEvent
+	42, // 39: binlogdata.VStreamRowsRequest.effective_caller_id:type_name -> vtrpc.CallerID
+	43, // 40: binlogdata.VStreamRowsRequest.immediate_caller_id:type_name -> query.VTGateCallerID
+	44, // 41: binlogdata.VStreamRowsRequest.target:type_name -> query.Target
+	45, // 42: binlogdata.VStreamRowsRequest.lastpk:type_name -> query.QueryResult
+	41, // 43: binlogdata.VStreamRowsResponse.fields:type_name -> query.Field
+	41, // 44: binlogdata.VStreamRowsResponse.pkfields:type_name -> query.Field
+	40, // 45: binlogdata.VStreamRowsResponse.rows:type_name -> query.Row
+	40, // 46: binlogdata.VStreamRowsResponse.lastpk:type_name -> query.Row
+	31, // 47: binlogdata.LastPKEvent.table_last_p_k:type_name -> binlogdata.TableLastPK
+	45, // 48: binlogdata.TableLastPK.lastpk:type_name -> query.QueryResult
+	42, // 49: binlogdata.VStreamResultsRequest.effective_caller_id:type_name -> vtrpc.CallerID
+	43, // 50: binlogdata.VStreamResultsRequest.immediate_caller_id:type_name -> query.VTGateCallerID
+	44, // 51: binlogdata.VStreamResultsRequest.target:type_name -> query.Target
+	41, // 52: binlogdata.VStreamResultsResponse.fields:type_name -> query.Field
+	40, // 53: binlogdata.VStreamResultsResponse.rows:type_name -> query.Row
+	3,  // 54: binlogdata.BinlogTransaction.Statement.category:type_name -> binlogdata.BinlogTransaction.Statement.Category
+	5,  // 55: binlogdata.BinlogTransaction.Statement.charset:type_name -> binlogdata.Charset
+	11, // 56: binlogdata.Rule.ConvertCharsetEntry.value:type_name -> binlogdata.CharsetConversion
+	57, // [57:57] is the sub-list for method output_type
+	57, // [57:57] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_binlogdata_proto_init() }
@@ -3053,7 +3168,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RowChange); i {
+			switch v := v.(*Sink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3065,7 +3180,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RowEvent); i {
+			switch v := v.(*RowChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3077,7 +3192,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldEvent); i {
+			switch v := v.(*RowEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3089,7 +3204,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardGtid); i {
+			switch v := v.(*FieldEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3101,7 +3216,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VGtid); i {
+			switch v := v.(*ShardGtid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3113,7 +3228,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyspaceShard); i {
+			switch v := v.(*VGtid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3125,7 +3240,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Journal); i {
+			switch v := v.(*KeyspaceShard); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3137,7 +3252,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VEvent); i {
+			switch v := v.(*Journal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3149,7 +3264,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinimalTable); i {
+			switch v := v.(*VEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3161,7 +3276,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinimalSchema); i {
+			switch v := v.(*MinimalTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3173,7 +3288,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamRequest); i {
+			switch v := v.(*MinimalSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3185,7 +3300,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamResponse); i {
+			switch v := v.(*VStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3197,7 +3312,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamRowsRequest); i {
+			switch v := v.(*VStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3209,7 +3324,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamRowsResponse); i {
+			switch v := v.(*VStreamRowsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3221,7 +3336,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LastPKEvent); i {
+			switch v := v.(*VStreamRowsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3233,7 +3348,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TableLastPK); i {
+			switch v := v.(*LastPKEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3245,7 +3360,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamResultsRequest); i {
+			switch v := v.(*TableLastPK); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3257,7 +3372,7 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VStreamResultsResponse); i {
+			switch v := v.(*VStreamResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3269,6 +3384,18 @@ func file_binlogdata_proto_init() {
 			}
 		}
 		file_binlogdata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VStreamResultsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_binlogdata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BinlogTransaction_Statement); i {
 			case 0:
 				return &v.state
@@ -3287,7 +3414,7 @@ func file_binlogdata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_binlogdata_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   31,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
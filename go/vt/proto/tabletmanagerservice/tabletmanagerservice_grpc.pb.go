@@ -37,6 +37,10 @@ type TabletManagerClient interface {
 	RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(ctx context.Context, in *tabletmanagerdata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
 	ReloadSchema(ctx context.Context, in *tabletmanagerdata.ReloadSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReloadSchemaResponse, error)
+	// ListQueryQuarantines returns the queries quarantined by the tablet server
+	ListQueryQuarantines(ctx context.Context, in *tabletmanagerdata.ListQueryQuarantinesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ListQueryQuarantinesResponse, error)
+	// ClearQueryQuarantines releases quarantined queries before the end of their cool-off period
+	ClearQueryQuarantines(ctx context.Context, in *tabletmanagerdata.ClearQueryQuarantinesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ClearQueryQuarantinesResponse, error)
 	PreflightSchema(ctx context.Context, in *tabletmanagerdata.PreflightSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PreflightSchemaResponse, error)
 	ApplySchema(ctx context.Context, in *tabletmanagerdata.ApplySchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ApplySchemaResponse, error)
 	LockTables(ctx context.Context, in *tabletmanagerdata.LockTablesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.LockTablesResponse, error)
@@ -215,6 +219,24 @@ func (c *tabletManagerClient) ReloadSchema(ctx context.Context, in *tabletmanage
 	return out, nil
 }
 
+func (c *tabletManagerClient) ListQueryQuarantines(ctx context.Context, in *tabletmanagerdata.ListQueryQuarantinesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ListQueryQuarantinesResponse, error) {
+	out := new(tabletmanagerdata.ListQueryQuarantinesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ListQueryQuarantines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ClearQueryQuarantines(ctx context.Context, in *tabletmanagerdata.ClearQueryQuarantinesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ClearQueryQuarantinesResponse, error) {
+	out := new(tabletmanagerdata.ClearQueryQuarantinesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ClearQueryQuarantines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) PreflightSchema(ctx context.Context, in *tabletmanagerdata.PreflightSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.PreflightSchemaResponse, error) {
 	out := new(tabletmanagerdata.PreflightSchemaResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/PreflightSchema", in, out, opts...)
@@ -580,6 +602,10 @@ type TabletManagerServer interface {
 	RunHealthCheck(context.Context, *tabletmanagerdata.RunHealthCheckRequest) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(context.Context, *tabletmanagerdata.IgnoreHealthErrorRequest) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
 	ReloadSchema(context.Context, *tabletmanagerdata.ReloadSchemaRequest) (*tabletmanagerdata.ReloadSchemaResponse, error)
+	// ListQueryQuarantines returns the queries quarantined by the tablet server
+	ListQueryQuarantines(context.Context, *tabletmanagerdata.ListQueryQuarantinesRequest) (*tabletmanagerdata.ListQueryQuarantinesResponse, error)
+	// ClearQueryQuarantines releases quarantined queries before the end of their cool-off period
+	ClearQueryQuarantines(context.Context, *tabletmanagerdata.ClearQueryQuarantinesRequest) (*tabletmanagerdata.ClearQueryQuarantinesResponse, error)
 	PreflightSchema(context.Context, *tabletmanagerdata.PreflightSchemaRequest) (*tabletmanagerdata.PreflightSchemaResponse, error)
 	ApplySchema(context.Context, *tabletmanagerdata.ApplySchemaRequest) (*tabletmanagerdata.ApplySchemaResponse, error)
 	LockTables(context.Context, *tabletmanagerdata.LockTablesRequest) (*tabletmanagerdata.LockTablesResponse, error)
@@ -683,6 +709,12 @@ func (UnimplementedTabletManagerServer) IgnoreHealthError(context.Context, *tabl
 func (UnimplementedTabletManagerServer) ReloadSchema(context.Context, *tabletmanagerdata.ReloadSchemaRequest) (*tabletmanagerdata.ReloadSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReloadSchema not implemented")
 }
+func (UnimplementedTabletManagerServer) ListQueryQuarantines(context.Context, *tabletmanagerdata.ListQueryQuarantinesRequest) (*tabletmanagerdata.ListQueryQuarantinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueryQuarantines not implemented")
+}
+func (UnimplementedTabletManagerServer) ClearQueryQuarantines(context.Context, *tabletmanagerdata.ClearQueryQuarantinesRequest) (*tabletmanagerdata.ClearQueryQuarantinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearQueryQuarantines not implemented")
+}
 func (UnimplementedTabletManagerServer) PreflightSchema(context.Context, *tabletmanagerdata.PreflightSchemaRequest) (*tabletmanagerdata.PreflightSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PreflightSchema not implemented")
 }
@@ -1011,6 +1043,42 @@ func _TabletManager_ReloadSchema_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_ListQueryQuarantines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ListQueryQuarantinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ListQueryQuarantines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ListQueryQuarantines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ListQueryQuarantines(ctx, req.(*tabletmanagerdata.ListQueryQuarantinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ClearQueryQuarantines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ClearQueryQuarantinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ClearQueryQuarantines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ClearQueryQuarantines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ClearQueryQuarantines(ctx, req.(*tabletmanagerdata.ClearQueryQuarantinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_PreflightSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.PreflightSchemaRequest)
 	if err := dec(in); err != nil {
@@ -1666,6 +1734,14 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReloadSchema",
 			Handler:    _TabletManager_ReloadSchema_Handler,
 		},
+		{
+			MethodName: "ListQueryQuarantines",
+			Handler:    _TabletManager_ListQueryQuarantines_Handler,
+		},
+		{
+			MethodName: "ClearQueryQuarantines",
+			Handler:    _TabletManager_ClearQueryQuarantines_Handler,
+		},
 		{
 			MethodName: "PreflightSchema",
 			Handler:    _TabletManager_PreflightSchema_Handler,
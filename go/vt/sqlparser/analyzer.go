@@ -504,3 +504,61 @@ var lockingFunctions = map[string]interface{}{
 	"release_all_locks": nil,
 	"release_lock":      nil,
 }
+
+// FindNonDeterministicExpr returns the first expression within node that
+// does not always evaluate to the same value for the same row, such as
+// now(), rand() or a reference to a variable. It returns nil if there is none.
+func FindNonDeterministicExpr(node SQLNode) Expr {
+	var found Expr
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *CurTimeFuncExpr:
+			found = node
+		case *FuncExpr:
+			name := node.Name.Lowered()
+			// unix_timestamp is only deterministic when it converts its argument
+			if _, ok := nonDeterministicFunctions[name]; ok || (name == "unix_timestamp" && len(node.Exprs) == 0) || IsLockingFunc(node) {
+				found = node
+			}
+		case *ColName:
+			if node.Name.AtCount() != NoAt {
+				found = node
+			}
+		}
+		return found == nil, nil
+	}, node)
+	return found
+}
+
+var nonDeterministicFunctions = map[string]interface{}{
+	"benchmark":         nil,
+	"connection_id":     nil,
+	"curdate":           nil,
+	"current_date":      nil,
+	"current_role":      nil,
+	"current_time":      nil,
+	"current_timestamp": nil,
+	"current_user":      nil,
+	"curtime":           nil,
+	"database":          nil,
+	"found_rows":        nil,
+	"last_insert_id":    nil,
+	"localtime":         nil,
+	"localtimestamp":    nil,
+	"now":               nil,
+	"rand":              nil,
+	"random_bytes":      nil,
+	"row_count":         nil,
+	"schema":            nil,
+	"session_user":      nil,
+	"sleep":             nil,
+	"sysdate":           nil,
+	"system_user":       nil,
+	"user":              nil,
+	"utc_date":          nil,
+	"utc_time":          nil,
+	"utc_timestamp":     nil,
+	"uuid":              nil,
+	"uuid_short":        nil,
+	"version":           nil,
+}
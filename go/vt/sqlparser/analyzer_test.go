@@ -547,3 +547,47 @@ func TestNewPlanValue(t *testing.T) {
 }
 
 var mustMatch = utils.MustMatchFn(".Conn")
+
+func TestFindNonDeterministicExpr(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in: "a + 1",
+	}, {
+		in: "concat(a, unix_timestamp(b))",
+	}, {
+		in:  "concat(a, now())",
+		out: "now()",
+	}, {
+		in:  "a > current_timestamp(3)",
+		out: "current_timestamp(3)",
+	}, {
+		in:  "if(a, rand(), 1)",
+		out: "rand()",
+	}, {
+		in:  "unix_timestamp()",
+		out: "unix_timestamp()",
+	}, {
+		in:  "a = @x",
+		out: "@x",
+	}, {
+		in:  "a = @@session.sql_mode",
+		out: "@@session.sql_mode",
+	}, {
+		in:  "get_lock('l', 1)",
+		out: "get_lock('l', 1)",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := Parse("select " + tc.in + " from t")
+			require.NoError(t, err)
+			found := FindNonDeterministicExpr(stmt.(*Select).SelectExprs)
+			if tc.out == "" {
+				assert.Nil(t, found)
+				return
+			}
+			assert.Equal(t, tc.out, String(found))
+		})
+	}
+}
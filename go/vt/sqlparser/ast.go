@@ -45,6 +45,7 @@ type (
 		AddOrder(*Order)
 		SetLimit(*Limit)
 		SetLock(lock Lock)
+		SetWith(with *With)
 		MakeDistinct()
 		GetColumnCount() int
 	}
@@ -207,8 +208,10 @@ type (
 		Comments    Comments
 		SelectExprs SelectExprs
 		Where       *Where
+		With        *With
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     WindowDefinitions
 		OrderBy     OrderBy
 		Limit       *Limit
 		Lock        Lock
@@ -244,6 +247,22 @@ type (
 		OrderBy        OrderBy
 		Limit          *Limit
 		Lock           Lock
+		With           *With
+	}
+
+	// With represents the WITH clause of a query, containing the
+	// common table expressions that can be used in the rest of the query.
+	With struct {
+		CTEs      []*CommonTableExpr
+		Recursive bool
+	}
+
+	// CommonTableExpr represents a single common table expression,
+	// e.g. cte(a, b) AS (SELECT ...)
+	CommonTableExpr struct {
+		TableID  TableIdent
+		Columns  Columns
+		Subquery *Subquery
 	}
 
 	// VStream represents a VSTREAM statement.
@@ -1886,11 +1905,24 @@ type (
 	}
 
 	// FuncExpr represents a function call.
+	// Aggregate functions can be used as window functions, in which case
+	// Over holds the window they are computed over.
 	FuncExpr struct {
 		Qualifier TableIdent
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
+	}
+
+	// WindowFuncExpr represents a call to one of the nonaggregate window
+	// functions, e.g. ROW_NUMBER() OVER w or LAG(a, 1) IGNORE NULLS OVER (ORDER BY b).
+	WindowFuncExpr struct {
+		Name          ColIdent
+		Exprs         Exprs
+		FromFirstLast FromFirstLastType
+		NullTreatment NullTreatmentType
+		Over          *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -1999,6 +2031,7 @@ func (*SubstrExpr) iExpr()        {}
 func (*ConvertUsingExpr) iExpr()  {}
 func (*MatchExpr) iExpr()         {}
 func (*GroupConcatExpr) iExpr()   {}
+func (*WindowFuncExpr) iExpr()    {}
 func (*Default) iExpr()           {}
 
 // Exprs represents a list of value expressions.
@@ -2036,6 +2069,58 @@ type Order struct {
 // OrderDirection is an enum for the direction in which to order - asc or desc.
 type OrderDirection int8
 
+// OverClause represents the OVER clause of a window function. It either
+// refers to a named window or holds an inline window specification.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents the window a window function is computed
+// over. Name optionally refers to a named window it is based on.
+type WindowSpecification struct {
+	Name            ColIdent
+	PartitionClause Exprs
+	OrderClause     OrderBy
+	FrameClause     *FrameClause
+}
+
+// WindowDefinitions represents the WINDOW clause of a SELECT.
+type WindowDefinitions []*WindowDefinition
+
+// WindowDefinition represents a named window, e.g. w AS (PARTITION BY a).
+type WindowDefinition struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil when the frame is given by its start point only.
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FramePoint represents one of the boundaries of a window frame.
+// Expr is only set for the ExprPreceding and ExprFollowing types.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FrameUnitType is an enum for the unit of a window frame - rows or range.
+type FrameUnitType int8
+
+// FramePointType is an enum for the type of a window frame boundary.
+type FramePointType int8
+
+// FromFirstLastType is an enum for the FROM FIRST / FROM LAST option of NTH_VALUE.
+type FromFirstLastType int8
+
+// NullTreatmentType is an enum for the RESPECT NULLS / IGNORE NULLS option of window functions.
+type NullTreatmentType int8
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
@@ -87,6 +87,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneComments(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
+	case *CommonTableExpr:
+		return CloneRefOfCommonTableExpr(in)
 	case *ComparisonExpr:
 		return CloneRefOfComparisonExpr(in)
 	case *ConstraintDefinition:
@@ -135,6 +137,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfForce(in)
 	case *ForeignKeyDefinition:
 		return CloneRefOfForeignKeyDefinition(in)
+	case *FrameClause:
+		return CloneRefOfFrameClause(in)
+	case *FramePoint:
+		return CloneRefOfFramePoint(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
 	case GroupBy:
@@ -199,6 +205,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
 		return CloneRefOfOtherRead(in)
+	case *OverClause:
+		return CloneRefOfOverClause(in)
 	case *ParenSelect:
 		return CloneRefOfParenSelect(in)
 	case *ParenTableExpr:
@@ -315,6 +323,16 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfWhen(in)
 	case *Where:
 		return CloneRefOfWhere(in)
+	case *WindowDefinition:
+		return CloneRefOfWindowDefinition(in)
+	case WindowDefinitions:
+		return CloneWindowDefinitions(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
+	case *WindowSpecification:
+		return CloneRefOfWindowSpecification(in)
+	case *With:
+		return CloneRefOfWith(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -591,6 +609,9 @@ func CloneRefOfColumnType(n *ColumnType) *ColumnType {
 
 // CloneColumns creates a deep clone of the input.
 func CloneColumns(n Columns) Columns {
+	if n == nil {
+		return nil
+	}
 	res := make(Columns, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -600,7 +621,10 @@ func CloneColumns(n Columns) Columns {
 
 // CloneComments creates a deep clone of the input.
 func CloneComments(n Comments) Comments {
-	res := make(Comments, 0, len(n))
+	if n == nil {
+		return nil
+	}
+	res := make(Comments, len(n))
 	copy(res, n)
 	return res
 }
@@ -614,6 +638,18 @@ func CloneRefOfCommit(n *Commit) *Commit {
 	return &out
 }
 
+// CloneRefOfCommonTableExpr creates a deep clone of the input.
+func CloneRefOfCommonTableExpr(n *CommonTableExpr) *CommonTableExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.TableID = CloneTableIdent(n.TableID)
+	out.Columns = CloneColumns(n.Columns)
+	out.Subquery = CloneRefOfSubquery(n.Subquery)
+	return &out
+}
+
 // CloneRefOfComparisonExpr creates a deep clone of the input.
 func CloneRefOfComparisonExpr(n *ComparisonExpr) *ComparisonExpr {
 	if n == nil {
@@ -836,6 +872,9 @@ func CloneRefOfExplainTab(n *ExplainTab) *ExplainTab {
 
 // CloneExprs creates a deep clone of the input.
 func CloneExprs(n Exprs) Exprs {
+	if n == nil {
+		return nil
+	}
 	res := make(Exprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -875,6 +914,27 @@ func CloneRefOfForeignKeyDefinition(n *ForeignKeyDefinition) *ForeignKeyDefiniti
 	return &out
 }
 
+// CloneRefOfFrameClause creates a deep clone of the input.
+func CloneRefOfFrameClause(n *FrameClause) *FrameClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Start = CloneRefOfFramePoint(n.Start)
+	out.End = CloneRefOfFramePoint(n.End)
+	return &out
+}
+
+// CloneRefOfFramePoint creates a deep clone of the input.
+func CloneRefOfFramePoint(n *FramePoint) *FramePoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
 // CloneRefOfFuncExpr creates a deep clone of the input.
 func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	if n == nil {
@@ -884,11 +944,15 @@ func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	out.Qualifier = CloneTableIdent(n.Qualifier)
 	out.Name = CloneColIdent(n.Name)
 	out.Exprs = CloneSelectExprs(n.Exprs)
+	out.Over = CloneRefOfOverClause(n.Over)
 	return &out
 }
 
 // CloneGroupBy creates a deep clone of the input.
 func CloneGroupBy(n GroupBy) GroupBy {
+	if n == nil {
+		return nil
+	}
 	res := make(GroupBy, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -1104,6 +1168,9 @@ func CloneRefOfNullVal(n *NullVal) *NullVal {
 
 // CloneOnDup creates a deep clone of the input.
 func CloneOnDup(n OnDup) OnDup {
+	if n == nil {
+		return nil
+	}
 	res := make(OnDup, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUpdateExpr(x))
@@ -1144,6 +1211,9 @@ func CloneRefOfOrder(n *Order) *Order {
 
 // CloneOrderBy creates a deep clone of the input.
 func CloneOrderBy(n OrderBy) OrderBy {
+	if n == nil {
+		return nil
+	}
 	res := make(OrderBy, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfOrder(x))
@@ -1179,6 +1249,17 @@ func CloneRefOfOtherRead(n *OtherRead) *OtherRead {
 	return &out
 }
 
+// CloneRefOfOverClause creates a deep clone of the input.
+func CloneRefOfOverClause(n *OverClause) *OverClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.WindowName = CloneColIdent(n.WindowName)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
+	return &out
+}
+
 // CloneRefOfParenSelect creates a deep clone of the input.
 func CloneRefOfParenSelect(n *ParenSelect) *ParenSelect {
 	if n == nil {
@@ -1225,6 +1306,9 @@ func CloneRefOfPartitionSpec(n *PartitionSpec) *PartitionSpec {
 
 // ClonePartitions creates a deep clone of the input.
 func ClonePartitions(n Partitions) Partitions {
+	if n == nil {
+		return nil
+	}
 	res := make(Partitions, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -1346,8 +1430,10 @@ func CloneRefOfSelect(n *Select) *Select {
 	out.Comments = CloneComments(n.Comments)
 	out.SelectExprs = CloneSelectExprs(n.SelectExprs)
 	out.Where = CloneRefOfWhere(n.Where)
+	out.With = CloneRefOfWith(n.With)
 	out.GroupBy = CloneGroupBy(n.GroupBy)
 	out.Having = CloneRefOfWhere(n.Having)
+	out.Windows = CloneWindowDefinitions(n.Windows)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
 	out.Into = CloneRefOfSelectInto(n.Into)
@@ -1356,6 +1442,9 @@ func CloneRefOfSelect(n *Select) *Select {
 
 // CloneSelectExprs creates a deep clone of the input.
 func CloneSelectExprs(n SelectExprs) SelectExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(SelectExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneSelectExpr(x))
@@ -1396,6 +1485,9 @@ func CloneRefOfSetExpr(n *SetExpr) *SetExpr {
 
 // CloneSetExprs creates a deep clone of the input.
 func CloneSetExprs(n SetExprs) SetExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(SetExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfSetExpr(x))
@@ -1517,6 +1609,9 @@ func CloneRefOfSubstrExpr(n *SubstrExpr) *SubstrExpr {
 
 // CloneTableExprs creates a deep clone of the input.
 func CloneTableExprs(n TableExprs) TableExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(TableExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableExpr(x))
@@ -1536,6 +1631,9 @@ func CloneTableName(n TableName) TableName {
 
 // CloneTableNames creates a deep clone of the input.
 func CloneTableNames(n TableNames) TableNames {
+	if n == nil {
+		return nil
+	}
 	res := make(TableNames, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableName(x))
@@ -1545,6 +1643,9 @@ func CloneTableNames(n TableNames) TableNames {
 
 // CloneTableOptions creates a deep clone of the input.
 func CloneTableOptions(n TableOptions) TableOptions {
+	if n == nil {
+		return nil
+	}
 	res := make(TableOptions, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfTableOption(x))
@@ -1615,6 +1716,7 @@ func CloneRefOfUnion(n *Union) *Union {
 	out.UnionSelects = CloneSliceOfRefOfUnionSelect(n.UnionSelects)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
+	out.With = CloneRefOfWith(n.With)
 	return &out
 }
 
@@ -1665,6 +1767,9 @@ func CloneRefOfUpdateExpr(n *UpdateExpr) *UpdateExpr {
 
 // CloneUpdateExprs creates a deep clone of the input.
 func CloneUpdateExprs(n UpdateExprs) UpdateExprs {
+	if n == nil {
+		return nil
+	}
 	res := make(UpdateExprs, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUpdateExpr(x))
@@ -1698,6 +1803,9 @@ func CloneRefOfVStream(n *VStream) *VStream {
 
 // CloneValTuple creates a deep clone of the input.
 func CloneValTuple(n ValTuple) ValTuple {
+	if n == nil {
+		return nil
+	}
 	res := make(ValTuple, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneExpr(x))
@@ -1716,6 +1824,9 @@ func CloneRefOfValidation(n *Validation) *Validation {
 
 // CloneValues creates a deep clone of the input.
 func CloneValues(n Values) Values {
+	if n == nil {
+		return nil
+	}
 	res := make(Values, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneValTuple(x))
@@ -1771,6 +1882,64 @@ func CloneRefOfWhere(n *Where) *Where {
 	return &out
 }
 
+// CloneRefOfWindowDefinition creates a deep clone of the input.
+func CloneRefOfWindowDefinition(n *WindowDefinition) *WindowDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
+	return &out
+}
+
+// CloneWindowDefinitions creates a deep clone of the input.
+func CloneWindowDefinitions(n WindowDefinitions) WindowDefinitions {
+	if n == nil {
+		return nil
+	}
+	res := make(WindowDefinitions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfWindowDefinition(x))
+	}
+	return res
+}
+
+// CloneRefOfWindowFuncExpr creates a deep clone of the input.
+func CloneRefOfWindowFuncExpr(n *WindowFuncExpr) *WindowFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Exprs = CloneExprs(n.Exprs)
+	out.Over = CloneRefOfOverClause(n.Over)
+	return &out
+}
+
+// CloneRefOfWindowSpecification creates a deep clone of the input.
+func CloneRefOfWindowSpecification(n *WindowSpecification) *WindowSpecification {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.PartitionClause = CloneExprs(n.PartitionClause)
+	out.OrderClause = CloneOrderBy(n.OrderClause)
+	out.FrameClause = CloneRefOfFrameClause(n.FrameClause)
+	return &out
+}
+
+// CloneRefOfWith creates a deep clone of the input.
+func CloneRefOfWith(n *With) *With {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.CTEs = CloneSliceOfRefOfCommonTableExpr(n.CTEs)
+	return &out
+}
+
 // CloneRefOfXorExpr creates a deep clone of the input.
 func CloneRefOfXorExpr(n *XorExpr) *XorExpr {
 	if n == nil {
@@ -2010,6 +2179,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneValTuple(in)
 	case *ValuesFuncExpr:
 		return CloneRefOfValuesFuncExpr(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
@@ -2222,6 +2393,9 @@ func CloneTableExpr(in TableExpr) TableExpr {
 
 // CloneSliceOfRefOfColumnDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfColumnDefinition(n []*ColumnDefinition) []*ColumnDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*ColumnDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfColumnDefinition(x))
@@ -2231,6 +2405,9 @@ func CloneSliceOfRefOfColumnDefinition(n []*ColumnDefinition) []*ColumnDefinitio
 
 // CloneSliceOfCollateAndCharset creates a deep clone of the input.
 func CloneSliceOfCollateAndCharset(n []CollateAndCharset) []CollateAndCharset {
+	if n == nil {
+		return nil
+	}
 	res := make([]CollateAndCharset, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneCollateAndCharset(x))
@@ -2240,6 +2417,9 @@ func CloneSliceOfCollateAndCharset(n []CollateAndCharset) []CollateAndCharset {
 
 // CloneSliceOfAlterOption creates a deep clone of the input.
 func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
+	if n == nil {
+		return nil
+	}
 	res := make([]AlterOption, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneAlterOption(x))
@@ -2249,6 +2429,9 @@ func CloneSliceOfAlterOption(n []AlterOption) []AlterOption {
 
 // CloneSliceOfColIdent creates a deep clone of the input.
 func CloneSliceOfColIdent(n []ColIdent) []ColIdent {
+	if n == nil {
+		return nil
+	}
 	res := make([]ColIdent, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneColIdent(x))
@@ -2258,6 +2441,9 @@ func CloneSliceOfColIdent(n []ColIdent) []ColIdent {
 
 // CloneSliceOfRefOfWhen creates a deep clone of the input.
 func CloneSliceOfRefOfWhen(n []*When) []*When {
+	if n == nil {
+		return nil
+	}
 	res := make([]*When, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfWhen(x))
@@ -2296,13 +2482,19 @@ func CloneRefOfColumnTypeOptions(n *ColumnTypeOptions) *ColumnTypeOptions {
 
 // CloneSliceOfString creates a deep clone of the input.
 func CloneSliceOfString(n []string) []string {
-	res := make([]string, 0, len(n))
+	if n == nil {
+		return nil
+	}
+	res := make([]string, len(n))
 	copy(res, n)
 	return res
 }
 
 // CloneSliceOfRefOfIndexColumn creates a deep clone of the input.
 func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexColumn, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexColumn(x))
@@ -2312,6 +2504,9 @@ func CloneSliceOfRefOfIndexColumn(n []*IndexColumn) []*IndexColumn {
 
 // CloneSliceOfRefOfIndexOption creates a deep clone of the input.
 func CloneSliceOfRefOfIndexOption(n []*IndexOption) []*IndexOption {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexOption, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexOption(x))
@@ -2332,6 +2527,9 @@ func CloneRefOfJoinCondition(n *JoinCondition) *JoinCondition {
 
 // CloneTableAndLockTypes creates a deep clone of the input.
 func CloneTableAndLockTypes(n TableAndLockTypes) TableAndLockTypes {
+	if n == nil {
+		return nil
+	}
 	res := make(TableAndLockTypes, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfTableAndLockType(x))
@@ -2341,6 +2539,9 @@ func CloneTableAndLockTypes(n TableAndLockTypes) TableAndLockTypes {
 
 // CloneSliceOfRefOfPartitionDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfPartitionDefinition(n []*PartitionDefinition) []*PartitionDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*PartitionDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfPartitionDefinition(x))
@@ -2350,6 +2551,9 @@ func CloneSliceOfRefOfPartitionDefinition(n []*PartitionDefinition) []*Partition
 
 // CloneSliceOfRefOfRenameTablePair creates a deep clone of the input.
 func CloneSliceOfRefOfRenameTablePair(n []*RenameTablePair) []*RenameTablePair {
+	if n == nil {
+		return nil
+	}
 	res := make([]*RenameTablePair, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfRenameTablePair(x))
@@ -2368,6 +2572,9 @@ func CloneRefOfBool(n *bool) *bool {
 
 // CloneSliceOfTableExpr creates a deep clone of the input.
 func CloneSliceOfTableExpr(n []TableExpr) []TableExpr {
+	if n == nil {
+		return nil
+	}
 	res := make([]TableExpr, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneTableExpr(x))
@@ -2377,6 +2584,9 @@ func CloneSliceOfTableExpr(n []TableExpr) []TableExpr {
 
 // CloneSliceOfCharacteristic creates a deep clone of the input.
 func CloneSliceOfCharacteristic(n []Characteristic) []Characteristic {
+	if n == nil {
+		return nil
+	}
 	res := make([]Characteristic, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneCharacteristic(x))
@@ -2427,6 +2637,9 @@ func CloneRefOfTableOption(n *TableOption) *TableOption {
 
 // CloneSliceOfRefOfIndexDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfIndexDefinition(n []*IndexDefinition) []*IndexDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*IndexDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfIndexDefinition(x))
@@ -2436,6 +2649,9 @@ func CloneSliceOfRefOfIndexDefinition(n []*IndexDefinition) []*IndexDefinition {
 
 // CloneSliceOfRefOfConstraintDefinition creates a deep clone of the input.
 func CloneSliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) []*ConstraintDefinition {
+	if n == nil {
+		return nil
+	}
 	res := make([]*ConstraintDefinition, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfConstraintDefinition(x))
@@ -2445,6 +2661,9 @@ func CloneSliceOfRefOfConstraintDefinition(n []*ConstraintDefinition) []*Constra
 
 // CloneSliceOfRefOfUnionSelect creates a deep clone of the input.
 func CloneSliceOfRefOfUnionSelect(n []*UnionSelect) []*UnionSelect {
+	if n == nil {
+		return nil
+	}
 	res := make([]*UnionSelect, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneRefOfUnionSelect(x))
@@ -2464,6 +2683,9 @@ func CloneRefOfVindexParam(n *VindexParam) *VindexParam {
 
 // CloneSliceOfVindexParam creates a deep clone of the input.
 func CloneSliceOfVindexParam(n []VindexParam) []VindexParam {
+	if n == nil {
+		return nil
+	}
 	res := make([]VindexParam, 0, len(n))
 	for _, x := range n {
 		res = append(res, CloneVindexParam(x))
@@ -2471,6 +2693,18 @@ func CloneSliceOfVindexParam(n []VindexParam) []VindexParam {
 	return res
 }
 
+// CloneSliceOfRefOfCommonTableExpr creates a deep clone of the input.
+func CloneSliceOfRefOfCommonTableExpr(n []*CommonTableExpr) []*CommonTableExpr {
+	if n == nil {
+		return nil
+	}
+	res := make([]*CommonTableExpr, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfCommonTableExpr(x))
+	}
+	return res
+}
+
 // CloneCollateAndCharset creates a deep clone of the input.
 func CloneCollateAndCharset(n CollateAndCharset) CollateAndCharset {
 	return *CloneRefOfCollateAndCharset(&n)
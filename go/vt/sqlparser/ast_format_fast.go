@@ -25,6 +25,7 @@ import (
 
 // formatFast formats the node.
 func (node *Select) formatFast(buf *TrackedBuffer) {
+	node.With.formatFast(buf)
 	buf.WriteString("select ")
 	node.Comments.formatFast(buf)
 
@@ -61,6 +62,8 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 
 	node.Having.formatFast(buf)
 
+	node.Windows.formatFast(buf)
+
 	node.OrderBy.formatFast(buf)
 
 	node.Limit.formatFast(buf)
@@ -78,6 +81,7 @@ func (node *ParenSelect) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *Union) formatFast(buf *TrackedBuffer) {
+	node.With.formatFast(buf)
 	node.FirstStatement.formatFast(buf)
 	for _, us := range node.UnionSelects {
 		us.formatFast(buf)
@@ -87,6 +91,32 @@ func (node *Union) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(node.Lock.ToString())
 }
 
+// formatFast formats the node.
+func (node *With) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.WriteString(prefix)
+		cte.formatFast(buf)
+		prefix = ", "
+	}
+	buf.WriteByte(' ')
+}
+
+// formatFast formats the node.
+func (node *CommonTableExpr) formatFast(buf *TrackedBuffer) {
+	node.TableID.formatFast(buf)
+	node.Columns.formatFast(buf)
+	buf.WriteString(" as ")
+	node.Subquery.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *UnionSelect) formatFast(buf *TrackedBuffer) {
 	if node.Distinct {
@@ -1470,6 +1500,107 @@ func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(distinct)
 	node.Exprs.formatFast(buf)
 	buf.WriteByte(')')
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node
+func (node *WindowFuncExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Name.Lowered())
+	buf.WriteByte('(')
+	node.Exprs.formatFast(buf)
+	buf.WriteByte(')')
+	buf.WriteString(node.FromFirstLast.ToString())
+	buf.WriteString(node.NullTreatment.ToString())
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node
+func (node *OverClause) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec == nil {
+		buf.WriteString(" over ")
+		node.WindowName.formatFast(buf)
+		return
+	}
+	buf.WriteString(" over (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *WindowSpecification) formatFast(buf *TrackedBuffer) {
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("partition by ")
+		node.PartitionClause.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		for i, order := range node.OrderClause {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			order.formatFast(buf)
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.WriteString(prefix)
+		node.FrameClause.formatFast(buf)
+	}
+}
+
+// formatFast formats the node
+func (node WindowDefinitions) formatFast(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
+}
+
+// formatFast formats the node
+func (node *WindowDefinition) formatFast(buf *TrackedBuffer) {
+	node.Name.formatFast(buf)
+	buf.WriteString(" as (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Unit.ToString())
+	if node.End == nil {
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+		return
+	}
+	buf.WriteString(" between ")
+	node.Start.formatFast(buf)
+	buf.WriteString(" and ")
+	node.End.formatFast(buf)
+}
+
+// formatFast formats the node
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case ExprPrecedingType, ExprFollowingType:
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+		buf.WriteString(node.Type.ToString())
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
 }
 
 // formatFast formats the node
@@ -330,7 +330,7 @@ func (node *AliasedTableExpr) RemoveHints() *AliasedTableExpr {
 	return &noHints
 }
 
-//TableName returns a TableName pointing to this table expr
+// TableName returns a TableName pointing to this table expr
 func (node *AliasedTableExpr) TableName() (TableName, error) {
 	if !node.As.IsEmpty() {
 		return TableName{Name: node.As}, nil
@@ -480,27 +480,45 @@ func (node *ColName) Equal(c *ColName) bool {
 
 // Aggregates is a map of all aggregate functions.
 var Aggregates = map[string]bool{
-	"avg":          true,
-	"bit_and":      true,
-	"bit_or":       true,
-	"bit_xor":      true,
-	"count":        true,
-	"group_concat": true,
-	"max":          true,
-	"min":          true,
-	"std":          true,
-	"stddev_pop":   true,
-	"stddev_samp":  true,
-	"stddev":       true,
-	"sum":          true,
-	"var_pop":      true,
-	"var_samp":     true,
-	"variance":     true,
+	"avg":            true,
+	"bit_and":        true,
+	"bit_or":         true,
+	"bit_xor":        true,
+	"count":          true,
+	"group_concat":   true,
+	"json_arrayagg":  true,
+	"json_objectagg": true,
+	"max":            true,
+	"min":            true,
+	"std":            true,
+	"stddev_pop":     true,
+	"stddev_samp":    true,
+	"stddev":         true,
+	"sum":            true,
+	"var_pop":        true,
+	"var_samp":       true,
+	"variance":       true,
+}
+
+// SeparatorValue returns the separator used to concatenate the values,
+// which is a comma if the expression doesn't specify any.
+func (node *GroupConcatExpr) SeparatorValue() string {
+	if node.Separator == "" {
+		return ","
+	}
+	tkn := NewStringTokenizer(strings.TrimPrefix(node.Separator, " separator "))
+	typ, val := tkn.Scan()
+	if typ != STRING {
+		return ","
+	}
+	return val
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// An aggregate function used as a window function is not, as it
+// does not group the rows it is computed over.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
 }
 
 // NewColIdent makes a new ColIdent.
@@ -528,7 +546,7 @@ func NewColNameWithQualifier(identifier string, table TableName) *ColName {
 	}
 }
 
-//NewSelect is used to create a select statement
+// NewSelect is used to create a select statement
 func NewSelect(comments Comments, exprs SelectExprs, selectOptions []string, from TableExprs, where *Where, groupBy GroupBy, having *Where) *Select {
 	var cache *bool
 	var distinct, straightJoinHint, sqlFoundRows bool
@@ -739,6 +757,11 @@ func (node *Select) SetLock(lock Lock) {
 	node.Lock = lock
 }
 
+// SetWith sets the WITH clause
+func (node *Select) SetWith(with *With) {
+	node.With = with
+}
+
 // MakeDistinct makes the statement distinct
 func (node *Select) MakeDistinct() {
 	node.Distinct = true
@@ -796,6 +819,11 @@ func (node *ParenSelect) SetLock(lock Lock) {
 	node.Select.SetLock(lock)
 }
 
+// SetWith sets the WITH clause
+func (node *ParenSelect) SetWith(with *With) {
+	node.Select.SetWith(with)
+}
+
 // MakeDistinct implements the SelectStatement interface
 func (node *ParenSelect) MakeDistinct() {
 	node.Select.MakeDistinct()
@@ -837,6 +865,11 @@ func (node *Union) SetLock(lock Lock) {
 	node.Lock = lock
 }
 
+// SetWith sets the WITH clause
+func (node *Union) SetWith(with *With) {
+	node.With = with
+}
+
 // MakeDistinct implements the SelectStatement interface
 func (node *Union) MakeDistinct() {
 	node.UnionSelects[len(node.UnionSelects)-1].Distinct = true
@@ -847,7 +880,7 @@ func (node *Union) GetColumnCount() int {
 	return node.FirstStatement.GetColumnCount()
 }
 
-//Unionize returns a UNION, either creating one or adding SELECT to an existing one
+// Unionize returns a UNION, either creating one or adding SELECT to an existing one
 func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit, lock Lock) *Union {
 	union, isUnion := lhs.(*Union)
 	if isUnion {
@@ -971,6 +1004,64 @@ func (joinType JoinType) ToString() string {
 	}
 }
 
+// ToString returns the frame unit as a string
+func (unit FrameUnitType) ToString() string {
+	switch unit {
+	case FrameRowsType:
+		return FrameRowsStr
+	case FrameRangeType:
+		return FrameRangeStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the frame point type as a string
+func (typ FramePointType) ToString() string {
+	switch typ {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return ExprPrecedingStr
+	case ExprFollowingType:
+		return ExprFollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
+// ToString returns the FROM FIRST / FROM LAST option as a string
+func (typ FromFirstLastType) ToString() string {
+	switch typ {
+	case NoFromFirstLastType:
+		return ""
+	case FromFirstType:
+		return FromFirstStr
+	case FromLastType:
+		return FromLastStr
+	default:
+		return "Unknown FromFirstLastType"
+	}
+}
+
+// ToString returns the null treatment option as a string
+func (typ NullTreatmentType) ToString() string {
+	switch typ {
+	case NoNullTreatmentType:
+		return ""
+	case RespectNullsType:
+		return RespectNullsStr
+	case IgnoreNullsType:
+		return IgnoreNullsStr
+	default:
+		return "Unknown NullTreatmentType"
+	}
+}
+
 // ToString returns the operator as a string
 func (op ComparisonExprOperator) ToString() string {
 	switch op {
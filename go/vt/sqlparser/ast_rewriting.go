@@ -356,7 +356,10 @@ func (er *expressionRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 		sysvars.TransactionMode.Name,
 		sysvars.ReadAfterWriteGTID.Name,
 		sysvars.ReadAfterWriteTimeOut.Name,
+		sysvars.SessionEnableConsolidator.Name,
+		sysvars.SessionEnableTxRetry.Name,
 		sysvars.SessionEnableSystemSettings.Name,
+		sysvars.SessionReadWriteSplit.Name,
 		sysvars.SessionTrackGTIDs.Name,
 		sysvars.SessionUUID.Name,
 		sysvars.SkipQueryPlanCache.Name,
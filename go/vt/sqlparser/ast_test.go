@@ -405,6 +405,31 @@ func TestIsAggregate(t *testing.T) {
 	}
 }
 
+func TestGroupConcatSeparatorValue(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{{
+		in:  "select group_concat(a) from t",
+		out: ",",
+	}, {
+		in:  "select group_concat(a order by b separator '|') from t",
+		out: "|",
+	}, {
+		in:  "select group_concat(a separator '') from t",
+		out: "",
+	}, {
+		in:  "select group_concat(a separator 'it''s') from t",
+		out: "it's",
+	}}
+	for _, tc := range testcases {
+		stmt, err := Parse(tc.in)
+		require.NoError(t, err)
+		expr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr.(*GroupConcatExpr)
+		assert.Equal(t, tc.out, expr.SeparatorValue(), tc.in)
+	}
+}
+
 func TestIsImpossible(t *testing.T) {
 	f := ComparisonExpr{
 		Operator: NotEqualOp,
@@ -39,6 +39,10 @@ const (
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
 	// DirectiveAllowScatter lets scatter plans pass through even when they are turned off by `no-scatter`.
 	DirectiveAllowScatter = "ALLOW_SCATTER"
+	// DirectiveWorkloadPool selects the workload pool that vttablet uses to execute the query.
+	DirectiveWorkloadPool = "WORKLOAD_POOL"
+	// DirectiveCacheTTL caches the results of a SELECT in vtgate for the given duration.
+	DirectiveCacheTTL = "CACHE_TTL"
 )
 
 func isNonSpace(r rune) bool {
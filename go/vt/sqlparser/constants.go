@@ -183,6 +183,25 @@ const (
 	AscScr  = "asc"
 	DescScr = "desc"
 
+	// FrameClause.Unit
+	FrameRowsStr  = "rows"
+	FrameRangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	ExprPrecedingStr      = "preceding"
+	ExprFollowingStr      = "following"
+
+	// WindowFuncExpr.FromFirstLast
+	FromFirstStr = " from first"
+	FromLastStr  = " from last"
+
+	// WindowFuncExpr.NullTreatment
+	RespectNullsStr = " respect nulls"
+	IgnoreNullsStr  = " ignore nulls"
+
 	// SetExpr.Expr, for SET TRANSACTION ... or START TRANSACTION
 	// TransactionStr is the Name for a SET TRANSACTION statement
 	TransactionStr = "transaction"
@@ -404,6 +423,35 @@ const (
 	DescOrder
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	FrameRowsType FrameUnitType = iota
+	FrameRangeType
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
+// Constant for Enum Type - FromFirstLastType
+const (
+	NoFromFirstLastType FromFirstLastType = iota
+	FromFirstType
+	FromLastType
+)
+
+// Constant for Enum Type - NullTreatmentType
+const (
+	NoNullTreatmentType NullTreatmentType = iota
+	RespectNullsType
+	IgnoreNullsType
+)
+
 // Constant for Enum Type - ConvertTypeOperator
 const (
 	NoOperator ConvertTypeOperator = iota
@@ -18,16 +18,48 @@ package sqlparser
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // ErrExprNotSupported signals that the expression cannot be handled by expression evaluation engine.
 var ErrExprNotSupported = fmt.Errorf("Expr Not Supported")
 
+// ColumnLookup returns the offset and the field of a column in the rows an expression is evaluated against
+type ColumnLookup func(col *ColName) (int, *querypb.Field, error)
+
+type converter struct {
+	lookup ColumnLookup
+}
+
 //Convert converts between AST expressions and executable expressions
 func Convert(e Expr) (evalengine.Expr, error) {
+	return (&converter{}).convert(e)
+}
+
+// ConvertWithColumns is like Convert, but it also converts the column references in the expression,
+// using lookup to find them in the rows the expression is evaluated against
+func ConvertWithColumns(e Expr, lookup ColumnLookup) (evalengine.Expr, error) {
+	return (&converter{lookup: lookup}).convert(e)
+}
+
+func (c *converter) convert(e Expr) (evalengine.Expr, error) {
 	switch node := e.(type) {
+	case *ColName:
+		if c.lookup == nil {
+			return nil, ErrExprNotSupported
+		}
+		offset, field, err := c.lookup(node)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewTypedColumn(offset, field.Type, collations.ID(field.Charset)), nil
 	case Argument:
 		return evalengine.NewBindVar(string(node)), nil
 	case *Literal:
@@ -39,6 +71,8 @@ func Convert(e Expr) (evalengine.Expr, error) {
 		case StrVal:
 			return evalengine.NewLiteralString(node.Bytes()), nil
 		}
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
 	case BoolVal:
 		if node {
 			return evalengine.NewLiteralIntFromBytes([]byte("1"))
@@ -55,14 +89,24 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			op = &evalengine.Multiplication{}
 		case DivOp:
 			op = &evalengine.Division{}
+		case ModOp:
+			return c.convertCall("mod", node.Left, node.Right)
+		case JSONExtractOp:
+			return c.convertCall("json_extract", node.Left, node.Right)
+		case JSONUnquoteExtractOp:
+			extract, err := c.convertCall("json_extract", node.Left, node.Right)
+			if err != nil {
+				return nil, err
+			}
+			return evalengine.NewCallExpr("json_unquote", []evalengine.Expr{extract})
 		default:
 			return nil, ErrExprNotSupported
 		}
-		left, err := Convert(node.Left)
+		left, err := c.convert(node.Left)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Convert(node.Right)
+		right, err := c.convert(node.Right)
 		if err != nil {
 			return nil, err
 		}
@@ -71,7 +115,278 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			Left:  left,
 			Right: right,
 		}, nil
+	case *UnaryExpr:
+		if node.Operator != UMinusOp {
+			return nil, ErrExprNotSupported
+		}
+		inner, err := c.convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.BinaryOp{
+			Expr:  &evalengine.Subtraction{},
+			Left:  evalengine.NewLiteralInt(0),
+			Right: inner,
+		}, nil
+	case *ComparisonExpr:
+		return c.convertComparison(node)
+	case *RangeCond:
+		return c.convertRangeCond(node)
+	case *IsExpr:
+		inner, err := c.convert(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		var op evalengine.IsOperator
+		switch node.Right {
+		case IsNullOp:
+			op = evalengine.IsNull
+		case IsNotNullOp:
+			op = evalengine.IsNotNull
+		case IsTrueOp:
+			op = evalengine.IsTrue
+		case IsNotTrueOp:
+			op = evalengine.IsNotTrue
+		case IsFalseOp:
+			op = evalengine.IsFalse
+		case IsNotFalseOp:
+			op = evalengine.IsNotFalse
+		default:
+			return nil, ErrExprNotSupported
+		}
+		return &evalengine.IsExpr{Inner: inner, Op: op}, nil
+	case *AndExpr:
+		left, right, err := c.convertBoth(node.Left, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.AndExpr{Left: left, Right: right}, nil
+	case *OrExpr:
+		left, right, err := c.convertBoth(node.Left, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.OrExpr{Left: left, Right: right}, nil
+	case *XorExpr:
+		left, right, err := c.convertBoth(node.Left, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.XorExpr{Left: left, Right: right}, nil
+	case *NotExpr:
+		inner, err := c.convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.NotExpr{Inner: inner}, nil
+	case *CaseExpr:
+		return c.convertCase(node)
+	case *ConvertExpr:
+		return c.convertCast(node)
+	case *FuncExpr:
+		if node.Distinct || !node.Qualifier.IsEmpty() || node.IsAggregate() {
+			return nil, ErrExprNotSupported
+		}
+		args := make([]Expr, 0, len(node.Exprs))
+		for _, selectExpr := range node.Exprs {
+			aliased, ok := selectExpr.(*AliasedExpr)
+			if !ok {
+				return nil, ErrExprNotSupported
+			}
+			args = append(args, aliased.Expr)
+		}
+		return c.convertCall(node.Name.Lowered(), args...)
+	case *SubstrExpr:
+		var str Expr = node.Name
+		if node.StrVal != nil {
+			str = node.StrVal
+		}
+		if node.To == nil {
+			return c.convertCall("substr", str, node.From)
+		}
+		return c.convertCall("substr", str, node.From, node.To)
+	}
+	return nil, ErrExprNotSupported
+}
+
+func (c *converter) convertBoth(l, r Expr) (evalengine.Expr, evalengine.Expr, error) {
+	left, err := c.convert(l)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := c.convert(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func (c *converter) convertComparison(node *ComparisonExpr) (evalengine.Expr, error) {
+	var op evalengine.ComparisonOp
+	switch node.Operator {
+	case EqualOp:
+		op = &evalengine.EqualOp{}
+	case NotEqualOp:
+		op = &evalengine.NotEqualOp{}
+	case NullSafeEqualOp:
+		op = &evalengine.NullSafeEqualOp{}
+	case LessThanOp:
+		op = &evalengine.LessThanOp{}
+	case LessEqualOp:
+		op = &evalengine.LessEqualOp{}
+	case GreaterThanOp:
+		op = &evalengine.GreaterThanOp{}
+	case GreaterEqualOp:
+		op = &evalengine.GreaterEqualOp{}
+	case InOp, NotInOp:
+		left, err := c.convert(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := c.convertTuple(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.InExpr{Left: left, Right: right, Negate: node.Operator == NotInOp}, nil
+	case LikeOp, NotLikeOp:
+		left, right, err := c.convertBoth(node.Left, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		var escape evalengine.Expr
+		if node.Escape != nil {
+			escape, err = c.convert(node.Escape)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &evalengine.LikeExpr{Left: left, Right: right, Escape: escape, Negate: node.Operator == NotLikeOp}, nil
+	default:
+		return nil, ErrExprNotSupported
+	}
+	left, right, err := c.convertBoth(node.Left, node.Right)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.ComparisonExpr{Op: op, Left: left, Right: right}, nil
+}
 
+func (c *converter) convertTuple(e Expr) (evalengine.TupleExpr, error) {
+	switch node := e.(type) {
+	case ValTuple:
+		tuple := make(evalengine.Tuple, 0, len(node))
+		for _, expr := range node {
+			val, err := c.convert(expr)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, val)
+		}
+		return tuple, nil
+	case ListArg:
+		return &evalengine.ListBindVariable{Key: string(node)}, nil
 	}
 	return nil, ErrExprNotSupported
 }
+
+// convertRangeCond converts `a BETWEEN b AND c` into `a >= b AND a <= c`,
+// and `a NOT BETWEEN b AND c` into `a < b OR a > c`
+func (c *converter) convertRangeCond(node *RangeCond) (evalengine.Expr, error) {
+	left, err := c.convert(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := c.convertBoth(node.From, node.To)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Operator {
+	case BetweenOp:
+		return &evalengine.AndExpr{
+			Left:  &evalengine.ComparisonExpr{Op: &evalengine.GreaterEqualOp{}, Left: left, Right: from},
+			Right: &evalengine.ComparisonExpr{Op: &evalengine.LessEqualOp{}, Left: left, Right: to},
+		}, nil
+	case NotBetweenOp:
+		return &evalengine.OrExpr{
+			Left:  &evalengine.ComparisonExpr{Op: &evalengine.LessThanOp{}, Left: left, Right: from},
+			Right: &evalengine.ComparisonExpr{Op: &evalengine.GreaterThanOp{}, Left: left, Right: to},
+		}, nil
+	}
+	return nil, ErrExprNotSupported
+}
+
+func (c *converter) convertCase(node *CaseExpr) (evalengine.Expr, error) {
+	result := &evalengine.CaseExpr{}
+	var err error
+	if node.Expr != nil {
+		result.Base, err = c.convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		cond, val, err := c.convertBoth(when.Cond, when.Val)
+		if err != nil {
+			return nil, err
+		}
+		result.Whens = append(result.Whens, &evalengine.WhenThen{When: cond, Then: val})
+	}
+	if node.Else != nil {
+		result.Else, err = c.convert(node.Else)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (c *converter) convertCall(name string, exprs ...Expr) (evalengine.Expr, error) {
+	args := make([]evalengine.Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		arg, err := c.convert(expr)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	call, err := evalengine.NewCallExpr(name, args)
+	if err == evalengine.ErrUnknownFunction {
+		return nil, ErrExprNotSupported
+	}
+	return call, err
+}
+
+// castTypes maps the types of CAST and CONVERT to the type of their result
+var castTypes = map[string]querypb.Type{
+	"binary":   sqltypes.VarBinary,
+	"char":     sqltypes.VarBinary,
+	"nchar":    sqltypes.VarBinary,
+	"signed":   sqltypes.Int64,
+	"unsigned": sqltypes.Uint64,
+	"decimal":  sqltypes.Decimal,
+	"date":     sqltypes.Date,
+	"datetime": sqltypes.Datetime,
+	"time":     sqltypes.Time,
+}
+
+func (c *converter) convertCast(node *ConvertExpr) (evalengine.Expr, error) {
+	typ, ok := castTypes[strings.ToLower(node.Type.Type)]
+	if !ok {
+		return nil, ErrExprNotSupported
+	}
+	inner, err := c.convert(node.Expr)
+	if err != nil {
+		return nil, err
+	}
+	cast := &evalengine.CastExpr{Inner: inner, Typ: typ}
+	if strings.EqualFold(node.Type.Type, "binary") {
+		cast.Collation = collations.Binary
+	}
+	if node.Type.Scale != nil {
+		cast.Scale, err = strconv.Atoi(node.Type.Scale.Val)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cast, nil
+}
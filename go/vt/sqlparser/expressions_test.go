@@ -17,10 +17,12 @@ limitations under the License.
 package sqlparser
 
 import (
+	"fmt"
 	"testing"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	"github.com/stretchr/testify/assert"
@@ -70,6 +72,366 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "1 = 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 = 2",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "1 = null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "null <=> null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 <=> null",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "1 != 2",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 <> 1",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "2 < 10",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "cast('2' as binary) < '10'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "'10' = 10",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' = 0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1.5 >= 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":uint64_bind_variable > -1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'b' > cast('a' as binary)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 in (3, 2, 1)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 in (3, 2)",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "1 in (3, null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 not in (3, 2)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null in (1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "2 in ::tuple_bind_variable",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "5 not in ::tuple_bind_variable",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "2 between 1 and 3",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "4 between 1 and 3",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "4 not between 1 and 3",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 is null",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "1 is not null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "0 is false",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is not true",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' like 'a%'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' like 'a_c'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' like 'a_'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "'a%c' like 'a\\%c'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'a%c' like 'a|%c' escape '|'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' not like '%d%'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null like 'a'",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 and 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 and 0",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "null and 0",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "null and 1",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "null or 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null or 0",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "0 or 0",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "1 xor 1",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "not 0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "not null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "true and false",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "case when 1 = 2 then 'a' when 2 = 2 then 'b' end",
+		expected:   sqltypes.NewVarBinary("b"),
+	}, {
+		expression: "case 3 when 1 then 'a' else 'c' end",
+		expected:   sqltypes.NewVarBinary("c"),
+	}, {
+		expression: "case when 0 then 1 end",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "if(1 > 0, 'yes', 'no')",
+		expected:   sqltypes.NewVarBinary("yes"),
+	}, {
+		expression: "if(null, 'yes', 'no')",
+		expected:   sqltypes.NewVarBinary("no"),
+	}, {
+		expression: "ifnull(null, 42)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "coalesce(null, null, 'x')",
+		expected:   sqltypes.NewVarBinary("x"),
+	}, {
+		expression: "nullif(1, 1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "nullif(1, 2)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "concat('a', 1, 'b')",
+		expected:   sqltypes.NewVarBinary("a1b"),
+	}, {
+		expression: "concat('a', null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat_ws(',', 'a', null, 'b')",
+		expected:   sqltypes.NewVarBinary("a,b"),
+	}, {
+		expression: "length('héllo')",
+		expected:   sqltypes.NewInt64(6),
+	}, {
+		expression: "char_length('héllo')",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "upper('abc')",
+		expected:   sqltypes.NewVarBinary("ABC"),
+	}, {
+		expression: "lower('ABC')",
+		expected:   sqltypes.NewVarBinary("abc"),
+	}, {
+		expression: "left('abcdef', 2)",
+		expected:   sqltypes.NewVarBinary("ab"),
+	}, {
+		expression: "right('abcdef', 2)",
+		expected:   sqltypes.NewVarBinary("ef"),
+	}, {
+		expression: "substr('abcdef', 2, 3)",
+		expected:   sqltypes.NewVarBinary("bcd"),
+	}, {
+		expression: "substring('abcdef', -2)",
+		expected:   sqltypes.NewVarBinary("ef"),
+	}, {
+		expression: "substr('abcdef' from 3 for 2)",
+		expected:   sqltypes.NewVarBinary("cd"),
+	}, {
+		expression: "trim('  a  ')",
+		expected:   sqltypes.NewVarBinary("a"),
+	}, {
+		expression: "replace('aXbX', 'X', 'y')",
+		expected:   sqltypes.NewVarBinary("ayby"),
+	}, {
+		expression: "reverse('abc')",
+		expected:   sqltypes.NewVarBinary("cba"),
+	}, {
+		expression: "repeat('ab', 3)",
+		expected:   sqltypes.NewVarBinary("ababab"),
+	}, {
+		expression: "lpad('5', 3, '0')",
+		expected:   sqltypes.NewVarBinary("005"),
+	}, {
+		expression: "rpad('abc', 2, '0')",
+		expected:   sqltypes.NewVarBinary("ab"),
+	}, {
+		expression: "instr('foobar', 'bar')",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "locate('o', 'foobar', 3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "ascii('a')",
+		expected:   sqltypes.NewInt64(97),
+	}, {
+		expression: "strcmp('a', 'b')",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "abs(-3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "ceil(1.2)",
+		expected:   sqltypes.NewFloat64(2),
+	}, {
+		expression: "floor(-1.2)",
+		expected:   sqltypes.NewFloat64(-2),
+	}, {
+		expression: "round(2.567, 2)",
+		expected:   sqltypes.NewFloat64(2.57),
+	}, {
+		expression: "round(1234, -2)",
+		expected:   sqltypes.NewInt64(1200),
+	}, {
+		expression: "truncate(2.567, 1)",
+		expected:   sqltypes.NewFloat64(2.5),
+	}, {
+		expression: "mod(10, 3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "10 % 4",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "mod(10, 0)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "sign(-2.5)",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "sqrt(16)",
+		expected:   sqltypes.NewFloat64(4),
+	}, {
+		expression: "sqrt(-1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "pow(2, 10)",
+		expected:   sqltypes.NewFloat64(1024),
+	}, {
+		expression: "log(2, 8)",
+		expected:   sqltypes.NewFloat64(3),
+	}, {
+		expression: "ln(0)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "greatest(1, 5, 3)",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "least(cast('b' as binary), cast('a' as binary), cast('c' as binary))",
+		expected:   sqltypes.NewVarBinary("a"),
+	}, {
+		expression: "year('2021-03-15 10:20:30')",
+		expected:   sqltypes.NewInt64(2021),
+	}, {
+		expression: "month('2021-03-15')",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "dayofmonth('2021-03-15')",
+		expected:   sqltypes.NewInt64(15),
+	}, {
+		expression: "quarter('2021-08-01')",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "dayofweek('2021-03-14')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "weekday('2021-03-14')",
+		expected:   sqltypes.NewInt64(6),
+	}, {
+		expression: "hour('10:20:30')",
+		expected:   sqltypes.NewInt64(10),
+	}, {
+		expression: "minute('2021-03-15 10:20:30')",
+		expected:   sqltypes.NewInt64(20),
+	}, {
+		expression: "second('10:20:30')",
+		expected:   sqltypes.NewInt64(30),
+	}, {
+		expression: "date('2021-03-15 10:20:30')",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-03-15")),
+	}, {
+		expression: "datediff('2021-03-15 23:59:59', '2021-03-01')",
+		expected:   sqltypes.NewInt64(14),
+	}, {
+		expression: "year('not a date')",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "cast('42abc' as signed)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "convert(7, unsigned)",
+		expected:   sqltypes.NewUint64(7),
+	}, {
+		expression: "cast(3.14159 as decimal(10, 2))",
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("3.14")),
+	}, {
+		expression: "cast('12345678901234567.891' as decimal(20, 2))",
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("12345678901234567.89")),
+	}, {
+		expression: "cast(9007199254740993 as decimal(20, 0))",
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("9007199254740993")),
+	}, {
+		expression: "cast(12 as char)",
+		expected:   sqltypes.NewVarBinary("12"),
+	}, {
+		expression: "cast('2021-03-15 10:20:30' as date)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-03-15")),
+	}, {
+		expression: "cast('2021-03-15' as datetime)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-03-15 00:00:00")),
+	}, {
+		expression: `json_extract('{"a": {"b": [1, "x"]}}', '$.a.b[1]')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`"x"`)),
+	}, {
+		expression: `json_extract('{"a": 1, "b c": 2}', '$.a', '$."b c"')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`[1, 2]`)),
+	}, {
+		expression: `json_extract('{"a": 1}', '$.b')`,
+		expected:   sqltypes.NULL,
+	}, {
+		expression: `json_unquote(json_extract('{"a": "x\\ty"}', '$.a'))`,
+		expected:   sqltypes.NewVarBinary("x\ty"),
+	}, {
+		expression: `json_unquote('[1]')`,
+		expected:   sqltypes.NewVarBinary("[1]"),
 	}}
 
 	for _, test := range tests {
@@ -87,6 +449,7 @@ func TestEvaluate(t *testing.T) {
 					"string_bind_variable": sqltypes.StringBindVariable("bar"),
 					"uint64_bind_variable": sqltypes.Uint64BindVariable(22),
 					"float_bind_variable":  sqltypes.Float64BindVariable(2.2),
+					"tuple_bind_variable":  sqltypes.TestBindVariable([]interface{}{1, 2, 3}),
 				},
 				Row: nil,
 			}
@@ -100,3 +463,90 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateWithColumns(t *testing.T) {
+	fields := []*querypb.Field{{Name: "id", Type: sqltypes.Int64}, {Name: "name", Type: sqltypes.VarChar, Charset: uint32(collations.LookupByName("utf8mb4_general_ci").ID())}, {Name: "doc", Type: sqltypes.TypeJSON}}
+	lookup := func(col *ColName) (int, *querypb.Field, error) {
+		for i, field := range fields {
+			if col.Name.EqualString(field.Name) {
+				return i, field, nil
+			}
+		}
+		return 0, nil, fmt.Errorf("column %s not found", String(col))
+	}
+	row := []sqltypes.Value{sqltypes.NewInt64(3), sqltypes.NewVarChar("abc"), sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"a": "x"}`))}
+
+	tests := []struct {
+		expression string
+		expected   sqltypes.Value
+		typ        querypb.Type
+	}{{
+		expression: "id",
+		expected:   sqltypes.NewInt64(3),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "id * 2 + 1",
+		expected:   sqltypes.NewInt64(7),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "concat(upper(name), '-', id)",
+		expected:   sqltypes.NewVarBinary("ABC-3"),
+		typ:        sqltypes.VarBinary,
+	}, {
+		expression: "case when id > 2 then 'big' else 'small' end",
+		expected:   sqltypes.NewVarBinary("big"),
+		typ:        sqltypes.VarBinary,
+	}, {
+		expression: "name like 'a%' and id in (1, 3)",
+		expected:   sqltypes.NewInt64(1),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "doc->>'$.a'",
+		expected:   sqltypes.NewVarBinary("x"),
+		typ:        sqltypes.VarBinary,
+	}, {
+		expression: "coalesce(name, 'none')",
+		expected:   sqltypes.NewVarBinary("abc"),
+		typ:        sqltypes.VarBinary,
+	}, {
+		expression: "name = 'ABC'",
+		expected:   sqltypes.NewInt64(1),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "name in ('x', 'Abc')",
+		expected:   sqltypes.NewInt64(1),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "cast(name as binary) = 'ABC'",
+		expected:   sqltypes.NewInt64(0),
+		typ:        sqltypes.Int64,
+	}}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := Parse("select " + test.expression + " from t")
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			expr, err := ConvertWithColumns(astExpr, lookup)
+			require.NoError(t, err)
+			env := evalengine.ExpressionEnv{Row: row}
+			typ, err := expr.Type(env)
+			require.NoError(t, err)
+			assert.Equal(t, test.typ, typ)
+			r, err := expr.Evaluate(env)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value())
+		})
+	}
+
+	expr, err := ConvertWithColumns(&ComparisonExpr{Operator: EqualOp, Left: NewStrLiteral("a"), Right: NewStrLiteral("A")}, lookup)
+	require.NoError(t, err)
+	// two literals are compared with the default collation
+	r, err := expr.Evaluate(evalengine.ExpressionEnv{Row: row})
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(1), r.Value())
+
+	_, err = ConvertWithColumns(NewColName("missing"), lookup)
+	assert.EqualError(t, err, "column missing not found")
+	_, err = Convert(NewColName("id"))
+	assert.Equal(t, ErrExprNotSupported, err)
+}
@@ -27,7 +27,7 @@ package sqlparser
 func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 	switch node := node.(type) {
 	case *Select:
-		buf.Myprintf("select %v from ", node.SelectExprs)
+		buf.Myprintf("%vselect %v from ", node.With, node.SelectExprs)
 		var prefix string
 		for _, n := range node.From {
 			buf.Myprintf("%s%v", prefix, n)
@@ -37,8 +37,11 @@ func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 		if node.GroupBy != nil {
 			node.GroupBy.Format(buf)
 		}
+		if node.Windows != nil {
+			buf.Myprintf("%v", node.Windows)
+		}
 	case *Union:
-		buf.astPrintf(node, "%v", node.FirstStatement)
+		buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 		for _, us := range node.UnionSelects {
 			buf.astPrintf(node, "%v", us)
 		}
@@ -136,12 +136,13 @@ var keywords = []keyword{
 	{"continue", UNUSED},
 	{"convert", CONVERT},
 	{"copy", COPY},
-	{"cume_dist", UNUSED},
+	{"cume_dist", CUME_DIST},
 	{"substr", SUBSTR},
 	{"substring", SUBSTRING},
 	{"create", CREATE},
 	{"cross", CROSS},
 	{"csv", CSV},
+	{"current", CURRENT},
 	{"current_date", CURRENT_DATE},
 	{"current_time", CURRENT_TIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
@@ -164,7 +165,7 @@ var keywords = []keyword{
 	{"delay_key_write", DELAY_KEY_WRITE},
 	{"delayed", UNUSED},
 	{"delete", DELETE},
-	{"dense_rank", UNUSED},
+	{"dense_rank", DENSE_RANK},
 	{"desc", DESC},
 	{"describe", DESCRIBE},
 	{"deterministic", UNUSED},
@@ -209,12 +210,13 @@ var keywords = []keyword{
 	{"fetch", UNUSED},
 	{"fields", FIELDS},
 	{"first", FIRST},
-	{"first_value", UNUSED},
+	{"first_value", FIRST_VALUE},
 	{"fixed", FIXED},
 	{"float", FLOAT_TYPE},
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
@@ -277,13 +279,13 @@ var keywords = []keyword{
 	{"keyspaces", KEYSPACES},
 	{"key_block_size", KEY_BLOCK_SIZE},
 	{"kill", UNUSED},
-	{"lag", UNUSED},
+	{"lag", LAG},
 	{"language", LANGUAGE},
 	{"last", LAST},
-	{"last_value", UNUSED},
+	{"last_value", LAST_VALUE},
 	{"last_insert_id", LAST_INSERT_ID},
 	{"lateral", UNUSED},
-	{"lead", UNUSED},
+	{"lead", LEAD},
 	{"leading", UNUSED},
 	{"leave", UNUSED},
 	{"left", LEFT},
@@ -335,9 +337,10 @@ var keywords = []keyword{
 	{"none", NONE},
 	{"not", NOT},
 	{"no_write_to_binlog", NO_WRITE_TO_BINLOG},
-	{"nth_value", UNUSED},
-	{"ntile", UNUSED},
+	{"nth_value", NTH_VALUE},
+	{"ntile", NTILE},
 	{"null", NULL},
+	{"nulls", NULLS},
 	{"numeric", NUMERIC},
 	{"of", UNUSED},
 	{"off", OFF},
@@ -354,31 +357,32 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
 	{"partition", PARTITION},
 	{"partitioning", PARTITIONING},
 	{"password", PASSWORD},
-	{"percent_rank", UNUSED},
+	{"percent_rank", PERCENT_RANK},
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
+	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
 	{"query", QUERY},
-	{"range", UNUSED},
-	{"rank", UNUSED},
+	{"range", RANGE},
+	{"rank", RANK},
 	{"read", READ},
 	{"reads", UNUSED},
 	{"read_write", UNUSED},
 	{"real", REAL},
 	{"rebuild", REBUILD},
-	{"recursive", UNUSED},
+	{"recursive", RECURSIVE},
 	{"redundant", REDUNDANT},
 	{"references", REFERENCES},
 	{"regexp", REGEXP},
@@ -393,6 +397,7 @@ var keywords = []keyword{
 	{"replace", REPLACE},
 	{"require", UNUSED},
 	{"resignal", UNUSED},
+	{"respect", RESPECT},
 	{"restrict", RESTRICT},
 	{"return", UNUSED},
 	{"retry", RETRY},
@@ -401,8 +406,10 @@ var keywords = []keyword{
 	{"right", RIGHT},
 	{"rlike", REGEXP},
 	{"rollback", ROLLBACK},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
-	{"row_number", UNUSED},
+	{"row_number", ROW_NUMBER},
+	{"rows", ROWS},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
 	{"schema", SCHEMA},
@@ -472,6 +479,7 @@ var keywords = []keyword{
 	{"triggers", TRIGGERS},
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
 	{"undo", UNUSED},
@@ -513,7 +521,7 @@ var keywords = []keyword{
 	{"when", WHEN},
 	{"where", WHERE},
 	{"while", UNUSED},
-	{"window", UNUSED},
+	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
 	{"work", WORK},
@@ -154,6 +154,32 @@ var (
 	}, {
 		input:  "(select id, a from t order by id limit 1) union (select id, b as a from s order by id limit 1) order by a limit 1",
 		output: "(select id, a from t order by id asc limit 1) union (select id, b as a from s order by id asc limit 1) order by a asc limit 1",
+	}, {
+		input: "with cte as (select a from t) select a from cte",
+	}, {
+		input:  "WITH cte1 AS (SELECT a, b FROM t1), cte2 AS (SELECT c FROM t2) SELECT b, c FROM cte1 JOIN cte2 ON cte1.a = cte2.c",
+		output: "with cte1 as (select a, b from t1), cte2 as (select c from t2) select b, c from cte1 join cte2 on cte1.a = cte2.c",
+	}, {
+		input: "with cte(x, y) as (select a, b from t) select x from cte where y = 1",
+	}, {
+		input: "with recursive cte(n) as (select 1 from dual union all select n + 1 from cte where n < 5) select n from cte",
+	}, {
+		input: "with cte as (select a from t) select a from cte union select b from s order by a asc",
+	}, {
+		input: "with cte as (select a from t) (select a from cte) union (select 1 from dual)",
+	}, {
+		input: "select * from (with cte as (select a from t) select a from cte) as dt",
+	}, {
+		input: "select * from t where a in (with cte as (select b from s) select b from cte)",
+	}, {
+		input: "with cte as (select a from t) select a from cte where a in (with cte2 as (select b from s) select b from cte2)",
+	}, {
+		input: "insert into t(a) with cte as (select a from s) select a from cte",
+	}, {
+		input: "create view v as with cte as (select a from t) select a from cte",
+	}, {
+		input:  "with `recursive` as (select a from t) select a from `recursive`",
+		output: "with `recursive` as (select a from t) select a from `recursive`",
 	}, {
 		input: "select a from (select 1 as a from tbl1 union select 2 from tbl2) as t",
 	}, {
@@ -1830,6 +1856,55 @@ var (
 	}, {
 		input:  "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
 		output: "select `name`, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by `name`",
+	}, {
+		input: "select a, row_number() over (partition by b order by c asc) from t",
+	}, {
+		input:  "SELECT a, RANK() OVER (ORDER BY b DESC), DENSE_RANK() OVER (ORDER BY b DESC) FROM t",
+		output: "select a, rank() over (order by b desc), dense_rank() over (order by b desc) from t",
+	}, {
+		input: "select a, cume_dist() over w, percent_rank() over w from t window w as (order by b asc)",
+	}, {
+		input: "select a, ntile(4) over (order by b asc) from t",
+	}, {
+		input: "select a, lag(b) over w, lead(b, 2, 0) over w from t window w as (partition by c order by a asc)",
+	}, {
+		input: "select a, lag(b, 1) respect nulls over (order by a asc) from t",
+	}, {
+		input: "select first_value(b) over (order by a asc), last_value(b) ignore nulls over (order by a asc) from t",
+	}, {
+		input: "select nth_value(b, 2) from first ignore nulls over (order by a asc) from t",
+	}, {
+		input: "select nth_value(b, 2) from last over (order by a asc) from t",
+	}, {
+		input: "select a, sum(b) over (partition by c) from t",
+	}, {
+		input: "select a, count(*) over () from t",
+	}, {
+		input:  "select a, avg(b) over (partition by c order by a rows unbounded preceding) from t",
+		output: "select a, avg(b) over (partition by c order by a asc rows unbounded preceding) from t",
+	}, {
+		input: "select a, sum(b) over (order by a asc rows between 1 preceding and 1 following) from t",
+	}, {
+		input: "select a, sum(b) over (order by a asc rows between current row and unbounded following) from t",
+	}, {
+		input: "select a, sum(b) over (order by a asc range between interval 1 day preceding and current row) from t",
+	}, {
+		input: "select a, sum(b) over (w rows between unbounded preceding and current row) from t window w as (partition by c order by a asc)",
+	}, {
+		input: "select a, rank() over w from t window v as (partition by c), w as (v order by a asc)",
+	}, {
+		input: "select a, row_number() over w from t where b = 1 group by a having count(*) > 1 window w as (order by a asc) order by a asc limit 10",
+	}, {
+		input: "select a, sum(b) over (rows unbounded preceding) from t",
+	}, {
+		input:  "select `rows`, `range` from t",
+		output: "select `rows`, `range` from t",
+	}, {
+		input:  "select row, rows from t where rows > 70",
+		output: "select `row`, `rows` from t where `rows` > 70",
+	}, {
+		input:  "select current, following, nulls, preceding, respect, unbounded from t",
+		output: "select `current`, `following`, `nulls`, `preceding`, `respect`, `unbounded` from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -2003,7 +2078,7 @@ var (
 	}, {
 		input: "call proc(@param)",
 	}, {
-		input:  "create table unused_reserved_keywords (dense_rank bigint, lead VARCHAR(255), percent_rank decimal(3, 0), constraint PK_project PRIMARY KEY (dense_rank))",
+		input:  "create table unused_reserved_keywords (`dense_rank` bigint, `lead` VARCHAR(255), `percent_rank` decimal(3, 0), constraint PK_project PRIMARY KEY (`dense_rank`))",
 		output: "create table unused_reserved_keywords (\n\t`dense_rank` bigint,\n\t`lead` VARCHAR(255),\n\t`percent_rank` decimal(3,0),\n\tconstraint PK_project PRIMARY KEY (`dense_rank`)\n)",
 	}}
 )
@@ -3172,6 +3247,12 @@ var (
 		output       string
 		excludeMulti bool // Don't use in the ParseNext multi-statement parsing tests.
 	}{{
+		input:  "select lag(a, 1, 0, 2) over (order by a) from t",
+		output: "too many arguments for lag at position 41",
+	}, {
+		input:  "select row_number() from t",
+		output: "syntax error at position 25 near 'from'",
+	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
 	}, {
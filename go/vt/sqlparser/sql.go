@@ -150,361 +150,366 @@ const VIRTUAL = 57447
 const STORED = 57448
 const LOWER_THAN_CHARSET = 57449
 const CHARSET = 57450
-const UNIQUE = 57451
-const KEY = 57452
-const OR = 57453
-const XOR = 57454
-const AND = 57455
-const NOT = 57456
-const BETWEEN = 57457
-const CASE = 57458
-const WHEN = 57459
-const THEN = 57460
-const ELSE = 57461
-const END = 57462
-const LE = 57463
-const GE = 57464
-const NE = 57465
-const NULL_SAFE_EQUAL = 57466
-const IS = 57467
-const LIKE = 57468
-const REGEXP = 57469
-const IN = 57470
-const SHIFT_LEFT = 57471
-const SHIFT_RIGHT = 57472
-const DIV = 57473
-const MOD = 57474
-const UNARY = 57475
-const COLLATE = 57476
-const BINARY = 57477
-const UNDERSCORE_BINARY = 57478
-const UNDERSCORE_UTF8MB4 = 57479
-const UNDERSCORE_UTF8 = 57480
-const UNDERSCORE_LATIN1 = 57481
-const INTERVAL = 57482
-const JSON_EXTRACT_OP = 57483
-const JSON_UNQUOTE_EXTRACT_OP = 57484
-const CREATE = 57485
-const ALTER = 57486
-const DROP = 57487
-const RENAME = 57488
-const ANALYZE = 57489
-const ADD = 57490
-const FLUSH = 57491
-const CHANGE = 57492
-const MODIFY = 57493
-const REVERT = 57494
-const SCHEMA = 57495
-const TABLE = 57496
-const INDEX = 57497
-const VIEW = 57498
-const TO = 57499
-const IGNORE = 57500
-const IF = 57501
-const PRIMARY = 57502
-const COLUMN = 57503
-const SPATIAL = 57504
-const FULLTEXT = 57505
-const KEY_BLOCK_SIZE = 57506
-const CHECK = 57507
-const INDEXES = 57508
-const ACTION = 57509
-const CASCADE = 57510
-const CONSTRAINT = 57511
-const FOREIGN = 57512
-const NO = 57513
-const REFERENCES = 57514
-const RESTRICT = 57515
-const SHOW = 57516
-const DESCRIBE = 57517
-const EXPLAIN = 57518
-const DATE = 57519
-const ESCAPE = 57520
-const REPAIR = 57521
-const OPTIMIZE = 57522
-const TRUNCATE = 57523
-const COALESCE = 57524
-const EXCHANGE = 57525
-const REBUILD = 57526
-const PARTITIONING = 57527
-const REMOVE = 57528
-const MAXVALUE = 57529
-const PARTITION = 57530
-const REORGANIZE = 57531
-const LESS = 57532
-const THAN = 57533
-const PROCEDURE = 57534
-const TRIGGER = 57535
-const VINDEX = 57536
-const VINDEXES = 57537
-const DIRECTORY = 57538
-const NAME = 57539
-const UPGRADE = 57540
-const STATUS = 57541
-const VARIABLES = 57542
-const WARNINGS = 57543
-const CASCADED = 57544
-const DEFINER = 57545
-const OPTION = 57546
-const SQL = 57547
-const UNDEFINED = 57548
-const SEQUENCE = 57549
-const MERGE = 57550
-const TEMPORARY = 57551
-const TEMPTABLE = 57552
-const INVOKER = 57553
-const SECURITY = 57554
-const FIRST = 57555
-const AFTER = 57556
-const LAST = 57557
-const VITESS_MIGRATION = 57558
-const CANCEL = 57559
-const RETRY = 57560
-const COMPLETE = 57561
-const BEGIN = 57562
-const START = 57563
-const TRANSACTION = 57564
-const COMMIT = 57565
-const ROLLBACK = 57566
-const SAVEPOINT = 57567
-const RELEASE = 57568
-const WORK = 57569
-const BIT = 57570
-const TINYINT = 57571
-const SMALLINT = 57572
-const MEDIUMINT = 57573
-const INT = 57574
-const INTEGER = 57575
-const BIGINT = 57576
-const INTNUM = 57577
-const REAL = 57578
-const DOUBLE = 57579
-const FLOAT_TYPE = 57580
-const DECIMAL = 57581
-const NUMERIC = 57582
-const TIME = 57583
-const TIMESTAMP = 57584
-const DATETIME = 57585
-const YEAR = 57586
-const CHAR = 57587
-const VARCHAR = 57588
-const BOOL = 57589
-const CHARACTER = 57590
-const VARBINARY = 57591
-const NCHAR = 57592
-const TEXT = 57593
-const TINYTEXT = 57594
-const MEDIUMTEXT = 57595
-const LONGTEXT = 57596
-const BLOB = 57597
-const TINYBLOB = 57598
-const MEDIUMBLOB = 57599
-const LONGBLOB = 57600
-const JSON = 57601
-const ENUM = 57602
-const GEOMETRY = 57603
-const POINT = 57604
-const LINESTRING = 57605
-const POLYGON = 57606
-const GEOMETRYCOLLECTION = 57607
-const MULTIPOINT = 57608
-const MULTILINESTRING = 57609
-const MULTIPOLYGON = 57610
-const NULLX = 57611
-const AUTO_INCREMENT = 57612
-const APPROXNUM = 57613
-const SIGNED = 57614
-const UNSIGNED = 57615
-const ZEROFILL = 57616
-const CODE = 57617
-const COLLATION = 57618
-const COLUMNS = 57619
-const DATABASES = 57620
-const ENGINES = 57621
-const EVENT = 57622
-const EXTENDED = 57623
-const FIELDS = 57624
-const FULL = 57625
-const FUNCTION = 57626
-const GTID_EXECUTED = 57627
-const KEYSPACES = 57628
-const OPEN = 57629
-const PLUGINS = 57630
-const PRIVILEGES = 57631
-const PROCESSLIST = 57632
-const SCHEMAS = 57633
-const TABLES = 57634
-const TRIGGERS = 57635
-const USER = 57636
-const VGTID_EXECUTED = 57637
-const VITESS_KEYSPACES = 57638
-const VITESS_METADATA = 57639
-const VITESS_MIGRATIONS = 57640
-const VITESS_SHARDS = 57641
-const VITESS_TABLETS = 57642
-const VSCHEMA = 57643
-const NAMES = 57644
-const GLOBAL = 57645
-const SESSION = 57646
-const ISOLATION = 57647
-const LEVEL = 57648
-const READ = 57649
-const WRITE = 57650
-const ONLY = 57651
-const REPEATABLE = 57652
-const COMMITTED = 57653
-const UNCOMMITTED = 57654
-const SERIALIZABLE = 57655
-const CURRENT_TIMESTAMP = 57656
-const DATABASE = 57657
-const CURRENT_DATE = 57658
-const CURRENT_TIME = 57659
-const LOCALTIME = 57660
-const LOCALTIMESTAMP = 57661
-const CURRENT_USER = 57662
-const UTC_DATE = 57663
-const UTC_TIME = 57664
-const UTC_TIMESTAMP = 57665
-const REPLACE = 57666
-const CONVERT = 57667
-const CAST = 57668
-const SUBSTR = 57669
-const SUBSTRING = 57670
-const GROUP_CONCAT = 57671
-const SEPARATOR = 57672
-const TIMESTAMPADD = 57673
-const TIMESTAMPDIFF = 57674
-const MATCH = 57675
-const AGAINST = 57676
-const BOOLEAN = 57677
-const LANGUAGE = 57678
-const WITH = 57679
-const QUERY = 57680
-const EXPANSION = 57681
-const WITHOUT = 57682
-const VALIDATION = 57683
-const UNUSED = 57684
-const ARRAY = 57685
-const CUME_DIST = 57686
-const DESCRIPTION = 57687
-const DENSE_RANK = 57688
-const EMPTY = 57689
-const EXCEPT = 57690
-const FIRST_VALUE = 57691
-const GROUPING = 57692
-const GROUPS = 57693
-const JSON_TABLE = 57694
-const LAG = 57695
-const LAST_VALUE = 57696
-const LATERAL = 57697
-const LEAD = 57698
-const MEMBER = 57699
-const NTH_VALUE = 57700
-const NTILE = 57701
-const OF = 57702
-const OVER = 57703
-const PERCENT_RANK = 57704
-const RANK = 57705
-const RECURSIVE = 57706
-const ROW_NUMBER = 57707
-const SYSTEM = 57708
-const WINDOW = 57709
-const ACTIVE = 57710
-const ADMIN = 57711
-const BUCKETS = 57712
-const CLONE = 57713
-const COMPONENT = 57714
-const DEFINITION = 57715
-const ENFORCED = 57716
-const EXCLUDE = 57717
-const FOLLOWING = 57718
-const GEOMCOLLECTION = 57719
-const GET_MASTER_PUBLIC_KEY = 57720
-const HISTOGRAM = 57721
-const HISTORY = 57722
-const INACTIVE = 57723
-const INVISIBLE = 57724
-const LOCKED = 57725
-const MASTER_COMPRESSION_ALGORITHMS = 57726
-const MASTER_PUBLIC_KEY_PATH = 57727
-const MASTER_TLS_CIPHERSUITES = 57728
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57729
-const NESTED = 57730
-const NETWORK_NAMESPACE = 57731
-const NOWAIT = 57732
-const NULLS = 57733
-const OJ = 57734
-const OLD = 57735
-const OPTIONAL = 57736
-const ORDINALITY = 57737
-const ORGANIZATION = 57738
-const OTHERS = 57739
-const PATH = 57740
-const PERSIST = 57741
-const PERSIST_ONLY = 57742
-const PRECEDING = 57743
-const PRIVILEGE_CHECKS_USER = 57744
-const PROCESS = 57745
-const RANDOM = 57746
-const REFERENCE = 57747
-const REQUIRE_ROW_FORMAT = 57748
-const RESOURCE = 57749
-const RESPECT = 57750
-const RESTART = 57751
-const RETAIN = 57752
-const REUSE = 57753
-const ROLE = 57754
-const SECONDARY = 57755
-const SECONDARY_ENGINE = 57756
-const SECONDARY_LOAD = 57757
-const SECONDARY_UNLOAD = 57758
-const SKIP = 57759
-const SRID = 57760
-const THREAD_PRIORITY = 57761
-const TIES = 57762
-const UNBOUNDED = 57763
-const VCPU = 57764
-const VISIBLE = 57765
-const FORMAT = 57766
-const TREE = 57767
-const VITESS = 57768
-const TRADITIONAL = 57769
-const LOCAL = 57770
-const LOW_PRIORITY = 57771
-const NO_WRITE_TO_BINLOG = 57772
-const LOGS = 57773
-const ERROR = 57774
-const GENERAL = 57775
-const HOSTS = 57776
-const OPTIMIZER_COSTS = 57777
-const USER_RESOURCES = 57778
-const SLOW = 57779
-const CHANNEL = 57780
-const RELAY = 57781
-const EXPORT = 57782
-const AVG_ROW_LENGTH = 57783
-const CONNECTION = 57784
-const CHECKSUM = 57785
-const DELAY_KEY_WRITE = 57786
-const ENCRYPTION = 57787
-const ENGINE = 57788
-const INSERT_METHOD = 57789
-const MAX_ROWS = 57790
-const MIN_ROWS = 57791
-const PACK_KEYS = 57792
-const PASSWORD = 57793
-const FIXED = 57794
-const DYNAMIC = 57795
-const COMPRESSED = 57796
-const REDUNDANT = 57797
-const COMPACT = 57798
-const ROW_FORMAT = 57799
-const STATS_AUTO_RECALC = 57800
-const STATS_PERSISTENT = 57801
-const STATS_SAMPLE_PAGES = 57802
-const STORAGE = 57803
-const MEMORY = 57804
-const DISK = 57805
+const ROWS = 57451
+const EMPTY_WINDOW_NAME = 57452
+const UNIQUE = 57453
+const KEY = 57454
+const OR = 57455
+const XOR = 57456
+const AND = 57457
+const NOT = 57458
+const BETWEEN = 57459
+const CASE = 57460
+const WHEN = 57461
+const THEN = 57462
+const ELSE = 57463
+const END = 57464
+const LE = 57465
+const GE = 57466
+const NE = 57467
+const NULL_SAFE_EQUAL = 57468
+const IS = 57469
+const LIKE = 57470
+const REGEXP = 57471
+const IN = 57472
+const SHIFT_LEFT = 57473
+const SHIFT_RIGHT = 57474
+const DIV = 57475
+const MOD = 57476
+const UNARY = 57477
+const COLLATE = 57478
+const BINARY = 57479
+const UNDERSCORE_BINARY = 57480
+const UNDERSCORE_UTF8MB4 = 57481
+const UNDERSCORE_UTF8 = 57482
+const UNDERSCORE_LATIN1 = 57483
+const INTERVAL = 57484
+const JSON_EXTRACT_OP = 57485
+const JSON_UNQUOTE_EXTRACT_OP = 57486
+const CREATE = 57487
+const ALTER = 57488
+const DROP = 57489
+const RENAME = 57490
+const ANALYZE = 57491
+const ADD = 57492
+const FLUSH = 57493
+const CHANGE = 57494
+const MODIFY = 57495
+const REVERT = 57496
+const SCHEMA = 57497
+const TABLE = 57498
+const INDEX = 57499
+const VIEW = 57500
+const TO = 57501
+const IGNORE = 57502
+const IF = 57503
+const PRIMARY = 57504
+const COLUMN = 57505
+const SPATIAL = 57506
+const FULLTEXT = 57507
+const KEY_BLOCK_SIZE = 57508
+const CHECK = 57509
+const INDEXES = 57510
+const ACTION = 57511
+const CASCADE = 57512
+const CONSTRAINT = 57513
+const FOREIGN = 57514
+const NO = 57515
+const REFERENCES = 57516
+const RESTRICT = 57517
+const SHOW = 57518
+const DESCRIBE = 57519
+const EXPLAIN = 57520
+const DATE = 57521
+const ESCAPE = 57522
+const REPAIR = 57523
+const OPTIMIZE = 57524
+const TRUNCATE = 57525
+const COALESCE = 57526
+const EXCHANGE = 57527
+const REBUILD = 57528
+const PARTITIONING = 57529
+const REMOVE = 57530
+const MAXVALUE = 57531
+const PARTITION = 57532
+const REORGANIZE = 57533
+const LESS = 57534
+const THAN = 57535
+const PROCEDURE = 57536
+const TRIGGER = 57537
+const VINDEX = 57538
+const VINDEXES = 57539
+const DIRECTORY = 57540
+const NAME = 57541
+const UPGRADE = 57542
+const STATUS = 57543
+const VARIABLES = 57544
+const WARNINGS = 57545
+const CASCADED = 57546
+const DEFINER = 57547
+const OPTION = 57548
+const SQL = 57549
+const UNDEFINED = 57550
+const SEQUENCE = 57551
+const MERGE = 57552
+const TEMPORARY = 57553
+const TEMPTABLE = 57554
+const INVOKER = 57555
+const SECURITY = 57556
+const FIRST = 57557
+const AFTER = 57558
+const LAST = 57559
+const VITESS_MIGRATION = 57560
+const CANCEL = 57561
+const RETRY = 57562
+const COMPLETE = 57563
+const BEGIN = 57564
+const START = 57565
+const TRANSACTION = 57566
+const COMMIT = 57567
+const ROLLBACK = 57568
+const SAVEPOINT = 57569
+const RELEASE = 57570
+const WORK = 57571
+const BIT = 57572
+const TINYINT = 57573
+const SMALLINT = 57574
+const MEDIUMINT = 57575
+const INT = 57576
+const INTEGER = 57577
+const BIGINT = 57578
+const INTNUM = 57579
+const REAL = 57580
+const DOUBLE = 57581
+const FLOAT_TYPE = 57582
+const DECIMAL = 57583
+const NUMERIC = 57584
+const TIME = 57585
+const TIMESTAMP = 57586
+const DATETIME = 57587
+const YEAR = 57588
+const CHAR = 57589
+const VARCHAR = 57590
+const BOOL = 57591
+const CHARACTER = 57592
+const VARBINARY = 57593
+const NCHAR = 57594
+const TEXT = 57595
+const TINYTEXT = 57596
+const MEDIUMTEXT = 57597
+const LONGTEXT = 57598
+const BLOB = 57599
+const TINYBLOB = 57600
+const MEDIUMBLOB = 57601
+const LONGBLOB = 57602
+const JSON = 57603
+const ENUM = 57604
+const GEOMETRY = 57605
+const POINT = 57606
+const LINESTRING = 57607
+const POLYGON = 57608
+const GEOMETRYCOLLECTION = 57609
+const MULTIPOINT = 57610
+const MULTILINESTRING = 57611
+const MULTIPOLYGON = 57612
+const NULLX = 57613
+const AUTO_INCREMENT = 57614
+const APPROXNUM = 57615
+const SIGNED = 57616
+const UNSIGNED = 57617
+const ZEROFILL = 57618
+const CODE = 57619
+const COLLATION = 57620
+const COLUMNS = 57621
+const DATABASES = 57622
+const ENGINES = 57623
+const EVENT = 57624
+const EXTENDED = 57625
+const FIELDS = 57626
+const FULL = 57627
+const FUNCTION = 57628
+const GTID_EXECUTED = 57629
+const KEYSPACES = 57630
+const OPEN = 57631
+const PLUGINS = 57632
+const PRIVILEGES = 57633
+const PROCESSLIST = 57634
+const SCHEMAS = 57635
+const TABLES = 57636
+const TRIGGERS = 57637
+const USER = 57638
+const VGTID_EXECUTED = 57639
+const VITESS_KEYSPACES = 57640
+const VITESS_METADATA = 57641
+const VITESS_MIGRATIONS = 57642
+const VITESS_SHARDS = 57643
+const VITESS_TABLETS = 57644
+const VSCHEMA = 57645
+const NAMES = 57646
+const GLOBAL = 57647
+const SESSION = 57648
+const ISOLATION = 57649
+const LEVEL = 57650
+const READ = 57651
+const WRITE = 57652
+const ONLY = 57653
+const REPEATABLE = 57654
+const COMMITTED = 57655
+const UNCOMMITTED = 57656
+const SERIALIZABLE = 57657
+const CURRENT_TIMESTAMP = 57658
+const DATABASE = 57659
+const CURRENT_DATE = 57660
+const CURRENT_TIME = 57661
+const LOCALTIME = 57662
+const LOCALTIMESTAMP = 57663
+const CURRENT_USER = 57664
+const UTC_DATE = 57665
+const UTC_TIME = 57666
+const UTC_TIMESTAMP = 57667
+const REPLACE = 57668
+const CONVERT = 57669
+const CAST = 57670
+const SUBSTR = 57671
+const SUBSTRING = 57672
+const GROUP_CONCAT = 57673
+const SEPARATOR = 57674
+const TIMESTAMPADD = 57675
+const TIMESTAMPDIFF = 57676
+const MATCH = 57677
+const AGAINST = 57678
+const BOOLEAN = 57679
+const LANGUAGE = 57680
+const WITH = 57681
+const QUERY = 57682
+const EXPANSION = 57683
+const WITHOUT = 57684
+const VALIDATION = 57685
+const UNUSED = 57686
+const ARRAY = 57687
+const CUME_DIST = 57688
+const DESCRIPTION = 57689
+const DENSE_RANK = 57690
+const EMPTY = 57691
+const EXCEPT = 57692
+const FIRST_VALUE = 57693
+const GROUPING = 57694
+const GROUPS = 57695
+const JSON_TABLE = 57696
+const LAG = 57697
+const LAST_VALUE = 57698
+const LATERAL = 57699
+const LEAD = 57700
+const MEMBER = 57701
+const NTH_VALUE = 57702
+const NTILE = 57703
+const OF = 57704
+const OVER = 57705
+const PERCENT_RANK = 57706
+const RANK = 57707
+const RECURSIVE = 57708
+const ROW_NUMBER = 57709
+const SYSTEM = 57710
+const WINDOW = 57711
+const CURRENT = 57712
+const RANGE = 57713
+const ROW = 57714
+const ACTIVE = 57715
+const ADMIN = 57716
+const BUCKETS = 57717
+const CLONE = 57718
+const COMPONENT = 57719
+const DEFINITION = 57720
+const ENFORCED = 57721
+const EXCLUDE = 57722
+const FOLLOWING = 57723
+const GEOMCOLLECTION = 57724
+const GET_MASTER_PUBLIC_KEY = 57725
+const HISTOGRAM = 57726
+const HISTORY = 57727
+const INACTIVE = 57728
+const INVISIBLE = 57729
+const LOCKED = 57730
+const MASTER_COMPRESSION_ALGORITHMS = 57731
+const MASTER_PUBLIC_KEY_PATH = 57732
+const MASTER_TLS_CIPHERSUITES = 57733
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57734
+const NESTED = 57735
+const NETWORK_NAMESPACE = 57736
+const NOWAIT = 57737
+const NULLS = 57738
+const OJ = 57739
+const OLD = 57740
+const OPTIONAL = 57741
+const ORDINALITY = 57742
+const ORGANIZATION = 57743
+const OTHERS = 57744
+const PATH = 57745
+const PERSIST = 57746
+const PERSIST_ONLY = 57747
+const PRECEDING = 57748
+const PRIVILEGE_CHECKS_USER = 57749
+const PROCESS = 57750
+const RANDOM = 57751
+const REFERENCE = 57752
+const REQUIRE_ROW_FORMAT = 57753
+const RESOURCE = 57754
+const RESPECT = 57755
+const RESTART = 57756
+const RETAIN = 57757
+const REUSE = 57758
+const ROLE = 57759
+const SECONDARY = 57760
+const SECONDARY_ENGINE = 57761
+const SECONDARY_LOAD = 57762
+const SECONDARY_UNLOAD = 57763
+const SKIP = 57764
+const SRID = 57765
+const THREAD_PRIORITY = 57766
+const TIES = 57767
+const UNBOUNDED = 57768
+const VCPU = 57769
+const VISIBLE = 57770
+const FORMAT = 57771
+const TREE = 57772
+const VITESS = 57773
+const TRADITIONAL = 57774
+const LOCAL = 57775
+const LOW_PRIORITY = 57776
+const NO_WRITE_TO_BINLOG = 57777
+const LOGS = 57778
+const ERROR = 57779
+const GENERAL = 57780
+const HOSTS = 57781
+const OPTIMIZER_COSTS = 57782
+const USER_RESOURCES = 57783
+const SLOW = 57784
+const CHANNEL = 57785
+const RELAY = 57786
+const EXPORT = 57787
+const AVG_ROW_LENGTH = 57788
+const CONNECTION = 57789
+const CHECKSUM = 57790
+const DELAY_KEY_WRITE = 57791
+const ENCRYPTION = 57792
+const ENGINE = 57793
+const INSERT_METHOD = 57794
+const MAX_ROWS = 57795
+const MIN_ROWS = 57796
+const PACK_KEYS = 57797
+const PASSWORD = 57798
+const FIXED = 57799
+const DYNAMIC = 57800
+const COMPRESSED = 57801
+const REDUNDANT = 57802
+const COMPACT = 57803
+const ROW_FORMAT = 57804
+const STATS_AUTO_RECALC = 57805
+const STATS_PERSISTENT = 57806
+const STATS_SAMPLE_PAGES = 57807
+const STORAGE = 57808
+const MEMORY = 57809
+const DISK = 57810
 
 var yyToknames = [...]string{
 	"$end",
@@ -618,6 +623,8 @@ var yyToknames = [...]string{
 	"STORED",
 	"LOWER_THAN_CHARSET",
 	"CHARSET",
+	"ROWS",
+	"EMPTY_WINDOW_NAME",
 	"UNIQUE",
 	"KEY",
 	"OR",
@@ -891,6 +898,9 @@ var yyToknames = [...]string{
 	"ROW_NUMBER",
 	"SYSTEM",
 	"WINDOW",
+	"CURRENT",
+	"RANGE",
+	"ROW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -1001,3105 +1011,3260 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 44,
+	1, 119,
+	486, 119,
+	-2, 125,
 	-1, 45,
-	1, 112,
-	481, 112,
-	-2, 118,
-	-1, 46,
-	111, 118,
-	150, 118,
-	265, 118,
-	-2, 341,
-	-1, 53,
-	33, 489,
-	172, 489,
-	183, 489,
-	216, 503,
-	217, 503,
-	-2, 491,
-	-1, 58,
-	174, 513,
-	-2, 511,
-	-1, 84,
-	57, 581,
-	-2, 589,
+	111, 125,
+	152, 125,
+	267, 125,
+	-2, 348,
+	-1, 52,
+	33, 496,
+	174, 496,
+	185, 496,
+	218, 510,
+	219, 510,
+	-2, 498,
+	-1, 57,
+	176, 520,
+	-2, 518,
 	-1, 97,
-	171, 955,
-	-2, 91,
+	173, 1009,
+	-2, 98,
 	-1, 99,
-	1, 113,
-	481, 113,
-	-2, 118,
+	1, 120,
+	486, 120,
+	-2, 125,
 	-1, 109,
-	112, 244,
-	177, 244,
-	-2, 335,
+	114, 251,
+	179, 251,
+	-2, 342,
 	-1, 128,
-	111, 118,
-	150, 118,
-	265, 118,
-	-2, 350,
-	-1, 569,
-	157, 976,
-	-2, 972,
-	-1, 570,
-	157, 977,
-	-2, 973,
-	-1, 589,
-	57, 582,
-	-2, 594,
-	-1, 590,
-	57, 583,
-	-2, 595,
-	-1, 611,
-	125, 1327,
-	-2, 84,
-	-1, 612,
-	125, 1208,
-	-2, 85,
-	-1, 618,
-	125, 1259,
-	-2, 949,
-	-1, 758,
-	125, 1142,
-	-2, 946,
-	-1, 794,
-	182, 38,
-	187, 38,
-	-2, 255,
-	-1, 871,
-	1, 388,
-	481, 388,
-	-2, 118,
-	-1, 1112,
-	1, 285,
-	481, 285,
-	-2, 118,
-	-1, 1115,
-	23, 137,
-	-2, 139,
-	-1, 1188,
-	112, 244,
-	177, 244,
-	-2, 335,
-	-1, 1197,
-	182, 39,
-	187, 39,
-	-2, 256,
-	-1, 1406,
-	157, 981,
-	-2, 975,
-	-1, 1497,
-	75, 66,
-	83, 66,
-	-2, 70,
-	-1, 1518,
-	1, 286,
-	481, 286,
-	-2, 118,
-	-1, 1951,
-	5, 842,
-	18, 842,
-	20, 842,
-	31, 842,
-	84, 842,
-	-2, 621,
-	-1, 2185,
-	47, 917,
-	-2, 911,
+	111, 125,
+	152, 125,
+	267, 125,
+	-2, 357,
+	-1, 584,
+	159, 1030,
+	-2, 1026,
+	-1, 585,
+	159, 1031,
+	-2, 1027,
+	-1, 594,
+	57, 588,
+	-2, 596,
+	-1, 619,
+	127, 1385,
+	-2, 91,
+	-1, 620,
+	127, 1264,
+	-2, 92,
+	-1, 626,
+	127, 1315,
+	-2, 1003,
+	-1, 767,
+	127, 1197,
+	-2, 1000,
+	-1, 803,
+	184, 38,
+	189, 38,
+	-2, 262,
+	-1, 880,
+	1, 395,
+	486, 395,
+	-2, 125,
+	-1, 1085,
+	57, 589,
+	-2, 601,
+	-1, 1086,
+	57, 590,
+	-2, 602,
+	-1, 1138,
+	1, 292,
+	486, 292,
+	-2, 125,
+	-1, 1141,
+	23, 144,
+	-2, 146,
+	-1, 1214,
+	114, 251,
+	179, 251,
+	-2, 342,
+	-1, 1223,
+	184, 39,
+	189, 39,
+	-2, 263,
+	-1, 1433,
+	159, 1035,
+	-2, 1029,
+	-1, 1534,
+	75, 73,
+	83, 73,
+	-2, 77,
+	-1, 1555,
+	1, 293,
+	486, 293,
+	-2, 125,
+	-1, 2028,
+	5, 896,
+	18, 896,
+	20, 896,
+	31, 896,
+	84, 896,
+	-2, 628,
+	-1, 2312,
+	47, 971,
+	-2, 965,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 29660
+const yyLast = 31200
 
 var yyAct = [...]int{
-	569, 2281, 2105, 2010, 2238, 2215, 2225, 2251, 931, 1775,
-	2162, 83, 3, 1737, 2186, 2132, 1782, 1931, 1586, 1704,
-	2102, 1783, 1013, 1443, 1932, 527, 1060, 1827, 1738, 1928,
-	512, 1551, 1807, 1067, 1871, 541, 1831, 1556, 510, 1571,
-	137, 824, 1724, 2124, 1392, 1808, 1890, 1169, 1809, 165,
-	1400, 1943, 165, 1664, 475, 165, 1584, 1094, 882, 1304,
-	491, 1494, 165, 582, 1195, 761, 1515, 81, 123, 1558,
-	165, 1617, 616, 1570, 1801, 1104, 1097, 1070, 789, 1476,
-	591, 1065, 1087, 1445, 1052, 1426, 576, 1483, 503, 911,
-	542, 34, 491, 1090, 1369, 491, 165, 491, 514, 802,
-	613, 1202, 1568, 1287, 769, 790, 1301, 949, 768, 765,
-	1547, 1459, 795, 1403, 791, 1103, 1499, 1537, 1077, 792,
-	1309, 1101, 140, 79, 106, 34, 1088, 867, 1026, 100,
-	1164, 101, 8, 7, 33, 929, 6, 78, 107, 1615,
-	1187, 498, 1851, 1850, 1029, 1878, 167, 168, 169, 1536,
-	1879, 1273, 2134, 1358, 950, 167, 168, 169, 1440, 1441,
-	1213, 1357, 1356, 1355, 598, 602, 1354, 826, 102, 829,
-	578, 450, 478, 762, 1353, 501, 777, 502, 1346, 2270,
-	840, 841, 1702, 844, 845, 846, 847, 108, 950, 850,
-	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
-	861, 862, 863, 864, 2182, 499, 1979, 2081, 2158, 617,
-	806, 2157, 467, 610, 577, 772, 828, 827, 2297, 960,
-	784, 466, 102, 2100, 84, 1654, 2101, 2248, 2296, 783,
-	805, 782, 464, 80, 2208, 2289, 837, 554, 2106, 560,
-	561, 558, 559, 1603, 557, 556, 555, 1563, 2247, 830,
-	831, 832, 1907, 960, 562, 563, 2207, 2043, 842, 1178,
-	1703, 86, 87, 88, 89, 90, 91, 1858, 1561, 97,
-	461, 1857, 162, 1957, 781, 445, 876, 877, 1768, 473,
-	1442, 1767, 1510, 1511, 1769, 1105, 102, 1106, 1500, 1958,
-	1959, 870, 1877, 1652, 1509, 575, 35, 161, 889, 72,
-	39, 40, 918, 890, 920, 901, 906, 907, 573, 956,
-	572, 888, 948, 887, 889, 902, 1791, 895, 478, 890,
-	2012, 103, 927, 1530, 1529, 2212, 479, 776, 2034, 778,
-	779, 2032, 489, 1345, 145, 493, 487, 1293, 1056, 866,
-	917, 919, 1832, 956, 1347, 1348, 1349, 167, 168, 169,
-	478, 1585, 2006, 1560, 451, 1429, 453, 468, 1854, 481,
-	2007, 480, 457, 1618, 455, 459, 469, 460, 2295, 454,
-	1288, 465, 71, 478, 456, 470, 471, 485, 484, 472,
-	1623, 463, 482, 781, 865, 781, 478, 773, 142, 843,
-	143, 903, 908, 896, 775, 774, 2013, 904, 905, 160,
-	924, 872, 909, 910, 1866, 2271, 2171, 975, 974, 984,
-	985, 977, 978, 979, 980, 981, 982, 983, 976, 926,
-	2154, 986, 1628, 1626, 1627, 785, 2095, 1630, 915, 1631,
-	1633, 1632, 916, 1624, 849, 848, 780, 1263, 2014, 585,
-	1620, 779, 921, 1622, 813, 165, 869, 165, 811, 1891,
-	165, 955, 952, 953, 954, 959, 961, 958, 804, 957,
-	1587, 1477, 822, 914, 146, 821, 951, 1787, 922, 820,
-	819, 818, 479, 151, 817, 816, 491, 491, 491, 1264,
-	1978, 1265, 1294, 1621, 815, 955, 952, 953, 954, 959,
-	961, 958, 1893, 957, 491, 491, 810, 786, 1181, 823,
-	951, 766, 2292, 766, 479, 764, 483, 942, 798, 1500,
-	885, 804, 891, 892, 893, 894, 2285, 803, 923, 1856,
-	2287, 766, 807, 797, 476, 899, 1562, 479, 797, 1302,
-	839, 868, 808, 928, 1569, 604, 1867, 2206, 1609, 477,
-	479, 1298, 814, 936, 2213, 780, 812, 780, 833, 1201,
-	809, 1986, 1853, 1916, 1895, 1915, 1899, 1914, 1894, 1653,
-	1892, 925, 804, 1176, 1175, 1897, 930, 930, 930, 1174,
-	803, 1870, 1843, 165, 1896, 1299, 797, 800, 801, 138,
-	766, 1172, 2239, 449, 794, 798, 34, 1898, 1900, 444,
-	1058, 1275, 1274, 1276, 1277, 1278, 1705, 1707, 99, 995,
-	997, 491, 1683, 793, 165, 886, 165, 165, 996, 491,
-	1057, 1873, 933, 934, 1200, 491, 1872, 613, 998, 999,
-	804, 803, 878, 1865, 1680, 2172, 1864, 2193, 945, 943,
-	1010, 73, 944, 804, 1015, 1016, 1017, 1018, 1019, 1020,
-	1021, 1022, 2063, 1025, 1027, 1030, 1030, 1030, 1027, 1030,
-	1030, 1027, 1030, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
-	1605, 875, 1956, 1729, 898, 1055, 1053, 1086, 804, 34,
-	1672, 2283, 1071, 1873, 2284, 900, 2282, 1014, 1872, 803,
-	1595, 838, 1505, 1028, 1031, 1033, 1035, 1036, 1038, 1040,
-	1041, 1292, 803, 1081, 1011, 880, 1092, 807, 797, 1706,
-	1032, 1034, 1069, 1037, 1039, 976, 1042, 808, 986, 1516,
-	986, 1764, 912, 1050, 975, 974, 984, 985, 977, 978,
-	979, 980, 981, 982, 983, 976, 617, 803, 986, 1455,
-	963, 1341, 94, 797, 800, 801, 1310, 766, 966, 1909,
-	884, 794, 798, 167, 168, 169, 966, 1394, 998, 999,
+	584, 2348, 2123, 2191, 2404, 2319, 2361, 2086, 2393, 2337,
+	2324, 2313, 2276, 1793, 2008, 1023, 1757, 1839, 1975, 1552,
+	2251, 1742, 593, 3, 1504, 1831, 1838, 1496, 2005, 1625,
+	545, 530, 2009, 1573, 1095, 1863, 940, 1489, 1794, 1482,
+	1926, 515, 1588, 1886, 833, 1593, 1785, 891, 165, 1610,
+	513, 165, 510, 478, 165, 2215, 1864, 1087, 1494, 494,
+	1865, 165, 1418, 1239, 1426, 770, 2020, 1703, 1609, 165,
+	1948, 1120, 1623, 1531, 165, 1430, 123, 1078, 137, 920,
+	506, 798, 1656, 1595, 1130, 1857, 1330, 1123, 1513, 1520,
+	1602, 1113, 494, 624, 517, 494, 165, 494, 591, 1073,
+	1221, 1116, 82, 1484, 1395, 1454, 1114, 958, 1313, 811,
+	599, 1129, 1327, 778, 799, 777, 1607, 801, 774, 800,
+	1536, 804, 1127, 1228, 1102, 70, 1584, 1021, 621, 1574,
+	597, 34, 1190, 140, 100, 101, 876, 595, 106, 1036,
+	107, 1335, 8, 7, 1213, 6, 501, 1039, 938, 81,
+	1195, 1906, 1905, 1654, 1299, 1933, 1934, 2278, 167, 168,
+	169, 605, 959, 610, 1479, 1480, 1384, 1383, 1382, 1381,
+	835, 1380, 1379, 504, 1372, 505, 771, 2380, 102, 1755,
+	2309, 781, 453, 849, 850, 959, 853, 854, 855, 856,
+	108, 786, 859, 860, 861, 862, 863, 864, 865, 866,
+	867, 868, 869, 870, 871, 872, 873, 838, 600, 502,
+	2128, 2389, 2127, 2169, 618, 85, 1977, 2387, 2056, 1429,
+	815, 625, 2385, 2302, 1743, 2280, 2248, 969, 814, 2247,
+	837, 836, 793, 102, 792, 791, 2388, 2353, 2354, 2432,
+	2355, 2187, 2386, 1600, 2188, 72, 846, 839, 840, 841,
+	969, 2347, 87, 88, 89, 90, 91, 2427, 2299, 97,
+	72, 72, 162, 2412, 1598, 448, 2192, 2338, 2349, 1788,
+	851, 602, 1642, 2353, 2354, 558, 2355, 564, 565, 562,
+	563, 2346, 561, 560, 559, 590, 1693, 1965, 594, 1481,
+	2119, 1204, 566, 567, 1789, 72, 1756, 102, 74, 38,
+	39, 2298, 2035, 2036, 1824, 2356, 2034, 1823, 1913, 2229,
+	1825, 790, 1912, 885, 886, 1547, 1548, 965, 1932, 1691,
+	957, 79, 1131, 936, 1132, 1546, 167, 168, 169, 910,
+	915, 916, 161, 898, 588, 587, 79, 79, 899, 911,
+	965, 2356, 898, 1373, 1374, 1375, 897, 899, 896, 1537,
+	1847, 1597, 481, 481, 875, 904, 103, 1567, 1566, 2088,
+	481, 785, 2358, 787, 492, 2110, 2108, 788, 1371, 145,
+	496, 79, 2260, 984, 983, 993, 994, 986, 987, 988,
+	989, 990, 991, 992, 985, 490, 481, 995, 1667, 1665,
+	1666, 607, 1077, 1319, 470, 2268, 1289, 2269, 1887, 1624,
+	2082, 852, 1909, 469, 1662, 927, 2381, 929, 2083, 1657,
+	1828, 167, 168, 169, 467, 912, 917, 2426, 1314, 790,
+	935, 782, 933, 142, 881, 143, 918, 794, 784, 783,
+	919, 905, 913, 914, 160, 2089, 1672, 481, 1290, 858,
+	1291, 1921, 857, 926, 928, 1669, 2090, 1670, 165, 1671,
+	165, 1659, 464, 165, 1661, 2244, 507, 1663, 813, 2182,
+	1626, 476, 964, 961, 962, 963, 968, 970, 967, 1976,
+	966, 931, 795, 789, 879, 788, 2212, 960, 1514, 494,
+	494, 494, 822, 831, 830, 964, 961, 962, 963, 968,
+	970, 967, 820, 966, 1660, 2055, 1207, 494, 494, 2303,
+	960, 146, 894, 829, 900, 901, 902, 903, 482, 482,
+	151, 1843, 828, 827, 826, 825, 482, 812, 824, 819,
+	832, 951, 816, 806, 1599, 937, 932, 908, 2408, 1537,
+	2423, 924, 817, 775, 2350, 925, 454, 773, 456, 471,
+	1320, 484, 482, 483, 460, 930, 458, 462, 472, 463,
+	818, 457, 1925, 468, 934, 1949, 459, 473, 474, 488,
+	487, 475, 1911, 466, 485, 2410, 923, 775, 790, 874,
+	2350, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017,
+	1018, 789, 823, 2359, 2297, 1227, 806, 1328, 165, 1457,
+	2351, 2261, 821, 482, 848, 73, 1608, 165, 1951, 1301,
+	1300, 1302, 1303, 1304, 775, 612, 1922, 1005, 494, 807,
+	73, 73, 165, 895, 165, 165, 138, 494, 1648, 1324,
+	945, 73, 1692, 494, 1098, 842, 2351, 1097, 942, 943,
+	884, 878, 75, 1928, 1758, 1760, 2330, 2063, 1927, 2328,
+	887, 954, 952, 1908, 953, 73, 1984, 813, 2332, 2333,
+	1226, 1834, 1983, 621, 1841, 1842, 1928, 1982, 813, 2329,
+	1953, 1927, 1957, 1202, 1952, 1201, 1950, 1200, 907, 1898,
+	1325, 1955, 1024, 1198, 452, 447, 1644, 99, 1022, 909,
+	1954, 1920, 2285, 813, 1919, 2406, 813, 1112, 2407, 1074,
+	2405, 486, 2151, 1956, 1958, 2033, 1835, 1784, 1038, 1041,
+	1043, 1045, 1046, 1048, 1050, 1051, 812, 1042, 1044, 479,
+	1047, 1049, 813, 1052, 1712, 1724, 877, 812, 1837, 1840,
+	1721, 1832, 816, 806, 480, 1007, 1008, 1553, 1634, 1542,
+	789, 1843, 817, 1071, 1094, 1106, 1841, 1842, 1020, 1759,
+	889, 1833, 812, 995, 1820, 812, 625, 847, 806, 809,
+	810, 985, 775, 1767, 995, 1318, 803, 807, 984, 983,
+	993, 994, 986, 987, 988, 989, 990, 991, 992, 985,
+	165, 812, 995, 1402, 1191, 802, 1367, 806, 809, 810,
+	921, 775, 893, 1199, 1336, 803, 807, 1400, 1401, 1399,
 	139, 144, 141, 147, 148, 149, 150, 152, 153, 154,
-	155, 165, 871, 965, 963, 1165, 156, 157, 158, 159,
-	998, 999, 2202, 1665, 1173, 1376, 825, 1289, 1941, 1290,
-	966, 1427, 1291, 95, 1619, 1778, 1295, 1107, 586, 1374,
-	1375, 1373, 1604, 491, 946, 1197, 1820, 979, 980, 981,
-	982, 983, 976, 1206, 1059, 986, 1427, 1210, 1690, 1395,
-	491, 491, 2137, 491, 1966, 491, 491, 1602, 491, 491,
-	491, 491, 491, 491, 1965, 1591, 1179, 1180, 1212, 1211,
-	1779, 1199, 1597, 491, 2264, 913, 1207, 165, 1246, 1600,
-	975, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 1781, 165, 986, 1776, 1601, 1186, 1193, 1311,
-	883, 1241, 1242, 1074, 491, 813, 165, 1205, 1785, 1786,
-	1678, 811, 2080, 1777, 2290, 1961, 2293, 1300, 1677, 2276,
-	2079, 165, 1597, 1984, 1249, 1250, 1805, 964, 965, 963,
-	1255, 1256, 167, 168, 169, 1911, 1796, 165, 1243, 1171,
-	1203, 1203, 2291, 1204, 165, 966, 1599, 2277, 1282, 964,
-	965, 963, 1184, 165, 165, 165, 165, 165, 165, 165,
-	165, 165, 491, 491, 491, 1196, 1182, 966, 1183, 1657,
-	1658, 1659, 507, 1784, 1460, 1461, 1259, 977, 978, 979,
-	980, 981, 982, 983, 976, 1787, 2294, 986, 1102, 165,
-	71, 1244, 1804, 1306, 1314, 1566, 603, 1457, 1797, 1283,
-	600, 1318, 1372, 1320, 1321, 1322, 1323, 1268, 1267, 1281,
-	1327, 1215, 1679, 1216, 1918, 1218, 1220, 1312, 1313, 1224,
-	1226, 1228, 1230, 1232, 1342, 1303, 1266, 1393, 167, 168,
-	169, 1317, 1771, 2280, 102, 1257, 1396, 1251, 1324, 1325,
-	1326, 783, 1177, 782, 1248, 1370, 1352, 167, 168, 169,
-	491, 1579, 930, 930, 930, 167, 168, 169, 1316, 1577,
-	1404, 1456, 1919, 964, 965, 963, 504, 984, 985, 977,
-	978, 979, 980, 981, 982, 983, 976, 1397, 1398, 986,
-	608, 966, 1247, 1780, 491, 491, 605, 606, 1410, 1280,
-	1415, 1418, 964, 965, 963, 165, 1428, 1337, 1338, 1339,
-	1222, 1270, 2279, 964, 965, 963, 2278, 2265, 1371, 491,
-	966, 1405, 2259, 2257, 2121, 1806, 165, 2077, 1448, 491,
-	2009, 966, 2051, 165, 1406, 165, 167, 168, 169, 1964,
-	1404, 1920, 1814, 165, 165, 1802, 1648, 1434, 1435, 80,
-	491, 1613, 1612, 491, 1495, 964, 965, 963, 613, 586,
-	1279, 613, 1449, 1307, 491, 1271, 1258, 1254, 1253, 1252,
-	2152, 1450, 1269, 966, 964, 965, 963, 1993, 2245, 1411,
-	1412, 1462, 1014, 1417, 1420, 1421, 1407, 1364, 1366, 1367,
-	2151, 1474, 966, 2104, 2231, 1993, 2200, 2229, 1993, 2195,
-	1834, 1498, 1470, 1519, 1406, 1365, 2233, 2234, 1817, 1433,
-	1785, 1786, 1436, 1437, 1524, 2230, 1993, 2194, 1531, 491,
-	1532, 1533, 1534, 1535, 1725, 1572, 1573, 1574, 586, 1520,
-	1576, 1578, 1940, 1496, 2176, 586, 1543, 1544, 1545, 1546,
-	2098, 586, 1523, 491, 35, 1553, 1758, 1472, 1725, 491,
-	1206, 1993, 2096, 1206, 1500, 1206, 2058, 1559, 1597, 586,
-	2061, 586, 1503, 1596, 1976, 1975, 1507, 617, 1929, 1522,
-	617, 1506, 1972, 1973, 2201, 1784, 1811, 1940, 1521, 82,
-	2040, 530, 529, 532, 533, 534, 535, 1787, 1972, 1971,
-	531, 1598, 536, 491, 1480, 1393, 1468, 586, 1500, 1852,
-	1393, 1393, 1168, 1836, 1829, 1830, 1480, 586, 1583, 1501,
-	1501, 962, 586, 35, 1590, 35, 570, 1593, 1940, 1594,
-	71, 1549, 1550, 1564, 1554, 1567, 1479, 1538, 1539, 1540,
-	1575, 1565, 1168, 1167, 1113, 1112, 165, 1732, 1469, 1608,
-	962, 806, 1993, 165, 1610, 1611, 1468, 1597, 165, 165,
-	1607, 1203, 165, 1592, 165, 1974, 1554, 1589, 1606, 1588,
-	165, 805, 1733, 1480, 2139, 166, 1508, 165, 166, 1695,
-	1694, 166, 1502, 1502, 1468, 579, 492, 2082, 166, 1480,
-	1504, 1500, 1237, 71, 2148, 1597, 166, 1580, 1458, 71,
-	2164, 71, 2103, 1616, 165, 491, 975, 974, 984, 985,
-	977, 978, 979, 980, 981, 982, 983, 976, 492, 1468,
-	986, 492, 166, 492, 1438, 1350, 1297, 1099, 1408, 1409,
-	788, 1643, 1644, 787, 2074, 2069, 1646, 2083, 2084, 2085,
-	870, 1170, 1238, 1239, 1240, 1647, 975, 974, 984, 985,
-	977, 978, 979, 980, 981, 982, 983, 976, 1552, 2008,
-	986, 71, 1968, 1837, 1548, 1542, 1636, 1541, 970, 1370,
-	973, 1285, 1198, 1194, 1451, 1166, 987, 988, 989, 990,
-	991, 992, 993, 96, 971, 972, 969, 975, 974, 984,
-	985, 977, 978, 979, 980, 981, 982, 983, 976, 165,
-	1810, 986, 2011, 2086, 1944, 1945, 2165, 165, 1000, 1001,
-	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1651, 1563,
-	2261, 2226, 1991, 1990, 1989, 1947, 1234, 967, 1660, 1929,
-	165, 1821, 1371, 1637, 1674, 1343, 1751, 1711, 1489, 1490,
-	1950, 165, 165, 165, 165, 165, 1949, 1811, 1734, 1718,
-	2087, 2088, 1746, 165, 1673, 1671, 1745, 165, 578, 2273,
-	165, 165, 1749, 504, 165, 165, 165, 1750, 1756, 2246,
-	1739, 1689, 1024, 1235, 1236, 1669, 1670, 1770, 1730, 1747,
-	1921, 1714, 1068, 1701, 1748, 2187, 2189, 2062, 1996, 1723,
-	1709, 1727, 1053, 1722, 2190, 1708, 1687, 2275, 1795, 2217,
-	1717, 2250, 577, 2252, 1063, 1066, 1726, 2216, 1712, 2220,
-	2184, 1728, 1296, 571, 1789, 1527, 1713, 1815, 835, 1773,
-	1092, 1759, 834, 1740, 491, 1761, 1743, 1735, 1736, 165,
-	2021, 1092, 1092, 1092, 1092, 1092, 165, 1757, 1306, 1752,
-	1061, 1762, 491, 1810, 1765, 1876, 935, 1496, 491, 2056,
-	1092, 1062, 1206, 1206, 1092, 1845, 1844, 1559, 491, 1774,
-	1423, 103, 1813, 1794, 1840, 1798, 1799, 1800, 1741, 1742,
-	1849, 1744, 1460, 1461, 1424, 1453, 1833, 1803, 596, 592,
-	1987, 165, 165, 165, 165, 165, 1640, 1812, 1485, 1488,
-	1489, 1490, 1486, 593, 1487, 1491, 2197, 165, 165, 1818,
-	2159, 1788, 1493, 1629, 1847, 1656, 1822, 1823, 1824, 1186,
-	2258, 596, 592, 580, 581, 1405, 1838, 1839, 1072, 1073,
-	595, 1721, 594, 1846, 583, 2256, 593, 2255, 1406, 1720,
-	2221, 2219, 2055, 491, 1992, 1848, 1581, 584, 82, 1393,
-	2054, 1887, 1792, 1793, 1924, 1725, 2263, 2262, 579, 1842,
-	1684, 589, 590, 595, 1868, 594, 1681, 1082, 1075, 2263,
-	2191, 1889, 1963, 1454, 80, 85, 77, 1888, 1, 491,
-	2228, 166, 462, 166, 1439, 1880, 166, 1051, 474, 2224,
-	165, 1908, 1886, 1901, 1272, 1262, 1902, 2107, 2161, 1999,
-	491, 1557, 1874, 796, 128, 1875, 491, 491, 1887, 1517,
-	1518, 2241, 492, 492, 492, 93, 1930, 1485, 1488, 1489,
-	1490, 1486, 1667, 1487, 1491, 759, 1668, 1944, 1945, 165,
-	492, 492, 92, 1739, 799, 897, 1582, 1675, 1676, 1933,
-	2099, 1790, 1528, 1682, 1119, 1117, 1685, 1686, 1118, 1116,
-	1939, 1121, 1120, 1115, 1692, 1344, 1693, 488, 165, 1696,
-	1697, 1698, 1699, 1700, 1927, 1948, 1492, 163, 1108, 1076,
-	1952, 836, 1954, 1710, 1955, 452, 1977, 1340, 1614, 458,
-	994, 1719, 1766, 1960, 614, 607, 1985, 1935, 2214, 2183,
-	1953, 2185, 165, 2133, 1934, 2188, 34, 2181, 2274, 2249,
-	491, 2196, 1525, 1452, 1064, 2053, 1923, 1688, 491, 166,
-	1023, 1425, 1091, 513, 165, 1308, 1447, 1363, 528, 1092,
-	1754, 1755, 1982, 1983, 165, 1981, 525, 1980, 526, 1969,
-	1970, 2000, 1463, 1995, 1731, 1998, 968, 492, 165, 1917,
-	166, 165, 166, 166, 1997, 492, 1559, 511, 505, 1083,
-	2022, 492, 1484, 2003, 2002, 1482, 1481, 1638, 1095, 1946,
-	1942, 1089, 1467, 1526, 1855, 1994, 2005, 1938, 947, 588,
-	500, 1368, 2017, 2016, 1377, 1378, 1379, 1380, 1381, 1382,
-	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 771,
-	1422, 2170, 1655, 1359, 1360, 1361, 1362, 2030, 2042, 587,
-	61, 38, 495, 2019, 2020, 2269, 938, 597, 32, 2027,
-	2028, 31, 2029, 30, 29, 2031, 28, 2033, 23, 22,
-	21, 20, 2025, 19, 25, 18, 17, 16, 98, 48,
-	2057, 45, 43, 1430, 105, 104, 46, 42, 873, 2066,
-	27, 1739, 26, 2065, 15, 14, 13, 2046, 1413, 1414,
-	12, 11, 10, 9, 5, 4, 2071, 165, 941, 2073,
-	165, 165, 165, 491, 491, 24, 2072, 1012, 2041, 2,
-	0, 0, 0, 0, 0, 2047, 2048, 2049, 0, 0,
-	0, 0, 2108, 491, 491, 491, 504, 0, 0, 0,
-	2093, 0, 0, 0, 0, 1884, 1885, 166, 0, 2114,
-	975, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 0, 0, 986, 0, 0, 0, 491, 491,
-	491, 165, 2112, 0, 0, 0, 0, 0, 0, 492,
-	0, 0, 491, 0, 491, 0, 0, 0, 1514, 2052,
-	491, 0, 2140, 0, 2130, 491, 492, 492, 2136, 492,
-	2142, 492, 492, 2138, 492, 492, 492, 492, 492, 492,
-	0, 1936, 2128, 2129, 1933, 0, 0, 2145, 1933, 492,
-	0, 0, 2147, 166, 491, 0, 2120, 491, 0, 0,
-	2153, 0, 1951, 0, 0, 2163, 0, 2156, 2155, 166,
-	0, 2076, 0, 2078, 0, 0, 0, 1555, 0, 2144,
-	492, 2160, 166, 0, 0, 2146, 0, 0, 0, 0,
-	0, 0, 0, 2149, 0, 2150, 0, 166, 0, 1934,
-	2180, 34, 0, 1934, 0, 0, 0, 2192, 0, 0,
-	0, 0, 0, 166, 0, 491, 165, 0, 0, 0,
-	166, 2199, 0, 0, 0, 1933, 0, 491, 2113, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 492, 492,
-	492, 0, 2203, 0, 491, 0, 0, 2211, 0, 0,
-	2218, 2131, 491, 491, 0, 2222, 2227, 0, 0, 2235,
-	2163, 2242, 2240, 2232, 0, 166, 0, 0, 0, 0,
-	2254, 2253, 1739, 0, 0, 0, 0, 0, 0, 0,
-	1934, 2260, 0, 0, 0, 0, 0, 0, 0, 0,
-	2266, 0, 2024, 0, 0, 2198, 2026, 0, 0, 2272,
-	34, 0, 0, 0, 0, 0, 0, 2035, 2036, 0,
-	0, 0, 0, 0, 0, 2286, 0, 0, 0, 0,
-	0, 0, 0, 2050, 540, 2288, 492, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 34, 0,
-	2059, 2060, 0, 0, 2064, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1661, 1662, 1663,
-	492, 492, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 166, 0, 164, 0, 0, 448, 2039, 0, 486,
-	0, 0, 0, 0, 0, 492, 448, 2045, 0, 161,
-	0, 0, 166, 0, 448, 492, 0, 0, 0, 166,
-	0, 166, 0, 0, 0, 2097, 0, 0, 0, 166,
-	166, 601, 601, 103, 0, 125, 492, 0, 0, 492,
-	448, 0, 0, 0, 0, 0, 145, 0, 0, 0,
-	492, 0, 0, 0, 0, 0, 0, 0, 1691, 0,
-	975, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 0, 2125, 986, 0, 0, 135, 0, 0,
-	0, 0, 124, 0, 0, 0, 0, 1715, 1716, 1066,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	142, 0, 143, 0, 0, 492, 0, 112, 113, 134,
-	133, 160, 0, 975, 974, 984, 985, 977, 978, 979,
-	980, 981, 982, 983, 976, 0, 0, 986, 0, 492,
-	0, 0, 0, 0, 0, 492, 0, 0, 0, 0,
-	0, 2166, 2167, 2168, 2169, 0, 2173, 0, 2174, 2175,
-	2177, 0, 0, 0, 2178, 2179, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 110, 136, 117, 109,
-	0, 130, 131, 0, 0, 0, 146, 0, 0, 492,
-	0, 0, 0, 0, 0, 151, 118, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2205, 0, 0, 0,
-	121, 119, 114, 115, 116, 120, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 0, 0, 0, 122,
-	0, 161, 166, 0, 0, 0, 0, 0, 0, 166,
-	0, 0, 0, 0, 166, 166, 0, 0, 166, 0,
-	166, 539, 0, 0, 0, 103, 166, 0, 0, 0,
-	0, 0, 0, 166, 0, 0, 0, 0, 145, 0,
-	0, 0, 2267, 2268, 0, 0, 0, 0, 1882, 1883,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	166, 492, 0, 1903, 1904, 0, 1905, 1906, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 1912, 1913, 1772,
-	0, 490, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 0, 143, 0, 0, 0, 0, 0,
-	0, 1910, 0, 160, 0, 0, 0, 2038, 0, 0,
-	0, 0, 0, 615, 0, 0, 763, 0, 770, 0,
-	0, 0, 0, 0, 0, 0, 132, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1925, 0, 126, 0,
-	0, 127, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1136, 0, 0, 166, 0, 0, 0, 0,
-	0, 1962, 0, 166, 0, 0, 0, 0, 146, 448,
-	0, 448, 0, 0, 448, 0, 0, 151, 0, 0,
+	155, 1840, 494, 975, 1223, 94, 156, 157, 158, 159,
+	1643, 2293, 1232, 1843, 834, 2018, 1236, 1704, 1099, 494,
+	494, 1658, 494, 1321, 494, 494, 1133, 494, 494, 494,
+	494, 494, 494, 955, 1455, 1967, 1731, 1455, 1205, 1206,
+	1876, 880, 494, 1315, 972, 1316, 165, 1272, 1317, 988,
+	989, 990, 991, 992, 985, 2413, 1233, 995, 95, 2227,
+	975, 2043, 165, 1007, 1008, 2042, 1082, 1219, 1007, 1008,
+	1630, 1238, 1237, 494, 1212, 165, 167, 168, 169, 1225,
+	1420, 1267, 1268, 1241, 1636, 1242, 1326, 1244, 1246, 2374,
+	165, 1250, 1252, 1254, 1256, 1258, 1275, 1276, 973, 974,
+	972, 976, 1281, 1282, 892, 922, 165, 1269, 1640, 1337,
+	1641, 1836, 1231, 165, 1639, 822, 975, 1197, 1636, 1229,
+	1229, 820, 165, 165, 165, 165, 165, 165, 165, 165,
+	165, 494, 494, 494, 1230, 1210, 1222, 1208, 507, 974,
+	972, 1209, 1638, 2429, 1421, 2038, 1107, 1034, 2424, 167,
+	168, 169, 1340, 1852, 1862, 79, 975, 2168, 165, 1344,
+	1751, 1346, 1347, 1348, 1349, 1285, 2167, 1398, 1353, 1603,
+	1604, 2430, 1270, 973, 974, 972, 1338, 1339, 2417, 2061,
+	1861, 1969, 1368, 1332, 611, 1769, 973, 974, 972, 1308,
+	1343, 975, 1306, 973, 974, 972, 1419, 1350, 1351, 1352,
+	1329, 1860, 1203, 1128, 975, 1422, 2418, 1605, 1296, 1396,
+	1309, 975, 1294, 1293, 102, 792, 791, 1853, 2425, 494,
+	1292, 986, 987, 988, 989, 990, 991, 992, 985, 1378,
+	1394, 995, 1283, 1403, 1404, 1405, 1406, 1407, 1408, 1409,
+	1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417, 1342, 1768,
+	1307, 2137, 1438, 1305, 494, 494, 2421, 1431, 1423, 1424,
+	973, 974, 972, 1696, 1697, 1698, 167, 168, 169, 1295,
+	1827, 1443, 1446, 1397, 613, 614, 165, 1456, 975, 1363,
+	1364, 1365, 973, 974, 972, 1475, 1476, 1720, 973, 974,
+	972, 1277, 1487, 1458, 165, 616, 1274, 494, 1273, 2138,
+	975, 165, 1082, 1248, 494, 2017, 975, 1432, 2420, 165,
+	2419, 165, 2401, 2399, 1433, 1390, 1392, 1393, 2375, 165,
+	165, 1462, 1463, 2222, 1434, 1719, 494, 2207, 2165, 494,
+	2139, 2041, 1024, 1718, 1870, 1391, 1858, 1687, 1022, 2368,
+	494, 1532, 1652, 1431, 1651, 1499, 1488, 1333, 1024, 1297,
+	1436, 983, 993, 994, 986, 987, 988, 989, 990, 991,
+	992, 985, 621, 1284, 995, 621, 973, 974, 972, 167,
+	168, 169, 1280, 1618, 1279, 1506, 167, 168, 169, 1278,
+	973, 974, 972, 1081, 975, 2085, 2242, 1575, 1576, 1577,
+	2241, 1535, 2190, 1511, 1889, 494, 1782, 2344, 975, 2006,
+	1433, 1611, 1612, 1613, 1786, 1557, 1615, 1617, 2017, 534,
+	533, 536, 537, 538, 539, 1782, 2287, 1556, 535, 494,
+	540, 1560, 1782, 2286, 1502, 494, 1232, 1873, 1509, 1232,
+	971, 1232, 1590, 167, 168, 169, 1561, 1616, 1544, 1635,
+	2270, 1082, 1540, 1596, 83, 1439, 1440, 2146, 1543, 1445,
+	1448, 1449, 2185, 1082, 1559, 625, 1782, 2183, 625, 1558,
+	1636, 1082, 2149, 1082, 2053, 2052, 2049, 2050, 2292, 494,
+	1782, 1419, 2049, 2048, 1517, 1461, 1419, 1419, 1464, 1465,
+	1710, 1082, 1537, 1907, 1622, 1334, 993, 994, 986, 987,
+	988, 989, 990, 991, 992, 985, 1538, 1568, 995, 1569,
+	1570, 1571, 1572, 1786, 1498, 1538, 1606, 592, 1601, 1591,
+	1194, 1891, 165, 1586, 1587, 1580, 1581, 1582, 1583, 165,
+	1614, 1884, 1885, 1082, 165, 165, 1629, 815, 165, 1632,
+	165, 1633, 1637, 1647, 1645, 814, 165, 1229, 1649, 1650,
+	1628, 1591, 1646, 165, 1627, 1517, 1082, 1082, 1782, 1781,
+	1814, 1631, 971, 1082, 1194, 1193, 1139, 1138, 1537, 1539,
+	1778, 1516, 1517, 1385, 1386, 1387, 1388, 1541, 1539, 2051,
+	165, 494, 2170, 2017, 1710, 1545, 1537, 1770, 585, 1736,
+	1735, 1710, 1636, 1655, 1263, 1619, 1682, 1683, 1636, 602,
+	1093, 1685, 1477, 1376, 1323, 1125, 797, 796, 79, 2253,
+	1686, 984, 983, 993, 994, 986, 987, 988, 989, 990,
+	991, 992, 985, 1867, 1517, 995, 1096, 72, 2162, 1441,
+	1442, 2157, 2171, 2172, 2173, 879, 166, 1196, 1589, 166,
+	1396, 1710, 166, 2084, 1264, 1265, 1266, 495, 1675, 166,
+	2045, 1892, 1585, 1579, 1578, 1311, 1224, 166, 1220, 1192,
+	96, 2087, 166, 1700, 1701, 1702, 2254, 1471, 1472, 1473,
+	1474, 507, 507, 1600, 1866, 79, 2174, 2371, 507, 2325,
+	495, 1492, 1495, 495, 166, 495, 1260, 2068, 165, 2021,
+	2022, 2067, 2066, 2024, 1690, 2383, 165, 2006, 1877, 1744,
+	1745, 1746, 1747, 79, 1397, 1676, 1369, 1988, 494, 1522,
+	1525, 1526, 1527, 1523, 1699, 1524, 1528, 1805, 2238, 2021,
+	2022, 1867, 1806, 2175, 2176, 2027, 1715, 2026, 165, 165,
+	165, 165, 165, 1261, 1262, 1551, 1803, 1802, 1801, 2345,
+	165, 1804, 2140, 1497, 165, 2150, 1790, 165, 165, 1434,
+	2072, 165, 165, 165, 1783, 1713, 1522, 1525, 1526, 1527,
+	1523, 1997, 1524, 1528, 1826, 1996, 1812, 1779, 1795, 1730,
+	600, 2416, 984, 983, 993, 994, 986, 987, 988, 989,
+	990, 991, 992, 985, 1772, 1851, 995, 1754, 1807, 1074,
+	1526, 1527, 2314, 2316, 1592, 2392, 1771, 2366, 2394, 1762,
+	2311, 2317, 2363, 1850, 1986, 1854, 1855, 1856, 1780, 1322,
+	2362, 494, 1987, 1848, 1849, 586, 1845, 1564, 165, 1796,
+	1815, 1435, 1799, 1437, 1817, 165, 1871, 844, 1808, 1451,
+	33, 494, 843, 1813, 1490, 1797, 1798, 494, 1800, 1818,
+	1821, 1232, 1232, 1452, 1829, 1491, 1332, 494, 2097, 1830,
+	1866, 1931, 1869, 2122, 1596, 1883, 944, 1708, 1709, 1904,
+	1466, 1467, 1468, 1469, 1470, 84, 1900, 1899, 103, 2144,
+	165, 165, 165, 165, 165, 1859, 1888, 1903, 1765, 2064,
+	1728, 1895, 1603, 1604, 1679, 2369, 165, 165, 1868, 2289,
+	1878, 1879, 1880, 2249, 1844, 1530, 1503, 1995, 1507, 1874,
+	1902, 603, 604, 1668, 1695, 1994, 1212, 1079, 984, 983,
+	993, 994, 986, 987, 988, 989, 990, 991, 992, 985,
+	1092, 1088, 995, 494, 2400, 2398, 2397, 2367, 1901, 2365,
+	1419, 1432, 2266, 2226, 2069, 1089, 1620, 1080, 1433, 1940,
+	592, 1893, 1894, 2225, 2143, 1786, 2373, 2372, 602, 2214,
+	1725, 1946, 1722, 1108, 494, 1100, 2373, 1923, 2283, 1945,
+	1500, 1501, 1091, 1947, 1090, 2040, 1966, 1937, 1938, 1766,
+	83, 1929, 494, 86, 1930, 1092, 1088, 80, 1935, 1978,
+	1974, 1980, 1981, 1, 1961, 1962, 1944, 1963, 1964, 165,
+	1089, 2327, 1959, 494, 465, 494, 1943, 1478, 1970, 1971,
+	1072, 1960, 494, 494, 477, 2323, 1298, 1288, 2193, 2250,
+	2075, 1594, 2007, 805, 128, 1085, 1086, 1091, 1554, 1090,
+	1992, 1555, 2340, 93, 768, 165, 92, 808, 906, 1621,
+	2010, 1945, 2186, 1846, 1998, 1985, 166, 1565, 166, 1991,
+	1795, 166, 1145, 1143, 1144, 1142, 1147, 2000, 1146, 1141,
+	2016, 1370, 491, 1529, 165, 163, 1134, 1732, 1101, 845,
+	455, 2054, 1999, 1366, 1653, 461, 2015, 495, 495, 495,
+	2029, 1003, 2031, 2003, 2032, 1993, 2025, 1822, 2046, 2047,
+	622, 615, 2062, 2012, 2360, 495, 495, 2310, 165, 2312,
+	2277, 2315, 2308, 2415, 2391, 494, 2288, 2039, 2030, 1562,
+	1764, 1493, 2224, 494, 2142, 1729, 1033, 1453, 1117, 165,
+	516, 1486, 1389, 531, 528, 529, 1773, 2037, 1787, 165,
+	977, 2059, 2060, 514, 508, 1109, 1521, 2074, 1519, 1518,
+	1677, 1121, 2058, 165, 2023, 2057, 165, 2076, 2019, 2071,
+	1115, 1777, 1563, 1910, 2081, 2098, 956, 2073, 1084, 503,
+	780, 1596, 1450, 2259, 1694, 2079, 2118, 1083, 60, 37,
+	2070, 2078, 498, 2379, 947, 609, 32, 31, 30, 29,
+	2093, 28, 23, 22, 2092, 21, 166, 20, 19, 25,
+	18, 17, 16, 494, 98, 166, 47, 2095, 2096, 44,
+	42, 105, 104, 45, 41, 882, 495, 27, 26, 2099,
+	166, 15, 166, 166, 165, 495, 2106, 2126, 14, 13,
+	2129, 495, 2131, 2132, 12, 11, 10, 9, 5, 4,
+	950, 24, 1706, 2124, 2213, 2301, 1707, 2300, 2318, 2279,
+	2211, 1939, 2352, 532, 2101, 2, 1714, 0, 0, 1716,
+	1717, 0, 0, 0, 0, 1723, 2145, 0, 1726, 1727,
+	0, 0, 0, 0, 2153, 0, 1733, 0, 1734, 0,
+	0, 1737, 1738, 1739, 1740, 1741, 2154, 2159, 0, 1795,
+	0, 1748, 1749, 1750, 165, 1752, 1753, 165, 165, 165,
+	494, 0, 2161, 0, 0, 0, 1763, 2160, 0, 0,
+	0, 0, 2164, 0, 2166, 0, 0, 0, 2194, 494,
+	494, 494, 0, 0, 2181, 0, 0, 0, 2163, 0,
+	2103, 2104, 0, 2105, 0, 2200, 2107, 0, 2109, 0,
+	0, 0, 0, 0, 0, 2189, 0, 0, 0, 0,
+	0, 1810, 1811, 1968, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 543, 494, 494, 494, 165, 2199, 2198,
+	0, 0, 0, 1979, 0, 0, 0, 0, 166, 0,
+	494, 0, 494, 0, 0, 0, 0, 0, 494, 0,
+	1989, 1990, 1495, 494, 0, 2232, 2201, 2202, 2203, 2204,
+	2205, 2230, 2223, 0, 2208, 2209, 2221, 2010, 2004, 2228,
+	495, 2010, 2235, 0, 2239, 0, 2240, 2237, 0, 0,
+	2219, 2220, 493, 494, 0, 0, 2206, 495, 495, 0,
+	495, 2245, 495, 495, 0, 495, 495, 495, 495, 495,
+	495, 0, 2243, 0, 0, 2246, 0, 0, 0, 0,
+	495, 0, 0, 0, 166, 623, 0, 0, 772, 2252,
+	779, 2234, 2267, 0, 0, 0, 0, 2236, 2274, 2265,
+	166, 0, 2282, 0, 2284, 0, 0, 0, 0, 0,
+	0, 495, 0, 166, 494, 165, 2275, 0, 0, 0,
+	2010, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 494, 2291, 0, 0, 0, 0, 0, 2294, 0,
+	0, 494, 2304, 0, 166, 0, 494, 0, 0, 2305,
+	0, 166, 0, 494, 494, 0, 0, 2321, 1941, 1942,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 495,
+	495, 495, 2334, 2339, 2326, 2331, 2116, 0, 0, 0,
+	0, 2357, 0, 0, 2364, 1795, 0, 0, 0, 2252,
+	2341, 0, 0, 0, 2370, 0, 166, 2115, 0, 0,
+	0, 0, 0, 2335, 2376, 0, 0, 0, 0, 0,
+	0, 2384, 2382, 0, 0, 0, 0, 0, 0, 0,
+	0, 2120, 2121, 0, 0, 0, 0, 2396, 2395, 494,
+	0, 0, 0, 0, 2402, 0, 0, 0, 2409, 0,
+	0, 2013, 0, 0, 0, 0, 2411, 0, 0, 0,
+	0, 494, 0, 0, 0, 0, 0, 495, 0, 0,
+	0, 0, 2028, 507, 494, 0, 2422, 0, 0, 2390,
+	2155, 0, 0, 2156, 0, 2428, 2158, 984, 983, 993,
+	994, 986, 987, 988, 989, 990, 991, 992, 985, 0,
+	0, 995, 495, 495, 984, 983, 993, 994, 986, 987,
+	988, 989, 990, 991, 992, 985, 0, 0, 995, 0,
+	0, 0, 0, 0, 166, 984, 983, 993, 994, 986,
+	987, 988, 989, 990, 991, 992, 985, 2114, 0, 995,
+	0, 0, 166, 0, 0, 495, 0, 0, 0, 166,
+	0, 0, 495, 0, 0, 0, 0, 166, 0, 166,
+	0, 0, 0, 0, 0, 0, 0, 166, 166, 0,
+	0, 0, 0, 979, 495, 982, 0, 495, 0, 0,
+	0, 996, 997, 998, 999, 1000, 1001, 1002, 495, 980,
+	981, 978, 984, 983, 993, 994, 986, 987, 988, 989,
+	990, 991, 992, 985, 2100, 0, 995, 1936, 2102, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2111,
+	2112, 0, 0, 0, 0, 0, 0, 984, 983, 993,
+	994, 986, 987, 988, 989, 990, 991, 992, 985, 2130,
+	0, 995, 0, 495, 0, 2136, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 984, 983, 993, 994, 986,
+	987, 988, 989, 990, 991, 992, 985, 495, 0, 995,
+	0, 2147, 2148, 495, 0, 2152, 0, 2113, 0, 0,
+	0, 0, 623, 623, 623, 1705, 0, 2281, 507, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	946, 948, 0, 0, 0, 984, 983, 993, 994, 986,
+	987, 988, 989, 990, 991, 992, 985, 495, 0, 995,
+	0, 0, 0, 0, 0, 0, 0, 0, 507, 0,
+	0, 0, 0, 0, 0, 2184, 0, 0, 0, 0,
+	161, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1882, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 103, 0, 125, 166, 0, 0,
+	0, 0, 166, 166, 0, 0, 166, 145, 166, 0,
+	0, 0, 0, 2210, 166, 0, 0, 0, 0, 0,
+	0, 166, 0, 2216, 0, 984, 983, 993, 994, 986,
+	987, 988, 989, 990, 991, 992, 985, 0, 135, 995,
+	0, 1104, 0, 124, 0, 0, 0, 0, 166, 495,
+	623, 0, 0, 0, 0, 0, 1135, 0, 0, 0,
+	0, 142, 0, 143, 0, 0, 0, 0, 1215, 1216,
+	134, 133, 160, 984, 983, 993, 994, 986, 987, 988,
+	989, 990, 991, 992, 985, 0, 0, 995, 0, 0,
+	0, 2255, 2256, 2257, 2258, 0, 2262, 0, 2263, 2264,
+	0, 0, 0, 0, 0, 2271, 0, 0, 0, 2272,
+	2273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 1217,
+	136, 0, 1214, 0, 130, 131, 0, 0, 0, 146,
+	0, 0, 0, 0, 0, 0, 0, 0, 151, 2296,
 	0, 0, 0, 0, 0, 0, 166, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 166, 166, 166,
-	166, 166, 0, 0, 0, 0, 0, 0, 0, 166,
-	0, 0, 0, 166, 0, 0, 166, 166, 0, 0,
-	166, 166, 166, 975, 974, 984, 985, 977, 978, 979,
-	980, 981, 982, 983, 976, 0, 0, 986, 0, 0,
-	0, 0, 139, 144, 141, 147, 148, 149, 150, 152,
-	153, 154, 155, 0, 0, 0, 0, 0, 156, 157,
-	158, 159, 0, 0, 2023, 0, 0, 0, 0, 0,
-	0, 0, 0, 1124, 0, 0, 0, 0, 0, 0,
-	492, 0, 0, 138, 0, 166, 0, 0, 0, 0,
-	0, 0, 166, 0, 0, 0, 0, 448, 492, 0,
-	0, 0, 0, 0, 492, 0, 0, 0, 0, 0,
-	0, 0, 0, 601, 492, 0, 1137, 0, 0, 0,
-	0, 0, 2044, 0, 0, 0, 0, 0, 448, 0,
-	448, 1098, 0, 0, 0, 0, 0, 166, 166, 166,
-	166, 166, 0, 0, 0, 504, 0, 0, 0, 0,
-	0, 2075, 2067, 166, 166, 2068, 0, 0, 2070, 0,
-	0, 0, 0, 0, 0, 0, 0, 1150, 1153, 1154,
-	1155, 1156, 1157, 1158, 0, 1159, 1160, 1161, 1162, 1163,
-	1138, 1139, 1140, 1141, 1122, 1123, 1151, 0, 1125, 492,
-	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
-	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2115, 2116, 2117, 2118, 2119, 492, 0, 0, 2122, 2123,
-	0, 0, 0, 0, 0, 0, 166, 0, 0, 1054,
-	0, 0, 0, 0, 0, 0, 492, 0, 0, 0,
-	0, 0, 492, 492, 139, 144, 141, 147, 148, 149,
-	150, 152, 153, 154, 155, 2135, 504, 0, 0, 0,
-	156, 157, 158, 159, 1152, 166, 2037, 0, 0, 0,
-	0, 0, 0, 0, 0, 448, 0, 0, 0, 0,
-	0, 447, 0, 0, 0, 0, 0, 615, 615, 615,
-	0, 494, 0, 0, 166, 0, 0, 0, 0, 574,
-	0, 0, 0, 0, 0, 937, 939, 975, 974, 984,
-	985, 977, 978, 979, 980, 981, 982, 983, 976, 0,
-	1209, 986, 0, 0, 0, 767, 0, 0, 166, 0,
-	0, 0, 0, 0, 0, 0, 492, 0, 0, 0,
-	0, 0, 0, 0, 492, 1209, 1209, 0, 0, 0,
-	166, 448, 0, 0, 0, 0, 0, 0, 0, 0,
-	166, 0, 0, 0, 0, 0, 0, 1260, 0, 0,
-	0, 2236, 0, 0, 166, 0, 0, 166, 0, 1881,
-	448, 0, 975, 974, 984, 985, 977, 978, 979, 980,
-	981, 982, 983, 976, 0, 1305, 986, 0, 0, 975,
-	974, 984, 985, 977, 978, 979, 980, 981, 982, 983,
-	976, 448, 1079, 986, 0, 0, 1666, 0, 448, 0,
-	615, 0, 0, 0, 0, 0, 1109, 1328, 1329, 448,
-	448, 448, 448, 448, 448, 448, 975, 974, 984, 985,
-	977, 978, 979, 980, 981, 982, 983, 976, 0, 0,
-	986, 974, 984, 985, 977, 978, 979, 980, 981, 982,
-	983, 976, 0, 448, 986, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 166, 0, 0, 166, 166, 166, 492,
-	492, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
-	492, 492, 0, 0, 0, 601, 1305, 0, 0, 0,
-	601, 601, 0, 0, 601, 601, 601, 0, 0, 0,
-	1209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 492, 492, 492, 166, 0, 0,
-	601, 601, 601, 601, 601, 0, 0, 0, 492, 1260,
-	492, 0, 0, 0, 0, 0, 492, 0, 0, 0,
-	0, 492, 0, 0, 0, 0, 0, 0, 0, 0,
-	448, 0, 0, 0, 0, 0, 1305, 448, 0, 448,
-	0, 0, 0, 0, 763, 0, 0, 448, 448, 0,
-	492, 0, 0, 492, 0, 0, 0, 1208, 0, 0,
-	0, 1214, 1214, 0, 1214, 0, 1214, 1214, 0, 1223,
-	1214, 1214, 1214, 1214, 1214, 0, 0, 0, 0, 0,
-	0, 0, 1208, 1208, 763, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 492, 166, 0, 874, 1284, 879, 0, 0, 881,
-	0, 0, 0, 492, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	492, 0, 0, 0, 0, 0, 0, 0, 492, 492,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 615, 615, 615, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1826, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	103, 0, 125, 0, 0, 0, 0, 0, 0, 0,
-	448, 0, 0, 145, 0, 0, 161, 448, 0, 0,
-	0, 0, 448, 448, 0, 0, 448, 1185, 1641, 0,
-	0, 1399, 0, 615, 448, 0, 0, 0, 0, 0,
-	103, 448, 125, 1085, 135, 0, 1096, 1208, 0, 124,
-	0, 0, 0, 145, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1431, 1432, 142, 448, 143,
-	0, 0, 0, 0, 1189, 1190, 134, 133, 160, 0,
-	0, 0, 0, 0, 135, 0, 0, 0, 0, 124,
-	1464, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1079, 0, 0, 615, 0, 0, 0, 142, 0, 143,
-	0, 0, 0, 0, 1189, 1190, 134, 133, 160, 0,
-	0, 615, 0, 0, 615, 0, 601, 601, 0, 0,
-	0, 0, 129, 1191, 136, 763, 1188, 0, 130, 131,
-	0, 0, 0, 146, 0, 0, 0, 601, 0, 0,
-	0, 0, 151, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 448, 0, 0, 0, 0, 0, 0,
-	0, 1260, 129, 1191, 136, 0, 1188, 0, 130, 131,
-	0, 0, 0, 146, 0, 0, 0, 0, 0, 0,
-	770, 0, 151, 601, 448, 0, 0, 0, 0, 0,
-	1114, 0, 0, 0, 1209, 448, 448, 448, 448, 448,
-	0, 0, 0, 0, 763, 0, 0, 1753, 0, 0,
-	770, 448, 0, 0, 448, 448, 0, 0, 448, 1763,
-	1305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 763, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 448, 0, 0, 0, 0, 138, 0,
-	1825, 0, 0, 0, 0, 1286, 0, 0, 0, 0,
-	1209, 0, 0, 132, 0, 0, 0, 0, 0, 0,
-	1305, 0, 0, 0, 0, 126, 0, 0, 127, 0,
-	0, 0, 0, 0, 0, 0, 1315, 0, 0, 0,
-	0, 0, 0, 1319, 0, 448, 448, 448, 448, 448,
-	0, 0, 0, 132, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 448, 448, 0, 0, 126, 1650, 0, 127, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1096, 0,
-	0, 0, 0, 0, 0, 0, 601, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	0, 0, 0, 0, 166, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 495, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 166, 166, 166,
+	166, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 166, 0, 0, 166, 166, 0, 0, 166,
+	166, 166, 0, 0, 0, 2377, 2378, 0, 0, 0,
+	0, 0, 0, 0, 544, 772, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1234, 0,
+	0, 0, 1240, 1240, 138, 1240, 0, 1240, 1240, 0,
+	1249, 1240, 1240, 1240, 1240, 1240, 0, 0, 0, 0,
+	0, 0, 0, 1234, 1234, 772, 0, 0, 0, 495,
+	0, 0, 164, 0, 0, 451, 166, 0, 489, 0,
+	0, 0, 0, 166, 0, 451, 0, 0, 0, 495,
+	0, 0, 0, 451, 0, 495, 1310, 0, 598, 132,
+	0, 0, 2431, 0, 0, 495, 0, 0, 0, 0,
+	608, 126, 608, 1075, 127, 161, 546, 71, 0, 0,
+	451, 0, 0, 0, 0, 0, 0, 0, 166, 166,
+	166, 166, 166, 0, 0, 0, 0, 0, 0, 103,
+	0, 0, 0, 0, 166, 166, 0, 0, 0, 0,
+	0, 71, 145, 0, 623, 623, 623, 0, 0, 0,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 497, 0, 0, 0, 0, 0,
+	0, 495, 589, 0, 0, 0, 0, 0, 71, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 601,
+	0, 0, 0, 0, 0, 0, 142, 0, 143, 776,
+	0, 0, 495, 0, 0, 0, 0, 160, 139, 144,
+	141, 147, 148, 149, 150, 152, 153, 154, 155, 0,
+	495, 0, 0, 0, 156, 157, 158, 159, 0, 0,
+	0, 0, 1425, 0, 623, 0, 0, 166, 0, 0,
+	0, 495, 0, 495, 0, 0, 0, 0, 0, 1234,
+	495, 495, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1459, 1460, 0,
+	0, 0, 0, 166, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 151, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 0, 0, 0,
+	1505, 0, 0, 0, 0, 0, 0, 1104, 0, 0,
+	623, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0, 0, 623,
+	0, 0, 623, 495, 0, 0, 0, 0, 0, 0,
+	0, 495, 0, 772, 0, 0, 0, 166, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 0, 166, 0, 0, 0, 0, 138,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 779, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 495, 772, 0, 0, 0, 0, 0, 779, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 451, 0, 451, 0, 0, 451, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 772, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 166, 166, 166, 495, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 495, 495, 495,
+	0, 883, 0, 888, 0, 0, 890, 0, 0, 0,
+	0, 0, 0, 139, 144, 141, 147, 148, 149, 150,
+	152, 153, 154, 155, 0, 0, 0, 0, 0, 156,
+	157, 158, 159, 0, 1689, 939, 939, 939, 0, 0,
+	0, 0, 495, 495, 495, 166, 0, 0, 0, 0,
+	0, 0, 451, 0, 0, 71, 0, 0, 495, 0,
+	495, 598, 0, 0, 0, 0, 495, 0, 1004, 1006,
+	0, 495, 0, 0, 0, 0, 451, 0, 451, 1124,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1019, 495, 0, 0, 1025, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 0, 1035, 1037, 1040, 1040, 1040, 1037, 1040,
+	1040, 1037, 1040, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
+	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
+	1070, 0, 0, 0, 0, 0, 1076, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 71, 0,
+	0, 1774, 495, 166, 0, 1111, 0, 0, 1122, 0,
+	1118, 0, 0, 0, 0, 0, 0, 0, 0, 495,
+	1234, 0, 0, 0, 0, 0, 0, 0, 0, 495,
+	0, 0, 0, 0, 495, 0, 0, 0, 0, 0,
+	0, 495, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 451, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1872, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 495, 0, 1235,
+	0, 0, 0, 0, 1505, 0, 0, 0, 1234, 0,
+	1890, 0, 0, 0, 0, 0, 0, 0, 623, 495,
+	1896, 0, 0, 0, 1235, 1235, 0, 0, 0, 0,
+	451, 0, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1140, 0, 0, 1286, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 451,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1331, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	451, 0, 0, 0, 0, 0, 623, 451, 0, 0,
+	0, 0, 0, 0, 0, 0, 1354, 1355, 451, 451,
+	451, 451, 451, 451, 451, 0, 0, 0, 0, 1271,
+	0, 0, 0, 0, 0, 0, 0, 1973, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 451, 0, 0, 1240, 0, 0, 1312, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2001, 0, 623, 0,
+	0, 0, 1234, 0, 0, 2014, 1240, 0, 0, 1341,
+	0, 0, 0, 0, 0, 0, 1345, 1162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1356, 1357, 1358,
+	1359, 1360, 1361, 1362, 608, 1331, 0, 939, 939, 939,
+	608, 608, 0, 0, 608, 608, 608, 0, 0, 0,
+	1235, 161, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1122, 1211, 0, 0, 0, 0, 0, 0, 0,
+	608, 608, 608, 608, 608, 103, 0, 125, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 145, 0,
+	1286, 0, 0, 0, 0, 0, 0, 0, 772, 608,
+	0, 1234, 0, 0, 0, 0, 1505, 0, 598, 0,
+	0, 0, 0, 0, 0, 451, 0, 0, 0, 135,
+	0, 1331, 0, 451, 124, 451, 0, 0, 0, 0,
+	0, 0, 0, 451, 451, 0, 0, 0, 0, 0,
+	1150, 0, 142, 0, 143, 0, 0, 0, 0, 1215,
+	1216, 134, 133, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1163, 0, 0, 2125, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1508, 0, 0, 0, 0, 129,
+	1217, 136, 1512, 1214, 1515, 130, 131, 0, 0, 0,
+	146, 0, 0, 1534, 0, 1533, 0, 0, 0, 151,
+	0, 1234, 0, 0, 1176, 1179, 1180, 1181, 1182, 1183,
+	1184, 0, 1185, 1186, 1187, 1188, 1189, 1164, 1165, 1166,
+	1167, 1148, 1149, 1177, 0, 1151, 0, 1152, 1153, 1154,
+	1155, 1156, 1157, 1158, 1159, 1160, 1161, 1168, 1169, 1170,
+	1171, 1172, 1173, 1174, 1175, 0, 0, 0, 0, 0,
+	0, 0, 0, 1505, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2195, 2196, 2197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 451, 0, 0, 0,
+	0, 0, 0, 451, 0, 138, 0, 0, 451, 451,
+	0, 1178, 451, 0, 1680, 0, 0, 2217, 2217, 2217,
+	451, 0, 0, 0, 0, 0, 0, 451, 0, 0,
+	0, 0, 0, 2231, 0, 2233, 0, 0, 0, 0,
+	0, 1505, 0, 0, 0, 0, 1505, 0, 0, 0,
+	0, 0, 0, 0, 451, 0, 0, 0, 0, 0,
+	132, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 0, 0, 127, 623, 0, 0, 0,
+	0, 0, 0, 0, 0, 1122, 0, 0, 0, 0,
+	0, 0, 1664, 0, 0, 0, 0, 1673, 1674, 0,
+	0, 1678, 0, 0, 0, 0, 0, 0, 0, 1681,
+	0, 0, 608, 608, 0, 0, 1684, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 608, 0, 1505, 0, 0,
+	0, 0, 0, 1688, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2306, 0, 0, 0, 0, 0,
+	0, 0, 451, 0, 2320, 0, 0, 1234, 0, 2322,
+	1286, 0, 0, 0, 0, 0, 623, 623, 0, 139,
 	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	0, 0, 0, 0, 448, 156, 157, 158, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 1209, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
-	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	0, 0, 0, 448, 0, 156, 157, 158, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1208, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 448, 0, 0, 1471, 0, 0, 0, 0,
-	0, 0, 1475, 0, 1478, 0, 0, 0, 0, 0,
-	0, 0, 0, 1497, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 448, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1209, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 448, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 448, 0,
-	0, 0, 0, 0, 0, 1816, 0, 0, 0, 0,
-	0, 0, 448, 0, 0, 448, 0, 0, 0, 0,
-	0, 0, 0, 1828, 0, 0, 0, 1208, 0, 1835,
-	35, 36, 37, 72, 39, 40, 0, 615, 0, 1841,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	76, 0, 0, 0, 41, 67, 68, 0, 65, 69,
-	0, 0, 0, 0, 0, 0, 0, 66, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1209, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 615, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 448, 0, 0, 448, 448, 448, 0, 0, 0,
-	0, 0, 0, 0, 0, 1096, 0, 0, 0, 0,
-	1214, 0, 1625, 0, 0, 0, 0, 1634, 1635, 0,
-	0, 1639, 0, 0, 0, 0, 0, 0, 0, 1642,
-	0, 615, 0, 0, 1208, 0, 1645, 1937, 1214, 0,
-	0, 0, 0, 0, 44, 47, 50, 49, 52, 0,
-	64, 0, 0, 70, 0, 1260, 0, 0, 0, 0,
-	0, 0, 0, 1649, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 53, 75, 74, 0, 0,
-	62, 63, 51, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 55, 56, 0, 57, 58, 59, 60, 0, 0,
-	0, 763, 0, 0, 1208, 0, 0, 0, 0, 1828,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	448, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1760, 0, 0, 0, 0, 73, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1208, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1819, 0,
-	0, 0, 0, 0, 1828, 2094, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2109, 2110, 2111, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1859, 1860, 1861, 1862, 1863, 0, 0, 0, 0, 2126,
-	2126, 2126, 0, 0, 0, 0, 1096, 1869, 0, 0,
-	0, 0, 0, 2141, 0, 2143, 0, 0, 0, 0,
-	0, 1828, 0, 0, 0, 0, 1828, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1828, 0, 0, 615, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1922,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1828, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1208, 0, 2223, 0, 0, 0, 0,
-	0, 0, 0, 615, 615, 0, 0, 1967, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1988, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2001, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2004, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2015, 0, 0,
-	2018, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2089, 0, 0, 2090,
-	2091, 2092, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 741, 727, 393, 0, 676, 744, 647, 664, 754,
-	667, 670, 710, 626, 689, 317, 661, 0, 651, 622,
-	657, 623, 649, 678, 224, 646, 729, 692, 743, 275,
-	221, 628, 652, 331, 666, 176, 712, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 750, 279, 699, 0, 378, 302, 0, 0, 0,
-	680, 733, 687, 723, 675, 711, 636, 698, 745, 662,
-	707, 746, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 2243, 2244, 0, 0, 0,
-	0, 0, 198, 0, 205, 704, 740, 659, 706, 219,
-	263, 226, 218, 395, 751, 732, 0, 191, 742, 682,
-	709, 757, 621, 701, 0, 624, 627, 753, 736, 655,
-	229, 0, 0, 0, 0, 0, 0, 0, 679, 688,
-	720, 673, 0, 0, 0, 0, 0, 0, 0, 0,
-	653, 0, 697, 0, 0, 0, 632, 625, 0, 0,
-	0, 0, 677, 0, 0, 0, 635, 0, 654, 721,
-	0, 619, 247, 629, 303, 2204, 725, 735, 674, 427,
-	739, 672, 671, 716, 633, 731, 665, 274, 631, 271,
-	171, 187, 0, 663, 313, 352, 358, 730, 650, 658,
-	210, 656, 356, 327, 412, 194, 237, 349, 332, 354,
-	696, 714, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 932, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 645, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 726, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	718, 756, 326, 357, 200, 414, 377, 640, 644, 638,
-	639, 690, 691, 641, 747, 748, 749, 722, 634, 0,
-	642, 643, 0, 728, 737, 738, 695, 170, 184, 277,
-	752, 346, 240, 440, 421, 417, 620, 637, 216, 648,
-	0, 0, 660, 668, 669, 681, 683, 684, 685, 686,
-	694, 702, 703, 705, 713, 715, 717, 719, 724, 734,
-	755, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 693, 700, 287, 234, 252, 262, 708, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 741, 727, 393,
-	0, 676, 744, 647, 664, 754, 667, 670, 710, 626,
-	689, 317, 661, 0, 651, 622, 657, 623, 649, 678,
-	224, 646, 729, 692, 743, 275, 221, 628, 652, 331,
-	666, 176, 712, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 750, 279, 699,
-	0, 378, 302, 0, 0, 0, 680, 733, 687, 723,
-	675, 711, 636, 698, 745, 662, 707, 746, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
+	0, 0, 0, 0, 0, 156, 157, 158, 159, 0,
+	0, 1235, 451, 451, 451, 451, 451, 0, 1711, 0,
+	0, 601, 0, 0, 1809, 0, 0, 0, 451, 0,
+	0, 451, 451, 0, 0, 451, 1819, 1331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2320, 0, 0, 0, 0, 0, 0, 0,
+	1761, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2414, 0, 0, 0, 0, 0,
+	1006, 0, 0, 0, 0, 0, 0, 2125, 0, 0,
+	1791, 1792, 451, 0, 1118, 1118, 1118, 1118, 1118, 1881,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1235,
+	1533, 1816, 0, 1118, 0, 0, 0, 1118, 0, 1331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 451, 451, 451, 451, 451, 0,
+	0, 0, 0, 103, 0, 125, 0, 0, 0, 0,
+	451, 451, 0, 0, 0, 0, 145, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1875, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 608, 608, 135, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1897, 0, 0, 0, 0, 0,
+	142, 0, 143, 0, 0, 0, 0, 112, 113, 134,
+	133, 160, 0, 1914, 1915, 1916, 1917, 1918, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1122,
+	1924, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 451, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1235, 0, 0, 0, 72, 35, 36,
+	74, 38, 39, 0, 0, 0, 0, 129, 110, 136,
+	117, 109, 0, 130, 131, 0, 0, 78, 146, 451,
+	0, 40, 66, 67, 0, 64, 68, 151, 118, 0,
+	0, 0, 0, 0, 65, 0, 0, 0, 0, 0,
+	1972, 0, 121, 119, 114, 115, 116, 120, 451, 0,
+	0, 0, 111, 0, 0, 0, 0, 0, 0, 0,
+	0, 122, 0, 53, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 0, 1118, 0, 0, 0, 0,
+	0, 0, 451, 0, 0, 0, 2011, 0, 71, 0,
+	0, 0, 1235, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 451, 0, 0, 0, 0, 0, 0,
+	0, 1118, 0, 451, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 451, 0, 0,
+	451, 0, 0, 138, 0, 0, 0, 2044, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 43, 46, 49, 48, 51, 0, 63,
+	0, 0, 69, 0, 0, 0, 0, 0, 0, 0,
+	0, 2065, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 52, 77, 76, 0, 132, 61,
+	62, 50, 2077, 0, 0, 0, 0, 0, 451, 0,
+	126, 0, 2080, 127, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2091, 0, 0, 2094,
+	0, 0, 1235, 0, 0, 0, 0, 0, 0, 0,
+	54, 55, 0, 56, 57, 58, 59, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2117, 0, 0, 0, 0, 0, 451, 0,
+	0, 451, 451, 451, 0, 0, 0, 0, 0, 2133,
+	2134, 2135, 0, 0, 0, 0, 0, 2141, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 144, 141,
+	147, 148, 149, 150, 152, 153, 154, 155, 0, 0,
+	0, 0, 0, 156, 157, 158, 159, 0, 0, 0,
+	0, 0, 0, 0, 75, 0, 0, 0, 0, 0,
+	0, 1286, 0, 0, 0, 0, 0, 73, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2177, 0, 0,
+	2178, 2179, 2180, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2011, 0, 71, 0, 2011, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 451,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1235, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2011, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2290, 0, 0, 0, 0, 71, 0, 2295, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 750, 736, 396, 71, 684, 753, 655,
+	672, 763, 675, 678, 718, 634, 697, 318, 669, 0,
+	659, 630, 665, 631, 657, 686, 225, 654, 738, 700,
+	752, 276, 222, 636, 660, 332, 674, 176, 720, 372,
+	210, 285, 283, 401, 236, 228, 224, 208, 260, 291,
+	330, 390, 324, 759, 280, 707, 0, 381, 303, 0,
+	0, 0, 688, 742, 695, 732, 683, 719, 644, 706,
+	754, 670, 715, 755, 266, 207, 175, 315, 382, 240,
+	0, 0, 0, 167, 168, 169, 2403, 2342, 2343, 0,
+	0, 0, 0, 0, 198, 0, 205, 712, 749, 667,
+	714, 220, 264, 227, 219, 398, 760, 741, 0, 191,
+	370, 0, 751, 690, 717, 766, 629, 709, 0, 632,
+	635, 762, 745, 663, 230, 0, 0, 0, 0, 0,
+	0, 0, 687, 696, 729, 681, 0, 0, 0, 0,
+	0, 0, 0, 0, 661, 0, 705, 0, 0, 0,
+	640, 633, 0, 0, 0, 0, 685, 0, 0, 0,
+	643, 0, 662, 730, 0, 627, 248, 637, 304, 0,
+	734, 744, 682, 430, 748, 680, 679, 724, 641, 740,
+	673, 275, 639, 272, 171, 187, 0, 671, 314, 353,
+	359, 739, 658, 666, 211, 664, 357, 328, 415, 194,
+	238, 350, 333, 355, 704, 722, 356, 281, 403, 345,
+	413, 431, 432, 218, 308, 421, 394, 427, 442, 188,
+	215, 322, 387, 418, 378, 301, 399, 400, 271, 377,
+	246, 174, 279, 439, 186, 365, 202, 179, 389, 411,
+	199, 368, 0, 0, 444, 181, 409, 386, 298, 268,
+	269, 180, 0, 349, 223, 244, 213, 317, 406, 407,
+	212, 445, 190, 426, 183, 941, 425, 310, 402, 410,
+	299, 290, 182, 408, 297, 289, 274, 234, 255, 343,
+	284, 344, 256, 306, 305, 307, 0, 177, 0, 383,
+	419, 446, 195, 196, 197, 653, 233, 237, 243, 245,
+	251, 252, 259, 277, 321, 342, 340, 346, 735, 397,
+	414, 422, 429, 435, 436, 440, 437, 438, 441, 309,
+	258, 379, 273, 282, 727, 765, 327, 358, 200, 417,
+	380, 648, 652, 646, 647, 698, 699, 649, 756, 757,
+	758, 731, 642, 0, 650, 651, 0, 737, 746, 747,
+	703, 170, 184, 278, 761, 347, 241, 443, 424, 420,
+	628, 645, 217, 656, 0, 0, 668, 676, 677, 689,
+	691, 692, 693, 694, 702, 710, 711, 713, 721, 723,
+	726, 728, 733, 743, 764, 209, 725, 369, 172, 173,
+	185, 193, 203, 216, 231, 239, 249, 254, 257, 261,
+	262, 265, 270, 287, 292, 293, 294, 295, 311, 312,
+	313, 316, 319, 320, 323, 325, 326, 329, 335, 336,
+	337, 338, 339, 341, 348, 352, 360, 361, 362, 363,
+	364, 366, 367, 373, 374, 375, 376, 384, 388, 404,
+	405, 416, 428, 433, 250, 412, 434, 0, 286, 701,
+	708, 288, 235, 253, 263, 716, 423, 385, 189, 354,
+	242, 178, 206, 192, 214, 229, 232, 267, 296, 302,
+	331, 334, 247, 226, 204, 351, 201, 371, 391, 392,
+	393, 395, 300, 221, 750, 736, 396, 0, 684, 753,
+	655, 672, 763, 675, 678, 718, 634, 697, 318, 669,
+	0, 659, 630, 665, 631, 657, 686, 225, 654, 738,
+	700, 752, 276, 222, 636, 660, 332, 674, 176, 720,
+	372, 210, 285, 283, 401, 236, 228, 224, 208, 260,
+	291, 330, 390, 324, 759, 280, 707, 0, 381, 303,
+	0, 0, 0, 688, 742, 695, 732, 683, 719, 644,
+	706, 754, 670, 715, 755, 266, 207, 175, 315, 382,
+	240, 0, 0, 0, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 198, 0, 205, 712, 749,
+	667, 714, 220, 264, 227, 219, 398, 760, 741, 0,
+	191, 370, 0, 751, 690, 717, 766, 629, 709, 0,
+	632, 635, 762, 745, 663, 230, 0, 0, 0, 0,
+	0, 0, 0, 687, 696, 729, 681, 0, 0, 0,
+	0, 0, 0, 2002, 0, 661, 0, 705, 0, 0,
+	0, 640, 633, 0, 0, 0, 0, 685, 0, 0,
+	0, 643, 0, 662, 730, 0, 627, 248, 637, 304,
+	0, 734, 744, 682, 430, 748, 680, 679, 724, 641,
+	740, 673, 275, 639, 272, 171, 187, 0, 671, 314,
+	353, 359, 739, 658, 666, 211, 664, 357, 328, 415,
+	194, 238, 350, 333, 355, 704, 722, 356, 281, 403,
+	345, 413, 431, 432, 218, 308, 421, 394, 427, 442,
+	188, 215, 322, 387, 418, 378, 301, 399, 400, 271,
+	377, 246, 174, 279, 439, 186, 365, 202, 179, 389,
+	411, 199, 368, 0, 0, 444, 181, 409, 386, 298,
+	268, 269, 180, 0, 349, 223, 244, 213, 317, 406,
+	407, 212, 445, 190, 426, 183, 941, 425, 310, 402,
+	410, 299, 290, 182, 408, 297, 289, 274, 234, 255,
+	343, 284, 344, 256, 306, 305, 307, 0, 177, 0,
+	383, 419, 446, 195, 196, 197, 653, 233, 237, 243,
+	245, 251, 252, 259, 277, 321, 342, 340, 346, 735,
+	397, 414, 422, 429, 435, 436, 440, 437, 438, 441,
+	309, 258, 379, 273, 282, 727, 765, 327, 358, 200,
+	417, 380, 648, 652, 646, 647, 698, 699, 649, 756,
+	757, 758, 731, 642, 0, 650, 651, 0, 737, 746,
+	747, 703, 170, 184, 278, 761, 347, 241, 443, 424,
+	420, 628, 645, 217, 656, 0, 0, 668, 676, 677,
+	689, 691, 692, 693, 694, 702, 710, 711, 713, 721,
+	723, 726, 728, 733, 743, 764, 209, 725, 369, 172,
+	173, 185, 193, 203, 216, 231, 239, 249, 254, 257,
+	261, 262, 265, 270, 287, 292, 293, 294, 295, 311,
+	312, 313, 316, 319, 320, 323, 325, 326, 329, 335,
+	336, 337, 338, 339, 341, 348, 352, 360, 361, 362,
+	363, 364, 366, 367, 373, 374, 375, 376, 384, 388,
+	404, 405, 416, 428, 433, 250, 412, 434, 0, 286,
+	701, 708, 288, 235, 253, 263, 716, 423, 385, 189,
+	354, 242, 178, 206, 192, 214, 229, 232, 267, 296,
+	302, 331, 334, 247, 226, 204, 351, 201, 371, 391,
+	392, 393, 395, 300, 221, 750, 736, 396, 0, 684,
+	753, 655, 672, 763, 675, 678, 718, 634, 697, 318,
+	669, 0, 659, 630, 665, 631, 657, 686, 225, 654,
+	738, 700, 752, 276, 222, 636, 660, 332, 674, 176,
+	720, 372, 210, 285, 283, 401, 236, 228, 224, 208,
+	260, 291, 330, 390, 324, 759, 280, 707, 0, 381,
+	303, 0, 0, 0, 688, 742, 695, 732, 683, 719,
+	644, 706, 754, 670, 715, 755, 266, 207, 175, 315,
+	382, 240, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 712,
+	749, 667, 714, 220, 264, 227, 219, 398, 760, 741,
+	0, 191, 370, 0, 751, 690, 717, 766, 629, 709,
+	0, 632, 635, 762, 745, 663, 230, 0, 0, 0,
+	0, 0, 0, 0, 687, 696, 729, 681, 0, 0,
+	0, 0, 0, 0, 1820, 0, 661, 0, 705, 0,
+	0, 0, 640, 633, 0, 0, 0, 0, 685, 0,
+	0, 0, 643, 0, 662, 730, 0, 627, 248, 637,
+	304, 0, 734, 744, 682, 430, 748, 680, 679, 724,
+	641, 740, 673, 275, 639, 272, 171, 187, 0, 671,
+	314, 353, 359, 739, 658, 666, 211, 664, 357, 328,
+	415, 194, 238, 350, 333, 355, 704, 722, 356, 281,
+	403, 345, 413, 431, 432, 218, 308, 421, 394, 427,
+	442, 188, 215, 322, 387, 418, 378, 301, 399, 400,
+	271, 377, 246, 174, 279, 439, 186, 365, 202, 179,
+	389, 411, 199, 368, 0, 0, 444, 181, 409, 386,
+	298, 268, 269, 180, 0, 349, 223, 244, 213, 317,
+	406, 407, 212, 445, 190, 426, 183, 941, 425, 310,
+	402, 410, 299, 290, 182, 408, 297, 289, 274, 234,
+	255, 343, 284, 344, 256, 306, 305, 307, 0, 177,
+	0, 383, 419, 446, 195, 196, 197, 653, 233, 237,
+	243, 245, 251, 252, 259, 277, 321, 342, 340, 346,
+	735, 397, 414, 422, 429, 435, 436, 440, 437, 438,
+	441, 309, 258, 379, 273, 282, 727, 765, 327, 358,
+	200, 417, 380, 648, 652, 646, 647, 698, 699, 649,
+	756, 757, 758, 731, 642, 0, 650, 651, 0, 737,
+	746, 747, 703, 170, 184, 278, 761, 347, 241, 443,
+	424, 420, 628, 645, 217, 656, 0, 0, 668, 676,
+	677, 689, 691, 692, 693, 694, 702, 710, 711, 713,
+	721, 723, 726, 728, 733, 743, 764, 209, 725, 369,
+	172, 173, 185, 193, 203, 216, 231, 239, 249, 254,
+	257, 261, 262, 265, 270, 287, 292, 293, 294, 295,
+	311, 312, 313, 316, 319, 320, 323, 325, 326, 329,
+	335, 336, 337, 338, 339, 341, 348, 352, 360, 361,
+	362, 363, 364, 366, 367, 373, 374, 375, 376, 384,
+	388, 404, 405, 416, 428, 433, 250, 412, 434, 0,
+	286, 701, 708, 288, 235, 253, 263, 716, 423, 385,
+	189, 354, 242, 178, 206, 192, 214, 229, 232, 267,
+	296, 302, 331, 334, 247, 226, 204, 351, 201, 371,
+	391, 392, 393, 395, 300, 221, 750, 736, 396, 0,
+	684, 753, 655, 672, 763, 675, 678, 718, 634, 697,
+	318, 669, 0, 659, 630, 665, 631, 657, 686, 225,
+	654, 738, 700, 752, 276, 222, 636, 660, 332, 674,
+	176, 720, 372, 210, 285, 283, 401, 236, 228, 224,
+	208, 260, 291, 330, 390, 324, 759, 280, 707, 0,
+	381, 303, 0, 0, 0, 688, 742, 695, 732, 683,
+	719, 644, 706, 754, 670, 715, 755, 266, 207, 175,
+	315, 382, 240, 0, 0, 0, 167, 168, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
+	712, 749, 667, 714, 220, 264, 227, 219, 398, 760,
+	741, 0, 191, 370, 0, 751, 690, 717, 766, 629,
+	709, 0, 632, 635, 762, 745, 663, 230, 0, 0,
+	0, 0, 0, 0, 0, 687, 696, 729, 681, 0,
+	0, 0, 0, 0, 0, 1510, 0, 661, 0, 705,
+	0, 0, 0, 640, 633, 0, 0, 0, 0, 685,
+	0, 0, 0, 643, 0, 662, 730, 0, 627, 248,
+	637, 304, 0, 734, 744, 682, 430, 748, 680, 679,
+	724, 641, 740, 673, 275, 639, 272, 171, 187, 0,
+	671, 314, 353, 359, 739, 658, 666, 211, 664, 357,
+	328, 415, 194, 238, 350, 333, 355, 704, 722, 356,
+	281, 403, 345, 413, 431, 432, 218, 308, 421, 394,
+	427, 442, 188, 215, 322, 387, 418, 378, 301, 399,
+	400, 271, 377, 246, 174, 279, 439, 186, 365, 202,
+	179, 389, 411, 199, 368, 0, 0, 444, 181, 409,
+	386, 298, 268, 269, 180, 0, 349, 223, 244, 213,
+	317, 406, 407, 212, 445, 190, 426, 183, 941, 425,
+	310, 402, 410, 299, 290, 182, 408, 297, 289, 274,
+	234, 255, 343, 284, 344, 256, 306, 305, 307, 0,
+	177, 0, 383, 419, 446, 195, 196, 197, 653, 233,
+	237, 243, 245, 251, 252, 259, 277, 321, 342, 340,
+	346, 735, 397, 414, 422, 429, 435, 436, 440, 437,
+	438, 441, 309, 258, 379, 273, 282, 727, 765, 327,
+	358, 200, 417, 380, 648, 652, 646, 647, 698, 699,
+	649, 756, 757, 758, 731, 642, 0, 650, 651, 0,
+	737, 746, 747, 703, 170, 184, 278, 761, 347, 241,
+	443, 424, 420, 628, 645, 217, 656, 0, 0, 668,
+	676, 677, 689, 691, 692, 693, 694, 702, 710, 711,
+	713, 721, 723, 726, 728, 733, 743, 764, 209, 725,
+	369, 172, 173, 185, 193, 203, 216, 231, 239, 249,
+	254, 257, 261, 262, 265, 270, 287, 292, 293, 294,
+	295, 311, 312, 313, 316, 319, 320, 323, 325, 326,
+	329, 335, 336, 337, 338, 339, 341, 348, 352, 360,
+	361, 362, 363, 364, 366, 367, 373, 374, 375, 376,
+	384, 388, 404, 405, 416, 428, 433, 250, 412, 434,
+	0, 286, 701, 708, 288, 235, 253, 263, 716, 423,
+	385, 189, 354, 242, 178, 206, 192, 214, 229, 232,
+	267, 296, 302, 331, 334, 247, 226, 204, 351, 201,
+	371, 391, 392, 393, 395, 300, 221, 750, 736, 396,
+	0, 684, 753, 655, 672, 763, 675, 678, 718, 634,
+	697, 318, 669, 0, 659, 630, 665, 631, 657, 686,
+	225, 654, 738, 700, 752, 276, 222, 636, 660, 332,
+	674, 176, 720, 372, 210, 285, 283, 401, 236, 228,
+	224, 208, 260, 291, 330, 390, 324, 759, 280, 707,
+	0, 381, 303, 0, 0, 0, 688, 742, 695, 732,
+	683, 719, 644, 706, 754, 670, 715, 755, 266, 207,
+	175, 315, 382, 240, 79, 0, 0, 167, 168, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 704, 740, 659, 706, 219, 263, 226, 218, 395,
-	751, 732, 0, 191, 742, 682, 709, 757, 621, 701,
-	0, 624, 627, 753, 736, 655, 229, 0, 0, 0,
-	0, 0, 0, 0, 679, 688, 720, 673, 0, 0,
-	0, 0, 0, 0, 1926, 0, 653, 0, 697, 0,
-	0, 0, 632, 625, 0, 0, 0, 0, 677, 0,
-	0, 0, 635, 0, 654, 721, 0, 619, 247, 629,
-	303, 0, 725, 735, 674, 427, 739, 672, 671, 716,
-	633, 731, 665, 274, 631, 271, 171, 187, 0, 663,
-	313, 352, 358, 730, 650, 658, 210, 656, 356, 327,
-	412, 194, 237, 349, 332, 354, 696, 714, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 932, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 645, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	726, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 718, 756, 326, 357,
-	200, 414, 377, 640, 644, 638, 639, 690, 691, 641,
-	747, 748, 749, 722, 634, 0, 642, 643, 0, 728,
-	737, 738, 695, 170, 184, 277, 752, 346, 240, 440,
-	421, 417, 620, 637, 216, 648, 0, 0, 660, 668,
-	669, 681, 683, 684, 685, 686, 694, 702, 703, 705,
-	713, 715, 717, 719, 724, 734, 755, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 693, 700,
-	287, 234, 252, 262, 708, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 741, 727, 393, 0, 676, 744, 647,
-	664, 754, 667, 670, 710, 626, 689, 317, 661, 0,
-	651, 622, 657, 623, 649, 678, 224, 646, 729, 692,
-	743, 275, 221, 628, 652, 331, 666, 176, 712, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 750, 279, 699, 0, 378, 302, 0,
-	0, 0, 680, 733, 687, 723, 675, 711, 636, 698,
-	745, 662, 707, 746, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 704, 740, 659,
-	706, 219, 263, 226, 218, 395, 751, 732, 0, 191,
-	742, 682, 709, 757, 621, 701, 0, 624, 627, 753,
-	736, 655, 229, 0, 0, 0, 0, 0, 0, 0,
-	679, 688, 720, 673, 0, 0, 0, 0, 0, 0,
-	1764, 0, 653, 0, 697, 0, 0, 0, 632, 625,
-	0, 0, 0, 0, 677, 0, 0, 0, 635, 0,
-	654, 721, 0, 619, 247, 629, 303, 0, 725, 735,
-	674, 427, 739, 672, 671, 716, 633, 731, 665, 274,
-	631, 271, 171, 187, 0, 663, 313, 352, 358, 730,
-	650, 658, 210, 656, 356, 327, 412, 194, 237, 349,
-	332, 354, 696, 714, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 932, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 645, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 726, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 718, 756, 326, 357, 200, 414, 377, 640,
-	644, 638, 639, 690, 691, 641, 747, 748, 749, 722,
-	634, 0, 642, 643, 0, 728, 737, 738, 695, 170,
-	184, 277, 752, 346, 240, 440, 421, 417, 620, 637,
-	216, 648, 0, 0, 660, 668, 669, 681, 683, 684,
-	685, 686, 694, 702, 703, 705, 713, 715, 717, 719,
-	724, 734, 755, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 693, 700, 287, 234, 252, 262,
-	708, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 741,
-	727, 393, 0, 676, 744, 647, 664, 754, 667, 670,
-	710, 626, 689, 317, 661, 0, 651, 622, 657, 623,
-	649, 678, 224, 646, 729, 692, 743, 275, 221, 628,
-	652, 331, 666, 176, 712, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 750,
-	279, 699, 0, 378, 302, 0, 0, 0, 680, 733,
-	687, 723, 675, 711, 636, 698, 745, 662, 707, 746,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
+	205, 712, 749, 667, 714, 220, 264, 227, 219, 398,
+	760, 741, 0, 191, 370, 0, 751, 690, 717, 766,
+	629, 709, 0, 632, 635, 762, 745, 663, 230, 0,
+	0, 0, 0, 0, 0, 0, 687, 696, 729, 681,
+	0, 0, 0, 0, 0, 0, 0, 0, 661, 0,
+	705, 0, 0, 0, 640, 633, 0, 0, 0, 0,
+	685, 0, 0, 0, 643, 0, 662, 730, 0, 627,
+	248, 637, 304, 0, 734, 744, 682, 430, 748, 680,
+	679, 724, 641, 740, 673, 275, 639, 272, 171, 187,
+	0, 671, 314, 353, 359, 739, 658, 666, 211, 664,
+	357, 328, 415, 194, 238, 350, 333, 355, 704, 722,
+	356, 281, 403, 345, 413, 431, 432, 218, 308, 421,
+	394, 427, 442, 188, 215, 322, 387, 418, 378, 301,
+	399, 400, 271, 377, 246, 174, 279, 439, 186, 365,
+	202, 179, 389, 411, 199, 368, 0, 0, 444, 181,
+	409, 386, 298, 268, 269, 180, 0, 349, 223, 244,
+	213, 317, 406, 407, 212, 445, 190, 426, 183, 941,
+	425, 310, 402, 410, 299, 290, 182, 408, 297, 289,
+	274, 234, 255, 343, 284, 344, 256, 306, 305, 307,
+	0, 177, 0, 383, 419, 446, 195, 196, 197, 653,
+	233, 237, 243, 245, 251, 252, 259, 277, 321, 342,
+	340, 346, 735, 397, 414, 422, 429, 435, 436, 440,
+	437, 438, 441, 309, 258, 379, 273, 282, 727, 765,
+	327, 358, 200, 417, 380, 648, 652, 646, 647, 698,
+	699, 649, 756, 757, 758, 731, 642, 0, 650, 651,
+	0, 737, 746, 747, 703, 170, 184, 278, 761, 347,
+	241, 443, 424, 420, 628, 645, 217, 656, 0, 0,
+	668, 676, 677, 689, 691, 692, 693, 694, 702, 710,
+	711, 713, 721, 723, 726, 728, 733, 743, 764, 209,
+	725, 369, 172, 173, 185, 193, 203, 216, 231, 239,
+	249, 254, 257, 261, 262, 265, 270, 287, 292, 293,
+	294, 295, 311, 312, 313, 316, 319, 320, 323, 325,
+	326, 329, 335, 336, 337, 338, 339, 341, 348, 352,
+	360, 361, 362, 363, 364, 366, 367, 373, 374, 375,
+	376, 384, 388, 404, 405, 416, 428, 433, 250, 412,
+	434, 0, 286, 701, 708, 288, 235, 253, 263, 716,
+	423, 385, 189, 354, 242, 178, 206, 192, 214, 229,
+	232, 267, 296, 302, 331, 334, 247, 226, 204, 351,
+	201, 371, 391, 392, 393, 395, 300, 221, 750, 736,
+	396, 0, 684, 753, 655, 672, 763, 675, 678, 718,
+	634, 697, 318, 669, 0, 659, 630, 665, 631, 657,
+	686, 225, 654, 738, 700, 752, 276, 222, 636, 660,
+	332, 674, 176, 720, 372, 210, 285, 283, 401, 236,
+	228, 224, 208, 260, 291, 330, 390, 324, 759, 280,
+	707, 0, 381, 303, 0, 0, 0, 688, 742, 695,
+	732, 683, 719, 644, 706, 754, 670, 715, 755, 266,
+	207, 175, 315, 382, 240, 0, 0, 0, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
+	0, 205, 712, 749, 667, 714, 220, 264, 227, 219,
+	398, 760, 741, 0, 191, 370, 0, 751, 690, 717,
+	766, 629, 709, 0, 632, 635, 762, 745, 663, 230,
+	0, 0, 0, 0, 0, 0, 0, 687, 696, 729,
+	681, 0, 0, 0, 0, 0, 0, 0, 0, 661,
+	0, 705, 0, 0, 0, 640, 633, 0, 0, 0,
+	0, 685, 0, 0, 0, 643, 0, 662, 730, 0,
+	627, 248, 637, 304, 0, 734, 744, 682, 430, 748,
+	680, 679, 724, 641, 740, 673, 275, 639, 272, 171,
+	187, 0, 671, 314, 353, 359, 739, 658, 666, 211,
+	664, 357, 328, 415, 194, 238, 350, 333, 355, 704,
+	722, 356, 281, 403, 345, 413, 431, 432, 218, 308,
+	421, 394, 427, 442, 188, 215, 322, 387, 418, 378,
+	301, 399, 400, 271, 377, 246, 174, 279, 439, 186,
+	365, 202, 179, 389, 411, 199, 368, 0, 0, 444,
+	181, 409, 386, 298, 268, 269, 180, 0, 349, 223,
+	244, 213, 317, 406, 407, 212, 445, 190, 426, 183,
+	941, 425, 310, 402, 410, 299, 290, 182, 408, 297,
+	289, 274, 234, 255, 343, 284, 344, 256, 306, 305,
+	307, 0, 177, 0, 383, 419, 446, 195, 196, 197,
+	653, 233, 237, 243, 245, 251, 252, 259, 277, 321,
+	342, 340, 346, 735, 397, 414, 422, 429, 435, 436,
+	440, 437, 438, 441, 309, 258, 379, 273, 282, 727,
+	765, 327, 358, 200, 417, 380, 648, 652, 646, 647,
+	698, 699, 649, 756, 757, 758, 731, 642, 0, 650,
+	651, 0, 737, 746, 747, 703, 170, 184, 278, 761,
+	347, 241, 443, 424, 420, 628, 645, 217, 656, 0,
+	0, 668, 676, 677, 689, 691, 692, 693, 694, 702,
+	710, 711, 713, 721, 723, 726, 728, 733, 743, 764,
+	209, 725, 369, 172, 173, 185, 193, 203, 216, 231,
+	239, 249, 254, 257, 261, 262, 265, 270, 287, 292,
+	293, 294, 295, 311, 312, 313, 316, 319, 320, 323,
+	325, 326, 329, 335, 336, 337, 338, 339, 341, 348,
+	352, 360, 361, 362, 363, 364, 366, 367, 373, 374,
+	375, 376, 384, 388, 404, 405, 416, 428, 433, 250,
+	412, 434, 0, 286, 701, 708, 288, 235, 253, 263,
+	716, 423, 385, 189, 354, 242, 178, 206, 192, 214,
+	229, 232, 267, 296, 302, 331, 334, 247, 226, 204,
+	351, 201, 371, 391, 392, 393, 395, 300, 221, 750,
+	736, 396, 0, 684, 753, 655, 672, 763, 675, 678,
+	718, 634, 697, 318, 669, 0, 659, 630, 665, 631,
+	657, 686, 225, 654, 738, 700, 752, 276, 222, 636,
+	660, 332, 674, 176, 720, 372, 210, 285, 283, 401,
+	236, 228, 224, 208, 260, 291, 330, 390, 324, 759,
+	280, 707, 0, 381, 303, 0, 0, 0, 688, 742,
+	695, 732, 683, 719, 644, 706, 754, 670, 715, 755,
+	266, 207, 175, 315, 382, 240, 0, 0, 0, 167,
 	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 704, 740, 659, 706, 219, 263, 226,
-	218, 395, 751, 732, 0, 191, 742, 682, 709, 757,
-	621, 701, 0, 624, 627, 753, 736, 655, 229, 0,
-	0, 0, 0, 0, 0, 0, 679, 688, 720, 673,
-	0, 0, 0, 0, 0, 0, 1473, 0, 653, 0,
-	697, 0, 0, 0, 632, 625, 0, 0, 0, 0,
-	677, 0, 0, 0, 635, 0, 654, 721, 0, 619,
-	247, 629, 303, 0, 725, 735, 674, 427, 739, 672,
-	671, 716, 633, 731, 665, 274, 631, 271, 171, 187,
-	0, 663, 313, 352, 358, 730, 650, 658, 210, 656,
-	356, 327, 412, 194, 237, 349, 332, 354, 696, 714,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 932,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 645,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 726, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 718, 756,
-	326, 357, 200, 414, 377, 640, 644, 638, 639, 690,
-	691, 641, 747, 748, 749, 722, 634, 0, 642, 643,
-	0, 728, 737, 738, 695, 170, 184, 277, 752, 346,
-	240, 440, 421, 417, 620, 637, 216, 648, 0, 0,
-	660, 668, 669, 681, 683, 684, 685, 686, 694, 702,
-	703, 705, 713, 715, 717, 719, 724, 734, 755, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	693, 700, 287, 234, 252, 262, 708, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 741, 727, 393, 0, 676,
-	744, 647, 664, 754, 667, 670, 710, 626, 689, 317,
-	661, 0, 651, 622, 657, 623, 649, 678, 224, 646,
-	729, 692, 743, 275, 221, 628, 652, 331, 666, 176,
-	712, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 750, 279, 699, 0, 378,
-	302, 0, 0, 0, 680, 733, 687, 723, 675, 711,
-	636, 698, 745, 662, 707, 746, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 704,
-	740, 659, 706, 219, 263, 226, 218, 395, 751, 732,
-	0, 191, 742, 682, 709, 757, 621, 701, 0, 624,
-	627, 753, 736, 655, 229, 0, 0, 0, 0, 0,
-	0, 0, 679, 688, 720, 673, 0, 0, 0, 0,
-	0, 0, 0, 0, 653, 0, 697, 0, 0, 0,
-	632, 625, 0, 0, 0, 0, 677, 0, 0, 0,
-	635, 0, 654, 721, 0, 619, 247, 629, 303, 0,
-	725, 735, 674, 427, 739, 672, 671, 716, 633, 731,
-	665, 274, 631, 271, 171, 187, 0, 663, 313, 352,
-	358, 730, 650, 658, 210, 656, 356, 327, 412, 194,
-	237, 349, 332, 354, 696, 714, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 932, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 645, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 726, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 718, 756, 326, 357, 200, 414,
-	377, 640, 644, 638, 639, 690, 691, 641, 747, 748,
-	749, 722, 634, 0, 642, 643, 0, 728, 737, 738,
-	695, 170, 184, 277, 752, 346, 240, 440, 421, 417,
-	620, 637, 216, 648, 0, 0, 660, 668, 669, 681,
-	683, 684, 685, 686, 694, 702, 703, 705, 713, 715,
-	717, 719, 724, 734, 755, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 693, 700, 287, 234,
-	252, 262, 708, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 741, 727, 393, 0, 676, 744, 647, 664, 754,
-	667, 670, 710, 626, 689, 317, 661, 0, 651, 622,
-	657, 623, 649, 678, 224, 646, 729, 692, 743, 275,
-	221, 628, 652, 331, 666, 176, 712, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 750, 279, 699, 0, 378, 302, 0, 0, 0,
-	680, 733, 687, 723, 675, 711, 636, 698, 745, 662,
-	707, 746, 265, 207, 175, 314, 379, 239, 0, 0,
+	198, 0, 205, 712, 749, 667, 714, 220, 264, 227,
+	219, 398, 760, 741, 0, 767, 370, 0, 751, 690,
+	717, 766, 629, 709, 0, 632, 635, 762, 745, 663,
+	230, 0, 0, 0, 0, 0, 0, 0, 687, 696,
+	729, 681, 0, 0, 0, 0, 0, 0, 0, 0,
+	661, 0, 705, 0, 0, 0, 640, 633, 0, 0,
+	0, 0, 685, 0, 0, 0, 643, 0, 662, 730,
+	0, 627, 248, 637, 304, 0, 734, 744, 682, 430,
+	748, 680, 679, 724, 641, 740, 673, 275, 639, 272,
+	171, 187, 0, 671, 314, 353, 359, 739, 658, 666,
+	211, 664, 357, 328, 415, 194, 238, 350, 333, 355,
+	704, 722, 356, 281, 403, 345, 413, 431, 432, 218,
+	308, 421, 394, 427, 442, 188, 215, 322, 387, 418,
+	378, 301, 399, 400, 271, 377, 246, 174, 279, 439,
+	186, 365, 202, 179, 389, 411, 199, 368, 0, 0,
+	444, 181, 409, 386, 298, 268, 269, 180, 0, 349,
+	223, 244, 213, 317, 406, 407, 212, 445, 190, 426,
+	183, 638, 425, 310, 402, 410, 299, 290, 182, 408,
+	297, 289, 274, 234, 255, 343, 284, 344, 256, 306,
+	305, 307, 0, 177, 0, 383, 419, 446, 195, 196,
+	197, 653, 233, 237, 243, 245, 251, 252, 259, 277,
+	321, 342, 340, 346, 735, 397, 414, 422, 429, 435,
+	436, 440, 437, 438, 441, 626, 620, 619, 273, 282,
+	727, 765, 327, 358, 200, 417, 380, 648, 652, 646,
+	647, 698, 699, 649, 756, 757, 758, 731, 642, 0,
+	650, 651, 0, 737, 746, 747, 703, 170, 184, 278,
+	761, 347, 241, 443, 424, 420, 628, 645, 217, 656,
+	0, 0, 668, 676, 677, 689, 691, 692, 693, 694,
+	702, 710, 711, 713, 721, 723, 726, 728, 733, 743,
+	764, 209, 725, 369, 172, 173, 185, 193, 203, 216,
+	231, 239, 249, 254, 257, 261, 262, 265, 270, 287,
+	292, 293, 294, 295, 311, 312, 313, 316, 319, 320,
+	323, 325, 326, 329, 335, 336, 337, 338, 339, 341,
+	348, 352, 360, 361, 362, 363, 364, 366, 367, 373,
+	374, 375, 376, 384, 388, 404, 405, 416, 428, 433,
+	250, 412, 434, 0, 286, 701, 708, 288, 235, 253,
+	263, 716, 423, 385, 189, 354, 242, 178, 206, 192,
+	214, 229, 232, 267, 296, 302, 331, 334, 247, 226,
+	204, 351, 201, 371, 391, 392, 393, 395, 300, 221,
+	750, 736, 396, 0, 684, 753, 655, 672, 763, 675,
+	678, 718, 634, 697, 318, 669, 0, 659, 630, 665,
+	631, 657, 686, 225, 654, 738, 700, 752, 276, 222,
+	636, 660, 332, 674, 176, 720, 372, 210, 285, 283,
+	401, 236, 228, 224, 208, 260, 291, 330, 390, 324,
+	759, 280, 707, 0, 381, 303, 0, 0, 0, 688,
+	742, 695, 732, 683, 719, 644, 706, 754, 670, 715,
+	755, 266, 207, 175, 315, 382, 240, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 712, 749, 667, 714, 220, 264,
+	227, 219, 398, 760, 741, 0, 767, 370, 0, 751,
+	690, 717, 766, 629, 709, 0, 632, 635, 762, 745,
+	663, 230, 0, 0, 0, 0, 0, 0, 0, 687,
+	696, 729, 681, 0, 0, 0, 0, 0, 0, 0,
+	0, 661, 0, 705, 0, 0, 0, 640, 633, 0,
+	0, 0, 0, 685, 0, 0, 0, 643, 0, 662,
+	730, 0, 627, 248, 637, 304, 0, 734, 744, 682,
+	430, 748, 680, 679, 724, 641, 740, 673, 275, 639,
+	272, 171, 187, 0, 671, 314, 353, 359, 739, 658,
+	666, 211, 664, 357, 328, 415, 194, 238, 350, 333,
+	355, 704, 722, 356, 281, 403, 345, 413, 431, 432,
+	218, 308, 421, 394, 427, 442, 188, 215, 322, 387,
+	418, 378, 301, 399, 400, 271, 377, 246, 174, 279,
+	439, 186, 365, 202, 179, 389, 1126, 199, 368, 0,
+	0, 444, 181, 409, 386, 298, 268, 269, 180, 0,
+	349, 223, 244, 213, 317, 406, 407, 212, 445, 190,
+	426, 183, 638, 425, 310, 402, 410, 299, 290, 182,
+	408, 297, 289, 274, 234, 255, 343, 284, 344, 256,
+	306, 305, 307, 0, 177, 0, 383, 419, 446, 195,
+	196, 197, 653, 233, 237, 243, 245, 251, 252, 259,
+	277, 321, 342, 340, 346, 735, 397, 414, 422, 429,
+	435, 436, 440, 437, 438, 441, 626, 620, 619, 273,
+	282, 727, 765, 327, 358, 200, 417, 380, 648, 652,
+	646, 647, 698, 699, 649, 756, 757, 758, 731, 642,
+	0, 650, 651, 0, 737, 746, 747, 703, 170, 184,
+	278, 761, 347, 241, 443, 424, 420, 628, 645, 217,
+	656, 0, 0, 668, 676, 677, 689, 691, 692, 693,
+	694, 702, 710, 711, 713, 721, 723, 726, 728, 733,
+	743, 764, 209, 725, 369, 172, 173, 185, 193, 203,
+	216, 231, 239, 249, 254, 257, 261, 262, 265, 270,
+	287, 292, 293, 294, 295, 311, 312, 313, 316, 319,
+	320, 323, 325, 326, 329, 335, 336, 337, 338, 339,
+	341, 348, 352, 360, 361, 362, 363, 364, 366, 367,
+	373, 374, 375, 376, 384, 388, 404, 405, 416, 428,
+	433, 250, 412, 434, 0, 286, 701, 708, 288, 235,
+	253, 263, 716, 423, 385, 189, 354, 242, 178, 206,
+	192, 214, 229, 232, 267, 296, 302, 331, 334, 247,
+	226, 204, 351, 201, 371, 391, 392, 393, 395, 300,
+	221, 750, 736, 396, 0, 684, 753, 655, 672, 763,
+	675, 678, 718, 634, 697, 318, 669, 0, 659, 630,
+	665, 631, 657, 686, 225, 654, 738, 700, 752, 276,
+	222, 636, 660, 332, 674, 176, 720, 372, 210, 285,
+	283, 401, 236, 228, 224, 208, 260, 291, 330, 390,
+	324, 759, 280, 707, 0, 381, 303, 0, 0, 0,
+	688, 742, 695, 732, 683, 719, 644, 706, 754, 670,
+	715, 755, 266, 207, 175, 315, 382, 240, 0, 0,
 	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 704, 740, 659, 706, 219,
-	263, 226, 218, 395, 751, 732, 0, 191, 742, 682,
-	709, 757, 621, 701, 0, 624, 627, 753, 736, 655,
-	229, 0, 0, 0, 0, 0, 0, 0, 679, 688,
-	720, 673, 0, 0, 0, 0, 0, 0, 0, 0,
-	653, 0, 697, 0, 0, 0, 632, 625, 0, 0,
-	0, 0, 677, 0, 0, 0, 635, 0, 654, 721,
-	0, 619, 247, 629, 303, 0, 725, 735, 674, 427,
-	739, 672, 671, 716, 633, 731, 665, 274, 631, 271,
-	171, 187, 0, 663, 313, 352, 358, 730, 650, 658,
-	210, 656, 356, 327, 412, 194, 237, 349, 332, 354,
-	696, 714, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 932, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 645, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 726, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	718, 756, 326, 357, 200, 414, 377, 640, 644, 638,
-	639, 690, 691, 641, 747, 748, 749, 722, 634, 0,
-	642, 643, 0, 728, 737, 738, 695, 170, 184, 277,
-	752, 346, 240, 440, 421, 417, 620, 637, 216, 648,
-	0, 0, 660, 668, 669, 681, 683, 684, 685, 686,
-	694, 702, 703, 705, 713, 715, 717, 719, 724, 734,
-	755, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 693, 700, 287, 234, 252, 262, 708, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 741, 727, 393,
-	0, 676, 744, 647, 664, 754, 667, 670, 710, 626,
-	689, 317, 661, 0, 651, 622, 657, 623, 649, 678,
-	224, 646, 729, 692, 743, 275, 221, 628, 652, 331,
-	666, 176, 712, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 750, 279, 699,
-	0, 378, 302, 0, 0, 0, 680, 733, 687, 723,
-	675, 711, 636, 698, 745, 662, 707, 746, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 704, 740, 659, 706, 219, 263, 226, 218, 395,
-	751, 732, 0, 758, 742, 682, 709, 757, 621, 701,
-	0, 624, 627, 753, 736, 655, 229, 0, 0, 0,
-	0, 0, 0, 0, 679, 688, 720, 673, 0, 0,
-	0, 0, 0, 0, 0, 0, 653, 0, 697, 0,
-	0, 0, 632, 625, 0, 0, 0, 0, 677, 0,
-	0, 0, 635, 0, 654, 721, 0, 619, 247, 629,
-	303, 0, 725, 735, 674, 427, 739, 672, 671, 716,
-	633, 731, 665, 274, 631, 271, 171, 187, 0, 663,
-	313, 352, 358, 730, 650, 658, 210, 656, 356, 327,
-	412, 194, 237, 349, 332, 354, 696, 714, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 630, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 645, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	726, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 618, 612, 611, 272, 281, 718, 756, 326, 357,
-	200, 414, 377, 640, 644, 638, 639, 690, 691, 641,
-	747, 748, 749, 722, 634, 0, 642, 643, 0, 728,
-	737, 738, 695, 170, 184, 277, 752, 346, 240, 440,
-	421, 417, 620, 637, 216, 648, 0, 0, 660, 668,
-	669, 681, 683, 684, 685, 686, 694, 702, 703, 705,
-	713, 715, 717, 719, 724, 734, 755, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 693, 700,
-	287, 234, 252, 262, 708, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 741, 727, 393, 0, 676, 744, 647,
-	664, 754, 667, 670, 710, 626, 689, 317, 661, 0,
-	651, 622, 657, 623, 649, 678, 224, 646, 729, 692,
-	743, 275, 221, 628, 652, 331, 666, 176, 712, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 750, 279, 699, 0, 378, 302, 0,
-	0, 0, 680, 733, 687, 723, 675, 711, 636, 698,
-	745, 662, 707, 746, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 704, 740, 659,
-	706, 219, 263, 226, 218, 395, 751, 732, 0, 758,
-	742, 682, 709, 757, 621, 701, 0, 624, 627, 753,
-	736, 655, 229, 0, 0, 0, 0, 0, 0, 0,
-	679, 688, 720, 673, 0, 0, 0, 0, 0, 0,
-	0, 0, 653, 0, 697, 0, 0, 0, 632, 625,
-	0, 0, 0, 0, 677, 0, 0, 0, 635, 0,
-	654, 721, 0, 619, 247, 629, 303, 0, 725, 735,
-	674, 427, 739, 672, 671, 716, 633, 731, 665, 274,
-	631, 271, 171, 187, 0, 663, 313, 352, 358, 730,
-	650, 658, 210, 656, 356, 327, 412, 194, 237, 349,
-	332, 354, 696, 714, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 1100, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 630, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 645, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 726, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 618, 612, 611,
-	272, 281, 718, 756, 326, 357, 200, 414, 377, 640,
-	644, 638, 639, 690, 691, 641, 747, 748, 749, 722,
-	634, 0, 642, 643, 0, 728, 737, 738, 695, 170,
-	184, 277, 752, 346, 240, 440, 421, 417, 620, 637,
-	216, 648, 0, 0, 660, 668, 669, 681, 683, 684,
-	685, 686, 694, 702, 703, 705, 713, 715, 717, 719,
-	724, 734, 755, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 693, 700, 287, 234, 252, 262,
-	708, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 741,
-	727, 393, 0, 676, 744, 647, 664, 754, 667, 670,
-	710, 626, 689, 317, 661, 0, 651, 622, 657, 623,
-	649, 678, 224, 646, 729, 692, 743, 275, 221, 628,
-	652, 331, 666, 176, 712, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 750,
-	279, 699, 0, 378, 302, 0, 0, 0, 680, 733,
-	687, 723, 675, 711, 636, 698, 745, 662, 707, 746,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 704, 740, 659, 706, 219, 263, 226,
-	218, 395, 751, 732, 0, 758, 742, 682, 709, 757,
-	621, 701, 0, 624, 627, 753, 736, 655, 229, 0,
-	0, 0, 0, 0, 0, 0, 679, 688, 720, 673,
-	0, 0, 0, 0, 0, 0, 0, 0, 653, 0,
-	697, 0, 0, 0, 632, 625, 0, 0, 0, 0,
-	677, 0, 0, 0, 635, 0, 654, 721, 0, 619,
-	247, 629, 303, 0, 725, 735, 674, 427, 739, 672,
-	671, 716, 633, 731, 665, 274, 631, 271, 171, 187,
-	0, 663, 313, 352, 358, 730, 650, 658, 210, 656,
-	356, 327, 412, 194, 237, 349, 332, 354, 696, 714,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 609, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 630,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 645,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 726, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 618, 612, 611, 272, 281, 718, 756,
-	326, 357, 200, 414, 377, 640, 644, 638, 639, 690,
-	691, 641, 747, 748, 749, 722, 634, 0, 642, 643,
-	0, 728, 737, 738, 695, 170, 184, 277, 752, 346,
-	240, 440, 421, 417, 620, 637, 216, 648, 0, 0,
-	660, 668, 669, 681, 683, 684, 685, 686, 694, 702,
-	703, 705, 713, 715, 717, 719, 724, 734, 755, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	693, 700, 287, 234, 252, 262, 708, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	1401, 0, 509, 0, 0, 0, 224, 508, 0, 0,
-	0, 275, 221, 0, 1402, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 552, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 543, 544, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	71, 0, 0, 167, 168, 169, 530, 529, 532, 533,
-	534, 535, 0, 0, 198, 531, 205, 536, 537, 538,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 506, 523, 0, 551, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 520, 521,
-	599, 0, 0, 0, 567, 0, 522, 0, 0, 515,
-	516, 518, 517, 519, 524, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 566, 0,
-	0, 427, 0, 0, 564, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 554,
-	565, 560, 561, 558, 559, 553, 557, 556, 555, 568,
-	545, 546, 547, 548, 550, 0, 562, 563, 549, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 509, 0, 0, 0,
-	224, 508, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 552, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 543, 544,
-	0, 0, 0, 0, 0, 0, 1512, 0, 265, 207,
-	175, 314, 379, 239, 71, 0, 0, 167, 168, 169,
-	530, 529, 532, 533, 534, 535, 0, 0, 198, 531,
-	205, 536, 537, 538, 1513, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 506,
-	523, 0, 551, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 520, 521, 0, 0, 0, 0, 567, 0,
-	522, 0, 0, 515, 516, 518, 517, 519, 524, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 566, 0, 0, 427, 0, 0, 564, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 554, 565, 560, 561, 558, 559, 553,
-	557, 556, 555, 568, 545, 546, 547, 548, 550, 0,
-	562, 563, 549, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	509, 0, 0, 0, 224, 508, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 552, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 543, 544, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	586, 167, 168, 169, 530, 529, 532, 533, 534, 535,
-	0, 0, 198, 531, 205, 536, 537, 538, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 506, 523, 0, 551, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 520, 521, 0, 0,
-	0, 0, 567, 0, 522, 0, 0, 515, 516, 518,
-	517, 519, 524, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 566, 0, 0, 427,
-	0, 0, 564, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 554, 565, 560,
-	561, 558, 559, 553, 557, 556, 555, 568, 545, 546,
-	547, 548, 550, 0, 562, 563, 549, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 509, 0, 0, 0, 224, 508,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 552, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 543, 544, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 530, 529,
-	532, 533, 534, 535, 0, 0, 198, 531, 205, 536,
-	537, 538, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 506, 523, 0,
-	551, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	520, 521, 599, 0, 0, 0, 567, 0, 522, 0,
-	0, 515, 516, 518, 517, 519, 524, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	566, 0, 0, 427, 0, 0, 564, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 554, 565, 560, 561, 558, 559, 553, 557, 556,
-	555, 568, 545, 546, 547, 548, 550, 0, 562, 563,
-	549, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 509, 0,
-	0, 0, 224, 508, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 552,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	543, 544, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 71, 0, 0, 167,
-	168, 169, 530, 1419, 532, 533, 534, 535, 0, 0,
-	198, 531, 205, 536, 537, 538, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 506, 523, 0, 551, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 520, 521, 599, 0, 0, 0,
-	567, 0, 522, 0, 0, 515, 516, 518, 517, 519,
-	524, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 566, 0, 0, 427, 0, 0,
-	564, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 554, 565, 560, 561, 558,
-	559, 553, 557, 556, 555, 568, 545, 546, 547, 548,
-	550, 0, 562, 563, 549, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 509, 0, 0, 0, 224, 508, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 552, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 543, 544, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	71, 0, 0, 167, 168, 169, 530, 1416, 532, 533,
-	534, 535, 0, 0, 198, 531, 205, 536, 537, 538,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 506, 523, 0, 551, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 520, 521,
-	599, 0, 0, 0, 567, 0, 522, 0, 0, 515,
-	516, 518, 517, 519, 524, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 566, 0,
-	0, 427, 0, 0, 564, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 554,
-	565, 560, 561, 558, 559, 553, 557, 556, 555, 568,
-	545, 546, 547, 548, 550, 0, 562, 563, 549, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 579,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 509, 0, 0,
-	0, 224, 508, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 552, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 543,
-	544, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 71, 0, 0, 167, 168,
-	169, 530, 529, 532, 533, 534, 535, 0, 0, 198,
-	531, 205, 536, 537, 538, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	506, 523, 0, 551, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 520, 521, 0, 0, 0, 0, 567,
-	0, 522, 0, 0, 515, 516, 518, 517, 519, 524,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 566, 0, 0, 427, 0, 0, 564,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 554, 565, 560, 561, 558, 559,
-	553, 557, 556, 555, 568, 545, 546, 547, 548, 550,
-	0, 562, 563, 549, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 509, 0, 0, 0, 224, 508, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 552, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 543, 544, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
-	0, 0, 167, 168, 169, 530, 529, 532, 533, 534,
-	535, 0, 0, 198, 531, 205, 536, 537, 538, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 506, 523, 0, 551, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 520, 521, 0,
-	0, 0, 0, 567, 0, 522, 0, 0, 515, 516,
-	518, 517, 519, 524, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 566, 0, 0,
-	427, 0, 0, 564, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 554, 565,
-	560, 561, 558, 559, 553, 557, 556, 555, 568, 545,
-	546, 547, 548, 550, 0, 562, 563, 549, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 552, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 543, 544, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 71, 0, 0, 167, 168, 169, 530,
-	529, 532, 533, 534, 535, 0, 0, 198, 531, 205,
-	536, 537, 538, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 523,
-	0, 551, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 520, 521, 0, 0, 0, 0, 567, 0, 522,
-	0, 0, 515, 516, 518, 517, 519, 524, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 566, 0, 0, 427, 0, 0, 564, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 2237, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 554, 565, 560, 561, 558, 559, 553, 557,
-	556, 555, 568, 545, 546, 547, 548, 550, 0, 562,
-	563, 549, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	552, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 543, 544, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 71, 0, 586,
-	167, 168, 169, 530, 529, 532, 533, 534, 535, 0,
-	0, 198, 531, 205, 536, 537, 538, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 523, 0, 551, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 520, 521, 0, 0, 0,
-	0, 567, 0, 522, 0, 0, 515, 516, 518, 517,
-	519, 524, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 566, 0, 0, 427, 0,
-	0, 564, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 554, 565, 560, 561,
-	558, 559, 553, 557, 556, 555, 568, 545, 546, 547,
-	548, 550, 0, 562, 563, 549, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 552, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 543, 544, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 71, 0, 0, 167, 168, 169, 530, 529, 532,
-	533, 534, 535, 0, 0, 198, 531, 205, 536, 537,
-	538, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 523, 0, 551,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 520,
-	521, 0, 0, 0, 0, 567, 0, 522, 0, 0,
-	515, 516, 518, 517, 519, 524, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 566,
-	0, 0, 427, 0, 0, 564, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	554, 565, 560, 561, 558, 559, 553, 557, 556, 555,
-	568, 545, 546, 547, 548, 550, 0, 562, 563, 549,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 975,
-	974, 984, 985, 977, 978, 979, 980, 981, 982, 983,
-	976, 0, 0, 986, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	804, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 803,
-	427, 0, 0, 0, 0, 0, 800, 801, 274, 766,
-	271, 171, 187, 794, 798, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
+	0, 0, 198, 0, 205, 712, 749, 667, 714, 220,
+	264, 227, 219, 398, 760, 741, 0, 767, 370, 0,
+	751, 690, 717, 766, 629, 709, 0, 632, 635, 762,
+	745, 663, 230, 0, 0, 0, 0, 0, 0, 0,
+	687, 696, 729, 681, 0, 0, 0, 0, 0, 0,
+	0, 0, 661, 0, 705, 0, 0, 0, 640, 633,
+	0, 0, 0, 0, 685, 0, 0, 0, 643, 0,
+	662, 730, 0, 627, 248, 637, 304, 0, 734, 744,
+	682, 430, 748, 680, 679, 724, 641, 740, 673, 275,
+	639, 272, 171, 187, 0, 671, 314, 353, 359, 739,
+	658, 666, 211, 664, 357, 328, 415, 194, 238, 350,
+	333, 355, 704, 722, 356, 281, 403, 345, 413, 431,
+	432, 218, 308, 421, 394, 427, 442, 188, 215, 322,
+	387, 418, 378, 301, 399, 400, 271, 377, 246, 174,
+	279, 439, 186, 365, 202, 179, 389, 617, 199, 368,
+	0, 0, 444, 181, 409, 386, 298, 268, 269, 180,
+	0, 349, 223, 244, 213, 317, 406, 407, 212, 445,
+	190, 426, 183, 638, 425, 310, 402, 410, 299, 290,
+	182, 408, 297, 289, 274, 234, 255, 343, 284, 344,
+	256, 306, 305, 307, 0, 177, 0, 383, 419, 446,
+	195, 196, 197, 653, 233, 237, 243, 245, 251, 252,
+	259, 277, 321, 342, 340, 346, 735, 397, 414, 422,
+	429, 435, 436, 440, 437, 438, 441, 626, 620, 619,
+	273, 282, 727, 765, 327, 358, 200, 417, 380, 648,
+	652, 646, 647, 698, 699, 649, 756, 757, 758, 731,
+	642, 0, 650, 651, 0, 737, 746, 747, 703, 170,
+	184, 278, 761, 347, 241, 443, 424, 420, 628, 645,
+	217, 656, 0, 0, 668, 676, 677, 689, 691, 692,
+	693, 694, 702, 710, 711, 713, 721, 723, 726, 728,
+	733, 743, 764, 209, 725, 369, 172, 173, 185, 193,
+	203, 216, 231, 239, 249, 254, 257, 261, 262, 265,
+	270, 287, 292, 293, 294, 295, 311, 312, 313, 316,
+	319, 320, 323, 325, 326, 329, 335, 336, 337, 338,
+	339, 341, 348, 352, 360, 361, 362, 363, 364, 366,
+	367, 373, 374, 375, 376, 384, 388, 404, 405, 416,
+	428, 433, 250, 412, 434, 0, 286, 701, 708, 288,
+	235, 253, 263, 716, 423, 385, 189, 354, 242, 178,
+	206, 192, 214, 229, 232, 267, 296, 302, 331, 334,
+	247, 226, 204, 351, 201, 371, 391, 392, 393, 395,
+	300, 221, 396, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 318, 0, 0, 1427, 0, 512,
+	0, 0, 0, 225, 511, 0, 0, 0, 276, 222,
+	0, 1428, 332, 0, 176, 0, 372, 210, 285, 283,
+	401, 236, 228, 224, 208, 260, 291, 330, 390, 324,
+	556, 280, 0, 0, 381, 303, 0, 0, 0, 0,
+	0, 547, 548, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 207, 175, 315, 382, 240, 79, 0, 0,
+	167, 168, 169, 534, 533, 536, 537, 538, 539, 0,
+	0, 198, 535, 205, 540, 541, 542, 0, 220, 264,
+	227, 219, 398, 0, 0, 0, 191, 370, 0, 0,
+	0, 0, 0, 0, 509, 526, 0, 555, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 523, 524, 606,
+	0, 0, 0, 571, 0, 525, 0, 0, 518, 519,
+	521, 520, 522, 527, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 248, 0, 304, 0, 570, 0, 0,
+	430, 0, 0, 568, 0, 0, 0, 0, 275, 0,
+	272, 171, 187, 0, 0, 314, 353, 359, 0, 0,
+	0, 211, 0, 357, 328, 415, 194, 238, 350, 333,
+	355, 0, 0, 356, 281, 403, 345, 413, 431, 432,
+	218, 308, 421, 394, 427, 442, 188, 215, 322, 387,
+	418, 378, 301, 399, 400, 271, 377, 246, 174, 279,
+	439, 186, 365, 202, 179, 389, 411, 199, 368, 0,
+	0, 444, 181, 409, 386, 298, 268, 269, 180, 0,
+	349, 223, 244, 213, 317, 406, 407, 212, 445, 190,
+	426, 183, 0, 425, 310, 402, 410, 299, 290, 182,
+	408, 297, 289, 274, 234, 255, 343, 284, 344, 256,
+	306, 305, 307, 0, 177, 0, 383, 419, 446, 195,
+	196, 197, 0, 233, 237, 243, 245, 251, 252, 259,
+	277, 321, 342, 340, 346, 0, 397, 414, 422, 429,
+	435, 436, 440, 437, 438, 441, 309, 258, 379, 273,
+	282, 0, 0, 327, 358, 200, 417, 380, 558, 569,
+	564, 565, 562, 563, 557, 561, 560, 559, 572, 549,
+	550, 551, 552, 554, 0, 566, 567, 553, 170, 184,
+	278, 0, 347, 241, 443, 424, 420, 0, 573, 217,
+	574, 0, 0, 579, 0, 0, 0, 582, 580, 0,
+	583, 0, 581, 578, 0, 0, 575, 576, 0, 577,
+	0, 0, 209, 0, 369, 172, 173, 185, 193, 203,
+	216, 231, 239, 249, 254, 257, 261, 262, 265, 270,
+	287, 292, 293, 294, 295, 311, 312, 313, 316, 319,
+	320, 323, 325, 326, 329, 335, 336, 337, 338, 339,
+	341, 348, 352, 360, 361, 362, 363, 364, 366, 367,
+	373, 374, 375, 376, 384, 388, 404, 405, 416, 428,
+	433, 250, 412, 434, 0, 286, 0, 0, 288, 235,
+	253, 263, 0, 423, 385, 189, 354, 242, 178, 206,
+	192, 214, 229, 232, 267, 296, 302, 331, 334, 247,
+	226, 204, 351, 201, 371, 391, 392, 393, 395, 300,
+	221, 396, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 318, 0, 0, 0, 0, 512, 0,
+	0, 0, 225, 511, 0, 0, 0, 276, 222, 0,
+	0, 332, 0, 176, 0, 372, 210, 285, 283, 401,
+	236, 228, 224, 208, 260, 291, 330, 390, 324, 556,
+	280, 0, 0, 381, 303, 0, 0, 0, 0, 0,
+	547, 548, 0, 0, 0, 0, 0, 0, 1549, 0,
+	266, 207, 175, 315, 382, 240, 79, 0, 0, 167,
+	168, 169, 534, 533, 536, 537, 538, 539, 0, 0,
+	198, 535, 205, 540, 541, 542, 1550, 220, 264, 227,
+	219, 398, 0, 0, 0, 191, 370, 0, 0, 0,
+	0, 0, 0, 509, 526, 0, 555, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 523, 524, 0, 0,
+	0, 0, 571, 0, 525, 0, 0, 518, 519, 521,
+	520, 522, 527, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 248, 0, 304, 0, 570, 0, 0, 430,
+	0, 0, 568, 0, 0, 0, 0, 275, 0, 272,
+	171, 187, 0, 0, 314, 353, 359, 0, 0, 0,
+	211, 0, 357, 328, 415, 194, 238, 350, 333, 355,
+	0, 0, 356, 281, 403, 345, 413, 431, 432, 218,
+	308, 421, 394, 427, 442, 188, 215, 322, 387, 418,
+	378, 301, 399, 400, 271, 377, 246, 174, 279, 439,
+	186, 365, 202, 179, 389, 411, 199, 368, 0, 0,
+	444, 181, 409, 386, 298, 268, 269, 180, 0, 349,
+	223, 244, 213, 317, 406, 407, 212, 445, 190, 426,
+	183, 0, 425, 310, 402, 410, 299, 290, 182, 408,
+	297, 289, 274, 234, 255, 343, 284, 344, 256, 306,
+	305, 307, 0, 177, 0, 383, 419, 446, 195, 196,
+	197, 0, 233, 237, 243, 245, 251, 252, 259, 277,
+	321, 342, 340, 346, 0, 397, 414, 422, 429, 435,
+	436, 440, 437, 438, 441, 309, 258, 379, 273, 282,
+	0, 0, 327, 358, 200, 417, 380, 558, 569, 564,
+	565, 562, 563, 557, 561, 560, 559, 572, 549, 550,
+	551, 552, 554, 0, 566, 567, 553, 170, 184, 278,
+	0, 347, 241, 443, 424, 420, 0, 573, 217, 574,
+	0, 0, 579, 0, 0, 0, 582, 580, 0, 583,
+	0, 581, 578, 0, 0, 575, 576, 0, 577, 0,
+	0, 209, 0, 369, 172, 173, 185, 193, 203, 216,
+	231, 239, 249, 254, 257, 261, 262, 265, 270, 287,
+	292, 293, 294, 295, 311, 312, 313, 316, 319, 320,
+	323, 325, 326, 329, 335, 336, 337, 338, 339, 341,
+	348, 352, 360, 361, 362, 363, 364, 366, 367, 373,
+	374, 375, 376, 384, 388, 404, 405, 416, 428, 433,
+	250, 412, 434, 0, 286, 0, 0, 288, 235, 253,
+	263, 0, 423, 385, 189, 354, 242, 178, 206, 192,
+	214, 229, 232, 267, 296, 302, 331, 334, 247, 226,
+	204, 351, 201, 371, 391, 392, 393, 395, 300, 221,
+	602, 396, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 318, 0, 0, 0, 0, 512, 0,
+	0, 0, 225, 511, 0, 0, 0, 276, 222, 0,
+	0, 332, 0, 176, 0, 372, 210, 285, 283, 401,
+	236, 228, 224, 208, 260, 291, 330, 390, 324, 556,
+	280, 0, 0, 381, 303, 0, 0, 0, 0, 0,
+	547, 548, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 207, 175, 315, 382, 240, 79, 0, 0, 167,
+	168, 169, 534, 533, 536, 537, 538, 539, 0, 0,
+	198, 535, 205, 540, 541, 542, 0, 220, 264, 227,
+	219, 398, 0, 0, 0, 191, 370, 0, 0, 0,
+	0, 0, 0, 509, 526, 0, 555, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 523, 524, 0, 0,
+	0, 0, 571, 0, 525, 0, 0, 518, 519, 521,
+	520, 522, 527, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 248, 0, 304, 0, 570, 0, 0, 430,
+	0, 0, 568, 0, 0, 0, 0, 275, 0, 272,
+	171, 187, 0, 0, 314, 353, 359, 0, 0, 0,
+	211, 0, 357, 328, 415, 194, 238, 350, 333, 355,
+	0, 0, 356, 281, 403, 345, 413, 431, 432, 218,
+	308, 421, 394, 427, 442, 188, 215, 322, 387, 418,
+	378, 301, 399, 400, 271, 377, 246, 174, 279, 439,
+	186, 365, 202, 179, 389, 411, 199, 368, 0, 0,
+	444, 181, 409, 386, 298, 268, 269, 180, 0, 349,
+	223, 244, 213, 317, 406, 407, 212, 445, 190, 426,
+	183, 0, 425, 310, 402, 410, 299, 290, 182, 408,
+	297, 289, 274, 234, 255, 343, 284, 344, 256, 306,
+	305, 307, 0, 177, 0, 383, 419, 446, 195, 196,
+	197, 0, 233, 237, 243, 245, 251, 252, 259, 277,
+	321, 342, 340, 346, 0, 397, 414, 422, 429, 435,
+	436, 440, 437, 438, 441, 309, 258, 379, 273, 282,
+	0, 0, 327, 358, 200, 417, 380, 558, 569, 564,
+	565, 562, 563, 557, 561, 560, 559, 572, 549, 550,
+	551, 552, 554, 0, 566, 567, 553, 170, 184, 278,
+	73, 347, 241, 443, 424, 420, 0, 573, 217, 574,
+	0, 0, 579, 0, 0, 0, 582, 580, 0, 583,
+	0, 581, 578, 0, 0, 575, 576, 0, 577, 0,
+	0, 209, 0, 369, 172, 173, 185, 193, 203, 216,
+	231, 239, 249, 254, 257, 261, 262, 265, 270, 287,
+	292, 293, 294, 295, 311, 312, 313, 316, 319, 320,
+	323, 325, 326, 329, 335, 336, 337, 338, 339, 341,
+	348, 352, 360, 361, 362, 363, 364, 366, 367, 373,
+	374, 375, 376, 384, 388, 404, 405, 416, 428, 433,
+	250, 412, 434, 0, 286, 0, 0, 288, 235, 253,
+	263, 0, 423, 385, 189, 354, 242, 178, 206, 192,
+	214, 229, 232, 267, 296, 302, 331, 334, 247, 226,
+	204, 351, 201, 371, 391, 392, 393, 395, 300, 221,
+	396, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 0, 512, 0, 0,
+	0, 225, 511, 0, 0, 0, 276, 222, 0, 0,
+	332, 0, 176, 0, 372, 210, 285, 283, 401, 236,
+	228, 224, 208, 260, 291, 330, 390, 324, 556, 280,
+	0, 0, 381, 303, 0, 0, 0, 0, 0, 547,
+	548, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	207, 175, 315, 382, 240, 79, 0, 1082, 167, 168,
+	169, 534, 533, 536, 537, 538, 539, 0, 0, 198,
+	535, 205, 540, 541, 542, 0, 220, 264, 227, 219,
+	398, 0, 0, 0, 191, 370, 0, 0, 0, 0,
+	0, 0, 509, 526, 0, 555, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 523, 524, 0, 0, 0,
+	0, 571, 0, 525, 0, 0, 518, 519, 521, 520,
+	522, 527, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 0, 304, 0, 570, 0, 0, 430, 0,
+	0, 568, 0, 0, 0, 0, 275, 0, 272, 171,
+	187, 0, 0, 314, 353, 359, 0, 0, 0, 211,
+	0, 357, 328, 415, 194, 238, 350, 333, 355, 0,
+	0, 356, 281, 403, 345, 413, 431, 432, 218, 308,
+	421, 394, 427, 442, 188, 215, 322, 387, 418, 378,
+	301, 399, 400, 271, 377, 246, 174, 279, 439, 186,
+	365, 202, 179, 389, 411, 199, 368, 0, 0, 444,
+	181, 409, 386, 298, 268, 269, 180, 0, 349, 223,
+	244, 213, 317, 406, 407, 212, 445, 190, 426, 183,
+	0, 425, 310, 402, 410, 299, 290, 182, 408, 297,
+	289, 274, 234, 255, 343, 284, 344, 256, 306, 305,
+	307, 0, 177, 0, 383, 419, 446, 195, 196, 197,
+	0, 233, 237, 243, 245, 251, 252, 259, 277, 321,
+	342, 340, 346, 0, 397, 414, 422, 429, 435, 436,
+	440, 437, 438, 441, 309, 258, 379, 273, 282, 0,
+	0, 327, 358, 200, 417, 380, 558, 569, 564, 565,
+	562, 563, 557, 561, 560, 559, 572, 549, 550, 551,
+	552, 554, 0, 566, 567, 553, 170, 184, 278, 0,
+	347, 241, 443, 424, 420, 0, 573, 217, 574, 0,
+	0, 579, 0, 0, 0, 582, 580, 0, 583, 0,
+	581, 578, 0, 0, 575, 576, 0, 577, 0, 0,
+	209, 0, 369, 172, 173, 185, 193, 203, 216, 231,
+	239, 249, 254, 257, 261, 262, 265, 270, 287, 292,
+	293, 294, 295, 311, 312, 313, 316, 319, 320, 323,
+	325, 326, 329, 335, 336, 337, 338, 339, 341, 348,
+	352, 360, 361, 362, 363, 364, 366, 367, 373, 374,
+	375, 376, 384, 388, 404, 405, 416, 428, 433, 250,
+	412, 434, 0, 286, 0, 0, 288, 235, 253, 263,
+	0, 423, 385, 189, 354, 242, 178, 206, 192, 214,
+	229, 232, 267, 296, 302, 331, 334, 247, 226, 204,
+	351, 201, 371, 391, 392, 393, 395, 300, 221, 396,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 318, 0, 0, 0, 0, 512, 0, 0, 0,
+	225, 511, 0, 0, 0, 276, 222, 0, 0, 332,
+	0, 176, 0, 372, 210, 285, 283, 401, 236, 228,
+	224, 208, 260, 291, 330, 390, 324, 556, 280, 0,
+	0, 381, 303, 0, 0, 0, 0, 0, 547, 548,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 207,
+	175, 315, 382, 240, 79, 0, 0, 167, 168, 169,
+	534, 533, 536, 537, 538, 539, 0, 0, 198, 535,
+	205, 540, 541, 542, 0, 220, 264, 227, 219, 398,
+	0, 0, 0, 191, 370, 0, 0, 0, 0, 0,
+	0, 509, 526, 0, 555, 0, 0, 0, 230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 523, 524, 606, 0, 0, 0,
+	571, 0, 525, 0, 0, 518, 519, 521, 520, 522,
+	527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	248, 0, 304, 0, 570, 0, 0, 430, 0, 0,
+	568, 0, 0, 0, 0, 275, 0, 272, 171, 187,
+	0, 0, 314, 353, 359, 0, 0, 0, 211, 0,
+	357, 328, 415, 194, 238, 350, 333, 355, 0, 0,
+	356, 281, 403, 345, 413, 431, 432, 218, 308, 421,
+	394, 427, 442, 188, 215, 322, 387, 418, 378, 301,
+	399, 400, 271, 377, 246, 174, 279, 439, 186, 365,
+	202, 179, 389, 411, 199, 368, 0, 0, 444, 181,
+	409, 386, 298, 268, 269, 180, 0, 349, 223, 244,
+	213, 317, 406, 407, 212, 445, 190, 426, 183, 0,
+	425, 310, 402, 410, 299, 290, 182, 408, 297, 289,
+	274, 234, 255, 343, 284, 344, 256, 306, 305, 307,
+	0, 177, 0, 383, 419, 446, 195, 196, 197, 0,
+	233, 237, 243, 245, 251, 252, 259, 277, 321, 342,
+	340, 346, 0, 397, 414, 422, 429, 435, 436, 440,
+	437, 438, 441, 309, 258, 379, 273, 282, 0, 0,
+	327, 358, 200, 417, 380, 558, 569, 564, 565, 562,
+	563, 557, 561, 560, 559, 572, 549, 550, 551, 552,
+	554, 0, 566, 567, 553, 170, 184, 278, 0, 347,
+	241, 443, 424, 420, 0, 573, 217, 574, 0, 0,
+	579, 0, 0, 0, 582, 580, 0, 583, 0, 581,
+	578, 0, 0, 575, 576, 0, 577, 0, 0, 209,
+	0, 369, 172, 173, 185, 193, 203, 216, 231, 239,
+	249, 254, 257, 261, 262, 265, 270, 287, 292, 293,
+	294, 295, 311, 312, 313, 316, 319, 320, 323, 325,
+	326, 329, 335, 336, 337, 338, 339, 341, 348, 352,
+	360, 361, 362, 363, 364, 366, 367, 373, 374, 375,
+	376, 384, 388, 404, 405, 416, 428, 433, 250, 412,
+	434, 0, 286, 0, 0, 288, 235, 253, 263, 0,
+	423, 385, 189, 354, 242, 178, 206, 192, 214, 229,
+	232, 267, 296, 302, 331, 334, 247, 226, 204, 351,
+	201, 371, 391, 392, 393, 395, 300, 221, 396, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 0, 512, 0, 0, 0, 225,
+	511, 0, 0, 0, 276, 222, 0, 0, 332, 0,
+	176, 0, 372, 210, 285, 283, 401, 236, 228, 224,
+	208, 260, 291, 330, 390, 324, 556, 280, 0, 0,
+	381, 303, 0, 0, 0, 0, 0, 547, 548, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 207, 175,
+	315, 382, 240, 79, 0, 0, 167, 168, 169, 534,
+	1447, 536, 537, 538, 539, 0, 0, 198, 535, 205,
+	540, 541, 542, 0, 220, 264, 227, 219, 398, 0,
+	0, 0, 191, 370, 0, 0, 0, 0, 0, 0,
+	509, 526, 0, 555, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 523, 524, 606, 0, 0, 0, 571,
+	0, 525, 0, 0, 518, 519, 521, 520, 522, 527,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 248,
+	0, 304, 0, 570, 0, 0, 430, 0, 0, 568,
+	0, 0, 0, 0, 275, 0, 272, 171, 187, 0,
+	0, 314, 353, 359, 0, 0, 0, 211, 0, 357,
+	328, 415, 194, 238, 350, 333, 355, 0, 0, 356,
+	281, 403, 345, 413, 431, 432, 218, 308, 421, 394,
+	427, 442, 188, 215, 322, 387, 418, 378, 301, 399,
+	400, 271, 377, 246, 174, 279, 439, 186, 365, 202,
+	179, 389, 411, 199, 368, 0, 0, 444, 181, 409,
+	386, 298, 268, 269, 180, 0, 349, 223, 244, 213,
+	317, 406, 407, 212, 445, 190, 426, 183, 0, 425,
+	310, 402, 410, 299, 290, 182, 408, 297, 289, 274,
+	234, 255, 343, 284, 344, 256, 306, 305, 307, 0,
+	177, 0, 383, 419, 446, 195, 196, 197, 0, 233,
+	237, 243, 245, 251, 252, 259, 277, 321, 342, 340,
+	346, 0, 397, 414, 422, 429, 435, 436, 440, 437,
+	438, 441, 309, 258, 379, 273, 282, 0, 0, 327,
+	358, 200, 417, 380, 558, 569, 564, 565, 562, 563,
+	557, 561, 560, 559, 572, 549, 550, 551, 552, 554,
+	0, 566, 567, 553, 170, 184, 278, 0, 347, 241,
+	443, 424, 420, 0, 573, 217, 574, 0, 0, 579,
+	0, 0, 0, 582, 580, 0, 583, 0, 581, 578,
+	0, 0, 575, 576, 0, 577, 0, 0, 209, 0,
+	369, 172, 173, 185, 193, 203, 216, 231, 239, 249,
+	254, 257, 261, 262, 265, 270, 287, 292, 293, 294,
+	295, 311, 312, 313, 316, 319, 320, 323, 325, 326,
+	329, 335, 336, 337, 338, 339, 341, 348, 352, 360,
+	361, 362, 363, 364, 366, 367, 373, 374, 375, 376,
+	384, 388, 404, 405, 416, 428, 433, 250, 412, 434,
+	0, 286, 0, 0, 288, 235, 253, 263, 0, 423,
+	385, 189, 354, 242, 178, 206, 192, 214, 229, 232,
+	267, 296, 302, 331, 334, 247, 226, 204, 351, 201,
+	371, 391, 392, 393, 395, 300, 221, 396, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 318,
+	0, 0, 0, 0, 512, 0, 0, 0, 225, 511,
+	0, 0, 0, 276, 222, 0, 0, 332, 0, 176,
+	0, 372, 210, 285, 283, 401, 236, 228, 224, 208,
+	260, 291, 330, 390, 324, 556, 280, 0, 0, 381,
+	303, 0, 0, 0, 0, 0, 547, 548, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 207, 175, 315,
+	382, 240, 79, 0, 0, 167, 168, 169, 534, 1444,
+	536, 537, 538, 539, 0, 0, 198, 535, 205, 540,
+	541, 542, 0, 220, 264, 227, 219, 398, 0, 0,
+	0, 191, 370, 0, 0, 0, 0, 0, 0, 509,
+	526, 0, 555, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 523, 524, 606, 0, 0, 0, 571, 0,
+	525, 0, 0, 518, 519, 521, 520, 522, 527, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	304, 0, 570, 0, 0, 430, 0, 0, 568, 0,
+	0, 0, 0, 275, 0, 272, 171, 187, 0, 0,
+	314, 353, 359, 0, 0, 0, 211, 0, 357, 328,
+	415, 194, 238, 350, 333, 355, 0, 0, 356, 281,
+	403, 345, 413, 431, 432, 218, 308, 421, 394, 427,
+	442, 188, 215, 322, 387, 418, 378, 301, 399, 400,
+	271, 377, 246, 174, 279, 439, 186, 365, 202, 179,
+	389, 411, 199, 368, 0, 0, 444, 181, 409, 386,
+	298, 268, 269, 180, 0, 349, 223, 244, 213, 317,
+	406, 407, 212, 445, 190, 426, 183, 0, 425, 310,
+	402, 410, 299, 290, 182, 408, 297, 289, 274, 234,
+	255, 343, 284, 344, 256, 306, 305, 307, 0, 177,
+	0, 383, 419, 446, 195, 196, 197, 0, 233, 237,
+	243, 245, 251, 252, 259, 277, 321, 342, 340, 346,
+	0, 397, 414, 422, 429, 435, 436, 440, 437, 438,
+	441, 309, 258, 379, 273, 282, 0, 0, 327, 358,
+	200, 417, 380, 558, 569, 564, 565, 562, 563, 557,
+	561, 560, 559, 572, 549, 550, 551, 552, 554, 0,
+	566, 567, 553, 170, 184, 278, 0, 347, 241, 443,
+	424, 420, 0, 573, 217, 574, 0, 0, 579, 0,
+	0, 0, 582, 580, 0, 583, 0, 581, 578, 0,
+	0, 575, 576, 0, 577, 0, 0, 209, 0, 369,
+	172, 173, 185, 193, 203, 216, 231, 239, 249, 254,
+	257, 261, 262, 265, 270, 287, 292, 293, 294, 295,
+	311, 312, 313, 316, 319, 320, 323, 325, 326, 329,
+	335, 336, 337, 338, 339, 341, 348, 352, 360, 361,
+	362, 363, 364, 366, 367, 373, 374, 375, 376, 384,
+	388, 404, 405, 416, 428, 433, 250, 412, 434, 0,
+	286, 0, 0, 288, 235, 253, 263, 0, 423, 385,
+	189, 354, 242, 178, 206, 192, 214, 229, 232, 267,
+	296, 302, 331, 334, 247, 226, 204, 351, 201, 371,
+	391, 392, 393, 395, 300, 221, 396, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 318, 0,
+	0, 0, 0, 512, 0, 0, 0, 225, 511, 0,
+	0, 0, 276, 222, 0, 0, 332, 0, 176, 0,
+	372, 210, 285, 283, 401, 236, 228, 224, 208, 260,
+	291, 330, 390, 324, 556, 280, 0, 0, 381, 303,
+	0, 0, 0, 0, 0, 547, 548, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 207, 175, 315, 382,
+	240, 79, 0, 0, 167, 168, 169, 534, 533, 536,
+	537, 538, 539, 0, 0, 198, 535, 205, 540, 541,
+	542, 0, 220, 264, 227, 219, 398, 0, 0, 0,
+	191, 370, 0, 0, 0, 0, 0, 0, 509, 526,
+	0, 555, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 523, 524, 0, 0, 0, 0, 571, 0, 525,
+	0, 0, 518, 519, 521, 520, 522, 527, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 248, 0, 304,
+	0, 570, 0, 0, 430, 0, 0, 568, 0, 0,
+	0, 0, 275, 0, 272, 171, 187, 0, 0, 314,
+	353, 359, 0, 0, 0, 211, 0, 357, 328, 415,
+	194, 238, 350, 333, 355, 0, 0, 356, 281, 403,
+	345, 413, 431, 432, 218, 308, 421, 394, 427, 442,
+	188, 215, 322, 387, 418, 378, 301, 399, 400, 271,
+	377, 246, 174, 279, 439, 186, 365, 202, 179, 389,
+	411, 199, 368, 0, 0, 444, 181, 409, 386, 298,
+	268, 269, 180, 0, 349, 223, 244, 213, 317, 406,
+	407, 212, 445, 190, 426, 183, 0, 425, 310, 402,
+	410, 299, 290, 182, 408, 297, 289, 274, 234, 255,
+	343, 284, 344, 256, 306, 305, 307, 0, 177, 0,
+	383, 419, 446, 195, 196, 197, 0, 233, 237, 243,
+	245, 251, 252, 259, 277, 321, 342, 340, 346, 0,
+	397, 414, 422, 429, 435, 436, 440, 437, 438, 441,
+	309, 258, 379, 273, 282, 0, 0, 327, 358, 200,
+	417, 380, 558, 569, 564, 565, 562, 563, 557, 561,
+	560, 559, 572, 549, 550, 551, 552, 554, 0, 566,
+	567, 553, 170, 184, 278, 0, 347, 241, 443, 424,
+	420, 0, 573, 217, 574, 0, 0, 579, 0, 0,
+	0, 582, 580, 0, 583, 0, 581, 578, 0, 0,
+	575, 576, 0, 577, 0, 0, 209, 0, 369, 172,
+	173, 185, 193, 203, 216, 231, 239, 249, 254, 257,
+	261, 262, 265, 270, 287, 292, 293, 294, 295, 311,
+	312, 313, 316, 319, 320, 323, 325, 326, 329, 335,
+	336, 337, 338, 339, 341, 348, 352, 360, 361, 362,
+	363, 364, 366, 367, 373, 374, 375, 376, 384, 388,
+	404, 405, 416, 428, 433, 250, 412, 434, 0, 286,
+	0, 0, 288, 235, 253, 263, 0, 423, 385, 189,
+	354, 242, 178, 206, 192, 214, 229, 232, 267, 296,
+	302, 331, 334, 247, 226, 204, 351, 201, 371, 391,
+	392, 393, 395, 300, 221, 396, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 318, 0, 0,
+	0, 0, 0, 0, 0, 0, 225, 0, 0, 0,
+	0, 276, 222, 0, 0, 332, 0, 176, 0, 372,
+	210, 285, 283, 401, 236, 228, 224, 208, 260, 291,
+	330, 390, 324, 556, 280, 0, 0, 381, 303, 0,
+	0, 0, 0, 0, 547, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 207, 175, 315, 382, 240,
+	79, 0, 0, 167, 168, 169, 534, 533, 536, 537,
+	538, 539, 0, 0, 198, 535, 205, 540, 541, 542,
+	0, 220, 264, 227, 219, 398, 0, 0, 0, 191,
+	370, 0, 0, 0, 0, 0, 0, 0, 526, 0,
+	555, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	523, 524, 0, 0, 0, 0, 571, 0, 525, 0,
+	0, 518, 519, 521, 520, 522, 527, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 248, 0, 304, 0,
+	570, 0, 0, 430, 0, 0, 568, 0, 0, 0,
+	0, 275, 0, 272, 171, 187, 0, 0, 314, 353,
+	359, 0, 0, 0, 211, 0, 357, 328, 415, 194,
+	238, 350, 333, 355, 2336, 0, 356, 281, 403, 345,
+	413, 431, 432, 218, 308, 421, 394, 427, 442, 188,
+	215, 322, 387, 418, 378, 301, 399, 400, 271, 377,
+	246, 174, 279, 439, 186, 365, 202, 179, 389, 411,
+	199, 368, 0, 0, 444, 181, 409, 386, 298, 268,
+	269, 180, 0, 349, 223, 244, 213, 317, 406, 407,
+	212, 445, 190, 426, 183, 0, 425, 310, 402, 410,
+	299, 290, 182, 408, 297, 289, 274, 234, 255, 343,
+	284, 344, 256, 306, 305, 307, 0, 177, 0, 383,
+	419, 446, 195, 196, 197, 0, 233, 237, 243, 245,
+	251, 252, 259, 277, 321, 342, 340, 346, 0, 397,
+	414, 422, 429, 435, 436, 440, 437, 438, 441, 309,
+	258, 379, 273, 282, 0, 0, 327, 358, 200, 417,
+	380, 558, 569, 564, 565, 562, 563, 557, 561, 560,
+	559, 572, 549, 550, 551, 552, 554, 0, 566, 567,
+	553, 170, 184, 278, 0, 347, 241, 443, 424, 420,
+	0, 573, 217, 574, 0, 0, 579, 0, 0, 0,
+	582, 580, 0, 583, 0, 581, 578, 0, 0, 575,
+	576, 0, 577, 0, 0, 209, 0, 369, 172, 173,
+	185, 193, 203, 216, 231, 239, 249, 254, 257, 261,
+	262, 265, 270, 287, 292, 293, 294, 295, 311, 312,
+	313, 316, 319, 320, 323, 325, 326, 329, 335, 336,
+	337, 338, 339, 341, 348, 352, 360, 361, 362, 363,
+	364, 366, 367, 373, 374, 375, 376, 384, 388, 404,
+	405, 416, 428, 433, 250, 412, 434, 0, 286, 0,
+	0, 288, 235, 253, 263, 0, 423, 385, 189, 354,
+	242, 178, 206, 192, 214, 229, 232, 267, 296, 302,
+	331, 334, 247, 226, 204, 351, 201, 371, 391, 392,
+	393, 395, 300, 221, 396, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 225, 0, 0, 0, 0,
+	276, 222, 0, 0, 332, 0, 176, 0, 372, 210,
+	285, 283, 401, 236, 228, 224, 208, 260, 291, 330,
+	390, 324, 556, 280, 0, 0, 381, 303, 0, 0,
+	0, 0, 0, 547, 548, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 207, 175, 315, 382, 240, 79,
+	0, 1082, 167, 168, 169, 534, 533, 536, 537, 538,
+	539, 0, 0, 198, 535, 205, 540, 541, 542, 0,
+	220, 264, 227, 219, 398, 0, 0, 0, 191, 370,
+	0, 0, 0, 0, 0, 0, 0, 526, 0, 555,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 523,
+	524, 0, 0, 0, 0, 571, 0, 525, 0, 0,
+	518, 519, 521, 520, 522, 527, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 248, 0, 304, 0, 570,
+	0, 0, 430, 0, 0, 568, 0, 0, 0, 0,
+	275, 0, 272, 171, 187, 0, 0, 314, 353, 359,
+	0, 0, 0, 211, 0, 357, 328, 415, 194, 238,
+	350, 333, 355, 0, 0, 356, 281, 403, 345, 413,
+	431, 432, 218, 308, 421, 394, 427, 442, 188, 215,
+	322, 387, 418, 378, 301, 399, 400, 271, 377, 246,
+	174, 279, 439, 186, 365, 202, 179, 389, 411, 199,
+	368, 0, 0, 444, 181, 409, 386, 298, 268, 269,
+	180, 0, 349, 223, 244, 213, 317, 406, 407, 212,
+	445, 190, 426, 183, 0, 425, 310, 402, 410, 299,
+	290, 182, 408, 297, 289, 274, 234, 255, 343, 284,
+	344, 256, 306, 305, 307, 0, 177, 0, 383, 419,
+	446, 195, 196, 197, 0, 233, 237, 243, 245, 251,
+	252, 259, 277, 321, 342, 340, 346, 0, 397, 414,
+	422, 429, 435, 436, 440, 437, 438, 441, 309, 258,
+	379, 273, 282, 0, 0, 327, 358, 200, 417, 380,
+	558, 569, 564, 565, 562, 563, 557, 561, 560, 559,
+	572, 549, 550, 551, 552, 554, 0, 566, 567, 553,
+	170, 184, 278, 0, 347, 241, 443, 424, 420, 0,
+	573, 217, 574, 0, 0, 579, 0, 0, 0, 582,
+	580, 0, 583, 0, 581, 578, 0, 0, 575, 576,
+	0, 577, 0, 0, 209, 0, 369, 172, 173, 185,
+	193, 203, 216, 231, 239, 249, 254, 257, 261, 262,
+	265, 270, 287, 292, 293, 294, 295, 311, 312, 313,
+	316, 319, 320, 323, 325, 326, 329, 335, 336, 337,
+	338, 339, 341, 348, 352, 360, 361, 362, 363, 364,
+	366, 367, 373, 374, 375, 376, 384, 388, 404, 405,
+	416, 428, 433, 250, 412, 434, 0, 286, 0, 0,
+	288, 235, 253, 263, 0, 423, 385, 189, 354, 242,
+	178, 206, 192, 214, 229, 232, 267, 296, 302, 331,
+	334, 247, 226, 204, 351, 201, 371, 391, 392, 393,
+	395, 300, 221, 396, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 225, 0, 0, 0, 0, 276,
+	222, 0, 0, 332, 0, 176, 0, 372, 210, 285,
+	283, 401, 236, 228, 224, 208, 260, 291, 330, 390,
+	324, 556, 280, 0, 0, 381, 303, 0, 0, 0,
+	0, 0, 547, 548, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 207, 175, 315, 382, 240, 79, 0,
+	0, 167, 168, 169, 534, 533, 536, 537, 538, 539,
+	0, 0, 198, 535, 205, 540, 541, 542, 0, 220,
+	264, 227, 219, 398, 0, 0, 0, 191, 370, 0,
+	0, 0, 0, 0, 0, 0, 526, 0, 555, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 523, 524,
+	0, 0, 0, 0, 571, 0, 525, 0, 0, 518,
+	519, 521, 520, 522, 527, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 248, 0, 304, 0, 570, 0,
+	0, 430, 0, 0, 568, 0, 0, 0, 0, 275,
+	0, 272, 171, 187, 0, 0, 314, 353, 359, 0,
+	0, 0, 211, 0, 357, 328, 415, 194, 238, 350,
+	333, 355, 0, 0, 356, 281, 403, 345, 413, 431,
+	432, 218, 308, 421, 394, 427, 442, 188, 215, 322,
+	387, 418, 378, 301, 399, 400, 271, 377, 246, 174,
+	279, 439, 186, 365, 202, 179, 389, 411, 199, 368,
+	0, 0, 444, 181, 409, 386, 298, 268, 269, 180,
+	0, 349, 223, 244, 213, 317, 406, 407, 212, 445,
+	190, 426, 183, 0, 425, 310, 402, 410, 299, 290,
+	182, 408, 297, 289, 274, 234, 255, 343, 284, 344,
+	256, 306, 305, 307, 0, 177, 0, 383, 419, 446,
+	195, 196, 197, 0, 233, 237, 243, 245, 251, 252,
+	259, 277, 321, 342, 340, 346, 0, 397, 414, 422,
+	429, 435, 436, 440, 437, 438, 441, 309, 258, 379,
+	273, 282, 0, 0, 327, 358, 200, 417, 380, 558,
+	569, 564, 565, 562, 563, 557, 561, 560, 559, 572,
+	549, 550, 551, 552, 554, 0, 566, 567, 553, 170,
+	184, 278, 0, 347, 241, 443, 424, 420, 0, 573,
+	217, 574, 0, 0, 579, 0, 0, 0, 582, 580,
+	0, 583, 0, 581, 578, 0, 0, 575, 576, 0,
+	577, 0, 0, 209, 0, 369, 172, 173, 185, 193,
+	203, 216, 231, 239, 249, 254, 257, 261, 262, 265,
+	270, 287, 292, 293, 294, 295, 311, 312, 313, 316,
+	319, 320, 323, 325, 326, 329, 335, 336, 337, 338,
+	339, 341, 348, 352, 360, 361, 362, 363, 364, 366,
+	367, 373, 374, 375, 376, 384, 388, 404, 405, 416,
+	428, 433, 250, 412, 434, 0, 286, 0, 0, 288,
+	235, 253, 263, 0, 423, 385, 189, 354, 242, 178,
+	206, 192, 214, 229, 232, 267, 296, 302, 331, 334,
+	247, 226, 204, 351, 201, 371, 391, 392, 393, 395,
+	300, 221, 396, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 225, 0, 0, 0, 0, 276, 222,
+	0, 0, 332, 0, 176, 0, 372, 210, 285, 283,
+	401, 236, 228, 224, 208, 260, 291, 330, 390, 324,
+	0, 280, 0, 0, 381, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 207, 175, 315, 382, 240, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 0, 0, 0, 0, 220, 264,
+	227, 219, 398, 0, 0, 0, 191, 370, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 984, 983, 993, 994, 986, 987, 988,
+	989, 990, 991, 992, 985, 0, 0, 995, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 248, 0, 304, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 275, 0,
+	272, 171, 187, 0, 0, 314, 353, 359, 0, 0,
+	0, 211, 0, 357, 328, 415, 194, 238, 350, 333,
+	355, 0, 0, 356, 281, 403, 345, 413, 431, 432,
+	218, 308, 421, 394, 427, 442, 188, 215, 322, 387,
+	418, 378, 301, 399, 400, 271, 377, 246, 174, 279,
+	439, 186, 365, 202, 179, 389, 411, 199, 368, 0,
+	0, 444, 181, 409, 386, 298, 268, 269, 180, 0,
+	349, 223, 244, 213, 317, 406, 407, 212, 445, 190,
+	426, 183, 0, 425, 310, 402, 410, 299, 290, 182,
+	408, 297, 289, 274, 234, 255, 343, 284, 344, 256,
+	306, 305, 307, 0, 177, 0, 383, 419, 446, 195,
+	196, 197, 0, 233, 237, 243, 245, 251, 252, 259,
+	277, 321, 342, 340, 346, 0, 397, 414, 422, 429,
+	435, 436, 440, 437, 438, 441, 309, 258, 379, 273,
+	282, 0, 0, 327, 358, 200, 417, 380, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 1078, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	1080, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 964, 965, 963, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 966, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 35, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	586, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 1446, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 1261,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 1444, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
+	278, 0, 347, 241, 443, 424, 420, 0, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 209, 0, 369, 172, 173, 185, 193, 203,
+	216, 231, 239, 249, 254, 257, 261, 262, 265, 270,
+	287, 292, 293, 294, 295, 311, 312, 313, 316, 319,
+	320, 323, 325, 326, 329, 335, 336, 337, 338, 339,
+	341, 348, 352, 360, 361, 362, 363, 364, 366, 367,
+	373, 374, 375, 376, 384, 388, 404, 405, 416, 428,
+	433, 250, 412, 434, 0, 286, 0, 0, 288, 235,
+	253, 263, 0, 423, 385, 189, 354, 242, 178, 206,
+	192, 214, 229, 232, 267, 296, 302, 331, 334, 247,
+	226, 204, 351, 201, 371, 391, 392, 393, 395, 300,
+	221, 396, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 225, 0, 0, 0, 0, 276, 222, 0,
+	0, 332, 0, 176, 0, 372, 210, 285, 283, 401,
+	236, 228, 224, 208, 260, 291, 330, 390, 324, 0,
+	280, 0, 0, 381, 303, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 207, 175, 315, 382, 240, 0, 0, 0, 167,
 	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 760, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 766, 271, 171, 187,
-	764, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 1446, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 1261, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
+	198, 0, 205, 0, 0, 0, 0, 220, 264, 227,
+	219, 398, 0, 0, 0, 191, 370, 0, 0, 813,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 248, 0, 304, 0, 0, 0, 812, 430,
+	0, 0, 0, 0, 0, 809, 810, 275, 775, 272,
+	171, 187, 803, 807, 314, 353, 359, 0, 0, 0,
+	211, 0, 357, 328, 415, 194, 238, 350, 333, 355,
+	0, 0, 356, 281, 403, 345, 413, 431, 432, 218,
+	308, 421, 394, 427, 442, 188, 215, 322, 387, 418,
+	378, 301, 399, 400, 271, 377, 246, 174, 279, 439,
+	186, 365, 202, 179, 389, 411, 199, 368, 0, 0,
+	444, 181, 409, 386, 298, 268, 269, 180, 0, 349,
+	223, 244, 213, 317, 406, 407, 212, 445, 190, 426,
+	183, 0, 425, 310, 402, 410, 299, 290, 182, 408,
+	297, 289, 274, 234, 255, 343, 284, 344, 256, 306,
+	305, 307, 0, 177, 0, 383, 419, 446, 195, 196,
+	197, 0, 233, 237, 243, 245, 251, 252, 259, 277,
+	321, 342, 340, 346, 0, 397, 414, 422, 429, 435,
+	436, 440, 437, 438, 441, 309, 258, 379, 273, 282,
+	0, 0, 327, 358, 200, 417, 380, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 278,
+	0, 347, 241, 443, 424, 420, 0, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 369, 172, 173, 185, 193, 203, 216,
+	231, 239, 249, 254, 257, 261, 262, 265, 270, 287,
+	292, 293, 294, 295, 311, 312, 313, 316, 319, 320,
+	323, 325, 326, 329, 335, 336, 337, 338, 339, 341,
+	348, 352, 360, 361, 362, 363, 364, 366, 367, 373,
+	374, 375, 376, 384, 388, 404, 405, 416, 428, 433,
+	250, 412, 434, 0, 286, 0, 0, 288, 235, 253,
+	263, 0, 423, 385, 189, 354, 242, 178, 206, 192,
+	214, 229, 232, 267, 296, 302, 331, 334, 247, 226,
+	204, 351, 201, 371, 391, 392, 393, 395, 300, 221,
+	396, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 1103, 0, 0, 0,
+	0, 225, 0, 0, 0, 0, 276, 222, 0, 0,
+	332, 0, 176, 0, 372, 210, 285, 283, 401, 236,
+	228, 224, 208, 260, 291, 330, 390, 324, 0, 280,
+	0, 0, 381, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	207, 175, 315, 382, 240, 0, 0, 0, 167, 168,
+	169, 0, 1105, 0, 0, 0, 0, 0, 0, 198,
+	0, 205, 0, 0, 0, 0, 220, 264, 227, 219,
+	398, 0, 0, 0, 191, 370, 0, 0, 0, 973,
+	974, 972, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 975, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 0, 304, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 275, 0, 272, 171,
+	187, 0, 0, 314, 353, 359, 0, 0, 0, 211,
+	0, 357, 328, 415, 194, 238, 350, 333, 355, 0,
+	0, 356, 281, 403, 345, 413, 431, 432, 218, 308,
+	421, 394, 427, 442, 188, 215, 322, 387, 418, 378,
+	301, 399, 400, 271, 377, 246, 174, 279, 439, 186,
+	365, 202, 179, 389, 411, 199, 368, 0, 0, 444,
+	181, 409, 386, 298, 268, 269, 180, 0, 349, 223,
+	244, 213, 317, 406, 407, 212, 445, 190, 426, 183,
+	0, 425, 310, 402, 410, 299, 290, 182, 408, 297,
+	289, 274, 234, 255, 343, 284, 344, 256, 306, 305,
+	307, 0, 177, 0, 383, 419, 446, 195, 196, 197,
+	0, 233, 237, 243, 245, 251, 252, 259, 277, 321,
+	342, 340, 346, 0, 397, 414, 422, 429, 435, 436,
+	440, 437, 438, 441, 309, 258, 379, 273, 282, 0,
+	0, 327, 358, 200, 417, 380, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 184, 278, 0,
+	347, 241, 443, 424, 420, 0, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 369, 172, 173, 185, 193, 203, 216, 231,
+	239, 249, 254, 257, 261, 262, 265, 270, 287, 292,
+	293, 294, 295, 311, 312, 313, 316, 319, 320, 323,
+	325, 326, 329, 335, 336, 337, 338, 339, 341, 348,
+	352, 360, 361, 362, 363, 364, 366, 367, 373, 374,
+	375, 376, 384, 388, 404, 405, 416, 428, 433, 250,
+	412, 434, 0, 286, 0, 0, 288, 235, 253, 263,
+	0, 423, 385, 189, 354, 242, 178, 206, 192, 214,
+	229, 232, 267, 296, 302, 331, 334, 247, 226, 204,
+	351, 201, 371, 391, 392, 393, 395, 300, 221, 72,
+	396, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 225, 0, 0, 0, 0, 276, 222, 0, 0,
+	332, 0, 176, 0, 372, 210, 285, 283, 401, 236,
+	228, 224, 208, 260, 291, 330, 390, 324, 0, 280,
+	0, 0, 381, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	207, 175, 315, 382, 240, 79, 0, 1082, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
+	0, 205, 0, 0, 0, 0, 220, 264, 227, 219,
+	398, 0, 0, 0, 191, 370, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 0, 304, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 275, 0, 272, 171,
+	187, 0, 0, 314, 353, 359, 0, 0, 0, 211,
+	0, 357, 328, 415, 194, 238, 350, 333, 355, 0,
+	0, 356, 281, 403, 345, 413, 431, 432, 218, 308,
+	421, 394, 427, 442, 188, 215, 322, 387, 418, 378,
+	301, 399, 400, 271, 377, 246, 174, 279, 439, 186,
+	365, 202, 179, 389, 411, 199, 368, 0, 0, 444,
+	181, 409, 386, 298, 268, 269, 180, 0, 349, 223,
+	244, 213, 317, 406, 407, 212, 445, 190, 426, 183,
+	0, 425, 310, 402, 410, 299, 290, 182, 408, 297,
+	289, 274, 234, 255, 343, 284, 344, 256, 306, 305,
+	307, 0, 177, 0, 383, 419, 446, 195, 196, 197,
+	0, 233, 237, 243, 245, 251, 252, 259, 277, 321,
+	342, 340, 346, 0, 397, 414, 422, 429, 435, 436,
+	440, 437, 438, 441, 309, 258, 379, 273, 282, 0,
+	0, 327, 358, 200, 417, 380, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 184, 278, 73,
+	347, 241, 443, 424, 420, 0, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 369, 172, 173, 185, 193, 203, 216, 231,
+	239, 249, 254, 257, 261, 262, 265, 270, 287, 292,
+	293, 294, 295, 311, 312, 313, 316, 319, 320, 323,
+	325, 326, 329, 335, 336, 337, 338, 339, 341, 348,
+	352, 360, 361, 362, 363, 364, 366, 367, 373, 374,
+	375, 376, 384, 388, 404, 405, 416, 428, 433, 250,
+	412, 434, 0, 286, 0, 0, 288, 235, 253, 263,
+	0, 423, 385, 189, 354, 242, 178, 206, 192, 214,
+	229, 232, 267, 296, 302, 331, 334, 247, 226, 204,
+	351, 201, 371, 391, 392, 393, 395, 300, 221, 72,
+	396, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 225, 0, 0, 0, 0, 276, 222, 0, 0,
+	332, 0, 176, 0, 372, 210, 285, 283, 401, 236,
+	228, 224, 208, 260, 291, 330, 390, 324, 0, 280,
+	0, 0, 381, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	207, 175, 315, 382, 240, 79, 0, 0, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
+	0, 205, 0, 0, 0, 0, 220, 264, 227, 219,
+	398, 0, 0, 0, 191, 370, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 0, 304, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 275, 0, 272, 171,
+	187, 0, 0, 314, 353, 359, 0, 0, 0, 211,
+	0, 357, 328, 415, 194, 238, 350, 333, 355, 0,
+	0, 356, 281, 403, 345, 413, 431, 432, 218, 308,
+	421, 394, 427, 442, 188, 215, 322, 387, 418, 378,
+	301, 399, 400, 271, 377, 246, 174, 279, 439, 186,
+	365, 202, 179, 389, 411, 199, 368, 0, 0, 444,
+	181, 409, 386, 298, 268, 269, 180, 0, 349, 223,
+	244, 213, 317, 406, 407, 212, 445, 190, 426, 183,
+	0, 425, 310, 402, 410, 299, 290, 182, 408, 297,
+	289, 274, 234, 255, 343, 284, 344, 256, 306, 305,
+	307, 0, 177, 0, 383, 419, 446, 195, 196, 197,
+	0, 233, 237, 243, 245, 251, 252, 259, 277, 321,
+	342, 340, 346, 0, 397, 414, 422, 429, 435, 436,
+	440, 437, 438, 441, 309, 258, 379, 273, 282, 0,
+	0, 327, 358, 200, 417, 380, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 184, 278, 73,
+	347, 241, 443, 424, 420, 0, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 369, 172, 173, 185, 193, 203, 216, 231,
+	239, 249, 254, 257, 261, 262, 265, 270, 287, 292,
+	293, 294, 295, 311, 312, 313, 316, 319, 320, 323,
+	325, 326, 329, 335, 336, 337, 338, 339, 341, 348,
+	352, 360, 361, 362, 363, 364, 366, 367, 373, 374,
+	375, 376, 384, 388, 404, 405, 416, 428, 433, 250,
+	412, 434, 0, 286, 0, 0, 288, 235, 253, 263,
+	0, 423, 385, 189, 354, 242, 178, 206, 192, 214,
+	229, 232, 267, 296, 302, 331, 334, 247, 226, 204,
+	351, 201, 371, 391, 392, 393, 395, 300, 221, 396,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 318, 0, 0, 0, 1485, 0, 0, 0, 0,
+	225, 0, 0, 0, 0, 276, 222, 0, 0, 332,
+	0, 176, 0, 372, 210, 285, 283, 401, 236, 228,
+	224, 208, 260, 291, 330, 390, 324, 0, 280, 0,
+	0, 381, 303, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 207,
+	175, 315, 382, 240, 0, 0, 0, 167, 168, 169,
+	0, 1287, 0, 0, 0, 0, 0, 0, 198, 0,
+	205, 0, 0, 0, 0, 220, 264, 227, 219, 398,
+	0, 0, 0, 191, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	248, 0, 304, 0, 0, 0, 0, 430, 0, 0,
+	0, 0, 0, 0, 0, 275, 0, 272, 171, 187,
+	0, 0, 314, 353, 359, 0, 0, 0, 211, 0,
+	357, 328, 415, 194, 238, 350, 333, 355, 0, 1483,
+	356, 281, 403, 345, 413, 431, 432, 218, 308, 421,
+	394, 427, 442, 188, 215, 322, 387, 418, 378, 301,
+	399, 400, 271, 377, 246, 174, 279, 439, 186, 365,
+	202, 179, 389, 411, 199, 368, 0, 0, 444, 181,
+	409, 386, 298, 268, 269, 180, 0, 349, 223, 244,
+	213, 317, 406, 407, 212, 445, 190, 426, 183, 0,
+	425, 310, 402, 410, 299, 290, 182, 408, 297, 289,
+	274, 234, 255, 343, 284, 344, 256, 306, 305, 307,
+	0, 177, 0, 383, 419, 446, 195, 196, 197, 0,
+	233, 237, 243, 245, 251, 252, 259, 277, 321, 342,
+	340, 346, 0, 397, 414, 422, 429, 435, 436, 440,
+	437, 438, 441, 309, 258, 379, 273, 282, 0, 0,
+	327, 358, 200, 417, 380, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 184, 278, 0, 347,
+	241, 443, 424, 420, 0, 0, 217, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	0, 369, 172, 173, 185, 193, 203, 216, 231, 239,
+	249, 254, 257, 261, 262, 265, 270, 287, 292, 293,
+	294, 295, 311, 312, 313, 316, 319, 320, 323, 325,
+	326, 329, 335, 336, 337, 338, 339, 341, 348, 352,
+	360, 361, 362, 363, 364, 366, 367, 373, 374, 375,
+	376, 384, 388, 404, 405, 416, 428, 433, 250, 412,
+	434, 0, 286, 0, 0, 288, 235, 253, 263, 0,
+	423, 385, 189, 354, 242, 178, 206, 192, 214, 229,
+	232, 267, 296, 302, 331, 334, 247, 226, 204, 351,
+	201, 371, 391, 392, 393, 395, 300, 221, 396, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 225,
+	0, 0, 0, 0, 276, 222, 0, 0, 332, 0,
+	176, 0, 372, 210, 285, 283, 401, 236, 228, 224,
+	208, 260, 291, 330, 390, 324, 0, 280, 0, 0,
+	381, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 207, 175,
+	315, 382, 240, 0, 0, 0, 167, 168, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
+	0, 0, 0, 0, 220, 264, 227, 219, 398, 0,
+	0, 0, 191, 370, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 769, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 248,
+	0, 304, 0, 0, 0, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 275, 775, 272, 171, 187, 773,
+	0, 314, 353, 359, 0, 0, 0, 211, 0, 357,
+	328, 415, 194, 238, 350, 333, 355, 0, 0, 356,
+	281, 403, 345, 413, 431, 432, 218, 308, 421, 394,
+	427, 442, 188, 215, 322, 387, 418, 378, 301, 399,
+	400, 271, 377, 246, 174, 279, 439, 186, 365, 202,
+	179, 389, 411, 199, 368, 0, 0, 444, 181, 409,
+	386, 298, 268, 269, 180, 0, 349, 223, 244, 213,
+	317, 406, 407, 212, 445, 190, 426, 183, 0, 425,
+	310, 402, 410, 299, 290, 182, 408, 297, 289, 274,
+	234, 255, 343, 284, 344, 256, 306, 305, 307, 0,
+	177, 0, 383, 419, 446, 195, 196, 197, 0, 233,
+	237, 243, 245, 251, 252, 259, 277, 321, 342, 340,
+	346, 0, 397, 414, 422, 429, 435, 436, 440, 437,
+	438, 441, 309, 258, 379, 273, 282, 0, 0, 327,
+	358, 200, 417, 380, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 184, 278, 0, 347, 241,
+	443, 424, 420, 0, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 0,
+	369, 172, 173, 185, 193, 203, 216, 231, 239, 249,
+	254, 257, 261, 262, 265, 270, 287, 292, 293, 294,
+	295, 311, 312, 313, 316, 319, 320, 323, 325, 326,
+	329, 335, 336, 337, 338, 339, 341, 348, 352, 360,
+	361, 362, 363, 364, 366, 367, 373, 374, 375, 376,
+	384, 388, 404, 405, 416, 428, 433, 250, 412, 434,
+	0, 286, 0, 0, 288, 235, 253, 263, 0, 423,
+	385, 189, 354, 242, 178, 206, 192, 214, 229, 232,
+	267, 296, 302, 331, 334, 247, 226, 204, 351, 201,
+	371, 391, 392, 393, 395, 300, 221, 396, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 318,
+	0, 0, 0, 1485, 0, 0, 0, 0, 225, 0,
+	0, 0, 0, 276, 222, 0, 0, 332, 0, 176,
+	0, 372, 210, 285, 283, 401, 236, 228, 224, 208,
+	260, 291, 330, 390, 324, 0, 280, 0, 0, 381,
+	303, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 207, 175, 315,
+	382, 240, 0, 0, 0, 167, 168, 169, 0, 1287,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
+	0, 0, 0, 220, 264, 227, 219, 398, 0, 0,
+	0, 191, 370, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	304, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 275, 0, 272, 171, 187, 0, 0,
+	314, 353, 359, 0, 0, 0, 211, 0, 357, 328,
+	415, 194, 238, 350, 333, 355, 0, 0, 356, 281,
+	403, 345, 413, 431, 432, 218, 308, 421, 394, 427,
+	442, 188, 215, 322, 387, 418, 378, 301, 399, 400,
+	271, 377, 246, 174, 279, 439, 186, 365, 202, 179,
+	389, 411, 199, 368, 0, 0, 444, 181, 409, 386,
+	298, 268, 269, 180, 0, 349, 223, 244, 213, 317,
+	406, 407, 212, 445, 190, 426, 183, 0, 425, 310,
+	402, 410, 299, 290, 182, 408, 297, 289, 274, 234,
+	255, 343, 284, 344, 256, 306, 305, 307, 0, 177,
+	0, 383, 419, 446, 195, 196, 197, 0, 233, 237,
+	243, 245, 251, 252, 259, 277, 321, 342, 340, 346,
+	0, 397, 414, 422, 429, 435, 436, 440, 437, 438,
+	441, 309, 258, 379, 273, 282, 0, 0, 327, 358,
+	200, 417, 380, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 184, 278, 0, 347, 241, 443,
+	424, 420, 0, 0, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 209, 0, 369,
+	172, 173, 185, 193, 203, 216, 231, 239, 249, 254,
+	257, 261, 262, 265, 270, 287, 292, 293, 294, 295,
+	311, 312, 313, 316, 319, 320, 323, 325, 326, 329,
+	335, 336, 337, 338, 339, 341, 348, 352, 360, 361,
+	362, 363, 364, 366, 367, 373, 374, 375, 376, 384,
+	388, 404, 405, 416, 428, 433, 250, 412, 434, 0,
+	286, 0, 0, 288, 235, 253, 263, 0, 423, 385,
+	189, 354, 242, 178, 206, 192, 214, 229, 232, 267,
+	296, 302, 331, 334, 247, 226, 204, 351, 201, 371,
+	391, 392, 393, 395, 300, 221, 396, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 225, 0, 0,
+	0, 0, 276, 222, 0, 0, 332, 0, 176, 0,
+	372, 210, 285, 283, 401, 236, 228, 224, 208, 260,
+	291, 330, 390, 324, 0, 280, 0, 0, 381, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 207, 175, 315, 382,
+	240, 0, 0, 1082, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
+	0, 0, 220, 264, 227, 219, 398, 0, 0, 0,
+	191, 370, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 248, 0, 304,
+	0, 0, 0, 0, 430, 0, 0, 0, 2218, 0,
+	0, 0, 275, 0, 272, 171, 187, 0, 0, 314,
+	353, 359, 0, 0, 0, 211, 0, 357, 328, 415,
+	194, 238, 350, 333, 355, 0, 0, 356, 281, 403,
+	345, 413, 431, 432, 218, 308, 421, 394, 427, 442,
+	188, 215, 322, 387, 418, 378, 301, 399, 400, 271,
+	377, 246, 174, 279, 439, 186, 365, 202, 179, 389,
+	411, 199, 368, 0, 0, 444, 181, 409, 386, 298,
+	268, 269, 180, 0, 349, 223, 244, 213, 317, 406,
+	407, 212, 445, 190, 426, 183, 0, 425, 310, 402,
+	410, 299, 290, 182, 408, 297, 289, 274, 234, 255,
+	343, 284, 344, 256, 306, 305, 307, 0, 177, 0,
+	383, 419, 446, 195, 196, 197, 0, 233, 237, 243,
+	245, 251, 252, 259, 277, 321, 342, 340, 346, 0,
+	397, 414, 422, 429, 435, 436, 440, 437, 438, 441,
+	309, 258, 379, 273, 282, 0, 0, 327, 358, 200,
+	417, 380, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 184, 278, 0, 347, 241, 443, 424,
+	420, 0, 0, 217, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 0, 369, 172,
+	173, 185, 193, 203, 216, 231, 239, 249, 254, 257,
+	261, 262, 265, 270, 287, 292, 293, 294, 295, 311,
+	312, 313, 316, 319, 320, 323, 325, 326, 329, 335,
+	336, 337, 338, 339, 341, 348, 352, 360, 361, 362,
+	363, 364, 366, 367, 373, 374, 375, 376, 384, 388,
+	404, 405, 416, 428, 433, 250, 412, 434, 0, 286,
+	0, 0, 288, 235, 253, 263, 0, 423, 385, 189,
+	354, 242, 178, 206, 192, 214, 229, 232, 267, 296,
+	302, 331, 334, 247, 226, 204, 351, 201, 371, 391,
+	392, 393, 395, 300, 221, 396, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 318, 0, 0,
+	0, 0, 0, 0, 0, 0, 225, 0, 0, 0,
+	0, 276, 222, 0, 0, 332, 0, 176, 0, 372,
+	210, 285, 283, 401, 236, 228, 224, 208, 260, 291,
+	330, 390, 324, 0, 280, 0, 0, 381, 303, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 207, 175, 315, 382, 240,
+	0, 0, 0, 167, 168, 169, 0, 0, 1775, 0,
+	0, 1776, 0, 0, 198, 0, 205, 0, 0, 0,
+	0, 220, 264, 227, 219, 398, 0, 0, 0, 191,
+	370, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 248, 0, 304, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 275, 0, 272, 171, 187, 0, 0, 314, 353,
+	359, 0, 0, 0, 211, 0, 357, 328, 415, 194,
+	238, 350, 333, 355, 0, 0, 356, 281, 403, 345,
+	413, 431, 432, 218, 308, 421, 394, 427, 442, 188,
+	215, 322, 387, 418, 378, 301, 399, 400, 271, 377,
+	246, 174, 279, 439, 186, 365, 202, 179, 389, 411,
+	199, 368, 0, 0, 444, 181, 409, 386, 298, 268,
+	269, 180, 0, 349, 223, 244, 213, 317, 406, 407,
+	212, 445, 190, 426, 183, 0, 425, 310, 402, 410,
+	299, 290, 182, 408, 297, 289, 274, 234, 255, 343,
+	284, 344, 256, 306, 305, 307, 0, 177, 0, 383,
+	419, 446, 195, 196, 197, 0, 233, 237, 243, 245,
+	251, 252, 259, 277, 321, 342, 340, 346, 0, 397,
+	414, 422, 429, 435, 436, 440, 437, 438, 441, 309,
+	258, 379, 273, 282, 0, 0, 327, 358, 200, 417,
+	380, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 184, 278, 0, 347, 241, 443, 424, 420,
+	0, 0, 217, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 209, 0, 369, 172, 173,
+	185, 193, 203, 216, 231, 239, 249, 254, 257, 261,
+	262, 265, 270, 287, 292, 293, 294, 295, 311, 312,
+	313, 316, 319, 320, 323, 325, 326, 329, 335, 336,
+	337, 338, 339, 341, 348, 352, 360, 361, 362, 363,
+	364, 366, 367, 373, 374, 375, 376, 384, 388, 404,
+	405, 416, 428, 433, 250, 412, 434, 0, 286, 0,
+	0, 288, 235, 253, 263, 0, 423, 385, 189, 354,
+	242, 178, 206, 192, 214, 229, 232, 267, 296, 302,
+	331, 334, 247, 226, 204, 351, 201, 371, 391, 392,
+	393, 395, 300, 221, 396, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 225, 1137, 0, 0, 0,
+	276, 222, 0, 0, 332, 0, 176, 0, 372, 210,
+	285, 283, 401, 236, 228, 224, 208, 260, 291, 330,
+	390, 324, 0, 280, 0, 0, 381, 303, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 207, 175, 315, 382, 240, 0,
+	0, 0, 167, 168, 169, 0, 1136, 0, 0, 0,
+	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
+	220, 264, 227, 219, 398, 0, 0, 0, 191, 370,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 248, 0, 304, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 272, 171, 187, 0, 0, 314, 353, 359,
+	0, 0, 0, 211, 0, 357, 328, 415, 194, 238,
+	350, 333, 355, 0, 0, 356, 281, 403, 345, 413,
+	431, 432, 218, 308, 421, 394, 427, 442, 188, 215,
+	322, 387, 418, 378, 301, 399, 400, 271, 377, 246,
+	174, 279, 439, 186, 365, 202, 179, 389, 411, 199,
+	368, 0, 0, 444, 181, 409, 386, 298, 268, 269,
+	180, 0, 349, 223, 244, 213, 317, 406, 407, 212,
+	445, 190, 426, 183, 0, 425, 310, 402, 410, 299,
+	290, 182, 408, 297, 289, 274, 234, 255, 343, 284,
+	344, 256, 306, 305, 307, 0, 177, 0, 383, 419,
+	446, 195, 196, 197, 0, 233, 237, 243, 245, 251,
+	252, 259, 277, 321, 342, 340, 346, 0, 397, 414,
+	422, 429, 435, 436, 440, 437, 438, 441, 309, 258,
+	379, 273, 282, 0, 0, 327, 358, 200, 417, 380,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 184, 278, 0, 347, 241, 443, 424, 420, 0,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 0, 369, 172, 173, 185,
+	193, 203, 216, 231, 239, 249, 254, 257, 261, 262,
+	265, 270, 287, 292, 293, 294, 295, 311, 312, 313,
+	316, 319, 320, 323, 325, 326, 329, 335, 336, 337,
+	338, 339, 341, 348, 352, 360, 361, 362, 363, 364,
+	366, 367, 373, 374, 375, 376, 384, 388, 404, 405,
+	416, 428, 433, 250, 412, 434, 0, 286, 0, 0,
+	288, 235, 253, 263, 0, 423, 385, 189, 354, 242,
+	178, 206, 192, 214, 229, 232, 267, 296, 302, 331,
+	334, 247, 226, 204, 351, 201, 371, 391, 392, 393,
+	395, 300, 221, 396, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 225, 0, 0, 0, 0, 276,
+	222, 0, 0, 332, 0, 176, 0, 372, 210, 285,
+	283, 401, 236, 228, 224, 208, 260, 291, 330, 390,
+	324, 0, 280, 0, 0, 381, 303, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 207, 175, 315, 382, 240, 0, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 198, 0, 205, 0, 0, 0, 0, 220,
+	264, 227, 219, 398, 0, 0, 0, 191, 370, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 248, 0, 304, 0, 0, 0,
+	0, 430, 0, 0, 0, 2307, 0, 0, 0, 275,
+	0, 272, 171, 187, 0, 0, 314, 353, 359, 0,
+	0, 0, 211, 0, 357, 328, 415, 194, 238, 350,
+	333, 355, 0, 0, 356, 281, 403, 345, 413, 431,
+	432, 218, 308, 421, 394, 427, 442, 188, 215, 322,
+	387, 418, 378, 301, 399, 400, 271, 377, 246, 174,
+	279, 439, 186, 365, 202, 179, 389, 411, 199, 368,
+	0, 0, 444, 181, 409, 386, 298, 268, 269, 180,
+	0, 349, 223, 244, 213, 317, 406, 407, 212, 445,
+	190, 426, 183, 0, 425, 310, 402, 410, 299, 290,
+	182, 408, 297, 289, 274, 234, 255, 343, 284, 344,
+	256, 306, 305, 307, 0, 177, 0, 383, 419, 446,
+	195, 196, 197, 0, 233, 237, 243, 245, 251, 252,
+	259, 277, 321, 342, 340, 346, 0, 397, 414, 422,
+	429, 435, 436, 440, 437, 438, 441, 309, 258, 379,
+	273, 282, 0, 0, 327, 358, 200, 417, 380, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 586, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 2127,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 35, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
+	184, 278, 0, 347, 241, 443, 424, 420, 0, 0,
+	217, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 0, 369, 172, 173, 185, 193,
+	203, 216, 231, 239, 249, 254, 257, 261, 262, 265,
+	270, 287, 292, 293, 294, 295, 311, 312, 313, 316,
+	319, 320, 323, 325, 326, 329, 335, 336, 337, 338,
+	339, 341, 348, 352, 360, 361, 362, 363, 364, 366,
+	367, 373, 374, 375, 376, 384, 388, 404, 405, 416,
+	428, 433, 250, 412, 434, 0, 286, 0, 0, 288,
+	235, 253, 263, 0, 423, 385, 189, 354, 242, 178,
+	206, 192, 214, 229, 232, 267, 296, 302, 331, 334,
+	247, 226, 204, 351, 201, 371, 391, 392, 393, 395,
+	300, 221, 396, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 225, 0, 0, 0, 0, 276, 222,
+	0, 0, 332, 0, 176, 0, 372, 210, 285, 283,
+	401, 236, 228, 224, 208, 260, 291, 330, 390, 324,
+	0, 280, 0, 0, 381, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 207, 175, 315, 382, 240, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 0, 0, 0, 0, 220, 264,
+	227, 219, 398, 0, 0, 0, 191, 370, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 248, 0, 304, 0, 0, 0, 0,
+	430, 0, 0, 0, 2218, 0, 0, 0, 275, 0,
+	272, 171, 187, 0, 0, 314, 353, 359, 0, 0,
+	0, 211, 0, 357, 328, 415, 194, 238, 350, 333,
+	355, 0, 0, 356, 281, 403, 345, 413, 431, 432,
+	218, 308, 421, 394, 427, 442, 188, 215, 322, 387,
+	418, 378, 301, 399, 400, 271, 377, 246, 174, 279,
+	439, 186, 365, 202, 179, 389, 411, 199, 368, 0,
+	0, 444, 181, 409, 386, 298, 268, 269, 180, 0,
+	349, 223, 244, 213, 317, 406, 407, 212, 445, 190,
+	426, 183, 0, 425, 310, 402, 410, 299, 290, 182,
+	408, 297, 289, 274, 234, 255, 343, 284, 344, 256,
+	306, 305, 307, 0, 177, 0, 383, 419, 446, 195,
+	196, 197, 0, 233, 237, 243, 245, 251, 252, 259,
+	277, 321, 342, 340, 346, 0, 397, 414, 422, 429,
+	435, 436, 440, 437, 438, 441, 309, 258, 379, 273,
+	282, 0, 0, 327, 358, 200, 417, 380, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 1465, 0, 0, 1466, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 1111, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 1110, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
+	278, 0, 347, 241, 443, 424, 420, 0, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 209, 0, 369, 172, 173, 185, 193, 203,
+	216, 231, 239, 249, 254, 257, 261, 262, 265, 270,
+	287, 292, 293, 294, 295, 311, 312, 313, 316, 319,
+	320, 323, 325, 326, 329, 335, 336, 337, 338, 339,
+	341, 348, 352, 360, 361, 362, 363, 364, 366, 367,
+	373, 374, 375, 376, 384, 388, 404, 405, 416, 428,
+	433, 250, 412, 434, 0, 286, 0, 0, 288, 235,
+	253, 263, 0, 423, 385, 189, 354, 242, 178, 206,
+	192, 214, 229, 232, 267, 296, 302, 331, 334, 247,
+	226, 204, 351, 201, 371, 391, 392, 393, 395, 300,
+	221, 396, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 225, 0, 0, 0, 0, 276, 222, 0,
+	0, 332, 0, 176, 0, 372, 210, 285, 283, 401,
+	236, 228, 224, 208, 260, 291, 330, 390, 324, 0,
+	280, 0, 0, 381, 303, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 207, 175, 315, 382, 240, 79, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	198, 0, 205, 0, 0, 0, 0, 220, 264, 227,
+	219, 398, 0, 0, 0, 191, 370, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 248, 0, 304, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 275, 0, 272,
+	171, 187, 0, 0, 314, 353, 359, 0, 0, 0,
+	211, 0, 357, 328, 415, 194, 238, 350, 333, 355,
+	0, 0, 356, 281, 403, 345, 413, 431, 432, 218,
+	308, 421, 394, 427, 442, 188, 215, 322, 387, 418,
+	378, 301, 399, 400, 271, 377, 246, 174, 279, 439,
+	186, 365, 202, 179, 389, 411, 199, 368, 0, 0,
+	444, 181, 409, 386, 298, 268, 269, 180, 0, 349,
+	223, 244, 213, 317, 406, 407, 212, 445, 190, 426,
+	183, 0, 425, 310, 402, 410, 299, 290, 182, 408,
+	297, 289, 274, 234, 255, 343, 284, 344, 256, 306,
+	305, 307, 0, 177, 0, 383, 419, 446, 195, 196,
+	197, 0, 233, 237, 243, 245, 251, 252, 259, 277,
+	321, 342, 340, 346, 0, 397, 414, 422, 429, 435,
+	436, 440, 437, 438, 441, 309, 258, 379, 273, 282,
+	0, 0, 327, 358, 200, 417, 380, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 278,
+	0, 347, 241, 443, 424, 420, 0, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 369, 172, 173, 185, 193, 203, 216,
+	231, 239, 249, 254, 257, 261, 262, 265, 270, 287,
+	292, 293, 294, 295, 311, 312, 313, 316, 319, 320,
+	323, 325, 326, 329, 335, 336, 337, 338, 339, 341,
+	348, 352, 360, 361, 362, 363, 364, 366, 367, 373,
+	374, 375, 376, 384, 388, 404, 405, 416, 428, 433,
+	250, 412, 434, 0, 286, 0, 0, 288, 235, 253,
+	263, 0, 423, 385, 189, 354, 242, 178, 206, 192,
+	214, 229, 232, 267, 296, 302, 331, 334, 247, 226,
+	204, 351, 201, 371, 391, 392, 393, 395, 300, 221,
+	396, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 225, 0, 0, 0, 0, 276, 222, 0, 0,
+	332, 0, 176, 0, 372, 210, 285, 283, 401, 236,
+	228, 224, 208, 260, 291, 330, 390, 324, 0, 280,
+	0, 0, 381, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	207, 175, 315, 382, 240, 0, 0, 0, 167, 168,
+	169, 0, 1287, 0, 0, 0, 0, 0, 0, 198,
+	0, 205, 0, 0, 0, 0, 220, 264, 227, 219,
+	398, 0, 0, 0, 191, 370, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 0, 304, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 275, 0, 272, 171,
+	187, 0, 0, 314, 353, 359, 0, 0, 0, 211,
+	0, 357, 328, 415, 194, 238, 350, 333, 355, 0,
+	0, 356, 281, 403, 345, 413, 431, 432, 218, 308,
+	421, 394, 427, 442, 188, 215, 322, 387, 418, 378,
+	301, 399, 400, 271, 377, 246, 174, 279, 439, 186,
+	365, 202, 179, 389, 411, 199, 368, 0, 0, 444,
+	181, 409, 386, 298, 268, 269, 180, 0, 349, 223,
+	244, 213, 317, 406, 407, 212, 445, 190, 426, 183,
+	0, 425, 310, 402, 410, 299, 290, 182, 408, 297,
+	289, 274, 234, 255, 343, 284, 344, 256, 306, 305,
+	307, 0, 177, 0, 383, 419, 446, 195, 196, 197,
+	0, 233, 237, 243, 245, 251, 252, 259, 277, 321,
+	342, 340, 346, 0, 397, 414, 422, 429, 435, 436,
+	440, 437, 438, 441, 309, 258, 379, 273, 282, 0,
+	0, 327, 358, 200, 417, 380, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 184, 278, 0,
+	347, 241, 443, 424, 420, 0, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 369, 172, 173, 185, 193, 203, 216, 231,
+	239, 249, 254, 257, 261, 262, 265, 270, 287, 292,
+	293, 294, 295, 311, 312, 313, 316, 319, 320, 323,
+	325, 326, 329, 335, 336, 337, 338, 339, 341, 348,
+	352, 360, 361, 362, 363, 364, 366, 367, 373, 374,
+	375, 376, 384, 388, 404, 405, 416, 428, 433, 250,
+	412, 434, 0, 286, 0, 0, 288, 235, 253, 263,
+	0, 423, 385, 189, 354, 242, 178, 206, 192, 214,
+	229, 232, 267, 296, 302, 331, 334, 247, 226, 204,
+	351, 201, 371, 391, 392, 393, 395, 300, 221, 396,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	225, 0, 0, 0, 0, 276, 222, 0, 0, 332,
+	0, 176, 0, 372, 210, 285, 283, 401, 236, 228,
+	224, 208, 260, 291, 330, 390, 324, 0, 280, 0,
+	0, 381, 303, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 207,
+	175, 315, 382, 240, 0, 0, 0, 167, 168, 169,
+	0, 1105, 0, 0, 0, 0, 0, 0, 198, 0,
+	205, 0, 0, 0, 0, 220, 264, 227, 219, 398,
+	0, 0, 0, 191, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	248, 0, 304, 0, 0, 0, 0, 430, 0, 0,
+	0, 0, 0, 0, 0, 275, 0, 272, 171, 187,
+	0, 0, 314, 353, 359, 0, 0, 0, 211, 0,
+	357, 328, 415, 194, 238, 350, 333, 355, 0, 0,
+	356, 281, 403, 345, 413, 431, 432, 218, 308, 421,
+	394, 427, 442, 188, 215, 322, 387, 418, 378, 301,
+	399, 400, 271, 377, 246, 174, 279, 439, 186, 365,
+	202, 179, 389, 411, 199, 368, 0, 0, 444, 181,
+	409, 386, 298, 268, 269, 180, 0, 349, 223, 244,
+	213, 317, 406, 407, 212, 445, 190, 426, 183, 0,
+	425, 310, 402, 410, 299, 290, 182, 408, 297, 289,
+	274, 234, 255, 343, 284, 344, 256, 306, 305, 307,
+	0, 177, 0, 383, 419, 446, 195, 196, 197, 0,
+	233, 237, 243, 245, 251, 252, 259, 277, 321, 342,
+	340, 346, 0, 397, 414, 422, 429, 435, 436, 440,
+	437, 438, 441, 309, 258, 379, 273, 282, 0, 0,
+	327, 358, 200, 417, 380, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 184, 278, 0, 347,
+	241, 443, 424, 420, 0, 0, 217, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	0, 369, 172, 173, 185, 193, 203, 216, 231, 239,
+	249, 254, 257, 261, 262, 265, 270, 287, 292, 293,
+	294, 295, 311, 312, 313, 316, 319, 320, 323, 325,
+	326, 329, 335, 336, 337, 338, 339, 341, 348, 352,
+	360, 361, 362, 363, 364, 366, 367, 373, 374, 375,
+	376, 384, 388, 404, 405, 416, 428, 433, 250, 412,
+	434, 0, 286, 0, 0, 288, 235, 253, 263, 0,
+	423, 385, 189, 354, 242, 178, 206, 192, 214, 229,
+	232, 267, 296, 302, 331, 334, 247, 226, 204, 351,
+	201, 371, 391, 392, 393, 395, 300, 221, 396, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 225,
+	0, 0, 0, 0, 276, 222, 0, 0, 332, 0,
+	176, 0, 372, 210, 285, 283, 401, 236, 228, 224,
+	208, 260, 291, 330, 390, 324, 0, 280, 0, 0,
+	381, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 207, 175,
+	315, 382, 240, 0, 0, 0, 167, 168, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
+	0, 0, 0, 0, 220, 264, 227, 219, 398, 0,
+	0, 0, 191, 370, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 248,
+	0, 304, 0, 0, 0, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 275, 0, 272, 171, 187, 0,
+	0, 314, 353, 359, 0, 0, 0, 211, 0, 357,
+	328, 415, 194, 238, 350, 333, 355, 0, 0, 356,
+	281, 403, 345, 413, 431, 432, 218, 308, 421, 394,
+	427, 442, 188, 215, 322, 387, 418, 378, 301, 399,
+	400, 271, 377, 246, 174, 279, 439, 186, 365, 202,
+	179, 389, 411, 199, 368, 0, 0, 444, 181, 409,
+	386, 298, 268, 269, 180, 0, 349, 223, 244, 213,
+	317, 406, 407, 212, 445, 190, 426, 183, 0, 425,
+	310, 402, 410, 299, 290, 182, 408, 297, 289, 274,
+	234, 255, 343, 284, 344, 256, 306, 305, 307, 0,
+	177, 0, 383, 419, 446, 195, 196, 197, 0, 233,
+	237, 243, 245, 251, 252, 259, 277, 321, 342, 340,
+	346, 0, 397, 414, 422, 429, 435, 436, 440, 437,
+	438, 441, 309, 258, 379, 273, 282, 0, 0, 327,
+	358, 200, 417, 380, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 184, 278, 1377, 347, 241,
+	443, 424, 420, 0, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 0,
+	369, 172, 173, 185, 193, 203, 216, 231, 239, 249,
+	254, 257, 261, 262, 265, 270, 287, 292, 293, 294,
+	295, 311, 312, 313, 316, 319, 320, 323, 325, 326,
+	329, 335, 336, 337, 338, 339, 341, 348, 352, 360,
+	361, 362, 363, 364, 366, 367, 373, 374, 375, 376,
+	384, 388, 404, 405, 416, 428, 433, 250, 412, 434,
+	0, 286, 0, 0, 288, 235, 253, 263, 0, 423,
+	385, 189, 354, 242, 178, 206, 192, 214, 229, 232,
+	267, 296, 302, 331, 334, 247, 226, 204, 351, 201,
+	371, 391, 392, 393, 395, 300, 221, 396, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 318,
+	0, 1259, 0, 0, 0, 0, 0, 0, 225, 0,
+	0, 0, 0, 276, 222, 0, 0, 332, 0, 176,
+	0, 372, 210, 285, 283, 401, 236, 228, 224, 208,
+	260, 291, 330, 390, 324, 0, 280, 0, 0, 381,
+	303, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 207, 175, 315,
+	382, 240, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
+	0, 0, 0, 220, 264, 227, 219, 398, 0, 0,
+	0, 191, 370, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	304, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 275, 0, 272, 171, 187, 0, 0,
+	314, 353, 359, 0, 0, 0, 211, 0, 357, 328,
+	415, 194, 238, 350, 333, 355, 0, 0, 356, 281,
+	403, 345, 413, 431, 432, 218, 308, 421, 394, 427,
+	442, 188, 215, 322, 387, 418, 378, 301, 399, 400,
+	271, 377, 246, 174, 279, 439, 186, 365, 202, 179,
+	389, 411, 199, 368, 0, 0, 444, 181, 409, 386,
+	298, 268, 269, 180, 0, 349, 223, 244, 213, 317,
+	406, 407, 212, 445, 190, 426, 183, 0, 425, 310,
+	402, 410, 299, 290, 182, 408, 297, 289, 274, 234,
+	255, 343, 284, 344, 256, 306, 305, 307, 0, 177,
+	0, 383, 419, 446, 195, 196, 197, 0, 233, 237,
+	243, 245, 251, 252, 259, 277, 321, 342, 340, 346,
+	0, 397, 414, 422, 429, 435, 436, 440, 437, 438,
+	441, 309, 258, 379, 273, 282, 0, 0, 327, 358,
+	200, 417, 380, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 184, 278, 0, 347, 241, 443,
+	424, 420, 0, 0, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 209, 0, 369,
+	172, 173, 185, 193, 203, 216, 231, 239, 249, 254,
+	257, 261, 262, 265, 270, 287, 292, 293, 294, 295,
+	311, 312, 313, 316, 319, 320, 323, 325, 326, 329,
+	335, 336, 337, 338, 339, 341, 348, 352, 360, 361,
+	362, 363, 364, 366, 367, 373, 374, 375, 376, 384,
+	388, 404, 405, 416, 428, 433, 250, 412, 434, 0,
+	286, 0, 0, 288, 235, 253, 263, 0, 423, 385,
+	189, 354, 242, 178, 206, 192, 214, 229, 232, 267,
+	296, 302, 331, 334, 247, 226, 204, 351, 201, 371,
+	391, 392, 393, 395, 300, 221, 396, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 318, 0,
+	1257, 0, 0, 0, 0, 0, 0, 225, 0, 0,
+	0, 0, 276, 222, 0, 0, 332, 0, 176, 0,
+	372, 210, 285, 283, 401, 236, 228, 224, 208, 260,
+	291, 330, 390, 324, 0, 280, 0, 0, 381, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 207, 175, 315, 382,
+	240, 0, 0, 0, 167, 168, 169, 0, 0, 0,
 	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 2210, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	2127, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
+	0, 0, 220, 264, 227, 219, 398, 0, 0, 0,
+	191, 370, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 248, 0, 304,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 275, 0, 272, 171, 187, 0, 0, 314,
+	353, 359, 0, 0, 0, 211, 0, 357, 328, 415,
+	194, 238, 350, 333, 355, 0, 0, 356, 281, 403,
+	345, 413, 431, 432, 218, 308, 421, 394, 427, 442,
+	188, 215, 322, 387, 418, 378, 301, 399, 400, 271,
+	377, 246, 174, 279, 439, 186, 365, 202, 179, 389,
+	411, 199, 368, 0, 0, 444, 181, 409, 386, 298,
+	268, 269, 180, 0, 349, 223, 244, 213, 317, 406,
+	407, 212, 445, 190, 426, 183, 0, 425, 310, 402,
+	410, 299, 290, 182, 408, 297, 289, 274, 234, 255,
+	343, 284, 344, 256, 306, 305, 307, 0, 177, 0,
+	383, 419, 446, 195, 196, 197, 0, 233, 237, 243,
+	245, 251, 252, 259, 277, 321, 342, 340, 346, 0,
+	397, 414, 422, 429, 435, 436, 440, 437, 438, 441,
+	309, 258, 379, 273, 282, 0, 0, 327, 358, 200,
+	417, 380, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 184, 278, 0, 347, 241, 443, 424,
+	420, 0, 0, 217, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 0, 369, 172,
+	173, 185, 193, 203, 216, 231, 239, 249, 254, 257,
+	261, 262, 265, 270, 287, 292, 293, 294, 295, 311,
+	312, 313, 316, 319, 320, 323, 325, 326, 329, 335,
+	336, 337, 338, 339, 341, 348, 352, 360, 361, 362,
+	363, 364, 366, 367, 373, 374, 375, 376, 384, 388,
+	404, 405, 416, 428, 433, 250, 412, 434, 0, 286,
+	0, 0, 288, 235, 253, 263, 0, 423, 385, 189,
+	354, 242, 178, 206, 192, 214, 229, 232, 267, 296,
+	302, 331, 334, 247, 226, 204, 351, 201, 371, 391,
+	392, 393, 395, 300, 221, 396, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 318, 0, 1255,
+	0, 0, 0, 0, 0, 0, 225, 0, 0, 0,
+	0, 276, 222, 0, 0, 332, 0, 176, 0, 372,
+	210, 285, 283, 401, 236, 228, 224, 208, 260, 291,
+	330, 390, 324, 0, 280, 0, 0, 381, 303, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 207, 175, 315, 382, 240,
+	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
+	0, 220, 264, 227, 219, 398, 0, 0, 0, 191,
+	370, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 248, 0, 304, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 275, 0, 272, 171, 187, 0, 0, 314, 353,
+	359, 0, 0, 0, 211, 0, 357, 328, 415, 194,
+	238, 350, 333, 355, 0, 0, 356, 281, 403, 345,
+	413, 431, 432, 218, 308, 421, 394, 427, 442, 188,
+	215, 322, 387, 418, 378, 301, 399, 400, 271, 377,
+	246, 174, 279, 439, 186, 365, 202, 179, 389, 411,
+	199, 368, 0, 0, 444, 181, 409, 386, 298, 268,
+	269, 180, 0, 349, 223, 244, 213, 317, 406, 407,
+	212, 445, 190, 426, 183, 0, 425, 310, 402, 410,
+	299, 290, 182, 408, 297, 289, 274, 234, 255, 343,
+	284, 344, 256, 306, 305, 307, 0, 177, 0, 383,
+	419, 446, 195, 196, 197, 0, 233, 237, 243, 245,
+	251, 252, 259, 277, 321, 342, 340, 346, 0, 397,
+	414, 422, 429, 435, 436, 440, 437, 438, 441, 309,
+	258, 379, 273, 282, 0, 0, 327, 358, 200, 417,
+	380, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 184, 278, 0, 347, 241, 443, 424, 420,
+	0, 0, 217, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 209, 0, 369, 172, 173,
+	185, 193, 203, 216, 231, 239, 249, 254, 257, 261,
+	262, 265, 270, 287, 292, 293, 294, 295, 311, 312,
+	313, 316, 319, 320, 323, 325, 326, 329, 335, 336,
+	337, 338, 339, 341, 348, 352, 360, 361, 362, 363,
+	364, 366, 367, 373, 374, 375, 376, 384, 388, 404,
+	405, 416, 428, 433, 250, 412, 434, 0, 286, 0,
+	0, 288, 235, 253, 263, 0, 423, 385, 189, 354,
+	242, 178, 206, 192, 214, 229, 232, 267, 296, 302,
+	331, 334, 247, 226, 204, 351, 201, 371, 391, 392,
+	393, 395, 300, 221, 396, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 318, 0, 1253, 0,
+	0, 0, 0, 0, 0, 225, 0, 0, 0, 0,
+	276, 222, 0, 0, 332, 0, 176, 0, 372, 210,
+	285, 283, 401, 236, 228, 224, 208, 260, 291, 330,
+	390, 324, 0, 280, 0, 0, 381, 303, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 207, 175, 315, 382, 240, 0,
 	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
+	220, 264, 227, 219, 398, 0, 0, 0, 191, 370,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 248, 0, 304, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 272, 171, 187, 0, 0, 314, 353, 359,
+	0, 0, 0, 211, 0, 357, 328, 415, 194, 238,
+	350, 333, 355, 0, 0, 356, 281, 403, 345, 413,
+	431, 432, 218, 308, 421, 394, 427, 442, 188, 215,
+	322, 387, 418, 378, 301, 399, 400, 271, 377, 246,
+	174, 279, 439, 186, 365, 202, 179, 389, 411, 199,
+	368, 0, 0, 444, 181, 409, 386, 298, 268, 269,
+	180, 0, 349, 223, 244, 213, 317, 406, 407, 212,
+	445, 190, 426, 183, 0, 425, 310, 402, 410, 299,
+	290, 182, 408, 297, 289, 274, 234, 255, 343, 284,
+	344, 256, 306, 305, 307, 0, 177, 0, 383, 419,
+	446, 195, 196, 197, 0, 233, 237, 243, 245, 251,
+	252, 259, 277, 321, 342, 340, 346, 0, 397, 414,
+	422, 429, 435, 436, 440, 437, 438, 441, 309, 258,
+	379, 273, 282, 0, 0, 327, 358, 200, 417, 380,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 184, 278, 0, 347, 241, 443, 424, 420, 0,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 0, 369, 172, 173, 185,
+	193, 203, 216, 231, 239, 249, 254, 257, 261, 262,
+	265, 270, 287, 292, 293, 294, 295, 311, 312, 313,
+	316, 319, 320, 323, 325, 326, 329, 335, 336, 337,
+	338, 339, 341, 348, 352, 360, 361, 362, 363, 364,
+	366, 367, 373, 374, 375, 376, 384, 388, 404, 405,
+	416, 428, 433, 250, 412, 434, 0, 286, 0, 0,
+	288, 235, 253, 263, 0, 423, 385, 189, 354, 242,
+	178, 206, 192, 214, 229, 232, 267, 296, 302, 331,
+	334, 247, 226, 204, 351, 201, 371, 391, 392, 393,
+	395, 300, 221, 396, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 318, 0, 1251, 0, 0,
+	0, 0, 0, 0, 225, 0, 0, 0, 0, 276,
+	222, 0, 0, 332, 0, 176, 0, 372, 210, 285,
+	283, 401, 236, 228, 224, 208, 260, 291, 330, 390,
+	324, 0, 280, 0, 0, 381, 303, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 207, 175, 315, 382, 240, 0, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 198, 0, 205, 0, 0, 0, 0, 220,
+	264, 227, 219, 398, 0, 0, 0, 191, 370, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 248, 0, 304, 0, 0, 0,
+	0, 430, 0, 0, 0, 0, 0, 0, 0, 275,
+	0, 272, 171, 187, 0, 0, 314, 353, 359, 0,
+	0, 0, 211, 0, 357, 328, 415, 194, 238, 350,
+	333, 355, 0, 0, 356, 281, 403, 345, 413, 431,
+	432, 218, 308, 421, 394, 427, 442, 188, 215, 322,
+	387, 418, 378, 301, 399, 400, 271, 377, 246, 174,
+	279, 439, 186, 365, 202, 179, 389, 411, 199, 368,
+	0, 0, 444, 181, 409, 386, 298, 268, 269, 180,
+	0, 349, 223, 244, 213, 317, 406, 407, 212, 445,
+	190, 426, 183, 0, 425, 310, 402, 410, 299, 290,
+	182, 408, 297, 289, 274, 234, 255, 343, 284, 344,
+	256, 306, 305, 307, 0, 177, 0, 383, 419, 446,
+	195, 196, 197, 0, 233, 237, 243, 245, 251, 252,
+	259, 277, 321, 342, 340, 346, 0, 397, 414, 422,
+	429, 435, 436, 440, 437, 438, 441, 309, 258, 379,
+	273, 282, 0, 0, 327, 358, 200, 417, 380, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	1261, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 1080, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 1351, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 1233, 0, 0, 0, 0, 0,
-	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 1231, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	184, 278, 0, 347, 241, 443, 424, 420, 0, 0,
+	217, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 0, 369, 172, 173, 185, 193,
+	203, 216, 231, 239, 249, 254, 257, 261, 262, 265,
+	270, 287, 292, 293, 294, 295, 311, 312, 313, 316,
+	319, 320, 323, 325, 326, 329, 335, 336, 337, 338,
+	339, 341, 348, 352, 360, 361, 362, 363, 364, 366,
+	367, 373, 374, 375, 376, 384, 388, 404, 405, 416,
+	428, 433, 250, 412, 434, 0, 286, 0, 0, 288,
+	235, 253, 263, 0, 423, 385, 189, 354, 242, 178,
+	206, 192, 214, 229, 232, 267, 296, 302, 331, 334,
+	247, 226, 204, 351, 201, 371, 391, 392, 393, 395,
+	300, 221, 396, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 318, 0, 1247, 0, 0, 0,
+	0, 0, 0, 225, 0, 0, 0, 0, 276, 222,
+	0, 0, 332, 0, 176, 0, 372, 210, 285, 283,
+	401, 236, 228, 224, 208, 260, 291, 330, 390, 324,
+	0, 280, 0, 0, 381, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 207, 175, 315, 382, 240, 0, 0, 0,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 198, 0, 205, 0, 0, 0, 0, 220, 264,
+	227, 219, 398, 0, 0, 0, 191, 370, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 248, 0, 304, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 275, 0,
+	272, 171, 187, 0, 0, 314, 353, 359, 0, 0,
+	0, 211, 0, 357, 328, 415, 194, 238, 350, 333,
+	355, 0, 0, 356, 281, 403, 345, 413, 431, 432,
+	218, 308, 421, 394, 427, 442, 188, 215, 322, 387,
+	418, 378, 301, 399, 400, 271, 377, 246, 174, 279,
+	439, 186, 365, 202, 179, 389, 411, 199, 368, 0,
+	0, 444, 181, 409, 386, 298, 268, 269, 180, 0,
+	349, 223, 244, 213, 317, 406, 407, 212, 445, 190,
+	426, 183, 0, 425, 310, 402, 410, 299, 290, 182,
+	408, 297, 289, 274, 234, 255, 343, 284, 344, 256,
+	306, 305, 307, 0, 177, 0, 383, 419, 446, 195,
+	196, 197, 0, 233, 237, 243, 245, 251, 252, 259,
+	277, 321, 342, 340, 346, 0, 397, 414, 422, 429,
+	435, 436, 440, 437, 438, 441, 309, 258, 379, 273,
+	282, 0, 0, 327, 358, 200, 417, 380, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 1229, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
+	278, 0, 347, 241, 443, 424, 420, 0, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 209, 0, 369, 172, 173, 185, 193, 203,
+	216, 231, 239, 249, 254, 257, 261, 262, 265, 270,
+	287, 292, 293, 294, 295, 311, 312, 313, 316, 319,
+	320, 323, 325, 326, 329, 335, 336, 337, 338, 339,
+	341, 348, 352, 360, 361, 362, 363, 364, 366, 367,
+	373, 374, 375, 376, 384, 388, 404, 405, 416, 428,
+	433, 250, 412, 434, 0, 286, 0, 0, 288, 235,
+	253, 263, 0, 423, 385, 189, 354, 242, 178, 206,
+	192, 214, 229, 232, 267, 296, 302, 331, 334, 247,
+	226, 204, 351, 201, 371, 391, 392, 393, 395, 300,
+	221, 396, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 318, 0, 1245, 0, 0, 0, 0,
+	0, 0, 225, 0, 0, 0, 0, 276, 222, 0,
+	0, 332, 0, 176, 0, 372, 210, 285, 283, 401,
+	236, 228, 224, 208, 260, 291, 330, 390, 324, 0,
+	280, 0, 0, 381, 303, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 207, 175, 315, 382, 240, 0, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	198, 0, 205, 0, 0, 0, 0, 220, 264, 227,
+	219, 398, 0, 0, 0, 191, 370, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 248, 0, 304, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 275, 0, 272,
+	171, 187, 0, 0, 314, 353, 359, 0, 0, 0,
+	211, 0, 357, 328, 415, 194, 238, 350, 333, 355,
+	0, 0, 356, 281, 403, 345, 413, 431, 432, 218,
+	308, 421, 394, 427, 442, 188, 215, 322, 387, 418,
+	378, 301, 399, 400, 271, 377, 246, 174, 279, 439,
+	186, 365, 202, 179, 389, 411, 199, 368, 0, 0,
+	444, 181, 409, 386, 298, 268, 269, 180, 0, 349,
+	223, 244, 213, 317, 406, 407, 212, 445, 190, 426,
+	183, 0, 425, 310, 402, 410, 299, 290, 182, 408,
+	297, 289, 274, 234, 255, 343, 284, 344, 256, 306,
+	305, 307, 0, 177, 0, 383, 419, 446, 195, 196,
+	197, 0, 233, 237, 243, 245, 251, 252, 259, 277,
+	321, 342, 340, 346, 0, 397, 414, 422, 429, 435,
+	436, 440, 437, 438, 441, 309, 258, 379, 273, 282,
+	0, 0, 327, 358, 200, 417, 380, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 278,
+	0, 347, 241, 443, 424, 420, 0, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 369, 172, 173, 185, 193, 203, 216,
+	231, 239, 249, 254, 257, 261, 262, 265, 270, 287,
+	292, 293, 294, 295, 311, 312, 313, 316, 319, 320,
+	323, 325, 326, 329, 335, 336, 337, 338, 339, 341,
+	348, 352, 360, 361, 362, 363, 364, 366, 367, 373,
+	374, 375, 376, 384, 388, 404, 405, 416, 428, 433,
+	250, 412, 434, 0, 286, 0, 0, 288, 235, 253,
+	263, 0, 423, 385, 189, 354, 242, 178, 206, 192,
+	214, 229, 232, 267, 296, 302, 331, 334, 247, 226,
+	204, 351, 201, 371, 391, 392, 393, 395, 300, 221,
+	396, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 318, 0, 1243, 0, 0, 0, 0, 0,
+	0, 225, 0, 0, 0, 0, 276, 222, 0, 0,
+	332, 0, 176, 0, 372, 210, 285, 283, 401, 236,
+	228, 224, 208, 260, 291, 330, 390, 324, 0, 280,
+	0, 0, 381, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	207, 175, 315, 382, 240, 0, 0, 0, 167, 168,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
+	0, 205, 0, 0, 0, 0, 220, 264, 227, 219,
+	398, 0, 0, 0, 191, 370, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 230,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 248, 0, 304, 0, 0, 0, 0, 430, 0,
+	0, 0, 0, 0, 0, 0, 275, 0, 272, 171,
+	187, 0, 0, 314, 353, 359, 0, 0, 0, 211,
+	0, 357, 328, 415, 194, 238, 350, 333, 355, 0,
+	0, 356, 281, 403, 345, 413, 431, 432, 218, 308,
+	421, 394, 427, 442, 188, 215, 322, 387, 418, 378,
+	301, 399, 400, 271, 377, 246, 174, 279, 439, 186,
+	365, 202, 179, 389, 411, 199, 368, 0, 0, 444,
+	181, 409, 386, 298, 268, 269, 180, 0, 349, 223,
+	244, 213, 317, 406, 407, 212, 445, 190, 426, 183,
+	0, 425, 310, 402, 410, 299, 290, 182, 408, 297,
+	289, 274, 234, 255, 343, 284, 344, 256, 306, 305,
+	307, 0, 177, 0, 383, 419, 446, 195, 196, 197,
+	0, 233, 237, 243, 245, 251, 252, 259, 277, 321,
+	342, 340, 346, 0, 397, 414, 422, 429, 435, 436,
+	440, 437, 438, 441, 309, 258, 379, 273, 282, 0,
+	0, 327, 358, 200, 417, 380, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 184, 278, 0,
+	347, 241, 443, 424, 420, 0, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 0, 369, 172, 173, 185, 193, 203, 216, 231,
+	239, 249, 254, 257, 261, 262, 265, 270, 287, 292,
+	293, 294, 295, 311, 312, 313, 316, 319, 320, 323,
+	325, 326, 329, 335, 336, 337, 338, 339, 341, 348,
+	352, 360, 361, 362, 363, 364, 366, 367, 373, 374,
+	375, 376, 384, 388, 404, 405, 416, 428, 433, 250,
+	412, 434, 0, 286, 0, 0, 288, 235, 253, 263,
+	0, 423, 385, 189, 354, 242, 178, 206, 192, 214,
+	229, 232, 267, 296, 302, 331, 334, 247, 226, 204,
+	351, 201, 371, 391, 392, 393, 395, 300, 221, 396,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	225, 0, 0, 0, 0, 276, 222, 0, 0, 332,
+	0, 176, 0, 372, 210, 285, 283, 401, 236, 228,
+	224, 208, 260, 291, 330, 390, 324, 0, 280, 0,
+	0, 381, 303, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 207,
+	175, 315, 382, 240, 1218, 0, 0, 167, 168, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
+	205, 0, 0, 0, 0, 220, 264, 227, 219, 398,
+	0, 0, 0, 191, 370, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 230, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	248, 0, 304, 0, 0, 0, 0, 430, 0, 0,
+	0, 0, 0, 0, 0, 275, 0, 272, 171, 187,
+	0, 0, 314, 353, 359, 0, 0, 0, 211, 0,
+	357, 328, 415, 194, 238, 350, 333, 355, 0, 0,
+	356, 281, 403, 345, 413, 431, 432, 218, 308, 421,
+	394, 427, 442, 188, 215, 322, 387, 418, 378, 301,
+	399, 400, 271, 377, 246, 174, 279, 439, 186, 365,
+	202, 179, 389, 411, 199, 368, 0, 0, 444, 181,
+	409, 386, 298, 268, 269, 180, 0, 349, 223, 244,
+	213, 317, 406, 407, 212, 445, 190, 426, 183, 0,
+	425, 310, 402, 410, 299, 290, 182, 408, 297, 289,
+	274, 234, 255, 343, 284, 344, 256, 306, 305, 307,
+	0, 177, 0, 383, 419, 446, 195, 196, 197, 0,
+	233, 237, 243, 245, 251, 252, 259, 277, 321, 342,
+	340, 346, 0, 397, 414, 422, 429, 435, 436, 440,
+	437, 438, 441, 309, 258, 379, 273, 282, 0, 0,
+	327, 358, 200, 417, 380, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 184, 278, 0, 347,
+	241, 443, 424, 420, 0, 0, 217, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 209,
+	0, 369, 172, 173, 185, 193, 203, 216, 231, 239,
+	249, 254, 257, 261, 262, 265, 270, 287, 292, 293,
+	294, 295, 311, 312, 313, 316, 319, 320, 323, 325,
+	326, 329, 335, 336, 337, 338, 339, 341, 348, 352,
+	360, 361, 362, 363, 364, 366, 367, 373, 374, 375,
+	376, 384, 388, 404, 405, 416, 428, 433, 250, 412,
+	434, 0, 286, 0, 0, 288, 235, 253, 263, 0,
+	423, 385, 189, 354, 242, 178, 206, 192, 214, 229,
+	232, 267, 296, 302, 331, 334, 247, 226, 204, 351,
+	201, 371, 391, 392, 393, 395, 300, 221, 396, 0,
+	0, 0, 0, 1119, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 225,
+	0, 0, 0, 0, 276, 222, 0, 0, 332, 0,
+	176, 0, 372, 210, 285, 283, 401, 236, 228, 224,
+	208, 260, 291, 330, 390, 324, 0, 280, 0, 0,
+	381, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 207, 175,
+	315, 382, 240, 0, 0, 0, 167, 168, 169, 0,
 	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 1227, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	1225, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 220, 264, 227, 219, 398, 0,
+	0, 0, 191, 370, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 230, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 248,
+	0, 304, 0, 0, 0, 0, 430, 0, 0, 0,
+	0, 0, 0, 0, 275, 0, 272, 171, 187, 0,
+	0, 314, 353, 359, 0, 0, 0, 211, 0, 357,
+	328, 415, 194, 238, 350, 333, 355, 0, 0, 356,
+	281, 403, 345, 413, 431, 432, 218, 308, 421, 394,
+	427, 442, 188, 215, 322, 387, 418, 378, 301, 399,
+	400, 271, 377, 246, 174, 279, 439, 186, 365, 202,
+	179, 389, 411, 199, 368, 0, 0, 444, 181, 409,
+	386, 298, 268, 269, 180, 0, 349, 223, 244, 213,
+	317, 406, 407, 212, 445, 190, 426, 183, 0, 425,
+	310, 402, 410, 299, 290, 182, 408, 297, 289, 274,
+	234, 255, 343, 284, 344, 256, 306, 305, 307, 0,
+	177, 0, 383, 419, 446, 195, 196, 197, 0, 233,
+	237, 243, 245, 251, 252, 259, 277, 321, 342, 340,
+	346, 0, 397, 414, 422, 429, 435, 436, 440, 437,
+	438, 441, 309, 258, 379, 273, 282, 0, 0, 327,
+	358, 200, 417, 380, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 184, 278, 0, 347, 241,
+	443, 424, 420, 0, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 0,
+	369, 172, 173, 185, 193, 203, 216, 231, 239, 249,
+	254, 257, 261, 262, 265, 270, 287, 292, 293, 294,
+	295, 311, 312, 313, 316, 319, 320, 323, 325, 326,
+	329, 335, 336, 337, 338, 339, 341, 348, 352, 360,
+	361, 362, 363, 364, 366, 367, 373, 374, 375, 376,
+	384, 388, 404, 405, 416, 428, 433, 250, 412, 434,
+	0, 286, 0, 0, 288, 235, 253, 263, 0, 423,
+	385, 189, 354, 242, 178, 206, 192, 214, 229, 232,
+	267, 296, 302, 331, 334, 247, 226, 204, 351, 201,
+	371, 391, 392, 393, 395, 300, 221, 396, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 318,
+	0, 0, 0, 0, 0, 0, 0, 1110, 225, 0,
+	0, 0, 0, 276, 222, 0, 0, 332, 0, 176,
+	0, 372, 210, 285, 283, 401, 236, 228, 224, 208,
+	260, 291, 330, 390, 324, 0, 280, 0, 0, 381,
+	303, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 207, 175, 315,
+	382, 240, 0, 0, 0, 167, 168, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
+	0, 0, 0, 220, 264, 227, 219, 398, 0, 0,
+	0, 191, 370, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 248, 0,
+	304, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	0, 0, 0, 275, 0, 272, 171, 187, 0, 0,
+	314, 353, 359, 0, 0, 0, 211, 0, 357, 328,
+	415, 194, 238, 350, 333, 355, 0, 0, 356, 281,
+	403, 345, 413, 431, 432, 218, 308, 421, 394, 427,
+	442, 188, 215, 322, 387, 418, 378, 301, 399, 400,
+	271, 377, 246, 174, 279, 439, 186, 365, 202, 179,
+	389, 411, 199, 368, 0, 0, 444, 181, 409, 386,
+	298, 268, 269, 180, 0, 349, 223, 244, 213, 317,
+	406, 407, 212, 445, 190, 426, 183, 0, 425, 310,
+	402, 410, 299, 290, 182, 408, 297, 289, 274, 234,
+	255, 343, 284, 344, 256, 306, 305, 307, 0, 177,
+	0, 383, 419, 446, 195, 196, 197, 0, 233, 237,
+	243, 245, 251, 252, 259, 277, 321, 342, 340, 346,
+	0, 397, 414, 422, 429, 435, 436, 440, 437, 438,
+	441, 309, 258, 379, 273, 282, 0, 0, 327, 358,
+	200, 417, 380, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 184, 278, 0, 347, 241, 443,
+	424, 420, 0, 0, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 209, 0, 369,
+	172, 173, 185, 193, 203, 216, 231, 239, 249, 254,
+	257, 261, 262, 265, 270, 287, 292, 293, 294, 295,
+	311, 312, 313, 316, 319, 320, 323, 325, 326, 329,
+	335, 336, 337, 338, 339, 341, 348, 352, 360, 361,
+	362, 363, 364, 366, 367, 373, 374, 375, 376, 384,
+	388, 404, 405, 416, 428, 433, 250, 412, 434, 0,
+	286, 0, 0, 288, 235, 253, 263, 0, 423, 385,
+	189, 354, 242, 178, 206, 192, 214, 229, 232, 267,
+	296, 302, 331, 334, 247, 226, 204, 351, 201, 371,
+	391, 392, 393, 395, 300, 221, 396, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 225, 0, 0,
+	0, 0, 276, 222, 0, 0, 332, 0, 176, 0,
+	372, 210, 285, 283, 401, 236, 228, 224, 208, 260,
+	291, 330, 390, 324, 0, 280, 0, 0, 381, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 207, 175, 315, 382,
+	240, 0, 0, 0, 167, 168, 169, 0, 949, 0,
 	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 1221, 0, 0, 0, 0, 0,
-	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 1219, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
+	0, 0, 220, 264, 227, 219, 398, 0, 0, 0,
+	191, 370, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 230, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 248, 0, 304,
+	0, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 275, 0, 272, 171, 187, 0, 0, 314,
+	353, 359, 0, 0, 0, 211, 0, 357, 328, 415,
+	194, 238, 350, 333, 355, 0, 0, 356, 281, 403,
+	345, 413, 431, 432, 218, 308, 421, 394, 427, 442,
+	188, 215, 322, 387, 418, 378, 301, 399, 400, 271,
+	377, 246, 174, 279, 439, 186, 365, 202, 179, 389,
+	411, 199, 368, 0, 0, 444, 181, 409, 386, 298,
+	268, 269, 180, 0, 349, 223, 244, 213, 317, 406,
+	407, 212, 445, 190, 426, 183, 0, 425, 310, 402,
+	410, 299, 290, 182, 408, 297, 289, 274, 234, 255,
+	343, 284, 344, 256, 306, 305, 307, 0, 177, 0,
+	383, 419, 446, 195, 196, 197, 0, 233, 237, 243,
+	245, 251, 252, 259, 277, 321, 342, 340, 346, 0,
+	397, 414, 422, 429, 435, 436, 440, 437, 438, 441,
+	309, 258, 379, 273, 282, 0, 0, 327, 358, 200,
+	417, 380, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 184, 278, 0, 347, 241, 443, 424,
+	420, 0, 0, 217, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 209, 0, 369, 172,
+	173, 185, 193, 203, 216, 231, 239, 249, 254, 257,
+	261, 262, 265, 270, 287, 292, 293, 294, 295, 311,
+	312, 313, 316, 319, 320, 323, 325, 326, 329, 335,
+	336, 337, 338, 339, 341, 348, 352, 360, 361, 362,
+	363, 364, 366, 367, 373, 374, 375, 376, 384, 388,
+	404, 405, 416, 428, 433, 250, 412, 434, 0, 286,
+	0, 0, 288, 235, 253, 263, 0, 423, 385, 189,
+	354, 242, 178, 206, 192, 214, 229, 232, 267, 296,
+	302, 331, 334, 247, 226, 204, 351, 201, 371, 391,
+	392, 393, 395, 300, 221, 396, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 318, 0, 0,
+	0, 0, 0, 0, 0, 0, 225, 0, 0, 0,
+	0, 276, 222, 0, 0, 332, 0, 176, 0, 372,
+	210, 285, 283, 401, 236, 228, 224, 208, 260, 291,
+	330, 390, 324, 0, 280, 0, 0, 381, 303, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 207, 175, 315, 382, 240,
+	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
+	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
+	0, 220, 264, 227, 219, 398, 0, 0, 0, 191,
+	370, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 248, 0, 304, 0,
+	0, 0, 0, 430, 0, 0, 0, 0, 0, 0,
+	0, 275, 0, 272, 171, 187, 0, 0, 314, 353,
+	359, 0, 0, 0, 211, 0, 357, 328, 415, 194,
+	238, 350, 333, 355, 0, 0, 356, 281, 403, 345,
+	413, 431, 432, 218, 308, 421, 394, 427, 442, 188,
+	215, 322, 387, 418, 378, 301, 399, 400, 271, 377,
+	246, 174, 279, 439, 186, 365, 202, 179, 389, 411,
+	199, 368, 0, 0, 444, 181, 409, 386, 298, 268,
+	269, 180, 0, 349, 223, 244, 213, 317, 406, 407,
+	212, 445, 190, 426, 183, 0, 425, 310, 402, 410,
+	299, 290, 182, 408, 297, 289, 274, 234, 255, 343,
+	284, 344, 256, 306, 305, 307, 0, 177, 0, 383,
+	419, 446, 195, 196, 197, 0, 233, 237, 243, 245,
+	251, 252, 259, 277, 321, 342, 340, 346, 0, 397,
+	414, 422, 429, 435, 436, 440, 437, 438, 441, 309,
+	258, 379, 273, 282, 0, 0, 327, 358, 200, 417,
+	380, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 184, 278, 0, 347, 241, 443, 424, 420,
+	0, 0, 217, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 596, 0, 0, 0, 209, 0, 369, 172, 173,
+	185, 193, 203, 216, 231, 239, 249, 254, 257, 261,
+	262, 265, 270, 287, 292, 293, 294, 295, 311, 312,
+	313, 316, 319, 320, 323, 325, 326, 329, 335, 336,
+	337, 338, 339, 341, 348, 352, 360, 361, 362, 363,
+	364, 366, 367, 373, 374, 375, 376, 384, 388, 404,
+	405, 416, 428, 433, 250, 412, 434, 0, 286, 0,
+	0, 288, 235, 253, 263, 0, 423, 385, 189, 354,
+	242, 178, 206, 192, 214, 229, 232, 267, 296, 302,
+	331, 334, 247, 226, 204, 351, 201, 371, 391, 392,
+	393, 395, 300, 221, 396, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 225, 0, 0, 0, 0,
+	276, 222, 0, 0, 332, 0, 176, 0, 372, 210,
+	285, 283, 401, 236, 228, 224, 208, 260, 291, 330,
+	390, 324, 0, 280, 0, 0, 381, 303, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 207, 175, 315, 382, 240, 0,
 	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
+	220, 264, 227, 219, 398, 0, 0, 0, 191, 370,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 230, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 500, 0, 248, 0, 304, 0, 0,
+	0, 0, 430, 0, 0, 0, 0, 0, 0, 0,
+	275, 0, 272, 171, 187, 0, 0, 314, 353, 359,
+	0, 0, 0, 211, 0, 357, 328, 415, 194, 238,
+	350, 333, 355, 0, 0, 356, 281, 403, 345, 413,
+	431, 432, 218, 308, 421, 394, 427, 442, 188, 215,
+	322, 387, 418, 378, 301, 399, 400, 271, 377, 246,
+	174, 279, 439, 186, 365, 202, 179, 389, 411, 199,
+	368, 0, 0, 444, 181, 409, 386, 298, 268, 269,
+	180, 0, 349, 223, 244, 213, 317, 406, 407, 212,
+	445, 190, 426, 183, 0, 425, 310, 402, 410, 299,
+	290, 182, 408, 297, 289, 274, 234, 255, 343, 284,
+	344, 256, 306, 305, 307, 0, 177, 0, 383, 419,
+	446, 195, 196, 197, 0, 233, 237, 243, 245, 251,
+	252, 259, 277, 321, 342, 340, 346, 0, 397, 414,
+	422, 429, 435, 436, 440, 437, 438, 441, 309, 258,
+	379, 273, 282, 0, 0, 327, 358, 200, 417, 380,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 184, 278, 0, 347, 241, 443, 424, 420, 0,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 209, 0, 369, 172, 173, 185,
+	193, 203, 216, 231, 239, 249, 254, 257, 261, 262,
+	265, 270, 287, 292, 293, 294, 295, 311, 312, 313,
+	316, 319, 320, 323, 325, 326, 329, 335, 336, 337,
+	338, 339, 341, 348, 352, 360, 361, 362, 363, 364,
+	366, 367, 373, 374, 375, 376, 384, 388, 404, 405,
+	416, 428, 433, 499, 412, 434, 0, 286, 0, 0,
+	288, 235, 253, 263, 0, 423, 385, 189, 354, 242,
+	178, 206, 192, 214, 229, 232, 267, 296, 302, 331,
+	334, 247, 226, 204, 351, 201, 371, 391, 392, 393,
+	395, 300, 221, 396, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 225, 0, 0, 0, 0, 276,
+	222, 0, 0, 332, 0, 176, 0, 372, 210, 285,
+	283, 401, 236, 228, 224, 208, 260, 291, 330, 390,
+	324, 0, 280, 0, 0, 381, 303, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 207, 175, 315, 382, 240, 0, 0,
+	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 198, 0, 205, 0, 0, 0, 0, 220,
+	264, 227, 219, 398, 0, 0, 0, 191, 370, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 248, 0, 304, 0, 0, 449,
+	0, 430, 0, 0, 0, 0, 0, 0, 0, 275,
+	0, 272, 171, 187, 0, 0, 314, 353, 359, 0,
+	0, 0, 211, 0, 357, 328, 415, 194, 238, 350,
+	333, 355, 0, 0, 356, 281, 403, 345, 413, 431,
+	432, 218, 308, 421, 394, 427, 442, 188, 215, 322,
+	387, 418, 378, 301, 399, 400, 271, 377, 246, 174,
+	279, 439, 186, 365, 202, 179, 389, 411, 199, 368,
+	0, 0, 444, 181, 409, 386, 298, 268, 269, 180,
+	0, 349, 223, 244, 213, 317, 406, 407, 212, 445,
+	190, 426, 183, 0, 425, 310, 402, 410, 299, 290,
+	182, 408, 297, 289, 274, 234, 255, 343, 284, 344,
+	256, 306, 305, 307, 0, 177, 0, 383, 419, 446,
+	195, 196, 197, 0, 233, 237, 243, 245, 251, 252,
+	259, 277, 321, 342, 340, 346, 0, 397, 414, 422,
+	429, 435, 436, 440, 437, 438, 441, 309, 258, 379,
+	273, 282, 0, 0, 327, 358, 200, 417, 380, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 1217, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 1192, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	184, 278, 0, 347, 241, 443, 424, 420, 0, 0,
+	217, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 209, 0, 369, 172, 173, 185, 193,
+	203, 216, 231, 239, 249, 254, 257, 261, 262, 265,
+	270, 287, 292, 293, 294, 295, 311, 312, 313, 316,
+	319, 320, 323, 325, 326, 329, 335, 336, 337, 338,
+	339, 341, 348, 352, 360, 361, 362, 363, 364, 366,
+	367, 373, 374, 375, 376, 384, 388, 404, 405, 416,
+	428, 433, 250, 412, 434, 0, 286, 0, 0, 288,
+	235, 253, 263, 0, 423, 385, 189, 354, 242, 178,
+	206, 192, 214, 229, 232, 267, 296, 302, 331, 334,
+	247, 226, 204, 351, 201, 371, 391, 392, 393, 395,
+	300, 221, 396, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 225, 0, 0, 0, 0, 276, 222,
+	0, 0, 332, 0, 176, 0, 372, 210, 285, 283,
+	401, 236, 228, 224, 208, 260, 291, 330, 390, 324,
+	0, 280, 0, 0, 381, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 207, 175, 315, 382, 240, 0, 0, 0,
 	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 1093, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
-	1084, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 940, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
+	0, 198, 0, 205, 0, 0, 0, 0, 220, 264,
+	227, 219, 398, 0, 0, 0, 191, 370, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 248, 0, 304, 0, 0, 0, 0,
+	430, 0, 0, 0, 0, 0, 0, 0, 275, 0,
+	272, 171, 187, 0, 0, 314, 353, 359, 0, 0,
+	0, 211, 0, 357, 328, 415, 194, 238, 350, 333,
+	355, 0, 0, 356, 281, 403, 345, 413, 431, 432,
+	218, 308, 421, 394, 427, 442, 188, 215, 322, 387,
+	418, 378, 301, 399, 400, 271, 377, 246, 174, 279,
+	439, 186, 365, 202, 179, 389, 411, 199, 368, 0,
+	0, 444, 181, 409, 386, 298, 268, 269, 180, 0,
+	349, 223, 244, 213, 317, 406, 407, 212, 445, 190,
+	426, 183, 0, 425, 310, 402, 410, 299, 290, 182,
+	408, 297, 289, 274, 234, 255, 343, 284, 344, 256,
+	306, 305, 307, 0, 177, 0, 383, 419, 446, 195,
+	196, 197, 0, 233, 237, 243, 245, 251, 252, 259,
+	277, 321, 342, 340, 346, 0, 397, 414, 422, 429,
+	435, 436, 440, 437, 438, 441, 309, 258, 379, 273,
+	282, 0, 0, 327, 358, 200, 417, 380, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 497, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 496, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 446, 0, 427, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
+	278, 0, 347, 241, 443, 424, 420, 0, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 209, 0, 369, 172, 173, 185, 193, 203,
+	216, 231, 239, 249, 254, 257, 261, 262, 265, 270,
+	287, 292, 293, 294, 295, 311, 312, 313, 316, 319,
+	320, 323, 325, 326, 329, 335, 336, 337, 338, 339,
+	341, 348, 352, 360, 361, 362, 363, 364, 366, 367,
+	373, 374, 375, 376, 384, 388, 404, 405, 416, 428,
+	433, 250, 412, 434, 0, 286, 0, 0, 288, 235,
+	253, 263, 0, 423, 385, 189, 354, 242, 178, 206,
+	192, 214, 229, 232, 267, 296, 302, 331, 334, 247,
+	226, 204, 351, 201, 371, 391, 392, 393, 395, 300,
+	221, 396, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 225, 0, 0, 0, 0, 276, 222, 0,
+	0, 332, 0, 176, 0, 372, 210, 285, 283, 401,
+	236, 228, 224, 208, 260, 291, 330, 390, 324, 0,
+	280, 0, 0, 381, 303, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 207, 175, 315, 382, 240, 0, 0, 0, 167,
+	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	198, 0, 205, 0, 0, 0, 0, 220, 264, 227,
+	219, 398, 0, 0, 0, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 248, 0, 304, 0, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 275, 0, 272,
+	171, 187, 0, 0, 314, 353, 359, 0, 0, 0,
+	211, 0, 357, 328, 415, 194, 238, 350, 333, 355,
+	0, 0, 356, 281, 403, 345, 413, 431, 432, 218,
+	308, 421, 394, 427, 442, 188, 215, 322, 387, 418,
+	378, 301, 399, 400, 271, 377, 246, 174, 279, 439,
+	186, 365, 202, 179, 389, 411, 199, 368, 0, 0,
+	444, 181, 409, 386, 298, 268, 269, 180, 0, 349,
+	223, 244, 213, 317, 406, 407, 212, 445, 190, 426,
+	183, 0, 425, 310, 402, 410, 299, 290, 182, 408,
+	297, 289, 274, 234, 255, 343, 284, 344, 256, 306,
+	305, 307, 0, 177, 0, 383, 419, 446, 195, 196,
+	197, 0, 233, 237, 243, 245, 251, 252, 259, 277,
+	321, 342, 340, 346, 0, 397, 414, 422, 429, 435,
+	436, 440, 437, 438, 441, 309, 258, 379, 273, 282,
+	0, 0, 327, 358, 200, 417, 380, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 184, 278,
+	0, 347, 241, 443, 424, 420, 0, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 369, 172, 173, 185, 193, 203, 216,
+	231, 239, 249, 254, 257, 261, 262, 265, 270, 287,
+	292, 293, 294, 295, 311, 312, 313, 316, 319, 320,
+	323, 325, 326, 329, 335, 336, 337, 338, 339, 341,
+	348, 352, 360, 361, 362, 363, 364, 366, 367, 373,
+	374, 375, 376, 384, 388, 404, 405, 416, 428, 433,
+	250, 412, 434, 0, 286, 0, 0, 288, 235, 253,
+	263, 0, 423, 385, 189, 354, 242, 178, 206, 192,
+	214, 229, 232, 267, 296, 302, 331, 334, 247, 226,
+	204, 351, 201, 371, 391, 392, 393, 395, 300, 221,
 }
 
 var yyPact = [...]int{
-	4154, -1000, -344, 1699, -1000, -1000, -1000, -1000, -1000, -1000,
+	4721, -1000, -337, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1755, 1411, -1000, -1000, -1000, -1000, -1000,
+	-1000, 723, 1368, -1000, 1629, 4574, -1000, 30235, 502, -1000,
+	29756, 501, 241, 30235, -1000, 144, -1000, 118, 30235, 126,
+	29277, -1000, -1000, -277, 12989, 1563, 24, 23, 30235, -1000,
+	1714, 255, -1000, 28798, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1383, 1670, 1755, 11552, -1000, 11552, 428, 428,
+	428, 9156, -1000, -1000, 17781, 30235, 30235, 247, -1000, 1629,
+	-1000, -1000, 275, -1000, 267, 1314, -1000, 1313, -1000, 568,
+	343, 312, 385, 375, 311, 308, 307, 306, 305, 296,
+	277, 276, 316, -1000, 687, 687, -129, -130, 3020, 406,
+	406, 406, 449, 1589, 1584, -1000, 571, -1000, 687, 687,
+	249, 687, 687, 687, 687, 227, 224, 687, 687, 687,
+	687, 687, 687, 687, 687, 687, 687, 687, 687, 687,
+	687, 687, 396, 1629, 207, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1662, 1188, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 650, 1341, -1000, 1572, 2334, -1000, 29179, 418,
-	-1000, 28705, 412, 61, 29179, -1000, 97, -1000, 88, 29179,
-	93, 28231, -1000, -1000, -270, 12587, 1511, 1, -1, 29179,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1319,
-	1632, 1646, 1660, 1094, 1630, -1000, 10690, 10690, 360, 360,
-	360, 8794, -1000, -1000, 16854, 29179, 29179, 215, -1000, 1572,
-	-1000, -1000, 275, -1000, 294, 1290, -1000, 1287, -1000, 398,
-	345, 291, 341, 337, 279, 270, 269, 266, 265, 264,
-	260, 257, 297, -1000, 651, 651, -141, -142, 292, 350,
-	350, 350, 374, 1529, 1525, -1000, 507, -1000, 651, 651,
-	239, 651, 651, 651, 651, 222, 221, 651, 651, 651,
-	651, 651, 651, 651, 651, 651, 651, 651, 651, 651,
-	651, 651, 213, 1572, 186, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4126,27 +4291,27 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 30235, 139, 30235,
+	-1000, 581, 30235, 769, 769, 37, 769, 769, 769, 769,
+	137, 494, 18, -1000, 121, 214, 112, 212, 768, 232,
+	73, -1000, -1000, 203, 768, 109, -1000, 769, 7232, 7232,
+	7232, -1000, 1616, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 444, -1000, -1000, -1000, -1000, 30235, 28319, 289, 706,
+	-1000, -1000, -1000, 9, -1000, -1000, 1147, 972, -1000, 12989,
+	2384, 1316, 1316, -1000, -1000, 565, -1000, -1000, 14426, 14426,
+	14426, 14426, 14426, 14426, 14426, 14426, 14426, 14426, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 29179, 104, 29179, -1000, 538, 29179,
-	727, 727, 4, 727, 727, 727, 727, 101, 492, -4,
-	-1000, 99, 181, 90, 187, 700, 131, 70, -1000, -1000,
-	183, 700, 110, -1000, 727, 6890, 6890, 6890, -1000, 1556,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 369, -1000,
-	-1000, -1000, -1000, 29179, 27757, 290, 669, -1000, -1000, -1000,
-	3, -1000, -1000, 1207, 1010, -1000, 12587, 1291, 1251, 1251,
-	-1000, -1000, 460, -1000, -1000, 14009, 14009, 14009, 14009, 14009,
-	14009, 14009, 14009, 14009, 14009, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1251,
-	537, -1000, 12113, 1251, 1251, 1251, 1251, 1251, 1251, 1251,
-	1251, 12587, 1251, 1251, 1251, 1251, 1251, 1251, 1251, 1251,
-	1251, 1251, 1251, 1251, 1251, 1251, 1251, 1251, 1251, -1000,
-	-1000, -1000, 29179, -1000, 1251, 105, 1662, -1000, 1188, -1000,
-	-1000, -1000, 1560, 12587, 12587, 1662, -1000, 1465, 10690, -1000,
-	-1000, 1597, -1000, -1000, -1000, -1000, -1000, 768, 1686, -1000,
-	15431, 536, 1685, 27283, -1000, 20647, 26809, 1284, 8318, -37,
-	-1000, -1000, -1000, 662, 19225, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1316, 579, -1000, 10594, 1316, 1316, 1316,
+	1316, 1316, 1316, 1316, 1316, 12989, 1316, 1316, 1316, 1316,
+	1316, 1316, 1316, 1316, 1316, 1316, 1316, 1316, 1316, 1316,
+	1316, 1316, 1316, 1316, 1316, 1316, 1316, 1316, 1316, 1316,
+	1316, 1316, 1316, 1316, -1000, -1000, -1000, 30235, -1000, 1316,
+	157, 1679, 1710, 1018, 1744, 1307, 30235, -1000, 1334, 1714,
+	-1000, 255, -1000, -1000, -1000, 1733, -1000, 15863, 576, 851,
+	1731, 27840, -1000, 21134, 27361, 1312, 8675, -2, -1000, -1000,
+	-1000, 699, 19697, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1616, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1556, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4158,196 +4323,211 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1201,
-	29179, -1000, -1000, 2672, 1001, -1000, 1333, -1000, 1199, -1000,
-	1299, 1302, 410, 1001, 397, 392, 391, -1000, -82, -1000,
-	-1000, -1000, -1000, -1000, 651, 651, 296, 2334, 3561, -1000,
-	-1000, -1000, 26335, 1331, 1001, -1000, 1330, -1000, 718, 437,
-	449, 449, 1001, -1000, -1000, 29179, 1001, 716, 715, 29179,
-	29179, -1000, 25861, -1000, 25387, 24913, 970, 29179, 24439, 23965,
-	23491, 23017, 22543, -1000, 1437, -1000, 1303, -1000, -1000, -1000,
-	29179, 29179, 29179, 207, -1000, -1000, 29179, 1001, -1000, -1000,
-	952, 914, 651, 651, 907, 1030, 1029, 1028, 651, 651,
-	905, 1027, 21121, 249, 896, 878, 877, 1032, 1026, 122,
-	1020, 879, 869, 29179, 1329, 29179, -1000, 150, 617, 248,
-	661, 1572, 1510, 1283, 367, 404, 1001, 353, 353, -1000,
-	7366, -1000, -1000, 1024, 12587, -1000, 724, 700, 700, -1000,
-	-1000, -1000, -1000, -1000, -1000, 727, 29179, 724, -1000, -1000,
-	-1000, 700, 727, 29179, 727, 727, 727, 727, 700, 700,
-	700, 727, 29179, 29179, 29179, 29179, 29179, 29179, 29179, 29179,
-	29179, 6890, 6890, 6890, 598, 727, -1000, 1401, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 91, -1000, -1000, -1000,
-	-1000, -1000, 1699, -1000, -1000, -1000, -98, 1282, 22069, -1000,
-	-274, -282, -285, -286, -1000, -1000, -1000, -287, -295, -1000,
-	-1000, -1000, 12587, 12587, 12587, 12587, 1038, 606, 14009, 868,
-	656, 14009, 14009, 14009, 14009, 14009, 14009, 14009, 14009, 14009,
-	14009, 14009, 14009, 14009, 14009, 14009, 658, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1001, -1000, 1682, 1143, 1143,
-	560, 560, 560, 560, 560, 560, 560, 560, 560, 14483,
-	9268, 7366, 1094, 1178, 1662, 10690, 10690, 12587, 12587, 11638,
-	11164, 10690, 1578, 660, 1010, 29179, -1000, 1025, -1000, -1000,
-	13535, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 29179, 29179, 10690, 10690, 10690, 10690, 10690,
-	-1000, 1281, -1000, -166, 16380, 12587, 1023, 1646, 1094, 1597,
-	1588, 1693, 594, 938, 1255, -1000, 909, 1646, 18751, 1276,
-	-1000, 1597, -1000, -1000, -1000, 29179, -1000, -1000, 21595, -1000,
-	-1000, 6414, 29179, 256, 29179, -1000, 1246, 1564, -1000, -1000,
-	-1000, 1619, 18277, 29179, 1248, 1247, -1000, -1000, 525, 7842,
-	-37, -1000, 7842, 1233, -1000, -29, -43, 9742, 559, -1000,
-	-1000, -1000, 292, 14957, 1080, 1517, 34, -1000, -1000, -1000,
-	1299, -1000, 1299, 1299, 1299, 1299, 207, 207, 207, 207,
-	-1000, -1000, -1000, -1000, -1000, 1325, 1323, -1000, 1299, 1299,
-	1299, 1299, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1322,
-	1322, 1322, 1316, 1316, 339, -1000, 12587, 172, 29179, 1587,
-	865, 150, 358, 1384, 1001, 1001, 1001, 358, -1000, 930,
-	922, -1000, 1254, -1000, -1000, 1659, -1000, -1000, 555, 764,
-	758, 520, 29179, 121, 255, -1000, 321, -1000, 29179, 1001,
-	712, 449, 1001, -1000, 1001, -1000, -1000, -1000, -1000, 523,
-	-1000, -1000, 1001, 1252, -1000, 1214, 799, 732, 749, 710,
-	1252, -1000, -1000, -111, 1252, -1000, 1252, -1000, 1252, -1000,
-	1252, -1000, 1252, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 630, 29179, 121, 658, -1000, 364, -1000, -1000, 658,
-	658, -1000, -1000, -1000, -1000, 1013, 1012, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1273, 30235,
+	-1000, -1000, 3907, 1091, -1000, 1367, -1000, 1271, -1000, 1345,
+	1347, 500, 1091, 493, 491, 489, -1000, -52, -1000, -1000,
+	-1000, -1000, -1000, 687, 687, 292, 4574, 3966, -1000, -1000,
+	-1000, 26882, 1366, 1091, -1000, 1364, -1000, 764, 471, 532,
+	532, 1091, -1000, -1000, 30235, 1091, 757, 756, 30235, 30235,
+	-1000, 26403, -1000, 25924, 25445, 1013, 30235, 24966, 24487, 24008,
+	23529, 23050, -1000, 1447, -1000, 1355, -1000, -1000, -1000, 30235,
+	30235, 30235, 242, -1000, -1000, 30235, 1091, -1000, -1000, 1008,
+	1006, 687, 687, 1001, 1090, 1085, 1083, 687, 687, 942,
+	1074, 21613, 206, 930, 923, 922, 979, 1060, 125, 963,
+	960, 920, 30235, 1363, 30235, -1000, 196, 681, 304, 696,
+	1629, 1557, 1311, 443, 497, 1091, 409, 409, -1000, 7713,
+	-1000, -1000, 1058, 12989, -1000, 772, 768, 768, -1000, -1000,
+	-1000, -1000, -1000, -1000, 769, 30235, 772, -1000, -1000, -1000,
+	768, 769, 30235, 769, 769, 769, 769, 768, 768, 768,
+	769, 30235, 30235, 30235, 30235, 30235, 30235, 30235, 30235, 30235,
+	7232, 7232, 7232, 641, 769, -1000, 1422, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 124, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -104, 1310, 22571, -1000, -281,
+	-282, -284, -285, -1000, -1000, -1000, -286, -287, -1000, -1000,
+	-1000, 12989, 12989, 12989, 12989, 1016, 669, 14426, 873, 652,
+	14426, 14426, 14426, 14426, 14426, 14426, 14426, 14426, 14426, 14426,
+	14426, 14426, 14426, 14426, 14426, 791, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1091, -1000, 265, 1121, 1121, 591,
+	591, 591, 591, 591, 591, 591, 591, 591, 14905, 9635,
+	7713, 1239, 1732, 1269, 1714, 11552, 11552, 12989, 12989, 12510,
+	12031, 11552, 1597, 714, 972, 30235, -1000, 1018, -1000, -1000,
+	13947, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 30235, 30235, 11552, 11552, 11552, 11552, 11552,
+	1018, 1018, 1018, 1018, 1018, 12989, 12989, 12989, 12989, 12989,
+	12989, -1000, 1309, -1000, -162, 17302, 12989, 1057, 1604, 12989,
+	12989, 1714, -1000, 1476, 11552, -1000, -1000, 1689, -1000, -1000,
+	-1000, -1000, -1000, 30235, 1307, 1663, 30235, 1679, 1018, 1689,
+	30235, -1000, -1000, 22092, -1000, -1000, 6751, -1000, 30235, 271,
+	30235, -1000, 1331, 1482, -1000, -1000, -1000, 1662, 16823, 30235,
+	1293, 1284, -1000, -1000, 570, 8194, -2, -1000, 8194, 1292,
+	-1000, 0, -12, 10114, 575, -1000, -1000, -1000, 3020, 15384,
+	1152, 1569, 66, -1000, -1000, -1000, 1345, -1000, 1345, 1345,
+	1345, 1345, 242, 242, 242, 242, -1000, -1000, -1000, -1000,
+	-1000, 1362, 1361, -1000, 1345, 1345, 1345, 1345, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1360, 1360, 1360, 1346, 1346,
+	383, -1000, 12989, 168, 30235, 1647, 917, 196, 418, 1388,
+	1091, 1091, 1091, 418, -1000, 1148, 1084, -1000, 1302, -1000,
+	-1000, 1709, -1000, -1000, 597, 814, 808, 543, 30235, 167,
+	253, -1000, 420, -1000, 30235, 1091, 755, 532, 1091, -1000,
+	1091, -1000, -1000, -1000, -1000, 569, -1000, -1000, 1091, 1299,
+	-1000, 1305, 835, 807, 801, 803, 1299, -1000, -1000, -84,
+	1299, -1000, 1299, -1000, 1299, -1000, 1299, -1000, 1299, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 646, 30235, 167,
+	791, -1000, 442, -1000, -1000, 791, 791, -1000, -1000, -1000,
+	-1000, 1055, 1053, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -339, 29179, -1000, 141, 659, 229,
-	272, 209, 29179, 188, 1622, 204, 217, 29179, 29179, 353,
-	1399, 29179, 1602, 29179, -1000, -1000, -1000, -1000, 1010, 29179,
-	-1000, -1000, 727, 727, -1000, -1000, 29179, 727, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 727, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1007, -1000, 29179, 29179, -1000, -1000, -1000, -1000, -1000,
-	37, -31, 205, -1000, -1000, -1000, -1000, 1625, -1000, 1010,
-	606, 648, 614, -1000, -1000, 830, -1000, -1000, 2931, -1000,
-	-1000, -1000, -1000, 868, 14009, 14009, 14009, 578, 2931, 3060,
-	889, 3074, 560, 655, 655, 558, 558, 558, 558, 558,
-	797, 797, -1000, -1000, -1000, -1000, 1025, -1000, -1000, -1000,
-	1025, 10690, 10690, 1241, 1251, 513, -1000, 1319, -1000, -1000,
-	1646, 1163, 1163, 795, 949, 612, 1684, 1163, 590, 1678,
-	1163, 1163, 10690, -1000, -1000, 685, -1000, 12587, 1025, -1000,
-	704, 1237, 1236, 1163, 1025, 1025, 1163, 1163, 29179, -1000,
-	-263, -1000, -65, 524, 1251, -1000, 21121, 1025, 1207, -1000,
-	1560, -1000, -1000, 1508, -1000, 1462, 12587, 12587, 12587, -1000,
-	-1000, -1000, 1560, 1649, -1000, 1478, 1474, 1672, 10690, 20647,
-	1597, -1000, -1000, -1000, 506, 1672, 1257, 1251, -1000, 29179,
-	20647, 20647, 20647, 20647, 20647, -1000, 1432, 1428, -1000, 1455,
-	1438, 1412, 29179, -1000, 1173, 1094, 18277, 256, 1121, 20647,
-	29179, -1000, -1000, 20647, 29179, 5938, -1000, 1233, -37, -46,
-	-1000, -1000, -1000, -1000, 1010, -1000, 903, -1000, 2546, -1000,
-	319, -1000, -1000, -1000, -1000, 756, 1618, 1515, 25, -1000,
-	-1000, -1000, 207, 207, -1000, -1000, 559, 807, 559, 559,
-	559, 1006, 1006, -1000, -1000, -1000, -1000, -1000, 862, -1000,
-	-1000, -1000, 796, -1000, -1000, 991, 1409, 172, -1000, -1000,
-	651, 1003, 1520, 29179, -1000, -1000, 1074, 141, 29179, 679,
-	1397, -1000, 1384, 1384, 1384, 29179, -1000, -1000, -1000, -1000,
-	3521, 29179, 1171, -1000, 111, 29179, 1066, 29179, -1000, 1169,
-	1321, 1001, 1001, -1000, -1000, 7366, -1000, 29179, 1251, -1000,
-	-1000, -1000, -1000, 401, 1567, 1566, 121, 111, 559, 1001,
-	-1000, -1000, -1000, -1000, -1000, -337, 1165, 379, 129, 182,
-	29179, 29179, 29179, 29179, 29179, 461, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 190, 362, -1000, 29179, 29179, 488, -1000,
-	-1000, -1000, 700, -1000, -1000, 700, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1554, -32, -312, -1000, -305, -1000, -1000, -1000,
-	-1000, 578, 2931, 3023, -1000, 14009, 14009, -1000, -1000, 1163,
-	1163, 10690, 7366, 1662, 1560, -1000, -1000, 298, 658, 298,
-	14009, 14009, -1000, 14009, 14009, -1000, -99, 1213, 615, -1000,
-	12587, 773, -1000, -1000, 14009, 14009, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 385, 383, 381, 29179, -1000,
-	-1000, -1000, 933, 1002, 1460, 1010, 1010, -1000, -1000, 29179,
-	-1000, -1000, -1000, -1000, 1670, 12587, -1000, 1230, -1000, 5462,
-	1646, 1395, 29179, 1251, 1699, 15906, 29179, 1185, -1000, 653,
-	1564, 1370, 1391, 1683, -1000, -1000, -1000, -1000, 1422, -1000,
-	1416, -1000, -1000, -1000, -1000, -1000, 1094, 1672, 20647, 1161,
-	-1000, 1161, -1000, 505, -1000, -1000, -1000, -51, -39, -1000,
-	-1000, -1000, 292, -1000, -1000, -1000, -1000, 776, 14009, 1692,
-	-1000, 1000, -1000, -1000, 711, 701, -1000, 29179, 1320, -1000,
-	-1000, -1000, 559, 559, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1155, -1000, 1139, 1222, 1131, 89, -1000, 1148, 1552,
-	651, 651, -1000, 793, -1000, 1001, -1000, -1000, 378, -1000,
-	1596, 29179, 1390, 1389, 1388, -1000, 1657, 1209, -1000, 29179,
-	-1000, -1000, 29179, -1000, -1000, 1473, 172, 29179, -1000, -1000,
-	-1000, -1000, 255, 29179, -1000, 1143, 111, -1000, -1000, -1000,
-	-1000, -1000, -1000, 29179, 132, -1000, 1317, 994, -1000, 1367,
-	-1000, -1000, -1000, -1000, 109, 227, -1000, 29179, 426, 1409,
-	29179, -1000, -1000, -1000, 727, 727, -1000, 1539, -1000, 1001,
-	-1000, 14009, 2931, 2931, -1000, -1000, 1025, -1000, 1646, -1000,
-	1025, 1299, 1299, -1000, 1299, 1316, -1000, 1299, 81, 1299,
-	78, 1025, 1025, 3006, 2637, 2307, 1210, 1251, -90, -1000,
-	1010, 12587, 2254, 1894, 1251, 1251, 1251, 1125, 993, 207,
-	-1000, -1000, -1000, 1665, 1655, 1010, -1000, -1000, -1000, 1561,
-	1144, 1123, -1000, -1000, 10216, 1127, 1472, 485, 1125, 1662,
-	29179, 12587, -1000, -1000, 12587, 1293, -1000, 12587, -1000, -1000,
-	-1000, 1662, 1662, 1161, -1000, -1000, 569, -1000, -1000, -1000,
-	-1000, -1000, 2931, -94, -1000, -1000, -1000, 1292, 14009, -1000,
-	-1000, 207, 988, 207, 790, -1000, 782, -1000, -1000, -184,
-	-1000, -1000, 1298, 1414, -1000, -1000, 29179, -1000, -1000, 29179,
-	29179, 29179, 29179, 29179, -1000, -1000, 219, -1000, 1118, 1107,
-	-1000, -131, -1000, -1000, 1260, -1000, -1000, -1000, 1059, -1000,
-	-116, 1001, 29179, 29179, 29179, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2931, -1000, 1560, -1000, -1000, 262, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 14009, 14009, 14009,
-	14009, 14009, 1646, 985, 1010, 14009, 14009, 17802, 20173, 20173,
-	17328, 207, 20, -1000, 12587, 12587, 699, -1000, 1251, -1000,
-	1259, 29179, 1251, 29179, -1000, 1646, -1000, 1010, 1010, 29179,
-	1010, 1646, -1000, -1000, 29179, 1250, 559, -1000, 559, 1056,
-	1036, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1260,
-	-1000, -1000, -1000, 1209, -1000, 212, 278, -1000, 255, -1000,
-	-147, -150, 1617, 29179, -1000, -1000, 7366, -1000, -1000, 1258,
-	1371, -1000, -1000, -1000, -1000, 704, 704, 704, 704, 271,
-	1025, -1000, 704, 704, 1101, -1000, -1000, -1000, 1101, 1101,
-	524, -237, -1000, 1506, 1480, 1010, 1207, 1690, -1000, 1251,
-	1699, 470, 1123, -1000, -1000, 1083, -1000, 1065, -1000, -1000,
-	-1000, -1000, -1000, 1613, 1251, -1000, -1000, -1000, -1000, 1188,
-	1062, 1141, -1000, 647, 29179, 29179, -1000, -1000, -1000, -1000,
-	1025, 185, -121, -1000, -1000, -1000, 19699, -1000, -1000, -1000,
-	-1000, 20, 276, -1000, 1494, 1480, -1000, 1654, 1502, 1653,
-	-1000, 29179, 1123, 29179, -1000, 1387, 1048, 1188, 13061, 228,
-	-1000, 7366, 4986, 1044, -1000, -1000, 1449, -105, -129, -1000,
-	-1000, 1490, 1493, 1493, 1494, -1000, 1650, 1648, -1000, 984,
-	1633, 983, 1099, -1000, 1386, -1000, 1676, -1000, -1000, -1000,
-	735, 978, -1000, -1000, -1000, 228, 704, 1025, -1000, -40,
-	-1000, -1000, -1000, -1000, -1000, 1367, -1000, 1439, -1000, 1485,
-	805, -1000, -1000, -1000, -1000, 977, 973, -1000, 904, -1000,
-	-1000, 1689, 486, 486, -1000, -1000, -1000, -1000, -1000, 338,
-	-1000, -1000, -116, -119, -1000, 800, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 318, 847, -1000, 147, -1000, -127,
-	-1000, -1000, -1000, -1000, -1000, -1000, -138, -1000,
+	-330, 30235, -1000, 185, 694, 238, 281, 231, 30235, 152,
+	1672, 220, 221, 30235, 30235, 409, 1421, 30235, 1650, 30235,
+	-1000, -1000, -1000, -1000, 972, 30235, -1000, -1000, 769, 769,
+	-1000, -1000, 30235, 769, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 769, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1048, -1000, 30235,
+	30235, -1000, -1000, -1000, -1000, -1000, 32, -7, 266, -1000,
+	-1000, -1000, -1000, 1674, -1000, 972, 669, 822, 726, -1000,
+	-1000, 964, -1000, -1000, 2635, -1000, -1000, -1000, -1000, 873,
+	14426, 14426, 14426, 620, 2635, 2497, 1146, 1012, 591, 705,
+	705, 602, 602, 602, 602, 602, 879, 879, -1000, -1000,
+	-1000, -1000, 1018, -1000, -1000, -1000, 1018, 11552, 11552, 1298,
+	-1000, 1316, 555, -1000, 1383, -1000, 1239, -1000, 1679, 1197,
+	1197, 1050, 1064, 708, 1730, 1197, 703, 1728, 1197, 1197,
+	11552, -1000, -1000, 711, -1000, 12989, 1018, -1000, 1263, 1297,
+	1296, 1197, 1018, 1018, 1197, 1197, -156, -156, -156, -156,
+	-156, 782, 782, 782, 877, 1269, 1269, 30235, -1000, -271,
+	-1000, -31, 562, 1316, -1000, 21613, 1018, 1147, -1000, 1641,
+	1749, 616, 966, 1294, -1000, 944, 1679, 19218, 1348, 1689,
+	-1000, -1000, -1000, 1316, 1265, -1000, 1604, -1000, -1000, -1000,
+	-1000, 538, 1722, 239, 1316, -1000, 30235, 21134, 21134, 21134,
+	21134, 21134, -1000, 1464, 1463, -1000, 1462, 1443, 1514, 30235,
+	-1000, 1262, 1018, 16823, 271, 1275, 21134, 30235, -1000, -1000,
+	21134, 30235, 6270, -1000, 1292, -2, -22, -1000, -1000, -1000,
+	-1000, 972, -1000, 981, -1000, 327, -1000, 349, -1000, -1000,
+	-1000, -1000, 622, 1661, 1567, 57, -1000, -1000, -1000, 242,
+	242, -1000, -1000, 575, 864, 575, 575, 575, 1047, 1047,
+	-1000, -1000, -1000, -1000, -1000, 911, -1000, -1000, -1000, 890,
+	-1000, -1000, 870, 1433, 168, -1000, -1000, 687, 1045, 1579,
+	30235, -1000, -1000, -1000, -1000, 1143, 185, 30235, 721, 1414,
+	-1000, 1388, 1388, 1388, 30235, -1000, -1000, -1000, -1000, 2665,
+	30235, 1238, -1000, 165, 30235, 1110, 30235, -1000, 1227, 1359,
+	1091, 1091, -1000, -1000, 7713, -1000, 30235, 1316, -1000, -1000,
+	-1000, -1000, 496, 1628, 1627, 167, 165, 575, 1091, -1000,
+	-1000, -1000, -1000, -1000, -333, 1199, 468, 171, 223, 30235,
+	30235, 30235, 30235, 30235, 517, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 225, 430, -1000, 30235, 30235, 469, -1000, -1000,
+	-1000, 768, -1000, -1000, 768, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1610, -8, -307, -1000, -304, -1000, -1000, -1000, -1000,
+	620, 2635, 2419, -1000, 14426, 14426, -1000, -156, 1197, 1197,
+	11552, 11552, 7713, 1714, -1000, 1604, -1000, -1000, 402, 791,
+	402, 14426, 14426, -1000, 14426, 14426, -1000, -66, 1291, 709,
+	-1000, 12989, 857, -1000, -1000, 14426, 14426, -1000, -1000, -1000,
+	-1000, -1000, -1000, 21134, -1000, -1000, -1000, -1000, -156, 39,
+	39, 12989, 39, 39, -1000, -1000, -1000, -1000, 483, 478,
+	472, 30235, -1000, -1000, -1000, 1554, -1000, 1438, 12989, 12989,
+	12989, -1000, 1604, 1675, -1000, 1500, 1496, 1722, 21134, 1689,
+	-1000, -1000, 30235, -1000, 5789, 1679, 12989, 1413, 30235, 1316,
+	-1000, 16343, 30235, 1290, -1000, 688, 1482, 1405, 1409, 1435,
+	-1000, -1000, -1000, -1000, 1453, -1000, 1451, -1000, -1000, -1000,
+	-1000, -1000, 1018, 1722, 21134, 1191, -1000, 1191, -1000, 536,
+	-1000, -1000, -1000, -20, -28, -1000, -1000, -1000, 3020, -1000,
+	-1000, -1000, -1000, 846, 14426, 1745, -1000, 1042, -1000, -1000,
+	750, 746, -1000, 30235, 1358, -1000, -1000, -1000, 575, 575,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1189, -1000, 1183,
+	1286, 1181, 99, -1000, 1335, 1609, 687, 687, -1000, 889,
+	-1000, 1091, -1000, -1000, 462, -1000, 1645, 30235, 1408, 1407,
+	1403, -1000, 1707, 1187, 30235, -1000, -1000, 30235, -1000, -1000,
+	1485, 168, 30235, -1000, -1000, -1000, -1000, 253, 30235, -1000,
+	1121, 165, -1000, -1000, -1000, -1000, -1000, -1000, 30235, 178,
+	-1000, 1351, 1099, -1000, 1376, -1000, -1000, -1000, -1000, 146,
+	233, -1000, 30235, 446, 1433, 30235, -1000, -1000, -1000, 769,
+	769, -1000, 1607, -1000, 1091, -1000, 14426, 2635, 2635, -1000,
+	-1000, -1000, -1000, -1000, 1018, -1000, 1679, -1000, 1018, 1345,
+	1345, -1000, 1345, 1346, -1000, 1345, 114, 1345, 113, 1018,
+	1018, 2587, 2447, 2317, 2296, 1316, -59, -1000, 972, 12989,
+	2279, 1560, 30714, -1000, -1000, -156, -201, -203, -156, 782,
+	-156, -156, 1316, 1316, 1316, 1177, 1010, 1041, 1472, 972,
+	972, -1000, -1000, 30235, -1000, -1000, -1000, -1000, 1720, 1279,
+	-1000, -1000, -1000, -1000, 972, -1000, 1631, 1125, 1164, -1000,
+	-1000, 11073, 1179, 1480, 533, 1177, 1714, 30235, 12989, -1000,
+	-1000, 12989, 1339, -1000, 12989, -1000, -1000, -1000, 1714, 1714,
+	1191, -1000, -1000, 600, -1000, -1000, -1000, -1000, -1000, 2635,
+	-58, -1000, -1000, -1000, 1336, 14426, -1000, -1000, 242, 1039,
+	242, 876, -1000, 867, -1000, -1000, -183, -1000, -1000, 1343,
+	1437, -1000, -1000, 30235, -1000, -1000, 30235, 30235, 30235, 30235,
+	-1000, -1000, 250, -1000, 1173, 1169, -1000, -115, -1000, -1000,
+	1334, -1000, -1000, -1000, 1108, -1000, -90, 1091, 30235, 30235,
+	30235, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2635,
+	-1000, 1604, -1000, -1000, 326, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 14426, 14426, 14426, 14426, 14426, 1679, 1038,
+	972, 14426, 14426, 1018, 269, -1000, -1000, -1000, -1000, -1000,
+	1727, -1000, -1000, 18739, 20655, 20655, 18260, 1034, 242, -1000,
+	-1000, -1000, 1718, 1706, 744, -1000, 1316, -1000, 254, 30235,
+	1316, 30235, -1000, 1679, -1000, 972, 972, 30235, 972, 1679,
+	-1000, -1000, 30235, 1424, 575, -1000, 575, 1106, 1102, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1334, -1000, -1000,
+	-1000, 1187, 245, 320, -1000, 253, -1000, -131, -134, 1660,
+	-1000, -1000, 7713, -1000, -1000, 1317, 1381, -1000, -1000, -1000,
+	-1000, 1263, 1263, 1263, 1263, 235, 1018, -1000, 1263, 1263,
+	-1000, 1714, 1705, 39, 163, 1157, -1000, -1000, -1000, 1157,
+	1157, 562, 242, 46, -161, 12989, 12989, 1738, -1000, 1316,
+	-1000, 523, 1164, -1000, -1000, 1139, -1000, 1132, -1000, -1000,
+	-1000, -1000, -1000, 1656, 1316, -1000, -1000, -1000, -1000, 255,
+	1185, -1000, 684, 30235, 30235, -1000, -1000, -1000, -1000, 1018,
+	230, -99, -1000, -1000, -1000, 111, 12989, -156, -1000, -1000,
+	20176, -1000, -1000, -1000, -1000, -266, -1000, 1546, 1537, -1000,
+	30235, 972, 1147, 30235, 1164, 30235, -1000, 1395, 540, 255,
+	13468, -89, 7713, 5308, 1113, -1000, -1000, 1469, -74, -107,
+	-1000, 147, -1000, -1000, 1147, -1000, -1000, -1000, 46, 313,
+	-1000, 1547, 1537, -1000, 1702, 1540, 1700, -1000, 1056, -1000,
+	1652, 1022, -1000, 1393, -1000, 1726, -1000, -1000, -1000, 790,
+	1029, -1000, -1000, -1000, -89, 1263, 1018, -1000, -44, -1000,
+	-1000, -1000, -1000, -1000, 1376, -1000, 1425, -1000, -1000, 183,
+	-167, -181, -187, -1000, -1000, -1000, 14426, 1534, 1538, 1538,
+	1547, -1000, 1699, 1698, -1000, 1024, 1697, 1023, 30235, 1316,
+	-1000, 1736, 498, 498, -1000, -1000, -1000, -1000, -1000, 381,
+	-1000, -1000, -90, -93, 737, -1000, -1000, -1000, -1000, -1000,
+	14905, 1509, 904, -1000, -1000, -1000, -1000, 1021, 1019, -1000,
+	967, -1000, -1000, 30714, -1000, -1000, -1000, 344, 919, -1000,
+	194, -1000, -100, 183, -1000, -1000, 869, -1000, -1000, -1000,
+	-1000, -1000, 1018, -1000, -1000, -1000, -1000, -119, -1000, -1000,
+	-1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1999, 1997, 11, 134, 86, 1995, 1988, 1985, 1984,
-	136, 133, 132, 1983, 1982, 1981, 1980, 1976, 1975, 1974,
-	1972, 1970, 1968, 1967, 1966, 68, 140, 45, 48, 138,
-	1965, 1964, 32, 1962, 1961, 1959, 131, 129, 598, 1958,
-	122, 1957, 1956, 1955, 1954, 1953, 1951, 1950, 1949, 1948,
-	1946, 1944, 1943, 1941, 1938, 224, 1937, 1936, 4, 1935,
-	34, 1932, 1931, 1930, 1929, 1928, 107, 1922, 1921, 1920,
-	123, 1919, 1900, 53, 113, 50, 77, 1899, 1898, 80,
-	127, 1896, 71, 103, 1894, 1893, 960, 1892, 61, 82,
-	126, 1891, 51, 1890, 1889, 57, 1888, 1887, 1886, 87,
-	1885, 1882, 2989, 1879, 76, 93, 19, 42, 1878, 1877,
-	1866, 1864, 38, 932, 1862, 1858, 25, 1856, 1848, 144,
-	1847, 94, 22, 1846, 17, 35, 24, 1843, 98, 1842,
-	30, 56, 36, 1841, 85, 1840, 1837, 1836, 1835, 33,
-	1834, 81, 111, 63, 1833, 1832, 1831, 7, 15, 1829,
-	1828, 1827, 1825, 1823, 1821, 14, 1819, 5, 1818, 26,
-	1817, 27, 20, 43, 79, 160, 29, 13, 1815, 117,
-	1814, 28, 121, 75, 115, 1812, 1811, 1810, 956, 152,
-	1809, 1808, 58, 1807, 106, 102, 1806, 215, 1805, 1801,
-	72, 1266, 2571, 8, 118, 1799, 1798, 2274, 59, 83,
-	23, 1797, 89, 1796, 1787, 1785, 141, 135, 44, 948,
-	46, 1783, 1782, 1781, 1779, 1778, 1775, 1774, 47, 149,
-	66, 110, 31, 1772, 1771, 1770, 74, 65, 1766, 114,
-	105, 78, 99, 1765, 120, 101, 64, 1764, 41, 1762,
-	1755, 1745, 1741, 40, 1740, 1739, 1734, 1733, 104, 108,
-	69, 39, 1731, 37, 73, 112, 109, 1729, 18, 124,
-	10, 1728, 2, 0, 1727, 3, 130, 176, 119, 1725,
-	1724, 1, 1719, 6, 1718, 1717, 84, 1714, 1712, 1710,
-	16, 21, 9, 1708, 1706, 90, 355, 116, 1705, 128,
-}
-
-//line sql.y:5326
+	0, 2025, 127, 22, 1620, 125, 110, 131, 137, 130,
+	2023, 2022, 21, 2021, 2, 2020, 2019, 2018, 5, 2017,
+	1, 2015, 2014, 18, 2013, 2011, 2010, 2009, 2008, 145,
+	143, 142, 2007, 2006, 2005, 2004, 1999, 1998, 1991, 1988,
+	1987, 1985, 1984, 1983, 76, 144, 56, 60, 140, 1982,
+	1981, 35, 1980, 1979, 1976, 135, 134, 677, 1974, 133,
+	1972, 1971, 1970, 1969, 1968, 1967, 1965, 1963, 1962, 1961,
+	1959, 1958, 1957, 1956, 215, 1955, 1954, 9, 1953, 40,
+	1952, 1949, 1948, 1947, 1946, 107, 1944, 1943, 1942, 102,
+	1940, 1939, 67, 219, 64, 75, 1938, 1936, 57, 136,
+	1934, 82, 108, 1933, 1932, 391, 1931, 73, 91, 106,
+	1930, 66, 1928, 1924, 71, 1921, 1920, 1919, 89, 1918,
+	1916, 3023, 1915, 87, 101, 16, 46, 1914, 1913, 1910,
+	1908, 50, 52, 1906, 1905, 31, 1904, 1903, 147, 1902,
+	104, 15, 1901, 14, 30, 32, 1900, 94, 1898, 41,
+	72, 43, 1897, 105, 1896, 1895, 1894, 1892, 27, 1891,
+	58, 90, 77, 1890, 1889, 1886, 8, 12, 1884, 1883,
+	1882, 1881, 1880, 1879, 11, 1877, 6, 1874, 37, 1873,
+	24, 34, 55, 88, 63, 28, 13, 1871, 129, 1870,
+	38, 122, 84, 111, 1867, 1865, 1861, 984, 157, 1855,
+	1854, 47, 1853, 112, 116, 1851, 181, 1850, 1849, 93,
+	1378, 2133, 36, 124, 1848, 1846, 2934, 86, 103, 39,
+	1845, 79, 1843, 1842, 1841, 146, 148, 62, 1003, 70,
+	1839, 1838, 1836, 1835, 1834, 1833, 1832, 150, 33, 19,
+	126, 42, 1827, 1823, 1822, 85, 65, 1819, 119, 114,
+	81, 109, 1818, 141, 123, 100, 1817, 44, 1816, 1814,
+	1813, 1812, 78, 1811, 1808, 1804, 1803, 113, 115, 83,
+	49, 1801, 45, 68, 121, 118, 1800, 29, 138, 20,
+	1799, 3, 0, 1798, 7, 132, 191, 117, 1797, 1796,
+	4, 1795, 10, 1794, 1790, 99, 1787, 1784, 1781, 26,
+	17, 25, 1773, 1767, 3026, 589, 120, 1763, 139,
+}
+
+//line sql.y:5627
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -4494,6 +4674,16 @@ func (st *yySymType) createTableUnion() *CreateTable {
 	return v
 }
 
+func (st *yySymType) cteUnion() *CommonTableExpr {
+	v, _ := st.union.(*CommonTableExpr)
+	return v
+}
+
+func (st *yySymType) ctesUnion() []*CommonTableExpr {
+	v, _ := st.union.([]*CommonTableExpr)
+	return v
+}
+
 func (st *yySymType) derivedTableUnion() *DerivedTable {
 	v, _ := st.union.(*DerivedTable)
 	return v
@@ -4514,6 +4704,26 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
+func (st *yySymType) frameUnitTypeUnion() FrameUnitType {
+	v, _ := st.union.(FrameUnitType)
+	return v
+}
+
+func (st *yySymType) fromFirstLastTypeUnion() FromFirstLastType {
+	v, _ := st.union.(FromFirstLastType)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -4604,6 +4814,11 @@ func (st *yySymType) matchExprOptionUnion() MatchExprOption {
 	return v
 }
 
+func (st *yySymType) nullTreatmentTypeUnion() NullTreatmentType {
+	v, _ := st.union.(NullTreatmentType)
+	return v
+}
+
 func (st *yySymType) optLikeUnion() *OptLike {
 	v, _ := st.union.(*OptLike)
 	return v
@@ -4629,6 +4844,11 @@ func (st *yySymType) orderDirectionUnion() OrderDirection {
 	return v
 }
 
+func (st *yySymType) overClauseUnion() *OverClause {
+	v, _ := st.union.(*OverClause)
+	return v
+}
+
 func (st *yySymType) partDefUnion() *PartitionDefinition {
 	v, _ := st.union.(*PartitionDefinition)
 	return v
@@ -4794,148 +5014,173 @@ func (st *yySymType) whensUnion() []*When {
 	return v
 }
 
+func (st *yySymType) windowDefinitionUnion() *WindowDefinition {
+	v, _ := st.union.(*WindowDefinition)
+	return v
+}
+
+func (st *yySymType) windowDefinitionsUnion() WindowDefinitions {
+	v, _ := st.union.(WindowDefinitions)
+	return v
+}
+
+func (st *yySymType) windowSpecUnion() *WindowSpecification {
+	v, _ := st.union.(*WindowSpecification)
+	return v
+}
+
+func (st *yySymType) withUnion() *With {
+	v, _ := st.union.(*With)
+	return v
+}
+
 var yyR1 = [...]int{
-	0, 283, 284, 284, 1, 1, 1, 1, 1, 1,
+	0, 302, 303, 303, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 263, 263, 263, 266, 266,
-	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
-	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
-	12, 12, 287, 287, 97, 97, 95, 95, 96, 96,
-	164, 164, 13, 14, 14, 174, 174, 173, 173, 173,
-	175, 175, 175, 175, 209, 209, 15, 15, 15, 15,
-	15, 71, 71, 265, 265, 264, 262, 262, 261, 261,
-	260, 23, 24, 33, 33, 33, 33, 34, 35, 267,
-	267, 239, 39, 39, 38, 38, 38, 38, 40, 40,
-	37, 37, 36, 36, 241, 241, 228, 228, 240, 240,
-	240, 240, 240, 240, 240, 227, 227, 85, 85, 145,
-	145, 145, 145, 145, 145, 145, 145, 279, 279, 146,
-	146, 146, 146, 146, 146, 280, 280, 280, 280, 211,
-	211, 211, 211, 214, 214, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 213, 213, 213, 213, 213, 215,
-	215, 215, 215, 215, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 217,
-	217, 217, 217, 217, 217, 217, 217, 226, 226, 218,
-	218, 221, 221, 222, 222, 222, 223, 223, 223, 224,
-	224, 219, 219, 219, 219, 220, 220, 220, 229, 253,
-	253, 252, 252, 250, 250, 250, 250, 238, 238, 247,
-	247, 247, 247, 247, 237, 237, 233, 233, 233, 234,
-	234, 235, 235, 232, 232, 236, 236, 249, 249, 248,
-	230, 230, 231, 231, 255, 281, 281, 281, 281, 282,
-	282, 256, 272, 273, 271, 271, 271, 271, 271, 60,
-	60, 60, 186, 186, 186, 245, 245, 244, 244, 244,
-	246, 246, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	181, 181, 181, 270, 270, 270, 270, 270, 270, 269,
-	269, 269, 242, 242, 242, 268, 268, 131, 131, 132,
-	132, 30, 30, 30, 30, 30, 30, 29, 29, 29,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 31, 31, 26, 26, 26, 26, 26, 26,
-	26, 26, 26, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 225, 225, 225, 257, 257, 258,
-	258, 17, 22, 22, 18, 18, 18, 18, 19, 19,
-	41, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 274, 274, 180,
-	180, 188, 188, 179, 179, 202, 202, 202, 182, 182,
-	182, 183, 183, 278, 278, 278, 43, 43, 45, 45,
-	46, 47, 47, 204, 204, 205, 205, 48, 49, 61,
-	61, 61, 61, 61, 61, 63, 63, 63, 7, 7,
-	7, 7, 57, 57, 57, 6, 6, 44, 44, 51,
-	275, 275, 276, 277, 277, 277, 277, 52, 54, 20,
-	20, 20, 20, 20, 20, 78, 78, 66, 66, 66,
-	66, 66, 66, 66, 66, 66, 66, 66, 66, 72,
-	72, 72, 67, 67, 288, 55, 56, 56, 70, 70,
-	70, 64, 64, 64, 69, 69, 69, 75, 75, 77,
-	77, 77, 77, 77, 79, 79, 79, 79, 79, 79,
-	79, 74, 74, 76, 76, 76, 76, 195, 195, 195,
-	194, 194, 87, 87, 88, 88, 89, 89, 90, 90,
-	90, 129, 105, 105, 162, 162, 161, 161, 163, 163,
-	163, 163, 165, 165, 91, 91, 91, 91, 92, 92,
-	93, 93, 94, 94, 203, 203, 200, 200, 200, 199,
-	199, 98, 98, 98, 100, 99, 99, 99, 99, 101,
-	101, 103, 103, 102, 102, 104, 106, 106, 106, 106,
-	106, 107, 107, 86, 86, 86, 86, 86, 86, 86,
-	86, 177, 177, 109, 109, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 120, 120, 120, 120, 120,
-	120, 110, 110, 110, 110, 110, 110, 110, 73, 73,
-	121, 121, 121, 128, 122, 122, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	117, 117, 117, 117, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 289, 289, 119, 118, 118, 118,
-	118, 118, 118, 118, 68, 68, 68, 68, 68, 208,
-	208, 208, 210, 210, 210, 210, 210, 210, 210, 210,
-	210, 210, 210, 210, 210, 135, 135, 65, 65, 133,
-	133, 134, 136, 136, 130, 130, 130, 112, 112, 112,
-	112, 112, 112, 112, 112, 114, 114, 114, 137, 137,
-	138, 138, 139, 139, 140, 140, 141, 142, 142, 142,
-	143, 143, 143, 143, 32, 32, 32, 32, 32, 27,
-	27, 27, 27, 28, 28, 28, 80, 80, 80, 80,
-	82, 82, 81, 81, 58, 58, 59, 59, 59, 83,
-	83, 84, 84, 84, 84, 159, 159, 159, 144, 144,
-	144, 144, 151, 151, 151, 147, 147, 149, 149, 149,
-	150, 150, 150, 148, 156, 156, 158, 158, 157, 157,
-	153, 153, 154, 154, 155, 155, 155, 152, 152, 111,
-	111, 111, 111, 111, 160, 160, 160, 160, 166, 166,
-	124, 124, 126, 126, 125, 127, 167, 167, 171, 168,
-	168, 172, 172, 172, 172, 172, 169, 169, 170, 170,
-	196, 196, 196, 176, 176, 187, 187, 184, 184, 185,
-	185, 178, 178, 189, 189, 189, 53, 123, 123, 254,
-	254, 251, 192, 192, 193, 193, 197, 197, 201, 201,
-	198, 198, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 285, 286, 206, 207, 207,
-	207,
+	1, 1, 1, 1, 1, 282, 282, 282, 285, 285,
+	40, 69, 3, 3, 4, 4, 4, 4, 2, 2,
+	7, 7, 8, 8, 9, 27, 28, 5, 6, 6,
+	29, 29, 81, 81, 30, 31, 31, 31, 31, 306,
+	306, 116, 116, 114, 114, 115, 115, 183, 183, 32,
+	33, 33, 193, 193, 192, 192, 192, 194, 194, 194,
+	194, 228, 228, 34, 34, 34, 34, 34, 90, 90,
+	284, 284, 283, 281, 281, 280, 280, 279, 42, 43,
+	52, 52, 52, 52, 53, 54, 286, 286, 258, 58,
+	58, 57, 57, 57, 57, 59, 59, 56, 56, 55,
+	55, 260, 260, 247, 247, 259, 259, 259, 259, 259,
+	259, 259, 246, 246, 104, 104, 164, 164, 164, 164,
+	164, 164, 164, 164, 298, 298, 165, 165, 165, 165,
+	165, 165, 299, 299, 299, 299, 230, 230, 230, 230,
+	233, 233, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 232, 232, 232, 232, 232, 234, 234, 234, 234,
+	234, 235, 235, 235, 235, 235, 235, 235, 235, 235,
+	235, 235, 235, 235, 235, 235, 236, 236, 236, 236,
+	236, 236, 236, 236, 245, 245, 237, 237, 240, 240,
+	241, 241, 241, 242, 242, 242, 243, 243, 238, 238,
+	238, 238, 239, 239, 239, 248, 272, 272, 271, 271,
+	269, 269, 269, 269, 257, 257, 266, 266, 266, 266,
+	266, 256, 256, 252, 252, 252, 253, 253, 254, 254,
+	251, 251, 255, 255, 268, 268, 267, 249, 249, 250,
+	250, 274, 300, 300, 300, 300, 301, 301, 275, 291,
+	292, 290, 290, 290, 290, 290, 79, 79, 79, 205,
+	205, 205, 264, 264, 263, 263, 263, 265, 265, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 200, 200, 200,
+	289, 289, 289, 289, 289, 289, 288, 288, 288, 261,
+	261, 261, 287, 287, 150, 150, 151, 151, 49, 49,
+	49, 49, 49, 49, 48, 48, 48, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 50,
+	50, 45, 45, 45, 45, 45, 45, 45, 45, 45,
+	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+	35, 35, 35, 35, 35, 35, 35, 35, 35, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 244, 244, 244, 276, 276, 277, 277, 36, 41,
+	41, 37, 37, 37, 37, 38, 38, 60, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
+	61, 61, 61, 61, 293, 293, 199, 199, 207, 207,
+	198, 198, 221, 221, 221, 201, 201, 201, 202, 202,
+	297, 297, 297, 62, 62, 64, 64, 65, 66, 66,
+	223, 223, 224, 224, 67, 68, 80, 80, 80, 80,
+	80, 80, 82, 82, 82, 26, 26, 26, 26, 76,
+	76, 76, 25, 25, 63, 63, 70, 294, 294, 295,
+	296, 296, 296, 296, 71, 73, 39, 39, 39, 39,
+	39, 39, 97, 97, 85, 85, 85, 85, 85, 85,
+	85, 85, 85, 85, 85, 85, 91, 91, 91, 86,
+	86, 307, 74, 75, 75, 89, 89, 89, 83, 83,
+	83, 88, 88, 88, 94, 94, 96, 96, 96, 96,
+	96, 98, 98, 98, 98, 98, 98, 98, 93, 93,
+	95, 95, 95, 95, 214, 214, 214, 213, 213, 106,
+	106, 107, 107, 108, 108, 109, 109, 109, 148, 124,
+	124, 181, 181, 180, 180, 182, 182, 182, 182, 184,
+	184, 110, 110, 110, 110, 111, 111, 112, 112, 113,
+	113, 222, 222, 219, 219, 219, 218, 218, 117, 117,
+	117, 119, 118, 118, 118, 118, 120, 120, 122, 122,
+	121, 121, 123, 125, 125, 125, 125, 125, 126, 126,
+	105, 105, 105, 105, 105, 105, 105, 105, 196, 196,
+	128, 128, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 139, 139, 139, 139, 139, 139, 129, 129,
+	129, 129, 129, 129, 129, 92, 92, 140, 140, 140,
+	147, 147, 141, 141, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 132, 132, 132, 136,
+	136, 136, 136, 10, 10, 10, 10, 10, 10, 10,
+	10, 10, 10, 10, 23, 23, 23, 22, 22, 22,
+	13, 13, 12, 12, 14, 24, 24, 15, 15, 19,
+	19, 19, 21, 21, 20, 20, 20, 20, 20, 11,
+	11, 11, 11, 16, 16, 17, 17, 18, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 135, 308, 308,
+	138, 137, 137, 137, 137, 137, 137, 137, 87, 87,
+	87, 87, 87, 227, 227, 227, 229, 229, 229, 229,
+	229, 229, 229, 229, 229, 229, 229, 229, 229, 154,
+	154, 84, 84, 152, 152, 153, 155, 155, 149, 149,
+	149, 131, 131, 131, 131, 131, 131, 131, 131, 133,
+	133, 133, 156, 156, 157, 157, 158, 158, 159, 159,
+	160, 161, 161, 161, 162, 162, 162, 162, 51, 51,
+	51, 51, 51, 46, 46, 46, 46, 47, 47, 47,
+	99, 99, 99, 99, 101, 101, 100, 100, 77, 77,
+	78, 78, 78, 102, 102, 103, 103, 103, 103, 178,
+	178, 178, 163, 163, 163, 163, 170, 170, 170, 166,
+	166, 168, 168, 168, 169, 169, 169, 167, 175, 175,
+	177, 177, 176, 176, 172, 172, 173, 173, 174, 174,
+	174, 171, 171, 130, 130, 130, 130, 130, 179, 179,
+	179, 179, 185, 185, 143, 143, 145, 145, 144, 146,
+	186, 186, 190, 187, 187, 191, 191, 191, 191, 191,
+	188, 188, 189, 189, 215, 215, 215, 195, 195, 206,
+	206, 203, 203, 204, 204, 197, 197, 208, 208, 208,
+	72, 142, 142, 273, 273, 270, 211, 211, 212, 212,
+	216, 216, 220, 220, 217, 217, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 209, 209, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 210, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 304, 305, 225, 226, 226, 226,
 }
 
 var yyR2 = [...]int{
@@ -4943,100 +5188,106 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	2, 3, 5, 6, 6, 7, 4, 6, 5, 7,
-	8, 1, 3, 7, 8, 1, 1, 9, 9, 8,
-	7, 7, 1, 1, 1, 3, 1, 3, 1, 3,
-	0, 4, 3, 5, 4, 1, 3, 3, 2, 2,
-	2, 2, 2, 1, 1, 1, 2, 2, 6, 12,
-	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
-	3, 6, 4, 7, 8, 8, 8, 6, 3, 1,
-	1, 4, 0, 1, 1, 1, 2, 2, 0, 1,
-	4, 4, 4, 4, 2, 4, 1, 3, 1, 1,
-	3, 4, 3, 3, 3, 4, 9, 0, 2, 0,
-	2, 3, 3, 4, 2, 3, 2, 1, 1, 0,
-	2, 2, 3, 3, 2, 2, 1, 2, 1, 3,
-	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
-	2, 2, 2, 2, 4, 4, 2, 2, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
-	3, 0, 5, 0, 3, 5, 0, 1, 1, 0,
-	1, 0, 2, 2, 2, 0, 2, 2, 5, 0,
-	1, 1, 2, 1, 3, 2, 3, 0, 1, 4,
-	3, 3, 4, 2, 0, 2, 1, 1, 1, 1,
-	1, 0, 1, 1, 1, 0, 1, 1, 3, 3,
-	3, 1, 3, 1, 7, 5, 6, 6, 7, 0,
-	1, 5, 3, 3, 1, 1, 2, 2, 2, 0,
-	1, 1, 0, 1, 2, 0, 1, 1, 3, 2,
-	1, 2, 3, 3, 4, 4, 3, 3, 3, 3,
-	4, 4, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 4, 5,
-	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
-	2, 0, 1, 5, 1, 3, 7, 1, 3, 3,
-	1, 2, 2, 2, 5, 5, 5, 6, 6, 5,
-	5, 2, 2, 2, 2, 3, 3, 3, 4, 1,
-	3, 5, 1, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 2, 4, 4, 2, 11, 3, 6,
-	8, 6, 6, 6, 13, 8, 6, 10, 5, 5,
-	5, 5, 5, 3, 7, 4, 4, 4, 4, 3,
-	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
-	8, 3, 3, 5, 7, 7, 6, 5, 3, 2,
-	3, 3, 3, 7, 3, 3, 3, 3, 4, 7,
-	5, 2, 4, 4, 4, 4, 4, 5, 5, 4,
-	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	2, 4, 2, 4, 5, 4, 4, 4, 4, 3,
-	3, 5, 2, 3, 3, 3, 3, 1, 1, 0,
-	1, 0, 1, 1, 1, 0, 2, 2, 0, 2,
-	2, 0, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 5, 0, 1, 0, 1, 2, 3, 0,
-	3, 3, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 3, 3, 2, 2, 3,
-	1, 3, 2, 1, 2, 1, 2, 2, 4, 3,
-	3, 6, 4, 7, 6, 1, 3, 2, 2, 2,
-	2, 1, 1, 1, 3, 2, 1, 1, 1, 0,
-	1, 1, 0, 3, 0, 2, 0, 2, 1, 2,
-	2, 0, 1, 1, 0, 1, 1, 0, 1, 0,
-	1, 2, 3, 4, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
-	1, 1, 0, 2, 1, 3, 1, 1, 1, 3,
-	3, 3, 3, 7, 0, 3, 1, 3, 1, 1,
-	3, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
-	5, 0, 2, 1, 3, 3, 3, 2, 3, 1,
-	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
-	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 5, 6, 4, 4, 6, 6, 6, 8,
-	8, 8, 8, 9, 8, 5, 4, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
-	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
-	1, 1, 2, 3, 3, 1, 2, 2, 1, 2,
-	1, 2, 2, 1, 2, 0, 1, 0, 2, 1,
-	2, 4, 0, 2, 1, 3, 5, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 0, 3,
-	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
-	0, 2, 4, 4, 0, 2, 2, 1, 1, 3,
-	3, 3, 3, 3, 3, 3, 0, 3, 3, 3,
-	0, 3, 1, 1, 0, 4, 0, 1, 1, 0,
-	3, 1, 3, 2, 1, 0, 2, 4, 0, 9,
-	3, 5, 0, 3, 3, 0, 1, 0, 2, 2,
-	0, 2, 2, 2, 0, 2, 1, 2, 3, 3,
-	0, 2, 1, 2, 3, 4, 3, 0, 1, 2,
-	1, 5, 4, 4, 1, 3, 3, 5, 0, 5,
-	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
-	3, 3, 3, 3, 3, 2, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
-	3, 0, 1, 0, 1, 1, 5, 0, 1, 0,
-	1, 2, 1, 1, 1, 1, 1, 1, 0, 1,
+	2, 3, 1, 2, 5, 6, 6, 7, 4, 6,
+	2, 3, 1, 3, 4, 5, 7, 9, 1, 3,
+	7, 8, 1, 1, 9, 9, 8, 7, 7, 1,
+	1, 1, 3, 1, 3, 1, 3, 0, 4, 3,
+	5, 4, 1, 3, 3, 2, 2, 2, 2, 2,
+	1, 1, 1, 2, 2, 6, 12, 2, 0, 2,
+	0, 2, 1, 0, 2, 1, 3, 3, 6, 4,
+	7, 8, 8, 8, 6, 3, 1, 1, 4, 0,
+	1, 1, 1, 2, 2, 0, 1, 4, 4, 4,
+	4, 2, 4, 1, 3, 1, 1, 3, 4, 3,
+	3, 3, 4, 9, 0, 2, 0, 2, 3, 3,
+	4, 2, 3, 2, 1, 1, 0, 2, 2, 3,
+	3, 2, 2, 1, 2, 1, 3, 1, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
+	2, 4, 4, 2, 2, 3, 3, 3, 3, 1,
+	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
+	0, 3, 5, 0, 1, 1, 0, 1, 0, 2,
+	2, 2, 0, 2, 2, 5, 0, 1, 1, 2,
+	1, 3, 2, 3, 0, 1, 4, 3, 3, 4,
+	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
+	1, 7, 5, 6, 6, 7, 0, 1, 5, 3,
+	3, 1, 1, 2, 2, 2, 0, 1, 1, 0,
+	1, 2, 0, 1, 1, 3, 2, 1, 2, 3,
+	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 4, 5, 0, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 0, 2, 0, 2, 0, 1,
+	5, 1, 3, 7, 1, 3, 3, 1, 2, 2,
+	2, 5, 5, 5, 6, 6, 5, 5, 2, 2,
+	2, 2, 3, 3, 3, 4, 1, 3, 5, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 4, 4, 2, 11, 3, 6, 8, 6, 6,
+	6, 13, 8, 6, 10, 5, 5, 5, 5, 5,
+	3, 7, 4, 4, 4, 4, 3, 3, 3, 7,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	2, 0, 2, 2, 1, 3, 8, 8, 3, 3,
+	5, 7, 7, 6, 5, 3, 2, 3, 3, 3,
+	7, 3, 3, 3, 3, 4, 7, 5, 2, 4,
+	4, 4, 4, 4, 5, 5, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 2, 4, 2,
+	4, 5, 4, 4, 4, 4, 3, 3, 5, 2,
+	3, 3, 3, 3, 1, 1, 0, 1, 0, 1,
+	1, 1, 0, 2, 2, 0, 2, 2, 0, 2,
+	0, 1, 1, 2, 1, 1, 2, 1, 1, 5,
+	0, 1, 0, 1, 2, 3, 0, 3, 3, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 1, 3, 3, 2, 2, 3, 1, 3, 2,
+	1, 2, 1, 2, 2, 4, 3, 3, 6, 4,
+	7, 6, 1, 3, 2, 2, 2, 2, 1, 1,
+	1, 3, 2, 1, 1, 1, 0, 1, 1, 0,
+	3, 0, 2, 0, 2, 1, 2, 2, 0, 1,
+	1, 0, 1, 1, 0, 1, 0, 1, 2, 3,
+	4, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
+	2, 1, 3, 1, 1, 1, 3, 3, 3, 3,
+	7, 0, 3, 1, 3, 1, 1, 3, 3, 1,
+	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
+	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
+	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
+	1, 3, 3, 0, 5, 4, 5, 5, 0, 2,
+	1, 3, 3, 3, 2, 3, 1, 2, 0, 3,
+	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
+	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
+	3, 4, 1, 3, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 3, 1, 1, 1, 1, 1, 5,
+	5, 5, 6, 4, 4, 4, 4, 4, 5, 6,
+	6, 9, 6, 6, 0, 2, 2, 0, 2, 2,
+	0, 1, 4, 2, 4, 0, 1, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 1, 3, 0, 2, 1, 3, 5, 4, 4,
+	6, 6, 6, 8, 8, 8, 8, 9, 8, 5,
+	4, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 8, 8, 0, 2,
+	3, 4, 4, 4, 4, 4, 4, 4, 0, 3,
+	4, 7, 3, 1, 1, 1, 2, 3, 3, 1,
+	2, 2, 1, 2, 1, 2, 2, 1, 2, 0,
+	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
+	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 0, 3, 0, 2, 0, 3, 1, 3,
+	2, 0, 1, 1, 0, 2, 4, 4, 0, 2,
+	2, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	0, 3, 3, 3, 0, 3, 1, 1, 0, 4,
+	0, 1, 1, 0, 3, 1, 3, 2, 1, 0,
+	2, 4, 0, 9, 3, 5, 0, 3, 3, 0,
+	1, 0, 2, 2, 0, 2, 2, 2, 0, 2,
+	1, 2, 3, 3, 0, 2, 1, 2, 3, 4,
+	3, 0, 1, 2, 1, 5, 4, 4, 1, 3,
+	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
+	1, 3, 3, 1, 3, 3, 3, 3, 3, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 0, 2, 0, 3, 0, 1, 0, 1, 1,
+	5, 0, 1, 0, 1, 2, 1, 1, 1, 1,
+	1, 1, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5078,490 +5329,517 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
-	1,
+	1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -283, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
-	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -54, -4, -285, 6, 7, 8, -62, 10,
-	11, 30, -23, -33, 160, -34, -24, 161, -35, 163,
-	162, 198, 164, 191, 72, 237, 238, 240, 241, 242,
-	243, -63, 196, 197, 166, 34, 43, 31, 32, 35,
-	169, 82, 9, 341, 193, 192, 26, -284, 481, -70,
-	5, -139, 16, -3, -55, -288, -55, -55, -55, -55,
-	-55, -55, -239, -241, 82, 133, 82, -55, -39, -38,
-	-37, -36, -40, 29, -30, -31, -259, -29, -26, 165,
-	162, 206, 103, 104, 198, 199, 200, 164, 182, 197,
-	201, 196, 215, -25, 78, 31, 354, 357, -246, 161,
-	167, 168, 342, 106, 105, 73, 163, -243, 287, 458,
-	-40, 460, 96, 98, 459, 42, 172, 461, 462, 463,
-	464, 181, 465, 466, 467, 468, 474, 475, 476, 477,
-	107, 5, -55, -201, -197, -263, -191, 85, 86, 87,
-	351, 184, 385, 386, 231, 78, 39, 287, 458, 237,
-	251, 245, 272, 264, 352, 387, 234, 185, 219, 455,
-	262, 111, 460, 388, 199, 292, 293, 294, 96, 240,
-	328, 473, 236, 389, 471, 98, 459, 77, 49, 42,
-	194, 260, 256, 461, 220, 390, 362, 213, 106, 103,
-	480, 34, 254, 48, 28, 470, 105, 47, 462, 124,
-	391, 463, 296, 277, 449, 46, 297, 200, 392, 81,
-	356, 457, 298, 255, 299, 230, 469, 166, 393, 441,
-	300, 301, 450, 394, 278, 282, 395, 320, 302, 50,
-	396, 397, 451, 104, 398, 76, 464, 249, 250, 399,
-	228, 183, 322, 276, 181, 33, 303, 353, 232, 56,
-	207, 323, 44, 280, 43, 445, 400, 448, 275, 271,
-	51, 401, 402, 403, 404, 465, 274, 248, 270, 479,
-	225, 466, 60, 168, 284, 283, 285, 214, 319, 267,
-	405, 406, 407, 188, 79, 408, 257, 19, 409, 410,
-	304, 221, 411, 54, 412, 413, 326, 197, 414, 52,
-	467, 37, 202, 468, 415, 416, 417, 418, 419, 306,
-	420, 305, 279, 281, 209, 307, 355, 421, 253, 201,
-	472, 422, 189, 456, 203, 206, 196, 327, 190, 423,
-	424, 425, 426, 427, 235, 428, 429, 241, 474, 41,
-	430, 431, 432, 433, 229, 224, 321, 330, 59, 80,
-	289, 434, 454, 247, 222, 435, 238, 53, 475, 476,
-	477, 216, 478, 7, 309, 107, 226, 227, 45, 268,
-	208, 436, 437, 258, 259, 273, 246, 269, 239, 442,
-	210, 310, 198, 438, 329, 223, 290, 359, 215, 311,
-	453, 358, 266, 263, 217, 439, 312, 173, 211, 212,
-	440, 443, 313, 314, 316, 317, 233, 315, 318, 218,
-	357, 244, 261, 291, 171, -55, 171, -102, -197, 171,
-	-169, 293, -188, 295, 308, 303, 313, 301, -180, 304,
-	306, 209, -278, 320, 171, 310, 160, 151, 296, 305,
-	314, 315, 318, 218, -274, -263, 463, 478, 111, 265,
-	300, 298, 321, 445, 317, 316, -197, 239, -204, 244,
-	-192, -263, -191, 242, -102, -61, 441, 164, -206, -206,
-	-72, 445, 447, -122, -86, -108, 117, -113, 29, 24,
-	-112, -109, -130, -127, -128, 151, 152, 154, 153, 155,
-	140, 141, 148, 118, 156, -117, -115, -116, -118, 89,
-	88, 97, 90, 91, 92, 93, 99, 100, 101, -192,
-	-197, -125, -285, 66, 67, 342, 343, 344, 345, 350,
-	346, 120, 55, 337, 331, 340, 339, 338, 335, 336,
-	333, 334, 348, 349, 176, 332, 170, 146, 341, -263,
-	-191, 42, 309, 309, -102, -55, -5, -4, -285, 6,
-	21, 22, -143, 18, 17, -286, 84, -64, -77, 61,
-	62, -79, 22, 36, 65, 63, 21, -56, -76, 142,
-	-86, -197, -76, -178, 175, -178, -178, -168, -209, 239,
-	-172, 321, 320, -193, -170, -192, -190, -169, 319, 165,
-	360, 116, 23, 25, 119, 151, 17, 120, 35, 167,
-	265, 182, 150, 178, 342, 160, 70, 361, 333, 334,
-	331, 337, 344, 345, 332, 295, 29, 11, 363, 26,
-	192, 22, 36, 144, 162, 123, 195, 24, 193, 101,
-	366, 20, 73, 187, 12, 180, 38, 14, 367, 368,
-	15, 176, 175, 135, 172, 68, 9, 156, 27, 132,
-	64, 369, 113, 370, 371, 372, 373, 66, 133, 18,
-	335, 336, 31, 446, 374, 350, 204, 146, 71, 57,
-	447, 117, 375, 376, 99, 377, 102, 74, 452, 114,
-	16, 69, 40, 378, 205, 379, 177, 380, 324, 381,
-	134, 163, 341, 67, 382, 170, 308, 6, 347, 30,
-	191, 179, 109, 65, 383, 171, 122, 348, 349, 174,
-	100, 5, 112, 32, 10, 72, 75, 338, 339, 340,
-	55, 108, 354, 121, 13, 384, 325, 115, 111, -240,
-	133, -227, -231, -192, 186, -256, 182, -102, -249, -248,
-	-192, -71, -187, 172, 180, 179, 112, -267, 114, 226,
-	332, 170, -36, -37, -169, 150, 203, 83, 83, -231,
-	-230, -229, -268, 205, 186, -255, -247, 178, 187, -237,
-	179, 180, -232, 172, 113, -268, -232, 177, 187, 205,
-	205, 107, 205, 107, 205, 205, 205, 205, 205, 205,
-	205, 205, 205, 202, -238, 125, -238, 358, 358, -243,
-	-268, -268, -268, 174, 33, 33, -189, -232, 174, 23,
-	-238, -238, -169, 150, -238, -238, -238, -238, 213, 213,
-	-238, -238, -238, -238, -238, -238, -238, -238, -238, -238,
-	-238, -238, -238, -238, -238, 171, -267, -80, 318, 233,
-	78, -38, 215, -22, -102, -187, 172, 173, -267, -102,
-	157, -102, -182, 133, 13, -182, -179, 309, 307, 294,
-	299, -182, -182, -182, -182, 216, 292, -233, 172, 33,
-	183, 309, 216, 292, 216, 217, 216, 217, 302, 312,
-	216, -202, 12, 135, 332, 297, 301, 209, 171, 210,
-	173, 311, -263, 448, 217, -202, 309, 212, -182, -207,
-	-285, -193, 265, -207, -207, 30, 174, -192, -57, -192,
-	89, -7, -3, -11, -10, -12, 125, -78, 309, -66,
-	151, 463, 449, 450, 451, 448, 306, 456, 454, 452,
-	216, 453, 83, 116, 114, 115, 132, -86, -110, 135,
-	117, 133, 134, 119, 137, 136, 147, 140, 141, 142,
-	143, 144, 145, 146, 138, 139, 150, 125, 126, 127,
-	128, 129, 130, 131, -177, -285, -128, -285, 158, 159,
-	-113, -113, -113, -113, -113, -113, -113, -113, -113, -113,
-	-285, 157, -2, -122, -4, -285, -285, -285, -285, -285,
-	-285, -285, -285, -135, -86, -285, -289, -285, -289, -119,
-	-285, -289, -119, -289, -119, -289, -289, -119, -289, -119,
-	-289, -289, -119, -285, -285, -285, -285, -285, -285, -285,
-	-206, -275, -276, -105, -102, -285, 233, -139, -3, -55,
-	-159, 20, 31, -86, -140, -141, -86, -139, 57, -74,
-	-76, -79, 61, 62, 95, 12, -195, -194, 23, -192,
-	89, 157, 12, -103, 27, -102, -88, -89, -90, -91,
-	-105, -129, -285, 12, -95, -96, -102, -104, -197, 83,
-	239, -172, -209, -174, -173, 322, 324, 125, -196, -192,
-	89, 29, 84, 83, -102, -211, -214, -216, -215, -217,
-	-212, -213, 262, 263, 151, 266, 268, 269, 270, 271,
-	272, 273, 274, 275, 276, 277, 30, 194, 258, 259,
-	260, 261, 278, 279, 280, 281, 282, 283, 284, 285,
-	245, 264, 352, 246, 247, 248, 249, 250, 251, 253,
-	254, 255, 256, 257, -266, -263, 82, 84, 83, -218,
-	82, -80, 171, -263, 172, 172, 172, -55, 341, -238,
-	-238, 202, -29, -26, -259, 16, -25, -26, 165, 103,
-	104, 162, 82, -227, 82, -236, -266, -263, 82, 113,
-	177, 112, -235, -232, -235, -236, -263, -130, -192, -197,
-	-263, 113, 113, -165, -192, -165, -165, 21, -165, 21,
-	-165, 21, 90, -192, -165, 21, -165, 21, -165, 21,
-	-165, 21, -165, 21, 29, 76, 77, 29, 79, 80,
-	81, -130, -130, -227, -169, -102, -263, 90, 90, -238,
-	-238, 90, 89, 89, 89, -238, -238, 90, 89, -199,
-	-197, 89, -269, 188, 230, 232, 90, 90, 90, 90,
-	29, 89, -270, 29, 470, 469, 471, 472, 473, 90,
-	29, 90, 29, 90, -192, 82, -102, -83, 220, 160,
-	162, 165, 74, 89, 234, 125, 42, 83, 174, 171,
-	-263, -184, 176, -184, -198, -197, -190, 89, -86, -234,
-	12, 135, -202, -202, -182, -102, -234, -202, -182, -102,
-	-182, -182, -182, -182, -202, -202, -202, -182, -197, -197,
-	-102, -102, -102, -102, -102, -102, -102, -207, -207, -207,
-	-183, 133, -182, 74, -205, 242, 276, 442, 443, 444,
-	83, 354, -95, 448, 448, 448, 448, 448, 448, -86,
-	-86, -86, -86, -120, 99, 117, 100, 101, -113, -121,
-	-125, -128, 94, 135, 133, 134, 119, -113, -113, -113,
-	-113, -113, -113, -113, -113, -113, -113, -113, -113, -113,
-	-113, -113, -208, -263, 89, 151, -263, -112, -112, -192,
-	-75, 22, 36, -74, -193, -198, -190, -70, -286, -286,
-	-139, -74, -74, -86, -86, -130, 89, -74, -130, 89,
-	-74, -74, -69, 22, 36, -133, -134, 121, -130, -286,
-	-113, -192, -192, -74, -75, -75, -74, -74, 83, -277,
-	324, 325, 446, -200, 205, -199, 23, -123, -122, 89,
-	-143, -286, -144, 27, 10, 135, 83, 19, 83, -142,
-	25, 26, -143, -114, -192, 90, 93, -87, 83, 12,
-	-79, -102, -194, 142, -198, -102, -164, 205, -102, 30,
-	83, -98, -100, -99, -101, 64, 68, 70, 65, 66,
-	67, 71, -203, 23, -88, -3, -285, -102, -95, -287,
-	83, 12, 75, -287, 83, 157, -172, -174, 83, 323,
-	325, 326, 74, 102, -86, -220, 150, -245, -244, -243,
-	-227, -229, -230, -231, 84, -145, -85, 38, -223, 290,
-	289, -218, -218, -218, -218, -218, -219, -169, -219, -219,
-	-219, 82, 82, -218, -218, -218, -218, -221, 82, -221,
-	-221, -222, 82, -222, -256, -86, -253, -252, -250, -251,
-	181, 96, 354, 75, -248, -142, 90, -83, -185, 176,
-	-254, -251, -263, -263, -263, -185, -263, 89, -263, 89,
-	83, 17, -228, -227, -131, 230, -258, 205, -255, -249,
-	-236, 113, -235, -236, -236, 157, -263, 83, 27, 107,
-	107, 107, 107, 354, 162, 30, -227, -131, -208, 174,
-	-208, -208, 89, 89, -181, 478, -95, -82, 222, 125,
-	211, 211, 171, 171, 224, -102, 235, 236, 234, 21,
-	223, 225, 227, 213, -102, -102, -184, 74, -97, -102,
-	24, -197, -102, -182, -182, -102, -182, -182, 89, -102,
-	-192, -66, 324, 354, 20, -67, 20, 99, 100, 101,
-	-121, -113, -113, -113, -73, 195, 116, -286, -286, -74,
-	-74, -285, 157, -5, -143, -286, -286, 83, 75, 23,
-	12, 12, -286, 12, 12, -286, -286, -74, -136, -134,
-	123, -86, -286, -286, 83, 83, -286, -286, -286, -286,
-	-286, -276, 445, 325, -106, 72, 175, 73, -285, -199,
-	-286, -159, 40, 48, 59, -86, -86, -141, -159, -176,
-	20, 12, 55, 55, -107, 13, -76, -88, -79, 157,
-	-107, -111, 30, 55, -3, -285, -285, -167, -171, -130,
-	-89, -90, -90, -89, -90, 64, 64, 64, 69, 64,
-	69, 64, -99, -197, -286, -286, -3, -164, 75, -88,
-	-102, -88, -104, -197, 142, -173, -175, 327, 324, 330,
-	-263, 89, 83, -243, -231, -282, 99, 117, 29, 74,
-	287, 96, -280, -281, 177, 112, 113, 189, 23, 39,
-	-224, 291, -219, -219, -220, -263, 89, 151, -220, -220,
-	-220, -226, 89, -226, 90, 90, 84, -32, -27, -28,
-	31, 78, -250, -238, 89, 37, -192, 84, -82, -102,
-	117, 74, -254, -254, -254, -197, 16, -161, -192, 83,
-	84, -132, 231, -130, 84, -192, 84, 82, -236, -236,
-	-193, -192, -285, 171, 29, 29, -131, -132, -220, -263,
-	480, 479, 84, 173, 229, -84, 337, 89, 85, -102,
-	-102, -102, -102, -102, 165, 162, 214, 174, -95, -102,
-	83, -60, 190, 185, -202, -202, 31, 324, 457, 455,
-	-73, 116, -113, -113, -286, -286, -75, -193, -139, -159,
-	-210, 151, 262, 194, 260, 256, 276, 267, 289, 258,
-	290, -208, -210, -113, -113, -113, -113, 351, -139, 124,
-	-86, 122, -113, -113, 172, 172, 172, -165, 41, 89,
-	89, 60, -102, -137, 14, -86, 142, -143, -166, 74,
-	-167, -124, -126, -125, -285, -160, -286, -192, -165, -107,
-	83, 125, -93, -92, 74, 75, -94, 74, -92, 64,
-	64, -286, -107, -88, -107, -107, 157, 324, 328, 329,
-	-243, 99, -113, 10, 89, 113, 113, -102, 82, -220,
-	-220, 84, 83, 84, 83, 84, 83, -186, 391, 117,
-	-28, -27, -238, -238, 90, -263, 173, 24, -102, 74,
-	74, 74, 17, 83, -227, -130, 55, -253, -161, -257,
-	-258, -102, -112, -132, -102, -81, 220, 228, 82, 86,
-	-265, 75, 211, 287, 211, -102, -60, -32, -102, -182,
-	-182, 31, -263, -113, -286, -143, -286, -218, -218, -218,
-	-222, -218, 250, -218, 250, -286, -286, 20, 20, 20,
-	20, -285, -65, 347, -86, 83, 83, -285, -285, -285,
-	-286, 89, -219, -138, 15, 17, 28, -166, 83, -286,
-	-286, 83, 55, 157, -286, -139, -171, -86, -86, 82,
-	-86, -139, -107, -116, 82, -113, -219, 89, -219, 90,
-	90, 391, 29, 79, 80, 81, 29, 76, 77, -102,
-	-102, -102, -102, -161, -192, 207, 84, -286, 83, -225,
-	354, 357, -162, 82, 84, -262, 354, -264, -263, -192,
-	-192, -192, -159, -219, -263, -113, -113, -113, -113, -113,
-	-143, 89, -113, -113, -163, -286, -192, 177, -163, -163,
-	-200, -219, -148, -153, -179, -86, -122, 113, -126, 55,
-	-3, -192, -124, -192, -143, -161, -143, -161, 84, -220,
-	-220, 84, 84, -162, 208, -281, -258, 358, 358, 23,
-	-161, -261, -260, -193, 82, 75, -286, -286, -286, -286,
-	-68, 135, 354, -286, -286, -286, 83, -286, -286, -286,
-	-106, -151, 441, -156, 44, -154, -155, 45, -152, 46,
-	54, 10, -124, 157, 84, 84, -146, 23, -285, -3,
-	84, 83, 125, -161, -102, -286, 352, 71, 355, -192,
-	177, -148, 49, 268, -158, -157, 53, 45, -155, 17,
-	47, 17, -167, -192, -272, -273, 74, -282, -279, 99,
-	117, 96, -280, 108, 109, -3, -113, 204, -58, 354,
-	-260, -242, -193, 89, 90, 84, 60, 353, 356, -149,
-	51, -147, 50, -147, -157, 17, 17, 89, 17, 89,
-	-273, 74, 11, 10, 99, 89, -58, -286, -286, -59,
-	219, 445, -265, 60, -150, 52, 74, 102, 89, 89,
-	89, -271, 190, 185, 188, 30, -271, 182, -262, 354,
-	74, 102, 184, 29, 99, 221, 355, 356,
+	-1000, -302, -1, -3, -27, -28, -29, -30, -31, -32,
+	-33, -34, -35, -36, -37, -38, -60, -61, -62, -64,
+	-65, -66, -67, -68, -25, -63, -39, -40, -69, -70,
+	-71, -72, -73, -4, -7, 7, 8, -81, 10, 11,
+	30, -42, -52, 162, -53, -43, 163, -54, 165, 164,
+	200, 166, 193, 72, 239, 240, 242, 243, 244, 245,
+	-82, 198, 199, 168, 34, 43, 31, 32, 35, 171,
+	-5, -304, 6, 356, 9, 343, 195, 194, 26, 82,
+	-303, 486, -89, 5, -4, -74, -307, -74, -74, -74,
+	-74, -74, -258, -260, 82, 135, 82, -74, -58, -57,
+	-56, -55, -59, 29, -49, -50, -278, -48, -45, 167,
+	164, 208, 103, 104, 200, 201, 202, 166, 184, 199,
+	203, 198, 217, -44, 78, 31, 356, 359, -265, 163,
+	169, 170, 344, 106, 105, 73, 165, -262, 289, 463,
+	-59, 465, 96, 98, 464, 42, 174, 466, 467, 468,
+	469, 183, 470, 471, 472, 473, 479, 480, 481, 482,
+	107, 5, -74, -220, -216, -282, -210, 85, 86, 87,
+	353, 186, 390, 391, 233, 78, 39, 289, 463, 239,
+	253, 247, 274, 266, 354, 392, 236, 187, 221, 460,
+	264, 111, 465, 393, 201, 294, 295, 296, 96, 242,
+	330, 478, 238, 394, 476, 98, 464, 77, 49, 387,
+	42, 196, 262, 258, 466, 222, 395, 364, 215, 106,
+	103, 485, 34, 256, 48, 28, 475, 105, 47, 467,
+	126, 396, 468, 298, 279, 454, 46, 299, 202, 397,
+	81, 358, 462, 300, 257, 301, 232, 474, 168, 398,
+	446, 302, 303, 455, 399, 280, 284, 400, 322, 304,
+	50, 401, 402, 456, 104, 403, 76, 469, 251, 252,
+	404, 230, 185, 324, 278, 183, 33, 305, 355, 234,
+	56, 209, 325, 44, 282, 43, 450, 405, 453, 277,
+	273, 51, 406, 407, 408, 409, 470, 276, 250, 272,
+	484, 227, 471, 60, 170, 286, 285, 287, 216, 321,
+	269, 410, 411, 412, 190, 79, 413, 259, 19, 414,
+	415, 306, 223, 416, 54, 417, 418, 328, 199, 419,
+	52, 472, 37, 204, 473, 420, 421, 422, 423, 424,
+	308, 425, 307, 281, 283, 211, 309, 357, 426, 255,
+	203, 477, 427, 191, 461, 205, 208, 198, 329, 192,
+	428, 429, 430, 431, 432, 237, 433, 434, 243, 389,
+	112, 479, 41, 435, 436, 437, 438, 231, 226, 323,
+	332, 59, 80, 291, 439, 459, 249, 224, 440, 240,
+	53, 480, 481, 482, 218, 483, 7, 311, 107, 228,
+	229, 45, 270, 210, 441, 442, 260, 261, 275, 248,
+	271, 241, 447, 212, 312, 200, 443, 331, 225, 292,
+	361, 217, 313, 458, 360, 268, 265, 219, 444, 314,
+	175, 213, 214, 445, 448, 315, 316, 318, 319, 235,
+	317, 320, 220, 359, 246, 263, 293, 173, -74, 173,
+	-121, -216, 173, -188, 295, -207, 297, 310, 305, 315,
+	303, -199, 306, 308, 211, -297, 322, 173, 312, 162,
+	153, 298, 307, 316, 317, 320, 220, -293, -282, 468,
+	483, 111, 267, 302, 300, 323, 450, 319, 318, -216,
+	241, -223, 246, -211, -282, -210, 244, -121, -80, 446,
+	166, -225, -225, -91, 450, 452, -141, -105, -127, 119,
+	-132, 29, 24, -131, -128, -149, -146, -147, 153, 154,
+	156, 155, 157, 142, 143, 150, 120, 158, -136, -134,
+	-135, -137, -10, 89, 88, 97, 90, 91, 92, 93,
+	99, 100, 101, -211, -216, -144, -304, 66, 67, 344,
+	345, 346, 347, 352, 348, 122, 55, 339, 333, 342,
+	341, 340, 337, 338, 335, 336, 350, 351, 178, 334,
+	172, 148, 343, 363, 365, 381, 382, 384, 378, 368,
+	373, 377, 372, 375, -282, -210, 42, 311, 311, -121,
+	-74, -158, 16, -3, -74, -8, 383, -9, -216, -6,
+	-5, -304, 6, 21, 22, -95, 144, -105, -216, -75,
+	-95, -197, 177, -197, -197, -187, -228, 241, -191, 323,
+	322, -212, -189, -211, -209, -188, 321, 167, 362, 118,
+	23, 25, 121, 153, 17, 122, 35, 169, 267, 184,
+	152, 180, 344, 162, 70, 363, 335, 336, 333, 339,
+	346, 347, 334, 297, 29, 11, 365, 26, 194, 22,
+	36, 146, 164, 125, 197, 24, 195, 101, 368, 20,
+	73, 189, 12, 182, 38, 14, 369, 370, 15, 178,
+	177, 137, 174, 68, 9, 158, 27, 134, 64, 371,
+	115, 372, 373, 374, 375, 66, 135, 18, 337, 338,
+	31, 451, 376, 352, 206, 148, 71, 57, 452, 119,
+	377, 378, 99, 379, 102, 74, 457, 116, 16, 69,
+	40, 380, 207, 381, 179, 388, 382, 326, 383, 136,
+	165, 343, 67, 384, 172, 310, 6, 349, 30, 193,
+	181, 109, 65, 385, 173, 124, 350, 351, 176, 100,
+	5, 114, 32, 10, 72, 75, 340, 341, 342, 55,
+	108, 356, 123, 13, 386, 327, 117, 111, -259, 135,
+	-246, -250, -211, 188, -275, 184, -121, -268, -267, -211,
+	-90, -206, 174, 182, 181, 114, -286, 116, 228, 334,
+	172, -55, -56, -188, 152, 205, 83, 83, -250, -249,
+	-248, -287, 207, 188, -274, -266, 180, 189, -256, 181,
+	182, -251, 174, 115, -287, -251, 179, 189, 207, 207,
+	107, 207, 107, 207, 207, 207, 207, 207, 207, 207,
+	207, 207, 204, -257, 127, -257, 360, 360, -262, -287,
+	-287, -287, 176, 33, 33, -208, -251, 176, 23, -257,
+	-257, -188, 152, -257, -257, -257, -257, 215, 215, -257,
+	-257, -257, -257, -257, -257, -257, -257, -257, -257, -257,
+	-257, -257, -257, -257, 173, -286, -99, 320, 235, 78,
+	-57, 217, -41, -121, -206, 174, 175, -286, -121, 159,
+	-121, -201, 135, 13, -201, -198, 311, 309, 296, 301,
+	-201, -201, -201, -201, 218, 294, -252, 174, 33, 185,
+	311, 218, 294, 218, 219, 218, 219, 304, 314, 218,
+	-221, 12, 137, 334, 299, 303, 211, 173, 212, 175,
+	313, -282, 453, 219, -221, 311, 214, -201, -226, -304,
+	-212, 267, -226, -226, 30, 176, -211, -76, -211, 89,
+	-26, -3, -30, -29, -31, 127, -97, 311, -85, 153,
+	468, 454, 455, 456, 453, 308, 461, 459, 457, 218,
+	458, 83, 118, 116, 117, 134, -105, -129, 137, 119,
+	135, 136, 121, 139, 138, 149, 142, 143, 144, 145,
+	146, 147, 148, 140, 141, 152, 127, 128, 129, 130,
+	131, 132, 133, -196, -304, -147, -304, 160, 161, -132,
+	-132, -132, -132, -132, -132, -132, -132, -132, -132, -304,
+	159, -2, -7, -141, -5, -304, -304, -304, -304, -304,
+	-304, -304, -304, -154, -105, -304, -308, -304, -308, -138,
+	-304, -308, -138, -308, -138, -308, -308, -138, -308, -138,
+	-308, -308, -138, -304, -304, -304, -304, -304, -304, -304,
+	-304, -304, -304, -304, -304, -304, -304, -304, -304, -304,
+	-304, -225, -294, -295, -124, -121, -304, 235, -162, 18,
+	17, -305, 84, -83, -96, 61, 62, -98, 22, 36,
+	65, 63, 21, 83, -8, -181, 82, -158, -3, -74,
+	12, -214, -213, 23, -211, 89, 159, 95, 12, -122,
+	27, -121, -107, -108, -109, -110, -124, -148, -304, 12,
+	-114, -115, -121, -123, -216, 83, 241, -191, -228, -193,
+	-192, 324, 326, 127, -215, -211, 89, 29, 84, 83,
+	-121, -230, -233, -235, -234, -236, -231, -232, 264, 265,
+	153, 268, 270, 271, 272, 273, 274, 275, 276, 277,
+	278, 279, 30, 196, 260, 261, 262, 263, 280, 281,
+	282, 283, 284, 285, 286, 287, 247, 266, 354, 248,
+	249, 250, 251, 252, 253, 255, 256, 257, 258, 259,
+	-285, -282, 82, 84, 83, -237, 82, -99, 173, -282,
+	174, 174, 174, -74, 343, -257, -257, 204, -48, -45,
+	-278, 16, -44, -45, 167, 103, 104, 164, 82, -246,
+	82, -255, -285, -282, 82, 115, 179, 114, -254, -251,
+	-254, -255, -282, -149, -211, -216, -282, 115, 115, -184,
+	-211, -184, -184, 21, -184, 21, -184, 21, 90, -211,
+	-184, 21, -184, 21, -184, 21, -184, 21, -184, 21,
+	29, 76, 77, 29, 79, 80, 81, -149, -149, -246,
+	-188, -121, -282, 90, 90, -257, -257, 90, 89, 89,
+	89, -257, -257, 90, 89, -218, -216, 89, -288, 190,
+	232, 234, 90, 90, 90, 90, 29, 89, -289, 29,
+	475, 474, 476, 477, 478, 90, 29, 90, 29, 90,
+	-211, 82, -121, -102, 222, 162, 164, 167, 74, 89,
+	236, 127, 42, 83, 176, 173, -282, -203, 178, -203,
+	-217, -216, -209, 89, -105, -253, 12, 137, -221, -221,
+	-201, -121, -253, -221, -201, -121, -201, -201, -201, -201,
+	-221, -221, -221, -201, -216, -216, -121, -121, -121, -121,
+	-121, -121, -121, -226, -226, -226, -202, 135, -201, 74,
+	-224, 244, 278, 447, 448, 449, 83, 356, -114, 453,
+	453, 453, 453, 453, 453, -105, -105, -105, -105, -139,
+	99, 119, 100, 101, -132, -140, -144, -147, 94, 137,
+	135, 136, 121, -132, -132, -132, -132, -132, -132, -132,
+	-132, -132, -132, -132, -132, -132, -132, -132, -227, -282,
+	89, 153, -282, -131, -131, -211, -94, 22, 36, -93,
+	-95, -212, -217, -209, -89, -305, -2, -305, -158, -93,
+	-93, -105, -105, -149, 89, -93, -149, 89, -93, -93,
+	-88, 22, 36, -152, -153, 123, -149, -305, -132, -211,
+	-211, -93, -94, -94, -93, -93, -305, -305, -305, -305,
+	-305, -105, -105, -105, -105, -141, -141, 83, -296, 326,
+	327, 451, -219, 207, -218, 23, -142, -141, 89, -178,
+	20, 31, -105, -159, -160, -105, -158, 57, -93, -98,
+	61, 62, -9, 23, -180, -211, -162, -305, -121, -213,
+	144, -217, -121, -183, 207, -121, 30, 83, -117, -119,
+	-118, -120, 64, 68, 70, 65, 66, 67, 71, -222,
+	23, -107, -3, -304, -121, -114, -306, 83, 12, 75,
+	-306, 83, 159, -191, -193, 83, 325, 327, 328, 74,
+	102, -105, -239, 152, -264, -263, -262, -246, -248, -249,
+	-250, 84, -164, -104, 38, -242, 292, 291, -237, -237,
+	-237, -237, -237, -238, -188, -238, -238, -238, 82, 82,
+	-237, -237, -237, -237, -240, 82, -240, -240, -241, 82,
+	-241, -275, -105, -272, -271, -269, -270, 183, 96, 356,
+	75, -267, -161, 25, 26, 90, -102, -204, 178, -273,
+	-270, -282, -282, -282, -204, -282, 89, -282, 89, 83,
+	17, -247, -246, -150, 232, -277, 207, -274, -268, -255,
+	115, -254, -255, -255, 159, -282, 83, 27, 107, 107,
+	107, 107, 356, 164, 30, -246, -150, -227, 176, -227,
+	-227, 89, 89, -200, 483, -114, -101, 224, 127, 213,
+	213, 173, 173, 226, -121, 237, 238, 236, 21, 225,
+	227, 229, 215, -121, -121, -203, 74, -116, -121, 24,
+	-216, -121, -201, -201, -121, -201, -201, 89, -121, -211,
+	-85, 326, 356, 20, -86, 20, 99, 100, 101, -140,
+	-132, -132, -132, -92, 197, 118, -305, -305, -93, -93,
+	83, -304, 159, -6, -305, -162, -305, -305, 83, 75,
+	23, 12, 12, -305, 12, 12, -305, -305, -93, -155,
+	-153, 125, -105, -305, -305, 83, 83, -305, -305, -305,
+	-305, -305, -12, 380, -12, -12, -12, -12, -305, -305,
+	-305, 83, -305, -305, -295, 450, 327, -125, 72, 177,
+	73, -304, -218, -305, -163, 27, 10, 137, 83, 19,
+	83, -161, -162, -133, -211, 90, 93, -106, 12, -98,
+	-147, 84, 83, -178, 159, -126, 13, -130, 30, 55,
+	-3, -304, -304, -186, -190, -149, -108, -109, -109, -108,
+	-109, 64, 64, 64, 69, 64, 69, 64, -118, -216,
+	-305, -305, -3, -183, 75, -107, -121, -107, -123, -216,
+	144, -192, -194, 329, 326, 332, -282, 89, 83, -262,
+	-250, -301, 99, 119, 29, 74, 289, 96, -299, -300,
+	179, 114, 115, 191, 23, 39, -243, 293, -238, -238,
+	-239, -282, 89, 153, -239, -239, -239, -245, 89, -245,
+	90, 90, 84, -51, -46, -47, 31, 78, -269, -257,
+	89, 37, -211, 84, -101, -121, 119, 74, -273, -273,
+	-273, -216, 16, -180, 83, 84, -151, 233, -149, 84,
+	-211, 84, 82, -255, -255, -212, -211, -304, 173, 29,
+	29, -150, -151, -239, -282, 485, 484, 84, 175, 231,
+	-103, 339, 89, 85, -121, -121, -121, -121, -121, 167,
+	164, 216, 176, -114, -121, 83, -79, 192, 187, -221,
+	-221, 31, 326, 462, 460, -92, 118, -132, -132, -13,
+	-12, -305, -305, -95, -94, -212, -158, -178, -229, 153,
+	264, 196, 262, 258, 278, 269, 291, 260, 292, -227,
+	-229, -132, -132, -132, -132, 353, -158, 126, -105, 124,
+	-132, -132, -304, -211, -12, -23, 430, 177, -23, -105,
+	-23, -23, 174, 174, 174, -184, 40, 48, 59, -105,
+	-105, -160, -178, -195, 20, 12, 55, 55, -126, -107,
+	-98, -211, 144, -162, -105, -185, 74, -186, -143, -145,
+	-144, -304, -179, -305, -211, -184, -126, 83, 127, -112,
+	-111, 74, 75, -113, 74, -111, 64, 64, -305, -126,
+	-107, -126, -126, 159, 326, 330, 331, -262, 99, -132,
+	10, 89, 115, 115, -121, 82, -239, -239, 84, 83,
+	84, 83, 84, 83, -205, 396, 119, -47, -46, -257,
+	-257, 90, -282, 175, 24, -121, 74, 74, 74, 17,
+	-246, -149, 55, -272, -180, -276, -277, -121, -131, -151,
+	-121, -100, 222, 230, 82, 86, -284, 75, 213, 289,
+	213, -121, -79, -51, -121, -201, -201, 31, -282, -132,
+	-305, -162, -305, -237, -237, -237, -241, -237, 252, -237,
+	252, -305, -305, 20, 20, 20, 20, -304, -84, 349,
+	-105, 83, 83, -14, -24, -211, -12, 413, 413, -12,
+	-305, -12, -12, -304, -304, -304, -305, 41, 89, 89,
+	60, -121, -156, 14, 28, -185, 83, -305, -305, 83,
+	55, 159, -305, -158, -190, -105, -105, 82, -105, -158,
+	-126, -135, 82, -132, -238, 89, -238, 90, 90, 396,
+	29, 79, 80, 81, 29, 76, 77, -121, -121, -121,
+	-121, -180, 209, 84, -305, 83, -244, 356, 359, -181,
+	84, -281, 356, -283, -282, -211, -211, -211, -178, -238,
+	-282, -132, -132, -132, -132, -132, -162, 89, -132, -132,
+	-305, -15, 207, -22, 12, -182, -305, -211, 179, -182,
+	-182, -219, 89, -238, -157, 15, 17, 115, -145, 55,
+	-3, -211, -143, -211, -162, -180, -162, -180, 84, -239,
+	-239, 84, 84, -181, 210, -300, -277, 360, 360, 23,
+	-280, -279, -212, 82, 75, -305, -305, -305, -305, -87,
+	137, 356, -305, -305, -305, -158, 17, -23, 232, 234,
+	83, -305, -305, -305, -125, -238, -167, -172, -198, -16,
+	386, -105, -141, 10, -143, 159, 84, 84, -165, 23,
+	-304, -3, 83, 127, -180, -121, -305, 354, 71, 357,
+	-19, -21, 112, 388, -141, -12, -211, 179, -170, 446,
+	-175, 44, -173, -174, 45, -171, 46, 54, -17, -18,
+	-211, -186, -211, -291, -292, 74, -301, -298, 99, 119,
+	96, -299, 108, 109, -3, -132, 206, -77, 356, -279,
+	-261, -212, 89, 90, 84, 60, 355, 358, -20, 121,
+	387, 443, -11, 90, 91, 93, 158, -167, 49, 270,
+	-177, -176, 53, 45, -174, 17, 47, 17, 83, 23,
+	-292, 74, 11, 10, 99, 89, -77, -305, -305, -78,
+	221, 450, -284, 60, -20, 389, 423, 398, 423, 398,
+	-132, -168, 51, -166, 50, -166, -176, 17, 17, 89,
+	17, 89, -18, -304, -290, 192, 187, 190, 30, -290,
+	184, -281, 356, 118, -211, -169, 52, 74, 102, 89,
+	89, 89, -14, 186, 29, 99, 223, 357, -20, 74,
+	102, -305, 358,
 }
 
 var yyDef = [...]int{
 	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 842, 0, 574, 574, 574, 574, 574,
-	574, 574, 0, 0, 574, -2, -2, 574, 978, 0,
-	574, 0, 0, -2, 507, 508, 0, 510, -2, 0,
-	0, 519, 1397, 1397, 569, 0, 0, 0, 0, 0,
-	574, 1395, 55, 56, 525, 526, 527, 1, 3, 0,
-	578, 850, 0, 0, -2, 576, 0, 0, 961, 961,
-	961, 0, 86, 87, 0, 0, 0, -2, 90, -2,
-	114, 115, 0, 119, 383, 344, 386, 342, 372, -2,
-	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 347, 237, 237, 0, 0, -2, 335,
-	335, 335, 0, 0, 0, 369, 963, 290, 237, 237,
-	0, 237, 237, 237, 237, 0, 0, 237, 237, 237,
-	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 866, 118, 979, 976, 977, 35, 36, 37,
-	1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130,
-	1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140,
-	1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150,
-	1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160,
-	1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170,
-	1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180,
-	1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190,
-	1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
-	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
-	1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
-	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
-	1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240,
-	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
-	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
-	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
-	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
-	1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290,
-	1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300,
-	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
-	1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320,
-	1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330,
-	1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340,
-	1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350,
-	1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360,
-	1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370,
-	1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380,
-	1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390,
-	1391, 1392, 1393, 1394, 0, 955, 0, 439, 663, 0,
-	498, 498, 0, 498, 498, 498, 498, 0, 0, 0,
-	451, 0, 0, 0, 0, 495, 0, 0, 470, 472,
-	0, 495, 0, 482, 498, 1398, 1398, 1398, 946, 0,
-	492, 490, 504, 505, 487, 488, 506, 509, 0, 514,
-	517, 972, 973, 0, 532, 0, 1200, 524, 537, 538,
-	0, 570, 571, 40, 714, 673, 0, 679, 681, 0,
-	716, 717, 718, 719, 720, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 746, 747, 748, 749, 827,
-	828, 829, 830, 831, 832, 833, 834, 683, 684, 824,
-	0, 935, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 815, 0, 784, 784, 784, 784, 784, 784, 784,
-	784, 784, 0, 0, 0, 0, 0, 0, 0, -2,
-	-2, 1397, 0, 547, 0, 0, 842, 51, 0, 574,
-	579, 580, 885, 0, 0, 842, 1396, 0, 0, -2,
-	-2, 590, 596, 597, 598, 599, 600, 575, 0, 603,
-	607, 0, 0, 0, 962, 0, 0, 72, 0, 1359,
-	939, -2, -2, 0, 0, 974, 975, 948, -2, 982,
-	983, 984, 985, 986, 987, 988, 989, 990, 991, 992,
-	993, 994, 995, 996, 997, 998, 999, 1000, 1001, 1002,
-	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012,
-	1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022,
-	1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
-	1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042,
-	1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052,
-	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062,
-	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
-	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
-	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
-	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
-	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
-	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, -2, 0,
-	0, 128, 129, 0, 38, 263, 0, 124, 0, 257,
-	209, 866, 0, 0, 0, 0, 0, 574, 0, 956,
-	109, 110, 116, 117, 237, 237, 0, 118, 118, 351,
-	352, 353, 0, 0, -2, 261, 0, 336, 0, 0,
-	251, 251, 255, 253, 254, 0, 0, 0, 0, 0,
-	0, 363, 0, 364, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 423, 0, 238, 0, 381, 382, 291,
-	0, 0, 0, 0, 361, 362, 0, 0, 964, 965,
-	0, 0, 237, 237, 0, 0, 0, 0, 237, 237,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 879, 0, 0,
-	0, -2, 0, 431, 0, 0, 0, 957, 957, 438,
-	0, 440, 441, 0, 0, 442, 0, 495, 495, 493,
-	494, 444, 445, 446, 447, 498, 0, 0, 246, 247,
-	248, 495, 498, 0, 498, 498, 498, 498, 495, 495,
-	495, 498, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1398, 1398, 1398, 501, 498, 479, 480, 483, 484,
-	1399, 1400, 993, 485, 486, 947, 515, 518, 535, 533,
-	534, 536, 528, 529, 530, 531, 0, 549, 550, 555,
-	0, 0, 0, 0, 561, 562, 563, 0, 0, 566,
-	567, 568, 0, 0, 0, 0, 0, 677, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 701, 702, 703,
-	704, 705, 706, 707, 680, 0, 694, 0, 0, 0,
-	736, 737, 738, 739, 740, 741, 742, 743, 744, 0,
-	587, 0, 0, 0, 842, 0, 0, 0, 0, 0,
-	0, 0, 584, 0, 816, 0, 767, 0, 768, 776,
-	0, 769, 777, 770, 778, 771, 772, 779, 773, 780,
-	774, 775, 781, 0, 0, 0, 587, 587, 0, 0,
-	41, 539, 540, 0, 646, 967, 0, 850, 0, 589,
-	888, 0, 0, 851, 843, 844, 847, 850, 0, 612,
-	601, 591, 594, 595, 577, 0, 604, 608, 0, 610,
-	611, 0, 0, 70, 0, 662, 0, 614, 616, 617,
-	618, 644, 0, 0, 0, 0, 66, 68, 663, 0,
-	1359, 945, 0, 74, 75, 0, 0, 0, 225, 950,
-	951, 952, -2, 244, 0, -2, 216, 160, 161, 162,
-	209, 164, 209, 209, 209, 209, 221, 221, 221, 221,
-	192, 193, 194, 195, 196, 0, 0, 179, 209, 209,
-	209, 209, 199, 200, 201, 202, 203, 204, 205, 206,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 211,
-	211, 211, 213, 213, 0, 39, 0, 229, 0, 847,
-	0, 879, 959, 969, 0, 0, 0, 959, 92, 0,
-	0, 384, 345, 373, 385, 0, 348, 349, -2, 0,
-	0, 335, 0, 337, 0, 245, 0, -2, 0, 255,
-	0, 251, 255, 252, 255, 243, 256, 365, 824, 0,
-	366, 367, 0, 403, 632, 0, 0, 0, 0, 0,
-	409, 410, 411, 0, 413, 414, 415, 416, 417, 418,
-	419, 420, 421, 422, 374, 375, 376, 377, 378, 379,
-	380, 0, 0, 337, 0, 370, 0, 292, 293, 0,
-	0, 296, 297, 298, 299, 0, 0, 302, 303, 304,
-	649, 650, 305, 329, 330, 331, 306, 307, 308, 309,
-	310, 311, 312, 323, 324, 325, 326, 327, 328, 313,
-	314, 315, 316, 317, 320, 0, 102, 870, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 957,
-	0, 0, 0, 0, 664, 980, 981, 499, 500, 0,
-	249, 250, 498, 498, 448, 471, 0, 498, 452, 473,
-	453, 455, 454, 456, 475, 476, 498, 459, 496, 497,
-	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
-	477, 0, 478, 0, 0, 516, 520, 521, 522, 523,
-	0, 0, 552, 557, 558, 559, 560, 572, 565, 715,
-	674, 675, 676, 678, 695, 0, 697, 699, 685, 686,
-	710, 711, 712, 0, 0, 0, 0, 708, 690, 0,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 735, 799, 800, 801, 0, 733, 734, 745,
-	0, 0, 0, 588, 825, 0, -2, 0, 713, 934,
-	850, 0, 0, 0, 0, 718, 827, 0, 718, 827,
-	0, 0, 0, 585, 586, 822, 819, 0, 0, 785,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 542,
-	543, 545, 0, 666, 0, 647, 0, 0, 968, 548,
-	885, 52, 42, 0, 886, 0, 0, 0, 0, 846,
-	848, 849, 885, 0, 835, 0, 0, 671, 0, 0,
-	592, 48, 609, 605, 0, 671, 0, 0, 661, 0,
-	0, 0, 0, 0, 0, 651, 0, 0, 654, 0,
-	0, 0, 0, 645, 0, 0, 0, -2, 0, 0,
-	0, 62, 63, 0, 0, 0, 940, 73, 0, 0,
-	78, 79, 941, 942, 943, 944, 0, 111, -2, 287,
-	130, 132, 133, 134, 125, 269, 0, 0, 219, 217,
-	218, 163, 221, 221, 186, 187, 225, 0, 225, 225,
-	225, 0, 0, 180, 181, 182, 183, 174, 0, 175,
-	176, 177, 0, 178, 262, 0, 854, 230, 231, 233,
-	237, 0, 0, 0, 258, 259, 0, 870, 0, 0,
-	0, 970, 969, 969, 969, 0, 120, 121, 122, 123,
-	118, 0, 0, 126, 339, 0, 0, 0, 260, 0,
-	0, 255, 255, 240, 241, 0, 368, 0, 0, 405,
-	406, 407, 408, 0, 0, 0, 337, 339, 225, 0,
-	294, 295, 300, 301, 318, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 398, 399, 400, 401,
-	867, 868, 869, 0, 0, 432, 0, 0, 279, 64,
-	958, 437, 495, 458, 474, 495, 450, 457, 502, 481,
-	512, 556, 0, 0, 0, 564, 0, 696, 698, 700,
-	687, 708, 691, 0, 688, 0, 0, 682, 750, 0,
-	0, 587, 0, 842, 885, 754, 755, 0, 0, 0,
-	0, 0, 792, 0, 0, 793, 0, 842, 0, 820,
-	0, 0, 766, 786, 0, 0, 787, 788, 789, 790,
-	791, 541, 544, 546, 622, 0, 0, 0, 0, 648,
-	966, 44, 0, 0, 0, 852, 853, 845, 43, 0,
-	953, 954, 836, 837, 838, 0, 602, 613, 593, 0,
-	850, 928, 0, 0, 920, 0, 0, 671, 936, 0,
-	615, 640, 642, 0, 637, 652, 653, 655, 0, 657,
-	0, 659, 660, 619, 620, 621, 0, 671, 0, 671,
-	67, 671, 69, 0, 665, 76, 77, 0, 0, 83,
-	226, 227, 118, 289, 131, 135, 140, 0, 0, 0,
-	144, 0, 146, 270, 0, 156, 158, 0, 0, 138,
-	159, 220, 225, 225, 188, 222, 223, 224, 189, 190,
-	191, 0, 207, 0, 0, 0, 282, 88, 858, 857,
-	237, 237, 232, 0, 235, 0, 971, 210, 0, 101,
-	0, 0, 0, 0, 0, 107, 0, 343, 626, 0,
-	354, 355, 0, 338, 402, 0, 229, 0, 239, 242,
-	825, 633, 0, 0, 356, 0, 339, 359, 360, 371,
-	321, 322, 319, 0, 0, 880, 881, 0, 884, 93,
-	391, 393, 392, 396, 0, 0, 389, 0, 279, 854,
-	0, 436, 280, 281, 498, 498, 551, 0, 554, 0,
-	689, 0, 709, 692, 751, 752, 0, 826, 850, 46,
-	0, 209, 209, 805, 209, 213, 808, 209, 810, 209,
-	813, 0, 0, 0, 0, 0, 0, 0, 817, 765,
-	823, 0, 0, 0, 0, 0, 0, 0, 0, 221,
-	890, 887, 45, 840, 0, 672, 606, 49, 53, 0,
-	928, 919, 930, 932, 0, 0, 0, 924, 0, 842,
-	0, 0, 634, 641, 0, 0, 635, 0, 636, 656,
-	658, -2, 842, 671, 60, 61, 0, 80, 81, 82,
-	288, 141, 142, 0, 145, 155, 157, 0, 0, 184,
-	185, 221, 0, 221, 0, 214, 0, 271, 283, 0,
-	855, 856, 0, 0, 234, 236, 0, 960, 103, 0,
-	0, 0, 0, 0, 127, 340, 0, 228, 0, 0,
-	427, 424, 357, 358, 624, 871, 872, 873, 0, 883,
-	96, 0, 0, 0, 0, 433, 434, 435, 65, 443,
-	449, 553, 573, 693, 753, 885, 756, 802, 221, 806,
-	807, 809, 811, 812, 814, 758, 757, 0, 0, 0,
-	0, 0, 850, 0, 821, 0, 0, 0, 0, 0,
-	646, 221, 910, 50, 0, 0, 0, 54, 0, 933,
-	0, 0, 0, 0, 71, 850, 937, 938, 638, 0,
-	643, 850, 59, 143, 0, 0, 225, 208, 225, 0,
-	0, 284, 859, 860, 861, 862, 863, 864, 865, 624,
-	104, 105, 106, 346, 627, 0, 0, 404, 0, 412,
-	0, 0, 0, 0, 882, 390, 0, 94, 95, 0,
-	0, 395, 47, 803, 804, 0, 0, 0, 0, 794,
-	0, 818, 0, 0, 0, 668, 628, 629, 0, 0,
-	666, 892, 891, 904, 917, 841, 839, 0, 931, 0,
-	923, 926, 922, 925, 57, 0, 58, 0, 149, 197,
-	198, 212, 215, 0, 0, 264, 428, 425, 426, 0,
-	0, 97, 98, 0, 0, 0, 759, 761, 760, 762,
-	0, 0, 0, 764, 782, 783, 0, 667, 669, 670,
-	623, 910, 0, 903, 0, -2, 912, 0, 0, 0,
-	918, 0, 921, 0, 639, 265, 269, 0, 0, 874,
-	625, 0, 0, 0, 397, 763, 0, 0, 0, 630,
-	631, 897, 895, 895, 905, 906, 0, 0, 913, 0,
-	0, 0, 929, 927, 266, 267, 0, 136, 150, 151,
-	0, 0, 154, 147, 148, 874, 0, 0, 387, 876,
-	99, 100, 332, 333, 334, 93, 795, 0, 798, 900,
-	0, 893, 896, 894, 907, 0, 0, 914, 0, 916,
-	268, 0, 0, 0, 152, 153, 89, 429, 430, 0,
-	877, 878, 96, 796, 889, 0, 898, 899, 908, 909,
-	915, 272, 274, 275, 0, 0, 273, 0, 394, 0,
-	901, 902, 276, 277, 278, 875, 0, 797,
+	31, 32, 33, 42, 0, 581, 581, 581, 581, 581,
+	581, 0, 0, 581, -2, -2, 581, 1032, 0, 581,
+	0, 0, -2, 514, 515, 0, 517, -2, 0, 0,
+	526, 1455, 1455, 576, 0, 0, 0, 0, 0, 581,
+	896, 0, 581, 0, 62, 63, 532, 533, 534, 1453,
+	1, 3, 0, 585, 43, 0, 583, 0, 1015, 1015,
+	1015, 0, 93, 94, 0, 0, 0, -2, 97, -2,
+	121, 122, 0, 126, 390, 351, 393, 349, 379, -2,
+	342, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 354, 244, 244, 0, 0, -2, 342,
+	342, 342, 0, 0, 0, 376, 1017, 297, 244, 244,
+	0, 244, 244, 244, 244, 0, 0, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 920, 125, 1033, 1030, 1031, 35, 36, 37,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195,
+	1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205,
+	1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
+	1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225,
+	1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235,
+	1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
+	1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
+	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
+	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
+	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
+	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
+	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
+	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
+	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
+	1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
+	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
+	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
+	1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375,
+	1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385,
+	1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395,
+	1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405,
+	1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415,
+	1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425,
+	1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435,
+	1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445,
+	1446, 1447, 1448, 1449, 1450, 1451, 1452, 0, 1009, 0,
+	446, 670, 0, 505, 505, 0, 505, 505, 505, 505,
+	0, 0, 0, 458, 0, 0, 0, 0, 502, 0,
+	0, 477, 479, 0, 502, 0, 489, 505, 1456, 1456,
+	1456, 1000, 0, 499, 497, 511, 512, 494, 495, 513,
+	516, 0, 521, 524, 1026, 1027, 0, 539, 0, 1256,
+	531, 544, 545, 0, 577, 578, 40, 722, 680, 0,
+	686, 688, 0, 724, 725, 726, 727, 728, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 754, 755,
+	756, 757, 758, 881, 882, 883, 884, 885, 886, 887,
+	888, 690, 691, 878, 0, 989, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 869, 0, 838, 838, 838,
+	838, 838, 838, 838, 838, 838, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, -2, -2, 1455, 0, 554, 0,
+	0, 904, 0, 0, -2, 50, 0, 52, 631, 896,
+	58, 0, 581, 586, 587, 0, 610, 614, 0, 582,
+	0, 0, 1016, 0, 0, 79, 0, 1417, 993, -2,
+	-2, 0, 0, 1028, 1029, 1002, -2, 1036, 1037, 1038,
+	1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048,
+	1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
+	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
+	1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
+	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098,
+	1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108,
+	1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
+	1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
+	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
+	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
+	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
+	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
+	1169, 1170, 1171, 1172, 1173, 1174, 1175, -2, 0, 0,
+	135, 136, 0, 38, 270, 0, 131, 0, 264, 216,
+	920, 0, 0, 0, 0, 0, 581, 0, 1010, 116,
+	117, 123, 124, 244, 244, 0, 125, 125, 358, 359,
+	360, 0, 0, -2, 268, 0, 343, 0, 0, 258,
+	258, 262, 260, 261, 0, 0, 0, 0, 0, 0,
+	370, 0, 371, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 430, 0, 245, 0, 388, 389, 298, 0,
+	0, 0, 0, 368, 369, 0, 0, 1018, 1019, 0,
+	0, 244, 244, 0, 0, 0, 0, 244, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 933, 0, 0, 0,
+	-2, 0, 438, 0, 0, 0, 1011, 1011, 445, 0,
+	447, 448, 0, 0, 449, 0, 502, 502, 500, 501,
+	451, 452, 453, 454, 505, 0, 0, 253, 254, 255,
+	502, 505, 0, 505, 505, 505, 505, 502, 502, 502,
+	505, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1456, 1456, 1456, 508, 505, 486, 487, 490, 491, 1457,
+	1458, 1047, 492, 493, 1001, 522, 525, 542, 540, 541,
+	543, 535, 536, 537, 538, 0, 556, 557, 562, 0,
+	0, 0, 0, 568, 569, 570, 0, 0, 573, 574,
+	575, 0, 0, 0, 0, 0, 684, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 708, 709, 710, 711,
+	712, 713, 714, 687, 0, 701, 0, 0, 0, 744,
+	745, 746, 747, 748, 749, 750, 751, 752, 0, 594,
+	0, 0, 0, 0, 896, 0, 0, 0, 0, 0,
+	0, 0, 591, 0, 870, 0, 821, 0, 822, 830,
+	0, 823, 831, 824, 832, 825, 826, 833, 827, 834,
+	828, 829, 835, 0, 0, 0, 594, 594, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 41, 546, 547, 0, 653, 1021, 0, 939, 0,
+	0, 896, 1454, 0, 0, -2, -2, 597, 603, 604,
+	605, 606, 607, 0, 51, 0, 0, 904, 0, 596,
+	0, 611, 615, 0, 617, 618, 0, 584, 0, 77,
+	0, 669, 0, 621, 623, 624, 625, 651, 0, 0,
+	0, 0, 73, 75, 670, 0, 1417, 999, 0, 81,
+	82, 0, 0, 0, 232, 1004, 1005, 1006, -2, 251,
+	0, -2, 223, 167, 168, 169, 216, 171, 216, 216,
+	216, 216, 228, 228, 228, 228, 199, 200, 201, 202,
+	203, 0, 0, 186, 216, 216, 216, 216, 206, 207,
+	208, 209, 210, 211, 212, 213, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 218, 218, 218, 220, 220,
+	0, 39, 0, 236, 0, 901, 0, 933, 1013, 1023,
+	0, 0, 0, 1013, 99, 0, 0, 391, 352, 380,
+	392, 0, 355, 356, -2, 0, 0, 342, 0, 344,
+	0, 252, 0, -2, 0, 262, 0, 258, 262, 259,
+	262, 250, 263, 372, 878, 0, 373, 374, 0, 410,
+	639, 0, 0, 0, 0, 0, 416, 417, 418, 0,
+	420, 421, 422, 423, 424, 425, 426, 427, 428, 429,
+	381, 382, 383, 384, 385, 386, 387, 0, 0, 344,
+	0, 377, 0, 299, 300, 0, 0, 303, 304, 305,
+	306, 0, 0, 309, 310, 311, 656, 657, 312, 336,
+	337, 338, 313, 314, 315, 316, 317, 318, 319, 330,
+	331, 332, 333, 334, 335, 320, 321, 322, 323, 324,
+	327, 0, 109, 924, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1011, 0, 0, 0, 0,
+	671, 1034, 1035, 506, 507, 0, 256, 257, 505, 505,
+	455, 478, 0, 505, 459, 480, 460, 462, 461, 463,
+	482, 483, 505, 466, 503, 504, 467, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 484, 0, 485, 0,
+	0, 523, 527, 528, 529, 530, 0, 0, 559, 564,
+	565, 566, 567, 579, 572, 723, 681, 682, 683, 685,
+	702, 0, 704, 706, 692, 693, 717, 718, 719, 0,
+	0, 0, 0, 715, 697, 0, 729, 730, 731, 732,
+	733, 734, 735, 736, 737, 738, 739, 740, 743, 853,
+	854, 855, 0, 741, 742, 753, 0, 0, 0, 595,
+	608, 879, 0, -2, 0, 720, 0, 988, 904, 0,
+	0, 0, 0, 726, 881, 0, 726, 881, 0, 0,
+	0, 592, 593, 876, 873, 0, 0, 839, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 549, 550,
+	552, 0, 673, 0, 654, 0, 0, 1022, 555, 942,
+	0, 0, 905, 897, 898, 901, 904, 0, 619, 598,
+	601, 602, 53, 0, 0, 633, 939, 59, 55, 616,
+	612, 0, 678, 0, 0, 668, 0, 0, 0, 0,
+	0, 0, 658, 0, 0, 661, 0, 0, 0, 0,
+	652, 0, 0, 0, -2, 0, 0, 0, 69, 70,
+	0, 0, 0, 994, 80, 0, 0, 85, 86, 995,
+	996, 997, 998, 0, 118, -2, 294, 137, 139, 140,
+	141, 132, 276, 0, 0, 226, 224, 225, 170, 228,
+	228, 193, 194, 232, 0, 232, 232, 232, 0, 0,
+	187, 188, 189, 190, 181, 0, 182, 183, 184, 0,
+	185, 269, 0, 908, 237, 238, 240, 244, 0, 0,
+	0, 265, 266, 902, 903, 0, 924, 0, 0, 0,
+	1024, 1023, 1023, 1023, 0, 127, 128, 129, 130, 125,
+	0, 0, 133, 346, 0, 0, 0, 267, 0, 0,
+	262, 262, 247, 248, 0, 375, 0, 0, 412, 413,
+	414, 415, 0, 0, 0, 344, 346, 232, 0, 301,
+	302, 307, 308, 325, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 405, 406, 407, 408, 921,
+	922, 923, 0, 0, 439, 0, 0, 286, 71, 1012,
+	444, 502, 465, 481, 502, 457, 464, 509, 488, 519,
+	563, 0, 0, 0, 571, 0, 703, 705, 707, 694,
+	715, 698, 0, 695, 0, 0, 689, 780, 0, 0,
+	0, 594, 0, 896, 721, 939, 808, 809, 0, 0,
+	0, 0, 0, 846, 0, 0, 847, 0, 896, 0,
+	874, 0, 0, 820, 840, 0, 0, 841, 842, 843,
+	844, 845, 763, 0, 764, 765, 766, 767, 0, 774,
+	774, 0, 774, 774, 548, 551, 553, 629, 0, 0,
+	0, 0, 655, 1020, 44, 0, 940, 0, 0, 0,
+	0, 900, 939, 0, 889, 0, 0, 678, 0, 599,
+	54, 632, 0, 46, 0, 904, 0, 982, 0, 0,
+	974, 0, 0, 678, 990, 0, 622, 647, 649, 0,
+	644, 659, 660, 662, 0, 664, 0, 666, 667, 626,
+	627, 628, 0, 678, 0, 678, 74, 678, 76, 0,
+	672, 83, 84, 0, 0, 90, 233, 234, 125, 296,
+	138, 142, 147, 0, 0, 0, 151, 0, 153, 277,
+	0, 163, 165, 0, 0, 145, 166, 227, 232, 232,
+	195, 229, 230, 231, 196, 197, 198, 0, 214, 0,
+	0, 0, 289, 95, 912, 911, 244, 244, 239, 0,
+	242, 0, 1025, 217, 0, 108, 0, 0, 0, 0,
+	0, 114, 0, 350, 0, 361, 362, 0, 345, 409,
+	0, 236, 0, 246, 249, 879, 640, 0, 0, 363,
+	0, 346, 366, 367, 378, 328, 329, 326, 0, 0,
+	934, 935, 0, 938, 100, 398, 400, 399, 403, 0,
+	0, 396, 0, 286, 908, 0, 443, 287, 288, 505,
+	505, 558, 0, 561, 0, 696, 0, 716, 699, 759,
+	781, 760, 761, 609, 0, 880, 904, 48, 0, 216,
+	216, 859, 216, 220, 862, 216, 864, 216, 867, 0,
+	0, 0, 0, 0, 0, 0, 871, 819, 877, 0,
+	0, 0, 785, 783, 768, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 906,
+	907, 899, 45, 0, 1007, 1008, 890, 891, 892, 620,
+	600, 634, 613, 56, 679, 60, 0, 982, 973, 984,
+	986, 0, 0, 0, 978, 0, 896, 0, 0, 641,
+	648, 0, 0, 642, 0, 643, 663, 665, -2, 896,
+	678, 67, 68, 0, 87, 88, 89, 295, 148, 149,
+	0, 152, 162, 164, 0, 0, 191, 192, 228, 0,
+	228, 0, 221, 0, 278, 290, 0, 909, 910, 0,
+	0, 241, 243, 0, 1014, 110, 0, 0, 0, 0,
+	134, 347, 0, 235, 0, 0, 434, 431, 364, 365,
+	631, 925, 926, 927, 0, 937, 103, 0, 0, 0,
+	0, 440, 441, 442, 72, 450, 456, 560, 580, 700,
+	762, 939, 810, 856, 228, 860, 861, 863, 865, 866,
+	868, 812, 811, 0, 0, 0, 0, 0, 904, 0,
+	875, 0, 0, 0, 787, 786, 769, 775, 776, 770,
+	777, 772, 773, 0, 0, 0, 653, 0, 228, 944,
+	941, 47, 894, 0, 0, 61, 0, 987, 0, 0,
+	0, 0, 78, 904, 991, 992, 645, 0, 650, 904,
+	66, 150, 0, 0, 232, 215, 232, 0, 0, 291,
+	913, 914, 915, 916, 917, 918, 919, 631, 111, 112,
+	113, 353, 0, 0, 411, 0, 419, 0, 0, 0,
+	936, 397, 0, 101, 102, 0, 0, 402, 49, 857,
+	858, 0, 0, 0, 0, 848, 0, 872, 0, 0,
+	782, 896, 0, 774, 0, 0, 675, 635, 636, 0,
+	0, 673, 228, 964, 803, 0, 0, 0, 985, 0,
+	977, 980, 976, 979, 64, 0, 65, 0, 156, 204,
+	205, 219, 222, 0, 0, 271, 435, 432, 433, 0,
+	104, 105, 0, 0, 0, 813, 815, 814, 816, 0,
+	0, 0, 818, 836, 837, 789, 0, 0, 778, 779,
+	0, 674, 676, 677, 630, 946, 945, 958, 971, 57,
+	0, 895, 893, 0, 975, 0, 646, 272, 276, 0,
+	0, 928, 0, 0, 0, 404, 817, 0, 0, 0,
+	784, 0, 792, 793, 788, 771, 637, 638, 964, 0,
+	957, 0, -2, 966, 0, 0, 0, 972, 804, 805,
+	0, 983, 981, 273, 274, 0, 143, 157, 158, 0,
+	0, 161, 154, 155, 928, 0, 0, 394, 930, 106,
+	107, 339, 340, 341, 100, 849, 0, 852, 790, 0,
+	0, 0, 0, 799, 800, 801, 0, 951, 949, 949,
+	959, 960, 0, 0, 967, 0, 0, 0, 0, 0,
+	275, 0, 0, 0, 159, 160, 96, 436, 437, 0,
+	931, 932, 103, 850, 0, 794, 795, 796, 797, 798,
+	0, 954, 0, 947, 950, 948, 961, 0, 0, 968,
+	0, 970, 806, 785, 279, 281, 282, 0, 0, 280,
+	0, 401, 0, 0, 802, 943, 0, 952, 953, 962,
+	963, 969, 0, 283, 284, 285, 929, 0, 791, 955,
+	956, 807, 851,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 118, 3, 3, 3, 145, 137, 3,
-	82, 84, 142, 140, 83, 141, 157, 143, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 481,
-	126, 125, 127, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 120, 3, 3, 3, 147, 139, 3,
+	82, 84, 144, 142, 83, 143, 159, 145, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 486,
+	128, 127, 129, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 147, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 149, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 136, 3, 148,
+	3, 3, 3, 3, 138, 3, 150,
 }
 
 var yyTok2 = [...]int{
@@ -5576,10 +5854,10 @@ var yyTok2 = [...]int{
 	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 119, 120, 121, 122, 123, 124, 128,
-	129, 130, 131, 132, 133, 134, 135, 138, 139, 144,
-	146, 149, 150, 151, 152, 153, 154, 155, 156, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	115, 116, 117, 118, 119, 121, 122, 123, 124, 125,
+	126, 130, 131, 132, 133, 134, 135, 136, 137, 140,
+	141, 146, 148, 151, 152, 153, 154, 155, 156, 157,
+	158, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
 	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
@@ -5635,7 +5913,8 @@ var yyTok3 = [...]int{
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
 	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
-	57805, 480, 0,
+	57805, 480, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
+	57810, 485, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5985,68 +6264,68 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:435
+//line sql.y:468
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:440
+//line sql.y:473
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:441
+//line sql.y:474
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:445
+//line sql.y:478
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:478
+//line sql.y:511
 		{
 			setParseTree(yylex, nil)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:484
+//line sql.y:517
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:488
+//line sql.y:521
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:492
+//line sql.y:525
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:497
+//line sql.y:530
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:501
+//line sql.y:534
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:507
+//line sql.y:540
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -6054,15 +6333,32 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:513
+//line sql.y:546
 		{
 			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
 	case 42:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:552
+		{
+			yyLOCAL = yyDollar[1].selStmtUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 43:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:556
+		{
+			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
+			yyLOCAL = yyDollar[2].selStmtUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 44:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:519
+//line sql.y:565
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6072,34 +6368,34 @@ yydefault:
 			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 45:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:528
+//line sql.y:574
 		{
 			yyLOCAL = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmtUnion()}, OrderBy: yyDollar[4].orderByUnion(), Limit: yyDollar[5].limitUnion(), Lock: yyDollar[6].lockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 46:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:532
+//line sql.y:578
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 47:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:536
+//line sql.y:582
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 48:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:559
+//line sql.y:605
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6108,58 +6404,99 @@ yydefault:
 			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 49:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:567
+//line sql.y:613
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 50:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:619
+		{
+			yyLOCAL = &With{CTEs: yyDollar[2].ctesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:623
+		{
+			yyLOCAL = &With{CTEs: yyDollar[3].ctesUnion(), Recursive: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*CommonTableExpr
+//line sql.y:629
+		{
+			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 53:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:633
+		{
+			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
+		}
+	case 54:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *CommonTableExpr
+//line sql.y:639
+		{
+			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 55:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:573
+//line sql.y:645
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 56:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:579
+//line sql.y:651
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 57:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:587
+//line sql.y:659
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel.Windows = yyDollar[9].windowDefinitionsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:593
+//line sql.y:667
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:597
+//line sql.y:671
 		{
 			yyLOCAL = &ParenSelect{Select: yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:604
+//line sql.y:678
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -6172,10 +6509,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:616
+//line sql.y:690
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6186,258 +6523,258 @@ yydefault:
 			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:628
+//line sql.y:702
 		{
 			yyLOCAL = InsertAct
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:632
+//line sql.y:706
 		{
 			yyLOCAL = ReplaceAct
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:638
+//line sql.y:712
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 65:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:644
+//line sql.y:718
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:648
+//line sql.y:722
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:652
+//line sql.y:726
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:656
+//line sql.y:730
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:735
 		{
 		}
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:662
+//line sql.y:736
 		{
 		}
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:666
+//line sql.y:740
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:670
+//line sql.y:744
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
 		}
-	case 66:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:676
+//line sql.y:750
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:680
+//line sql.y:754
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 68:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:686
+//line sql.y:760
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:690
+//line sql.y:764
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:695
+//line sql.y:769
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:699
+//line sql.y:773
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:705
+//line sql.y:779
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 80:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:711
+//line sql.y:785
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:715
+//line sql.y:789
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:721
+//line sql.y:795
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:725
+//line sql.y:799
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
 		}
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:731
+//line sql.y:805
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:735
+//line sql.y:809
 		{
 			yyLOCAL = ReadWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:739
+//line sql.y:813
 		{
 			yyLOCAL = ReadOnly
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:745
+//line sql.y:819
 		{
 			yyLOCAL = RepeatableRead
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:749
+//line sql.y:823
 		{
 			yyLOCAL = ReadCommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:753
+//line sql.y:827
 		{
 			yyLOCAL = ReadUncommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:757
+//line sql.y:831
 		{
 			yyLOCAL = Serializable
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:763
+//line sql.y:837
 		{
 			yyLOCAL = SessionScope
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:767
+//line sql.y:841
 		{
 			yyLOCAL = GlobalScope
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:773
+//line sql.y:847
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:779
+//line sql.y:853
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6445,10 +6782,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:786
+//line sql.y:860
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6458,358 +6795,358 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:795
+//line sql.y:869
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:799
+//line sql.y:873
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
 			yyLOCAL = yyDollar[1].createDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 98:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:806
+//line sql.y:880
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:810
+//line sql.y:884
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 100:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:815
+//line sql.y:889
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 94:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:819
+//line sql.y:893
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 95:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:825
+//line sql.y:899
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 96:
+	case 103:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:830
+//line sql.y:904
 		{
 			var v []VindexParam
 			yyLOCAL = v
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:835
+//line sql.y:909
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:841
+//line sql.y:915
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:846
+//line sql.y:920
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
-	case 100:
+	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:852
+//line sql.y:926
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 101:
+	case 108:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:858
+//line sql.y:932
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 109:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:865
+//line sql.y:939
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 110:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:872
+//line sql.y:946
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 111:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:877
+//line sql.y:951
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 112:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:882
+//line sql.y:956
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 113:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:887
+//line sql.y:961
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 114:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:894
+//line sql.y:968
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:901
+//line sql.y:975
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 118:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:912
+//line sql.y:986
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:918
+//line sql.y:992
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:922
+//line sql.y:996
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:928
+//line sql.y:1002
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:932
+//line sql.y:1006
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:936
+//line sql.y:1010
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 117:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:940
+//line sql.y:1014
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 118:
+	case 125:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:946
+//line sql.y:1020
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:950
+//line sql.y:1024
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:956
+//line sql.y:1030
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 121:
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:960
+//line sql.y:1034
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 122:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:966
+//line sql.y:1040
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 123:
+	case 130:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:970
+//line sql.y:1044
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 124:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:977
+//line sql.y:1051
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:981
+//line sql.y:1055
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:987
+//line sql.y:1061
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:991
+//line sql.y:1065
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
 		}
-	case 128:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:997
+//line sql.y:1071
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1002
+//line sql.y:1076
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1007
+//line sql.y:1081
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
-	case 131:
+	case 138:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1011
+//line sql.y:1085
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
-	case 132:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1016
+//line sql.y:1090
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
-	case 133:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1020
+//line sql.y:1094
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 134:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1024
+//line sql.y:1098
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 135:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1030
+//line sql.y:1104
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.Reference = yyDollar[4].referenceDefinitionUnion()
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 143:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1036
+//line sql.y:1110
 		{
 			yyDollar[2].columnType.Options = yyDollar[8].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[6].exprUnion()
@@ -6817,1741 +7154,1741 @@ yydefault:
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1044
+//line sql.y:1118
 		{
 			yyVAL.str = ""
 		}
-	case 138:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1048
+//line sql.y:1122
 		{
 			yyVAL.str = ""
 		}
-	case 139:
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1057
+//line sql.y:1131
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1061
+//line sql.y:1135
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1067
+//line sql.y:1141
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1073
+//line sql.y:1147
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1078
+//line sql.y:1152
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1083
+//line sql.y:1157
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1088
+//line sql.y:1162
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1093
+//line sql.y:1167
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1100
+//line sql.y:1174
 		{
 			yyLOCAL = VirtualStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1104
+//line sql.y:1178
 		{
 			yyLOCAL = StoredStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1109
+//line sql.y:1183
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1113
+//line sql.y:1187
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1118
+//line sql.y:1192
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1124
+//line sql.y:1198
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1130
+//line sql.y:1204
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1135
+//line sql.y:1209
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1142
+//line sql.y:1216
 		{
 			yyLOCAL = colKeyPrimary
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1146
+//line sql.y:1220
 		{
 			yyLOCAL = colKeyUnique
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1150
+//line sql.y:1224
 		{
 			yyLOCAL = colKeyUniqueKey
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1154
+//line sql.y:1228
 		{
 			yyLOCAL = colKey
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1160
+//line sql.y:1234
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
 			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
 		}
-	case 163:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1171
+//line sql.y:1245
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
-	case 164:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1176
+//line sql.y:1250
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 165:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1256
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 166:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1260
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 167:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1190
+//line sql.y:1264
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 168:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1194
+//line sql.y:1268
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 169:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1272
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 170:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1276
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 171:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1280
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 172:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1284
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 173:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1288
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 174:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1220
+//line sql.y:1294
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 175:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1226
+//line sql.y:1300
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 176:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1232
+//line sql.y:1306
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 177:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1238
+//line sql.y:1312
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 178:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1244
+//line sql.y:1318
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 179:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1252
+//line sql.y:1326
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 180:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1256
+//line sql.y:1330
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 181:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1260
+//line sql.y:1334
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 182:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1338
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 183:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1268
+//line sql.y:1342
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 184:
+	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1274
+//line sql.y:1348
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 185:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1278
+//line sql.y:1352
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 186:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1282
+//line sql.y:1356
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 187:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1286
+//line sql.y:1360
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 188:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1290
+//line sql.y:1364
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 189:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1294
+//line sql.y:1368
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 190:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1298
+//line sql.y:1372
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 191:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1302
+//line sql.y:1376
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 192:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1306
+//line sql.y:1380
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 193:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1310
+//line sql.y:1384
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 194:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1314
+//line sql.y:1388
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 195:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1318
+//line sql.y:1392
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 196:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1396
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 197:
+	case 204:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1326
+//line sql.y:1400
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 198:
+	case 205:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1331
+//line sql.y:1405
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 199:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1337
+//line sql.y:1411
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 200:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1341
+//line sql.y:1415
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 201:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1345
+//line sql.y:1419
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 202:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1349
+//line sql.y:1423
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 203:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1353
+//line sql.y:1427
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 204:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1357
+//line sql.y:1431
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 205:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1361
+//line sql.y:1435
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 206:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1365
+//line sql.y:1439
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 207:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1371
+//line sql.y:1445
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 208:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1376
+//line sql.y:1450
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 209:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1381
+//line sql.y:1455
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 217:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1385
+//line sql.y:1459
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1390
+//line sql.y:1464
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 212:
+	case 219:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1394
+//line sql.y:1468
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 213:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1402
+//line sql.y:1476
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 214:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1406
+//line sql.y:1480
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 215:
+	case 222:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1412
+//line sql.y:1486
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 216:
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1420
+//line sql.y:1494
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1424
+//line sql.y:1498
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1428
+//line sql.y:1502
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1433
+//line sql.y:1507
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1437
+//line sql.y:1511
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 228:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1442
+//line sql.y:1516
 		{
 			yyVAL.str = ""
 		}
-	case 222:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1446
+//line sql.y:1520
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 223:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1450
+//line sql.y:1524
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 224:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1454
+//line sql.y:1528
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 225:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1459
+//line sql.y:1533
 		{
 			yyVAL.str = ""
 		}
-	case 226:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1463
+//line sql.y:1537
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 227:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1467
+//line sql.y:1541
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 228:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1474
+//line sql.y:1548
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 236:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1479
+//line sql.y:1553
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1483
+//line sql.y:1557
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1489
+//line sql.y:1563
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1493
+//line sql.y:1567
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 233:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1499
+//line sql.y:1573
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1503
+//line sql.y:1577
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1508
+//line sql.y:1582
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1512
+//line sql.y:1586
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1518
+//line sql.y:1592
 		{
 			yyVAL.str = ""
 		}
-	case 238:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1522
+//line sql.y:1596
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 239:
+	case 246:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1528
+//line sql.y:1602
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1532
+//line sql.y:1606
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1536
+//line sql.y:1610
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 249:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1540
+//line sql.y:1614
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 250:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1544
+//line sql.y:1618
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1549
+//line sql.y:1623
 		{
 			yyVAL.str = ""
 		}
-	case 245:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1553
+//line sql.y:1627
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 246:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1559
+//line sql.y:1633
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 247:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1563
+//line sql.y:1637
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 248:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1567
+//line sql.y:1641
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 249:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1574
+//line sql.y:1648
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 250:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1578
+//line sql.y:1652
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 251:
+	case 258:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1583
+//line sql.y:1657
 		{
 			yyVAL.str = "key"
 		}
-	case 252:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1587
+//line sql.y:1661
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 253:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1593
+//line sql.y:1667
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 254:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1597
+//line sql.y:1671
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 255:
+	case 262:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1602
+//line sql.y:1676
 		{
 			yyVAL.str = ""
 		}
-	case 256:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1606
+//line sql.y:1680
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 257:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1612
+//line sql.y:1686
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1616
+//line sql.y:1690
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 259:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1622
+//line sql.y:1696
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1628
+//line sql.y:1702
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1632
+//line sql.y:1706
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1638
+//line sql.y:1712
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1642
+//line sql.y:1716
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 271:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1648
+//line sql.y:1722
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 272:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1654
+//line sql.y:1728
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 273:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1658
+//line sql.y:1732
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 274:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1662
+//line sql.y:1736
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 275:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1666
+//line sql.y:1740
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1671
+//line sql.y:1745
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1675
+//line sql.y:1749
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 278:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1681
+//line sql.y:1755
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1687
+//line sql.y:1761
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1693
+//line sql.y:1767
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1699
+//line sql.y:1773
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1703
+//line sql.y:1777
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1707
+//line sql.y:1781
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1711
+//line sql.y:1785
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1715
+//line sql.y:1789
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 286:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1720
+//line sql.y:1794
 		{
 			yyVAL.str = ""
 		}
-	case 280:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1724
+//line sql.y:1798
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 281:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1728
+//line sql.y:1802
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 282:
+	case 289:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1733
+//line sql.y:1807
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1737
+//line sql.y:1811
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1741
+//line sql.y:1815
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 292:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1746
+//line sql.y:1820
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1750
+//line sql.y:1824
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1756
+//line sql.y:1830
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1760
+//line sql.y:1834
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 289:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1764
+//line sql.y:1838
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 290:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1770
+//line sql.y:1844
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1774
+//line sql.y:1848
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 292:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1780
+//line sql.y:1854
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1784
+//line sql.y:1858
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1788
+//line sql.y:1862
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1792
+//line sql.y:1866
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1796
+//line sql.y:1870
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1800
+//line sql.y:1874
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1804
+//line sql.y:1878
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1808
+//line sql.y:1882
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1812
+//line sql.y:1886
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1816
+//line sql.y:1890
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1820
+//line sql.y:1894
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1824
+//line sql.y:1898
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1828
+//line sql.y:1902
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1832
+//line sql.y:1906
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1836
+//line sql.y:1910
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1840
+//line sql.y:1914
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1844
+//line sql.y:1918
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1848
+//line sql.y:1922
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1852
+//line sql.y:1926
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1856
+//line sql.y:1930
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1860
+//line sql.y:1934
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1864
+//line sql.y:1938
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1868
+//line sql.y:1942
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1872
+//line sql.y:1946
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1876
+//line sql.y:1950
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1880
+//line sql.y:1954
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 325:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1884
+//line sql.y:1958
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 326:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1888
+//line sql.y:1962
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1893
+//line sql.y:1967
 		{
 			yyVAL.str = ""
 		}
-	case 321:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1897
+//line sql.y:1971
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 322:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1901
+//line sql.y:1975
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 332:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1920
+//line sql.y:1994
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 333:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1924
+//line sql.y:1998
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 334:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1928
+//line sql.y:2002
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 335:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1933
+//line sql.y:2007
 		{
 			yyVAL.str = ""
 		}
-	case 337:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1939
+//line sql.y:2013
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1943
+//line sql.y:2017
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1948
+//line sql.y:2022
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1952
+//line sql.y:2026
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1957
+//line sql.y:2031
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1961
+//line sql.y:2035
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1965
+//line sql.y:2039
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 344:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1969
+//line sql.y:2043
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1973
+//line sql.y:2047
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 346:
+	case 353:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1977
+//line sql.y:2051
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1983
+//line sql.y:2057
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1987
+//line sql.y:2061
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 349:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1991
+//line sql.y:2065
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 350:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1997
+//line sql.y:2071
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2001
+//line sql.y:2075
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2005
+//line sql.y:2079
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2009
+//line sql.y:2083
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 361:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2013
+//line sql.y:2087
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 362:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2017
+//line sql.y:2091
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 363:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2021
+//line sql.y:2095
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 364:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2025
+//line sql.y:2099
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 365:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2029
+//line sql.y:2103
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].colNameUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 366:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2033
+//line sql.y:2107
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 367:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2037
+//line sql.y:2111
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2041
+//line sql.y:2115
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2045
+//line sql.y:2119
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2049
+//line sql.y:2123
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2053
+//line sql.y:2127
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2057
+//line sql.y:2131
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2061
+//line sql.y:2135
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2065
+//line sql.y:2139
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 375:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2069
+//line sql.y:2143
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2073
+//line sql.y:2147
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2077
+//line sql.y:2151
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 378:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2081
+//line sql.y:2155
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2087
+//line sql.y:2161
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2091
+//line sql.y:2165
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 374:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2097
+//line sql.y:2171
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2101
+//line sql.y:2175
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2105
+//line sql.y:2179
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2109
+//line sql.y:2183
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2113
+//line sql.y:2187
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2117
+//line sql.y:2191
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2121
+//line sql.y:2195
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2125
+//line sql.y:2199
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2129
+//line sql.y:2203
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2135
+//line sql.y:2209
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 391:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2141
+//line sql.y:2215
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8559,10 +8896,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2148
+//line sql.y:2222
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8570,28 +8907,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 393:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2155
+//line sql.y:2229
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 394:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2161
+//line sql.y:2235
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2165
+//line sql.y:2239
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8599,10 +8936,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 396:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2172
+//line sql.y:2246
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8610,10 +8947,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 397:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2179
+//line sql.y:2253
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8626,10 +8963,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 398:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2191
+//line sql.y:2265
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8640,26 +8977,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 399:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2201
+//line sql.y:2275
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 400:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2205
+//line sql.y:2279
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 401:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2209
+//line sql.y:2283
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8673,10 +9010,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 402:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2222
+//line sql.y:2296
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8687,18 +9024,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 403:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2232
+//line sql.y:2306
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 404:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2236
+//line sql.y:2310
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8710,10 +9047,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 405:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2247
+//line sql.y:2321
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8721,10 +9058,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 406:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2254
+//line sql.y:2328
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8732,10 +9069,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 407:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2261
+//line sql.y:2335
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8743,282 +9080,282 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 408:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2268
+//line sql.y:2342
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 409:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2276
+//line sql.y:2350
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2280
+//line sql.y:2354
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 411:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2284
+//line sql.y:2358
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 412:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2288
+//line sql.y:2362
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 413:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2292
+//line sql.y:2366
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 414:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2296
+//line sql.y:2370
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2300
+//line sql.y:2374
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2304
+//line sql.y:2378
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2308
+//line sql.y:2382
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2312
+//line sql.y:2386
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 419:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2316
+//line sql.y:2390
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2320
+//line sql.y:2394
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2324
+//line sql.y:2398
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2328
+//line sql.y:2402
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2332
+//line sql.y:2406
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2336
+//line sql.y:2410
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2340
+//line sql.y:2414
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2344
+//line sql.y:2418
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2348
+//line sql.y:2422
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2352
+//line sql.y:2426
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2356
+//line sql.y:2430
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2360
+//line sql.y:2434
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 431:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2365
+//line sql.y:2439
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2369
+//line sql.y:2443
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2373
+//line sql.y:2447
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2380
+//line sql.y:2454
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2384
+//line sql.y:2458
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 429:
+	case 436:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2390
+//line sql.y:2464
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 437:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2394
+//line sql.y:2468
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2400
+//line sql.y:2474
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2406
+//line sql.y:2480
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 440:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2410
+//line sql.y:2484
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 434:
+	case 441:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2416
+//line sql.y:2490
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 442:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2420
+//line sql.y:2494
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -9028,387 +9365,387 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 443:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2429
+//line sql.y:2503
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 444:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2433
+//line sql.y:2507
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2439
+//line sql.y:2513
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2443
+//line sql.y:2517
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2448
+//line sql.y:2522
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2454
+//line sql.y:2528
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2458
+//line sql.y:2532
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 450:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2462
+//line sql.y:2536
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2466
+//line sql.y:2540
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2470
+//line sql.y:2544
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2474
+//line sql.y:2548
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2478
+//line sql.y:2552
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 455:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2482
+//line sql.y:2556
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 456:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2486
+//line sql.y:2560
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 457:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2490
+//line sql.y:2564
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2494
+//line sql.y:2568
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 459:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2498
+//line sql.y:2572
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 460:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2502
+//line sql.y:2576
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 461:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2580
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2584
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 463:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2514
+//line sql.y:2588
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2518
+//line sql.y:2592
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 465:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2522
+//line sql.y:2596
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 466:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2526
+//line sql.y:2600
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 467:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2530
+//line sql.y:2604
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2534
+//line sql.y:2608
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2538
+//line sql.y:2612
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 470:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2542
+//line sql.y:2616
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2546
+//line sql.y:2620
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2550
+//line sql.y:2624
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2554
+//line sql.y:2628
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2558
+//line sql.y:2632
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2562
+//line sql.y:2636
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2566
+//line sql.y:2640
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2570
+//line sql.y:2644
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2574
+//line sql.y:2648
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2578
+//line sql.y:2652
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 480:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2582
+//line sql.y:2656
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 481:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2586
+//line sql.y:2660
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2590
+//line sql.y:2664
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2594
+//line sql.y:2668
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2598
+//line sql.y:2672
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2603
+//line sql.y:2677
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2607
+//line sql.y:2681
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2611
+//line sql.y:2685
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 488:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2615
+//line sql.y:2689
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2619
+//line sql.y:2693
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2624
+//line sql.y:2698
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9416,1234 +9753,1234 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2638
+//line sql.y:2712
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2642
+//line sql.y:2716
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2646
+//line sql.y:2720
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2652
+//line sql.y:2726
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 488:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2656
+//line sql.y:2730
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 489:
+	case 496:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2662
+//line sql.y:2736
 		{
 			yyVAL.str = ""
 		}
-	case 490:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2666
+//line sql.y:2740
 		{
 			yyVAL.str = "extended "
 		}
-	case 491:
+	case 498:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2672
+//line sql.y:2746
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2676
+//line sql.y:2750
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2682
+//line sql.y:2756
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 494:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:2760
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 495:
+	case 502:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2692
+//line sql.y:2766
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 496:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2696
+//line sql.y:2770
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 497:
+	case 504:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2700
+//line sql.y:2774
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 498:
+	case 505:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2706
+//line sql.y:2780
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 506:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2710
+//line sql.y:2784
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2714
+//line sql.y:2788
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 508:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2720
+//line sql.y:2794
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2724
+//line sql.y:2798
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 510:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2730
+//line sql.y:2804
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 504:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2734
+//line sql.y:2808
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 505:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2738
+//line sql.y:2812
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 506:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2744
+//line sql.y:2818
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2748
+//line sql.y:2822
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2754
+//line sql.y:2828
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2758
+//line sql.y:2832
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2764
+//line sql.y:2838
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2770
+//line sql.y:2844
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 519:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2774
+//line sql.y:2848
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2779
+//line sql.y:2853
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 514:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2781
+//line sql.y:2855
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 515:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2784
+//line sql.y:2858
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 516:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2786
+//line sql.y:2860
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 517:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2791
+//line sql.y:2865
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2797
+//line sql.y:2871
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2802
+//line sql.y:2876
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2806
+//line sql.y:2880
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2810
+//line sql.y:2884
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2814
+//line sql.y:2888
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2818
+//line sql.y:2892
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2822
+//line sql.y:2896
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2828
+//line sql.y:2902
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 526:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2832
+//line sql.y:2906
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 527:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2836
+//line sql.y:2910
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 528:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2842
+//line sql.y:2916
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2846
+//line sql.y:2920
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2850
+//line sql.y:2924
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2854
+//line sql.y:2928
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2859
+//line sql.y:2933
 		{
 			yyVAL.str = ""
 		}
-	case 533:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2863
+//line sql.y:2937
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 534:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2867
+//line sql.y:2941
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 535:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2873
+//line sql.y:2947
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2877
+//line sql.y:2951
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2883
+//line sql.y:2957
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2887
+//line sql.y:2961
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2893
+//line sql.y:2967
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2899
+//line sql.y:2973
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2903
+//line sql.y:2977
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 542:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2909
+//line sql.y:2983
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2915
+//line sql.y:2989
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2919
+//line sql.y:2993
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2923
+//line sql.y:2997
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2927
+//line sql.y:3001
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2933
+//line sql.y:3007
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 555:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2939
+//line sql.y:3013
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2945
+//line sql.y:3019
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2949
+//line sql.y:3023
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 558:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2953
+//line sql.y:3027
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2957
+//line sql.y:3031
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 560:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2961
+//line sql.y:3035
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 561:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2965
+//line sql.y:3039
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2971
+//line sql.y:3045
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 556:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2975
+//line sql.y:3049
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 557:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2981
+//line sql.y:3055
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 558:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2985
+//line sql.y:3059
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 559:
+	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2989
+//line sql.y:3063
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 560:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2993
+//line sql.y:3067
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 561:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2997
+//line sql.y:3071
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 562:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3001
+//line sql.y:3075
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 563:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3005
+//line sql.y:3079
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 564:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3009
+//line sql.y:3083
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 565:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3013
+//line sql.y:3087
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 566:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3017
+//line sql.y:3091
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 567:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3021
+//line sql.y:3095
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 568:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3025
+//line sql.y:3099
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 569:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3030
+//line sql.y:3104
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3034
+//line sql.y:3108
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3038
+//line sql.y:3112
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3043
+//line sql.y:3117
 		{
 			yyVAL.str = ""
 		}
-	case 573:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3047
+//line sql.y:3121
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 574:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3052
+//line sql.y:3126
 		{
 			setAllowComments(yylex, true)
 		}
-	case 575:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3056
+//line sql.y:3130
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 576:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3062
+//line sql.y:3136
 		{
 			yyVAL.strs = nil
 		}
-	case 577:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3066
+//line sql.y:3140
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 578:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3072
+//line sql.y:3146
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3076
+//line sql.y:3150
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3080
+//line sql.y:3154
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3085
+//line sql.y:3159
 		{
 			yyVAL.str = ""
 		}
-	case 582:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3089
+//line sql.y:3163
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 583:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3167
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 584:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3098
+//line sql.y:3172
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3102
+//line sql.y:3176
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3106
+//line sql.y:3180
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3111
+//line sql.y:3185
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3115
+//line sql.y:3189
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 596:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3120
+//line sql.y:3194
 		{
 			yyVAL.strs = nil
 		}
-	case 590:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3124
+//line sql.y:3198
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 591:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3128
+//line sql.y:3202
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 592:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3132
+//line sql.y:3206
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 593:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3136
+//line sql.y:3210
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 594:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3142
+//line sql.y:3216
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 595:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3146
+//line sql.y:3220
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 596:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3150
+//line sql.y:3224
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 597:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3228
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 598:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3232
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 599:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3162
+//line sql.y:3236
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 600:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3166
+//line sql.y:3240
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 601:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3172
+//line sql.y:3246
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3176
+//line sql.y:3250
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 603:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3182
+//line sql.y:3256
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3186
+//line sql.y:3260
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3190
+//line sql.y:3264
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 613:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3194
+//line sql.y:3268
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 614:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3199
+//line sql.y:3273
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 608:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3203
+//line sql.y:3277
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 609:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3207
+//line sql.y:3281
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 611:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3214
+//line sql.y:3288
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 612:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3219
+//line sql.y:3293
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3223
+//line sql.y:3297
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3229
+//line sql.y:3303
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3233
+//line sql.y:3307
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 618:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3243
+//line sql.y:3317
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3247
+//line sql.y:3321
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3251
+//line sql.y:3325
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3257
+//line sql.y:3331
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3263
+//line sql.y:3337
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 630:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3267
+//line sql.y:3341
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 631:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3272
+//line sql.y:3346
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3276
+//line sql.y:3350
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3282
+//line sql.y:3356
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3286
+//line sql.y:3360
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 628:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3292
+//line sql.y:3366
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3296
+//line sql.y:3370
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3300
+//line sql.y:3374
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 631:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3304
+//line sql.y:3378
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 632:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3310
+//line sql.y:3384
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3314
+//line sql.y:3388
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 634:
+	case 641:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3327
+//line sql.y:3401
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3331
+//line sql.y:3405
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3335
+//line sql.y:3409
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3339
+//line sql.y:3413
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3345
+//line sql.y:3419
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 639:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3347
+//line sql.y:3421
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 640:
+	case 647:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3351
+//line sql.y:3425
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 641:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3353
+//line sql.y:3427
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 642:
+	case 649:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3357
+//line sql.y:3431
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 643:
+	case 650:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3359
+//line sql.y:3433
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 644:
+	case 651:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3362
+//line sql.y:3436
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 645:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3364
+//line sql.y:3438
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3367
+//line sql.y:3441
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 647:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3371
+//line sql.y:3445
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 648:
+	case 655:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3375
+//line sql.y:3449
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 650:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3382
+//line sql.y:3456
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 651:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3388
+//line sql.y:3462
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 659:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3392
+//line sql.y:3466
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 660:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3396
+//line sql.y:3470
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3402
+//line sql.y:3476
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3408
+//line sql.y:3482
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3412
+//line sql.y:3486
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3416
+//line sql.y:3490
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3420
+//line sql.y:3494
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3426
+//line sql.y:3500
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3430
+//line sql.y:3504
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10652,668 +10989,677 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3440
+//line sql.y:3514
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 662:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3444
+//line sql.y:3518
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 663:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3450
+//line sql.y:3524
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 664:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3454
+//line sql.y:3528
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 665:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3460
+//line sql.y:3534
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 666:
+	case 673:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3465
+//line sql.y:3539
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 674:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3469
+//line sql.y:3543
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 675:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3473
+//line sql.y:3547
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 676:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3477
+//line sql.y:3551
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 677:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3481
+//line sql.y:3555
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 678:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3486
+//line sql.y:3560
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3490
+//line sql.y:3564
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3496
+//line sql.y:3570
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3500
+//line sql.y:3574
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3504
+//line sql.y:3578
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3508
+//line sql.y:3582
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3512
+//line sql.y:3586
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3516
+//line sql.y:3590
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3520
+//line sql.y:3594
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3524
+//line sql.y:3598
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 688:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3530
+//line sql.y:3604
 		{
 			yyVAL.str = ""
 		}
-	case 682:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3534
+//line sql.y:3608
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 683:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3540
+//line sql.y:3614
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3544
+//line sql.y:3618
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3550
+//line sql.y:3624
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3554
+//line sql.y:3628
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 694:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3558
+//line sql.y:3632
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 695:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3562
+//line sql.y:3636
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 696:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3566
+//line sql.y:3640
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3570
+//line sql.y:3644
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 698:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3574
+//line sql.y:3648
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 699:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3578
+//line sql.y:3652
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 700:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3582
+//line sql.y:3656
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3586
+//line sql.y:3660
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3592
+//line sql.y:3666
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3596
+//line sql.y:3670
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3600
+//line sql.y:3674
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 705:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3604
+//line sql.y:3678
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3608
+//line sql.y:3682
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3612
+//line sql.y:3686
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3618
+//line sql.y:3692
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3622
+//line sql.y:3696
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3626
+//line sql.y:3700
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3630
+//line sql.y:3704
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3634
+//line sql.y:3708
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3638
+//line sql.y:3712
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3642
+//line sql.y:3716
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 715:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3647
+//line sql.y:3721
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3651
+//line sql.y:3725
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3657
+//line sql.y:3731
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3661
+//line sql.y:3735
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3665
+//line sql.y:3739
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 720:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3672
+//line sql.y:3746
 		{
 			yyLOCAL = &Subquery{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 721:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *Subquery
+//line sql.y:3750
+		{
+			yyDollar[3].selStmtUnion().SetWith(yyDollar[2].withUnion())
+			yyLOCAL = &Subquery{yyDollar[3].selStmtUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3678
+//line sql.y:3757
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 723:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3682
+//line sql.y:3761
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 716:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3688
+//line sql.y:3767
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3692
+//line sql.y:3771
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3696
+//line sql.y:3775
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3700
+//line sql.y:3779
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3704
+//line sql.y:3783
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3708
+//line sql.y:3787
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 730:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3712
+//line sql.y:3791
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3716
+//line sql.y:3795
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3720
+//line sql.y:3799
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 733:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3724
+//line sql.y:3803
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3728
+//line sql.y:3807
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 735:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3732
+//line sql.y:3811
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3736
+//line sql.y:3815
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3740
+//line sql.y:3819
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3744
+//line sql.y:3823
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3748
+//line sql.y:3827
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3752
+//line sql.y:3831
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3756
+//line sql.y:3835
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3760
+//line sql.y:3839
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3764
+//line sql.y:3843
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3768
+//line sql.y:3847
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3772
+//line sql.y:3851
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3776
+//line sql.y:3855
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3780
+//line sql.y:3859
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3784
+//line sql.y:3863
 		{
 			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3788
+//line sql.y:3867
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3792
+//line sql.y:3871
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3796
+//line sql.y:3875
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3800
+//line sql.y:3879
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3804
+//line sql.y:3883
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -11322,666 +11668,1030 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 759:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3822
+//line sql.y:3902
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 760:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3826
+//line sql.y:3906
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 761:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3830
+//line sql.y:3910
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 762:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3834
+//line sql.y:3914
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 763:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3923
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("cume_dist"), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 764:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3927
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("dense_rank"), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 765:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3931
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("percent_rank"), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 766:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3935
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("rank"), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 767:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3844
+//line sql.y:3939
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("row_number"), Over: yyDollar[4].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 768:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3943
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("ntile"), Exprs: Exprs{yyDollar[3].exprUnion()}, Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 769:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3947
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("first_value"), Exprs: Exprs{yyDollar[3].exprUnion()}, NullTreatment: yyDollar[5].nullTreatmentTypeUnion(), Over: yyDollar[6].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 770:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3951
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("last_value"), Exprs: Exprs{yyDollar[3].exprUnion()}, NullTreatment: yyDollar[5].nullTreatmentTypeUnion(), Over: yyDollar[6].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 771:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3955
+		{
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("nth_value"), Exprs: Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()}, FromFirstLast: yyDollar[7].fromFirstLastTypeUnion(), NullTreatment: yyDollar[8].nullTreatmentTypeUnion(), Over: yyDollar[9].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3959
+		{
+			if len(yyDollar[3].exprsUnion()) > 3 {
+				yylex.Error("too many arguments for lag")
+				return 1
+			}
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("lag"), Exprs: yyDollar[3].exprsUnion(), NullTreatment: yyDollar[5].nullTreatmentTypeUnion(), Over: yyDollar[6].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3967
+		{
+			if len(yyDollar[3].exprsUnion()) > 3 {
+				yylex.Error("too many arguments for lead")
+				return 1
+			}
+			yyLOCAL = &WindowFuncExpr{Name: NewColIdent("lead"), Exprs: yyDollar[3].exprsUnion(), NullTreatment: yyDollar[5].nullTreatmentTypeUnion(), Over: yyDollar[6].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL NullTreatmentType
+//line sql.y:3976
+		{
+			yyLOCAL = NoNullTreatmentType
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL NullTreatmentType
+//line sql.y:3980
+		{
+			yyLOCAL = RespectNullsType
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL NullTreatmentType
+//line sql.y:3984
+		{
+			yyLOCAL = IgnoreNullsType
+		}
+		yyVAL.union = yyLOCAL
+	case 777:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL FromFirstLastType
+//line sql.y:3989
+		{
+			yyLOCAL = NoFromFirstLastType
+		}
+		yyVAL.union = yyLOCAL
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL FromFirstLastType
+//line sql.y:3993
+		{
+			yyLOCAL = FromFirstType
+		}
+		yyVAL.union = yyLOCAL
+	case 779:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL FromFirstLastType
+//line sql.y:3997
+		{
+			yyLOCAL = FromLastType
+		}
+		yyVAL.union = yyLOCAL
+	case 780:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4002
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4006
+		{
+			yyLOCAL = yyDollar[1].overClauseUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4012
+		{
+			yyLOCAL = &OverClause{WindowSpec: yyDollar[3].windowSpecUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 783:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4016
+		{
+			yyLOCAL = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+		yyVAL.union = yyLOCAL
+	case 784:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:4022
+		{
+			yyLOCAL = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionClause: yyDollar[2].exprsUnion(), OrderClause: yyDollar[3].orderByUnion(), FrameClause: yyDollar[4].frameClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 785:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4028
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 786:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4032
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 787:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4037
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 788:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4041
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 789:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4046
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 790:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4050
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitTypeUnion(), Start: yyDollar[2].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 791:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4054
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitTypeUnion(), Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 792:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4060
+		{
+			yyLOCAL = FrameRowsType
+		}
+		yyVAL.union = yyLOCAL
+	case 793:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4064
+		{
+			yyLOCAL = FrameRangeType
+		}
+		yyVAL.union = yyLOCAL
+	case 794:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4070
+		{
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
+		}
+		yyVAL.union = yyLOCAL
+	case 795:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4074
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 796:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4078
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 797:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4082
+		{
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 798:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4086
+		{
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 799:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4093
+		{
+			yyLOCAL = NewIntLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 800:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4097
+		{
+			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 801:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4101
+		{
+			yyLOCAL = NewArgument(yyDollar[1].str[1:])
+			bindVariable(yylex, yyDollar[1].str[1:])
+		}
+		yyVAL.union = yyLOCAL
+	case 802:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4106
+		{
+			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
+		}
+		yyVAL.union = yyLOCAL
+	case 803:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4111
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 804:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4115
+		{
+			yyLOCAL = yyDollar[2].windowDefinitionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 805:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:4121
+		{
+			yyLOCAL = WindowDefinitions{yyDollar[1].windowDefinitionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 806:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4125
+		{
+			yySLICE := (*WindowDefinitions)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].windowDefinitionUnion())
+		}
+	case 807:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *WindowDefinition
+//line sql.y:4131
+		{
+			yyLOCAL = &WindowDefinition{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpecUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 808:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4141
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 809:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3848
+//line sql.y:4145
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 810:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3852
+//line sql.y:4149
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 811:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3856
+//line sql.y:4153
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 812:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3860
+//line sql.y:4157
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 813:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3864
+//line sql.y:4161
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 814:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3868
+//line sql.y:4165
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 815:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3872
+//line sql.y:4169
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 816:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3876
+//line sql.y:4173
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 817:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3880
+//line sql.y:4177
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 818:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3884
+//line sql.y:4181
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 819:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3888
+//line sql.y:4185
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 820:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3892
+//line sql.y:4189
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 821:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3896
+//line sql.y:4193
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 822:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3906
+//line sql.y:4203
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 823:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3910
+//line sql.y:4207
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 824:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3914
+//line sql.y:4211
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3919
+//line sql.y:4216
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3924
+//line sql.y:4221
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3929
+//line sql.y:4226
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3935
+//line sql.y:4232
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 829:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3940
+//line sql.y:4237
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3945
+//line sql.y:4242
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3949
+//line sql.y:4246
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 832:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3953
+//line sql.y:4250
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 833:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3958
+//line sql.y:4255
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3963
+//line sql.y:4260
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 835:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3968
+//line sql.y:4265
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 836:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3972
+//line sql.y:4269
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 837:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3976
+//line sql.y:4273
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3986
+//line sql.y:4283
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 841:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3996
+//line sql.y:4293
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 842:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4000
+//line sql.y:4297
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 843:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4004
+//line sql.y:4301
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 844:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4008
+//line sql.y:4305
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 845:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4012
+//line sql.y:4309
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 846:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4016
+//line sql.y:4313
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 847:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4020
+//line sql.y:4317
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 848:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4026
+//line sql.y:4323
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 849:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4030
+//line sql.y:4327
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 850:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4034
+//line sql.y:4331
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 851:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4038
+//line sql.y:4335
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4042
+//line sql.y:4339
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4048
+//line sql.y:4345
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 800:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4052
+//line sql.y:4349
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 801:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4056
+//line sql.y:4353
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 802:
+	case 856:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4062
+//line sql.y:4359
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 857:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4066
+//line sql.y:4363
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 858:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4070
+//line sql.y:4367
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4074
+//line sql.y:4371
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4078
+//line sql.y:4375
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4082
+//line sql.y:4379
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4088
+//line sql.y:4385
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4092
+//line sql.y:4389
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4096
+//line sql.y:4393
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 865:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4100
+//line sql.y:4397
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 866:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4104
+//line sql.y:4401
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4108
+//line sql.y:4405
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4112
+//line sql.y:4409
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 869:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4117
+//line sql.y:4414
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4121
+//line sql.y:4418
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 871:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4126
+//line sql.y:4423
 		{
 			yyVAL.str = string("")
 		}
-	case 818:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4130
+//line sql.y:4427
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 819:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4136
+//line sql.y:4433
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4140
+//line sql.y:4437
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 821:
+	case 875:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4146
+//line sql.y:4443
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 876:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4151
+//line sql.y:4448
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4155
+//line sql.y:4452
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4161
+//line sql.y:4458
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4165
+//line sql.y:4462
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 880:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4169
+//line sql.y:4466
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4175
+//line sql.y:4472
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4179
+//line sql.y:4476
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 883:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4183
+//line sql.y:4480
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4187
+//line sql.y:4484
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4191
+//line sql.y:4488
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4195
+//line sql.y:4492
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4199
+//line sql.y:4496
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4204
+//line sql.y:4501
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4210
+//line sql.y:4507
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -11991,707 +12701,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 890:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4219
+//line sql.y:4516
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4223
+//line sql.y:4520
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 892:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4229
+//line sql.y:4526
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 893:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4233
+//line sql.y:4530
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 894:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4238
+//line sql.y:4535
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 895:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4242
+//line sql.y:4539
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 896:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4247
+//line sql.y:4544
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4251
+//line sql.y:4548
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4257
+//line sql.y:4554
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 899:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4261
+//line sql.y:4558
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 846:
+	case 900:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4267
+//line sql.y:4564
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4272
+//line sql.y:4569
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4276
+//line sql.y:4573
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 903:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4280
+//line sql.y:4577
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 904:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4285
+//line sql.y:4582
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 905:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4289
+//line sql.y:4586
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 906:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4293
+//line sql.y:4590
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 907:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4297
+//line sql.y:4594
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 908:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4302
+//line sql.y:4599
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 909:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4306
+//line sql.y:4603
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 910:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4310
+//line sql.y:4607
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4314
+//line sql.y:4611
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4318
+//line sql.y:4615
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 913:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4325
+//line sql.y:4622
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4329
+//line sql.y:4626
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4333
+//line sql.y:4630
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 916:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4337
+//line sql.y:4634
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4343
+//line sql.y:4640
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 918:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4347
+//line sql.y:4644
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4351
+//line sql.y:4648
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 920:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4356
+//line sql.y:4653
 		{
 			yyVAL.str = ""
 		}
-	case 867:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4360
+//line sql.y:4657
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 868:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4364
+//line sql.y:4661
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 869:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4368
+//line sql.y:4665
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 870:
+	case 924:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4373
+//line sql.y:4670
 		{
 			yyVAL.str = ""
 		}
-	case 871:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4377
+//line sql.y:4674
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 872:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4383
+//line sql.y:4680
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 873:
+	case 927:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4387
+//line sql.y:4684
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 874:
+	case 928:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4392
+//line sql.y:4689
 		{
 			yyVAL.str = ""
 		}
-	case 875:
+	case 929:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4396
+//line sql.y:4693
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 876:
+	case 930:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4401
+//line sql.y:4698
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 877:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4405
+//line sql.y:4702
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 878:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4409
+//line sql.y:4706
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 879:
+	case 933:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4414
+//line sql.y:4711
 		{
 			yyVAL.str = ""
 		}
-	case 880:
+	case 934:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4418
+//line sql.y:4715
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 881:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4424
+//line sql.y:4721
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 882:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4428
+//line sql.y:4725
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 883:
+	case 937:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4432
+//line sql.y:4729
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 884:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4436
+//line sql.y:4733
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 885:
+	case 939:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4441
+//line sql.y:4738
 		{
 			yyLOCAL = NoLock
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 940:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4445
+//line sql.y:4742
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 941:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4449
+//line sql.y:4746
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4454
+//line sql.y:4751
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 943:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4458
+//line sql.y:4755
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4462
+//line sql.y:4759
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 945:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4466
+//line sql.y:4763
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4471
+//line sql.y:4768
 		{
 			yyVAL.str = ""
 		}
-	case 893:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4475
+//line sql.y:4772
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 894:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4479
+//line sql.y:4776
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 895:
+	case 949:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4484
+//line sql.y:4781
 		{
 			yyVAL.str = ""
 		}
-	case 896:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4488
+//line sql.y:4785
 		{
 			yyVAL.str = " header"
 		}
-	case 897:
+	case 951:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4493
+//line sql.y:4790
 		{
 			yyVAL.str = ""
 		}
-	case 898:
+	case 952:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4497
+//line sql.y:4794
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 899:
+	case 953:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4501
+//line sql.y:4798
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 900:
+	case 954:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4506
+//line sql.y:4803
 		{
 			yyVAL.str = ""
 		}
-	case 901:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4510
+//line sql.y:4807
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 902:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4514
+//line sql.y:4811
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 903:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4520
+//line sql.y:4817
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 904:
+	case 958:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4525
+//line sql.y:4822
 		{
 			yyVAL.str = ""
 		}
-	case 905:
+	case 959:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4529
+//line sql.y:4826
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 906:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4535
+//line sql.y:4832
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 907:
+	case 961:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4539
+//line sql.y:4836
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 908:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4545
+//line sql.y:4842
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 909:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4549
+//line sql.y:4846
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 910:
+	case 964:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4554
+//line sql.y:4851
 		{
 			yyVAL.str = ""
 		}
-	case 911:
+	case 965:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4558
+//line sql.y:4855
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 912:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4564
+//line sql.y:4861
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 913:
+	case 967:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4568
+//line sql.y:4865
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 914:
+	case 968:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4574
+//line sql.y:4871
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 915:
+	case 969:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4578
+//line sql.y:4875
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 916:
+	case 970:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4582
+//line sql.y:4879
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 917:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4587
+//line sql.y:4884
 		{
 			yyVAL.str = ""
 		}
-	case 918:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4591
+//line sql.y:4888
 		{
 			yyVAL.str = " optionally"
 		}
-	case 919:
+	case 973:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4604
+//line sql.y:4901
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4608
+//line sql.y:4905
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 975:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4612
+//line sql.y:4909
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 976:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4616
+//line sql.y:4913
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 977:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4620
+//line sql.y:4917
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4626
+//line sql.y:4923
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 979:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4630
+//line sql.y:4927
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 980:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4634
+//line sql.y:4931
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 927:
+	case 981:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4638
+//line sql.y:4935
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 928:
+	case 982:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4643
+//line sql.y:4940
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 983:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4647
+//line sql.y:4944
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4653
+//line sql.y:4950
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 985:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4657
+//line sql.y:4954
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 932:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4663
+//line sql.y:4960
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 987:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4667
+//line sql.y:4964
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 988:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4673
+//line sql.y:4970
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4679
+//line sql.y:4976
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12700,329 +13410,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4689
+//line sql.y:4986
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 991:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4693
+//line sql.y:4990
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 938:
+	case 992:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4699
+//line sql.y:4996
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4705
+//line sql.y:5002
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 994:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4709
+//line sql.y:5006
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 941:
+	case 995:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4715
+//line sql.y:5012
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 996:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4719
+//line sql.y:5016
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 997:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4723
+//line sql.y:5020
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 998:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4727
+//line sql.y:5024
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 999:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4731
+//line sql.y:5028
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1001:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4739
+//line sql.y:5036
 		{
 			yyVAL.str = "charset"
 		}
-	case 950:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4749
+//line sql.y:5046
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4753
+//line sql.y:5050
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4757
+//line sql.y:5054
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1009:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4766
+//line sql.y:5063
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4768
+//line sql.y:5065
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1011:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4771
+//line sql.y:5068
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1012:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4773
+//line sql.y:5070
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1013:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4776
+//line sql.y:5073
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1014:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4778
+//line sql.y:5075
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4781
+//line sql.y:5078
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4783
+//line sql.y:5080
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1017:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4786
+//line sql.y:5083
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 964:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4788
+//line sql.y:5085
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 965:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4790
+//line sql.y:5087
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 966:
+	case 1020:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4794
+//line sql.y:5091
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1021:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4799
+//line sql.y:5096
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4803
+//line sql.y:5100
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1023:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4808
+//line sql.y:5105
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4810
+//line sql.y:5107
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1025:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4814
+//line sql.y:5111
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4820
+//line sql.y:5117
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 973:
+	case 1027:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4824
+//line sql.y:5121
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 975:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4831
+//line sql.y:5128
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 976:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4837
+//line sql.y:5134
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 977:
+	case 1031:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4841
+//line sql.y:5138
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 978:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4847
+//line sql.y:5144
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 979:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4851
+//line sql.y:5148
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 981:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4858
+//line sql.y:5155
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1395:
+	case 1453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5296
+//line sql.y:5597
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1396:
+	case 1454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5305
+//line sql.y:5606
 		{
 			decNesting(yylex)
 		}
-	case 1397:
+	case 1455:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5310
+//line sql.y:5611
 		{
 			skipToEnd(yylex)
 		}
-	case 1398:
+	case 1456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5315
+//line sql.y:5616
 		{
 			skipToEnd(yylex)
 		}
-	case 1399:
+	case 1457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5319
+//line sql.y:5620
 		{
 			skipToEnd(yylex)
 		}
-	case 1400:
+	case 1458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5323
+//line sql.y:5624
 		{
 			skipToEnd(yylex)
 		}
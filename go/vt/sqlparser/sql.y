@@ -160,6 +160,19 @@ func bindVariable(yylex yyLexer, bvar string) {
   boolean bool
   boolVal BoolVal
   ignore Ignore
+
+  with *With
+  cte  *CommonTableExpr
+  ctes []*CommonTableExpr
+  overClause *OverClause
+  windowSpec *WindowSpecification
+  windowDefinition *WindowDefinition
+  windowDefinitions WindowDefinitions
+  frameClause *FrameClause
+  framePoint *FramePoint
+  frameUnitType FrameUnitType
+  fromFirstLastType FromFirstLastType
+  nullTreatmentType NullTreatmentType
 }
 
 %token LEX_ERROR
@@ -187,6 +200,10 @@ func bindVariable(yylex yyLexer, bvar string) {
 // * NOTE: If you change anything here, update precedence.go as well *
 %nonassoc <str> LOWER_THAN_CHARSET
 %nonassoc <str> CHARSET
+// Resolve the ambiguity between a window name and a frame clause in
+// "OVER (ROWS ...)" in favor of the frame clause.
+%nonassoc <str> ROWS
+%nonassoc <str> EMPTY_WINDOW_NAME
 // Resolve column attribute ambiguity.
 %right <str> UNIQUE KEY
 %left <str> OR
@@ -265,6 +282,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <str> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
 %token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
+%token <str> CURRENT RANGE ROW
 %token <str> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <str> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
 %token <str> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
@@ -285,7 +303,22 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> FIXED DYNAMIC COMPRESSED REDUNDANT COMPACT ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES STORAGE MEMORY DISK
 
 %type <statement> command
-%type <selStmt> simple_select select_statement base_select union_rhs
+%type <selStmt> simple_select select_statement query_expression base_select union_rhs
+%type <with> with_clause
+%type <ctes> with_list
+%type <cte> common_table_expr
+%type <expr> function_call_window frame_expression
+%type <overClause> over_clause over_clause_opt
+%type <windowSpec> window_spec
+%type <exprs> window_partition_clause_opt
+%type <windowDefinitions> window_clause_opt window_definition_list
+%type <windowDefinition> window_definition
+%type <frameClause> frame_clause_opt
+%type <framePoint> frame_point
+%type <frameUnitType> frame_unit
+%type <fromFirstLastType> from_first_last_opt
+%type <nullTreatmentType> null_treatment_opt
+%type <colIdent> window_name_opt
 %type <statement> explain_statement explainable_statement
 %type <statement> stream_statement vstream_statement insert_statement update_statement delete_statement set_statement set_transaction_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement
@@ -515,6 +548,19 @@ load_statement:
   }
 
 select_statement:
+  query_expression
+  {
+    $$ = $1
+  }
+| with_clause query_expression
+  {
+    $2.SetWith($1)
+    $$ = $2
+  }
+
+// query_expression is a select statement without a WITH clause.
+// The WITH clause applies to the whole query, including all its unions.
+query_expression:
   base_select order_by_opt limit_opt lock_opt into_option
   {
     sel := $1.(*Select)
@@ -528,7 +574,7 @@ select_statement:
   {
     $$ = &Union{FirstStatement: &ParenSelect{Select: $2}, OrderBy: $4, Limit:$5, Lock:$6}
   }
-| select_statement union_op union_rhs order_by_opt limit_opt lock_opt
+| query_expression union_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = Unionize($1, $3, $2, $4, $5, $6)
   }
@@ -568,6 +614,32 @@ simple_select:
     $$ = Unionize($1, $3, $2, $4, $5, $6)
   }
 
+with_clause:
+  WITH with_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE with_list
+  {
+    $$ = &With{CTEs: $3, Recursive: true}
+  }
+
+with_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| with_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{TableID: $1, Columns: $2, Subquery: $4}
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -582,10 +654,12 @@ vstream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8          9
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 union_rhs:
@@ -3672,6 +3746,11 @@ subquery:
   {
     $$ = &Subquery{$2}
   }
+| openb with_clause simple_select closeb
+  {
+    $3.SetWith($2)
+    $$ = &Subquery{$3}
+  }
 
 expression_list:
   expression
@@ -3812,15 +3891,16 @@ value_expression:
 | function_call_keyword
 | function_call_nonkeyword
 | function_call_conflict
+| function_call_window
 
 /*
   Regular function calls without special token or syntax, guaranteed to not
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -3835,6 +3915,223 @@ function_call_generic:
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+/*
+  Nonaggregate window functions, which can only be called with an OVER clause
+*/
+function_call_window:
+  CUME_DIST openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("cume_dist"), Over: $4}
+  }
+| DENSE_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("dense_rank"), Over: $4}
+  }
+| PERCENT_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("percent_rank"), Over: $4}
+  }
+| RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("rank"), Over: $4}
+  }
+| ROW_NUMBER openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("row_number"), Over: $4}
+  }
+| NTILE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("ntile"), Exprs: Exprs{$3}, Over: $5}
+  }
+| FIRST_VALUE openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("first_value"), Exprs: Exprs{$3}, NullTreatment: $5, Over: $6}
+  }
+| LAST_VALUE openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("last_value"), Exprs: Exprs{$3}, NullTreatment: $5, Over: $6}
+  }
+| NTH_VALUE openb expression ',' expression closeb from_first_last_opt null_treatment_opt over_clause
+  {
+    $$ = &WindowFuncExpr{Name: NewColIdent("nth_value"), Exprs: Exprs{$3, $5}, FromFirstLast: $7, NullTreatment: $8, Over: $9}
+  }
+| LAG openb expression_list closeb null_treatment_opt over_clause
+  {
+    if len($3) > 3 {
+      yylex.Error("too many arguments for lag")
+      return 1
+    }
+    $$ = &WindowFuncExpr{Name: NewColIdent("lag"), Exprs: $3, NullTreatment: $5, Over: $6}
+  }
+| LEAD openb expression_list closeb null_treatment_opt over_clause
+  {
+    if len($3) > 3 {
+      yylex.Error("too many arguments for lead")
+      return 1
+    }
+    $$ = &WindowFuncExpr{Name: NewColIdent("lead"), Exprs: $3, NullTreatment: $5, Over: $6}
+  }
+
+null_treatment_opt:
+  {
+    $$ = NoNullTreatmentType
+  }
+| RESPECT NULLS
+  {
+    $$ = RespectNullsType
+  }
+| IGNORE NULLS
+  {
+    $$ = IgnoreNullsType
+  }
+
+from_first_last_opt:
+  {
+    $$ = NoFromFirstLastType
+  }
+| FROM FIRST
+  {
+    $$ = FromFirstType
+  }
+| FROM LAST
+  {
+    $$ = FromLastType
+  }
+
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| over_clause
+  {
+    $$ = $1
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  window_name_opt window_partition_clause_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+  }
+
+window_name_opt:
+  /* empty */ %prec EMPTY_WINDOW_NAME
+  {
+    $$ = ColIdent{}
+  }
+| sql_id
+  {
+    $$ = $1
+  }
+
+window_partition_clause_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = FrameRowsType
+  }
+| RANGE
+  {
+    $$ = FrameRangeType
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+// MySQL only accepts nonnegative numbers, placeholders and intervals as frame boundaries.
+frame_expression:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| FLOAT
+  {
+    $$ = NewFloatLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -4966,6 +5263,7 @@ reserved_keyword:
 | PARTITION
 | PERCENT_RANK
 | PRIMARY
+| RANGE
 | RANK
 | READ
 | RECURSIVE
@@ -5055,6 +5353,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -5214,6 +5513,8 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | ROW_FORMAT
 | S3
 | SECONDARY
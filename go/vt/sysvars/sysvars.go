@@ -47,6 +47,9 @@ var (
 	Charset                     = SystemVariable{Name: "charset", Default: utf8, IdentifierAsString: true}
 	ClientFoundRows             = SystemVariable{Name: "client_found_rows", IsBoolean: true, Default: off}
 	SessionEnableSystemSettings = SystemVariable{Name: "enable_system_settings", IsBoolean: true, Default: on}
+	SessionEnableConsolidator   = SystemVariable{Name: "enable_consolidator", IsBoolean: true, Default: on}
+	SessionEnableTxRetry        = SystemVariable{Name: "enable_transaction_retry", IsBoolean: true, Default: off}
+	SessionReadWriteSplit       = SystemVariable{Name: "read_write_split", IsBoolean: true, Default: off}
 	Names                       = SystemVariable{Name: "names", Default: utf8, IdentifierAsString: true}
 	SessionUUID                 = SystemVariable{Name: "session_uuid", IdentifierAsString: true}
 	SkipQueryPlanCache          = SystemVariable{Name: "skip_query_plan_cache", IsBoolean: true, Default: off}
@@ -81,6 +84,9 @@ var (
 		Names,
 		SessionUUID,
 		SessionEnableSystemSettings,
+		SessionEnableConsolidator,
+		SessionEnableTxRetry,
+		SessionReadWriteSplit,
 		ReadAfterWriteGTID,
 		ReadAfterWriteTimeOut,
 		SessionTrackGTIDs,
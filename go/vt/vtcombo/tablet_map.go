@@ -445,9 +445,9 @@ func (itc *internalTabletConn) Begin(ctx context.Context, target *querypb.Target
 }
 
 // Commit is part of queryservice.QueryService
-func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
-	rID, err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
-	return rID, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, string, error) {
+	rID, sessionStateChanges, err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
+	return rID, sessionStateChanges, tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
 // Rollback is part of queryservice.QueryService
@@ -695,6 +695,22 @@ func (itmc *internalTabletManagerClient) ReloadSchema(ctx context.Context, table
 	return t.tm.ReloadSchema(ctx, waitPosition)
 }
 
+func (itmc *internalTabletManagerClient) ListQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryQuarantine, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.ListQueryQuarantines(ctx)
+}
+
+func (itmc *internalTabletManagerClient) ClearQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet, fingerprint string) (int, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return 0, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.ClearQueryQuarantines(ctx, fingerprint)
+}
+
 func (itmc *internalTabletManagerClient) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -787,7 +803,7 @@ func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *top
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -21,10 +21,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -35,8 +38,8 @@ func init() {
 	addCommand("Shards", command{
 		"ListBackups",
 		commandListBackups,
-		"<keyspace/shard>",
-		"Lists all the backups for a shard."})
+		"[-show_parents] <keyspace/shard>",
+		"Lists all the backups for a shard. With -show_parents, also prints the parent of the incremental backups."})
 	addCommand("Shards", command{
 		"BackupShard",
 		commandBackupShard,
@@ -46,7 +49,7 @@ func init() {
 		"RemoveBackup",
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
-		"Removes a backup for the BackupStorage."})
+		"Removes a backup for the BackupStorage, and the archived binary logs that are older than the remaining backups. A backup that is the parent of incremental backups can't be removed."})
 
 	addCommand("Tablets", command{
 		"Backup",
@@ -56,8 +59,8 @@ func init() {
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-restore_to_pos=<position>] [-restore_to_timestamp=<time>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -restore_to_pos or -restore_to_timestamp, restores the latest backup before it and replays the archived binary logs up to it, then leaves the tablet DRAINED without replication."})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -170,6 +173,7 @@ func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.T
 }
 
 func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	showParents := subFlags.Bool("show_parents", false, "Also prints the backup each incremental backup is taken on top of")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -193,7 +197,19 @@ func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return err
 	}
 	for _, bh := range bhs {
-		wr.Logger().Printf("%v\n", bh.Name())
+		if !*showParents {
+			wr.Logger().Printf("%v\n", bh.Name())
+			continue
+		}
+		parent, err := mysqlctl.GetParentBackup(ctx, bh)
+		switch {
+		case err != nil:
+			wr.Logger().Printf("%v (incomplete)\n", bh.Name())
+		case parent != "":
+			wr.Logger().Printf("%v (incremental to %v)\n", bh.Name(), parent)
+		default:
+			wr.Logger().Printf("%v\n", bh.Name())
+		}
 	}
 	return nil
 }
@@ -218,16 +234,37 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		return err
 	}
 	defer bs.Close()
-	return bs.RemoveBackup(ctx, bucket, name)
+	if err := mysqlctl.RemoveBackup(ctx, bs, bucket, name); err != nil {
+		return err
+	}
+	_, err = mysqlctl.PruneBinlogArchive(ctx, bs, keyspace, shard, wr.Logger())
+	return err
 }
 
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToPos := subFlags.String("restore_to_pos", "", "Replays the archived binary logs up to this GTID position after restoring, e.g. MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-100")
+	restoreToTimestamp := subFlags.String("restore_to_timestamp", "", "Replays the archived binary logs up to this time (in RFC 3339 format, e.g. 2021-06-01T12:00:00Z) after restoring")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the RestoreFromBackup command requires the <tablet alias> argument")
 	}
+	if *restoreToPos != "" && *restoreToTimestamp != "" {
+		return fmt.Errorf("only one of -restore_to_pos and -restore_to_timestamp can be set")
+	}
+	if *restoreToPos != "" {
+		if _, err := mysql.DecodePosition(*restoreToPos); err != nil {
+			return fmt.Errorf("invalid -restore_to_pos %v: %v", *restoreToPos, err)
+		}
+	}
+	var restoreTime time.Time
+	if *restoreToTimestamp != "" {
+		var err error
+		if restoreTime, err = time.Parse(time.RFC3339, *restoreToTimestamp); err != nil {
+			return fmt.Errorf("invalid -restore_to_timestamp %v: %v", *restoreToTimestamp, err)
+		}
+	}
 
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
@@ -237,7 +274,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, *restoreToPos, restoreTime)
 	if err != nil {
 		return err
 	}
@@ -332,7 +332,7 @@ func commandVtTabletCommit(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	defer conn.Close(ctx)
 
 	// we do not support reserving through vtctl commands
-	_, err = conn.Commit(ctx, &querypb.Target{
+	_, _, err = conn.Commit(ctx, &querypb.Target{
 		Keyspace:   tabletInfo.Tablet.Keyspace,
 		Shard:      tabletInfo.Tablet.Shard,
 		TabletType: tabletInfo.Tablet.Type,
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+// This file contains the commands to inspect and resolve the distributed
+// transactions of the atomic (2PC) transaction mode.
+
+const twoPCGroupName = "Distributed Transactions"
+
+func init() {
+	addCommandGroup(twoPCGroupName)
+
+	addCommand(twoPCGroupName, command{
+		"DistributedTransaction",
+		commandDistributedTransaction,
+		"[-json] <list [<keyspace>] | read <dtid> | conclude <dtid> | force-resolve <dtid> commit|rollback>",
+		"Inspects and resolves the unresolved distributed transactions.\n" +
+			"list: lists the distributed transactions whose metadata is managed by the shards of the keyspace, or of all keyspaces.\n" +
+			"read: shows a distributed transaction, with the redo logs of its participants.\n" +
+			"conclude: resolves a distributed transaction the way vtgate does, rolling it back if it was not committed.\n" +
+			"force-resolve: commits or rolls back the prepared transactions of the participants regardless of the recorded decision, and deletes the metadata of the distributed transaction."})
+}
+
+func commandDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	jsonOutput := subFlags.Bool("json", false, "Output the transactions in JSON")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 1 {
+		return fmt.Errorf("the <action> argument is required for the DistributedTransaction command")
+	}
+
+	action, args := subFlags.Arg(0), subFlags.Args()[1:]
+	switch action {
+	case "list":
+		if len(args) > 1 {
+			return fmt.Errorf("the list action accepts only <keyspace> as optional positional parameter")
+		}
+		var keyspace string
+		if len(args) == 1 {
+			keyspace = args[0]
+		}
+		transactions, err := wr.ListDistributedTransactions(ctx, keyspace)
+		if err != nil {
+			return err
+		}
+		if *jsonOutput {
+			return printJSON(wr.Logger(), transactions)
+		}
+		printDistributedTransactions(wr, transactions)
+		return nil
+	case "read":
+		if len(args) != 1 {
+			return fmt.Errorf("the <dtid> argument is required for the read action")
+		}
+		transaction, err := wr.ReadDistributedTransaction(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		if *jsonOutput {
+			return printJSON(wr.Logger(), transaction)
+		}
+		printDistributedTransactions(wr, []*wrangler.DistributedTransaction{transaction})
+		for _, p := range transaction.Participants {
+			for _, stmt := range p.Statements {
+				wr.Logger().Printf("%s/%s: %s\n", p.Keyspace, p.Shard, stmt)
+			}
+		}
+		return nil
+	case "conclude":
+		if len(args) != 1 {
+			return fmt.Errorf("the <dtid> argument is required for the conclude action")
+		}
+		return wr.ConcludeDistributedTransaction(ctx, args[0])
+	case "force-resolve":
+		if len(args) != 2 || (args[1] != "commit" && args[1] != "rollback") {
+			return fmt.Errorf("the <dtid> and commit|rollback arguments are required for the force-resolve action")
+		}
+		return wr.ForceResolveDistributedTransaction(ctx, args[0], args[1] == "commit")
+	}
+	return fmt.Errorf("unknown action %q for the DistributedTransaction command", action)
+}
+
+func printDistributedTransactions(wr *wrangler.Wrangler, transactions []*wrangler.DistributedTransaction) {
+	if len(transactions) == 0 {
+		wr.Logger().Printf("There are no unresolved distributed transactions.\n")
+		return
+	}
+	table := tablewriter.NewWriter(loggerWriter{wr.Logger()})
+	table.SetAutoFormatHeaders(false)
+	table.SetHeader([]string{"Dtid", "State", "Created", "Participants"})
+	for _, t := range transactions {
+		var participants []string
+		for _, p := range t.Participants {
+			participant := p.Keyspace + "/" + p.Shard
+			if p.RedoState != "" {
+				participant += " (" + p.RedoState + ")"
+			}
+			participants = append(participants, participant)
+		}
+		var created string
+		if !t.TimeCreated.IsZero() {
+			created = t.TimeCreated.UTC().Format("2006-01-02 15:04:05")
+		}
+		table.Append([]string{t.Dtid, t.State, created, strings.Join(participants, ", ")})
+	}
+	table.Render()
+}
@@ -116,8 +116,10 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -200,6 +202,12 @@ var commands = []commandGroup{
 			{"IgnoreHealthError", commandIgnoreHealthError,
 				"<tablet alias> <ignore regexp>",
 				"Sets the regexp for health check errors to ignore on the specified tablet. The pattern has implicit ^$ anchors. Set to empty string or restart vttablet to stop ignoring anything."},
+			{"ListQueryQuarantines", commandListQueryQuarantines,
+				"<tablet alias>",
+				"Lists the queries quarantined on the specified tablet because they repeatedly timed out or ran too long."},
+			{"ClearQueryQuarantines", commandClearQueryQuarantines,
+				"<tablet alias> [<query fingerprint>]",
+				"Releases the quarantined query with the given fingerprint on the specified tablet, or all its quarantined queries if no fingerprint is given."},
 			{"Sleep", commandSleep,
 				"<tablet alias> <duration>",
 				"Blocks the action queue on the specified tablet for the specified amount of time. This is typically used for testing."},
@@ -296,6 +304,9 @@ var commands = []commandGroup{
 			{"SetKeyspaceServedFrom", commandSetKeyspaceServedFrom,
 				"[-source=<source keyspace name>] [-remove] [-cells=c1,c2,...] <keyspace name> <tablet type>",
 				"Changes the ServedFromMap manually. This command is intended for emergency fixes. This field is automatically set when you call the *MigrateServedFrom* command. This command does not rebuild the serving graph."},
+			{"UpdateThrottlerMetric", commandUpdateThrottlerMetric,
+				"[-query=<query>] [-threshold=<value>] [-remove] <keyspace name> <metric name>",
+				"Adds, updates or removes a metric checked by the tablet throttlers of the keyspace, in addition to the replication lag. The builtin threads_running and history_list_length metrics, and the lag metric, need no query. Other metrics need a SELECT query returning a single value, or a SHOW GLOBAL ... LIKE ... query. The shard metrics are read by the throttler user on the replicas, which may need more grants for custom queries."},
 			{"RebuildKeyspaceGraph", commandRebuildKeyspaceGraph,
 				"[-cells=c1,c2,...] [-allow_partial] <keyspace> ...",
 				"Rebuilds the serving data for the keyspace. This command may trigger an update to all connected clients."},
@@ -330,8 +341,11 @@ var commands = []commandGroup{
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				"Perform a diff of all tables in the workflow"},
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-chunks=<n>] [-parallel=<n>] [-previous_report_file=<file>] [-repair=print|apply] [-max_repairs=10000] <keyspace.workflow>",
+				"Perform a diff of all tables in the workflow. With -chunks, -previous_report_file or -repair, each table is split into ranges of its first primary key column that are compared in parallel, first by checksum and then row by row if the checksums differ."},
+			{"TabletVDiff", commandTabletVDiff,
+				"[-source_cell=<cell>] [-tablet_types=<types>] [-tables=<tables>] [-chunk_duration=5m] [-filtered_replication_wait_time=30s] [-only_pks] [-format=json] <keyspace.workflow> start | stop <uuid> | resume <uuid> | show [<uuid>] | delete <uuid>",
+				"Start, stop, resume, show or delete a diff of the tables of the workflow that runs on the target primaries, and saves its progress so that it can resume after restarts."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -1012,6 +1026,51 @@ func commandIgnoreHealthError(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.TabletManagerClient().IgnoreHealthError(ctx, tabletInfo.Tablet, pattern)
 }
 
+func commandListQueryQuarantines(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the ListQueryQuarantines command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	quarantines, err := wr.TabletManagerClient().ListQueryQuarantines(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), &tabletmanagerdatapb.ListQueryQuarantinesResponse{Quarantines: quarantines})
+}
+
+func commandClearQueryQuarantines(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 && subFlags.NArg() != 2 {
+		return fmt.Errorf("the <tablet alias> argument is required for the ClearQueryQuarantines command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	cleared, err := wr.TabletManagerClient().ClearQueryQuarantines(ctx, tabletInfo.Tablet, subFlags.Arg(1))
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("Released %v quarantined queries\n", cleared)
+	return nil
+}
+
 func commandWaitForDrain(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "Specifies a comma-separated list of cells to look for tablets")
@@ -1791,6 +1850,19 @@ func commandSetKeyspaceServedFrom(ctx context.Context, wr *wrangler.Wrangler, su
 	return wr.SetKeyspaceServedFrom(ctx, keyspace, servedType, cells, *source, *remove)
 }
 
+func commandUpdateThrottlerMetric(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	query := subFlags.String("query", "", "Specifies the query returning the value of the metric")
+	threshold := subFlags.Float64("threshold", 0, "Specifies the value of the metric above which the throttler denies the checks")
+	remove := subFlags.Bool("remove", false, "Indicates whether to add or update (default) or remove the metric")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <metric name> arguments are required for the UpdateThrottlerMetric command")
+	}
+	return wr.UpdateThrottlerMetric(ctx, subFlags.Arg(0), subFlags.Arg(1), *query, *threshold, *remove)
+}
+
 func commandRebuildKeyspaceGraph(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cells := subFlags.String("cells", "", "Specifies a comma-separated list of cells to update")
 	allowPartial := subFlags.Bool("allow_partial", false, "Specifies whether a SNAPSHOT keyspace is allowed to serve with an incomplete set of shards. Ignored for all other types of keyspaces")
@@ -2350,6 +2422,11 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	onlyPks := subFlags.Bool("only_pks", false, "When reporting missing rows, only show primary keys in the report.")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
+	chunks := subFlags.Int("chunks", 0, "Split each table into this many ranges of its first primary key column, which must be an integer")
+	parallel := subFlags.Int("parallel", 4, "How many ranges to compare at the same time, with -chunks")
+	previousReportFile := subFlags.String("previous_report_file", "", "The json report of an earlier run with -chunks. Its ranges are reused, and the ones that did not change since are not compared again")
+	repair := subFlags.String("repair", "", "Fix the rows that differ on the target: 'print' reports the statements that fix them, 'apply' executes them while the workflow is stopped")
+	maxRepairs := subFlags.Int("max_repairs", 10000, "The maximum number of rows of a table that -repair can fix")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2361,6 +2438,44 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	if err != nil {
 		return err
 	}
+	if *chunks > 0 || *previousReportFile != "" || *repair != "" {
+		params := &wrangler.ChunkedVDiffParams{
+			SourceCell:                  *sourceCell,
+			TargetCell:                  *targetCell,
+			TabletTypes:                 *tabletTypes,
+			FilteredReplicationWaitTime: *filteredReplicationWaitTime,
+			Tables:                      *tables,
+			Format:                      *format,
+			Debug:                       *debugQuery,
+			OnlyPks:                     *onlyPks,
+			Chunks:                      *chunks,
+			Parallelism:                 *parallel,
+			Repair:                      *repair,
+			MaxRepairs:                  *maxRepairs,
+		}
+		if params.Chunks == 0 {
+			params.Chunks = 1
+		}
+		if *previousReportFile != "" {
+			data, err := ioutil.ReadFile(*previousReportFile)
+			if err != nil {
+				return err
+			}
+			var previous map[string]*struct{ Chunks []*wrangler.DiffChunk }
+			if err := json.Unmarshal(data, &previous); err != nil {
+				return fmt.Errorf("cannot parse the previous report: %v", err)
+			}
+			params.PreviousChunks = make(map[string][]*wrangler.DiffChunk)
+			for table, report := range previous {
+				params.PreviousChunks[table] = report.Chunks
+			}
+		}
+		_, err = wr.VDiffChunked(ctx, keyspace, workflow, params)
+		if err != nil && strings.Contains(err.Error(), "context deadline exceeded") {
+			return fmt.Errorf("vdiff timed out: you may want to increase it with the flag -filtered_replication_wait_time=<timeoutSeconds>")
+		}
+		return err
+	}
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
@@ -2375,6 +2490,113 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return err
 }
 
+func commandTabletVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	sourceCell := subFlags.String("source_cell", "", "The source cell to compare from, defaults to the cell of the workflow")
+	tabletTypes := subFlags.String("tablet_types", "", "Tablet types of the source, defaults to the tablet types of the workflow")
+	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
+	chunkDuration := subFlags.Duration("chunk_duration", 0, "How long each chunk of a table is compared before the workflow is resumed, defaults to the -vdiff_chunk_duration of the tablets")
+	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 0, "How long to wait for the workflow and the source tablets to catch up, defaults to the -vdiff_filtered_replication_wait_time of the tablets")
+	onlyPks := subFlags.Bool("only_pks", false, "When reporting missing rows, only show primary keys in the report.")
+	format := subFlags.String("format", "", "Format of report") //"json" or ""
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("<keyspace.workflow> and the action are required")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	action := strings.ToLower(subFlags.Arg(1))
+	var vdiffUUID string
+	switch action {
+	case "start":
+		if subFlags.NArg() != 2 {
+			return fmt.Errorf("start does not take a uuid")
+		}
+	case "show":
+		if subFlags.NArg() > 3 {
+			return fmt.Errorf("show takes at most one uuid")
+		}
+		vdiffUUID = subFlags.Arg(2)
+	case "stop", "resume", "delete":
+		if subFlags.NArg() != 3 {
+			return fmt.Errorf("%s requires the uuid of the vdiff", action)
+		}
+		vdiffUUID = subFlags.Arg(2)
+	default:
+		return fmt.Errorf("invalid action %s: must be one of start, stop, resume, show or delete", action)
+	}
+
+	switch action {
+	case "start":
+		options := &vreplication.VDiffOptions{
+			SourceCell:                  *sourceCell,
+			TabletTypes:                 *tabletTypes,
+			ChunkDuration:               *chunkDuration,
+			FilteredReplicationWaitTime: *filteredReplicationWaitTime,
+			OnlyPKs:                     *onlyPks,
+		}
+		if *tables != "" {
+			options.Tables = strings.Split(*tables, ",")
+		}
+		vdiffUUID, err := wr.StartTabletVDiff(ctx, keyspace, workflow, options)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("VDiff %s started on the target shards of %s.%s\n", vdiffUUID, keyspace, workflow)
+		return nil
+	case "stop":
+		return wr.StopTabletVDiff(ctx, keyspace, workflow, vdiffUUID)
+	case "resume":
+		return wr.ResumeTabletVDiff(ctx, keyspace, workflow, vdiffUUID)
+	case "delete":
+		return wr.DeleteTabletVDiff(ctx, keyspace, workflow, vdiffUUID)
+	}
+
+	reports, err := wr.ShowTabletVDiff(ctx, keyspace, workflow, vdiffUUID)
+	if err != nil {
+		return err
+	}
+	if vdiffUUID != "" && len(reports) == 0 {
+		return fmt.Errorf("vdiff %s not found in %s.%s", vdiffUUID, keyspace, workflow)
+	}
+	if *format == "json" {
+		return printJSON(wr.Logger(), reports)
+	}
+	for _, report := range reports {
+		wr.Logger().Printf("VDiff %s: %s\n", report.UUID, report.State)
+		var shards, tables []string
+		for shard := range report.Shards {
+			shards = append(shards, shard)
+		}
+		sort.Strings(shards)
+		for table := range report.Tables {
+			tables = append(tables, table)
+		}
+		sort.Strings(tables)
+		for _, shard := range shards {
+			shardReport := report.Shards[shard]
+			wr.Logger().Printf("\tShard %s: %s, created at %s\n", shard, shardReport.State, shardReport.CreatedAt)
+			if shardReport.LastError != "" {
+				wr.Logger().Printf("\t\tLastError: %s\n", shardReport.LastError)
+			}
+		}
+		for _, table := range tables {
+			tr := report.Tables[table]
+			wr.Logger().Printf("\tSummary for table %v:\n", table)
+			wr.Logger().Printf("\t\tProcessedRows: %v\n", tr.ProcessedRows)
+			wr.Logger().Printf("\t\tMatchingRows: %v\n", tr.MatchingRows)
+			wr.Logger().Printf("\t\tMismatchedRows: %v\n", tr.MismatchedRows)
+			wr.Logger().Printf("\t\tExtraRowsSource: %v\n", tr.ExtraRowsSource)
+			wr.Logger().Printf("\t\tExtraRowsTarget: %v\n", tr.ExtraRowsTarget)
+		}
+	}
+	return nil
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
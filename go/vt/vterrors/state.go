@@ -39,6 +39,7 @@ const (
 	WrongTypeForVar
 	WrongValueForVar
 	LockOrActiveTransaction
+	ViewWrongList
 
 	// failed precondition
 	NoDB
@@ -79,7 +79,7 @@ func TestDiscoveryGatewayBegin(t *testing.T) {
 
 func TestDiscoveryGatewayCommit(t *testing.T) {
 	testDiscoveryGatewayTransact(t, func(dg *DiscoveryGateway, target *querypb.Target) error {
-		_, err := dg.Commit(context.Background(), target, 1)
+		_, _, err := dg.Commit(context.Background(), target, 1)
 		return err
 	})
 }
@@ -33,10 +33,12 @@ func (cached *AggregateParams) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(64)
 	}
 	// field Alias string
 	size += int64(len(cached.Alias))
+	// field Separator string
+	size += int64(len(cached.Separator))
 	return size
 }
 func (cached *AlterVSchema) CachedSize(alloc bool) int64 {
@@ -169,6 +171,24 @@ func (cached *Distinct) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Filter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Predicate vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Predicate.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Generate) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -185,6 +205,36 @@ func (cached *Generate) CachedSize(alloc bool) int64 {
 	size += cached.Values.CachedSize(false)
 	return size
 }
+func (cached *HashJoin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Cols []int
+	{
+		size += int64(cap(cached.Cols)) * int64(8)
+	}
+	// field LHSKeys []int
+	{
+		size += int64(cap(cached.LHSKeys)) * int64(8)
+	}
+	// field RHSKeys []int
+	{
+		size += int64(cap(cached.RHSKeys)) * int64(8)
+	}
+	return size
+}
 func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -390,7 +440,7 @@ func (cached *OrderedAggregate) CachedSize(alloc bool) int64 {
 	}
 	// field Aggregates []vitess.io/vitess/go/vt/vtgate/engine.AggregateParams
 	{
-		size += int64(cap(cached.Aggregates)) * int64(32)
+		size += int64(cap(cached.Aggregates)) * int64(64)
 		for _, elem := range cached.Aggregates {
 			size += elem.CachedSize(false)
 		}
@@ -411,7 +461,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(120)
+		size += int64(160)
 	}
 	// field Original string
 	size += int64(len(cached.Original))
@@ -428,6 +478,13 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field ResultCacheTables []string
+	{
+		size += int64(cap(cached.ResultCacheTables)) * int64(16)
+		for _, elem := range cached.ResultCacheTables {
+			size += int64(len(elem))
+		}
+	}
 	return size
 }
 func (cached *Projection) CachedSize(alloc bool) int64 {
@@ -792,7 +849,7 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(152)
+		size += int64(160)
 	}
 	// field DML vitess.io/vitess/go/vt/vtgate/engine.DML
 	size += cached.DML.CachedSize(false)
@@ -916,6 +973,48 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 	return size
 }
 
+func (cached *Window) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(88)
+	}
+	// field PartitionBy []int
+	{
+		size += int64(cap(cached.PartitionBy)) * int64(8)
+	}
+	// field OrderBy []int
+	{
+		size += int64(cap(cached.OrderBy)) * int64(8)
+	}
+	// field Functions []vitess.io/vitess/go/vt/vtgate/engine.WindowFunction
+	{
+		size += int64(cap(cached.Functions)) * int64(32)
+		for _, elem := range cached.Functions {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *WindowFunction) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Alias string
+	size += int64(len(cached.Alias))
+	return size
+}
+
 //go:nocheckptr
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
 	if cached == nil {
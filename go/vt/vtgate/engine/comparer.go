@@ -17,7 +17,9 @@ limitations under the License.
 package engine
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
@@ -25,6 +27,7 @@ import (
 type comparer struct {
 	orderBy, weightString, starColFixedIndex int
 	desc                                     bool
+	collationID                              collations.ID
 }
 
 // compare compares two rows given the comparer and returns which one should be earlier in the result set
@@ -38,7 +41,7 @@ func (c *comparer) compare(r1, r2 []sqltypes.Value) (int, error) {
 	} else {
 		colIndex = c.orderBy
 	}
-	cmp, err := evalengine.NullsafeCompare(r1[colIndex], r2[colIndex])
+	cmp, err := evalengine.NullsafeCompare(r1[colIndex], r2[colIndex], c.collationID)
 	if err != nil {
 		_, isComparisonErr := err.(evalengine.UnsupportedComparisonError)
 		if !(isComparisonErr && c.weightString != -1) {
@@ -47,7 +50,7 @@ func (c *comparer) compare(r1, r2 []sqltypes.Value) (int, error) {
 		// in case of a comparison error switch to using the weight string column for ordering
 		c.orderBy = c.weightString
 		c.weightString = -1
-		cmp, err = evalengine.NullsafeCompare(r1[c.orderBy], r2[c.orderBy])
+		cmp, err = evalengine.NullsafeCompare(r1[c.orderBy], r2[c.orderBy], collations.Unknown)
 		if err != nil {
 			return 0, err
 		}
@@ -59,16 +62,45 @@ func (c *comparer) compare(r1, r2 []sqltypes.Value) (int, error) {
 	return cmp, nil
 }
 
-// extractSlices extracts the three fields of OrderbyParams into a slice of comparers
-func extractSlices(input []OrderbyParams) []*comparer {
+// extractSlices extracts the three fields of OrderbyParams into a slice of comparers.
+// The collation of each ordered column is taken from fields, if they are known.
+func extractSlices(input []OrderbyParams, fields []*querypb.Field) []*comparer {
+	colls := newColumnCollations(fields)
 	var result []*comparer
 	for _, order := range input {
+		colIndex := order.Col
+		if order.StarColFixedIndex > order.Col && order.StarColFixedIndex < len(fields) {
+			colIndex = order.StarColFixedIndex
+		}
 		result = append(result, &comparer{
 			orderBy:           order.Col,
 			weightString:      order.WeightStringCol,
 			desc:              order.Desc,
 			starColFixedIndex: order.StarColFixedIndex,
+			collationID:       colls.get(colIndex),
 		})
 	}
 	return result
 }
+
+// columnCollations holds the collation of every column in a result.
+// MySQL reports the collation of a column as the character set of
+// its definition, which ends up in querypb.Field.Charset.
+type columnCollations []collations.ID
+
+func newColumnCollations(fields []*querypb.Field) columnCollations {
+	colls := make(columnCollations, len(fields))
+	for i, field := range fields {
+		colls[i] = collations.ID(field.Charset)
+	}
+	return colls
+}
+
+// get returns the collation of the given column, or collations.Unknown
+// if the fields were not available
+func (colls columnCollations) get(col int) collations.ID {
+	if col < 0 || col >= len(colls) {
+		return collations.Unknown
+	}
+	return colls[col]
+}
@@ -33,14 +33,15 @@ type Distinct struct {
 type row = []sqltypes.Value
 
 type probeTable struct {
-	m map[int64][]row
+	m     map[int64][]row
+	colls columnCollations
 }
 
 func (pt *probeTable) exists(inputRow row) (bool, error) {
 	// calculate hashcode from all column values in the input row
 	code := int64(17)
-	for _, value := range inputRow {
-		hashcode, err := evalengine.NullsafeHashcode(value)
+	for i, value := range inputRow {
+		hashcode, err := evalengine.NullsafeHashcode(value, pt.colls.get(i))
 		if err != nil {
 			return false, err
 		}
@@ -57,7 +58,7 @@ func (pt *probeTable) exists(inputRow row) (bool, error) {
 	// we found something in the map - still need to check all individual values
 	// so we don't just fall for a hash collision
 	for _, existingRow := range existingRows {
-		exists, err := equal(existingRow, inputRow)
+		exists, err := pt.equal(existingRow, inputRow)
 		if err != nil {
 			return false, err
 		}
@@ -71,9 +72,9 @@ func (pt *probeTable) exists(inputRow row) (bool, error) {
 	return false, nil
 }
 
-func equal(a, b []sqltypes.Value) (bool, error) {
+func (pt *probeTable) equal(a, b []sqltypes.Value) (bool, error) {
 	for i, aVal := range a {
-		cmp, err := evalengine.NullsafeCompare(aVal, b[i])
+		cmp, err := evalengine.NullsafeCompare(aVal, b[i], pt.colls.get(i))
 		if err != nil {
 			return false, err
 		}
@@ -84,8 +85,11 @@ func equal(a, b []sqltypes.Value) (bool, error) {
 	return true, nil
 }
 
-func newProbeTable() *probeTable {
-	return &probeTable{m: map[int64][]row{}}
+func newProbeTable(fields []*querypb.Field) *probeTable {
+	return &probeTable{
+		m:     map[int64][]row{},
+		colls: newColumnCollations(fields),
+	}
 }
 
 // Execute implements the Primitive interface
@@ -100,7 +104,7 @@ func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		InsertID: input.InsertID,
 	}
 
-	pt := newProbeTable()
+	pt := newProbeTable(input.Fields)
 
 	for _, row := range input.Rows {
 		exists, err := pt.exists(row)
@@ -117,9 +121,12 @@ func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 
 // StreamExecute implements the Primitive interface
 func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
-	pt := newProbeTable()
+	pt := newProbeTable(nil)
 
 	err := d.Source.StreamExecute(vcursor, bindVars, wantfields, func(input *sqltypes.Result) error {
+		if len(input.Fields) != 0 {
+			pt.colls = newColumnCollations(input.Fields)
+		}
 		result := &sqltypes.Result{
 			Fields:   input.Fields,
 			InsertID: input.InsertID,
@@ -25,9 +25,20 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
+// collated sets the collation with the given name on all the fields
+func collated(fields []*querypb.Field, name string) []*querypb.Field {
+	collationID := collations.LookupByName(name).ID()
+	for _, field := range fields {
+		field.Charset = uint32(collationID)
+	}
+	return fields
+}
+
 func TestDistinct(t *testing.T) {
 	type testCase struct {
 		testName       string
@@ -60,6 +71,14 @@ func TestDistinct(t *testing.T) {
 		testName:      "varchar columns",
 		inputs:        r("myid", "varchar", "monkey", "horse"),
 		expectedError: "types does not support hashcode yet: VARCHAR",
+	}, {
+		testName:       "varchar columns with collation",
+		inputs:         sqltypes.MakeTestResult(collated(sqltypes.MakeTestFields("a|b", "varchar|varchar"), "utf8mb4_general_ci"), "monkey|x", "MONKEY|X", "Mönkey|x", "horse|x"),
+		expectedResult: r("a|b", "varchar|varchar", "monkey|x", "horse|x"),
+	}, {
+		testName:       "varchar columns with case sensitive collation",
+		inputs:         sqltypes.MakeTestResult(collated(sqltypes.MakeTestFields("a", "varchar"), "utf8mb4_bin"), "monkey", "MONKEY", "monkey"),
+		expectedResult: r("a", "varchar", "monkey", "MONKEY"),
 	}}
 
 	for _, tc := range testCases {
@@ -94,6 +94,18 @@ func (t *noopVCursor) GetSessionEnableSystemSettings() bool {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetSessionEnableConsolidator(enable bool) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetSessionEnableTxRetry(enable bool) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetSessionReadWriteSplit(enable bool) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetReadAfterWriteTimeout(f float64) {
 	panic("implement me")
 }
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that evaluates a predicate on the rows of its
+// input in vtgate, like a WHERE predicate that can't be sent to the
+// tablets after a cross-shard join, and returns the matching rows.
+type Filter struct {
+	Predicate evalengine.Expr
+	Input     Primitive
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. The columns after it are only read by the
+	// predicate. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// Execute satisfies the Primitive interface.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	// The fields give the collations of the text columns to the predicate.
+	result, err := f.Input.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.filter(result, result.Fields, bindVars); err != nil {
+		return nil, err
+	}
+	if !wantfields {
+		result.Fields = nil
+	}
+	return result.Truncate(f.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	return f.Input.StreamExecute(vcursor, bindVars, true, func(result *sqltypes.Result) error {
+		if result.Fields != nil {
+			fields = result.Fields
+		}
+		if err := f.filter(result, fields, bindVars); err != nil {
+			return err
+		}
+		if !wantfields {
+			result.Fields = nil
+		}
+		return callback(result.Truncate(f.TruncateColumnCount))
+	})
+}
+
+// filter removes the rows of result that don't match the predicate.
+func (f *Filter) filter(result *sqltypes.Result, fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) error {
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+		Fields:   fields,
+	}
+	rows := result.Rows[:0]
+	for _, row := range result.Rows {
+		env.Row = row
+		match, err := f.Predicate.Evaluate(env)
+		if err != nil {
+			return err
+		}
+		if match.IsTrue() {
+			rows = append(rows, row)
+		}
+	}
+	result.Rows = rows
+	return nil
+}
+
+// GetFields satisfies the Primitive interface.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result, err := f.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return result.Truncate(f.TruncateColumnCount), nil
+}
+
+// Inputs returns the input to the filter.
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+// NeedsTransaction implements the Primitive interface.
+func (f *Filter) NeedsTransaction() bool {
+	return f.Input.NeedsTransaction()
+}
+
+func (f *Filter) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Predicate": f.Predicate.String(),
+	}
+	if f.TruncateColumnCount > 0 {
+		other["ResultColumns"] = f.TruncateColumnCount
+	}
+	return PrimitiveDescription{
+		OperatorType: "Filter",
+		Other:        other,
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestFilterExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	input := sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|A",
+		"3|b",
+		"4|null",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{input},
+	}
+
+	f := &Filter{
+		Predicate: &evalengine.ComparisonExpr{
+			Op:    &evalengine.EqualOp{},
+			Left:  evalengine.NewColumn(1),
+			Right: evalengine.NewLiteralString([]byte("A")),
+		},
+		Input:               fp,
+		TruncateColumnCount: 1,
+	}
+
+	// The fields don't have a collation, so the default one is used.
+	result, err := f.Execute(nil, nil, true)
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{`Execute  true`})
+	require.Equal(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("col1", "int64"),
+		"1",
+		"2",
+	), result)
+
+	// The collation of the fields is used when they have one.
+	fields = sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	fields[1].Charset = 63
+	fp = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|A",
+			"3|b",
+		)},
+	}
+	f.Input = fp
+	result, err = f.Execute(nil, nil, false)
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{`Execute  true`})
+	require.Nil(t, result.Fields)
+	require.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(2)}}, result.Rows)
+}
+
+func TestFilterStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	fields[1].Charset = 63
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|A",
+			"3|b",
+			"4|A",
+			"5|null",
+		)},
+	}
+
+	f := &Filter{
+		Predicate: &evalengine.ComparisonExpr{
+			Op:    &evalengine.EqualOp{},
+			Left:  evalengine.NewColumn(1),
+			Right: evalengine.NewLiteralString([]byte("A")),
+		},
+		Input: fp,
+	}
+
+	result, err := wrapStreamExecute(f, nil, nil, true)
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{`StreamExecute  true`})
+	require.Equal(t, sqltypes.MakeTestResult(
+		fields,
+		"2|A",
+		"4|A",
+	), result)
+}
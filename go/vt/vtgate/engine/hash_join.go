@@ -0,0 +1,345 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin is a primitive that joins the rows of its inputs on
+// equality predicates. Unlike Join, the RHS is executed only once:
+// its rows are kept in a hash table, which is probed with the rows
+// of the LHS. The planner is expected to put the smaller side on the
+// RHS, which can't have more rows than the max memory rows.
+// The rows are returned in the order of the LHS.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. It has the same meaning as in Join.
+	Cols []int `json:",omitempty"`
+
+	// LHSKeys and RHSKeys are the columns of the LHS and RHS
+	// results that are compared by the join predicates:
+	// the rows are joined if LHSKeys[i] = RHSKeys[i] for every i.
+	LHSKeys []int `json:",omitempty"`
+	RHSKeys []int `json:",omitempty"`
+}
+
+// hashTable stores the rows of the RHS by the hashcode of their keys.
+type hashTable struct {
+	comparisons []keyComparison
+	rows        map[int64][]hashedRow
+}
+
+// hashedRow is a row of the RHS with the values of its keys
+// coerced to the type they're compared as.
+type hashedRow struct {
+	keys []sqltypes.Value
+	row  []sqltypes.Value
+}
+
+// keyComparison is how the values of a pair of keys are compared.
+// Like in MySQL, they're compared as numbers if either of them is a
+// number. Otherwise, text values are compared with the collation of
+// both sides, and other values byte by byte.
+type keyComparison struct {
+	numeric bool
+	coll    collations.ID
+}
+
+// Execute is a Primitive function.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rresult, err := hj.executeRight(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	lresult, err := hj.Left.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	table, err := hj.buildHashTable(lresult.Fields, rresult)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	for _, lrow := range lresult.Rows {
+		rows, err := hj.probe(table, lrow)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return result, nil
+}
+
+// StreamExecute is a Primitive function.
+// The RHS is fully read before streaming the LHS.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	rresult, err := hj.executeRight(vcursor, bindVars)
+	if err != nil {
+		return err
+	}
+	var table *hashTable
+	return hj.Left.StreamExecute(vcursor, bindVars, true, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if len(lresult.Fields) != 0 && table == nil {
+			table, err = hj.buildHashTable(lresult.Fields, rresult)
+			if err != nil {
+				return err
+			}
+			if wantfields {
+				result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+			}
+		}
+		if table == nil && len(lresult.Rows) != 0 {
+			return vterrors.New(vtrpcpb.Code_INTERNAL, "[BUG] hash join: rows received before the fields of the LHS")
+		}
+		for _, lrow := range lresult.Rows {
+			rows, err := hj.probe(table, lrow)
+			if err != nil {
+				return err
+			}
+			result.Rows = append(result.Rows, rows...)
+		}
+		if len(result.Fields) == 0 && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+}
+
+// executeRight executes the RHS, whose rows are kept in memory.
+// The fields of both sides are always requested because the types
+// and the collations of the keys are needed to hash them.
+func (hj *HashJoin) executeRight(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rresult, err := hj.Right.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(rresult.Rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	return rresult, nil
+}
+
+// buildHashTable stores the rows of the RHS in a hash table.
+func (hj *HashJoin) buildHashTable(lfields []*querypb.Field, rresult *sqltypes.Result) (*hashTable, error) {
+	table := &hashTable{
+		comparisons: make([]keyComparison, len(hj.RHSKeys)),
+		rows:        make(map[int64][]hashedRow),
+	}
+	for i, key := range hj.RHSKeys {
+		if hj.LHSKeys[i] >= len(lfields) || key >= len(rresult.Fields) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] hash join: no field for the keys %d and %d", hj.LHSKeys[i], key)
+		}
+		var err error
+		table.comparisons[i], err = newKeyComparison(lfields[hj.LHSKeys[i]], rresult.Fields[key])
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, row := range rresult.Rows {
+		keys, hash, ok, err := table.hashKeys(row, hj.RHSKeys)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// A NULL key can't match any row.
+			continue
+		}
+		table.rows[hash] = append(table.rows[hash], hashedRow{keys: keys, row: row})
+	}
+	return table, nil
+}
+
+// newKeyComparison returns how the values of two keys with the given fields are compared.
+func newKeyComparison(lfield, rfield *querypb.Field) (keyComparison, error) {
+	if sqltypes.IsNumber(lfield.Type) || sqltypes.IsNumber(rfield.Type) {
+		return keyComparison{numeric: true}, nil
+	}
+	if !sqltypes.IsText(lfield.Type) || !sqltypes.IsText(rfield.Type) {
+		return keyComparison{coll: collations.Binary}, nil
+	}
+	lcoll, rcoll := collations.ID(lfield.Charset), collations.ID(rfield.Charset)
+	switch {
+	case lcoll == collations.Binary || rcoll == collations.Binary:
+		return keyComparison{coll: collations.Binary}, nil
+	case lcoll == collations.Unknown:
+		lcoll = rcoll
+	case rcoll != collations.Unknown && rcoll != lcoll:
+		return keyComparison{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot hash join text keys with different collations: %d and %d", lcoll, rcoll)
+	}
+	if lcoll == collations.Unknown {
+		lcoll = evalengine.DefaultCollation
+	}
+	if collations.LookupByID(lcoll) == nil {
+		return keyComparison{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "cannot hash join text keys with the unsupported collation %d", lcoll)
+	}
+	return keyComparison{coll: lcoll}, nil
+}
+
+// coerce converts the value of a key to the type it's compared as.
+func (kc keyComparison) coerce(v sqltypes.Value) (sqltypes.Value, error) {
+	switch {
+	case v.IsNull():
+		return v, nil
+	case kc.numeric:
+		return evalengine.ToNumeric(v)
+	case kc.coll == collations.Binary && !v.IsBinary():
+		return sqltypes.MakeTrusted(sqltypes.VarBinary, v.Raw()), nil
+	}
+	return v, nil
+}
+
+// probe returns the joined rows for a row of the LHS.
+func (hj *HashJoin) probe(table *hashTable, lrow []sqltypes.Value) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	keys, hash, ok, err := table.hashKeys(lrow, hj.LHSKeys)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		for _, rrow := range table.rows[hash] {
+			// Different keys can have the same hashcode.
+			equal, err := table.keysMatch(keys, rrow.keys)
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				out = append(out, joinRows(lrow, rrow.row, hj.Cols))
+			}
+		}
+	}
+	if hj.Opcode == LeftJoin && len(out) == 0 {
+		out = append(out, joinRows(lrow, nil, hj.Cols))
+	}
+	return out, nil
+}
+
+func (table *hashTable) keysMatch(lkeys, rkeys []sqltypes.Value) (bool, error) {
+	for i, cmp := range table.comparisons {
+		res, err := evalengine.NullsafeCompare(lkeys[i], rkeys[i], cmp.coll)
+		if err != nil {
+			return false, err
+		}
+		if res != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// hashKeys returns the values of the given columns of a row coerced to
+// the type they're compared as, and their hashcode.
+// It returns false if one of the values is NULL.
+func (table *hashTable) hashKeys(row []sqltypes.Value, cols []int) ([]sqltypes.Value, int64, bool, error) {
+	keys := make([]sqltypes.Value, len(cols))
+	var hash int64
+	for i, col := range cols {
+		if row[col].IsNull() {
+			return nil, 0, false, nil
+		}
+		key, err := table.comparisons[i].coerce(row[col])
+		if err != nil {
+			return nil, 0, false, err
+		}
+		code, err := evalengine.NullsafeHashcode(key, table.comparisons[i].coll)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		keys[i] = key
+		hash = hash*31 + code
+	}
+	return keys, hash, true, nil
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (hj *HashJoin) NeedsTransaction() bool {
+	return hj.Right.NeedsTransaction() || hj.Left.NeedsTransaction()
+}
+
+func (hj *HashJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":         hj.GetTableName(),
+		"JoinColumnIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(hj.Cols)), ","), "[]"),
+		"LHSKeys":           GenericJoin(hj.LHSKeys, intToString),
+		"RHSKeys":           GenericJoin(hj.RHSKeys, intToString),
+	}
+	return PrimitiveDescription{
+		OperatorType: "HashJoin",
+		Variant:      hj.Opcode.String(),
+		Other:        other,
+	}
+}
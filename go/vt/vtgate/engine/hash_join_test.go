@@ -0,0 +1,417 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+				"3|c|cc",
+				"null|d|dd",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col4|col5|col6",
+					"int64|varchar|varchar",
+				),
+				"1|e|ee",
+				"3|f|ff",
+				"3|g|gg",
+				"null|h|hh",
+			),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	jn := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 1, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	r, err := jn.Execute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+		"1|a|1|e",
+		"3|c|3|f",
+		"3|c|3|g",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = LeftJoin
+	r, err = jn.Execute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+		"1|a|1|e",
+		"2|b|null|null",
+		"3|c|3|f",
+		"3|c|3|g",
+		"null|d|null|null",
+	))
+
+	// Stream
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = wrapStreamExecute(jn, &noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute a: type:INT64 value:"10" true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	expectResult(t, "jn.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4|col5",
+			"int64|varchar|int64|varchar",
+		),
+		"1|a|1|e",
+		"2|b|null|null",
+		"3|c|3|f",
+		"3|c|3|g",
+		"null|d|null|null",
+	))
+}
+
+func TestHashJoinCollation(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|name",
+					"int64|varchar",
+				),
+				"1|Résumé",
+				"2|CV",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				collated(sqltypes.MakeTestFields(
+					"name|id",
+					"varchar|int64",
+				), "utf8mb4_general_ci"),
+				"resume|10",
+				"cv|20",
+				"resumes|30",
+			),
+		},
+	}
+
+	jn := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKeys: []int{1},
+		RHSKeys: []int{0},
+	}
+	r, err := jn.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|id",
+			"int64|int64",
+		),
+		"1|10",
+		"2|20",
+	)
+	want.Fields = nil
+	expectResult(t, "jn.Execute", r, want)
+}
+
+func TestHashJoinMixedTypes(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|int64",
+				),
+				"1|1",
+				"2|2",
+				"3|3",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				collated(sqltypes.MakeTestFields(
+					"col|id",
+					"varchar|int64",
+				), "utf8mb4_general_ci"),
+				"1|10",
+				"2.0|20",
+				"3abc|30",
+				"x|40",
+			),
+		},
+	}
+
+	// Like in MySQL, the keys are compared as numbers.
+	jn := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 2},
+		LHSKeys: []int{1},
+		RHSKeys: []int{0},
+	}
+	r, err := jn.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|id",
+			"int64|int64",
+		),
+		"1|10",
+		"2|20",
+		"3|30",
+	)
+	want.Fields = nil
+	expectResult(t, "jn.Execute", r, want)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = wrapStreamExecute(jn, &noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	expectResult(t, "jn.StreamExecute", r, want)
+}
+
+func TestHashJoinUnsupportedCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col",
+		"varchar",
+	)
+	// utf8mb4_0900_ai_ci isn't supported
+	fields[0].Charset = 255
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "a"),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "a"),
+		},
+	}
+
+	jn := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, 1},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	_, err := jn.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "cannot hash join text keys with the unsupported collation 255")
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	_, err = wrapStreamExecute(jn, &noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "cannot hash join text keys with the unsupported collation 255")
+}
+
+func TestHashJoinMultipleKeys(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"a|b",
+					"int64|int64",
+				),
+				"1|1",
+				"1|2",
+				"2|1",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"b|a|c",
+					"int64|int64|varchar",
+				),
+				"2|1|x",
+				"1|2|y",
+			),
+		},
+	}
+
+	jn := &HashJoin{
+		Opcode:  InnerJoin,
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 3},
+		LHSKeys: []int{0, 1},
+		RHSKeys: []int{1, 0},
+	}
+	r, err := jn.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"a|b|c",
+			"int64|int64|varchar",
+		),
+		"1|2|x",
+		"2|1|y",
+	)
+	want.Fields = nil
+	expectResult(t, "jn.Execute", r, want)
+}
+
+func TestHashJoinExecuteMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	saveIgnore := testIgnoreMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+		testIgnoreMaxMemoryRows = saveIgnore
+	}()
+
+	testCases := []struct {
+		ignoreMaxMemoryRows bool
+		err                 string
+	}{
+		{true, ""},
+		{false, "in-memory row count exceeded allowed limit of 2"},
+	}
+	for _, test := range testCases {
+		leftPrim := &fakePrimitive{
+			results: []*sqltypes.Result{
+				sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"col1",
+						"int64",
+					),
+					"1",
+				),
+			},
+		}
+		rightPrim := &fakePrimitive{
+			results: []*sqltypes.Result{
+				sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"col2",
+						"int64",
+					),
+					"1",
+					"2",
+					"3",
+				),
+			},
+		}
+		jn := &HashJoin{
+			Opcode:  InnerJoin,
+			Left:    leftPrim,
+			Right:   rightPrim,
+			Cols:    []int{-1, 1},
+			LHSKeys: []int{0},
+			RHSKeys: []int{0},
+		}
+		testIgnoreMaxMemoryRows = test.ignoreMaxMemoryRows
+		_, err := jn.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+		if test.err == "" {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, test.err)
+		}
+	}
+}
+
+func TestHashJoinGetFields(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+
+	jn := &HashJoin{
+		Opcode: InnerJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2, 1, 2},
+	}
+	r, err := jn.GetFields(nil, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	expectResult(t, "jn.GetFields", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col3|col4",
+			"int64|varchar|int64|varchar",
+		),
+	))
+}
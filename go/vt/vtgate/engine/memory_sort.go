@@ -77,7 +77,7 @@ func (ms *MemorySort) Execute(vcursor VCursor, bindVars map[string]*querypb.Bind
 	}
 	sh := &sortHeap{
 		rows:      result.Rows,
-		comparers: extractSlices(ms.OrderBy),
+		comparers: extractSlices(ms.OrderBy, result.Fields),
 	}
 	sort.Sort(sh)
 	if sh.err != nil {
@@ -104,11 +104,13 @@ func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 	// You have to reverse the ordering because the highest values
 	// must be dropped once the upper limit is reached.
 	sh := &sortHeap{
-		comparers: extractSlices(ms.OrderBy),
+		comparers: extractSlices(ms.OrderBy, nil),
 		reverse:   true,
 	}
 	err = ms.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
+			// the fields come before any row, so no comparison has been made yet
+			sh.comparers = extractSlices(ms.OrderBy, qr.Fields)
 			if err := cb(&sqltypes.Result{Fields: qr.Fields}); err != nil {
 				return err
 			}
@@ -516,3 +516,46 @@ func TestMemorySortExecuteNoVarChar(t *testing.T) {
 		t.Errorf("StreamExecute err: %v, want %v", err, want)
 	}
 }
+
+func TestMemorySortCollation(t *testing.T) {
+	fields := collated(sqltypes.MakeTestFields(
+		"c1",
+		"varchar",
+	), "utf8mb4_general_ci")
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"b",
+			"Éa",
+			"A",
+			"c",
+			"eb",
+		)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			WeightStringCol: -1,
+			Col:             0,
+		}},
+		Input: fp,
+	}
+
+	want := sqltypes.MakeTestResult(
+		fields,
+		"A",
+		"b",
+		"c",
+		"Éa",
+		"eb",
+	)
+
+	result, err := ms.Execute(nil, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(ms, &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, want.Rows, result.Rows)
+}
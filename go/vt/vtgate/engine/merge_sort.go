@@ -79,7 +79,9 @@ func (ms *MergeSort) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bin
 func (ms *MergeSort) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	ctx, cancel := context.WithCancel(vcursor.Context())
 	defer cancel()
-	gotFields := wantfields
+	// The fields are needed even if the caller doesn't want them,
+	// because they give the collations the rows are compared with.
+	gotFields := true
 	handles := make([]*streamHandle, len(ms.Primitives))
 	for i, input := range ms.Primitives {
 		handles[i] = runOneStream(ctx, vcursor, input, bindVars, gotFields)
@@ -90,14 +92,12 @@ func (ms *MergeSort) StreamExecute(vcursor VCursor, bindVars map[string]*querypb
 		}
 	}
 
-	if wantfields {
-		err := ms.getStreamingFields(handles, callback)
-		if err != nil {
-			return err
-		}
+	fields, err := ms.getStreamingFields(handles, wantfields, callback)
+	if err != nil {
+		return err
 	}
 
-	comparers := extractSlices(ms.OrderBy)
+	comparers := extractSlices(ms.OrderBy, fields)
 	sh := &scatterHeap{
 		rows:      make([]streamRow, 0, len(handles)),
 		comparers: comparers,
@@ -163,7 +163,7 @@ func (ms *MergeSort) StreamExecute(vcursor VCursor, bindVars map[string]*querypb
 		}
 	}
 
-	err := vterrors.Aggregate(errs)
+	err = vterrors.Aggregate(errs)
 	if err != nil && ms.ScatterErrorsAsWarnings && len(errs) < len(handles) {
 		// we got errors, but not all shards failed, so we can hide the error and just warn instead
 		partialSuccessScatterQueries.Add(1)
@@ -174,7 +174,7 @@ func (ms *MergeSort) StreamExecute(vcursor VCursor, bindVars map[string]*querypb
 	return err
 }
 
-func (ms *MergeSort) getStreamingFields(handles []*streamHandle, callback func(*sqltypes.Result) error) error {
+func (ms *MergeSort) getStreamingFields(handles []*streamHandle, wantfields bool, callback func(*sqltypes.Result) error) ([]*querypb.Field, error) {
 	var fields []*querypb.Field
 
 	if ms.ScatterErrorsAsWarnings {
@@ -193,20 +193,23 @@ func (ms *MergeSort) getStreamingFields(handles []*streamHandle, callback func(*
 	if fields == nil {
 		// something went wrong. need to figure out where the error can be
 		if !ms.ScatterErrorsAsWarnings {
-			return handles[0].err
+			return nil, handles[0].err
 		}
 
 		var errs []error
 		for _, handle := range handles {
 			errs = append(errs, handle.err)
 		}
-		return vterrors.Aggregate(errs)
+		return nil, vterrors.Aggregate(errs)
 	}
 
+	if !wantfields {
+		return fields, nil
+	}
 	if err := callback(&sqltypes.Result{Fields: fields}); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return fields, nil
 }
 
 func (ms *MergeSort) description() PrimitiveDescription {
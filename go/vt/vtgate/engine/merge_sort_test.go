@@ -147,6 +147,60 @@ func TestMergeSortWeightString(t *testing.T) {
 	utils.MustMatch(t, wantResults, results)
 }
 
+// TestMergeSortCollation tests that the rows are compared
+// with the collation of the fields.
+func TestMergeSortCollation(t *testing.T) {
+	idColFields := collated(sqltypes.MakeTestFields("id|col", "varchar|varchar"), "utf8mb4_general_ci")
+	shardResults := []*shardResult{{
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"a|1",
+			"C|2",
+		),
+	}, {
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"B|3",
+			"---",
+			"d|4",
+		),
+	}}
+	orderBy := []OrderbyParams{{
+		WeightStringCol: -1,
+		Col:             0,
+	}}
+
+	var results []*sqltypes.Result
+	err := testMergeSort(shardResults, orderBy, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(idColFields,
+		"a|1",
+		"---",
+		"B|3",
+		"---",
+		"C|2",
+		"---",
+		"d|4",
+	)
+	utils.MustMatch(t, wantResults, results)
+
+	// The fields are read to find the collation even if the caller doesn't want them.
+	ms := MergeSort{
+		Primitives: []StreamExecutor{shardResults[0], shardResults[1]},
+		OrderBy:    orderBy,
+	}
+	var rows [][]sqltypes.Value
+	err = ms.StreamExecute(&noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		require.Nil(t, qr.Fields)
+		rows = append(rows, qr.Rows...)
+		return nil
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(idColFields, "a|1", "B|3", "C|2", "d|4").Rows, rows)
+}
+
 // TestMergeSortDescending tests the normal flow of a merge
 // sort where all shards return descending rows.
 func TestMergeSortDescending(t *testing.T) {
@@ -347,6 +401,9 @@ type shardResult struct {
 
 func (sr *shardResult) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	for _, r := range sr.results {
+		if !wantfields && r.Fields != nil {
+			r = &sqltypes.Result{Rows: r.Rows}
+		}
 		if err := callback(r); err != nil {
 			return err
 		}
@@ -17,7 +17,11 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 
 	"google.golang.org/protobuf/proto"
@@ -63,8 +67,19 @@ type OrderedAggregate struct {
 type AggregateParams struct {
 	Opcode AggregateOpcode
 	Col    int
-	// Alias is set only for distinct opcodes.
+	// Alias is set only for distinct opcodes and for the opcodes
+	// whose value is computed from several columns.
 	Alias string `json:",omitempty"`
+	// Separator is the separator used by group_concat.
+	Separator string `json:",omitempty"`
+
+	// CountCol is the input column that contains the count of
+	// the values. It's used by avg, stddev and variance, for
+	// which Col contains the sum of the values.
+	CountCol int `json:",omitempty"`
+	// VarPopCol is the input column that contains the population
+	// variance of the values. It's used by stddev and variance.
+	VarPopCol int `json:",omitempty"`
 }
 
 func (ap AggregateParams) isDistinct() bool {
@@ -72,15 +87,40 @@ func (ap AggregateParams) isDistinct() bool {
 }
 
 func (ap AggregateParams) preProcess() bool {
-	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct || ap.Opcode == AggregateGtid
+	switch ap.Opcode {
+	case AggregateCountDistinct, AggregateSumDistinct, AggregateGtid:
+		return true
+	}
+	return ap.needsCount()
+}
+
+// needsCount returns true if the final value of the aggregate
+// is computed from the sum and the count of the values.
+func (ap AggregateParams) needsCount() bool {
+	switch ap.Opcode {
+	case AggregateAvg, AggregateStddevPop, AggregateStddevSamp, AggregateVarPop, AggregateVarSamp:
+		return true
+	}
+	return false
 }
 
 func (ap AggregateParams) String() string {
+	var args string
+	switch {
+	case ap.Opcode == AggregateAvg:
+		args = fmt.Sprintf("%d, %d", ap.Col, ap.CountCol)
+	case ap.needsCount():
+		args = fmt.Sprintf("%d, %d, %d", ap.Col, ap.CountCol, ap.VarPopCol)
+	case ap.Opcode == AggregateGroupConcat && ap.Separator != ",":
+		args = fmt.Sprintf("%d separator %s", ap.Col, sqltypes.EncodeStringSQL(ap.Separator))
+	default:
+		args = strconv.Itoa(ap.Col)
+	}
 	if ap.Alias != "" {
-		return fmt.Sprintf("%s(%d) AS %s", ap.Opcode.String(), ap.Col, ap.Alias)
+		return fmt.Sprintf("%s(%s) AS %s", ap.Opcode.String(), args, ap.Alias)
 	}
 
-	return fmt.Sprintf("%s(%d)", ap.Opcode.String(), ap.Col)
+	return fmt.Sprintf("%s(%s)", ap.Opcode.String(), args)
 }
 
 // AggregateOpcode is the aggregation Opcode.
@@ -95,27 +135,63 @@ const (
 	AggregateCountDistinct
 	AggregateSumDistinct
 	AggregateGtid
+	AggregateAvg
+	AggregateGroupConcat
+	AggregateBitAnd
+	AggregateBitOr
+	AggregateBitXor
+	AggregateStddevPop
+	AggregateStddevSamp
+	AggregateVarPop
+	AggregateVarSamp
+	AggregateJSONArrayAgg
+	AggregateJSONObjectAgg
 )
 
+// divPrecisionIncrement is the number of digits that MySQL adds to the
+// scale of a decimal when it's divided, like the default value of the
+// div_precision_increment variable.
+const divPrecisionIncrement = 4
+
 var (
 	opcodeType = map[AggregateOpcode]querypb.Type{
 		AggregateCountDistinct: sqltypes.Int64,
 		AggregateSumDistinct:   sqltypes.Decimal,
 		AggregateGtid:          sqltypes.VarChar,
+		AggregateStddevPop:     sqltypes.Float64,
+		AggregateStddevSamp:    sqltypes.Float64,
+		AggregateVarPop:        sqltypes.Float64,
+		AggregateVarSamp:       sqltypes.Float64,
 	}
 	// Some predefined values
-	countZero = sqltypes.MakeTrusted(sqltypes.Int64, []byte("0"))
-	countOne  = sqltypes.MakeTrusted(sqltypes.Int64, []byte("1"))
-	sumZero   = sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0"))
+	countZero  = sqltypes.MakeTrusted(sqltypes.Int64, []byte("0"))
+	countOne   = sqltypes.MakeTrusted(sqltypes.Int64, []byte("1"))
+	sumZero    = sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0"))
+	bitAndZero = sqltypes.MakeTrusted(sqltypes.Uint64, []byte("18446744073709551615"))
+	bitOrZero  = sqltypes.MakeTrusted(sqltypes.Uint64, []byte("0"))
 )
 
 // SupportedAggregates maps the list of supported aggregate
 // functions to their opcodes.
 var SupportedAggregates = map[string]AggregateOpcode{
-	"count": AggregateCount,
-	"sum":   AggregateSum,
-	"min":   AggregateMin,
-	"max":   AggregateMax,
+	"count":          AggregateCount,
+	"sum":            AggregateSum,
+	"min":            AggregateMin,
+	"max":            AggregateMax,
+	"avg":            AggregateAvg,
+	"group_concat":   AggregateGroupConcat,
+	"bit_and":        AggregateBitAnd,
+	"bit_or":         AggregateBitOr,
+	"bit_xor":        AggregateBitXor,
+	"std":            AggregateStddevPop,
+	"stddev":         AggregateStddevPop,
+	"stddev_pop":     AggregateStddevPop,
+	"stddev_samp":    AggregateStddevSamp,
+	"variance":       AggregateVarPop,
+	"var_pop":        AggregateVarPop,
+	"var_samp":       AggregateVarSamp,
+	"json_arrayagg":  AggregateJSONArrayAgg,
+	"json_objectagg": AggregateJSONObjectAgg,
 	// These functions don't exist in mysql, but are used
 	// to display the plan.
 	"count_distinct": AggregateCountDistinct,
@@ -123,11 +199,32 @@ var SupportedAggregates = map[string]AggregateOpcode{
 	"vgtid":          AggregateGtid,
 }
 
+// aggregateNames maps the opcodes to the names used to display them.
+// Unlike SupportedAggregates, it has only one name per opcode.
+var aggregateNames = map[AggregateOpcode]string{
+	AggregateCount:         "count",
+	AggregateSum:           "sum",
+	AggregateMin:           "min",
+	AggregateMax:           "max",
+	AggregateCountDistinct: "count_distinct",
+	AggregateSumDistinct:   "sum_distinct",
+	AggregateGtid:          "vgtid",
+	AggregateAvg:           "avg",
+	AggregateGroupConcat:   "group_concat",
+	AggregateBitAnd:        "bit_and",
+	AggregateBitOr:         "bit_or",
+	AggregateBitXor:        "bit_xor",
+	AggregateStddevPop:     "stddev_pop",
+	AggregateStddevSamp:    "stddev_samp",
+	AggregateVarPop:        "var_pop",
+	AggregateVarSamp:       "var_samp",
+	AggregateJSONArrayAgg:  "json_arrayagg",
+	AggregateJSONObjectAgg: "json_objectagg",
+}
+
 func (code AggregateOpcode) String() string {
-	for k, v := range SupportedAggregates {
-		if v == code {
-			return k
-		}
+	if name, ok := aggregateNames[code]; ok {
+		return name
 	}
 	panic("unreachable")
 }
@@ -172,6 +269,8 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	if err != nil {
 		return nil, err
 	}
+	// the collations must be taken before convertFields replaces the aggregated fields
+	colls := newColumnCollations(result.Fields)
 	out := &sqltypes.Result{
 		Fields: oa.convertFields(result.Fields),
 		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
@@ -181,24 +280,34 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	var curDistinct sqltypes.Value
 	for _, row := range result.Rows {
 		if current == nil {
-			current, curDistinct = oa.convertRow(row)
+			current, curDistinct, err = oa.convertRow(row)
+			if err != nil {
+				return nil, err
+			}
 			continue
 		}
 
-		equal, err := oa.keysEqual(current, row)
+		equal, err := oa.keysEqual(current, row, colls)
 		if err != nil {
 			return nil, err
 		}
 
 		if equal {
-			current, curDistinct, err = oa.merge(result.Fields, current, row, curDistinct)
+			current, curDistinct, err = oa.merge(result.Fields, colls, current, row, curDistinct)
 			if err != nil {
 				return nil, err
 			}
 			continue
 		}
-		out.Rows = append(out.Rows, current)
-		current, curDistinct = oa.convertRow(row)
+		final, err := oa.convertFinal(current)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, final)
+		current, curDistinct, err = oa.convertRow(row)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if len(result.Rows) == 0 && len(oa.Keys) == 0 {
@@ -226,6 +335,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	var current []sqltypes.Value
 	var curDistinct sqltypes.Value
 	var fields []*querypb.Field
+	var colls columnCollations
 
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
@@ -233,6 +343,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 
 	err := oa.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
+			colls = newColumnCollations(qr.Fields)
 			fields = oa.convertFields(qr.Fields)
 			if err := cb(&sqltypes.Result{Fields: fields}); err != nil {
 				return err
@@ -240,27 +351,38 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 		}
 		// This code is similar to the one in Execute.
 		for _, row := range qr.Rows {
+			var err error
 			if current == nil {
-				current, curDistinct = oa.convertRow(row)
+				current, curDistinct, err = oa.convertRow(row)
+				if err != nil {
+					return err
+				}
 				continue
 			}
 
-			equal, err := oa.keysEqual(current, row)
+			equal, err := oa.keysEqual(current, row, colls)
 			if err != nil {
 				return err
 			}
 
 			if equal {
-				current, curDistinct, err = oa.merge(fields, current, row, curDistinct)
+				current, curDistinct, err = oa.merge(fields, colls, current, row, curDistinct)
 				if err != nil {
 					return err
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			final, err := oa.convertFinal(current)
+			if err != nil {
+				return err
+			}
+			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{final}}); err != nil {
+				return err
+			}
+			current, curDistinct, err = oa.convertRow(row)
+			if err != nil {
 				return err
 			}
-			current, curDistinct = oa.convertRow(row)
 		}
 		return nil
 	})
@@ -269,7 +391,11 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		final, err := oa.convertFinal(current)
+		if err != nil {
+			return err
+		}
+		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{final}}); err != nil {
 			return err
 		}
 	}
@@ -284,17 +410,22 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 		if !aggr.preProcess() {
 			continue
 		}
+		typ, ok := opcodeType[aggr.Opcode]
+		if !ok {
+			// avg has the type of the sum it's computed from.
+			typ = fields[aggr.Col].Type
+		}
 		fields[aggr.Col] = &querypb.Field{
 			Name: aggr.Alias,
-			Type: opcodeType[aggr.Opcode],
+			Type: typ,
 		}
 	}
 	return fields
 }
 
-func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
+func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value, err error) {
 	if !oa.PreProcess {
-		return row, sqltypes.NULL
+		return row, sqltypes.NULL, nil
 	}
 	newRow = append(newRow, row...)
 	for _, aggr := range oa.Aggregates {
@@ -314,6 +445,10 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 			if err != nil {
 				newRow[aggr.Col] = sumZero
 			}
+		case AggregateStddevPop, AggregateStddevSamp, AggregateVarPop, AggregateVarSamp:
+			if err := convertVarianceRow(aggr, newRow); err != nil {
+				return nil, sqltypes.NULL, err
+			}
 		case AggregateGtid:
 			vgtid := &binlogdatapb.VGtid{}
 			vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{
@@ -326,7 +461,43 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 			newRow[aggr.Col] = val
 		}
 	}
-	return newRow, curDistinct
+	return newRow, curDistinct, nil
+}
+
+// convertVarianceRow replaces the sum and the population variance returned
+// by the input with the mean of the values and the sum of their squared
+// deviations from it (M2), which can be merged without losing precision.
+func convertVarianceRow(aggr AggregateParams, row []sqltypes.Value) error {
+	n, mean, m2, err := varianceInput(aggr, row)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		row[aggr.Col] = sqltypes.NULL
+		row[aggr.VarPopCol] = sqltypes.NULL
+		return nil
+	}
+	row[aggr.Col] = sqltypes.NewFloat64(mean)
+	row[aggr.VarPopCol] = sqltypes.NewFloat64(m2)
+	return nil
+}
+
+// varianceInput returns the count, the mean and the M2 of the values
+// of a row returned by the input.
+func varianceInput(aggr AggregateParams, row []sqltypes.Value) (n int64, mean, m2 float64, err error) {
+	n, err = evalengine.ToInt64(row[aggr.CountCol])
+	if err != nil || n == 0 {
+		return 0, 0, 0, err
+	}
+	sum, err := evalengine.ToFloat64(row[aggr.Col])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	varPop, err := evalengine.ToFloat64(row[aggr.VarPopCol])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return n, sum / float64(n), varPop * float64(n), nil
 }
 
 // GetFields is a Primitive function.
@@ -349,9 +520,9 @@ func (oa *OrderedAggregate) NeedsTransaction() bool {
 	return oa.Input.NeedsTransaction()
 }
 
-func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error) {
+func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value, colls columnCollations) (bool, error) {
 	for _, key := range oa.Keys {
-		cmp, err := evalengine.NullsafeCompare(row1[key], row2[key])
+		cmp, err := evalengine.NullsafeCompare(row1[key], row2[key], colls.get(key))
 		if err != nil {
 			return false, err
 		}
@@ -362,14 +533,14 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error)
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(fields []*querypb.Field, colls columnCollations, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
 	result := sqltypes.CopyRow(row1)
 	for _, aggr := range oa.Aggregates {
 		if aggr.isDistinct() {
 			if row2[aggr.Col].IsNull() {
 				continue
 			}
-			cmp, err := evalengine.NullsafeCompare(curDistinct, row2[aggr.Col])
+			cmp, err := evalengine.NullsafeCompare(curDistinct, row2[aggr.Col], colls.get(aggr.Col))
 			if err != nil {
 				return nil, sqltypes.NULL, err
 			}
@@ -385,9 +556,22 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 			v2 := row2[aggr.Col]
 			result[aggr.Col] = evalengine.NullsafeAdd(value, v2, fields[aggr.Col].Type)
 		case AggregateMin:
-			result[aggr.Col], err = evalengine.Min(row1[aggr.Col], row2[aggr.Col])
+			result[aggr.Col], err = evalengine.Min(row1[aggr.Col], row2[aggr.Col], colls.get(aggr.Col))
 		case AggregateMax:
-			result[aggr.Col], err = evalengine.Max(row1[aggr.Col], row2[aggr.Col])
+			result[aggr.Col], err = evalengine.Max(row1[aggr.Col], row2[aggr.Col], colls.get(aggr.Col))
+		case AggregateAvg:
+			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], fields[aggr.Col].Type)
+			result[aggr.CountCol] = evalengine.NullsafeAdd(row1[aggr.CountCol], row2[aggr.CountCol], sqltypes.Int64)
+		case AggregateStddevPop, AggregateStddevSamp, AggregateVarPop, AggregateVarSamp:
+			err = mergeVariance(aggr, row1, row2, result)
+		case AggregateBitAnd, AggregateBitOr, AggregateBitXor:
+			result[aggr.Col], err = mergeBits(aggr.Opcode, row1[aggr.Col], row2[aggr.Col])
+		case AggregateGroupConcat:
+			result[aggr.Col] = mergeGroupConcat(row1[aggr.Col], row2[aggr.Col], aggr.Separator)
+		case AggregateJSONArrayAgg:
+			result[aggr.Col] = mergeJSONArrays(row1[aggr.Col], row2[aggr.Col])
+		case AggregateJSONObjectAgg:
+			result[aggr.Col], err = mergeJSONObjects(row1[aggr.Col], row2[aggr.Col])
 		case AggregateCountDistinct:
 			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], countOne, opcodeType[aggr.Opcode])
 		case AggregateSumDistinct:
@@ -439,8 +623,22 @@ func createEmptyValueFor(opcode AggregateOpcode) (sqltypes.Value, error) {
 		AggregateSumDistinct,
 		AggregateSum,
 		AggregateMin,
-		AggregateMax:
+		AggregateMax,
+		AggregateAvg,
+		AggregateGroupConcat,
+		AggregateStddevPop,
+		AggregateStddevSamp,
+		AggregateVarPop,
+		AggregateVarSamp,
+		AggregateJSONArrayAgg,
+		AggregateJSONObjectAgg:
 		return sqltypes.NULL, nil
+	case AggregateBitAnd:
+		return bitAndZero, nil
+	case
+		AggregateBitOr,
+		AggregateBitXor:
+		return bitOrZero, nil
 
 	}
 	return sqltypes.NULL, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown aggregation %v", opcode)
@@ -480,7 +678,213 @@ func (oa *OrderedAggregate) convertFinal(current []sqltypes.Value) ([]sqltypes.V
 				return nil, err
 			}
 			result[aggr.Col] = sqltypes.NewVarChar(vgtid.String())
+		case AggregateAvg:
+			value, err := avgValue(current[aggr.Col], current[aggr.CountCol])
+			if err != nil {
+				return nil, err
+			}
+			result[aggr.Col] = value
+		case AggregateStddevPop, AggregateStddevSamp, AggregateVarPop, AggregateVarSamp:
+			value, err := varianceValue(aggr.Opcode, current[aggr.CountCol], current[aggr.VarPopCol])
+			if err != nil {
+				return nil, err
+			}
+			result[aggr.Col] = value
 		}
 	}
 	return result, nil
 }
+
+// avgValue returns the average computed from the sum and the count
+// of the values. The result has the type of the sum: a decimal for
+// exact values, and a float for approximate values.
+func avgValue(sum, count sqltypes.Value) (sqltypes.Value, error) {
+	n, err := evalengine.ToInt64(count)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if n == 0 || sum.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	if sum.Type() == sqltypes.Decimal {
+		return evalengine.DivideDecimal(sum, n, divPrecisionIncrement)
+	}
+	total, err := evalengine.ToFloat64(sum)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return sqltypes.NewFloat64(total / float64(n)), nil
+}
+
+// mergeVariance merges the values of a row returned by the input into
+// the count, the mean and the M2 of the current row, with the parallel
+// algorithm of Chan et al.
+func mergeVariance(aggr AggregateParams, current, row, result []sqltypes.Value) error {
+	n2, mean2, m2b, err := varianceInput(aggr, row)
+	if err != nil || n2 == 0 {
+		return err
+	}
+	n1, err := evalengine.ToInt64(current[aggr.CountCol])
+	if err != nil {
+		return err
+	}
+	if n1 == 0 {
+		result[aggr.Col] = sqltypes.NewFloat64(mean2)
+		result[aggr.CountCol] = sqltypes.NewInt64(n2)
+		result[aggr.VarPopCol] = sqltypes.NewFloat64(m2b)
+		return nil
+	}
+	mean1, err := evalengine.ToFloat64(current[aggr.Col])
+	if err != nil {
+		return err
+	}
+	m2a, err := evalengine.ToFloat64(current[aggr.VarPopCol])
+	if err != nil {
+		return err
+	}
+	n := n1 + n2
+	delta := mean2 - mean1
+	result[aggr.Col] = sqltypes.NewFloat64(mean1 + delta*float64(n2)/float64(n))
+	result[aggr.CountCol] = sqltypes.NewInt64(n)
+	result[aggr.VarPopCol] = sqltypes.NewFloat64(m2a + m2b + delta*delta*float64(n1)*float64(n2)/float64(n))
+	return nil
+}
+
+// varianceValue returns the variance or the standard deviation
+// computed from the count of the values and the sum of their
+// squared deviations from the mean.
+func varianceValue(opcode AggregateOpcode, count, m2 sqltypes.Value) (sqltypes.Value, error) {
+	n, err := evalengine.ToInt64(count)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if n == 0 || (n == 1 && (opcode == AggregateStddevSamp || opcode == AggregateVarSamp)) {
+		return sqltypes.NULL, nil
+	}
+	deviations, err := evalengine.ToFloat64(m2)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var variance float64
+	switch opcode {
+	case AggregateStddevPop, AggregateVarPop:
+		variance = deviations / float64(n)
+	default:
+		variance = deviations / float64(n-1)
+	}
+	if opcode == AggregateStddevPop || opcode == AggregateStddevSamp {
+		return sqltypes.NewFloat64(math.Sqrt(variance)), nil
+	}
+	return sqltypes.NewFloat64(variance), nil
+}
+
+func mergeBits(opcode AggregateOpcode, v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	if v1.IsNull() {
+		return v2, nil
+	}
+	if v2.IsNull() {
+		return v1, nil
+	}
+	b1, err := evalengine.ToUint64(v1)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	b2, err := evalengine.ToUint64(v2)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch opcode {
+	case AggregateBitAnd:
+		return sqltypes.NewUint64(b1 & b2), nil
+	case AggregateBitOr:
+		return sqltypes.NewUint64(b1 | b2), nil
+	}
+	return sqltypes.NewUint64(b1 ^ b2), nil
+}
+
+// mergeGroupConcat concatenates the results of group_concat from two rows.
+// The group_concat_max_len limit is only applied by the shards.
+func mergeGroupConcat(v1, v2 sqltypes.Value, separator string) sqltypes.Value {
+	if v1.IsNull() {
+		return v2
+	}
+	if v2.IsNull() {
+		return v1
+	}
+	raw := make([]byte, 0, v1.Len()+len(separator)+v2.Len())
+	raw = append(raw, v1.Raw()...)
+	raw = append(raw, separator...)
+	raw = append(raw, v2.Raw()...)
+	return sqltypes.MakeTrusted(v1.Type(), raw)
+}
+
+// mergeJSONArrays appends the elements of the second JSON array to the first one.
+func mergeJSONArrays(v1, v2 sqltypes.Value) sqltypes.Value {
+	if v1.IsNull() {
+		return v2
+	}
+	if v2.IsNull() {
+		return v1
+	}
+	a1 := bytes.TrimSpace(v1.Raw())
+	a2 := bytes.TrimSpace(v2.Raw())
+	if len(a1) <= 2 {
+		return v2
+	}
+	if len(a2) <= 2 {
+		return v1
+	}
+	raw := make([]byte, 0, len(a1)+len(a2))
+	raw = append(raw, a1[:len(a1)-1]...)
+	raw = append(raw, ", "...)
+	raw = append(raw, a2[1:]...)
+	return sqltypes.MakeTrusted(v1.Type(), raw)
+}
+
+// mergeJSONObjects merges the members of two JSON objects. Like MySQL,
+// the last value wins for duplicate keys, and the keys are sorted
+// by length, then by their bytes.
+func mergeJSONObjects(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
+	if v1.IsNull() {
+		return v2, nil
+	}
+	if v2.IsNull() {
+		return v1, nil
+	}
+	members := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(v1.Raw(), &members); err != nil {
+		return sqltypes.NULL, vterrors.Wrapf(err, "invalid result for json_objectagg")
+	}
+	if err := json.Unmarshal(v2.Raw(), &members); err != nil {
+		return sqltypes.NULL, vterrors.Wrapf(err, "invalid result for json_objectagg")
+	}
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if err := enc.Encode(key); err != nil {
+			return sqltypes.NULL, err
+		}
+		// Encode terminates the key with a newline.
+		buf.Truncate(buf.Len() - 1)
+		buf.WriteString(": ")
+		buf.Write(members[key])
+	}
+	buf.WriteByte('}')
+	return sqltypes.MakeTrusted(v1.Type(), buf.Bytes()), nil
+}
@@ -575,6 +575,48 @@ func TestOrderedAggregateKeysFail(t *testing.T) {
 	}
 }
 
+func TestOrderedAggregateKeysCollation(t *testing.T) {
+	fields := collated(sqltypes.MakeTestFields(
+		"col|count(*)|min(c)",
+		"varchar|decimal|varchar",
+	), "utf8mb4_general_ci")
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1|b",
+			"A|1|A",
+			"b|1|x",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    1,
+		}, {
+			Opcode: AggregateMin,
+			Col:    2,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	want := sqltypes.MakeTestResult(
+		fields,
+		"a|2|A",
+		"b|1|x",
+	)
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+	utils.MustMatch(t, want.Rows, result.Rows)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, nil, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, want.Rows, result.Rows)
+}
+
 func TestOrderedAggregateMergeFail(t *testing.T) {
 	fields := sqltypes.MakeTestFields(
 		"col|count(*)",
@@ -652,13 +694,13 @@ func TestMerge(t *testing.T) {
 		"1|3|2.8|2|bc",
 	)
 
-	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
+	merged, _, err := oa.merge(fields, nil, r.Rows[0], r.Rows[1], sqltypes.NULL)
 	assert.NoError(err)
-	want := sqltypes.MakeTestResult(fields, "1|5|6|2|bc").Rows[0]
+	want := sqltypes.MakeTestResult(fields, "1|5|6.0|2|bc").Rows[0]
 	assert.Equal(want, merged)
 
 	// swap and retry
-	merged, _, err = oa.merge(fields, r.Rows[1], r.Rows[0], sqltypes.NULL)
+	merged, _, err = oa.merge(fields, nil, r.Rows[1], r.Rows[0], sqltypes.NULL)
 	assert.NoError(err)
 	assert.Equal(want, merged)
 }
@@ -790,3 +832,267 @@ func TestOrderedAggregateExecuteGtid(t *testing.T) {
 	)
 	assert.Equal(t, wantResult, result)
 }
+
+func TestOrderedAggregateExecuteAvg(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|sum(val)|count(val)",
+				"varbinary|decimal|int64",
+			),
+			"a|1|1",
+			"a|2|1",
+			"b|5|2",
+			"c|null|0",
+			"c|4|2",
+			"d|0.10|1",
+			"d|0.20|2",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []AggregateParams{{
+			Opcode:   AggregateAvg,
+			Col:      1,
+			Alias:    "avg(val)",
+			CountCol: 2,
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|avg(val)",
+			"varbinary|decimal",
+		),
+		"a|1.5000",
+		"b|2.5000",
+		"c|2.0000",
+		"d|0.100000",
+	)
+
+	result, err := oa.Execute(nil, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+
+	// Every row sent by StreamExecute must have its final value.
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult.Rows, result.Rows)
+}
+
+func TestOrderedAggregateExecuteVariance(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|sum(val)|sum(val)|count(val)|var_pop(val)|count(val)|var_pop(val)",
+				"varbinary|decimal|decimal|int64|float64|int64|float64",
+			),
+			"a|6|6|2|1|2|1",
+			"a|6|6|2|1|2|1",
+			"b|3|3|1|0|1|0",
+			"c|null|null|0|null|0|null",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateStddevPop,
+			Col:       1,
+			Alias:     "std(val)",
+			CountCol:  3,
+			VarPopCol: 4,
+		}, {
+			Opcode:    AggregateVarSamp,
+			Col:       2,
+			Alias:     "var_samp(val)",
+			CountCol:  5,
+			VarPopCol: 6,
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+
+	result, err := oa.Execute(nil, nil, true)
+	require.NoError(t, err)
+
+	// The values of a are 2, 4, 2 and 4. b has a single value,
+	// for which the sample variance is not defined.
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|std(val)|var_samp(val)",
+			"varbinary|float64|float64",
+		),
+		"a|1|1.3333333333333333",
+		"b|0|null",
+		"c|null|null",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateExecuteVarianceLargeOffset(t *testing.T) {
+	// The values are 1e9+4, 1e9+7, 1e9+13 and 1e9+16, split across
+	// two shards. Their variance is 22.5, which the sum of the squares
+	// can't give with float64 values.
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"sum(val)|count(val)|var_pop(val)",
+				"decimal|int64|float64",
+			),
+			"2000000011|2|2.25",
+			"2000000029|2|2.25",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		PreProcess: true,
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateVarPop,
+			Col:       0,
+			Alias:     "var_pop(val)",
+			CountCol:  1,
+			VarPopCol: 2,
+		}},
+		TruncateColumnCount: 1,
+		Input:               fp,
+	}
+
+	result, err := oa.Execute(nil, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"var_pop(val)",
+			"float64",
+		),
+		"22.5",
+	), result)
+}
+
+func TestOrderedAggregateExecuteBits(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|bit_and(val)|bit_or(val)|bit_xor(val)",
+		"varbinary|uint64|uint64|uint64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|7|1|3",
+			"a|14|2|1",
+			"b|5|5|5",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateBitAnd,
+			Col:    1,
+		}, {
+			Opcode: AggregateBitOr,
+			Col:    2,
+		}, {
+			Opcode: AggregateBitXor,
+			Col:    3,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|6|3|2",
+		"b|5|5|5",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateExecuteGroupConcat(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|group_concat(val)|group_concat(val separator ';')",
+		"varbinary|text|text",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|x,y|x;y",
+			"a|null|null",
+			"a|z|z",
+			"b|w|w",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateGroupConcat,
+			Col:       1,
+			Separator: ",",
+		}, {
+			Opcode:    AggregateGroupConcat,
+			Col:       2,
+			Separator: ";",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|x,y,z|x;y;z",
+		"b|w|w",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestOrderedAggregateExecuteJSON(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{{
+			Fields: sqltypes.MakeTestFields(
+				"col|json_arrayagg(val)|json_objectagg(k, val)",
+				"varbinary|json|json",
+			),
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarBinary("a"),
+				sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`[1, "x"]`)),
+				sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"k1": 1, "key": [1, 2]}`)),
+			}, {
+				sqltypes.NewVarBinary("a"),
+				sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`[null]`)),
+				sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"k": "<v>", "k1": 2}`)),
+			}},
+		}},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateJSONArrayAgg,
+			Col:    1,
+		}, {
+			Opcode: AggregateJSONObjectAgg,
+			Col:    2,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantRows := [][]sqltypes.Value{{
+		sqltypes.NewVarBinary("a"),
+		sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`[1, "x", null]`)),
+		sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"k": "<v>", "k1": 2, "key": [1, 2]}`)),
+	}}
+	assert.Equal(t, wantRows, result.Rows)
+}
@@ -145,6 +145,10 @@ type (
 		SetSessionEnableSystemSettings(bool) error
 		GetSessionEnableSystemSettings() bool
 
+		SetSessionEnableConsolidator(bool) error
+		SetSessionEnableTxRetry(bool) error
+		SetSessionReadWriteSplit(bool) error
+
 		// SetReadAfterWriteGTID sets the GTID that the user expects a replica to have caught up with before answering a query
 		SetReadAfterWriteGTID(string)
 		SetReadAfterWriteTimeout(float64)
@@ -167,6 +171,10 @@ type (
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
 		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
 
+		ResultCacheTTL    time.Duration // How long vtgate caches the results of this query, 0 to not cache them
+		ResultCacheTables []string      // The tables read by this query, as keyspace.table, to invalidate its cached results
+		ReplicaReadable   bool          // Whether this query can read from replicas in the sessions that split their reads and writes
+
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
 		ShardQueries uint64 // Total number of shard queries
@@ -258,15 +266,17 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 	}
 
 	marshalPlan := struct {
-		QueryType    string
-		Original     string                `json:",omitempty"`
-		Instructions *PrimitiveDescription `json:",omitempty"`
-		ExecCount    uint64                `json:",omitempty"`
-		ExecTime     time.Duration         `json:",omitempty"`
-		ShardQueries uint64                `json:",omitempty"`
-		RowsAffected uint64                `json:",omitempty"`
-		RowsReturned uint64                `json:",omitempty"`
-		Errors       uint64                `json:",omitempty"`
+		QueryType         string
+		Original          string                `json:",omitempty"`
+		Instructions      *PrimitiveDescription `json:",omitempty"`
+		ResultCacheTTL    string                `json:",omitempty"`
+		ResultCacheTables []string              `json:",omitempty"`
+		ExecCount         uint64                `json:",omitempty"`
+		ExecTime          time.Duration         `json:",omitempty"`
+		ShardQueries      uint64                `json:",omitempty"`
+		RowsAffected      uint64                `json:",omitempty"`
+		RowsReturned      uint64                `json:",omitempty"`
+		Errors            uint64                `json:",omitempty"`
 	}{
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
@@ -278,6 +288,10 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsReturned: atomic.LoadUint64(&p.RowsReturned),
 		Errors:       atomic.LoadUint64(&p.Errors),
 	}
+	if p.ResultCacheTTL > 0 {
+		marshalPlan.ResultCacheTTL = p.ResultCacheTTL.String()
+		marshalPlan.ResultCacheTables = p.ResultCacheTables
+	}
 	return json.Marshal(marshalPlan)
 }
 
@@ -642,7 +642,7 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 		InsertID:     in.InsertID,
 	}
 
-	comparers := extractSlices(route.OrderBy)
+	comparers := extractSlices(route.OrderBy, in.Fields)
 
 	sort.Slice(out.Rows, func(i, j int) bool {
 		var cmp int
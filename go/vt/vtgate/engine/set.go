@@ -414,6 +414,12 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 		vcursor.Session().SetDDLStrategy(str)
 	case sysvars.SessionEnableSystemSettings.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableSystemSettings)
+	case sysvars.SessionEnableConsolidator.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableConsolidator)
+	case sysvars.SessionEnableTxRetry.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableTxRetry)
+	case sysvars.SessionReadWriteSplit.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionReadWriteSplit)
 	case sysvars.Charset.Name, sysvars.Names.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -0,0 +1,361 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that computes window functions over the rows
+// of its input. The input must be sorted by the PartitionBy columns,
+// followed by the OrderBy columns. The value of every window function
+// is appended as a new column to the input rows.
+//
+// Only the default window frame is supported: aggregate functions are
+// computed from the start of the partition up to the last peer of the
+// current row, or over the whole partition if there is no OrderBy.
+type Window struct {
+	// PartitionBy specifies the input columns that split the rows
+	// into partitions.
+	PartitionBy []int `json:",omitempty"`
+
+	// OrderBy specifies the input columns the window is ordered by.
+	// Rows of a partition that have the same values are peers.
+	OrderBy []int `json:",omitempty"`
+
+	// Functions specifies the window functions to compute.
+	Functions []WindowFunction
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowFunction specifies the parameters of a window function.
+type WindowFunction struct {
+	Opcode WindowOpcode
+	// Col is the input column of the argument of the function.
+	// It is -1 if the function has no argument, as in count(*).
+	Col int
+	// Alias is the name of the column produced by the function.
+	Alias string `json:",omitempty"`
+}
+
+func (wf WindowFunction) String() string {
+	switch {
+	case wf.Opcode == WindowCount && wf.Col == -1:
+		return "count(*)"
+	case wf.Col == -1:
+		return wf.Opcode.String()
+	}
+	return fmt.Sprintf("%s(%d)", wf.Opcode.String(), wf.Col)
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowCount
+	WindowSum
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFunctions maps the list of window functions
+// that can be computed by vtgate to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number": WindowRowNumber,
+	"rank":       WindowRank,
+	"dense_rank": WindowDenseRank,
+	"count":      WindowCount,
+	"sum":        WindowSum,
+	"min":        WindowMin,
+	"max":        WindowMax,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// Execute is a Primitive function.
+func (w *Window) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := w.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	colls := newColumnCollations(result.Fields)
+	out := &sqltypes.Result{
+		Fields: w.convertFields(result.Fields),
+		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
+	}
+	// This code is similar to the one in StreamExecute.
+	start := 0
+	for i := 1; i <= len(result.Rows); i++ {
+		if i < len(result.Rows) {
+			equal, err := keysEqual(w.PartitionBy, result.Rows[start], result.Rows[i], colls)
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				continue
+			}
+		}
+		rows, err := w.computePartition(result.Rows[start:i], colls)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, rows...)
+		start = i
+	}
+	return out, nil
+}
+
+// StreamExecute is a Primitive function.
+// The rows of a partition are buffered until the partition is complete.
+func (w *Window) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var partition [][]sqltypes.Value
+	var colls columnCollations
+
+	flush := func() error {
+		rows, err := w.computePartition(partition, colls)
+		if err != nil {
+			return err
+		}
+		partition = nil
+		return callback(&sqltypes.Result{Rows: rows})
+	}
+
+	err := w.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			colls = newColumnCollations(qr.Fields)
+			if err := callback(&sqltypes.Result{Fields: w.convertFields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		// This code is similar to the one in Execute.
+		for _, row := range qr.Rows {
+			if len(partition) != 0 {
+				equal, err := keysEqual(w.PartitionBy, partition[0], row, colls)
+				if err != nil {
+					return err
+				}
+				if !equal {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+			partition = append(partition, row)
+			if vcursor.ExceedsMaxMemoryRows(len(partition)) {
+				return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(partition) != 0 {
+		return flush()
+	}
+	return nil
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: w.convertFields(qr.Fields)}, nil
+}
+
+// Inputs returns the Primitive input for this window
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make([]*querypb.Field, 0, len(fields)+len(w.Functions))
+	result = append(result, fields...)
+	for _, fn := range w.Functions {
+		field := &querypb.Field{Name: fn.Alias}
+		switch fn.Opcode {
+		case WindowSum:
+			field.Type = sqltypes.Decimal
+		case WindowMin, WindowMax:
+			field.Type = fields[fn.Col].Type
+			field.Charset = fields[fn.Col].Charset
+		default:
+			field.Type = sqltypes.Int64
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// computePartition returns the rows of a partition, with the values of the
+// window functions appended to them.
+func (w *Window) computePartition(rows [][]sqltypes.Value, colls columnCollations) ([][]sqltypes.Value, error) {
+	out := make([][]sqltypes.Value, 0, len(rows))
+	counts := make([]int64, len(w.Functions))
+	values := make([]sqltypes.Value, len(w.Functions))
+	denseRank := int64(0)
+	for start := 0; start < len(rows); {
+		// Find the peers of the current row.
+		end := start + 1
+		for ; end < len(rows); end++ {
+			equal, err := keysEqual(w.OrderBy, rows[start], rows[end], colls)
+			if err != nil {
+				return nil, err
+			}
+			if !equal {
+				break
+			}
+		}
+		denseRank++
+
+		for _, row := range rows[start:end] {
+			for i, fn := range w.Functions {
+				if fn.Col == -1 {
+					counts[i]++
+					continue
+				}
+				v := row[fn.Col]
+				if v.IsNull() {
+					continue
+				}
+				var err error
+				switch fn.Opcode {
+				case WindowCount:
+					counts[i]++
+				case WindowSum:
+					values[i] = evalengine.NullsafeAdd(values[i], v, sqltypes.Decimal)
+				case WindowMin:
+					values[i], err = evalengine.Min(values[i], v, colls.get(fn.Col))
+				case WindowMax:
+					values[i], err = evalengine.Max(values[i], v, colls.get(fn.Col))
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for rowNumber := start; rowNumber < end; rowNumber++ {
+			row := make([]sqltypes.Value, 0, len(rows[rowNumber])+len(w.Functions))
+			row = append(row, rows[rowNumber]...)
+			for i, fn := range w.Functions {
+				switch fn.Opcode {
+				case WindowRowNumber:
+					row = append(row, sqltypes.NewInt64(int64(rowNumber+1)))
+				case WindowRank:
+					row = append(row, sqltypes.NewInt64(int64(start+1)))
+				case WindowDenseRank:
+					row = append(row, sqltypes.NewInt64(denseRank))
+				case WindowCount:
+					row = append(row, sqltypes.NewInt64(counts[i]))
+				case WindowSum, WindowMin, WindowMax:
+					row = append(row, values[i])
+				default:
+					return nil, fmt.Errorf("BUG: Unexpected opcode: %v", fn.Opcode)
+				}
+			}
+			out = append(out, row)
+		}
+		start = end
+	}
+	return out, nil
+}
+
+// keysEqual returns true if the two rows have the same values for the given columns.
+func keysEqual(keys []int, row1, row2 []sqltypes.Value, colls columnCollations) (bool, error) {
+	for _, key := range keys {
+		cmp, err := evalengine.NullsafeCompare(row1[key], row2[key], colls.get(key))
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func windowFunctionToString(i interface{}) string {
+	return i.(WindowFunction).String()
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions": GenericJoin(w.Functions, windowFunctionToString),
+	}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, intToString)
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, intToString)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
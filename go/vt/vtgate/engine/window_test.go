@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestWindowExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"dept|salary",
+				"varbinary|int64",
+			),
+			"a|10",
+			"a|20",
+			"a|20",
+			"a|30",
+			"b|5",
+			"c|null",
+			"c|7",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []int{0},
+		OrderBy:     []int{1},
+		Functions: []WindowFunction{
+			{Opcode: WindowRowNumber, Col: -1, Alias: "rn"},
+			{Opcode: WindowRank, Col: -1, Alias: "rk"},
+			{Opcode: WindowDenseRank, Col: -1, Alias: "drk"},
+			{Opcode: WindowCount, Col: 1, Alias: "cnt"},
+			{Opcode: WindowSum, Col: 1, Alias: "total"},
+			{Opcode: WindowMax, Col: 1, Alias: "top"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"dept|salary|rn|rk|drk|cnt|total|top",
+			"varbinary|int64|int64|int64|int64|int64|decimal|int64",
+		),
+		"a|10|1|1|1|1|10|10",
+		"a|20|2|2|2|3|50|20",
+		"a|20|3|2|2|3|50|20",
+		"a|30|4|4|3|4|80|30",
+		"b|5|1|1|1|1|5|5",
+		"c|null|1|1|1|0|null|null",
+		"c|7|2|2|2|1|7|7",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteWholePartition(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|dept",
+				"int64|varbinary",
+			),
+			"1|a",
+			"2|a",
+			"3|b",
+		)},
+	}
+
+	// Without an ORDER BY, all the rows of a partition are peers.
+	w := &Window{
+		PartitionBy: []int{1},
+		Functions: []WindowFunction{
+			{Opcode: WindowCount, Col: -1, Alias: "count(*) over (partition by dept)"},
+			{Opcode: WindowRank, Col: -1, Alias: "rank() over (partition by dept)"},
+			{Opcode: WindowMin, Col: 0, Alias: "min(id) over (partition by dept)"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.Execute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|dept|count(*) over (partition by dept)|rank() over (partition by dept)|min(id) over (partition by dept)",
+			"int64|varbinary|int64|int64|int64",
+		),
+		"1|a|2|1|1",
+		"2|a|2|1|1",
+		"3|b|1|1|3",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"dept|salary",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|10",
+			"a|20",
+			"a|30",
+			"b|5",
+			"b|6",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []int{0},
+		OrderBy:     []int{1},
+		Functions: []WindowFunction{
+			{Opcode: WindowRowNumber, Col: -1, Alias: "rn"},
+			{Opcode: WindowSum, Col: 1, Alias: "total"},
+		},
+		Input: fp,
+	}
+
+	var results []*sqltypes.Result
+	err := w.StreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The rows are sent once their partition is complete.
+	wantResults := sqltypes.MakeTestStreamingResults(
+		sqltypes.MakeTestFields(
+			"dept|salary|rn|total",
+			"varbinary|int64|int64|decimal",
+		),
+		"a|10|1|10",
+		"a|20|2|30",
+		"a|30|3|60",
+		"---",
+		"b|5|1|5",
+		"b|6|2|11",
+	)
+	assert.Equal(t, wantResults, results)
+}
+
+func TestWindowStreamExecuteMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"dept|salary",
+				"varbinary|int64",
+			),
+			"a|10",
+			"a|20",
+			"a|30",
+			"b|5",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []int{0},
+		Functions:   []WindowFunction{{Opcode: WindowCount, Col: -1}},
+		Input:       fp,
+	}
+
+	_, err := wrapStreamExecute(w, &noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestWindowGetFields(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"dept|name",
+		"varchar|varchar",
+	)
+	fp := &fakePrimitive{results: []*sqltypes.Result{{Fields: fields}}}
+
+	w := &Window{
+		PartitionBy: []int{0},
+		Functions: []WindowFunction{
+			{Opcode: WindowRowNumber, Col: -1, Alias: "rn"},
+			{Opcode: WindowMin, Col: 1, Alias: "first"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.GetFields(nil, nil)
+	require.NoError(t, err)
+	wantResult := &sqltypes.Result{Fields: sqltypes.MakeTestFields(
+		"dept|name|rn|first",
+		"varchar|varchar|int64|varchar",
+	)}
+	assert.Equal(t, wantResult, result)
+}
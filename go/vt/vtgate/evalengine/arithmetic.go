@@ -19,8 +19,10 @@ package evalengine
 import (
 	"bytes"
 	"fmt"
+	"hash/fnv"
 	"math"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	"strconv"
@@ -157,7 +159,8 @@ func Divide(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
 // Unsigned ints can only be added to positive ints. After the
 // addition, if one of the input types was Decimal, then
 // a Decimal is built. Otherwise, the final type of the
-// result is preserved.
+// result is preserved. If the result is a Decimal and both
+// values are exact numbers, the addition is exact.
 func NullsafeAdd(v1, v2 sqltypes.Value, resultType querypb.Type) sqltypes.Value {
 	if v1.IsNull() {
 		v1 = sqltypes.MakeTrusted(resultType, zeroBytes)
@@ -165,6 +168,11 @@ func NullsafeAdd(v1, v2 sqltypes.Value, resultType querypb.Type) sqltypes.Value
 	if v2.IsNull() {
 		v2 = sqltypes.MakeTrusted(resultType, zeroBytes)
 	}
+	if resultType == sqltypes.Decimal {
+		if sum, ok := addDecimals(v1, v2); ok {
+			return sum
+		}
+	}
 
 	lv1, err := newEvalResult(v1)
 	if err != nil {
@@ -183,8 +191,10 @@ func NullsafeAdd(v1, v2 sqltypes.Value, resultType querypb.Type) sqltypes.Value
 // NULL is the lowest value. If any value is
 // numeric, then a numeric comparison is performed after
 // necessary conversions. If none are numeric, then it's
-// a simple binary comparison. Uncomparable values return an error.
-func NullsafeCompare(v1, v2 sqltypes.Value) (int, error) {
+// a simple binary comparison. Text values are compared using
+// the given collation, and cannot be compared if the collation
+// is unknown. Uncomparable values return an error.
+func NullsafeCompare(v1, v2 sqltypes.Value, collationID collations.ID) (int, error) {
 	// Based on the categorization defined for the types,
 	// we're going to allow comparison of the following:
 	// Null, isNumber, IsBinary. This will exclude IsQuoted
@@ -212,6 +222,11 @@ func NullsafeCompare(v1, v2 sqltypes.Value) (int, error) {
 	if isByteComparable(v1) && isByteComparable(v2) {
 		return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
 	}
+	if v1.IsText() && v2.IsText() {
+		if coll := collations.LookupByID(collationID); coll != nil {
+			return coll.Collate(v1.ToBytes(), v2.ToBytes()), nil
+		}
+	}
 	return 0, UnsupportedComparisonError{
 		Type1: v1.Type(),
 		Type2: v2.Type(),
@@ -221,7 +236,7 @@ func NullsafeCompare(v1, v2 sqltypes.Value) (int, error) {
 // NullsafeHashcode returns an int64 hashcode that is guaranteed to be the same
 // for two values that are considered equal by `NullsafeCompare`.
 // TODO: should be extended to support all possible types
-func NullsafeHashcode(v sqltypes.Value) (int64, error) {
+func NullsafeHashcode(v sqltypes.Value, collationID collations.ID) (int64, error) {
 	if v.IsNull() {
 		return math.MaxInt64, nil
 	}
@@ -234,6 +249,21 @@ func NullsafeHashcode(v sqltypes.Value) (int64, error) {
 		return hashCode(result), nil
 	}
 
+	if isByteComparable(v) {
+		hash := fnv.New64a()
+		_, _ = hash.Write(v.ToBytes())
+		return int64(hash.Sum64()), nil
+	}
+
+	if v.IsText() {
+		if coll := collations.LookupByID(collationID); coll != nil {
+			// values that are equal under the collation have the same weight string
+			hash := fnv.New64a()
+			_, _ = hash.Write(coll.WeightString(nil, v.ToBytes()))
+			return int64(hash.Sum64()), nil
+		}
+	}
+
 	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "types does not support hashcode yet: %v", v.Type())
 }
 
@@ -251,19 +281,21 @@ func isByteComparable(v sqltypes.Value) bool {
 
 // Min returns the minimum of v1 and v2. If one of the
 // values is NULL, it returns the other value. If both
-// are NULL, it returns NULL.
-func Min(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
-	return minmax(v1, v2, true)
+// are NULL, it returns NULL. Text values are compared
+// using the given collation.
+func Min(v1, v2 sqltypes.Value, collationID collations.ID) (sqltypes.Value, error) {
+	return minmax(v1, v2, true, collationID)
 }
 
 // Max returns the maximum of v1 and v2. If one of the
 // values is NULL, it returns the other value. If both
-// are NULL, it returns NULL.
-func Max(v1, v2 sqltypes.Value) (sqltypes.Value, error) {
-	return minmax(v1, v2, false)
+// are NULL, it returns NULL. Text values are compared
+// using the given collation.
+func Max(v1, v2 sqltypes.Value, collationID collations.ID) (sqltypes.Value, error) {
+	return minmax(v1, v2, false, collationID)
 }
 
-func minmax(v1, v2 sqltypes.Value, min bool) (sqltypes.Value, error) {
+func minmax(v1, v2 sqltypes.Value, min bool, collationID collations.ID) (sqltypes.Value, error) {
 	if v1.IsNull() {
 		return v2, nil
 	}
@@ -271,7 +303,7 @@ func minmax(v1, v2 sqltypes.Value, min bool) (sqltypes.Value, error) {
 		return v1, nil
 	}
 
-	n, err := NullsafeCompare(v1, v2)
+	n, err := NullsafeCompare(v1, v2, collationID)
 	if err != nil {
 		return sqltypes.NULL, err
 	}
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -584,7 +585,7 @@ func TestNullsafeCompare(t *testing.T) {
 		out: -1,
 	}}
 	for _, tcase := range tcases {
-		got, err := NullsafeCompare(tcase.v1, tcase.v2)
+		got, err := NullsafeCompare(tcase.v1, tcase.v2, collations.Unknown)
 		if !vterrors.Equals(err, tcase.err) {
 			t.Errorf("NullsafeCompare(%v, %v) error: %v, want %v", printValue(tcase.v1), printValue(tcase.v2), vterrors.Print(err), vterrors.Print(tcase.err))
 		}
@@ -798,6 +799,38 @@ func TestToFloat64(t *testing.T) {
 	}
 }
 
+func TestToNumeric(t *testing.T) {
+	tcases := []struct {
+		v   sqltypes.Value
+		out sqltypes.Value
+	}{{
+		v:   TestValue(querypb.Type_VARCHAR, "1"),
+		out: NewInt64(1),
+	}, {
+		v:   TestValue(querypb.Type_VARCHAR, " 1.5abc"),
+		out: NewFloat64(1.5),
+	}, {
+		v:   TestValue(querypb.Type_VARCHAR, "abcd"),
+		out: NewFloat64(0),
+	}, {
+		v:   TestValue(querypb.Type_UINT64, "18446744073709551615"),
+		out: NewUint64(18446744073709551615),
+	}, {
+		v:   TestValue(querypb.Type_DECIMAL, "2.50"),
+		out: NewFloat64(2.5),
+	}, {
+		v:   sqltypes.NULL,
+		out: sqltypes.NULL,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.v.String(), func(t *testing.T) {
+			got, err := ToNumeric(tcase.v)
+			require.NoError(t, err)
+			require.Equal(t, tcase.out, got)
+		})
+	}
+}
+
 func TestToNative(t *testing.T) {
 	testcases := []struct {
 		in  sqltypes.Value
@@ -1268,7 +1301,7 @@ func TestMin(t *testing.T) {
 		err: vterrors.New(vtrpcpb.Code_UNKNOWN, "types are not comparable: VARCHAR vs VARCHAR"),
 	}}
 	for _, tcase := range tcases {
-		v, err := Min(tcase.v1, tcase.v2)
+		v, err := Min(tcase.v1, tcase.v2, collations.Unknown)
 		if !vterrors.Equals(err, tcase.err) {
 			t.Errorf("Min error: %v, want %v", vterrors.Print(err), vterrors.Print(tcase.err))
 		}
@@ -1317,7 +1350,7 @@ func TestMax(t *testing.T) {
 		err: vterrors.New(vtrpcpb.Code_UNKNOWN, "types are not comparable: VARCHAR vs VARCHAR"),
 	}}
 	for _, tcase := range tcases {
-		v, err := Max(tcase.v1, tcase.v2)
+		v, err := Max(tcase.v1, tcase.v2, collations.Unknown)
 		if !vterrors.Equals(err, tcase.err) {
 			t.Errorf("Max error: %v, want %v", vterrors.Print(err), vterrors.Print(tcase.err))
 		}
@@ -1335,19 +1368,71 @@ func TestHashCodes(t *testing.T) {
 	n1 := sqltypes.NULL
 	n2 := sqltypes.Value{}
 
-	h1, err := NullsafeHashcode(n1)
+	h1, err := NullsafeHashcode(n1, collations.Unknown)
 	require.NoError(t, err)
-	h2, err := NullsafeHashcode(n2)
+	h2, err := NullsafeHashcode(n2, collations.Unknown)
 	require.NoError(t, err)
 	assert.Equal(t, h1, h2)
 
 	char := TestValue(querypb.Type_VARCHAR, "aa")
-	_, err = NullsafeHashcode(char)
+	_, err = NullsafeHashcode(char, collations.Unknown)
 	require.Error(t, err)
 
 	num := TestValue(querypb.Type_INT64, "123")
-	_, err = NullsafeHashcode(num)
+	_, err = NullsafeHashcode(num, collations.Unknown)
+	require.NoError(t, err)
+
+	// text values that are equal under the collation must have the same hashcode
+	collationID := collations.LookupByName("utf8mb4_general_ci").ID()
+	h1, err = NullsafeHashcode(TestValue(querypb.Type_VARCHAR, "Résumé"), collationID)
+	require.NoError(t, err)
+	h2, err = NullsafeHashcode(TestValue(querypb.Type_VARCHAR, "resume"), collationID)
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+	h2, err = NullsafeHashcode(TestValue(querypb.Type_VARCHAR, "resumes"), collationID)
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, h2)
+
+	// binary values are hashed by their bytes
+	h1, err = NullsafeHashcode(TestValue(querypb.Type_VARBINARY, "abc"), collations.Unknown)
+	require.NoError(t, err)
+	h2, err = NullsafeHashcode(TestValue(querypb.Type_VARBINARY, "abc"), collations.Unknown)
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+	h2, err = NullsafeHashcode(TestValue(querypb.Type_VARBINARY, "ABC"), collations.Unknown)
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, h2)
+}
+
+func TestNullsafeCompareCollate(t *testing.T) {
+	tcases := []struct {
+		v1, v2    string
+		collation string
+		out       int
+	}{
+		{"abcd", "abcd", "utf8mb4_bin", 0},
+		{"abcd", "ABCD", "utf8mb4_bin", 1},
+		{"abcd", "ABCD", "utf8mb4_general_ci", 0},
+		{"abcd", "ABCE", "utf8mb4_general_ci", -1},
+		{"énorme", "enorme", "utf8mb4_general_ci", 0},
+		{"énorme", "enorme", "utf8mb4_bin", 1},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.v1+" "+tcase.collation+" "+tcase.v2, func(t *testing.T) {
+			collationID := collations.LookupByName(tcase.collation).ID()
+			got, err := NullsafeCompare(TestValue(querypb.Type_VARCHAR, tcase.v1), TestValue(querypb.Type_VARCHAR, tcase.v2), collationID)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+		})
+	}
+
+	collationID := collations.LookupByName("utf8mb4_general_ci").ID()
+	min, err := Min(TestValue(querypb.Type_VARCHAR, "b"), TestValue(querypb.Type_VARCHAR, "A"), collationID)
+	require.NoError(t, err)
+	assert.Equal(t, "A", min.ToString())
+	max, err := Max(TestValue(querypb.Type_VARCHAR, "b"), TestValue(querypb.Type_VARCHAR, "A"), collationID)
 	require.NoError(t, err)
+	assert.Equal(t, "b", max.ToString())
 }
 
 func printValue(v sqltypes.Value) string {
@@ -17,9 +17,13 @@ limitations under the License.
 package evalengine
 
 import (
+	"bytes"
+	"strconv"
 	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -56,3 +60,172 @@ func (e *EvalResult) ToBooleanStrict() (bool, error) {
 	}
 	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "is not a boolean")
 }
+
+// ToBoolean returns the truth value of the result as a WHERE clause sees it:
+// NULL and zero values are false, any other value is true
+func (e *EvalResult) ToBoolean() bool {
+	truth, isNull := e.truthValue()
+	return truth && !isNull
+}
+
+// CastExpr represents `CAST(a AS type)` and `CONVERT(a, type)`
+type CastExpr struct {
+	Inner Expr
+	Typ   querypb.Type
+	// Collation is the collation of the result when casting to a string,
+	// e.g. Binary for `CAST(a AS BINARY)`
+	Collation collations.ID
+	// Scale is the number of decimals kept when casting to DECIMAL
+	Scale int
+}
+
+var _ Expr = (*CastExpr)(nil)
+
+//Evaluate implements the Expr interface
+func (c *CastExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := c.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if val.isNull() {
+		return resultNull, nil
+	}
+	switch c.Typ {
+	case sqltypes.Int64:
+		return newEvalInt64(val.toInt64()), nil
+	case sqltypes.Uint64:
+		if num := val.toNumeric(); num.typ == sqltypes.Uint64 {
+			return num, nil
+		}
+		return EvalResult{typ: sqltypes.Uint64, uval: uint64(val.toInt64())}, nil
+	case sqltypes.Float64:
+		return newEvalFloat(val.toFloat()), nil
+	case sqltypes.Decimal:
+		return EvalResult{typ: sqltypes.Decimal, bytes: toDecimal(val.toRawBytes(), c.Scale)}, nil
+	case sqltypes.VarBinary:
+		result := newEvalText(val.toRawBytes())
+		result.collation = c.Collation
+		return result, nil
+	case sqltypes.Date:
+		t, ok := parseDatetime(val)
+		if !ok {
+			return resultNull, nil
+		}
+		return EvalResult{typ: sqltypes.Date, bytes: []byte(t.Format("2006-01-02"))}, nil
+	case sqltypes.Datetime:
+		t, ok := parseDatetime(val)
+		if !ok {
+			return resultNull, nil
+		}
+		return EvalResult{typ: sqltypes.Datetime, bytes: []byte(t.Format("2006-01-02 15:04:05"))}, nil
+	case sqltypes.Time:
+		t, ok := parseTime(val)
+		if !ok {
+			return resultNull, nil
+		}
+		return EvalResult{typ: sqltypes.Time, bytes: []byte(t.Format("15:04:05"))}, nil
+	}
+	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported cast to %s", c.Typ.String())
+}
+
+// toDecimal converts the textual representation of a number to a DECIMAL
+// with scale decimals. It works on the digits so that the result is exact,
+// and rounds half away from zero like MySQL. Like MySQL, it reads the
+// leading number of a string, and ignores the rest.
+func toDecimal(raw []byte, scale int) []byte {
+	s := strings.TrimLeft(string(raw), " \t\n")
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	// digits holds the digits of the number, and point the position of
+	// the decimal point within them.
+	var digits []byte
+	i := 0
+	for ; i < len(s) && isDigit(s[i]); i++ {
+		digits = append(digits, s[i])
+	}
+	point := len(digits)
+	if i < len(s) && s[i] == '.' {
+		for i++; i < len(s) && isDigit(s[i]); i++ {
+			digits = append(digits, s[i])
+		}
+	}
+	if len(digits) > 0 && i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '-' || s[j] == '+') {
+			j++
+		}
+		start := j
+		for ; j < len(s) && isDigit(s[j]); j++ {
+		}
+		if j > start {
+			if exp, err := strconv.Atoi(s[i+1 : j]); err == nil {
+				point += exp
+			}
+		}
+	}
+
+	// Align the digits so that there are at least one integral digit,
+	// and scale+1 fractional digits, the last one deciding the rounding.
+	if point < 1 {
+		digits = append(bytes.Repeat([]byte{'0'}, 1-point), digits...)
+		point = 1
+	}
+	if missing := point + scale + 1 - len(digits); missing > 0 {
+		digits = append(digits, bytes.Repeat([]byte{'0'}, missing)...)
+	}
+	roundUp := digits[point+scale] >= '5'
+	digits = digits[:point+scale]
+	if roundUp {
+		k := len(digits) - 1
+		for ; k >= 0 && digits[k] == '9'; k-- {
+			digits[k] = '0'
+		}
+		if k >= 0 {
+			digits[k]++
+		} else {
+			digits = append([]byte{'1'}, digits...)
+			point++
+		}
+	}
+
+	// Strip the leading zeros of the integral part.
+	for point > 1 && digits[0] == '0' {
+		digits = digits[1:]
+		point--
+	}
+	zero := true
+	for _, d := range digits {
+		if d != '0' {
+			zero = false
+			break
+		}
+	}
+
+	var result []byte
+	if neg && !zero {
+		result = append(result, '-')
+	}
+	result = append(result, digits[:point]...)
+	if scale > 0 {
+		result = append(result, '.')
+		result = append(result, digits[point:]...)
+	}
+	return result
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+//Type implements the Expr interface
+func (c *CastExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return c.Typ, nil
+}
+
+//String implements the Expr interface
+func (c *CastExpr) String() string {
+	return "cast(" + c.Inner.String() + " as " + strings.ToLower(c.Typ.String()) + ")"
+}
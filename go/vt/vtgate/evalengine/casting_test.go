@@ -83,3 +83,30 @@ func TestEvalResultToBooleanStrict(t *testing.T) {
 		})
 	}
 }
+
+func TestToDecimal(t *testing.T) {
+	tcases := []struct {
+		in    string
+		scale int
+		out   string
+	}{
+		{"3.14159", 2, "3.14"},
+		{"12345678901234567.891", 2, "12345678901234567.89"},
+		{"99.995", 2, "100.00"},
+		{"-99.995", 2, "-100.00"},
+		{"-0.001", 2, "0.00"},
+		{".5", 0, "1"},
+		{"42", 3, "42.000"},
+		{"007.10", 1, "7.1"},
+		{"1.5e3", 1, "1500.0"},
+		{"125e-2", 1, "1.3"},
+		{"  42abc", 0, "42"},
+		{"abc", 2, "0.00"},
+		{"18446744073709551615", 0, "18446744073709551615"},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			require.Equal(t, tcase.out, string(toDecimal([]byte(tcase.in), tcase.scale)))
+		})
+	}
+}
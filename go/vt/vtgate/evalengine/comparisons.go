@@ -0,0 +1,550 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// ComparisonExpr represents a two-value comparison such as `a = b` or `a < b`
+	ComparisonExpr struct {
+		Op          ComparisonOp
+		Left, Right Expr
+	}
+
+	// ComparisonOp allows comparison expressions to not have to evaluate child expressions - this is done by the ComparisonExpr
+	ComparisonOp interface {
+		Evaluate(left, right EvalResult) (EvalResult, error)
+		String() string
+	}
+
+	// InExpr represents `a IN (b, c, ...)` and `a NOT IN (b, c, ...)`
+	InExpr struct {
+		Left   Expr
+		Right  TupleExpr
+		Negate bool
+	}
+
+	// TupleExpr is the right hand side of an IN expression
+	TupleExpr interface {
+		EvaluateTuple(env ExpressionEnv) ([]EvalResult, error)
+		String() string
+	}
+
+	// Tuple is a list of expressions, such as `(1, 2, :a)`
+	Tuple []Expr
+
+	// ListBindVariable is a bind variable that contains a list of values, such as `::vals`
+	ListBindVariable struct{ Key string }
+
+	// LikeExpr represents `a LIKE b [ESCAPE c]` and `a NOT LIKE b [ESCAPE c]`
+	LikeExpr struct {
+		Left, Right Expr
+		// Escape is the escape character for the pattern. A nil Escape means the default escape character '\'
+		Escape Expr
+		Negate bool
+	}
+
+	// IsExpr represents `a IS [NOT] NULL` and `a IS [NOT] {TRUE|FALSE}`
+	IsExpr struct {
+		Inner Expr
+		Op    IsOperator
+	}
+
+	// IsOperator is the check performed by an IsExpr
+	IsOperator int8
+
+	// Comparison ops
+	EqualOp         struct{}
+	NotEqualOp      struct{}
+	NullSafeEqualOp struct{}
+	LessThanOp      struct{}
+	LessEqualOp     struct{}
+	GreaterThanOp   struct{}
+	GreaterEqualOp  struct{}
+)
+
+// Constants for IsOperator
+const (
+	IsNull IsOperator = iota
+	IsNotNull
+	IsTrue
+	IsNotTrue
+	IsFalse
+	IsNotFalse
+)
+
+var _ Expr = (*ComparisonExpr)(nil)
+var _ Expr = (*InExpr)(nil)
+var _ Expr = (*LikeExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+
+var _ TupleExpr = (Tuple)(nil)
+var _ TupleExpr = (*ListBindVariable)(nil)
+
+var _ ComparisonOp = (*EqualOp)(nil)
+var _ ComparisonOp = (*NotEqualOp)(nil)
+var _ ComparisonOp = (*NullSafeEqualOp)(nil)
+var _ ComparisonOp = (*LessThanOp)(nil)
+var _ ComparisonOp = (*LessEqualOp)(nil)
+var _ ComparisonOp = (*GreaterThanOp)(nil)
+var _ ComparisonOp = (*GreaterEqualOp)(nil)
+
+// compareEvalResults compares two values following the MySQL rules for comparisons:
+// if either value is numeric, both values are compared as numbers, otherwise they are
+// compared as strings, see compareText. The second return value is true if either of
+// the values is NULL, in which case the result of the comparison is unknown.
+func compareEvalResults(left, right EvalResult) (int, bool, error) {
+	if left.isNull() || right.isNull() {
+		return 0, true, nil
+	}
+	if left.isNumeric() || right.isNumeric() {
+		cmp, err := compareNumeric(left.toNumeric(), right.toNumeric())
+		return cmp, false, err
+	}
+	if left.typ == sqltypes.VarBinary && right.typ == sqltypes.VarBinary {
+		cmp, err := compareText(left, right)
+		return cmp, false, err
+	}
+	return bytes.Compare(left.bytes, right.bytes), false, nil
+}
+
+// DefaultCollation is the collation of the text values that have no collation of
+// their own, like literals, when they are compared with each other. It is the
+// collation of the connections to vtgate, utf8_general_ci.
+var DefaultCollation = collations.LookupByName("utf8_general_ci").ID()
+
+// compareText compares two strings. If either of them is a binary string, they are
+// compared byte by byte. Otherwise they are compared with their collation, see
+// textCollation.
+func compareText(left, right EvalResult) (int, error) {
+	coll, err := textCollation(left, right)
+	if err != nil {
+		return 0, err
+	}
+	if coll == nil {
+		return bytes.Compare(left.bytes, right.bytes), nil
+	}
+	return coll.Collate(left.bytes, right.bytes), nil
+}
+
+// textCollation returns the collation to compare two strings with, or nil if either
+// of them is a binary string. When only one of the values has a known collation,
+// like a column compared with a literal, its collation is used for both. When
+// neither has one, like two literals, they use DefaultCollation.
+func textCollation(left, right EvalResult) (collations.Collation, error) {
+	if left.collation == collations.Binary || right.collation == collations.Binary {
+		return nil, nil
+	}
+	id := left.collation
+	if id == collations.Unknown {
+		id = right.collation
+	} else if right.collation != collations.Unknown && right.collation != id {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot compare text values with different collations: %d and %d", left.collation, right.collation)
+	}
+	if id == collations.Unknown {
+		id = DefaultCollation
+	}
+	coll := collations.LookupByID(id)
+	if coll == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "cannot compare text values with the unsupported collation %d", id)
+	}
+	return coll, nil
+}
+
+func evaluateComparison(left, right EvalResult, test func(cmp int) bool) (EvalResult, error) {
+	cmp, isNull, err := compareEvalResults(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isNull {
+		return resultNull, nil
+	}
+	return newEvalBool(test(cmp)), nil
+}
+
+//Evaluate implements the ComparisonOp interface
+func (e *EqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+//Evaluate implements the ComparisonOp interface
+func (n *NotEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, func(cmp int) bool { return cmp != 0 })
+}
+
+//Evaluate implements the ComparisonOp interface
+func (n *NullSafeEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return newEvalBool(left.isNull() && right.isNull()), nil
+	}
+	return evaluateComparison(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+//Evaluate implements the ComparisonOp interface
+func (l *LessThanOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, func(cmp int) bool { return cmp < 0 })
+}
+
+//Evaluate implements the ComparisonOp interface
+func (l *LessEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, func(cmp int) bool { return cmp <= 0 })
+}
+
+//Evaluate implements the ComparisonOp interface
+func (g *GreaterThanOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, func(cmp int) bool { return cmp > 0 })
+}
+
+//Evaluate implements the ComparisonOp interface
+func (g *GreaterEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return evaluateComparison(left, right, func(cmp int) bool { return cmp >= 0 })
+}
+
+//String implements the ComparisonOp interface
+func (e *EqualOp) String() string {
+	return "="
+}
+
+//String implements the ComparisonOp interface
+func (n *NotEqualOp) String() string {
+	return "!="
+}
+
+//String implements the ComparisonOp interface
+func (n *NullSafeEqualOp) String() string {
+	return "<=>"
+}
+
+//String implements the ComparisonOp interface
+func (l *LessThanOp) String() string {
+	return "<"
+}
+
+//String implements the ComparisonOp interface
+func (l *LessEqualOp) String() string {
+	return "<="
+}
+
+//String implements the ComparisonOp interface
+func (g *GreaterThanOp) String() string {
+	return ">"
+}
+
+//String implements the ComparisonOp interface
+func (g *GreaterEqualOp) String() string {
+	return ">="
+}
+
+//Evaluate implements the Expr interface
+func (c *ComparisonExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	lVal, err := c.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	rVal, err := c.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return c.Op.Evaluate(lVal, rVal)
+}
+
+//Type implements the Expr interface
+func (c *ComparisonExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (c *ComparisonExpr) String() string {
+	return c.Left.String() + " " + c.Op.String() + " " + c.Right.String()
+}
+
+//EvaluateTuple implements the TupleExpr interface
+func (t Tuple) EvaluateTuple(env ExpressionEnv) ([]EvalResult, error) {
+	result := make([]EvalResult, 0, len(t))
+	for _, expr := range t {
+		val, err := expr.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, val)
+	}
+	return result, nil
+}
+
+//String implements the TupleExpr interface
+func (t Tuple) String() string {
+	exprs := make([]string, 0, len(t))
+	for _, expr := range t {
+		exprs = append(exprs, expr.String())
+	}
+	return "(" + strings.Join(exprs, ", ") + ")"
+}
+
+//EvaluateTuple implements the TupleExpr interface
+func (l *ListBindVariable) EvaluateTuple(env ExpressionEnv) ([]EvalResult, error) {
+	val, ok := env.BindVars[l.Key]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "Bind variable not found")
+	}
+	if val.Type != querypb.Type_TUPLE {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "query argument '%s' must be a tuple, got %s", l.Key, val.Type.String())
+	}
+	result := make([]EvalResult, 0, len(val.Values))
+	for _, value := range val.Values {
+		res, err := evaluateByType(&querypb.BindVariable{Type: value.Type, Value: value.Value})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, res)
+	}
+	return result, nil
+}
+
+//String implements the TupleExpr interface
+func (l *ListBindVariable) String() string {
+	return "::" + l.Key
+}
+
+//Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.isNull() {
+		return resultNull, nil
+	}
+	right, err := i.Right.EvaluateTuple(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	foundNull := false
+	for _, val := range right {
+		cmp, isNull, err := compareEvalResults(left, val)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if isNull {
+			foundNull = true
+			continue
+		}
+		if cmp == 0 {
+			return newEvalBool(!i.Negate), nil
+		}
+	}
+	// when no value matches, the result is unknown if the list contained a NULL
+	if foundNull {
+		return resultNull, nil
+	}
+	return newEvalBool(i.Negate), nil
+}
+
+//Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (i *InExpr) String() string {
+	if i.Negate {
+		return i.Left.String() + " not in " + i.Right.String()
+	}
+	return i.Left.String() + " in " + i.Right.String()
+}
+
+//Evaluate implements the Expr interface
+func (l *LikeExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := l.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := l.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	escape := '\\'
+	if l.Escape != nil {
+		esc, err := l.Escape.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		escBytes := esc.toRawBytes()
+		switch utf8.RuneCount(escBytes) {
+		case 0:
+			// an empty escape string disables escaping
+			escape = utf8.RuneError
+		case 1:
+			escape, _ = utf8.DecodeRune(escBytes)
+		default:
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to ESCAPE")
+		}
+	}
+	coll, err := textCollation(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	match := likeMatch(string(left.toRawBytes()), string(right.toRawBytes()), escape, coll)
+	return newEvalBool(match != l.Negate), nil
+}
+
+//Type implements the Expr interface
+func (l *LikeExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (l *LikeExpr) String() string {
+	op := " like "
+	if l.Negate {
+		op = " not like "
+	}
+	str := l.Left.String() + op + l.Right.String()
+	if l.Escape != nil {
+		str += " escape " + l.Escape.String()
+	}
+	return str
+}
+
+// likeMatch reports whether str matches the LIKE pattern. `%` matches any
+// sequence of characters, `_` matches exactly one character, and the escape
+// character makes the following character match literally. The characters
+// are compared with coll, or code point by code point if coll is nil.
+func likeMatch(str, pattern string, escape rune, coll collations.Collation) bool {
+	s := []rune(str)
+	p := []rune(pattern)
+
+	// the position in the pattern and the string just after the last `%`,
+	// so we can backtrack when a literal match fails
+	starP, starS := -1, -1
+	si, pi := 0, 0
+	for si < len(s) {
+		if pi < len(p) {
+			switch c := p[pi]; {
+			case c == '%':
+				starP, starS = pi, si
+				pi++
+				continue
+			case c == '_':
+				si++
+				pi++
+				continue
+			default:
+				next := pi + 1
+				if c == escape && next < len(p) {
+					c = p[next]
+					next++
+				}
+				if likeCharsEqual(c, s[si], coll) {
+					si++
+					pi = next
+					continue
+				}
+			}
+		}
+		if starP < 0 {
+			return false
+		}
+		// let the last `%` consume one more character and try again
+		starS++
+		si = starS
+		pi = starP + 1
+	}
+	for pi < len(p) && p[pi] == '%' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+func likeCharsEqual(a, b rune, coll collations.Collation) bool {
+	if a == b {
+		return true
+	}
+	if coll == nil {
+		return false
+	}
+	var abuf, bbuf [utf8.UTFMax]byte
+	an := utf8.EncodeRune(abuf[:], a)
+	bn := utf8.EncodeRune(bbuf[:], b)
+	return coll.Collate(abuf[:an], bbuf[:bn]) == 0
+}
+
+//Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	truth, isNull := val.truthValue()
+	switch i.Op {
+	case IsNull:
+		return newEvalBool(isNull), nil
+	case IsNotNull:
+		return newEvalBool(!isNull), nil
+	case IsTrue:
+		return newEvalBool(!isNull && truth), nil
+	case IsNotTrue:
+		return newEvalBool(isNull || !truth), nil
+	case IsFalse:
+		return newEvalBool(!isNull && !truth), nil
+	case IsNotFalse:
+		return newEvalBool(isNull || truth), nil
+	}
+	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unknown IS operator: %d", i.Op)
+}
+
+//Type implements the Expr interface
+func (i *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (i *IsExpr) String() string {
+	return i.Inner.String() + " " + i.Op.String()
+}
+
+// String returns the SQL representation of the check
+func (op IsOperator) String() string {
+	switch op {
+	case IsNull:
+		return "is null"
+	case IsNotNull:
+		return "is not null"
+	case IsTrue:
+		return "is true"
+	case IsNotTrue:
+		return "is not true"
+	case IsFalse:
+		return "is false"
+	case IsNotFalse:
+		return "is not false"
+	}
+	return "is <unknown>"
+}
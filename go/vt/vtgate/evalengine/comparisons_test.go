@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestLikeMatch(t *testing.T) {
+	tests := []struct {
+		str, pattern string
+		escape       rune
+		match        bool
+	}{
+		{"", "", '\\', true},
+		{"", "%", '\\', true},
+		{"", "_", '\\', false},
+		{"abc", "abc", '\\', true},
+		{"abc", "ab", '\\', false},
+		{"abc", "a%", '\\', true},
+		{"abc", "%c", '\\', true},
+		{"abc", "%b%", '\\', true},
+		{"abc", "a%b%c", '\\', true},
+		{"abc", "a%%%", '\\', true},
+		{"aXbXc", "%X%X%", '\\', true},
+		{"aXbXc", "%X%X%X%", '\\', false},
+		{"abc", "___", '\\', true},
+		{"abc", "__", '\\', false},
+		{"héllo", "h_llo", '\\', true},
+		{"a%c", `a\%c`, '\\', true},
+		{"abc", `a\%c`, '\\', false},
+		{"a_c", `a\_c`, '\\', true},
+		{"abc", `a\_c`, '\\', false},
+		{"a%c", "a#%c", '#', true},
+		{`a\`, `a\`, '\\', true},
+		{"mississippi", "m%iss%ppi", '\\', true},
+		{"mississippi", "m%iss%ppix", '\\', false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.str+" like "+tc.pattern, func(t *testing.T) {
+			assert.Equal(t, tc.match, likeMatch(tc.str, tc.pattern, tc.escape, nil))
+		})
+	}
+}
+
+func TestCompareEvalResults(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right sqltypes.Value
+		cmp         int
+		isNull      bool
+	}{
+		{"ints", sqltypes.NewInt64(1), sqltypes.NewInt64(2), -1, false},
+		{"int and uint", sqltypes.NewInt64(-1), sqltypes.NewUint64(1), -1, false},
+		{"int and float", sqltypes.NewInt64(2), sqltypes.NewFloat64(1.5), 1, false},
+		{"int and numeric string", sqltypes.NewInt64(10), sqltypes.NewVarChar("10"), 0, false},
+		{"int and string with numeric prefix", sqltypes.NewInt64(12), sqltypes.NewVarChar("12abc"), 0, false},
+		{"int and non-numeric string", sqltypes.NewInt64(0), sqltypes.NewVarChar("abc"), 0, false},
+		{"binary strings", sqltypes.NewVarBinary("10"), sqltypes.NewVarBinary("2"), -1, false},
+		{"binary string and text", sqltypes.NewVarBinary("a"), sqltypes.NewVarChar("A"), 1, false},
+		{"null", sqltypes.NULL, sqltypes.NewInt64(1), 0, true},
+		{"dates", sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-01-01")), sqltypes.NewVarChar("2020-12-31"), 1, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			left, err := newEvalResult(tc.left)
+			require.NoError(t, err)
+			right, err := newEvalResult(tc.right)
+			require.NoError(t, err)
+			cmp, isNull, err := compareEvalResults(left, right)
+			require.NoError(t, err)
+			assert.Equal(t, tc.isNull, isNull)
+			if !isNull {
+				assert.Equal(t, tc.cmp, cmp)
+			}
+		})
+	}
+}
+
+func TestParseFloatPrefix(t *testing.T) {
+	tests := map[string]float64{
+		"":        0,
+		"abc":     0,
+		"12":      12,
+		"12abc":   12,
+		"-1.5e2x": -150,
+		"1e":      1,
+		".5":      0.5,
+		"+3.":     3,
+		"-":       0,
+	}
+	for str, expected := range tests {
+		assert.Equal(t, expected, parseFloatPrefix(str), str)
+	}
+}
+
+func TestCompareText(t *testing.T) {
+	ci := collations.LookupByName("utf8mb4_general_ci").ID()
+	bin := collations.LookupByName("utf8mb4_bin").ID()
+	tests := []struct {
+		name        string
+		left, right EvalResult
+		cmp         int
+		err         string
+	}{
+		{"case insensitive", EvalResult{typ: sqltypes.VarBinary, collation: ci, bytes: []byte("a")}, newEvalText([]byte("A")), 0, ""},
+		{"case sensitive", newEvalText([]byte("a")), EvalResult{typ: sqltypes.VarBinary, collation: bin, bytes: []byte("A")}, 1, ""},
+		{"binary", EvalResult{typ: sqltypes.VarBinary, collation: ci, bytes: []byte("a")}, EvalResult{typ: sqltypes.VarBinary, collation: collations.Binary, bytes: []byte("A")}, 1, ""},
+		{"different collations", EvalResult{typ: sqltypes.VarBinary, collation: ci, bytes: []byte("a")}, EvalResult{typ: sqltypes.VarBinary, collation: bin, bytes: []byte("a")}, 0, "cannot compare text values with different collations: 45 and 46"},
+		{"literals use the default collation", newEvalText([]byte("a")), newEvalText([]byte("A")), 0, ""},
+		{"unsupported collation", EvalResult{typ: sqltypes.VarBinary, collation: 1000, bytes: []byte("a")}, newEvalText([]byte("a")), 0, "cannot compare text values with the unsupported collation 1000"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmp, isNull, err := compareEvalResults(tc.left, tc.right)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, isNull)
+			assert.Equal(t, tc.cmp, cmp)
+		})
+	}
+}
+
+func TestColumnCollation(t *testing.T) {
+	ci := collations.LookupByName("utf8mb4_general_ci").ID()
+	expr := &ComparisonExpr{Op: &EqualOp{}, Left: NewTypedColumn(0, sqltypes.VarChar, ci), Right: NewLiteralString([]byte("ABC"))}
+	result, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewVarChar("abc")}})
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(1), result.Value())
+
+	// the columns without a collation use the collation of their field
+	fields := sqltypes.MakeTestFields("a", "varchar")
+	fields[0].Charset = uint32(collations.LookupByName("utf8mb4_bin").ID())
+	expr = &ComparisonExpr{Op: &EqualOp{}, Left: NewColumn(0), Right: NewLiteralString([]byte("ABC"))}
+	result, err = expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewVarChar("abc")}, Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(0), result.Value())
+	expr = &ComparisonExpr{Op: &EqualOp{}, Left: NewTypedColumn(0, sqltypes.VarChar, ci), Right: NewLiteralString([]byte("ABC"))}
+
+	// binary columns are compared byte by byte whatever the collation of the column
+	result, err = expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewVarBinary("abc")}})
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(0), result.Value())
+}
+
+func TestLikeCollation(t *testing.T) {
+	ci := collations.LookupByName("utf8mb4_general_ci").ID()
+	bin := collations.LookupByName("utf8mb4_bin").ID()
+	tests := []struct {
+		name    string
+		left    Expr
+		pattern string
+		row     sqltypes.Value
+		match   int64
+	}{
+		{"case insensitive column", NewTypedColumn(0, sqltypes.VarChar, ci), "ABC%", sqltypes.NewVarChar("abcd"), 1},
+		{"accent insensitive column", NewTypedColumn(0, sqltypes.VarChar, ci), "h_llo", sqltypes.NewVarChar("Héllo"), 1},
+		{"case sensitive column", NewTypedColumn(0, sqltypes.VarChar, bin), "ABC%", sqltypes.NewVarChar("abcd"), 0},
+		{"binary column", NewTypedColumn(0, sqltypes.VarBinary, ci), "ABC%", sqltypes.NewVarBinary("abcd"), 0},
+		{"literals", NewLiteralString([]byte("abcd")), "ABC%", sqltypes.NULL, 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			expr := &LikeExpr{Left: tc.left, Right: NewLiteralString([]byte(tc.pattern))}
+			result, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{tc.row}})
+			require.NoError(t, err)
+			assert.Equal(t, sqltypes.NewInt64(tc.match), result.Value())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// The layouts accepted for DATE, DATETIME and TIME values. As in MySQL,
+// a value that cannot be parsed makes the date functions return NULL.
+var (
+	datetimeLayouts = []string{
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02",
+		"20060102150405",
+		"20060102",
+	}
+	timeLayouts = []string{
+		"15:04:05.999999999",
+		"15:04",
+	}
+)
+
+// parseDatetime parses a DATE or DATETIME value. The second return value is false
+// if the value is not a valid date.
+func parseDatetime(val EvalResult) (time.Time, bool) {
+	str := strings.TrimSpace(string(val.toRawBytes()))
+	for _, layout := range datetimeLayouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseTime parses a TIME value, falling back to the time part of a DATETIME
+func parseTime(val EvalResult) (time.Time, bool) {
+	str := strings.TrimSpace(string(val.toRawBytes()))
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			return t, true
+		}
+	}
+	return parseDatetime(val)
+}
+
+// dateField returns a function that extracts an integer field from a DATE or DATETIME value
+func dateField(field func(t time.Time) int) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		t, ok := parseDatetime(args[0])
+		if !ok {
+			return resultNull, nil
+		}
+		return newEvalInt64(int64(field(t))), nil
+	}
+}
+
+// timeField returns a function that extracts an integer field from a TIME or DATETIME value
+func timeField(field func(t time.Time) int) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		t, ok := parseTime(args[0])
+		if !ok {
+			return resultNull, nil
+		}
+		return newEvalInt64(int64(field(t))), nil
+	}
+}
+
+var (
+	builtinYear       = dateField(time.Time.Year)
+	builtinDayOfMonth = dateField(time.Time.Day)
+	builtinDayOfYear  = dateField(time.Time.YearDay)
+	builtinMonth      = dateField(func(t time.Time) int { return int(t.Month()) })
+	builtinQuarter    = dateField(func(t time.Time) int { return (int(t.Month())-1)/3 + 1 })
+	// DAYOFWEEK() returns 1 for Sunday, while WEEKDAY() returns 0 for Monday
+	builtinDayOfWeek = dateField(func(t time.Time) int { return int(t.Weekday()) + 1 })
+	builtinWeekday   = dateField(func(t time.Time) int { return (int(t.Weekday()) + 6) % 7 })
+
+	builtinHour   = timeField(time.Time.Hour)
+	builtinMinute = timeField(time.Time.Minute)
+	builtinSecond = timeField(time.Time.Second)
+)
+
+func builtinDate(args []EvalResult) (EvalResult, error) {
+	t, ok := parseDatetime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	return EvalResult{typ: sqltypes.Date, bytes: []byte(t.Format("2006-01-02"))}, nil
+}
+
+func builtinDateDiff(args []EvalResult) (EvalResult, error) {
+	t1, ok := parseDatetime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	t2, ok := parseDatetime(args[1])
+	if !ok {
+		return resultNull, nil
+	}
+	// only the date parts of the values are used in the calculation
+	d1 := time.Date(t1.Year(), t1.Month(), t1.Day(), 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(t2.Year(), t2.Month(), t2.Day(), 0, 0, 0, 0, time.UTC)
+	return newEvalInt64(int64(d1.Sub(d2).Hours() / 24)), nil
+}
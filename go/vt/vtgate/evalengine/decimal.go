@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math/big"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// decimal is an exact fixed-point number, like the values of the DECIMAL
+// type: its value is unscaled * 10^-scale.
+type decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// newDecimal parses the textual representation of a DECIMAL or an integer
+// value. The scale of the result is the number of digits after its point.
+func newDecimal(v sqltypes.Value) (decimal, error) {
+	if !v.IsIntegral() && v.Type() != sqltypes.Decimal {
+		return decimal{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "not an exact numeric value: %v", v)
+	}
+	str := v.ToString()
+	digits, frac := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		digits, frac = str[:i], str[i+1:]
+	}
+	unscaled, ok := new(big.Int).SetString(digits+frac, 10)
+	if !ok {
+		return decimal{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not parse value: '%s'", str)
+	}
+	return decimal{unscaled: unscaled, scale: len(frac)}, nil
+}
+
+// rescale returns d with the given scale. Digits are added, never removed.
+func (d decimal) rescale(scale int) decimal {
+	if scale <= d.scale {
+		return d
+	}
+	unscaled := new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+	return decimal{unscaled: unscaled, scale: scale}
+}
+
+func (d decimal) add(other decimal) decimal {
+	scale := d.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	left, right := d.rescale(scale), other.rescale(scale)
+	return decimal{unscaled: new(big.Int).Add(left.unscaled, right.unscaled), scale: scale}
+}
+
+// div divides d by n. The result has the given scale, and is rounded
+// half away from zero like MySQL does.
+func (d decimal) div(n int64, scale int) decimal {
+	// compute one more digit than needed to round the result
+	num := new(big.Int).Mul(d.unscaled, pow10(scale+1-d.scale))
+	quo := num.Quo(num, big.NewInt(n))
+	rem := new(big.Int)
+	quo.QuoRem(quo, big.NewInt(10), rem)
+	switch {
+	case rem.Cmp(big.NewInt(5)) >= 0:
+		quo.Add(quo, big.NewInt(1))
+	case rem.Cmp(big.NewInt(-5)) <= 0:
+		quo.Sub(quo, big.NewInt(1))
+	}
+	return decimal{unscaled: quo, scale: scale}
+}
+
+func (d decimal) toSQLValue() sqltypes.Value {
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		digits = "-" + digits
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(digits))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// addDecimals adds two exact numeric values without losing precision.
+// It returns false if either of them is not an exact numeric value.
+func addDecimals(v1, v2 sqltypes.Value) (sqltypes.Value, bool) {
+	d1, err := newDecimal(v1)
+	if err != nil {
+		return sqltypes.NULL, false
+	}
+	d2, err := newDecimal(v2)
+	if err != nil {
+		return sqltypes.NULL, false
+	}
+	return d1.add(d2).toSQLValue(), true
+}
+
+// DivideDecimal divides an exact numeric value by n and returns a DECIMAL.
+// The scale of the result is the scale of the value plus scaleIncrement, like
+// MySQL does with div_precision_increment. The result is rounded half away
+// from zero.
+func DivideDecimal(v sqltypes.Value, n int64, scaleIncrement int) (sqltypes.Value, error) {
+	if v.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	if n == 0 {
+		return sqltypes.NULL, nil
+	}
+	d, err := newDecimal(v)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return d.div(n, d.scale+scaleIncrement).toSQLValue(), nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func decimalValue(str string) sqltypes.Value {
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(str))
+}
+
+func TestNullsafeAddDecimal(t *testing.T) {
+	tcases := []struct {
+		v1, v2 sqltypes.Value
+		out    string
+	}{{
+		v1:  decimalValue("0.10"),
+		v2:  decimalValue("0.20"),
+		out: "0.30",
+	}, {
+		v1:  decimalValue("1.5"),
+		v2:  decimalValue("-2.25"),
+		out: "-0.75",
+	}, {
+		v1:  decimalValue("123456789012345678901234567890.1"),
+		v2:  decimalValue("0.01"),
+		out: "123456789012345678901234567890.11",
+	}, {
+		v1:  sqltypes.NULL,
+		v2:  decimalValue("3.5"),
+		out: "3.5",
+	}, {
+		v1:  sqltypes.NewInt64(2),
+		v2:  decimalValue("3.5"),
+		out: "5.5",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.v1.String()+"+"+tcase.v2.String(), func(t *testing.T) {
+			got := NullsafeAdd(tcase.v1, tcase.v2, sqltypes.Decimal)
+			assert.Equal(t, decimalValue(tcase.out), got)
+		})
+	}
+}
+
+func TestDivideDecimal(t *testing.T) {
+	tcases := []struct {
+		v         sqltypes.Value
+		n         int64
+		increment int
+		out       sqltypes.Value
+	}{{
+		v:         decimalValue("10.00"),
+		n:         3,
+		increment: 4,
+		out:       decimalValue("3.333333"),
+	}, {
+		v:         decimalValue("20.00"),
+		n:         3,
+		increment: 4,
+		out:       decimalValue("6.666667"),
+	}, {
+		v:         decimalValue("-20.00"),
+		n:         3,
+		increment: 4,
+		out:       decimalValue("-6.666667"),
+	}, {
+		v:         decimalValue("15"),
+		n:         4,
+		increment: 4,
+		out:       decimalValue("3.7500"),
+	}, {
+		v:         decimalValue("0.05"),
+		n:         1000,
+		increment: 4,
+		out:       decimalValue("0.000050"),
+	}, {
+		v:         decimalValue("1"),
+		n:         0,
+		increment: 4,
+		out:       sqltypes.NULL,
+	}, {
+		v:         sqltypes.NULL,
+		n:         2,
+		increment: 4,
+		out:       sqltypes.NULL,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.v.String(), func(t *testing.T) {
+			got, err := DivideDecimal(tcase.v, tcase.n, tcase.increment)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+		})
+	}
+
+	_, err := DivideDecimal(sqltypes.NewFloat64(1.5), 2, 4)
+	require.Error(t, err)
+}
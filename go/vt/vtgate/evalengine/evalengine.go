@@ -17,6 +17,7 @@ limitations under the License.
 package evalengine
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	"strconv"
@@ -117,6 +118,21 @@ func ToFloat64(v sqltypes.Value) (float64, error) {
 	return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "cannot convert to float: %s", v.String())
 }
 
+// ToNumeric converts Value to the number it's compared as when it's compared
+// with a number: an INT64, a UINT64 or a FLOAT64 value. Like in MySQL, strings
+// are converted to the number they start with, or 0.
+func ToNumeric(v sqltypes.Value) (sqltypes.Value, error) {
+	if v.IsNull() {
+		return v, nil
+	}
+	num, err := newEvalResult(v)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	num = num.toNumeric()
+	return num.toSQLValue(num.typ), nil
+}
+
 // ToNative converts Value to a native go type.
 // Decimal is returned as []byte.
 func ToNative(v sqltypes.Value) (interface{}, error) {
@@ -143,7 +159,9 @@ func ToNative(v sqltypes.Value) (interface{}, error) {
 func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 	raw := v.Raw()
 	switch {
-	case v.IsBinary() || v.IsText():
+	case v.IsBinary():
+		return EvalResult{bytes: raw, typ: sqltypes.VarBinary, collation: collations.Binary}, nil
+	case v.IsText():
 		return EvalResult{bytes: raw, typ: sqltypes.VarBinary}, nil
 	case v.IsSigned():
 		ival, err := strconv.ParseInt(string(raw), 10, 64)
@@ -228,6 +246,8 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 				format = 'f'
 			}
 			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, float64(v.fval), format, -1, 64))
+		case sqltypes.Decimal:
+			return sqltypes.MakeTrusted(resultType, v.bytes)
 		}
 	default:
 		return sqltypes.MakeTrusted(resultType, v.bytes)
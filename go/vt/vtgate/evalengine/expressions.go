@@ -18,8 +18,11 @@ package evalengine
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -29,17 +32,24 @@ import (
 
 type (
 	EvalResult struct {
-		typ   querypb.Type
-		ival  int64
-		uval  uint64
-		fval  float64
-		bytes []byte
+		typ querypb.Type
+		// collation is the collation of a text value: Binary for binary
+		// strings, Unknown if the value is text with an unknown collation
+		collation collations.ID
+		ival      int64
+		uval      uint64
+		fval      float64
+		bytes     []byte
 	}
 	//ExpressionEnv contains the environment that the expression
 	//evaluates in, such as the current row and bindvars
 	ExpressionEnv struct {
 		BindVars map[string]*querypb.BindVariable
 		Row      []sqltypes.Value
+		// Fields are the fields of Row, if they are known. The text columns
+		// whose collation was not known when planning use the collation of
+		// their field.
+		Fields []*querypb.Field
 	}
 
 	// Expr is the interface that all evaluating expressions must implement
@@ -59,8 +69,14 @@ type (
 	// Expressions
 	Literal      struct{ Val EvalResult }
 	BindVariable struct{ Key string }
-	Column       struct{ Offset int }
-	BinaryOp     struct {
+	Column       struct {
+		Offset int
+		// Typ is the type of the column, if it is known
+		Typ querypb.Type
+		// Collation is the collation of a text column, if it is known
+		Collation collations.ID
+	}
+	BinaryOp struct {
 		Expr        BinaryExpr
 		Left, Right Expr
 	}
@@ -72,6 +88,9 @@ type (
 	Division       struct{}
 )
 
+// resultNull is the EvalResult for a SQL NULL
+var resultNull = EvalResult{typ: sqltypes.Null}
+
 //Value allows for retrieval of the value we expose for public consumption
 func (e EvalResult) Value() sqltypes.Value {
 	return e.toSQLValue(e.typ)
@@ -100,11 +119,16 @@ func NewLiteralFloat(val []byte) (Expr, error) {
 	return &Literal{EvalResult{typ: sqltypes.Float64, fval: fval}}, nil
 }
 
-//NewLiteralFloat returns a literal expression
+//NewLiteralString returns a literal expression
 func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
 
+//NewLiteralNull returns a literal NULL expression
+func NewLiteralNull() Expr {
+	return &Literal{resultNull}
+}
+
 //NewBindVar returns a bind variable
 func NewBindVar(key string) Expr {
 	return &BindVariable{Key: key}
@@ -117,6 +141,15 @@ func NewColumn(offset int) Expr {
 	}
 }
 
+//NewTypedColumn returns a column of the given type and collation
+func NewTypedColumn(offset int, typ querypb.Type, collation collations.ID) Expr {
+	return &Column{
+		Offset:    offset,
+		Typ:       typ,
+		Collation: collation,
+	}
+}
+
 var _ Expr = (*Literal)(nil)
 var _ Expr = (*BindVariable)(nil)
 var _ Expr = (*BinaryOp)(nil)
@@ -157,8 +190,14 @@ func (b *BindVariable) Evaluate(env ExpressionEnv) (EvalResult, error) {
 //Evaluate implements the Expr interface
 func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	value := env.Row[c.Offset]
-	numeric, err := newEvalResult(value)
-	return numeric, err
+	result, err := newEvalResult(value)
+	if err == nil && value.IsText() {
+		result.collation = c.Collation
+		if result.collation == collations.Unknown && c.Offset < len(env.Fields) {
+			result.collation = collations.ID(env.Fields[c.Offset].Charset)
+		}
+	}
+	return result, err
 }
 
 //Evaluate implements the BinaryOp interface
@@ -232,6 +271,9 @@ func (l *Literal) Type(ExpressionEnv) (querypb.Type, error) {
 
 //Type implements the Expr interface
 func (c *Column) Type(ExpressionEnv) (querypb.Type, error) {
+	if c.Typ != sqltypes.Null {
+		return c.Typ, nil
+	}
 	return sqltypes.Float64, nil
 }
 
@@ -315,14 +357,174 @@ func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 			fval = 0
 		}
 		return EvalResult{typ: sqltypes.Float64, fval: fval}, nil
-	case sqltypes.VarChar, sqltypes.Text, sqltypes.VarBinary:
+	case sqltypes.VarChar, sqltypes.Text:
 		return EvalResult{typ: sqltypes.VarBinary, bytes: val.Value}, nil
+	case sqltypes.VarBinary:
+		return EvalResult{typ: sqltypes.VarBinary, collation: collations.Binary, bytes: val.Value}, nil
 	case sqltypes.Null:
 		return EvalResult{typ: sqltypes.Null}, nil
 	}
 	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "Type is not supported: %s", val.Type.String())
 }
 
+func newEvalBool(b bool) EvalResult {
+	if b {
+		return EvalResult{typ: sqltypes.Int64, ival: 1}
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: 0}
+}
+
+func newEvalInt64(i int64) EvalResult {
+	return EvalResult{typ: sqltypes.Int64, ival: i}
+}
+
+func newEvalFloat(f float64) EvalResult {
+	return EvalResult{typ: sqltypes.Float64, fval: f}
+}
+
+func newEvalText(b []byte) EvalResult {
+	return EvalResult{typ: sqltypes.VarBinary, bytes: b}
+}
+
+func (e *EvalResult) isNull() bool {
+	return e.typ == sqltypes.Null
+}
+
+func (e *EvalResult) isNumeric() bool {
+	return sqltypes.IsNumber(e.typ)
+}
+
+// toNumeric returns the value as an Int64, Uint64 or Float64 EvalResult.
+// Strings are converted the way MySQL converts them in a numeric context:
+// the longest numeric prefix is used and anything else evaluates to 0.
+func (e *EvalResult) toNumeric() EvalResult {
+	switch {
+	case e.isNull():
+		return resultNull
+	case sqltypes.IsSigned(e.typ):
+		return newEvalInt64(e.ival)
+	case sqltypes.IsUnsigned(e.typ):
+		return EvalResult{typ: sqltypes.Uint64, uval: e.uval}
+	case sqltypes.IsFloat(e.typ):
+		return newEvalFloat(e.fval)
+	}
+	str := strings.TrimSpace(string(e.bytes))
+	if ival, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return newEvalInt64(ival)
+	}
+	if uval, err := strconv.ParseUint(str, 10, 64); err == nil {
+		return EvalResult{typ: sqltypes.Uint64, uval: uval}
+	}
+	return newEvalFloat(parseFloatPrefix(str))
+}
+
+// toFloat returns the numeric value of the result as a float64
+func (e *EvalResult) toFloat() float64 {
+	num := e.toNumeric()
+	switch num.typ {
+	case sqltypes.Int64:
+		return float64(num.ival)
+	case sqltypes.Uint64:
+		return float64(num.uval)
+	case sqltypes.Float64:
+		return num.fval
+	}
+	return 0
+}
+
+// toInt64 returns the numeric value of the result as an int64, rounding floats
+// to the nearest integer like MySQL does when an integer argument is expected
+func (e *EvalResult) toInt64() int64 {
+	num := e.toNumeric()
+	switch num.typ {
+	case sqltypes.Int64:
+		return num.ival
+	case sqltypes.Uint64:
+		return int64(num.uval)
+	case sqltypes.Float64:
+		return int64(math.Round(num.fval))
+	}
+	return 0
+}
+
+// toRawBytes returns the textual representation of the result
+func (e *EvalResult) toRawBytes() []byte {
+	switch {
+	case e.isNull():
+		return nil
+	case sqltypes.IsSigned(e.typ):
+		return strconv.AppendInt(nil, e.ival, 10)
+	case sqltypes.IsUnsigned(e.typ):
+		return strconv.AppendUint(nil, e.uval, 10)
+	case sqltypes.IsFloat(e.typ):
+		return strconv.AppendFloat(nil, e.fval, 'f', -1, 64)
+	}
+	return e.bytes
+}
+
+// IsTrue returns true if the value satisfies a WHERE clause: it is neither
+// NULL nor zero.
+func (e EvalResult) IsTrue() bool {
+	truth, isNull := e.truthValue()
+	return truth && !isNull
+}
+
+// truthValue returns the boolean value of the result. The second return
+// value is true when the result is NULL, in which case it is neither true nor false.
+func (e *EvalResult) truthValue() (bool, bool) {
+	if e.isNull() {
+		return false, true
+	}
+	num := e.toNumeric()
+	switch num.typ {
+	case sqltypes.Int64:
+		return num.ival != 0, false
+	case sqltypes.Uint64:
+		return num.uval != 0, false
+	}
+	return num.fval != 0, false
+}
+
+// parseFloatPrefix parses the longest prefix of str that forms a valid number.
+// Returns 0 if str does not start with a number.
+func parseFloatPrefix(str string) float64 {
+	i := 0
+	if i < len(str) && (str[i] == '+' || str[i] == '-') {
+		i++
+	}
+	digits := 0
+	for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+		i++
+		digits++
+	}
+	if i < len(str) && str[i] == '.' {
+		i++
+		for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+			i++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0
+	}
+	if i < len(str) && (str[i] == 'e' || str[i] == 'E') {
+		j := i + 1
+		if j < len(str) && (str[j] == '+' || str[j] == '-') {
+			j++
+		}
+		if j < len(str) && str[j] >= '0' && str[j] <= '9' {
+			for j < len(str) && str[j] >= '0' && str[j] <= '9' {
+				j++
+			}
+			i = j
+		}
+	}
+	// the only possible failure here is a range error, in which case
+	// ParseFloat still returns the closest representable value
+	fval, _ := strconv.ParseFloat(str[:i], 64)
+	return fval
+}
+
 // debugString is
 func (e *EvalResult) debugString() string {
 	return fmt.Sprintf("(%s) %d %d %f %s", querypb.Type_name[int32(e.typ)], e.ival, e.uval, e.fval, string(e.bytes))
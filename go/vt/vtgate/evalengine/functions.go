@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"errors"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// ErrUnknownFunction is returned by NewCallExpr when the function cannot be evaluated by vtgate
+var ErrUnknownFunction = errors.New("unknown function")
+
+type (
+	// CallExpr represents a call to one of the builtin functions, such as `concat(a, b)`
+	CallExpr struct {
+		Name      string
+		Arguments []Expr
+		f         *builtin
+	}
+
+	// builtin describes a function that can be evaluated by vtgate
+	builtin struct {
+		// minArgs and maxArgs is the allowed range for the number of arguments. A negative maxArgs means
+		// that the function takes any number of arguments
+		minArgs, maxArgs int
+		// nullArgs is true for the functions that get called with NULL arguments.
+		// Any other function returns NULL as soon as one of its arguments is NULL
+		nullArgs bool
+		call     func(args []EvalResult) (EvalResult, error)
+		typeof   func(args []querypb.Type) querypb.Type
+	}
+)
+
+var _ Expr = (*CallExpr)(nil)
+
+var builtinFunctions = map[string]*builtin{
+	// control flow functions
+	"if":       {minArgs: 3, maxArgs: 3, nullArgs: true, call: builtinIf, typeof: typeOfArgs(1, 2)},
+	"ifnull":   {minArgs: 2, maxArgs: 2, nullArgs: true, call: builtinCoalesce, typeof: typeOfAllArgs},
+	"coalesce": {minArgs: 1, maxArgs: -1, nullArgs: true, call: builtinCoalesce, typeof: typeOfAllArgs},
+	"nullif":   {minArgs: 2, maxArgs: 2, nullArgs: true, call: builtinNullIf, typeof: typeOfArgs(0)},
+
+	// string functions
+	"concat":           {minArgs: 1, maxArgs: -1, call: builtinConcat, typeof: typeText},
+	"concat_ws":        {minArgs: 2, maxArgs: -1, nullArgs: true, call: builtinConcatWs, typeof: typeText},
+	"length":           {minArgs: 1, maxArgs: 1, call: builtinLength, typeof: typeInt64},
+	"octet_length":     {minArgs: 1, maxArgs: 1, call: builtinLength, typeof: typeInt64},
+	"char_length":      {minArgs: 1, maxArgs: 1, call: builtinCharLength, typeof: typeInt64},
+	"character_length": {minArgs: 1, maxArgs: 1, call: builtinCharLength, typeof: typeInt64},
+	"lower":            {minArgs: 1, maxArgs: 1, call: builtinLower, typeof: typeText},
+	"lcase":            {minArgs: 1, maxArgs: 1, call: builtinLower, typeof: typeText},
+	"upper":            {minArgs: 1, maxArgs: 1, call: builtinUpper, typeof: typeText},
+	"ucase":            {minArgs: 1, maxArgs: 1, call: builtinUpper, typeof: typeText},
+	"left":             {minArgs: 2, maxArgs: 2, call: builtinLeft, typeof: typeText},
+	"right":            {minArgs: 2, maxArgs: 2, call: builtinRight, typeof: typeText},
+	"substr":           {minArgs: 2, maxArgs: 3, call: builtinSubstr, typeof: typeText},
+	"substring":        {minArgs: 2, maxArgs: 3, call: builtinSubstr, typeof: typeText},
+	"mid":              {minArgs: 3, maxArgs: 3, call: builtinSubstr, typeof: typeText},
+	"trim":             {minArgs: 1, maxArgs: 1, call: builtinTrim, typeof: typeText},
+	"ltrim":            {minArgs: 1, maxArgs: 1, call: builtinLtrim, typeof: typeText},
+	"rtrim":            {minArgs: 1, maxArgs: 1, call: builtinRtrim, typeof: typeText},
+	"replace":          {minArgs: 3, maxArgs: 3, call: builtinReplace, typeof: typeText},
+	"reverse":          {minArgs: 1, maxArgs: 1, call: builtinReverse, typeof: typeText},
+	"repeat":           {minArgs: 2, maxArgs: 2, call: builtinRepeat, typeof: typeText},
+	"lpad":             {minArgs: 3, maxArgs: 3, call: builtinLpad, typeof: typeText},
+	"rpad":             {minArgs: 3, maxArgs: 3, call: builtinRpad, typeof: typeText},
+	"instr":            {minArgs: 2, maxArgs: 2, call: builtinInstr, typeof: typeInt64},
+	"locate":           {minArgs: 2, maxArgs: 3, call: builtinLocate, typeof: typeInt64},
+	"ascii":            {minArgs: 1, maxArgs: 1, call: builtinASCII, typeof: typeInt64},
+	"strcmp":           {minArgs: 2, maxArgs: 2, call: builtinStrcmp, typeof: typeInt64},
+
+	// math functions
+	"abs":      {minArgs: 1, maxArgs: 1, call: builtinAbs, typeof: typeOfNumericArgs(0)},
+	"ceil":     {minArgs: 1, maxArgs: 1, call: builtinCeil, typeof: typeOfNumericArgs(0)},
+	"ceiling":  {minArgs: 1, maxArgs: 1, call: builtinCeil, typeof: typeOfNumericArgs(0)},
+	"floor":    {minArgs: 1, maxArgs: 1, call: builtinFloor, typeof: typeOfNumericArgs(0)},
+	"round":    {minArgs: 1, maxArgs: 2, call: builtinRound, typeof: typeOfNumericArgs(0)},
+	"truncate": {minArgs: 2, maxArgs: 2, call: builtinTruncate, typeof: typeOfNumericArgs(0)},
+	"mod":      {minArgs: 2, maxArgs: 2, call: builtinMod, typeof: typeOfNumericArgs(0, 1)},
+	"sign":     {minArgs: 1, maxArgs: 1, call: builtinSign, typeof: typeInt64},
+	"sqrt":     {minArgs: 1, maxArgs: 1, call: builtinSqrt, typeof: typeFloat64},
+	"pow":      {minArgs: 2, maxArgs: 2, call: builtinPow, typeof: typeFloat64},
+	"power":    {minArgs: 2, maxArgs: 2, call: builtinPow, typeof: typeFloat64},
+	"exp":      {minArgs: 1, maxArgs: 1, call: builtinExp, typeof: typeFloat64},
+	"ln":       {minArgs: 1, maxArgs: 1, call: builtinLn, typeof: typeFloat64},
+	"log":      {minArgs: 1, maxArgs: 2, call: builtinLog, typeof: typeFloat64},
+	"log2":     {minArgs: 1, maxArgs: 1, call: builtinLog2, typeof: typeFloat64},
+	"log10":    {minArgs: 1, maxArgs: 1, call: builtinLog10, typeof: typeFloat64},
+	"pi":       {minArgs: 0, maxArgs: 0, call: builtinPi, typeof: typeFloat64},
+	"greatest": {minArgs: 2, maxArgs: -1, call: builtinGreatest, typeof: typeOfAllArgs},
+	"least":    {minArgs: 2, maxArgs: -1, call: builtinLeast, typeof: typeOfAllArgs},
+
+	// date and time functions
+	"year":       {minArgs: 1, maxArgs: 1, call: builtinYear, typeof: typeInt64},
+	"quarter":    {minArgs: 1, maxArgs: 1, call: builtinQuarter, typeof: typeInt64},
+	"month":      {minArgs: 1, maxArgs: 1, call: builtinMonth, typeof: typeInt64},
+	"day":        {minArgs: 1, maxArgs: 1, call: builtinDayOfMonth, typeof: typeInt64},
+	"dayofmonth": {minArgs: 1, maxArgs: 1, call: builtinDayOfMonth, typeof: typeInt64},
+	"dayofweek":  {minArgs: 1, maxArgs: 1, call: builtinDayOfWeek, typeof: typeInt64},
+	"weekday":    {minArgs: 1, maxArgs: 1, call: builtinWeekday, typeof: typeInt64},
+	"dayofyear":  {minArgs: 1, maxArgs: 1, call: builtinDayOfYear, typeof: typeInt64},
+	"hour":       {minArgs: 1, maxArgs: 1, call: builtinHour, typeof: typeInt64},
+	"minute":     {minArgs: 1, maxArgs: 1, call: builtinMinute, typeof: typeInt64},
+	"second":     {minArgs: 1, maxArgs: 1, call: builtinSecond, typeof: typeInt64},
+	"date":       {minArgs: 1, maxArgs: 1, call: builtinDate, typeof: typeDate},
+	"datediff":   {minArgs: 2, maxArgs: 2, call: builtinDateDiff, typeof: typeInt64},
+
+	// JSON functions
+	"json_extract": {minArgs: 2, maxArgs: -1, call: builtinJSONExtract, typeof: typeJSON},
+	"json_unquote": {minArgs: 1, maxArgs: 1, call: builtinJSONUnquote, typeof: typeText},
+}
+
+// NewCallExpr returns an expression that calls the builtin function with the given name.
+// It returns ErrUnknownFunction if the function cannot be evaluated by vtgate.
+func NewCallExpr(name string, args []Expr) (Expr, error) {
+	name = strings.ToLower(name)
+	f, ok := builtinFunctions[name]
+	if !ok {
+		return nil, ErrUnknownFunction
+	}
+	if len(args) < f.minArgs || (f.maxArgs >= 0 && len(args) > f.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", name)
+	}
+	return &CallExpr{
+		Name:      name,
+		Arguments: args,
+		f:         f,
+	}, nil
+}
+
+//Evaluate implements the Expr interface
+func (c *CallExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	args := make([]EvalResult, 0, len(c.Arguments))
+	for _, arg := range c.Arguments {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if val.isNull() && !c.f.nullArgs {
+			return resultNull, nil
+		}
+		args = append(args, val)
+	}
+	return c.f.call(args)
+}
+
+//Type implements the Expr interface
+func (c *CallExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	types := make([]querypb.Type, 0, len(c.Arguments))
+	for _, arg := range c.Arguments {
+		typ, err := arg.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	return c.f.typeof(types), nil
+}
+
+//String implements the Expr interface
+func (c *CallExpr) String() string {
+	args := make([]string, 0, len(c.Arguments))
+	for _, arg := range c.Arguments {
+		args = append(args, arg.String())
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func typeText([]querypb.Type) querypb.Type {
+	return sqltypes.VarBinary
+}
+
+func typeInt64([]querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+func typeFloat64([]querypb.Type) querypb.Type {
+	return sqltypes.Float64
+}
+
+func typeDate([]querypb.Type) querypb.Type {
+	return sqltypes.Date
+}
+
+func typeOfAllArgs(args []querypb.Type) querypb.Type {
+	return mergeTypes(args)
+}
+
+// typeOfArgs returns a type function that merges the types of the arguments at the given positions
+func typeOfArgs(positions ...int) func([]querypb.Type) querypb.Type {
+	return func(args []querypb.Type) querypb.Type {
+		types := make([]querypb.Type, 0, len(positions))
+		for _, pos := range positions {
+			types = append(types, args[pos])
+		}
+		return mergeTypes(types)
+	}
+}
+
+func mergeTypes(types []querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	for _, typ := range types {
+		switch {
+		case typ == sqltypes.Null:
+		case result == sqltypes.Null:
+			result = typ
+		case sqltypes.IsNumber(result) && sqltypes.IsNumber(typ):
+			result = mergeNumericalTypes(result, typ)
+		case result != typ:
+			result = sqltypes.VarBinary
+		}
+	}
+	return result
+}
+
+func builtinIf(args []EvalResult) (EvalResult, error) {
+	truth, isNull := args[0].truthValue()
+	if !isNull && truth {
+		return args[1], nil
+	}
+	return args[2], nil
+}
+
+func builtinCoalesce(args []EvalResult) (EvalResult, error) {
+	for _, arg := range args {
+		if !arg.isNull() {
+			return arg, nil
+		}
+	}
+	return resultNull, nil
+}
+
+func builtinNullIf(args []EvalResult) (EvalResult, error) {
+	cmp, isNull, err := compareEvalResults(args[0], args[1])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if !isNull && cmp == 0 {
+		return resultNull, nil
+	}
+	return args[0], nil
+}
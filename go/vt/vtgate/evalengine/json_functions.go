@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// jsonPathLeg is a single step of a JSON path: either an object member,
+// or an array cell if index is not negative
+type jsonPathLeg struct {
+	member string
+	index  int
+}
+
+func typeJSON([]querypb.Type) querypb.Type {
+	return sqltypes.TypeJSON
+}
+
+func builtinJSONExtract(args []EvalResult) (EvalResult, error) {
+	doc := bytes.TrimSpace(args[0].toRawBytes())
+	if !json.Valid(doc) {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text in argument 1 to function json_extract: %q", doc)
+	}
+	var found [][]byte
+	for _, arg := range args[1:] {
+		path, err := parseJSONPath(string(arg.toRawBytes()))
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if val, ok := extractJSONPath(doc, path); ok {
+			found = append(found, val)
+		}
+	}
+	switch {
+	case len(found) == 0:
+		return resultNull, nil
+	case len(args) == 2:
+		return EvalResult{typ: sqltypes.TypeJSON, bytes: found[0]}, nil
+	}
+	// with more than one path, the matches are wrapped in an array
+	buf := []byte{'['}
+	for i, val := range found {
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, val...)
+	}
+	return EvalResult{typ: sqltypes.TypeJSON, bytes: append(buf, ']')}, nil
+}
+
+func builtinJSONUnquote(args []EvalResult) (EvalResult, error) {
+	raw := args[0].toRawBytes()
+	if len(raw) < 2 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		return newEvalText(raw), nil
+	}
+	var str string
+	if err := json.Unmarshal(raw, &str); err != nil {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text in argument 1 to function json_unquote: %q", raw)
+	}
+	return newEvalText([]byte(str)), nil
+}
+
+// parseJSONPath parses the subset of the MySQL JSON path syntax that addresses a single
+// value, such as `$.a."b c"[2]`. Wildcards are not supported.
+func parseJSONPath(path string) ([]jsonPathLeg, error) {
+	invalid := func() ([]jsonPathLeg, error) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON path expression: %q", path)
+	}
+	str := strings.TrimSpace(path)
+	if !strings.HasPrefix(str, "$") {
+		return invalid()
+	}
+	str = str[1:]
+	var legs []jsonPathLeg
+	for str != "" {
+		switch str[0] {
+		case '.':
+			str = str[1:]
+			if strings.HasPrefix(str, "\"") {
+				end := strings.IndexByte(str[1:], '"')
+				if end < 0 {
+					return invalid()
+				}
+				legs = append(legs, jsonPathLeg{member: str[1 : end+1], index: -1})
+				str = str[end+2:]
+				continue
+			}
+			end := strings.IndexAny(str, ".[")
+			if end < 0 {
+				end = len(str)
+			}
+			member := str[:end]
+			if member == "" || member == "*" {
+				return invalid()
+			}
+			legs = append(legs, jsonPathLeg{member: member, index: -1})
+			str = str[end:]
+		case '[':
+			end := strings.IndexByte(str, ']')
+			if end < 0 {
+				return invalid()
+			}
+			index, err := strconv.Atoi(strings.TrimSpace(str[1:end]))
+			if err != nil || index < 0 {
+				return invalid()
+			}
+			legs = append(legs, jsonPathLeg{index: index})
+			str = str[end+1:]
+		case ' ':
+			str = str[1:]
+		default:
+			return invalid()
+		}
+	}
+	return legs, nil
+}
+
+// extractJSONPath returns the value at the given path of a valid JSON document.
+// The second return value is false if the document has no value at that path.
+func extractJSONPath(doc []byte, path []jsonPathLeg) ([]byte, bool) {
+	for _, leg := range path {
+		if leg.index < 0 {
+			var object map[string]json.RawMessage
+			if err := json.Unmarshal(doc, &object); err != nil {
+				return nil, false
+			}
+			val, ok := object[leg.member]
+			if !ok {
+				return nil, false
+			}
+			doc = val
+			continue
+		}
+		var array []json.RawMessage
+		if err := json.Unmarshal(doc, &array); err != nil {
+			// as in MySQL, a scalar or an object is treated as an array of one element
+			if leg.index == 0 {
+				continue
+			}
+			return nil, false
+		}
+		if leg.index >= len(array) {
+			return nil, false
+		}
+		doc = array[leg.index]
+	}
+	return bytes.TrimSpace(doc), true
+}
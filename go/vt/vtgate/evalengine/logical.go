@@ -0,0 +1,258 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The logical operators use the SQL three-valued logic: any operand can be
+// true, false or NULL (unknown), and the result is NULL whenever it cannot be
+// determined from the non-NULL operands alone.
+type (
+	// AndExpr represents `a AND b`
+	AndExpr struct {
+		Left, Right Expr
+	}
+
+	// OrExpr represents `a OR b`
+	OrExpr struct {
+		Left, Right Expr
+	}
+
+	// XorExpr represents `a XOR b`
+	XorExpr struct {
+		Left, Right Expr
+	}
+
+	// NotExpr represents `NOT a`
+	NotExpr struct {
+		Inner Expr
+	}
+
+	// CaseExpr represents `CASE [base] WHEN ... THEN ... [ELSE ...] END`
+	CaseExpr struct {
+		// Base is optional. When it is set, each When value is compared for equality against it,
+		// otherwise each When value is evaluated as a condition
+		Base  Expr
+		Whens []*WhenThen
+		Else  Expr
+	}
+
+	// WhenThen is a single `WHEN ... THEN ...` branch of a CaseExpr
+	WhenThen struct {
+		When, Then Expr
+	}
+)
+
+var _ Expr = (*AndExpr)(nil)
+var _ Expr = (*OrExpr)(nil)
+var _ Expr = (*XorExpr)(nil)
+var _ Expr = (*NotExpr)(nil)
+var _ Expr = (*CaseExpr)(nil)
+
+//Evaluate implements the Expr interface
+func (a *AndExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := a.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	lTruth, lNull := left.truthValue()
+	if !lNull && !lTruth {
+		return newEvalBool(false), nil
+	}
+	right, err := a.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	rTruth, rNull := right.truthValue()
+	switch {
+	case !rNull && !rTruth:
+		return newEvalBool(false), nil
+	case lNull || rNull:
+		return resultNull, nil
+	}
+	return newEvalBool(true), nil
+}
+
+//Evaluate implements the Expr interface
+func (o *OrExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := o.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	lTruth, lNull := left.truthValue()
+	if !lNull && lTruth {
+		return newEvalBool(true), nil
+	}
+	right, err := o.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	rTruth, rNull := right.truthValue()
+	switch {
+	case !rNull && rTruth:
+		return newEvalBool(true), nil
+	case lNull || rNull:
+		return resultNull, nil
+	}
+	return newEvalBool(false), nil
+}
+
+//Evaluate implements the Expr interface
+func (x *XorExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := x.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := x.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	lTruth, lNull := left.truthValue()
+	rTruth, rNull := right.truthValue()
+	if lNull || rNull {
+		return resultNull, nil
+	}
+	return newEvalBool(lTruth != rTruth), nil
+}
+
+//Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	truth, isNull := val.truthValue()
+	if isNull {
+		return resultNull, nil
+	}
+	return newEvalBool(!truth), nil
+}
+
+//Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		base, err = c.Base.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+	}
+	for _, when := range c.Whens {
+		cond, err := when.When.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		var matched bool
+		if c.Base != nil {
+			cmp, isNull, err := compareEvalResults(base, cond)
+			if err != nil {
+				return EvalResult{}, err
+			}
+			matched = !isNull && cmp == 0
+		} else {
+			truth, isNull := cond.truthValue()
+			matched = !isNull && truth
+		}
+		if matched {
+			return when.Then.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return resultNull, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+//Type implements the Expr interface
+func (a *AndExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//Type implements the Expr interface
+func (o *OrExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//Type implements the Expr interface
+func (x *XorExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//Type implements the Expr interface
+func (c *CaseExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	results := make([]Expr, 0, len(c.Whens)+1)
+	for _, when := range c.Whens {
+		results = append(results, when.Then)
+	}
+	if c.Else != nil {
+		results = append(results, c.Else)
+	}
+	types := make([]querypb.Type, 0, len(results))
+	for _, expr := range results {
+		typ, err := expr.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	return mergeTypes(types), nil
+}
+
+//String implements the Expr interface
+func (a *AndExpr) String() string {
+	return a.Left.String() + " and " + a.Right.String()
+}
+
+//String implements the Expr interface
+func (o *OrExpr) String() string {
+	return o.Left.String() + " or " + o.Right.String()
+}
+
+//String implements the Expr interface
+func (x *XorExpr) String() string {
+	return x.Left.String() + " xor " + x.Right.String()
+}
+
+//String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Inner.String()
+}
+
+//String implements the Expr interface
+func (c *CaseExpr) String() string {
+	str := "case"
+	if c.Base != nil {
+		str += " " + c.Base.String()
+	}
+	for _, when := range c.Whens {
+		str += " when " + when.When.String() + " then " + when.Then.String()
+	}
+	if c.Else != nil {
+		str += " else " + c.Else.String()
+	}
+	return str + " end"
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// typeOfNumericArgs is like typeOfArgs, but non-numeric arguments get converted to floats
+func typeOfNumericArgs(positions ...int) func([]querypb.Type) querypb.Type {
+	merge := typeOfArgs(positions...)
+	return func(args []querypb.Type) querypb.Type {
+		typ := merge(args)
+		if typ == sqltypes.Null || sqltypes.IsNumber(typ) {
+			return typ
+		}
+		return sqltypes.Float64
+	}
+}
+
+func builtinAbs(args []EvalResult) (EvalResult, error) {
+	num := args[0].toNumeric()
+	switch num.typ {
+	case sqltypes.Int64:
+		if num.ival == math.MinInt64 {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_OUT_OF_RANGE, "BIGINT value is out of range in 'abs(%d)'", num.ival)
+		}
+		if num.ival < 0 {
+			return newEvalInt64(-num.ival), nil
+		}
+		return num, nil
+	case sqltypes.Uint64:
+		return num, nil
+	}
+	return newEvalFloat(math.Abs(num.fval)), nil
+}
+
+func builtinCeil(args []EvalResult) (EvalResult, error) {
+	num := args[0].toNumeric()
+	if num.typ != sqltypes.Float64 {
+		return num, nil
+	}
+	return newEvalFloat(math.Ceil(num.fval)), nil
+}
+
+func builtinFloor(args []EvalResult) (EvalResult, error) {
+	num := args[0].toNumeric()
+	if num.typ != sqltypes.Float64 {
+		return num, nil
+	}
+	return newEvalFloat(math.Floor(num.fval)), nil
+}
+
+func builtinRound(args []EvalResult) (EvalResult, error) {
+	var decimals int64
+	if len(args) == 2 {
+		decimals = args[1].toInt64()
+	}
+	return roundOrTruncate(args[0].toNumeric(), decimals, math.Round), nil
+}
+
+func builtinTruncate(args []EvalResult) (EvalResult, error) {
+	return roundOrTruncate(args[0].toNumeric(), args[1].toInt64(), math.Trunc), nil
+}
+
+// roundOrTruncate drops the digits of num after the given number of decimals,
+// using fn to decide what happens with the dropped digits. A negative number
+// of decimals affects the digits left of the decimal point.
+func roundOrTruncate(num EvalResult, decimals int64, fn func(float64) float64) EvalResult {
+	if num.typ != sqltypes.Float64 {
+		if decimals >= 0 {
+			return num
+		}
+		shift := math.Pow10(int(-decimals))
+		return newEvalInt64(int64(fn(num.toFloat()/shift) * shift))
+	}
+	if decimals > 30 {
+		return num
+	}
+	shift := math.Pow10(int(decimals))
+	return newEvalFloat(fn(num.fval*shift) / shift)
+}
+
+func builtinMod(args []EvalResult) (EvalResult, error) {
+	a := args[0].toNumeric()
+	b := args[1].toNumeric()
+	if a.typ == sqltypes.Int64 && b.typ == sqltypes.Int64 {
+		if b.ival == 0 {
+			return resultNull, nil
+		}
+		if b.ival == -1 {
+			// avoid the overflow of math.MinInt64 % -1
+			return newEvalInt64(0), nil
+		}
+		return newEvalInt64(a.ival % b.ival), nil
+	}
+	if a.typ == sqltypes.Uint64 && b.typ == sqltypes.Uint64 {
+		if b.uval == 0 {
+			return resultNull, nil
+		}
+		return EvalResult{typ: sqltypes.Uint64, uval: a.uval % b.uval}, nil
+	}
+	divisor := b.toFloat()
+	if divisor == 0 {
+		return resultNull, nil
+	}
+	return newEvalFloat(math.Mod(a.toFloat(), divisor)), nil
+}
+
+func builtinSign(args []EvalResult) (EvalResult, error) {
+	f := args[0].toFloat()
+	switch {
+	case f > 0:
+		return newEvalInt64(1), nil
+	case f < 0:
+		return newEvalInt64(-1), nil
+	}
+	return newEvalInt64(0), nil
+}
+
+// floatResult returns NULL for the values that MySQL can't represent
+func floatResult(f float64) EvalResult {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return resultNull
+	}
+	return newEvalFloat(f)
+}
+
+func builtinSqrt(args []EvalResult) (EvalResult, error) {
+	return floatResult(math.Sqrt(args[0].toFloat())), nil
+}
+
+func builtinPow(args []EvalResult) (EvalResult, error) {
+	return floatResult(math.Pow(args[0].toFloat(), args[1].toFloat())), nil
+}
+
+func builtinExp(args []EvalResult) (EvalResult, error) {
+	return floatResult(math.Exp(args[0].toFloat())), nil
+}
+
+// logarithm returns NULL for values outside of the domain of the logarithm
+func logarithm(f float64, fn func(float64) float64) EvalResult {
+	if f <= 0 {
+		return resultNull
+	}
+	return floatResult(fn(f))
+}
+
+func builtinLn(args []EvalResult) (EvalResult, error) {
+	return logarithm(args[0].toFloat(), math.Log), nil
+}
+
+func builtinLog(args []EvalResult) (EvalResult, error) {
+	if len(args) == 1 {
+		return logarithm(args[0].toFloat(), math.Log), nil
+	}
+	base := args[0].toFloat()
+	if base <= 0 || base == 1 {
+		return resultNull, nil
+	}
+	return logarithm(args[1].toFloat(), func(f float64) float64 {
+		return math.Log(f) / math.Log(base)
+	}), nil
+}
+
+func builtinLog2(args []EvalResult) (EvalResult, error) {
+	return logarithm(args[0].toFloat(), math.Log2), nil
+}
+
+func builtinLog10(args []EvalResult) (EvalResult, error) {
+	return logarithm(args[0].toFloat(), math.Log10), nil
+}
+
+func builtinPi([]EvalResult) (EvalResult, error) {
+	return newEvalFloat(math.Pi), nil
+}
+
+func builtinGreatest(args []EvalResult) (EvalResult, error) {
+	return extremum(args, func(cmp int) bool { return cmp > 0 })
+}
+
+func builtinLeast(args []EvalResult) (EvalResult, error) {
+	return extremum(args, func(cmp int) bool { return cmp < 0 })
+}
+
+func extremum(args []EvalResult, better func(cmp int) bool) (EvalResult, error) {
+	result := args[0]
+	for _, arg := range args[1:] {
+		cmp, _, err := compareEvalResults(arg, result)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if better(cmp) {
+			result = arg
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+// String functions work on characters and not on bytes, so all the
+// positions and lengths are counted in runes.
+
+func builtinConcat(args []EvalResult) (EvalResult, error) {
+	var buf []byte
+	for _, arg := range args {
+		buf = append(buf, arg.toRawBytes()...)
+	}
+	return newEvalText(buf), nil
+}
+
+func builtinConcatWs(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	sep := args[0].toRawBytes()
+	var buf []byte
+	first := true
+	for _, arg := range args[1:] {
+		// unlike concat(), NULL arguments are skipped
+		if arg.isNull() {
+			continue
+		}
+		if !first {
+			buf = append(buf, sep...)
+		}
+		buf = append(buf, arg.toRawBytes()...)
+		first = false
+	}
+	return newEvalText(buf), nil
+}
+
+func builtinLength(args []EvalResult) (EvalResult, error) {
+	return newEvalInt64(int64(len(args[0].toRawBytes()))), nil
+}
+
+func builtinCharLength(args []EvalResult) (EvalResult, error) {
+	return newEvalInt64(int64(utf8.RuneCount(args[0].toRawBytes()))), nil
+}
+
+func builtinLower(args []EvalResult) (EvalResult, error) {
+	return newEvalText(bytes.ToLower(args[0].toRawBytes())), nil
+}
+
+func builtinUpper(args []EvalResult) (EvalResult, error) {
+	return newEvalText(bytes.ToUpper(args[0].toRawBytes())), nil
+}
+
+func builtinLeft(args []EvalResult) (EvalResult, error) {
+	str := []rune(string(args[0].toRawBytes()))
+	n := args[1].toInt64()
+	switch {
+	case n <= 0:
+		return newEvalText([]byte{}), nil
+	case n > int64(len(str)):
+		n = int64(len(str))
+	}
+	return newEvalText([]byte(string(str[:n]))), nil
+}
+
+func builtinRight(args []EvalResult) (EvalResult, error) {
+	str := []rune(string(args[0].toRawBytes()))
+	n := args[1].toInt64()
+	switch {
+	case n <= 0:
+		return newEvalText([]byte{}), nil
+	case n > int64(len(str)):
+		n = int64(len(str))
+	}
+	return newEvalText([]byte(string(str[int64(len(str))-n:]))), nil
+}
+
+// builtinSubstr implements substring(str, pos[, len]). Positions start at 1,
+// and a negative position counts from the end of the string.
+func builtinSubstr(args []EvalResult) (EvalResult, error) {
+	str := []rune(string(args[0].toRawBytes()))
+	size := int64(len(str))
+	pos := args[1].toInt64()
+	switch {
+	case pos == 0 || pos > size || -pos > size:
+		return newEvalText([]byte{}), nil
+	case pos > 0:
+		pos--
+	default:
+		pos = size + pos
+	}
+	end := size
+	if len(args) == 3 {
+		length := args[2].toInt64()
+		if length <= 0 {
+			return newEvalText([]byte{}), nil
+		}
+		if pos+length < end {
+			end = pos + length
+		}
+	}
+	return newEvalText([]byte(string(str[pos:end]))), nil
+}
+
+func builtinTrim(args []EvalResult) (EvalResult, error) {
+	return newEvalText(bytes.Trim(args[0].toRawBytes(), " ")), nil
+}
+
+func builtinLtrim(args []EvalResult) (EvalResult, error) {
+	return newEvalText(bytes.TrimLeft(args[0].toRawBytes(), " ")), nil
+}
+
+func builtinRtrim(args []EvalResult) (EvalResult, error) {
+	return newEvalText(bytes.TrimRight(args[0].toRawBytes(), " ")), nil
+}
+
+func builtinReplace(args []EvalResult) (EvalResult, error) {
+	str := args[0].toRawBytes()
+	from := args[1].toRawBytes()
+	if len(from) == 0 {
+		return newEvalText(str), nil
+	}
+	return newEvalText(bytes.ReplaceAll(str, from, args[2].toRawBytes())), nil
+}
+
+func builtinReverse(args []EvalResult) (EvalResult, error) {
+	str := []rune(string(args[0].toRawBytes()))
+	for i, j := 0, len(str)-1; i < j; i, j = i+1, j-1 {
+		str[i], str[j] = str[j], str[i]
+	}
+	return newEvalText([]byte(string(str))), nil
+}
+
+func builtinRepeat(args []EvalResult) (EvalResult, error) {
+	count := args[1].toInt64()
+	if count <= 0 {
+		return newEvalText([]byte{}), nil
+	}
+	return newEvalText(bytes.Repeat(args[0].toRawBytes(), int(count))), nil
+}
+
+func builtinLpad(args []EvalResult) (EvalResult, error) {
+	return pad(args, true)
+}
+
+func builtinRpad(args []EvalResult) (EvalResult, error) {
+	return pad(args, false)
+}
+
+func pad(args []EvalResult, left bool) (EvalResult, error) {
+	str := []rune(string(args[0].toRawBytes()))
+	length := args[1].toInt64()
+	padding := []rune(string(args[2].toRawBytes()))
+	switch {
+	case length < 0:
+		return resultNull, nil
+	case length <= int64(len(str)):
+		return newEvalText([]byte(string(str[:length]))), nil
+	case len(padding) == 0:
+		return newEvalText([]byte{}), nil
+	}
+	fill := make([]rune, 0, length-int64(len(str)))
+	for int64(len(fill)) < length-int64(len(str)) {
+		fill = append(fill, padding[len(fill)%len(padding)])
+	}
+	if left {
+		return newEvalText([]byte(string(fill) + string(str))), nil
+	}
+	return newEvalText([]byte(string(str) + string(fill))), nil
+}
+
+func builtinInstr(args []EvalResult) (EvalResult, error) {
+	return locate(args[1], args[0], 1), nil
+}
+
+func builtinLocate(args []EvalResult) (EvalResult, error) {
+	pos := int64(1)
+	if len(args) == 3 {
+		pos = args[2].toInt64()
+	}
+	return locate(args[0], args[1], pos), nil
+}
+
+// locate returns the 1-based position of the first occurrence of substr in str,
+// starting the search at pos. Returns 0 if substr cannot be found.
+func locate(substr, str EvalResult, pos int64) EvalResult {
+	haystack := []rune(string(str.toRawBytes()))
+	if pos < 1 || pos > int64(len(haystack))+1 {
+		return newEvalInt64(0)
+	}
+	idx := strings.Index(string(haystack[pos-1:]), string(substr.toRawBytes()))
+	if idx < 0 {
+		return newEvalInt64(0)
+	}
+	// idx is a byte offset, but we want to return the position in characters
+	return newEvalInt64(pos + int64(utf8.RuneCountInString(string(haystack[pos-1:])[:idx])))
+}
+
+func builtinASCII(args []EvalResult) (EvalResult, error) {
+	str := args[0].toRawBytes()
+	if len(str) == 0 {
+		return newEvalInt64(0), nil
+	}
+	return newEvalInt64(int64(str[0])), nil
+}
+
+func builtinStrcmp(args []EvalResult) (EvalResult, error) {
+	return newEvalInt64(int64(bytes.Compare(args[0].toRawBytes(), args[1].toRawBytes()))), nil
+}
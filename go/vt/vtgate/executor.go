@@ -34,6 +34,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/discovery"
@@ -70,6 +71,9 @@ var (
 
 	queriesProcessedByTable = stats.NewCountersWithMultiLabels("QueriesProcessedByTable", "Queries processed at vtgate by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
 	queriesRoutedByTable    = stats.NewCountersWithMultiLabels("QueriesRoutedByTable", "Queries routed from vtgate to vttablet by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
+
+	consolidatorWaits   = stats.NewTimings("ConsolidatorWaits", "Queries that waited for the result of an identical query in flight, and their wait time, by keyspace", "Keyspace")
+	consolidatorWaiting = stats.NewGauge("ConsolidatorWaiting", "Number of queries currently waiting for the result of an identical query in flight")
 )
 
 const (
@@ -103,6 +107,12 @@ type Executor struct {
 
 	// allowScatter will fail planning if set to false and a plan contains any scatter queries
 	allowScatter bool
+
+	// resultCache caches the results of the queries that opt in, nil if disabled
+	resultCache *resultCache
+
+	// consolidator consolidates the identical reads in flight, nil if disabled
+	consolidator *sync2.Consolidator
 }
 
 var executorOnce sync.Once
@@ -169,7 +179,7 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	defer span.Finish()
 
 	logStats := NewLogStats(ctx, method, sql, bindVars)
-	stmtType, result, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+	stmtType, result, err := e.executeWithTxRetry(ctx, safeSession, sql, bindVars, logStats)
 	logStats.Error = err
 	saveSessionStats(safeSession, stmtType, result, err)
 	if result != nil && len(result.Rows) > *warnMemoryRows {
@@ -319,6 +329,12 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 			bindVars[key] = sqltypes.StringBindVariable(session.SessionUUID)
 		case sysvars.SessionEnableSystemSettings.Name:
 			bindVars[key] = sqltypes.BoolBindVariable(session.EnableSystemSettings)
+		case sysvars.SessionEnableConsolidator.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(!session.DisableConsolidator)
+		case sysvars.SessionEnableTxRetry.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.EnableTransactionRetry)
+		case sysvars.SessionReadWriteSplit.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.ReadWriteSplit)
 		case sysvars.ReadAfterWriteGTID.Name:
 			var v string
 			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
@@ -1066,6 +1082,11 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 
+	splitRead := e.canSplitRead(plan, vc, safeSession)
+	if splitRead {
+		vc.tabletType = topodatapb.TabletType_REPLICA
+	}
+
 	// Some of the underlying primitives may send results one row at a time.
 	// So, we need the ability to consolidate those into reasonable chunks.
 	// The callback wrapper below accumulates rows and sends them as chunks
@@ -1111,6 +1132,23 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	}
 
 	err = plan.Instructions.StreamExecute(vc, bindVars, true, callbackGen)
+	if splitRead {
+		switch {
+		case err == nil:
+			splitReads.Add(splitReadReplica, 1)
+		case !seenResults && len(result.Rows) == 0 && canFallBackToPrimary(err):
+			// Nothing was sent to the client yet, the read can fall back
+			// to the primary.
+			splitReads.Add(splitReadPrimaryFallback, 1)
+			vc.tabletType = topodatapb.TabletType_MASTER
+			foundRows = 0
+			err = plan.Instructions.StreamExecute(vc, bindVars, true, callbackGen)
+		}
+	}
+	if safeSession.InTransaction() && safeSession.GetSessionEnableTxRetry() {
+		// The streamed results are not recorded to verify a replay.
+		safeSession.SetTxRetryUnsafe("it streamed results to the client")
+	}
 
 	logStats.ExecuteTime = time.Since(execStart)
 	e.updateQueryCounts(plan.Instructions.RouteType(), plan.Instructions.GetKeyspaceName(), plan.Instructions.GetTableName(), int64(logStats.ShardQueries))
@@ -1194,10 +1232,23 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		logStats.BindVariables = bindVars
 	}
 
-	if e.VSchema() == nil {
+	vschema := e.VSchema()
+	if vschema == nil {
 		return nil, errors.New("vschema not initialized")
 	}
 
+	// A prepared statement bound to its plan is not parsed again.
+	prefixKey := vcursor.planPrefixKey()
+	prepared := preparedPlanFromContext(vcursor.ctx)
+	if plan := prepared.get(prefixKey, vschema, bindVars); plan != nil {
+		vcursor.SetIgnoreMaxMemoryRows(prepared.ignoreMaxMemoryRows)
+		if logStats != nil {
+			logStats.SQL = comments.Leading + prepared.query + comments.Trailing
+			logStats.BindVariables = bindVars
+		}
+		return plan, nil
+	}
+
 	stmt, reserved, err := sqlparser.Parse2(sql)
 	if err != nil {
 		return nil, err
@@ -1213,7 +1264,15 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	vcursor.SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows)
 
 	// Normalize if possible and retry.
+	var extracted map[string]*querypb.BindVariable
 	if (e.normalize && sqlparser.CanNormalize(stmt)) || sqlparser.MustRewriteAST(stmt) {
+		var given map[string]bool
+		if prepared != nil {
+			given = make(map[string]bool, len(bindVars))
+			for name := range bindVars {
+				given[name] = true
+			}
+		}
 		parameterize := e.normalize // the public flag is called normalize
 		result, err := sqlparser.PrepareAST(stmt, reservedVars, bindVars, parameterize, vcursor.keyspace)
 		if err != nil {
@@ -1222,6 +1281,14 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		statement = result.AST
 		bindVarNeeds = result.BindVarNeeds
 		query = sqlparser.String(statement)
+		if prepared != nil {
+			extracted = make(map[string]*querypb.BindVariable)
+			for name, bv := range bindVars {
+				if !given[name] {
+					extracted[name] = bv
+				}
+			}
+		}
 	}
 
 	if logStats != nil {
@@ -1229,8 +1296,12 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		logStats.BindVariables = bindVars
 	}
 
-	planKey := vcursor.planPrefixKey() + ":" + query
+	cachePlan := !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) && sqlparser.CachePlan(statement)
+	planKey := prefixKey + ":" + query
 	if plan, ok := e.plans.Get(planKey); ok {
+		if prepared != nil && cachePlan {
+			prepared.bind(prefixKey, vschema, query, extracted, ignoreMaxMemoryRows, plan.(*engine.Plan))
+		}
 		return plan.(*engine.Plan), nil
 	}
 
@@ -1242,11 +1313,15 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	plan.Warnings = vcursor.warnings
 	vcursor.warnings = nil
 
-	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) && sqlparser.CachePlan(statement) {
+	if cachePlan {
 		e.plans.Set(planKey, plan)
 	}
 
-	return e.checkThatPlanIsValid(stmt, plan)
+	plan, err = e.checkThatPlanIsValid(stmt, plan)
+	if err == nil && prepared != nil && cachePlan {
+		prepared.bind(prefixKey, vschema, query, extracted, ignoreMaxMemoryRows, plan)
+	}
+	return plan, err
 }
 
 // skipQueryPlanCache extracts SkipQueryPlanCache from session
@@ -1479,8 +1554,8 @@ func (e *Executor) ExecuteMultiShard(ctx context.Context, rss []*srvtopo.Resolve
 }
 
 // StreamExecuteMulti implements the IExecutor interface
-func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) []error {
-	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, options, callback)
+func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, callback func(reply *sqltypes.Result) error) []error {
+	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, session, callback)
 }
 
 // ExecuteLock implements the IExecutor interface
@@ -26,6 +26,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/test/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -2594,3 +2595,82 @@ func TestSelectScatterFails(t *testing.T) {
 	_, err = executorExecSession(executor, "select /*vt+ ALLOW_SCATTER */ id from user", nil, sess)
 	require.NoError(t, err)
 }
+
+func TestSelectConsolidated(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.consolidator = sync2.NewConsolidator()
+	session := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	query := "select id from user where id = 1"
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "2")
+
+	// Identical queries wait for the query in flight.
+	inFlight, original := executor.consolidator.Create(queryResultKey("@master:"+query, "", map[string]*querypb.BindVariable{}))
+	require.True(t, original)
+	waitsBefore := consolidatorWaits.Counts()["TestExecutor"]
+	results := make(chan *sqltypes.Result, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			qr, err := executorExecSession(executor, query, nil, session)
+			assert.NoError(t, err)
+			results <- qr
+		}()
+	}
+	require.Eventually(t, func() bool {
+		return consolidatorWaiting.Get() == 3
+	}, 5*time.Second, time.Millisecond)
+
+	// The sessions that opt out, the transactions and the other
+	// queries don't wait.
+	_, err := executorExecSession(executor, query, nil, &vtgatepb.Session{TargetString: "@master", Autocommit: true, DisableConsolidator: true})
+	require.NoError(t, err)
+	_, err = executorExecSession(executor, query, nil, &vtgatepb.Session{TargetString: "@master"})
+	require.NoError(t, err)
+	_, err = executorExecSession(executor, "select id from user where id = 2", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+
+	inFlight.Result = want
+	inFlight.Broadcast()
+	for i := 0; i < 3; i++ {
+		utils.MustMatch(t, want, <-results)
+	}
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 3, consolidatorWaits.Counts()["TestExecutor"]-waitsBefore)
+	assert.EqualValues(t, 0, consolidatorWaiting.Get())
+
+	// Once the query completed, the next one executes.
+	_, err = executorExecSession(executor, query, nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, sbc1.ExecCount.Get())
+
+	// The errors are shared too.
+	inFlight, _ = executor.consolidator.Create(queryResultKey("@master:"+query, "", map[string]*querypb.BindVariable{}))
+	errs := make(chan error)
+	go func() {
+		_, err := executorExecSession(executor, query, nil, session)
+		errs <- err
+	}()
+	require.Eventually(t, func() bool {
+		return consolidatorWaiting.Get() == 1
+	}, 5*time.Second, time.Millisecond)
+	inFlight.Err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "shard unavailable")
+	inFlight.Broadcast()
+	assert.EqualError(t, <-errs, "shard unavailable")
+}
+
+func TestSelectConsolidatorSysVar(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	result, err := executor.Execute(context.Background(), "TestExecute", session, "select @@enable_consolidator", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `[[INT64(1)]]`, fmt.Sprintf("%v", result.Rows))
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set @@enable_consolidator = 0", nil)
+	require.NoError(t, err)
+	assert.True(t, session.DisableConsolidator)
+	result, err = executor.Execute(context.Background(), "TestExecute", session, "select @@enable_consolidator", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `[[INT64(0)]]`, fmt.Sprintf("%v", result.Rows))
+}
@@ -246,6 +246,12 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set @@enable_system_settings = false",
 		out: &vtgatepb.Session{Autocommit: true, EnableSystemSettings: false},
+	}, {
+		in:  "set @@enable_consolidator = off",
+		out: &vtgatepb.Session{Autocommit: true, DisableConsolidator: true},
+	}, {
+		in:  "set @@enable_consolidator = 1",
+		out: &vtgatepb.Session{Autocommit: true},
 	}, {
 		in:  "set @@socket = '/tmp/change.sock'",
 		err: "variable 'socket' is a read only variable",
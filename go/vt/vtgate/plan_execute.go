@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -120,7 +121,32 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 			e.executePlan(ctx, plan, vcursor, bindVars, execStart))
 	}
 
-	return e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	if e.canSplitRead(plan, vcursor, safeSession) {
+		return e.executeSplitRead(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	}
+
+	return e.executeRead(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+}
+
+// executeRead executes a plan that doesn't need a transaction, through
+// the result cache or the consolidator if they apply. They don't while the
+// reads of the session wait for its writes on the replicas.
+func (e *Executor) executeRead(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
+	return func(logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
+		if safeSession.hasPendingShardGtids() {
+			return e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+		}
+
+		if e.resultCache != nil && plan.ResultCacheTTL > 0 && !safeSession.InTransaction() && !safeSession.InReservedConn() {
+			return e.executeCachedPlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+		}
+
+		if e.canConsolidate(plan, safeSession) {
+			return e.executeConsolidatedPlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+		}
+
+		return e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	}
 }
 
 func (e *Executor) startTxIfNecessary(ctx context.Context, safeSession *SafeSession) error {
@@ -193,6 +219,90 @@ func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *
 	}
 }
 
+// executeCachedPlan serves the result of a plan from the result cache, or
+// executes the plan and caches its result.
+func (e *Executor) executeCachedPlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
+	return func(logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
+		user := callerid.ImmediateCallerIDFromContext(ctx).GetUsername()
+		key := queryResultKey(vcursor.planPrefixKey()+":"+plan.Original, user, bindVars)
+		if qr, ok := e.resultCache.Get(key); ok {
+			e.logSharedExecution(logStats, plan, vcursor, execStart, nil, qr)
+			return plan.Type, qr, nil
+		}
+
+		execute := e.executePlan
+		if e.canConsolidate(plan, safeSession) {
+			execute = e.executeConsolidatedPlan
+		}
+		snapshot := e.resultCache.Snapshot(plan.ResultCacheTables, vcursor.TabletType())
+		stmtType, qr, err := execute(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+		// The warnings of the query would not be returned with the cached result.
+		if err == nil && len(safeSession.GetWarnings()) == 0 {
+			e.resultCache.Set(key, qr, plan.ResultCacheTTL, snapshot)
+		}
+		return stmtType, qr, err
+	}
+}
+
+// canConsolidate returns true if the plan is a read that can share the
+// result of an identical read in flight.
+func (e *Executor) canConsolidate(plan *engine.Plan, safeSession *SafeSession) bool {
+	if e.consolidator == nil || plan.Type != sqlparser.StmtSelect {
+		return false
+	}
+	switch instructions := plan.Instructions.(type) {
+	case *engine.SQLCalcFoundRows, *engine.Lock:
+		// Their results depend on the session of the query.
+		return false
+	case *engine.Route:
+		// Every query must get its own sequence values.
+		if instructions.Opcode == engine.SelectNext {
+			return false
+		}
+	}
+	return safeSession.GetSessionEnableConsolidator() && !safeSession.InTransaction() && !safeSession.InReservedConn()
+}
+
+// executeConsolidatedPlan executes a plan, unless an identical query is
+// in flight, in which case it waits for its result instead.
+func (e *Executor) executeConsolidatedPlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
+	return func(logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
+		user := callerid.ImmediateCallerIDFromContext(ctx).GetUsername()
+		key := queryResultKey(vcursor.planPrefixKey()+":"+plan.Original, user, bindVars)
+		q, original := e.consolidator.Create(key)
+		if original {
+			defer q.Broadcast()
+			stmtType, qr, err := e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+			q.Result, q.Err = qr, err
+			return stmtType, qr, err
+		}
+
+		waitStart := time.Now()
+		consolidatorWaiting.Add(1)
+		q.Wait()
+		consolidatorWaiting.Add(-1)
+		consolidatorWaits.Record(plan.Instructions.GetKeyspaceName(), waitStart)
+		var qr *sqltypes.Result
+		if q.Err == nil {
+			// The result is shared by all the waiters.
+			qr = q.Result.(*sqltypes.Result).Copy()
+		}
+		e.logSharedExecution(logStats, plan, vcursor, execStart, q.Err, qr)
+		return plan.Type, qr, q.Err
+	}
+}
+
+// logSharedExecution logs the execution of a plan whose result was not
+// fetched from the tablets, but shared from the result cache or from an
+// identical query in flight.
+func (e *Executor) logSharedExecution(logStats *LogStats, plan *engine.Plan, vcursor *vcursorImpl, execStart time.Time, err error, qr *sqltypes.Result) {
+	logStats.Keyspace = plan.Instructions.GetKeyspaceName()
+	logStats.Table = plan.Instructions.GetTableName()
+	logStats.TabletType = vcursor.TabletType().String()
+	errCount := e.logExecutionEnd(logStats, execStart, plan, err, qr)
+	plan.AddStats(1, time.Since(logStats.StartTime), 0, logStats.RowsAffected, logStats.RowsReturned, errCount)
+}
+
 func (e *Executor) logExecutionEnd(logStats *LogStats, execStart time.Time, plan *engine.Plan, err error, qr *sqltypes.Result) uint64 {
 	logStats.ExecuteTime = time.Since(execStart)
 
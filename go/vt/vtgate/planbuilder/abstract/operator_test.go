@@ -114,6 +114,20 @@ JoinPredicates:
 		2:s
 	}
 	Predicate: t.id = s.id
+}`,
+	}, {
+		input: "select 1 from t left join s on t.id = s.id where s.name = 'Mister'",
+		output: `OuterJoin: {
+	Inner: 	QueryGraph: {
+	Tables:
+		1:t
+	}
+	Outer: 	QueryGraph: {
+	Tables:
+		2:s
+	}
+	Predicate: t.id = s.id
+	Filter: s.` + "`name`" + ` = 'Mister'
 }`,
 	}, {
 		input: "select 1 from t right join s on t.id = s.id",
@@ -185,6 +199,9 @@ func testString(op Operator) string {
 	case *LeftJoin:
 		leftStr := indent(testString(op.Left))
 		rightStr := indent(testString(op.Right))
+		if op.Filter != nil {
+			return fmt.Sprintf("OuterJoin: {\n\tInner: %s\n\tOuter: %s\n\tPredicate: %s\n\tFilter: %s\n}", leftStr, rightStr, sqlparser.String(op.Predicate), sqlparser.String(op.Filter))
+		}
 		return fmt.Sprintf("OuterJoin: {\n\tInner: %s\n\tOuter: %s\n\tPredicate: %s\n}", leftStr, rightStr, sqlparser.String(op.Predicate))
 	}
 	return "implement me"
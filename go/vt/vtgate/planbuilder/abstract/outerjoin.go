@@ -25,6 +25,10 @@ import (
 type LeftJoin struct {
 	Left, Right Operator
 	Predicate   sqlparser.Expr
+
+	// Filter holds the WHERE predicates that depend on the outer side.
+	// They can't be pushed below the join, so they are evaluated on its result.
+	Filter sqlparser.Expr
 }
 
 // PushPredicate implements the Operator interface
@@ -34,7 +38,8 @@ func (oj *LeftJoin) PushPredicate(expr sqlparser.Expr, semTable *semantics.SemTa
 		return oj.Left.PushPredicate(expr, semTable)
 	}
 
-	return semantics.Gen4NotSupportedF("cannot push predicates to the RHS of an outer join")
+	oj.Filter = sqlparser.AndExpressions(oj.Filter, expr)
+	return nil
 }
 
 // TableID implements the Operator interface
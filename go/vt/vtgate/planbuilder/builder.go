@@ -117,6 +117,10 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, reservedVars *sqlpars
 		Instructions: instruction,
 		BindVarNeeds: bindVarNeeds,
 	}
+	if sel, ok := stmt.(sqlparser.SelectStatement); ok {
+		setResultCache(plan, sel, vschema)
+		setReplicaReadable(plan, sel, vschema)
+	}
 	return plan, nil
 }
 
@@ -152,7 +156,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 		if err != nil {
 			return nil, err
 		}
-		return buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+		return buildRoutePlan(stmt, reservedVars, vschema, buildCTEPlan(configuredPlanner(query)))
 	case *sqlparser.Insert:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildInsertPlan)
 	case *sqlparser.Update:
@@ -160,7 +164,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 	case *sqlparser.Delete:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildDeletePlan)
 	case *sqlparser.Union:
-		return buildRoutePlan(stmt, reservedVars, vschema, buildUnionPlan)
+		return buildRoutePlan(stmt, reservedVars, vschema, buildCTEPlan(buildUnionPlan))
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	case *sqlparser.AlterMigration:
@@ -0,0 +1,355 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// buildCTEPlan wraps a SELECT/UNION planner with support for common table
+// expressions. If the whole statement can be served by a single keyspace,
+// or by a single shard of a sharded keyspace, it is sent down as is, WITH
+// clause included. Otherwise, the CTEs are inlined as derived tables and
+// the rewritten statement is handed to the wrapped planner.
+func buildCTEPlan(f func(sqlparser.Statement, *sqlparser.ReservedVars, ContextVSchema) (engine.Primitive, error)) func(sqlparser.Statement, *sqlparser.ReservedVars, ContextVSchema) (engine.Primitive, error) {
+	return func(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+		sel, ok := stmt.(sqlparser.SelectStatement)
+		if !ok || !hasCTE(sel) {
+			return f(stmt, reservedVars, vschema)
+		}
+
+		keyspace, allReference := cteKeyspace(sel, vschema)
+		switch {
+		case keyspace == nil:
+			inlined, err := inlineCTEs(sel)
+			if err != nil {
+				return nil, err
+			}
+			return f(inlined, reservedVars, vschema)
+		case !keyspace.Sharded:
+			return buildCTEPushdownPlan(engine.NewSimpleRoute(engine.SelectUnsharded, keyspace), sel), nil
+		case allReference:
+			return buildCTEPushdownPlan(engine.NewSimpleRoute(engine.SelectReference, keyspace), sel), nil
+		}
+
+		// The tables are in the same sharded keyspace. The statement can be sent
+		// down as is if the planner merges the inlined CTEs into a route to a single
+		// shard, which it only does when they use the same vindex value. Recursive
+		// CTEs can't be inlined, so their recursive references are replaced by the
+		// non-recursive part of the CTE, which reads the same tables.
+		probe, anchored := anchorRecursiveCTEs(sel)
+		inlined, err := inlineCTEs(probe)
+		if err != nil {
+			return nil, err
+		}
+		plan, err := f(inlined, reservedVars, vschema)
+		if err == nil {
+			if route, ok := plan.(*engine.Route); ok && route.Opcode == engine.SelectEqualUnique && route.Keyspace.Name == keyspace.Name {
+				return buildCTEPushdownPlan(route, sel), nil
+			}
+		}
+		if anchored {
+			// the probe can't be executed, report why the CTEs couldn't be inlined
+			_, err = inlineCTEs(sel)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return plan, nil
+	}
+}
+
+// hasCTE returns true if the statement or any of its subqueries
+// has a WITH clause.
+func hasCTE(stmt sqlparser.SelectStatement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if _, ok := node.(*sqlparser.With); ok {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// cteKeyspace returns the keyspace of all the tables referenced by the
+// statement outside of the CTE names, and whether they are all reference
+// tables. It returns nil if the tables are in different keyspaces, or if
+// some of them cannot be sent down unchanged.
+func cteKeyspace(stmt sqlparser.SelectStatement, vschema ContextVSchema) (*vindexes.Keyspace, bool) {
+	cteNames := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if cte, ok := node.(*sqlparser.CommonTableExpr); ok {
+			cteNames[cte.TableID.String()] = true
+		}
+		return true, nil
+	}, stmt)
+
+	var keyspace *vindexes.Keyspace
+	allReference := true
+	pushable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return pushable, nil
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok {
+			return pushable, nil
+		}
+		if tableName.Qualifier.IsEmpty() && cteNames[tableName.Name.String()] {
+			return true, nil
+		}
+		if sqlparser.SystemSchema(tableName.Qualifier.String()) {
+			pushable = false
+			return false, nil
+		}
+		vschemaTable, _, _, destination, err := vschema.FindTable(tableName)
+		if err != nil || destination != nil || vschemaTable.Name != tableName.Name {
+			pushable = false
+			return false, nil
+		}
+		switch vschemaTable.Type {
+		case "":
+			allReference = false
+		case vindexes.TypeReference:
+		default:
+			pushable = false
+			return false, nil
+		}
+		if keyspace != nil && keyspace.Name != vschemaTable.Keyspace.Name {
+			pushable = false
+			return false, nil
+		}
+		keyspace = vschemaTable.Keyspace
+		return true, nil
+	}, stmt)
+	if !pushable {
+		return nil, false
+	}
+	return keyspace, allReference
+}
+
+// buildCTEPushdownPlan sets the queries of eroute to the statement unchanged,
+// without the keyspace qualifiers of the tables.
+func buildCTEPushdownPlan(eroute *engine.Route, stmt sqlparser.SelectStatement) *engine.Route {
+	formatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if tableName, ok := node.(sqlparser.TableName); ok {
+			tableName.Name.Format(buf)
+			return
+		}
+		node.Format(buf)
+	}
+	buf := sqlparser.NewTrackedBuffer(formatter)
+	formatter(buf, stmt)
+	eroute.Query = buf.ParsedQuery().Query
+
+	fieldFormatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if tableName, ok := node.(sqlparser.TableName); ok {
+			tableName.Name.Format(buf)
+			return
+		}
+		sqlparser.FormatImpossibleQuery(buf, node)
+	}
+	buf = sqlparser.NewTrackedBuffer(fieldFormatter)
+	fieldFormatter(buf, stmt)
+	eroute.FieldQuery = buf.ParsedQuery().Query
+	return eroute
+}
+
+// anchorRecursiveCTEs returns a copy of stmt where the references of the
+// recursive CTEs to themselves are replaced by the first, non-recursive
+// query of their UNION. The result reads the same tables as stmt, but not
+// the same rows; it's only used to find out where stmt can be routed.
+// The second return value is false if stmt has no recursive CTEs.
+func anchorRecursiveCTEs(stmt sqlparser.SelectStatement) (sqlparser.SelectStatement, bool) {
+	stmt = sqlparser.CloneSelectStatement(stmt)
+	anchored := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		with, ok := node.(*sqlparser.With)
+		if !ok || !with.Recursive {
+			return true, nil
+		}
+		for _, cte := range with.CTEs {
+			name := cte.TableID.String()
+			union, ok := cte.Subquery.Select.(*sqlparser.Union)
+			if !ok || !referencesTable(union, name) || referencesTable(union.FirstStatement, name) {
+				continue
+			}
+			replaceCTEReferences(union, map[string]sqlparser.SelectStatement{name: union.FirstStatement})
+			anchored = true
+		}
+		return true, nil
+	}, stmt)
+	return stmt, anchored
+}
+
+// inlineCTEs rewrites every reference to a common table expression into
+// a derived table holding the CTE's query, and removes the WITH clauses.
+// Nested WITH clauses are resolved first, so that inner definitions
+// shadow outer ones. Recursive CTEs cannot be expressed as derived tables
+// and produce an error.
+func inlineCTEs(stmt sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+	stmt = sqlparser.CloneSelectStatement(stmt)
+	var err error
+	_ = sqlparser.Rewrite(stmt, nil, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			if node.With != nil {
+				with := node.With
+				node.With = nil
+				err = inlineWith(with, node)
+			}
+		case *sqlparser.Union:
+			if node.With != nil {
+				with := node.With
+				node.With = nil
+				err = inlineWith(with, node)
+			}
+		}
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// inlineWith replaces the references to the CTEs of with found in stmt.
+// A CTE may refer to the ones defined before it in the same WITH clause.
+func inlineWith(with *sqlparser.With, stmt sqlparser.SelectStatement) error {
+	bodies := map[string]sqlparser.SelectStatement{}
+	for _, cte := range with.CTEs {
+		name := cte.TableID.String()
+		if _, exists := bodies[name]; exists {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqTable, "Not unique table/alias: '%s'", name)
+		}
+		body := cte.Subquery.Select
+		if with.Recursive && referencesTable(body, name) {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression '%s' across shards", name)
+		}
+		replaceCTEReferences(body, bodies)
+		if err := setCTEColumns(cte, body); err != nil {
+			return err
+		}
+		bodies[name] = body
+	}
+	replaceCTEReferences(stmt, bodies)
+	return nil
+}
+
+// replaceCTEReferences turns every unqualified table reference to one of
+// the given CTEs into a derived table. The derived table keeps the CTE
+// name as its alias unless the reference was aliased.
+func replaceCTEReferences(stmt sqlparser.SelectStatement, bodies map[string]sqlparser.SelectStatement) {
+	if len(bodies) == 0 {
+		return
+	}
+	_ = sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		tableExpr, ok := cursor.Node().(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok || !tableName.Qualifier.IsEmpty() {
+			return true
+		}
+		body, ok := bodies[tableName.Name.String()]
+		if !ok {
+			return true
+		}
+		tableExpr.Expr = &sqlparser.DerivedTable{Select: cloneSelectStatement(body)}
+		if tableExpr.As.IsEmpty() {
+			tableExpr.As = tableName.Name
+		}
+		// The body has already been resolved, don't descend into it.
+		return false
+	}, nil)
+}
+
+// cloneSelectStatement makes a copy of stmt that can be planned on its own.
+// The generated clone shares the column names, which carry planning
+// metadata, so they are copied as well.
+func cloneSelectStatement(stmt sqlparser.SelectStatement) sqlparser.SelectStatement {
+	clone := sqlparser.CloneSelectStatement(stmt)
+	return sqlparser.Rewrite(clone, func(cursor *sqlparser.Cursor) bool {
+		if col, ok := cursor.Node().(*sqlparser.ColName); ok {
+			newCol := *col
+			cursor.Replace(&newCol)
+		}
+		return true
+	}, nil).(sqlparser.SelectStatement)
+}
+
+// setCTEColumns applies the column list of a CTE, if any, as aliases
+// of the select expressions of its query.
+func setCTEColumns(cte *sqlparser.CommonTableExpr, body sqlparser.SelectStatement) error {
+	if len(cte.Columns) == 0 {
+		return nil
+	}
+	sel := firstSelect(body)
+	if sel == nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column list in common table expression '%s'", cte.TableID.String())
+	}
+	var exprs []*sqlparser.AliasedExpr
+	for _, selectExpr := range sel.SelectExprs {
+		aliasedExpr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '*' expression in common table expression '%s' with a column list", cte.TableID.String())
+		}
+		exprs = append(exprs, aliasedExpr)
+	}
+	if len(exprs) != len(cte.Columns) {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.ViewWrongList, "In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
+	}
+	for i, aliasedExpr := range exprs {
+		aliasedExpr.As = cte.Columns[i]
+	}
+	return nil
+}
+
+// firstSelect returns the SELECT that defines the columns of stmt.
+func firstSelect(stmt sqlparser.SelectStatement) *sqlparser.Select {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return stmt
+	case *sqlparser.Union:
+		return firstSelect(stmt.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return firstSelect(stmt.Select)
+	}
+	return nil
+}
+
+// referencesTable returns true if stmt reads from the unqualified table name.
+func referencesTable(stmt sqlparser.SelectStatement, name string) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableExpr, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := tableExpr.Expr.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == name {
+				found = true
+			}
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*filter)(nil)
+
+// filter is used to build a Filter primitive.
+// It evaluates predicates that can't be sent to the tablets on the rows of its input.
+// It's only used by the Gen4 planner
+type filter struct {
+	input     logicalPlan
+	predicate sqlparser.Expr
+
+	efilter             evalengine.Expr
+	truncateColumnCount int
+}
+
+// Order implements the logicalPlan interface
+func (f *filter) Order() int {
+	panic("implement me")
+}
+
+// ResultColumns implements the logicalPlan interface
+func (f *filter) ResultColumns() []*resultColumn {
+	panic("implement me")
+}
+
+// Reorder implements the logicalPlan interface
+func (f *filter) Reorder(i int) {
+	panic("implement me")
+}
+
+// Wireup implements the logicalPlan interface
+func (f *filter) Wireup(lp logicalPlan, jt *jointab) error {
+	panic("implement me")
+}
+
+// WireupGen4 implements the logicalPlan interface
+// The columns used by the predicate are added to the input, and
+// removed again from the result once the predicate has been evaluated.
+func (f *filter) WireupGen4(semTable *semantics.SemTable) error {
+	origColCount, err := columnCount(f.input)
+	if err != nil {
+		return err
+	}
+	f.efilter, err = sqlparser.ConvertWithColumns(f.predicate, func(col *sqlparser.ColName) (int, *querypb.Field, error) {
+		offset, _, err := pushProjection(&sqlparser.AliasedExpr{Expr: col}, f.input, semTable, true)
+		if err != nil {
+			return 0, nil, err
+		}
+		// the collations of the columns are only known once the input returns its fields
+		return offset, &querypb.Field{}, nil
+	})
+	if err == sqlparser.ErrExprNotSupported {
+		return semantics.Gen4NotSupportedF("filter on %s", sqlparser.String(f.predicate))
+	}
+	if err != nil {
+		return err
+	}
+	colCount, err := columnCount(f.input)
+	if err != nil {
+		return err
+	}
+	if colCount > origColCount {
+		f.truncateColumnCount = origColCount
+	}
+	return f.input.WireupGen4(semTable)
+}
+
+// SupplyVar implements the logicalPlan interface
+func (f *filter) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	panic("implement me")
+}
+
+// SupplyCol implements the logicalPlan interface
+func (f *filter) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	panic("implement me")
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (f *filter) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	panic("implement me")
+}
+
+// Primitive implements the logicalPlan interface
+func (f *filter) Primitive() engine.Primitive {
+	return &engine.Filter{
+		Predicate:           f.efilter,
+		Input:               f.input.Primitive(),
+		TruncateColumnCount: f.truncateColumnCount,
+	}
+}
+
+// Inputs implements the logicalPlan interface
+func (f *filter) Inputs() []logicalPlan {
+	return []logicalPlan{f.input}
+}
+
+// Rewrite implements the logicalPlan interface
+func (f *filter) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 1 {
+		return vterrors.New(vtrpcpb.Code_INTERNAL, "[BUG]: expected only 1 input")
+	}
+	f.input = inputs[0]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (f *filter) ContainsTables() semantics.TableSet {
+	return f.input.ContainsTables()
+}
+
+// columnCount returns the number of columns returned by a plan built by the Gen4 planner
+func columnCount(plan logicalPlan) (int, error) {
+	switch node := plan.(type) {
+	case *route:
+		return node.Select.GetColumnCount(), nil
+	case *joinGen4:
+		return len(node.Cols), nil
+	case *filter:
+		return columnCount(node.input)
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unknown column count for %T", plan)
+}
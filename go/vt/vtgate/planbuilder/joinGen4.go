@@ -26,7 +26,7 @@ import (
 
 var _ logicalPlan = (*joinGen4)(nil)
 
-// joinGen4 is used to build a Join primitive.
+// joinGen4 is used to build a Join or a HashJoin primitive.
 // It's used to build an inner join and only used by the Gen4 planner
 type joinGen4 struct {
 	// Left and Right are the nodes for the join.
@@ -34,6 +34,10 @@ type joinGen4 struct {
 	Opcode      engine.JoinOpcode
 	Cols        []int
 	Vars        map[string]int
+
+	// LHSKeys and RHSKeys are only set for hash joins.
+	// They are the offsets of the columns compared by the join predicates.
+	LHSKeys, RHSKeys []int
 }
 
 // Order implements the logicalPlan interface
@@ -82,6 +86,16 @@ func (j *joinGen4) SupplyWeightString(colNumber int) (weightcolNumber int, err e
 
 // Primitive implements the logicalPlan interface
 func (j *joinGen4) Primitive() engine.Primitive {
+	if len(j.LHSKeys) > 0 {
+		return &engine.HashJoin{
+			Left:    j.Left.Primitive(),
+			Right:   j.Right.Primitive(),
+			Cols:    j.Cols,
+			Opcode:  j.Opcode,
+			LHSKeys: j.LHSKeys,
+			RHSKeys: j.RHSKeys,
+		}
+	}
 	return &engine.Join{
 		Left:   j.Left.Primitive(),
 		Right:  j.Right.Primitive(),
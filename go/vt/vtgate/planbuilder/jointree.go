@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"math"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -39,6 +40,9 @@ type (
 		clone() joinTree
 
 		pushOutputColumns([]*sqlparser.ColName, *semantics.SemTable) []int
+
+		// estimatedRows is a rough estimate of the number of rows returned by the joinTree
+		estimatedRows() int
 	}
 
 	relation interface {
@@ -110,6 +114,32 @@ type (
 		outer bool
 	}
 
+	// hashJoinPlan is a join that is executed by reading all the rows of the RHS
+	// into memory, and probing them with the rows of the LHS
+	hashJoinPlan struct {
+		// columns needed to feed other plans
+		columns []int
+
+		// the children of this plan
+		lhs, rhs joinTree
+
+		// lhsKeys and rhsKeys are the columns compared by the equality join predicates
+		lhsKeys, rhsKeys []*sqlparser.ColName
+
+		// predicates are the equality join predicates the keys were extracted from
+		predicates []sqlparser.Expr
+
+		outer bool
+	}
+
+	// filterPlan evaluates predicates on the rows of its source in vtgate.
+	// It's used for WHERE predicates on the outer side of a left join that
+	// could not be merged into a route.
+	filterPlan struct {
+		source     joinTree
+		predicates []sqlparser.Expr
+	}
+
 	parenTables []relation
 
 	// vindexPlusPredicates is a struct used to store all the predicates that the vindex can be used to query
@@ -127,6 +157,8 @@ type (
 // type assertions
 var _ joinTree = (*routePlan)(nil)
 var _ joinTree = (*joinPlan)(nil)
+var _ joinTree = (*hashJoinPlan)(nil)
+var _ joinTree = (*filterPlan)(nil)
 var _ relation = (*routeTable)(nil)
 var _ relation = (*leJoin)(nil)
 var _ relation = (parenTables)(nil)
@@ -236,6 +268,32 @@ func (rp *routePlan) cost() int {
 	return 1
 }
 
+// the row estimates are only used to compare plans with each other,
+// so they don't need to be more than ballpark numbers
+const (
+	estimatedScatterRows   = 10000
+	estimatedUnshardedRows = 1000
+	estimatedINRows        = 100
+	estimatedEqualRows     = 10
+)
+
+// estimatedRows implements the joinTree interface
+func (rp *routePlan) estimatedRows() int {
+	switch rp.routeOpCode {
+	case engine.SelectNone:
+		return 0
+	case engine.SelectEqualUnique, engine.SelectNext:
+		return 1
+	case engine.SelectEqual:
+		return estimatedEqualRows
+	case engine.SelectIN, engine.SelectMultiEqual:
+		return estimatedINRows
+	case engine.SelectScatter:
+		return estimatedScatterRows
+	}
+	return estimatedUnshardedRows
+}
+
 // addPredicate adds these predicates added to it. if the predicates can help,
 // they will improve the routeOpCode
 func (rp *routePlan) addPredicate(predicates ...sqlparser.Expr) error {
@@ -614,6 +672,19 @@ func (jp *joinPlan) clone() joinTree {
 	return result
 }
 
+// estimatedRows implements the joinTree interface
+// The RHS of a nested loop join is executed once for every row of the LHS
+func (jp *joinPlan) estimatedRows() int {
+	rows := jp.lhs.estimatedRows() * jp.rhs.estimatedRows()
+	if jp.outer && rows < jp.lhs.estimatedRows() {
+		return jp.lhs.estimatedRows()
+	}
+	if rows > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return rows
+}
+
 func (jp *joinPlan) pushOutputColumns(columns []*sqlparser.ColName, semTable *semantics.SemTable) []int {
 	var toTheLeft []bool
 	var lhs, rhs []*sqlparser.ColName
@@ -644,6 +715,91 @@ func (jp *joinPlan) pushOutputColumns(columns []*sqlparser.ColName, semTable *se
 	return outputColumns
 }
 
+func (hj *hashJoinPlan) tableID() semantics.TableSet {
+	return hj.lhs.tableID() | hj.rhs.tableID()
+}
+
+func (hj *hashJoinPlan) cost() int {
+	return hj.lhs.cost() + hj.rhs.cost()
+}
+
+// estimatedRows implements the joinTree interface
+// We don't know how selective the join predicates are,
+// so we assume that every row of the larger side matches a single row
+func (hj *hashJoinPlan) estimatedRows() int {
+	if hj.lhs.estimatedRows() > hj.rhs.estimatedRows() {
+		return hj.lhs.estimatedRows()
+	}
+	return hj.rhs.estimatedRows()
+}
+
+func (hj *hashJoinPlan) clone() joinTree {
+	return &hashJoinPlan{
+		columns:    append([]int(nil), hj.columns...),
+		lhs:        hj.lhs.clone(),
+		rhs:        hj.rhs.clone(),
+		lhsKeys:    hj.lhsKeys,
+		rhsKeys:    hj.rhsKeys,
+		predicates: hj.predicates,
+		outer:      hj.outer,
+	}
+}
+
+func (hj *hashJoinPlan) pushOutputColumns(columns []*sqlparser.ColName, semTable *semantics.SemTable) []int {
+	var toTheLeft []bool
+	var lhs, rhs []*sqlparser.ColName
+	for _, col := range columns {
+		col.Qualifier.Qualifier = sqlparser.NewTableIdent("")
+		if semTable.Dependencies(col).IsSolvedBy(hj.lhs.tableID()) {
+			lhs = append(lhs, col)
+			toTheLeft = append(toTheLeft, true)
+		} else {
+			rhs = append(rhs, col)
+			toTheLeft = append(toTheLeft, false)
+		}
+	}
+	lhsOffset := hj.lhs.pushOutputColumns(lhs, semTable)
+	rhsOffset := hj.rhs.pushOutputColumns(rhs, semTable)
+	outputColumns := make([]int, len(toTheLeft))
+	var l, r int
+	for i, isLeft := range toTheLeft {
+		outputColumns[i] = len(hj.columns)
+		if isLeft {
+			hj.columns = append(hj.columns, -lhsOffset[l]-1)
+			l++
+		} else {
+			hj.columns = append(hj.columns, rhsOffset[r]+1)
+			r++
+		}
+	}
+	return outputColumns
+}
+
+func (fp *filterPlan) tableID() semantics.TableSet {
+	return fp.source.tableID()
+}
+
+func (fp *filterPlan) cost() int {
+	return fp.source.cost()
+}
+
+func (fp *filterPlan) clone() joinTree {
+	return &filterPlan{
+		source:     fp.source.clone(),
+		predicates: fp.predicates,
+	}
+}
+
+func (fp *filterPlan) pushOutputColumns(columns []*sqlparser.ColName, semTable *semantics.SemTable) []int {
+	return fp.source.pushOutputColumns(columns, semTable)
+}
+
+// estimatedRows implements the joinTree interface
+// We don't know how selective the predicates are, so we use the estimate of the source
+func (fp *filterPlan) estimatedRows() int {
+	return fp.source.estimatedRows()
+}
+
 // costFor returns a cost struct to make route choices easier to compare
 func costFor(foundVindex vindexes.Vindex, opcode engine.RouteOpcode) cost {
 	switch opcode {
@@ -36,6 +36,12 @@ func transformToLogicalPlan(tree joinTree, semTable *semantics.SemTable) (logica
 
 	case *joinPlan:
 		return transformJoinPlan(n, semTable)
+
+	case *hashJoinPlan:
+		return transformHashJoinPlan(n, semTable)
+
+	case *filterPlan:
+		return transformFilterPlan(n, semTable)
 	}
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unknown type encountered: %T", tree)
@@ -63,6 +69,51 @@ func transformJoinPlan(n *joinPlan, semTable *semantics.SemTable) (logicalPlan,
 	}, nil
 }
 
+func transformHashJoinPlan(n *hashJoinPlan, semTable *semantics.SemTable) (logicalPlan, error) {
+	lhs, err := transformToLogicalPlan(n.lhs, semTable)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := transformToLogicalPlan(n.rhs, semTable)
+	if err != nil {
+		return nil, err
+	}
+	opCode := engine.InnerJoin
+	if n.outer {
+		opCode = engine.LeftJoin
+	}
+	join := &joinGen4{
+		Left:   lhs,
+		Right:  rhs,
+		Cols:   n.columns,
+		Opcode: opCode,
+	}
+	for i, lhsKey := range n.lhsKeys {
+		lhsOffset, err := wrapExprAndPush(lhsKey, lhs, semTable)
+		if err != nil {
+			return nil, err
+		}
+		rhsOffset, err := wrapExprAndPush(n.rhsKeys[i], rhs, semTable)
+		if err != nil {
+			return nil, err
+		}
+		join.LHSKeys = append(join.LHSKeys, lhsOffset)
+		join.RHSKeys = append(join.RHSKeys, rhsOffset)
+	}
+	return join, nil
+}
+
+func transformFilterPlan(n *filterPlan, semTable *semantics.SemTable) (logicalPlan, error) {
+	input, err := transformToLogicalPlan(n.source, semTable)
+	if err != nil {
+		return nil, err
+	}
+	return &filter{
+		input:     input,
+		predicate: sqlparser.AndExpressions(n.predicates...),
+	}, nil
+}
+
 func transformRoutePlan(n *routePlan) (*route, error) {
 	var tablesForSelect sqlparser.TableExprs
 	tableNameMap := map[string]interface{}{}
@@ -25,7 +25,10 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var _ logicalPlan = (*orderedAggregate)(nil)
@@ -58,9 +61,31 @@ var _ logicalPlan = (*orderedAggregate)(nil)
 type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
+	// concatOrder is the order by clause of a group_concat. Its columns
+	// are added to the group by and order by clauses of the route, which
+	// allows the partial results to be concatenated in the right order.
+	concatOrder sqlparser.OrderBy
+	// hiddenColumns are the additional expressions that must be sent
+	// to the route to compute aggregates like avg.
+	hiddenColumns []hiddenColumn
 	eaggr         *engine.OrderedAggregate
 }
 
+// hiddenColumn is an expression that's sent to the route only to
+// compute an aggregate. It's not part of the result. For example:
+// 'select avg(col) from t' is sent to the scatter route as
+// 'select sum(col), count(col) from t', and count(col) is hidden.
+// Hidden columns are pushed after the select list is complete
+// because the result columns of oa must match the ones of the route.
+type hiddenColumn struct {
+	expr *sqlparser.AliasedExpr
+	// aggr is the index of the aggregate that needs the column.
+	aggr int
+	// varPop is true if the column is the population variance of
+	// the values. Otherwise, it's the count of the values.
+	varPop bool
+}
+
 // checkAggregates analyzes the select expression for aggregates. If it determines
 // that a primitive is needed to handle the aggregation, it builds an orderedAggregate
 // primitive and returns it. It returns a groupByHandler if there is aggregation it
@@ -239,17 +264,31 @@ func (oa *orderedAggregate) Primitive() engine.Primitive {
 func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin logicalPlan) (rc *resultColumn, colNumber int, err error) {
 	funcExpr := expr.Expr.(*sqlparser.FuncExpr)
 	opcode := engine.SupportedAggregates[funcExpr.Name.Lowered()]
-	if len(funcExpr.Exprs) != 1 {
+	switch {
+	case opcode == engine.AggregateJSONObjectAgg:
+		if len(funcExpr.Exprs) != 2 {
+			return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+	case len(funcExpr.Exprs) != 1:
 		return nil, 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
 	}
 	handleDistinct, innerAliased, err := oa.needDistinctHandling(pb, funcExpr, opcode)
 	if err != nil {
 		return nil, 0, err
 	}
+	var alias string
+	if expr.As.IsEmpty() {
+		alias = sqlparser.String(expr.Expr)
+	} else {
+		alias = expr.As.String()
+	}
 	if handleDistinct {
 		if oa.extraDistinct != nil {
 			return nil, 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(funcExpr))
 		}
+		if oa.concatOrder != nil {
+			return nil, 0, fmt.Errorf("unsupported: distinct aggregation and group_concat with order by in a select: %s", sqlparser.String(funcExpr))
+		}
 		// Push the expression that's inside the aggregate.
 		// The column will eventually get added to the group by and order by clauses.
 		newBuilder, _, innerCol, err := planProjection(pb, oa.input, innerAliased, origin)
@@ -263,12 +302,6 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 		}
 		oa.extraDistinct = col
 		oa.eaggr.PreProcess = true
-		var alias string
-		if expr.As.IsEmpty() {
-			alias = sqlparser.String(expr.Expr)
-		} else {
-			alias = expr.As.String()
-		}
 		switch opcode {
 		case engine.AggregateCount:
 			opcode = engine.AggregateCountDistinct
@@ -281,15 +314,45 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 			Alias:  alias,
 		})
 	} else {
-		newBuilder, _, innerCol, err := planProjection(pb, oa.input, expr, origin)
+		// count and sum get here with distinct if their argument is a unique
+		// vindex, and distinct doesn't change the result of min and max.
+		if funcExpr.Distinct && opcode > engine.AggregateMax {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: distinct aggregation: %s", sqlparser.String(funcExpr))
+		}
+		params := engine.AggregateParams{Opcode: opcode}
+		pushed := expr
+		if opcode == engine.AggregateAvg || isVarianceAggregate(opcode) {
+			// The aggregate is computed from the sum and the count of the values,
+			// and their population variance for the variance. The sum takes the
+			// place of the aggregate, while the others are hidden columns.
+			argAliased, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+			}
+			arg := argAliased.Expr
+			pushed = &sqlparser.AliasedExpr{Expr: aggregateFunc("sum", arg), As: expr.As}
+			params.Alias = alias
+			oa.eaggr.PreProcess = true
+			aggr := len(oa.eaggr.Aggregates)
+			oa.hiddenColumns = append(oa.hiddenColumns, hiddenColumn{
+				expr: &sqlparser.AliasedExpr{Expr: aggregateFunc("count", arg)},
+				aggr: aggr,
+			})
+			if opcode != engine.AggregateAvg {
+				oa.hiddenColumns = append(oa.hiddenColumns, hiddenColumn{
+					expr:   &sqlparser.AliasedExpr{Expr: aggregateFunc("var_pop", arg)},
+					aggr:   aggr,
+					varPop: true,
+				})
+			}
+		}
+		newBuilder, _, innerCol, err := planProjection(pb, oa.input, pushed, origin)
 		if err != nil {
 			return nil, 0, err
 		}
 		pb.plan = newBuilder
-		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
-			Opcode: opcode,
-			Col:    innerCol,
-		})
+		params.Col = innerCol
+		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, params)
 	}
 
 	// Build a new rc with oa as origin because it's semantically different
@@ -299,6 +362,110 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 	return rc, len(oa.resultColumns) - 1, nil
 }
 
+// pushGroupConcat pushes a group_concat down to the route, and concatenates
+// the partial results. If the group_concat has an order by clause, its
+// columns are added to the grouping, which makes the route return the
+// partial results in the requested order.
+func (oa *orderedAggregate) pushGroupConcat(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin logicalPlan) (rc *resultColumn, colNumber int, err error) {
+	gc := expr.Expr.(*sqlparser.GroupConcatExpr)
+	if gc.Distinct {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: distinct aggregation: %s", sqlparser.String(gc))
+	}
+	if gc.Limit != nil {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: group_concat with limit: %s", sqlparser.String(gc))
+	}
+	if len(gc.OrderBy) != 0 {
+		if oa.concatOrder != nil {
+			return nil, 0, fmt.Errorf("unsupported: only one group_concat with order by allowed in a select: %s", sqlparser.String(gc))
+		}
+		if oa.extraDistinct != nil {
+			return nil, 0, fmt.Errorf("unsupported: distinct aggregation and group_concat with order by in a select: %s", sqlparser.String(gc))
+		}
+		for _, order := range gc.OrderBy {
+			if _, ok := order.Expr.(*sqlparser.ColName); !ok {
+				return nil, 0, fmt.Errorf("unsupported: in scatter query: complex order by expression: %s", sqlparser.String(order.Expr))
+			}
+		}
+		oa.concatOrder = gc.OrderBy
+	}
+	newBuilder, _, innerCol, err := planProjection(pb, oa.input, expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	pb.plan = newBuilder
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+		Opcode:    engine.AggregateGroupConcat,
+		Col:       innerCol,
+		Separator: gc.SeparatorValue(),
+	})
+
+	rc = newResultColumn(expr, oa)
+	oa.resultColumns = append(oa.resultColumns, rc)
+	return rc, len(oa.resultColumns) - 1, nil
+}
+
+// pushHiddenColumns pushes the hidden columns and the columns of concatOrder
+// to the route. It must be called once the select list is complete.
+// The columns of concatOrder are also added to the group by clause of the
+// route. They're added to the order by clause by the caller.
+func (oa *orderedAggregate) pushHiddenColumns(pb *primitiveBuilder) error {
+	if len(oa.hiddenColumns) == 0 && len(oa.concatOrder) == 0 {
+		return nil
+	}
+	for _, hc := range oa.hiddenColumns {
+		newInput, _, colNumber, err := planProjection(pb, oa.input, hc.expr, oa.input)
+		if err != nil {
+			return err
+		}
+		oa.input = newInput
+		if hc.varPop {
+			oa.eaggr.Aggregates[hc.aggr].VarPopCol = colNumber
+		} else {
+			oa.eaggr.Aggregates[hc.aggr].CountCol = colNumber
+		}
+	}
+	if len(oa.concatOrder) != 0 {
+		rb, ok := oa.input.(*route)
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected input for group_concat: %T", oa.input)
+		}
+		sel := rb.Select.(*sqlparser.Select)
+		for _, order := range oa.concatOrder {
+			col := order.Expr.(*sqlparser.ColName)
+			rb.SupplyCol(col)
+			if !groupByHasColumn(sel.GroupBy, col) {
+				sel.GroupBy = append(sel.GroupBy, col)
+			}
+		}
+	}
+	oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	return nil
+}
+
+func groupByHasColumn(groupBy sqlparser.GroupBy, col *sqlparser.ColName) bool {
+	for _, expr := range groupBy {
+		if groupCol, ok := expr.(*sqlparser.ColName); ok && groupCol.Metadata == col.Metadata {
+			return true
+		}
+	}
+	return false
+}
+
+func isVarianceAggregate(opcode engine.AggregateOpcode) bool {
+	switch opcode {
+	case engine.AggregateStddevPop, engine.AggregateStddevSamp, engine.AggregateVarPop, engine.AggregateVarSamp:
+		return true
+	}
+	return false
+}
+
+func aggregateFunc(name string, arg sqlparser.Expr) *sqlparser.FuncExpr {
+	return &sqlparser.FuncExpr{
+		Name:  sqlparser.NewColIdent(name),
+		Exprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: arg}},
+	}
+}
+
 // needDistinctHandling returns true if oa needs to handle the distinct clause.
 // If true, it will also return the aliased expression that needs to be pushed
 // down into the underlying route.
@@ -75,6 +75,10 @@ func planOAOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, oa *ordered
 		}
 	}
 
+	if err := oa.pushHiddenColumns(pb); err != nil {
+		return nil, err
+	}
+
 	// referenced tracks the keys referenced by the order by clause.
 	referenced := make([]bool, len(oa.eaggr.Keys))
 	postSort := false
@@ -136,6 +140,9 @@ func planOAOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, oa *ordered
 		selOrderBy = append(selOrderBy, &sqlparser.Order{Expr: oa.extraDistinct, Direction: sqlparser.AscOrder})
 	}
 
+	// Append the order of the group_concat if any.
+	selOrderBy = append(selOrderBy, oa.concatOrder...)
+
 	// Push down the order by.
 	// It's ok to push the original AST down because all references
 	// should point to the route. Only aggregate functions are originated
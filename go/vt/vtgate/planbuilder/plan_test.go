@@ -188,9 +188,12 @@ func TestPlan(t *testing.T) {
 	testFile(t, "use_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "set_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "union_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "cte_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "transaction_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "lock_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "large_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "result_cache_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "ddl_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
 	testFile(t, "flush_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
 	testFile(t, "show_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
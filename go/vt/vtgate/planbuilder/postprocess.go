@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 )
 
 // This file has functions to analyze postprocessing
@@ -97,7 +98,7 @@ var _ planVisitor = setUpperLimit
 func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 	arg := sqlparser.NewArgument("__upper_limit")
 	switch node := plan.(type) {
-	case *join, *joinGen4:
+	case *join, *joinGen4, *filter:
 		return false, node, nil
 	case *memorySort:
 		pv, err := sqlparser.NewPlanValue(arg)
@@ -130,19 +131,26 @@ func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 
 func createLimit(input logicalPlan, limit *sqlparser.Limit) (logicalPlan, error) {
 	plan := newLimit(input)
+	if err := setLimitValues(plan.elimit, limit); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// setLimitValues sets the count and the offset of elimit from the LIMIT clause.
+func setLimitValues(elimit *engine.Limit, limit *sqlparser.Limit) error {
 	pv, err := sqlparser.NewPlanValue(limit.Rowcount)
 	if err != nil {
-		return nil, vterrors.Wrap(err, "unexpected expression in LIMIT")
+		return vterrors.Wrap(err, "unexpected expression in LIMIT")
 	}
-	plan.elimit.Count = pv
+	elimit.Count = pv
 
 	if limit.Offset != nil {
 		pv, err = sqlparser.NewPlanValue(limit.Offset)
 		if err != nil {
-			return nil, vterrors.Wrap(err, "unexpected expression in OFFSET")
+			return vterrors.Wrap(err, "unexpected expression in OFFSET")
 		}
-		plan.elimit.Offset = pv
+		elimit.Offset = pv
 	}
-
-	return plan, nil
+	return nil
 }
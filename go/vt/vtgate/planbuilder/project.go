@@ -81,6 +81,13 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 				return node, rc, colNumber, nil
 			}
 		}
+		if _, ok := expr.Expr.(*sqlparser.GroupConcatExpr); ok {
+			rc, colNumber, err := node.pushGroupConcat(pb, expr, origin)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			return node, rc, colNumber, nil
+		}
 
 		// Ensure that there are no aggregates in the expression.
 		if nodeHasAggregates(expr.Expr) {
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// setReplicaReadable marks the SELECT plans that can read from replicas
+// in the sessions that split their reads and writes. The locking reads,
+// the reads of sequences, of the system schemas and of the tables with
+// read_from_primary stay on the primary.
+func setReplicaReadable(plan *engine.Plan, stmt sqlparser.SelectStatement, vschema ContextVSchema) {
+	sel := firstSelect(stmt)
+	if sel == nil || sel.Into != nil {
+		return
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		if stmt.Lock != sqlparser.NoLock {
+			return
+		}
+	case *sqlparser.Union:
+		if stmt.Lock != sqlparser.NoLock {
+			return
+		}
+	}
+	switch instructions := plan.Instructions.(type) {
+	case *engine.Lock:
+		return
+	case *engine.Route:
+		if instructions.Opcode == engine.SelectNext {
+			return
+		}
+	}
+
+	tables, resolved := selectTables(stmt, vschema)
+	if !resolved {
+		return
+	}
+	for _, table := range tables {
+		if table.ReadFromPrimary || table.Type == vindexes.TypeSequence {
+			return
+		}
+	}
+	plan.ReplicaReadable = true
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// setResultCache sets how long vtgate caches the results of a SELECT
+// plan, and the tables they are invalidated by. The CACHE_TTL comment
+// directive of the query has precedence over the cache_ttl of its vschema
+// tables, which applies only if all the tables read have one. The results
+// are not cached if a table cannot be resolved, since the cache would not
+// be invalidated by the writes to that table.
+func setResultCache(plan *engine.Plan, stmt sqlparser.SelectStatement, vschema ContextVSchema) {
+	sel := firstSelect(stmt)
+	if sel == nil || sel.Into != nil || sel.SQLCalcFoundRows || (sel.Cache != nil && !*sel.Cache) {
+		return
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		if stmt.Lock != sqlparser.NoLock {
+			return
+		}
+	case *sqlparser.Union:
+		if stmt.Lock != sqlparser.NoLock {
+			return
+		}
+	}
+
+	tables, tablesTTL, resolved := resultCacheTables(stmt, vschema)
+	if !resolved {
+		return
+	}
+	ttl, ok := resultCacheTTLDirective(sqlparser.ExtractCommentDirectives(sel.Comments))
+	if !ok {
+		ttl = tablesTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+	plan.ResultCacheTTL = ttl
+	plan.ResultCacheTables = tables
+}
+
+// resultCacheTTLDirective returns the value of the CACHE_TTL directive,
+// either a duration or a number of seconds, and whether it's set.
+func resultCacheTTLDirective(d sqlparser.CommentDirectives) (time.Duration, bool) {
+	val, ok := d[sqlparser.DirectiveCacheTTL]
+	if !ok {
+		return 0, false
+	}
+	switch val := val.(type) {
+	case int:
+		return time.Duration(val) * time.Second, true
+	case string:
+		ttl, err := time.ParseDuration(val)
+		if err != nil {
+			// An invalid directive disables the cache.
+			return 0, true
+		}
+		return ttl, true
+	}
+	return 0, true
+}
+
+// resultCacheTables returns the keyspace-qualified tables read by stmt,
+// the smallest cache_ttl of their vschema tables, and whether all of
+// them could be resolved. The cache_ttl is 0 if a table has none.
+func resultCacheTables(stmt sqlparser.SelectStatement, vschema ContextVSchema) ([]string, time.Duration, bool) {
+	readTables, resolved := selectTables(stmt, vschema)
+	allTTL := true
+	var ttl time.Duration
+	seen := make(map[string]bool)
+	var tables []string
+	for _, table := range readTables {
+		if table.CacheTTL <= 0 {
+			allTTL = false
+		} else if ttl == 0 || table.CacheTTL < ttl {
+			ttl = table.CacheTTL
+		}
+		name := table.Keyspace.Name + "." + table.Name.String()
+		if !seen[name] {
+			seen[name] = true
+			tables = append(tables, name)
+		}
+	}
+	sort.Strings(tables)
+	if !allTTL {
+		ttl = 0
+	}
+	return tables, ttl, resolved
+}
+
+// selectTables returns the vschema tables read by stmt, except dual, and
+// whether all of them could be resolved. The tables of the system schemas
+// are not resolved.
+func selectTables(stmt sqlparser.SelectStatement, vschema ContextVSchema) ([]*vindexes.Table, bool) {
+	var tableNames []sqlparser.TableName
+	cteNames := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.CommonTableExpr:
+			cteNames[node.TableID.String()] = true
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok {
+				tableNames = append(tableNames, tableName)
+			}
+		}
+		return true, nil
+	}, stmt)
+
+	resolved := true
+	var tables []*vindexes.Table
+	for _, tableName := range tableNames {
+		if tableName.Qualifier.IsEmpty() && cteNames[tableName.Name.String()] {
+			continue
+		}
+		if sqlparser.SystemSchema(tableName.Qualifier.String()) {
+			resolved = false
+			continue
+		}
+		table, _, _, _, err := vschema.FindTable(tableName)
+		if err != nil || table == nil || table.Keyspace == nil {
+			resolved = false
+			continue
+		}
+		if table.Name.String() == "dual" {
+			continue
+		}
+		tables = append(tables, table)
+	}
+	return tables, resolved
+}
@@ -21,8 +21,10 @@ import (
 	"io"
 	"sort"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -51,6 +53,9 @@ func newBuildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.P
 	if len(directives) > 0 {
 		return nil, semantics.Gen4NotSupportedF("comment directives")
 	}
+	if nodeHasWindowFuncs(sel.SelectExprs, sel.OrderBy) {
+		return nil, semantics.Gen4NotSupportedF("window functions")
+	}
 	keyspace, err := vschema.DefaultKeyspace()
 	if err != nil {
 		return nil, err
@@ -114,7 +119,14 @@ func optimizeQuery(opTree abstract.Operator, semTable *semantics.SemTable, vsche
 		if err != nil {
 			return nil, err
 		}
-		return mergeOrJoin(treeInner, treeOuter, []sqlparser.Expr{op.Predicate}, semTable, false)
+		tree, err := mergeOrJoin(treeInner, treeOuter, []sqlparser.Expr{op.Predicate}, semTable, false)
+		if err != nil {
+			return nil, err
+		}
+		if op.Filter == nil {
+			return tree, nil
+		}
+		return pushFilter(tree, sqlparser.SplitAndExpression(nil, op.Filter))
 	case *abstract.Join:
 		treeInner, err := optimizeQuery(op.LHS, semTable, vschema)
 		if err != nil {
@@ -131,6 +143,21 @@ func optimizeQuery(opTree abstract.Operator, semTable *semantics.SemTable, vsche
 	}
 }
 
+// pushFilter adds the predicates to the route when the join could be merged into one,
+// and otherwise evaluates them on the result of the join
+func pushFilter(tree joinTree, predicates []sqlparser.Expr) (joinTree, error) {
+	if rp, ok := tree.(*routePlan); ok {
+		if err := rp.addPredicate(predicates...); err != nil {
+			return nil, err
+		}
+		return rp, nil
+	}
+	return &filterPlan{
+		source:     tree,
+		predicates: predicates,
+	}, nil
+}
+
 func planLimit(limit *sqlparser.Limit, plan logicalPlan) (logicalPlan, error) {
 	if limit == nil {
 		return plan, nil
@@ -268,6 +295,38 @@ func pushJoinPredicate(exprs []sqlparser.Expr, tree joinTree, semTable *semantic
 			rhs:   rhsPlan,
 			outer: node.outer,
 		}, nil
+
+	case *hashJoinPlan:
+		node = node.clone().(*hashJoinPlan)
+		var lhsPreds, rhsPreds []sqlparser.Expr
+		for _, expr := range exprs {
+			deps := semTable.Dependencies(expr)
+			switch {
+			case deps.IsSolvedBy(node.rhs.tableID()):
+				rhsPreds = append(rhsPreds, expr)
+			case !node.outer && deps.IsSolvedBy(node.lhs.tableID()):
+				lhsPreds = append(lhsPreds, expr)
+			default:
+				// the predicate needs both sides of the join,
+				// so we fall back to a nested loop join
+				tree := &joinPlan{lhs: node.lhs, rhs: node.rhs, outer: node.outer}
+				return pushJoinPredicate(append(node.predicates, exprs...), tree, semTable)
+			}
+		}
+		var err error
+		if len(lhsPreds) > 0 {
+			node.lhs, err = pushJoinPredicate(lhsPreds, node.lhs, semTable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(rhsPreds) > 0 {
+			node.rhs, err = pushJoinPredicate(rhsPreds, node.rhs, semTable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return node, nil
 	default:
 		panic(fmt.Sprintf("BUG: unknown type %T", node))
 	}
@@ -309,7 +368,143 @@ func mergeOrJoin(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *s
 	}
 
 	tree := &joinPlan{lhs: lhs.clone(), rhs: rhs.clone(), outer: !inner}
-	return pushJoinPredicate(joinPredicates, tree, semTable)
+	nested, err := pushJoinPredicate(joinPredicates, tree, semTable)
+	if err != nil {
+		return nil, err
+	}
+	nestedJoin, ok := nested.(*joinPlan)
+	if !ok || !preferHashJoin(nestedJoin) {
+		return nested, nil
+	}
+	hashJoin, err := createHashJoin(lhs, rhs, joinPredicates, semTable, inner)
+	if err != nil || hashJoin == nil {
+		return nested, err
+	}
+	return hashJoin, nil
+}
+
+// hashJoinMinRows is the estimated number of rows the LHS of a nested loop join
+// needs to return before we consider replacing it with a hash join
+const hashJoinMinRows = 100
+
+// preferHashJoin returns true if the nested loop join would have to send a
+// scatter query for each one of the many rows returned by its LHS
+func preferHashJoin(nested *joinPlan) bool {
+	return nested.lhs.estimatedRows() >= hashJoinMinRows &&
+		nested.rhs.estimatedRows() >= estimatedScatterRows
+}
+
+// createHashJoin returns a hash join for the given inputs, or nil if the join
+// predicates are not equalities between the two sides, or compare columns
+// that can't be hashed, see hashableKeys.
+// For inner joins, the side that is expected to return the fewest rows is
+// used as the RHS, since its rows are kept in memory.
+func createHashJoin(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable, inner bool) (joinTree, error) {
+	var lhsPreds, rhsPreds []sqlparser.Expr
+	var lhsKeys, rhsKeys []*sqlparser.ColName
+	var keyPreds []sqlparser.Expr
+	for _, expr := range joinPredicates {
+		for _, predicate := range sqlparser.SplitAndExpression(nil, expr) {
+			deps := semTable.Dependencies(predicate)
+			switch {
+			case deps.IsSolvedBy(rhs.tableID()):
+				rhsPreds = append(rhsPreds, predicate)
+			case inner && deps.IsSolvedBy(lhs.tableID()):
+				lhsPreds = append(lhsPreds, predicate)
+			default:
+				lhsKey, rhsKey := hashJoinKeys(predicate, lhs.tableID(), rhs.tableID(), semTable)
+				if lhsKey == nil || !hashableKeys(lhsKey, rhsKey, semTable) {
+					return nil, nil
+				}
+				lhsKeys = append(lhsKeys, lhsKey)
+				rhsKeys = append(rhsKeys, rhsKey)
+				keyPreds = append(keyPreds, predicate)
+			}
+		}
+	}
+	if len(lhsKeys) == 0 {
+		return nil, nil
+	}
+
+	newLHS, newRHS := lhs.clone(), rhs.clone()
+	var err error
+	if len(lhsPreds) > 0 {
+		newLHS, err = pushJoinPredicate(lhsPreds, newLHS, semTable)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(rhsPreds) > 0 {
+		newRHS, err = pushJoinPredicate(rhsPreds, newRHS, semTable)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if inner && newLHS.estimatedRows() < newRHS.estimatedRows() {
+		newLHS, newRHS = newRHS, newLHS
+		lhsKeys, rhsKeys = rhsKeys, lhsKeys
+	}
+	return &hashJoinPlan{
+		lhs:        newLHS,
+		rhs:        newRHS,
+		lhsKeys:    lhsKeys,
+		rhsKeys:    rhsKeys,
+		predicates: keyPreds,
+		outer:      !inner,
+	}, nil
+}
+
+// hashJoinKeys returns the columns compared by an equality predicate between the LHS and the RHS
+func hashJoinKeys(predicate sqlparser.Expr, lhs, rhs semantics.TableSet, semTable *semantics.SemTable) (*sqlparser.ColName, *sqlparser.ColName) {
+	cmp, ok := predicate.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	left, lok := cmp.Left.(*sqlparser.ColName)
+	right, rok := cmp.Right.(*sqlparser.ColName)
+	if !lok || !rok {
+		return nil, nil
+	}
+	switch {
+	case semTable.Dependencies(left).IsSolvedBy(lhs) && semTable.Dependencies(right).IsSolvedBy(rhs):
+		return left, right
+	case semTable.Dependencies(right).IsSolvedBy(lhs) && semTable.Dependencies(left).IsSolvedBy(rhs):
+		return right, left
+	}
+	return nil, nil
+}
+
+// hashableKeys returns true if the hash join can compare the values of the two columns.
+// Their types must be known, and they must be compared either as numbers or byte by byte:
+// the collations of text columns are unknown until the tablets return them.
+func hashableKeys(lhsKey, rhsKey *sqlparser.ColName, semTable *semantics.SemTable) bool {
+	ltyp, rtyp := columnType(lhsKey, semTable), columnType(rhsKey, semTable)
+	switch {
+	case ltyp == sqltypes.Null || rtyp == sqltypes.Null:
+		return false
+	case sqltypes.IsNumber(ltyp) || sqltypes.IsNumber(rtyp):
+		return true
+	}
+	return ltyp == rtyp && !sqltypes.IsText(ltyp)
+}
+
+// columnType returns the type of a column as declared in the vschema,
+// or sqltypes.Null if it's unknown.
+func columnType(col *sqlparser.ColName, semTable *semantics.SemTable) querypb.Type {
+	deps := semTable.Dependencies(col)
+	if deps.NumberOfTables() != 1 {
+		return sqltypes.Null
+	}
+	tbl, err := semTable.TableInfoFor(deps)
+	if err != nil {
+		return sqltypes.Null
+	}
+	for _, info := range tbl.GetColumns() {
+		if col.Name.EqualString(info.Name) {
+			return info.Type
+		}
+	}
+	return sqltypes.Null
 }
 
 type (
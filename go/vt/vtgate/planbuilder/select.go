@@ -56,7 +56,17 @@ func buildSelectPlan(query string) func(sqlparser.Statement, *sqlparser.Reserved
 			return pb.plan, nil
 		}
 
+		var windowSel *sqlparser.Select
+		if nodeHasWindowFuncs(sel.SelectExprs, sel.OrderBy) {
+			// planning modifies the query, keep a copy in case the
+			// window functions have to be computed by vtgate.
+			windowSel = cloneSelectStatement(sel).(*sqlparser.Select)
+		}
+
 		plan, err := getPlan(sel)
+		if err == errWindowFuncsNotPushable {
+			return buildWindowPlan(windowSel, reservedVars, vschema)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -195,6 +205,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindowFuncs(sel, query); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel, reservedVars); err != nil {
 		return err
 	}
@@ -223,6 +236,7 @@ func setMiscFunc(in logicalPlan, sel *sqlparser.Select) error {
 			}
 			query.Comments = sel.Comments
 			query.Lock = sel.Lock
+			query.Windows = sel.Windows
 			if sel.Into != nil {
 				if node.eroute.Opcode != engine.SelectUnsharded {
 					return false, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "INTO is not supported on sharded keyspace")
@@ -253,6 +267,14 @@ func buildSQLCalcFoundRowsPlan(query string, sel *sqlparser.Select, reservedVars
 		return nil, err
 	}
 	sel2 := statement2.(*sqlparser.Select)
+	if hasCTE(sel2) {
+		// the query is planned with its CTEs inlined, so the count query has to be as well
+		inlined, err := inlineCTEs(sel2)
+		if err != nil {
+			return nil, err
+		}
+		sel2 = inlined.(*sqlparser.Select)
+	}
 
 	sel2.SQLCalcFoundRows = false
 	sel2.OrderBy = nil
@@ -85,6 +85,8 @@ func pushProjection(expr *sqlparser.AliasedExpr, plan logicalPlan, semTable *sem
 		}
 		node.Cols = append(node.Cols, column)
 		return len(node.Cols) - 1, true, nil
+	case *filter:
+		return pushProjection(expr, node.input, semTable, inner)
 	default:
 		return 0, false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%T not yet supported", node)
 	}
@@ -136,6 +138,9 @@ func planAggregations(qp *queryProjection, plan logicalPlan, semTable *semantics
 		}
 		fExpr := e.Expr.(*sqlparser.FuncExpr)
 		opcode := engine.SupportedAggregates[fExpr.Name.Lowered()]
+		if opcode == engine.AggregateAvg || isVarianceAggregate(opcode) {
+			return nil, semantics.Gen4NotSupportedF("aggregate function %s", fExpr.Name.Lowered())
+		}
 		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
 			Opcode: opcode,
 			Col:    offset,
@@ -150,6 +155,8 @@ func planOrderBy(qp *queryProjection, orderExprs []orderBy, plan logicalPlan, se
 		return planOrderByForRoute(orderExprs, plan, semTable)
 	case *joinGen4:
 		return planOrderByForJoin(qp, orderExprs, plan, semTable)
+	case *filter:
+		return createMemorySortPlan(orderExprs, plan, semTable)
 	default:
 		return nil, semantics.Gen4NotSupportedF("ordering on complex query")
 	}
@@ -233,7 +240,11 @@ func planOrderByForJoin(qp *queryProjection, orderExprs []orderBy, plan *joinGen
 		plan.Left = newLeft
 		return plan, nil
 	}
+	return createMemorySortPlan(orderExprs, plan, semTable)
+}
 
+// createMemorySortPlan sorts the rows of plan in vtgate
+func createMemorySortPlan(orderExprs []orderBy, plan logicalPlan, semTable *semantics.SemTable) (logicalPlan, error) {
 	primitive := &engine.MemorySort{}
 	ms := &memorySort{
 		resultsBuilder: resultsBuilder{
@@ -278,7 +289,6 @@ func planOrderByForJoin(qp *queryProjection, orderExprs []orderBy, plan *joinGen
 	}
 
 	return ms, nil
-
 }
 
 func allLeft(orderExprs []orderBy, semTable *semantics.SemTable, lhsTables semantics.TableSet) bool {
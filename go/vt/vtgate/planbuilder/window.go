@@ -0,0 +1,373 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strconv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// errWindowFuncsNotPushable is returned by processSelect when the window
+// functions of a top level query cannot be computed by the shards.
+// buildSelectPlan then computes them at the vtgate level.
+var errWindowFuncsNotPushable = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window functions")
+
+// windowOver returns the OVER clause of expr if it is a window function call.
+func windowOver(expr sqlparser.SQLNode) *sqlparser.OverClause {
+	switch expr := expr.(type) {
+	case *sqlparser.WindowFuncExpr:
+		return expr.Over
+	case *sqlparser.FuncExpr:
+		return expr.Over
+	}
+	return nil
+}
+
+// nodeHasWindowFuncs returns true if the node calls a window function.
+// Subqueries are not searched.
+func nodeHasWindowFuncs(nodes ...sqlparser.SQLNode) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if windowOver(node) != nil {
+			found = true
+		}
+		if _, ok := node.(*sqlparser.Subquery); ok {
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return !found, nil
+	}, nodes...)
+	return found
+}
+
+// checkWindowFuncs verifies that the window functions of sel, if any, can
+// be sent down along with the query. That's the case if the plan is a
+// route to a single shard, or if every window is partitioned by a unique
+// vindex: each partition then lives in a single shard.
+func (pb *primitiveBuilder) checkWindowFuncs(sel *sqlparser.Select, query string) error {
+	// The named windows are sent down with the query.
+	if err := pb.st.ResolveSymbols(sel.Windows); err != nil {
+		return err
+	}
+
+	var overs []*sqlparser.OverClause
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if over := windowOver(node); over != nil {
+			overs = append(overs, over)
+		}
+		_, isSubquery := node.(*sqlparser.Subquery)
+		return !isSubquery, nil
+	}, sel.SelectExprs, sel.OrderBy)
+	if len(overs) == 0 {
+		return nil
+	}
+
+	rb, isRoute := pb.plan.(*route)
+	if isRoute && rb.isSingleShard() {
+		return nil
+	}
+	if isRoute {
+		pushable := true
+		for _, over := range overs {
+			spec, err := resolveWindowSpec(over, sel.Windows)
+			if err != nil {
+				return err
+			}
+			if !pb.partitionHasUniqueVindex(spec.PartitionClause, rb) {
+				pushable = false
+				break
+			}
+		}
+		if pushable {
+			return nil
+		}
+	}
+	if query == "" {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window functions in subquery or union")
+	}
+	return errWindowFuncsNotPushable
+}
+
+func (pb *primitiveBuilder) partitionHasUniqueVindex(partition sqlparser.Exprs, rb *route) bool {
+	for _, expr := range partition {
+		vindex := pb.st.Vindex(expr, rb)
+		if vindex != nil && vindex.IsUnique() {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveWindowSpec returns the window specification of an OVER clause,
+// with the properties of the named windows it is based on filled in.
+func resolveWindowSpec(over *sqlparser.OverClause, windows sqlparser.WindowDefinitions) (*sqlparser.WindowSpecification, error) {
+	resolved := &sqlparser.WindowSpecification{}
+	name := over.WindowName
+	if over.WindowSpec != nil {
+		resolved.PartitionClause = over.WindowSpec.PartitionClause
+		resolved.OrderClause = over.WindowSpec.OrderClause
+		resolved.FrameClause = over.WindowSpec.FrameClause
+		name = over.WindowSpec.Name
+	}
+
+	seen := map[string]bool{}
+	for !name.IsEmpty() {
+		if seen[name.Lowered()] {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "There is a circularity in the window dependency graph.")
+		}
+		seen[name.Lowered()] = true
+
+		var base *sqlparser.WindowSpecification
+		for _, window := range windows {
+			if window.Name.Equal(name) {
+				base = window.WindowSpec
+				break
+			}
+		}
+		if base == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window name '%s' is not defined.", name.String())
+		}
+		if len(resolved.PartitionClause) == 0 {
+			resolved.PartitionClause = base.PartitionClause
+		}
+		if len(resolved.OrderClause) == 0 {
+			resolved.OrderClause = base.OrderClause
+		}
+		if resolved.FrameClause == nil {
+			resolved.FrameClause = base.FrameClause
+		}
+		name = base.Name
+	}
+	return resolved, nil
+}
+
+// buildWindowPlan builds a plan that computes the window functions of sel
+// at the vtgate level. The query is sent down without them, with the rows
+// sorted by the partition and the order of the window. An engine.Window
+// computes the functions on top, and the final projection, ordering and
+// limit are applied in memory.
+//
+// All the window functions must be top level expressions of the select
+// list, and share the same window.
+func buildWindowPlan(sel *sqlparser.Select, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+	if sel.Distinct || len(sel.GroupBy) > 0 || sel.Having != nil || nodeHasAggregates(sel.SelectExprs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window functions with aggregation")
+	}
+	if sel.Into != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "INTO is not supported on sharded keyspace")
+	}
+
+	inner := &sqlparser.Select{
+		Comments: sel.Comments,
+		From:     sel.From,
+		Where:    sel.Where,
+		Lock:     sel.Lock,
+	}
+	addColumn := func(expr sqlparser.Expr) int {
+		for i, selectExpr := range inner.SelectExprs {
+			if aliasedExpr := selectExpr.(*sqlparser.AliasedExpr); sqlparser.EqualsExpr(aliasedExpr.Expr, expr) {
+				return i
+			}
+		}
+		inner.SelectExprs = append(inner.SelectExprs, &sqlparser.AliasedExpr{Expr: expr})
+		return len(inner.SelectExprs) - 1
+	}
+
+	// The select expressions that don't call window functions are sent down
+	// as is. The other ones are computed by the window primitive, and are
+	// placed after the columns of the inner query.
+	cols := make([]int, len(sel.SelectExprs))
+	var windowExprs []*sqlparser.AliasedExpr
+	var windowCols []int
+	for i, selectExpr := range sel.SelectExprs {
+		aliasedExpr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '*' expression in cross-shard query with window functions")
+		}
+		if windowOver(aliasedExpr.Expr) != nil {
+			windowExprs = append(windowExprs, aliasedExpr)
+			windowCols = append(windowCols, i)
+			continue
+		}
+		if nodeHasWindowFuncs(aliasedExpr.Expr) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function in expression in cross-shard query: %s", sqlparser.String(aliasedExpr.Expr))
+		}
+		inner.SelectExprs = append(inner.SelectExprs, aliasedExpr)
+		cols[i] = len(inner.SelectExprs) - 1
+	}
+	for _, order := range sel.OrderBy {
+		if nodeHasWindowFuncs(order.Expr) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window function in order by in cross-shard query: %s", sqlparser.String(order.Expr))
+		}
+	}
+
+	var spec *sqlparser.WindowSpecification
+	for _, aliasedExpr := range windowExprs {
+		exprSpec, err := resolveWindowSpec(windowOver(aliasedExpr.Expr), sel.Windows)
+		if err != nil {
+			return nil, err
+		}
+		if spec != nil && sqlparser.String(spec) != sqlparser.String(exprSpec) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window functions over different windows")
+		}
+		spec = exprSpec
+	}
+	if spec.FrameClause != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: frame clause in cross-shard window functions")
+	}
+
+	eWindow := &engine.Window{}
+	for _, expr := range spec.PartitionClause {
+		col := addColumn(expr)
+		eWindow.PartitionBy = append(eWindow.PartitionBy, col)
+		inner.OrderBy = append(inner.OrderBy, &sqlparser.Order{Expr: expr, Direction: sqlparser.AscOrder})
+	}
+	for _, order := range spec.OrderClause {
+		col := addColumn(order.Expr)
+		eWindow.OrderBy = append(eWindow.OrderBy, col)
+		inner.OrderBy = append(inner.OrderBy, &sqlparser.Order{Expr: order.Expr, Direction: order.Direction})
+	}
+	for _, aliasedExpr := range windowExprs {
+		fn, err := buildWindowFunction(aliasedExpr, addColumn)
+		if err != nil {
+			return nil, err
+		}
+		eWindow.Functions = append(eWindow.Functions, fn)
+	}
+	for i, col := range windowCols {
+		cols[col] = len(inner.SelectExprs) + i
+	}
+
+	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
+	if err := pb.processSelect(inner, reservedVars, nil, ""); err != nil {
+		return nil, err
+	}
+	if err := pb.plan.Wireup(pb.plan, pb.jt); err != nil {
+		return nil, err
+	}
+	eWindow.Input = pb.plan.Primitive()
+
+	var plan engine.Primitive = &engine.Subquery{
+		Cols:     cols,
+		Subquery: eWindow,
+	}
+	if len(sel.OrderBy) > 0 {
+		eMemorySort := &engine.MemorySort{Input: plan}
+		for _, order := range sel.OrderBy {
+			col, err := findSelectExpr(order.Expr, sel.SelectExprs)
+			if err != nil {
+				return nil, err
+			}
+			eMemorySort.OrderBy = append(eMemorySort.OrderBy, engine.OrderbyParams{
+				Col:               col,
+				WeightStringCol:   -1,
+				Desc:              order.Direction == sqlparser.DescOrder,
+				StarColFixedIndex: col,
+			})
+		}
+		if sel.Limit != nil {
+			pv, err := sqlparser.NewPlanValue(sqlparser.NewArgument("__upper_limit"))
+			if err != nil {
+				return nil, err
+			}
+			eMemorySort.UpperLimit = pv
+		}
+		plan = eMemorySort
+	}
+	if sel.Limit != nil {
+		eLimit := &engine.Limit{Input: plan}
+		if err := setLimitValues(eLimit, sel.Limit); err != nil {
+			return nil, err
+		}
+		plan = eLimit
+	}
+	return plan, nil
+}
+
+// buildWindowFunction returns the parameters of the window function called
+// by expr. addColumn adds the argument of the function to the inner query.
+func buildWindowFunction(expr *sqlparser.AliasedExpr, addColumn func(sqlparser.Expr) int) (engine.WindowFunction, error) {
+	fn := engine.WindowFunction{Col: -1, Alias: expr.As.String()}
+	if fn.Alias == "" {
+		fn.Alias = sqlparser.String(expr.Expr)
+	}
+
+	var name string
+	var args sqlparser.SelectExprs
+	switch node := expr.Expr.(type) {
+	case *sqlparser.WindowFuncExpr:
+		name = node.Name.Lowered()
+	case *sqlparser.FuncExpr:
+		if node.Distinct {
+			return fn, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: distinct in cross-shard window function: %s", sqlparser.String(node))
+		}
+		name = node.Name.Lowered()
+		args = node.Exprs
+	}
+	opcode, ok := engine.SupportedWindowFunctions[name]
+	if !ok {
+		return fn, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window function: %s", sqlparser.String(expr.Expr))
+	}
+	fn.Opcode = opcode
+
+	switch opcode {
+	case engine.WindowRowNumber, engine.WindowRank, engine.WindowDenseRank:
+		return fn, nil
+	}
+	if len(args) != 1 {
+		return fn, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window function: %s", sqlparser.String(expr.Expr))
+	}
+	switch arg := args[0].(type) {
+	case *sqlparser.StarExpr:
+		if opcode != engine.WindowCount {
+			return fn, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window function: %s", sqlparser.String(expr.Expr))
+		}
+	case *sqlparser.AliasedExpr:
+		fn.Col = addColumn(arg.Expr)
+	}
+	return fn, nil
+}
+
+// findSelectExpr returns the offset of the select expression that expr,
+// an ORDER BY expression, refers to. It can be a column number, an alias,
+// or the expression itself.
+func findSelectExpr(expr sqlparser.Expr, selectExprs sqlparser.SelectExprs) (int, error) {
+	if literal, ok := expr.(*sqlparser.Literal); ok && literal.Type == sqlparser.IntVal {
+		num, err := strconv.Atoi(string(literal.Val))
+		if err != nil || num < 1 || num > len(selectExprs) {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown column '%s' in 'order clause'", sqlparser.String(literal))
+		}
+		return num - 1, nil
+	}
+	if col, ok := expr.(*sqlparser.ColName); ok && col.Qualifier.IsEmpty() {
+		for i, selectExpr := range selectExprs {
+			if selectExpr.(*sqlparser.AliasedExpr).As.Equal(col.Name) {
+				return i, nil
+			}
+		}
+	}
+	for i, selectExpr := range selectExprs {
+		if sqlparser.EqualsExpr(selectExpr.(*sqlparser.AliasedExpr).Expr, expr) {
+			return i, nil
+		}
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: memory sort: order by must reference a column in the select list: %s", sqlparser.String(expr))
+}
@@ -318,7 +318,19 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		}
 	}()
 
-	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
+	// The executions of the statement reuse its plan.
+	prepared, _ := prepare.ClientData.(*preparedPlan)
+	if prepared == nil {
+		prepared = &preparedPlan{}
+		prepare.ClientData = prepared
+	}
+	ctx = withPreparedPlan(ctx, prepared)
+
+	// The rows of a SELECT executed with a cursor are streamed
+	// as the client fetches them, unless in a transaction.
+	streamCursor := prepare.CursorType != mysql.CursorTypeNoCursor && !session.InTransaction &&
+		sqlparser.Preview(prepare.PrepareStmt) == sqlparser.StmtSelect
+	if session.Options.Workload == querypb.ExecuteOptions_OLAP || streamCursor {
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
@@ -349,6 +361,7 @@ func (vh *vtgateHandler) session(c *mysql.Conn) *vtgatepb.Session {
 			DDLStrategy:          *defaultDDLStrategy,
 			SessionUUID:          u.String(),
 			EnableSystemSettings: *sysVarSetEnabled,
+			ReadWriteSplit:       *readWriteSplit,
 		}
 		if c.Capabilities&mysql.CapabilityClientFoundRows != 0 {
 			session.Options.ClientFoundRows = true
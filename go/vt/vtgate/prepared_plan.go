@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var preparedPlanHits = stats.NewCounter("PreparedPlanHits", "Number of executions of prepared statements bound to their plan")
+
+// preparedPlan binds a prepared statement of a MySQL connection to its
+// plan, so that its executions skip the parsing, the normalization and
+// the plan cache lookup of the statement. The executions of a prepared
+// statement are sequential, so it's not protected by a mutex.
+type preparedPlan struct {
+	// prefixKey and vschema are the plan prefix key and the vschema
+	// the plan was built for. The plan is bound again if they changed.
+	prefixKey string
+	vschema   *vindexes.VSchema
+
+	// query is the normalized query, and bindVars are the bind
+	// variables of the literals extracted by the normalization.
+	query               string
+	bindVars            map[string]*querypb.BindVariable
+	ignoreMaxMemoryRows bool
+	plan                *engine.Plan
+}
+
+type preparedPlanKey struct{}
+
+// withPreparedPlan returns a context for the execution of the prepared
+// statement bound by prepared.
+func withPreparedPlan(ctx context.Context, prepared *preparedPlan) context.Context {
+	return context.WithValue(ctx, preparedPlanKey{}, prepared)
+}
+
+// preparedPlanFromContext returns the prepared plan of the execution,
+// or nil if it's not the execution of a prepared statement.
+func preparedPlanFromContext(ctx context.Context) *preparedPlan {
+	prepared, _ := ctx.Value(preparedPlanKey{}).(*preparedPlan)
+	return prepared
+}
+
+// get returns the bound plan if it's still valid for prefixKey and
+// vschema, and adds the extracted bind variables to bindVars.
+func (p *preparedPlan) get(prefixKey string, vschema *vindexes.VSchema, bindVars map[string]*querypb.BindVariable) *engine.Plan {
+	if p == nil || p.plan == nil || p.prefixKey != prefixKey || p.vschema != vschema {
+		return nil
+	}
+	for name, bv := range p.bindVars {
+		bindVars[name] = bv
+	}
+	preparedPlanHits.Add(1)
+	return p.plan
+}
+
+// bind binds the prepared statement to plan. extracted are the bind
+// variables of the literals extracted by the normalization.
+func (p *preparedPlan) bind(prefixKey string, vschema *vindexes.VSchema, query string, extracted map[string]*querypb.BindVariable, ignoreMaxMemoryRows bool, plan *engine.Plan) {
+	p.prefixKey = prefixKey
+	p.vschema = vschema
+	p.query = query
+	p.bindVars = extracted
+	p.ignoreMaxMemoryRows = ignoreMaxMemoryRows
+	p.plan = plan
+}
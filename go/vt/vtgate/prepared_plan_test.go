@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestPreparedPlan(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.normalize = true
+	session := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	prepared := &preparedPlan{}
+	ctx := withPreparedPlan(context.Background(), prepared)
+
+	sql := "select id from user where id = :v1 and name = 'foo'"
+	exec := func(id int64) {
+		t.Helper()
+		_, err := executor.Execute(ctx, "TestPreparedPlan", NewSafeSession(session), sql, map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(id),
+		})
+		require.NoError(t, err)
+	}
+	wantQueries := func(id int64) []*querypb.BoundQuery {
+		return []*querypb.BoundQuery{{
+			Sql: "select id from `user` where id = :v1 and `name` = :vtg1",
+			BindVariables: map[string]*querypb.BindVariable{
+				"v1":   sqltypes.Int64BindVariable(id),
+				"vtg1": sqltypes.StringBindVariable("foo"),
+			},
+		}}
+	}
+
+	exec(1)
+	require.NotNil(t, prepared.plan)
+	assert.Equal(t, "select id from `user` where id = :v1 and `name` = :vtg1", prepared.query)
+	utils.MustMatch(t, wantQueries(1), sbc1.Queries)
+	sbc1.Queries = nil
+
+	// The next executions use the bound plan, with the literals
+	// extracted by the normalization, even if the plan was evicted.
+	executor.plans.Clear()
+	hits := preparedPlanHits.Get()
+	exec(2)
+	assert.Equal(t, hits+1, preparedPlanHits.Get())
+	executor.plans.Wait()
+	assert.Zero(t, executor.plans.Len())
+	utils.MustMatch(t, wantQueries(2), sbc1.Queries)
+	sbc1.Queries = nil
+
+	// A new vschema binds the statement again.
+	plan := prepared.plan
+	vschema := *executor.VSchema()
+	executor.SaveVSchema(&vschema, nil)
+	exec(1)
+	assert.Equal(t, hits+1, preparedPlanHits.Get())
+	assert.NotSame(t, plan, prepared.plan)
+	executor.plans.Wait()
+	assert.Equal(t, 1, executor.plans.Len())
+	utils.MustMatch(t, wantQueries(1), sbc1.Queries)
+}
+
+func TestPreparedPlanNotCached(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	session := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	prepared := &preparedPlan{}
+	ctx := withPreparedPlan(context.Background(), prepared)
+
+	// The statements whose plans are not cached are not bound.
+	_, err := executor.Execute(ctx, "TestPreparedPlanNotCached", NewSafeSession(session), "select /*vt+ SKIP_QUERY_PLAN_CACHE=1 */ id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.Nil(t, prepared.plan)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	splitReadReplica         = "Replica"
+	splitReadPrimaryFallback = "PrimaryFallback"
+)
+
+var splitReads = stats.NewCountersWithSingleLabel("ReadWriteSplitReads", "Number of the autocommit reads of the sessions that split their reads and writes, by the tablet type that served them", "Served")
+
+// canSplitRead returns true if the plan is an autocommit read of a session
+// that splits its reads and writes, that can be sent to the replicas. The
+// locking reads, and the reads of the tables with read_from_primary, are
+// never readable from the replicas. The reads are kept on the primary while
+// the GTIDs of a write of the session are unknown, e.g. after a 2PC commit.
+func (e *Executor) canSplitRead(plan *engine.Plan, vcursor *vcursorImpl, safeSession *SafeSession) bool {
+	if !plan.ReplicaReadable || vcursor.tabletType != topodatapb.TabletType_MASTER {
+		return false
+	}
+	if !safeSession.GetSessionReadWriteSplit() || safeSession.InTransaction() || safeSession.InReservedConn() {
+		return false
+	}
+	return !safeSession.hasUnknownShardGtid()
+}
+
+// executeSplitRead executes a read on the replicas, and falls back to the
+// primary if they can't serve it, see canFallBackToPrimary.
+func (e *Executor) executeSplitRead(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
+	return func(logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
+		vcursor.tabletType = topodatapb.TabletType_REPLICA
+		stmtType, qr, err := e.executeRead(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+		if err == nil {
+			splitReads.Add(splitReadReplica, 1)
+			return stmtType, qr, nil
+		}
+		if !canFallBackToPrimary(err) {
+			return stmtType, qr, err
+		}
+		splitReads.Add(splitReadPrimaryFallback, 1)
+		vcursor.tabletType = topodatapb.TabletType_MASTER
+		return e.executeRead(ctx, plan, vcursor, bindVars, time.Now())(logStats, safeSession)
+	}
+}
+
+// canFallBackToPrimary returns true if a read that failed on the replicas
+// with err can be sent to the primary: no replica is available, or a
+// replica did not execute the writes of the session within the
+// read-after-write timeout. The tablets fail the reads with UNAVAILABLE
+// in both cases. The other errors would fail on the primary too.
+func canFallBackToPrimary(err error) bool {
+	return vterrors.Code(err) == vtrpcpb.Code_UNAVAILABLE
+}
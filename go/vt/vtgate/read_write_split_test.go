@@ -0,0 +1,247 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// createReadWriteSplitEnv returns an executor, the primary of the shard
+// -20 and its replica.
+func createReadWriteSplitEnv() (*Executor, *sandboxconn.SandboxConn, *sandboxconn.SandboxConn) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	hc := vtgateHealthCheck.(*discovery.FakeHealthCheck)
+	replica := hc.AddTestTablet("aa", "-20-replica", 1, "TestExecutor", "-20", topodatapb.TabletType_REPLICA, true, 1, nil)
+	return executor, sbc1, replica
+}
+
+func TestReadWriteSplit(t *testing.T) {
+	executor, primary, replica := createReadWriteSplitEnv()
+	session := &vtgatepb.Session{Autocommit: true, ReadWriteSplit: true}
+	read := "select id from user where id = 1"
+
+	_, err := executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Len(t, replica.Queries, 1)
+	assert.Empty(t, primary.Queries)
+
+	// The locking reads and the reads of a transaction go to the primary.
+	replica.Queries = nil
+	_, err = executorExecSession(executor, read+" for update", nil, session)
+	require.NoError(t, err)
+	_, err = executorExecSession(executor, "begin", nil, session)
+	require.NoError(t, err)
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	_, err = executorExecSession(executor, "rollback", nil, session)
+	require.NoError(t, err)
+	assert.Empty(t, replica.Queries)
+	assert.Len(t, primary.Queries, 2)
+
+	// The session can opt out.
+	primary.Queries = nil
+	_, err = executorExecSession(executor, "set @@read_write_split = 0", nil, session)
+	require.NoError(t, err)
+	assert.False(t, session.ReadWriteSplit)
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Empty(t, replica.Queries)
+	assert.Len(t, primary.Queries, 1)
+}
+
+func TestReadWriteSplitReadFromPrimary(t *testing.T) {
+	executor, primary, replica := createReadWriteSplitEnv()
+	executor.VSchema().Keyspaces["TestExecutor"].Tables["user"].ReadFromPrimary = true
+	session := &vtgatepb.Session{Autocommit: true, ReadWriteSplit: true}
+
+	_, err := executorExecSession(executor, "select id from user where id = 1", nil, session)
+	require.NoError(t, err)
+	assert.Empty(t, replica.Queries)
+	assert.Len(t, primary.Queries, 1)
+}
+
+func TestReadWriteSplitReadAfterWrite(t *testing.T) {
+	executor, primary, replica := createReadWriteSplitEnv()
+	session := &vtgatepb.Session{Autocommit: true, ReadWriteSplit: true}
+	read := "select id from user where id = 1"
+	uuid := "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+	// The primary returns the GTID of an autocommit write.
+	primary.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: uuid + ":5"}})
+	_, err := executorExecSession(executor, "update user set a = 2 where id = 1", nil, session)
+	require.NoError(t, err)
+	require.Len(t, primary.Options, 1)
+	assert.True(t, primary.Options[0].SessionTrackGtids)
+	assert.Equal(t, uuid+":5", session.ReadAfterWrite.ShardGtids["TestExecutor/-20"].GetGtid())
+
+	// The replica waits for it before the read, which satisfies it.
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	require.Len(t, replica.Options, 1)
+	assert.Equal(t, uuid+":5", replica.Options[0].ReadAfterWriteGtid)
+	assert.Equal(t, 1.0, replica.Options[0].ReadAfterWriteTimeout)
+	assert.Empty(t, session.ReadAfterWrite.ShardGtids)
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Empty(t, replica.Options[1].GetReadAfterWriteGtid())
+
+	// The commit of a transaction returns its GTID, which adds up with
+	// the GTIDs of the previous writes.
+	primary.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: uuid + ":6"}})
+	_, err = executorExecSession(executor, "update user set a = 3 where id = 1", nil, session)
+	require.NoError(t, err)
+	primary.CommitSessionStateChanges = uuid + ":7"
+	for _, sql := range []string{"begin", "update user set a = 4 where id = 1", "commit"} {
+		_, err = executorExecSession(executor, sql, nil, session)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, uuid+":6-7", session.ReadAfterWrite.ShardGtids["TestExecutor/-20"].GetGtid())
+	for _, query := range primary.Queries {
+		assert.NotContains(t, query.Sql, "gtid_executed")
+	}
+
+	// The GTIDs aren't waited for once the timeout passed.
+	session.ReadAfterWrite.ShardGtids["TestExecutor/-20"].ExpireTime = time.Now().UnixNano()
+	replica.Options = nil
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	require.Len(t, replica.Options, 1)
+	assert.Empty(t, replica.Options[0].GetReadAfterWriteGtid())
+	assert.Empty(t, session.ReadAfterWrite.ShardGtids)
+
+	// The reads stay on the primary while the GTID of a write is unknown,
+	// until the timeout passes.
+	NewSafeSession(session).recordUnknownShardGtid(&querypb.Target{Keyspace: "TestExecutor", Shard: "-20", TabletType: topodatapb.TabletType_MASTER})
+	primary.Queries = nil
+	replica.Queries = nil
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Empty(t, replica.Queries)
+	assert.Len(t, primary.Queries, 1)
+	session.ReadAfterWrite.ShardGtids["TestExecutor/-20"].ExpireTime = time.Now().UnixNano()
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Len(t, replica.Queries, 1)
+}
+
+func TestReadWriteSplitFallback(t *testing.T) {
+	executor, primary, replica := createReadWriteSplitEnv()
+	session := &vtgatepb.Session{Autocommit: true, ReadWriteSplit: true}
+	read := "select id from user where id = 1"
+	before := splitReads.Counts()[splitReadPrimaryFallback]
+
+	replica.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, err := executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Len(t, replica.Queries, 1)
+	assert.Len(t, primary.Queries, 1)
+	assert.Equal(t, before+1, splitReads.Counts()[splitReadPrimaryFallback])
+
+	// A streamed read falls back too.
+	replica.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	var qr sqltypes.Result
+	err = executor.StreamExecute(context.Background(), "TestReadWriteSplitFallback", NewSafeSession(session), read, nil, &querypb.Target{}, func(r *sqltypes.Result) error {
+		qr.AppendResult(r)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, qr.Rows, 1)
+	assert.Equal(t, before+2, splitReads.Counts()[splitReadPrimaryFallback])
+
+	// The other errors would fail on the primary too.
+	primary.Queries = nil
+	replica.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	_, err = executorExecSession(executor, read, nil, session)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	assert.Empty(t, primary.Queries)
+	replica.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	err = executor.StreamExecute(context.Background(), "TestReadWriteSplitFallback", NewSafeSession(session), read, nil, &querypb.Target{}, func(r *sqltypes.Result) error {
+		return nil
+	})
+	require.Error(t, err)
+	assert.Empty(t, primary.Queries)
+	assert.Equal(t, before+2, splitReads.Counts()[splitReadPrimaryFallback])
+}
+
+func TestReadWriteSplitUnknownGtid(t *testing.T) {
+	executor, primary, replica := createReadWriteSplitEnv()
+	session := &vtgatepb.Session{Autocommit: true, ReadWriteSplit: true}
+	read := "select id from user where id = 1"
+
+	// The reads on the primary don't return GTIDs.
+	_, err := executorExecSession(executor, read+" for update", nil, session)
+	require.NoError(t, err)
+	assert.Empty(t, session.ReadAfterWrite.GetShardGtids())
+
+	// The primary didn't return the GTID of an autocommit write, so the
+	// reads stay on the primary.
+	primary.Queries = nil
+	primary.SetResults([]*sqltypes.Result{{RowsAffected: 1}})
+	_, err = executorExecSession(executor, "update user set a = 2 where id = 1", nil, session)
+	require.NoError(t, err)
+	require.Contains(t, session.ReadAfterWrite.GetShardGtids(), "TestExecutor/-20")
+	assert.Empty(t, session.ReadAfterWrite.ShardGtids["TestExecutor/-20"].Gtid)
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Empty(t, replica.Queries)
+	assert.Len(t, primary.Queries, 2)
+}
+
+func TestReadWriteSplitResultCache(t *testing.T) {
+	executor, primary, replica := createReadWriteSplitEnv()
+	executor.resultCache = newResultCache(1024*1024, newFakeResultCacheStreamer().VStream)
+	defer executor.resultCache.Close()
+	executor.consolidator = sync2.NewConsolidator()
+	session := &vtgatepb.Session{Autocommit: true, ReadWriteSplit: true}
+	read := "select /*vt+ CACHE_TTL=60 */ id from user where id = 1"
+	uuid := "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+	_, err := executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Len(t, replica.Queries, 1)
+
+	// A read after a write waits for it on the replica, rather than
+	// getting the cached result.
+	primary.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: uuid + ":5"}})
+	_, err = executorExecSession(executor, "update user set a = 2 where id = 1", nil, session)
+	require.NoError(t, err)
+	replica.Options = nil
+	_, err = executorExecSession(executor, read, nil, session)
+	require.NoError(t, err)
+	assert.Len(t, replica.Queries, 2)
+	require.Len(t, replica.Options, 1)
+	assert.Equal(t, uuid+":5", replica.Options[0].ReadAfterWriteGtid)
+}
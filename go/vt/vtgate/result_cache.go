@@ -0,0 +1,251 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// resultCacheRetryDelay is the delay before an invalidation stream
+// that stopped is restarted.
+const resultCacheRetryDelay = 5 * time.Second
+
+var (
+	resultCacheHits          = stats.NewCounter("ResultCacheHits", "Number of queries served from the result cache")
+	resultCacheMisses        = stats.NewCounter("ResultCacheMisses", "Number of cacheable queries not found in the result cache")
+	resultCacheInvalidations = stats.NewCountersWithSingleLabel("ResultCacheInvalidations", "Number of invalidations of the cached results of a table", "Table")
+
+	resultCacheOnce sync.Once
+)
+
+// resultCacheStreamer streams the changes of a keyspace, like vstreamManager.VStream.
+type resultCacheStreamer func(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error
+
+// resultCache caches the results of the SELECTs that opt in with the
+// CACHE_TTL comment directive or the cache_ttl of their vschema tables.
+// The results are keyed by the plan, the bind variables and the caller.
+// When a streamer is set, the cached results of a table are invalidated
+// as soon as its rows change, otherwise they expire with their TTL.
+type resultCache struct {
+	results  *cache.LRUCache
+	maxSize  int64
+	streamer resultCacheStreamer
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	// tables has the invalidation state of the tables, by table and
+	// tablet type, since every tablet type sees the changes at its pace.
+	tables map[string]*resultCacheTable
+}
+
+// resultCacheTable counts the invalidations of a table for a tablet type.
+type resultCacheTable struct {
+	name       string
+	generation sync2.AtomicInt64
+}
+
+func (t *resultCacheTable) invalidate() {
+	t.generation.Add(1)
+	resultCacheInvalidations.Add(t.name, 1)
+}
+
+// cachedResult is a result in the cache, with the generations of its
+// tables at the time its query was executed.
+type cachedResult struct {
+	result      *sqltypes.Result
+	expireTime  time.Time
+	tables      []*resultCacheTable
+	generations []int64
+}
+
+func newResultCache(capacity int64, streamer resultCacheStreamer) *resultCache {
+	ctx, cancel := context.WithCancel(context.Background())
+	rc := &resultCache{
+		results: cache.NewLRUCache(capacity, func(value interface{}) int64 {
+			return value.(*cachedResult).result.CachedSize(true)
+		}),
+		// A single result doesn't flush most of the cache.
+		maxSize:  capacity / 10,
+		streamer: streamer,
+		ctx:      ctx,
+		cancel:   cancel,
+		tables:   make(map[string]*resultCacheTable),
+	}
+	resultCacheOnce.Do(func() {
+		stats.NewGaugeFunc("ResultCacheLength", "Result cache length", func() int64 {
+			return int64(rc.results.Len())
+		})
+		stats.NewGaugeFunc("ResultCacheSize", "Result cache size", rc.results.UsedCapacity)
+		stats.NewGaugeFunc("ResultCacheCapacity", "Result cache capacity", rc.results.MaxCapacity)
+		stats.NewCounterFunc("ResultCacheEvictions", "Result cache evictions", rc.results.Evictions)
+	})
+	return rc
+}
+
+// Close stops the invalidation streams.
+func (rc *resultCache) Close() {
+	rc.cancel()
+}
+
+// queryResultKey returns the key of the results of a query planned with
+// planKey, executed with bindVars by user. It keys both the result cache
+// and the consolidator.
+func queryResultKey(planKey, user string, bindVars map[string]*querypb.BindVariable) string {
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "%d:%s%d:%s", len(user), user, len(planKey), planKey)
+	for _, name := range names {
+		bv := bindVars[name]
+		fmt.Fprintf(&buf, "%d:%s%d:%d:%s", len(name), name, bv.Type, len(bv.Value), bv.Value)
+		fmt.Fprintf(&buf, "[%d", len(bv.Values))
+		for _, v := range bv.Values {
+			fmt.Fprintf(&buf, "%d:%d:%s", v.Type, len(v.Value), v.Value)
+		}
+		buf.WriteByte(']')
+	}
+	return buf.String()
+}
+
+// Get returns a copy of the cached result of key, if it's neither
+// expired nor invalidated.
+func (rc *resultCache) Get(key string) (*sqltypes.Result, bool) {
+	value, ok := rc.results.Get(key)
+	if !ok {
+		resultCacheMisses.Add(1)
+		return nil, false
+	}
+	cached := value.(*cachedResult)
+	valid := time.Now().Before(cached.expireTime)
+	for i, table := range cached.tables {
+		if table.generation.Get() != cached.generations[i] {
+			valid = false
+		}
+	}
+	if !valid {
+		rc.results.Delete(key)
+		resultCacheMisses.Add(1)
+		return nil, false
+	}
+	resultCacheHits.Add(1)
+	return cached.result.Copy(), true
+}
+
+// Snapshot returns the tables read by a query for the tablet type, and
+// their current generations. The snapshot must be taken before the query
+// is executed, so that its result is invalidated by concurrent changes.
+func (rc *resultCache) Snapshot(tables []string, tabletType topodatapb.TabletType) *cachedResult {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	snapshot := &cachedResult{}
+	if rc.streamer == nil {
+		return snapshot
+	}
+	for _, name := range tables {
+		key := name + "@" + strings.ToLower(tabletType.String())
+		table, ok := rc.tables[key]
+		if !ok {
+			table = &resultCacheTable{name: key}
+			rc.tables[key] = table
+			go rc.watch(name, tabletType, table)
+		}
+		snapshot.tables = append(snapshot.tables, table)
+		snapshot.generations = append(snapshot.generations, table.generation.Get())
+	}
+	return snapshot
+}
+
+// Set caches a copy of the result of key for ttl, unless a table of
+// the snapshot was invalidated since.
+func (rc *resultCache) Set(key string, result *sqltypes.Result, ttl time.Duration, snapshot *cachedResult) {
+	if result.CachedSize(true) > rc.maxSize {
+		return
+	}
+	for i, table := range snapshot.tables {
+		if table.generation.Get() != snapshot.generations[i] {
+			return
+		}
+	}
+	rc.results.Set(key, &cachedResult{
+		result:      result.Copy(),
+		expireTime:  time.Now().Add(ttl),
+		tables:      snapshot.tables,
+		generations: snapshot.generations,
+	})
+}
+
+// watch invalidates the cached results of a keyspace.table every time
+// its rows change, until the result cache is closed.
+func (rc *resultCache) watch(name string, tabletType topodatapb.TabletType, table *resultCacheTable) {
+	dot := strings.IndexByte(name, '.')
+	keyspace, tableName := name[:dot], name[dot+1:]
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: keyspace,
+			Gtid:     "current",
+		}},
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: tableName,
+		}},
+	}
+	for {
+		err := rc.streamer(rc.ctx, tabletType, vgtid, filter, &vtgatepb.VStreamFlags{}, func(events []*binlogdatapb.VEvent) error {
+			for _, event := range events {
+				if event.Type == binlogdatapb.VEventType_ROW || event.Type == binlogdatapb.VEventType_DDL {
+					table.invalidate()
+					break
+				}
+			}
+			return nil
+		})
+		// The changes are not seen until the stream is restarted.
+		table.invalidate()
+		if rc.ctx.Err() != nil {
+			return
+		}
+		log.Warningf("Result cache invalidation stream of %s stopped, restarting it: %v", table.name, err)
+		select {
+		case <-rc.ctx.Done():
+			return
+		case <-time.After(resultCacheRetryDelay):
+		}
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeResultCacheStreamer records the invalidation streams of a
+// result cache, by keyspace.table.
+type fakeResultCacheStreamer struct {
+	mu    sync.Mutex
+	sends map[string]func(events []*binlogdatapb.VEvent) error
+}
+
+func newFakeResultCacheStreamer() *fakeResultCacheStreamer {
+	return &fakeResultCacheStreamer{sends: make(map[string]func(events []*binlogdatapb.VEvent) error)}
+}
+
+func (f *fakeResultCacheStreamer) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
+	f.mu.Lock()
+	f.sends[vgtid.ShardGtids[0].Keyspace+"."+filter.Rules[0].Match] = send
+	f.mu.Unlock()
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// send sends events to the stream of table, once it's started.
+func (f *fakeResultCacheStreamer) send(t *testing.T, table string, events ...*binlogdatapb.VEvent) {
+	var send func(events []*binlogdatapb.VEvent) error
+	require.Eventually(t, func() bool {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		send = f.sends[table]
+		return send != nil
+	}, 5*time.Second, time.Millisecond)
+	require.NoError(t, send(events))
+}
+
+func TestResultCacheKey(t *testing.T) {
+	key := queryResultKey("ks@master:select 1", "user1", map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.StringBindVariable("x"),
+	})
+	assert.Equal(t, key, queryResultKey("ks@master:select 1", "user1", map[string]*querypb.BindVariable{
+		"b": sqltypes.StringBindVariable("x"),
+		"a": sqltypes.Int64BindVariable(1),
+	}))
+	assert.NotEqual(t, key, queryResultKey("ks@master:select 1", "user2", map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.StringBindVariable("x"),
+	}))
+	assert.NotEqual(t, key, queryResultKey("ks@master:select 1", "user1", map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.StringBindVariable("y"),
+	}))
+	assert.NotEqual(t, key, queryResultKey("ks@master:select 1", "user1", map[string]*querypb.BindVariable{
+		"a": sqltypes.StringBindVariable("1"),
+		"b": sqltypes.StringBindVariable("x"),
+	}))
+	// The lengths keep the values from running into each other.
+	assert.NotEqual(t,
+		queryResultKey("select 1", "", map[string]*querypb.BindVariable{"a": sqltypes.StringBindVariable("b1:c")}),
+		queryResultKey("select 1", "", map[string]*querypb.BindVariable{"a": sqltypes.StringBindVariable("b"), "c": sqltypes.StringBindVariable("")}))
+}
+
+func TestResultCacheExpiry(t *testing.T) {
+	rc := newResultCache(1024*1024, nil)
+	defer rc.Close()
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	_, ok := rc.Get("key")
+	assert.False(t, ok)
+
+	rc.Set("key", result, 50*time.Millisecond, rc.Snapshot([]string{"ks.t"}, topodatapb.TabletType_MASTER))
+	// The cache has its own copy of the result.
+	result.Rows = nil
+	got, ok := rc.Get("key")
+	require.True(t, ok)
+	assert.Len(t, got.Rows, 1)
+
+	time.Sleep(100 * time.Millisecond)
+	_, ok = rc.Get("key")
+	assert.False(t, ok)
+}
+
+func TestResultCacheInvalidation(t *testing.T) {
+	streamer := newFakeResultCacheStreamer()
+	rc := newResultCache(1024*1024, streamer.VStream)
+	defer rc.Close()
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	rc.Set("key1", result, time.Hour, rc.Snapshot([]string{"ks.t1"}, topodatapb.TabletType_MASTER))
+	rc.Set("key2", result, time.Hour, rc.Snapshot([]string{"ks.t1", "ks.t2"}, topodatapb.TabletType_MASTER))
+	rc.Set("key3", result, time.Hour, rc.Snapshot([]string{"ks.t1"}, topodatapb.TabletType_REPLICA))
+
+	// Other events don't invalidate the results.
+	streamer.send(t, "ks.t2", &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT})
+	_, ok := rc.Get("key2")
+	assert.True(t, ok)
+
+	streamer.send(t, "ks.t2", &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_ROW})
+	_, ok = rc.Get("key1")
+	assert.True(t, ok)
+	_, ok = rc.Get("key2")
+	assert.False(t, ok)
+
+	// The results of a snapshot taken before a change are not cached.
+	snapshot := rc.Snapshot([]string{"ks.t1"}, topodatapb.TabletType_MASTER)
+	streamer.send(t, "ks.t1", &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_DDL})
+	rc.Set("key4", result, time.Hour, snapshot)
+	_, ok = rc.Get("key4")
+	assert.False(t, ok)
+	_, ok = rc.Get("key1")
+	assert.False(t, ok)
+	_, ok = rc.Get("key3")
+	assert.True(t, ok)
+}
+
+func TestExecutorResultCache(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	streamer := newFakeResultCacheStreamer()
+	executor.resultCache = newResultCache(1024*1024, streamer.VStream)
+	defer executor.resultCache.Close()
+
+	session := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	exec := func(sql string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+		return executorExecSession(executor, sql, bindVars, session)
+	}
+
+	query := "select /*vt+ CACHE_TTL=60 */ id from user where id = 1"
+	want, err := exec(query, nil)
+	require.NoError(t, err)
+	got, err := exec(query, nil)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, got)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+
+	// Different bind variables are different results.
+	_, err = exec(query, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	// Transactions don't use the cache.
+	_, err = executorExecSession(executor, query, nil, &vtgatepb.Session{TargetString: "@master"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+
+	streamer.send(t, "TestExecutor.user", &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_ROW})
+	_, err = exec(query, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, sbc1.ExecCount.Get())
+
+	// Queries without a cache ttl are not cached.
+	_, err = exec("select id from user where id = 1", nil)
+	require.NoError(t, err)
+	_, err = exec("select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, sbc1.ExecCount.Get())
+}
@@ -23,6 +23,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -44,6 +45,12 @@ type SafeSession struct {
 	// this is a signal that found_rows has already been handles by the primitives,
 	// and doesn't have to be updated by the executor
 	foundRowsHandled bool
+
+	// txLogSize is the size in bytes of the first txLogCount statements
+	// of the TransactionLog. It's recomputed when the log doesn't have
+	// txLogCount statements, e.g. for the sessions sent back by clients.
+	txLogSize  int
+	txLogCount int
 	*vtgatepb.Session
 }
 
@@ -492,6 +499,106 @@ func (session *SafeSession) GetSessionEnableSystemSettings() bool {
 	return session.EnableSystemSettings
 }
 
+// SetSessionEnableConsolidator sets whether the queries of the session
+// can be consolidated with identical queries of other sessions.
+func (session *SafeSession) SetSessionEnableConsolidator(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.DisableConsolidator = !enable
+}
+
+// GetSessionEnableConsolidator returns whether the queries of the
+// session can be consolidated.
+func (session *SafeSession) GetSessionEnableConsolidator() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return !session.DisableConsolidator
+}
+
+// SetSessionEnableTxRetry sets whether the transactions of the session
+// are replayed when they fail because of a deadlock or a failover.
+func (session *SafeSession) SetSessionEnableTxRetry(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.EnableTransactionRetry = enable
+}
+
+// GetSessionEnableTxRetry returns whether the transactions of the
+// session are replayed.
+func (session *SafeSession) GetSessionEnableTxRetry() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.EnableTransactionRetry
+}
+
+// SetSessionReadWriteSplit sets whether the autocommit reads of the
+// session are sent to the replicas.
+func (session *SafeSession) SetSessionReadWriteSplit(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.ReadWriteSplit = enable
+}
+
+// GetSessionReadWriteSplit returns whether the autocommit reads of the
+// session are sent to the replicas.
+func (session *SafeSession) GetSessionReadWriteSplit() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadWriteSplit
+}
+
+// RecordTxStatement appends a statement to the transaction log. If the
+// log exceeds maxSize bytes, it's dropped, and the transaction can't
+// be replayed anymore.
+func (session *SafeSession) RecordTxStatement(stmt *vtgatepb.TransactionStatement, maxSize int) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.TransactionRetryUnsafe != "" {
+		return
+	}
+	if session.txLogCount != len(session.TransactionLog) {
+		session.txLogSize = 0
+		for _, recorded := range session.TransactionLog {
+			session.txLogSize += recorded.SizeVT()
+		}
+	}
+	size := session.txLogSize + stmt.SizeVT()
+	if size > maxSize {
+		session.TransactionLog = nil
+		session.TransactionRetryUnsafe = fmt.Sprintf("its statements exceed the transaction_retry_max_log_size of %d bytes", maxSize)
+		session.txLogSize, session.txLogCount = 0, 0
+		return
+	}
+	session.TransactionLog = append(session.TransactionLog, stmt)
+	session.txLogSize, session.txLogCount = size, len(session.TransactionLog)
+}
+
+// SetTxRetryUnsafe prevents the replay of the current transaction.
+func (session *SafeSession) SetTxRetryUnsafe(reason string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.TransactionLog = nil
+	session.txLogSize, session.txLogCount = 0, 0
+	session.TransactionRetryUnsafe = reason
+}
+
+// GetTxLog returns the statements recorded for the current transaction,
+// or the reason why it can't be replayed.
+func (session *SafeSession) GetTxLog() ([]*vtgatepb.TransactionStatement, string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.TransactionLog, session.TransactionRetryUnsafe
+}
+
+// ResetTxLog clears the transaction log.
+func (session *SafeSession) ResetTxLog() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.TransactionLog = nil
+	session.txLogSize, session.txLogCount = 0, 0
+	session.TransactionRetryUnsafe = ""
+}
+
 // SetReadAfterWriteGTID set the ReadAfterWriteGtid setting.
 func (session *SafeSession) SetReadAfterWriteGTID(vtgtid string) {
 	session.mu.Lock()
@@ -512,6 +619,162 @@ func (session *SafeSession) SetReadAfterWriteTimeout(timeout float64) {
 	session.ReadAfterWrite.ReadAfterWriteTimeout = timeout
 }
 
+// recordShardGtid records the GTID of a write of the session on the
+// primary of target, if the session splits its reads and writes. The
+// reads of the session on the replicas of the shard wait until they
+// executed it, or until the read-after-write timeout passes. An empty
+// gtid is unknown, and keeps the reads on the primary until then.
+func (session *SafeSession) recordShardGtid(target *querypb.Target, gtid string) {
+	if session == nil || session.Session == nil || target.GetTabletType() != topodatapb.TabletType_MASTER {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.setShardGtid(target, gtid)
+}
+
+// setShardGtid adds gtid to the GTID set of the shard of target, or makes
+// it unknown if gtid is empty. The session must be locked.
+func (session *SafeSession) setShardGtid(target *querypb.Target, gtid string) {
+	if !session.ReadWriteSplit {
+		return
+	}
+	if session.ReadAfterWrite == nil {
+		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
+	}
+	if session.ReadAfterWrite.ShardGtids == nil {
+		session.ReadAfterWrite.ShardGtids = make(map[string]*vtgatepb.ReadAfterWrite_ShardGtid)
+	}
+	shard := target.Keyspace + "/" + target.Shard
+	now := time.Now()
+	if prev := session.ReadAfterWrite.ShardGtids[shard]; prev != nil && now.UnixNano() < prev.ExpireTime {
+		if prev.Gtid == "" {
+			gtid = ""
+		} else if gtid != "" {
+			gtid = unionGtids(prev.Gtid, gtid)
+		}
+	}
+	session.ReadAfterWrite.ShardGtids[shard] = &vtgatepb.ReadAfterWrite_ShardGtid{
+		Gtid:       gtid,
+		ExpireTime: now.Add(session.readAfterWriteTimeout()).UnixNano(),
+	}
+}
+
+// hasUnknownShardGtid returns true if the GTID of a write of the session
+// is unknown, and its read-after-write timeout didn't pass.
+func (session *SafeSession) hasUnknownShardGtid() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	now := time.Now().UnixNano()
+	for _, shardGtid := range session.ReadAfterWrite.GetShardGtids() {
+		if shardGtid.Gtid == "" && now < shardGtid.ExpireTime {
+			return true
+		}
+	}
+	return false
+}
+
+// recordUnknownShardGtid keeps the reads of the session on the primary of
+// the shard of target until the read-after-write timeout passes, if the
+// session splits its reads and writes and the GTID of its write is unknown.
+func (session *SafeSession) recordUnknownShardGtid(target *querypb.Target) {
+	session.recordShardGtid(target, "")
+}
+
+// hasPendingShardGtids returns true if the reads of the session must see
+// writes that the replicas may not have executed yet: their results can't
+// be shared with other reads then.
+func (session *SafeSession) hasPendingShardGtids() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	now := time.Now().UnixNano()
+	for _, shardGtid := range session.ReadAfterWrite.GetShardGtids() {
+		if now < shardGtid.ExpireTime {
+			return true
+		}
+	}
+	return false
+}
+
+// unionGtids returns the union of two GTID sets, or the newer one if they
+// can't be parsed.
+func unionGtids(older, newer string) string {
+	olderPos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, older)
+	if err != nil {
+		return newer
+	}
+	newerPos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, newer)
+	if err != nil {
+		return newer
+	}
+	return olderPos.GTIDSet.Union(newerPos.GTIDSet).String()
+}
+
+// satisfyShardGtid forgets the GTID set of the shard of target once a
+// replica executed it before serving a read of the session.
+func (session *SafeSession) satisfyShardGtid(target *querypb.Target, options *querypb.ExecuteOptions) {
+	if session == nil || session.Session == nil || options.GetReadAfterWriteGtid() == "" {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	shard := target.Keyspace + "/" + target.Shard
+	if prev := session.ReadAfterWrite.GetShardGtids()[shard]; prev != nil && prev.Gtid == options.ReadAfterWriteGtid {
+		delete(session.ReadAfterWrite.ShardGtids, shard)
+	}
+}
+
+// readAfterWriteTimeout returns how long the replicas wait for the writes
+// of the session. The session must be locked.
+func (session *SafeSession) readAfterWriteTimeout() time.Duration {
+	if timeout := session.ReadAfterWrite.GetReadAfterWriteTimeout(); timeout > 0 {
+		return time.Duration(timeout * float64(time.Second))
+	}
+	return *readWriteSplitTimeout
+}
+
+// queryOptions returns the options of a query on target. The primaries
+// return the GTIDs of the writes of the sessions that split their reads
+// and writes, and the reads on the replicas wait until they executed them.
+func (session *SafeSession) queryOptions(target *querypb.Target) *querypb.ExecuteOptions {
+	if session == nil || session.Session == nil {
+		return nil
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.ReadWriteSplit {
+		return session.Options
+	}
+	if target.GetTabletType() == topodatapb.TabletType_MASTER {
+		options := session.cloneOptions()
+		options.SessionTrackGtids = true
+		return options
+	}
+	shard := target.Keyspace + "/" + target.Shard
+	shardGtid := session.ReadAfterWrite.GetShardGtids()[shard]
+	if shardGtid == nil {
+		return session.Options
+	}
+	if time.Now().UnixNano() >= shardGtid.ExpireTime {
+		delete(session.ReadAfterWrite.ShardGtids, shard)
+		return session.Options
+	}
+	if shardGtid.Gtid == "" {
+		return session.Options
+	}
+	options := session.cloneOptions()
+	options.ReadAfterWriteGtid = shardGtid.Gtid
+	options.ReadAfterWriteTimeout = session.readAfterWriteTimeout().Seconds()
+	return options
+}
+
+func (session *SafeSession) cloneOptions() *querypb.ExecuteOptions {
+	if session.Options == nil {
+		return &querypb.ExecuteOptions{}
+	}
+	return proto.Clone(session.Options).(*querypb.ExecuteOptions)
+}
+
 // SetSessionTrackGtids set the SessionTrackGtids setting.
 func (session *SafeSession) SetSessionTrackGtids(enable bool) {
 	session.mu.Lock()
@@ -67,3 +67,31 @@ func TestPrequeries(t *testing.T) {
 		t.Errorf("got %v but wanted %v", preQueries, want)
 	}
 }
+
+func TestRecordTxStatement(t *testing.T) {
+	stmt := &vtgatepb.TransactionStatement{Query: &querypb.BoundQuery{Sql: "insert into t values (1)"}}
+	maxSize := 3 * stmt.SizeVT()
+
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session.RecordTxStatement(stmt, maxSize)
+	session.RecordTxStatement(stmt, maxSize)
+	log, unsafe := session.GetTxLog()
+	require.Len(t, log, 2)
+	require.Empty(t, unsafe)
+
+	// The statements of a session sent back by the client are accounted for.
+	session = NewSafeSession(session.Session)
+	session.RecordTxStatement(stmt, maxSize)
+	log, _ = session.GetTxLog()
+	require.Len(t, log, 3)
+	session.RecordTxStatement(stmt, maxSize)
+	log, unsafe = session.GetTxLog()
+	require.Empty(t, log)
+	require.Contains(t, unsafe, "transaction_retry_max_log_size")
+
+	session.ResetTxLog()
+	session.RecordTxStatement(stmt, maxSize)
+	log, unsafe = session.GetTxLog()
+	require.Len(t, log, 1)
+	require.Empty(t, unsafe)
+}
@@ -205,9 +205,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			transactionID := info.transactionID
 			reservedID := info.reservedID
 
-			if session != nil && session.Session != nil {
-				opts = session.Session.Options
-			}
+			opts = session.queryOptions(rs.Target)
 
 			if autocommit {
 				// As this is auto-commit, the transactionID is supposed to be zero.
@@ -266,6 +264,12 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if err != nil {
 				return newInfo, err
 			}
+			// The GTID of an autocommit write is unknown if the primary
+			// didn't return it. The other writes get theirs on commit.
+			if autocommit || innerqr.SessionStateChanges != "" {
+				session.recordShardGtid(rs.Target, innerqr.SessionStateChanges)
+			}
+			session.satisfyShardGtid(rs.Target, opts)
 			mu.Lock()
 			defer mu.Unlock()
 
@@ -365,7 +369,7 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	query string,
 	rss []*srvtopo.ResolvedShard,
 	bindVars []map[string]*querypb.BindVariable,
-	options *querypb.ExecuteOptions,
+	session *SafeSession,
 	callback func(reply *sqltypes.Result) error,
 ) []error {
 	// mu protects fieldSent, callback and replyErr
@@ -373,9 +377,14 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	fieldSent := false
 
 	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
-		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
+		opts := session.queryOptions(rs.Target)
+		err := rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, opts, func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 		})
+		if err == nil {
+			session.satisfyShardGtid(rs.Target, opts)
+		}
+		return err
 	})
 	return allErrors.GetErrors()
 }
@@ -79,7 +79,7 @@ func TestTabletGatewayBegin(t *testing.T) {
 
 func TestTabletGatewayCommit(t *testing.T) {
 	testTabletGatewayTransact(t, func(tg *TabletGateway, target *querypb.Target) error {
-		_, err := tg.Commit(context.Background(), target, 1)
+		_, _, err := tg.Commit(context.Background(), target, 1)
 		return err
 	})
 }
@@ -90,7 +90,7 @@ func (txc *TxConn) queryService(alias *topodatapb.TabletAlias) (queryservice.Que
 	return txc.gateway.QueryServiceByAlias(alias, nil)
 }
 
-func (txc *TxConn) commitShard(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+func (txc *TxConn) commitShard(ctx context.Context, s *vtgatepb.Session_ShardSession, session *SafeSession) error {
 	if s.TransactionId == 0 {
 		return nil
 	}
@@ -100,30 +100,34 @@ func (txc *TxConn) commitShard(ctx context.Context, s *vtgatepb.Session_ShardSes
 	if err != nil {
 		return err
 	}
-	reservedID, err := qs.Commit(ctx, s.Target, s.TransactionId)
+	reservedID, sessionStateChanges, err := qs.Commit(ctx, s.Target, s.TransactionId)
 	if err != nil {
 		return err
 	}
 	s.TransactionId = 0
 	s.ReservedId = reservedID
+	session.recordShardGtid(s.Target, sessionStateChanges)
 	return nil
 }
 
 func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error {
-	if err := txc.runSessions(ctx, session.PreSessions, txc.commitShard); err != nil {
+	commitShard := func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+		return txc.commitShard(ctx, s, session)
+	}
+	if err := txc.runSessions(ctx, session.PreSessions, commitShard); err != nil {
 		_ = txc.Release(ctx, session)
 		return err
 	}
 
 	// Retain backward compatibility on commit order for the normal session.
 	for _, shardSession := range session.ShardSessions {
-		if err := txc.commitShard(ctx, shardSession); err != nil {
+		if err := txc.commitShard(ctx, shardSession, session); err != nil {
 			_ = txc.Release(ctx, session)
 			return err
 		}
 	}
 
-	if err := txc.runSessions(ctx, session.PostSessions, txc.commitShard); err != nil {
+	if err := txc.runSessions(ctx, session.PostSessions, commitShard); err != nil {
 		// If last commit fails, there will be nothing to rollback.
 		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("post-operation transaction had an error: %v", err)})
 		// With reserved connection we should release them.
@@ -172,6 +176,10 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
+	// The commits of the prepared transactions don't return their GTIDs.
+	for _, s := range session.ShardSessions {
+		session.recordUnknownShardGtid(s.Target)
+	}
 	err = txc.gateway.StartCommit(ctx, mmShard.Target, mmShard.TransactionId, dtid)
 	if err != nil {
 		return err
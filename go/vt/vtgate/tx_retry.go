@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	txRetryDeadlock = "Deadlock"
+	txRetryFailover = "Failover"
+)
+
+var (
+	txRetries       = stats.NewCountersWithSingleLabel("TransactionRetries", "Number of replays of the transactions that failed because of a deadlock or a failover, by reason", "Reason")
+	txRetryFailures = stats.NewCountersWithSingleLabel("TransactionRetryFailures", "Number of transactions that failed because of a deadlock or a failover and were not replayed successfully, by cause", "Cause")
+)
+
+// executeWithTxRetry executes a statement. If the session enables the
+// transaction retry, the statements of its transaction are recorded
+// with the hash of their results. When a statement of the transaction
+// fails because of a deadlock or a failover, the transaction is rolled
+// back and its statements are replayed, before the statement is
+// executed again. The replay fails if a result returned to the client
+// differs, such as the ids generated by an insert.
+func (e *Executor) executeWithTxRetry(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+	if !safeSession.GetSessionEnableTxRetry() {
+		return e.execute(ctx, safeSession, sql, bindVars, logStats)
+	}
+
+	// The execution adds bind variables to bindVars.
+	query := &querypb.BoundQuery{Sql: sql, BindVariables: copyBindVars(bindVars)}
+	inTx := safeSession.InTransaction()
+	stmtType, qr, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+	if err != nil && (inTx || !safeSession.Autocommit) && canRetryStatement(stmtType) {
+		for attempt := 1; err != nil; attempt++ {
+			reason := txRetryReason(err)
+			if reason == "" {
+				break
+			}
+			if _, unsafe := safeSession.GetTxLog(); unsafe != "" {
+				txRetryFailures.Add("Unsafe", 1)
+				err = vterrors.Wrapf(err, "transaction cannot be retried because %s", unsafe)
+				break
+			}
+			if attempt > *txRetryMaxAttempts {
+				txRetryFailures.Add("Exhausted", 1)
+				_ = e.txConn.Rollback(ctx, safeSession)
+				err = vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction rolled back after %d retries: %v", *txRetryMaxAttempts, err)
+				break
+			}
+			if err = e.replayTx(ctx, safeSession, reason, attempt); err != nil {
+				continue
+			}
+			stmtType, qr, err = e.execute(ctx, safeSession, sql, copyBindVars(query.BindVariables), logStats)
+		}
+	}
+
+	switch {
+	case !safeSession.InTransaction():
+		safeSession.ResetTxLog()
+	case err == nil:
+		if !inTx || stmtType == sqlparser.StmtBegin {
+			safeSession.ResetTxLog()
+		}
+		safeSession.RecordTxStatement(&vtgatepb.TransactionStatement{Query: query, ResultHash: txResultHash(qr)}, *txRetryMaxLogSize)
+	}
+	return stmtType, qr, err
+}
+
+// replayTx rolls back the transaction of the session, and executes its
+// recorded statements again. If a statement fails or returns another
+// result, the transaction is rolled back.
+//
+// The recorded statements start with the one that opened the
+// transaction, so all of them are executed in the new transaction, and
+// none is served from the result cache or the consolidator. A statement
+// that would be executed outside of the transaction fails the replay.
+func (e *Executor) replayTx(ctx context.Context, safeSession *SafeSession, reason string, attempt int) error {
+	txLog, _ := safeSession.GetTxLog()
+	_ = e.txConn.Rollback(ctx, safeSession)
+	if reason == txRetryDeadlock {
+		select {
+		case <-time.After(time.Duration(attempt) * *txRetryDeadlockBackoff):
+		case <-ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction rolled back before its retry: %v", ctx.Err())
+		}
+	}
+
+	txRetries.Add(reason, 1)
+	for i, stmt := range txLog {
+		if i > 0 && !safeSession.InTransaction() {
+			txRetryFailures.Add("Diverged", 1)
+			_ = e.txConn.Rollback(ctx, safeSession)
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction cannot be retried because it ended before its statement %d on replay", i+1)
+		}
+		logStats := NewLogStats(ctx, "TransactionRetry", stmt.Query.Sql, stmt.Query.BindVariables)
+		_, qr, err := e.execute(ctx, safeSession, stmt.Query.Sql, copyBindVars(stmt.Query.BindVariables), logStats)
+		logStats.Error = err
+		logStats.Send()
+		if err == nil && txResultHash(qr) != stmt.ResultHash {
+			txRetryFailures.Add("Diverged", 1)
+			err = vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction cannot be retried because the result of its statement %d changed on replay", i+1)
+		}
+		if err != nil {
+			_ = e.txConn.Rollback(ctx, safeSession)
+			return err
+		}
+	}
+	return nil
+}
+
+// canRetryStatement returns false for the statements that end or start
+// a transaction: a commit that failed may have been applied.
+func canRetryStatement(stmtType sqlparser.StatementType) bool {
+	switch stmtType {
+	case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback:
+		return false
+	}
+	return true
+}
+
+// txRetryReason returns why a transaction that failed with err can be
+// replayed, or an empty string if it can't.
+func txRetryReason(err error) string {
+	if sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERLockDeadlock {
+		return txRetryDeadlock
+	}
+	if buffer.CausedByFailover(err) || wasConnectionClosed(err) {
+		return txRetryFailover
+	}
+	return ""
+}
+
+// txResultHash returns the hash of what the client receives from a
+// result.
+func txResultHash(qr *sqltypes.Result) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	writeUint64 := func(v uint64) {
+		binary.BigEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	writeUint64(qr.RowsAffected)
+	writeUint64(qr.InsertID)
+	writeUint64(uint64(len(qr.Rows)))
+	for _, row := range qr.Rows {
+		for _, v := range row {
+			if v.IsNull() {
+				writeUint64(^uint64(0))
+				continue
+			}
+			writeUint64(uint64(v.Len()))
+			h.Write(v.Raw())
+		}
+	}
+	return h.Sum64()
+}
+
+// copyBindVars returns a deep copy of bindVars: the execution of a
+// statement may change the values of its bind variables, and the
+// recorded ones must stay as the client sent them.
+func copyBindVars(bindVars map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	copied := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		copied[k] = proto.Clone(v).(*querypb.BindVariable)
+	}
+	return copied
+}
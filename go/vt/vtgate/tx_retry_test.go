@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var errDeadlock = vterrors.New(vtrpcpb.Code_ABORTED, "Deadlock found when trying to get lock; try restarting transaction (errno 1213) (sqlstate 40001)")
+
+func newTxRetrySession(t *testing.T, executor *Executor) *SafeSession {
+	t.Helper()
+	*txRetryDeadlockBackoff = 0
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	_, err := executor.Execute(context.Background(), "TestTxRetry", session, "set @@enable_transaction_retry = 1", nil)
+	require.NoError(t, err)
+	require.True(t, session.GetSessionEnableTxRetry())
+	return session
+}
+
+func TestTxRetryDeadlock(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := newTxRetrySession(t, executor)
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestTxRetryDeadlock", session, sql, nil)
+		return err
+	}
+
+	require.NoError(t, exec("begin"))
+	require.NoError(t, exec("select id from user where id = 1"))
+	require.NoError(t, exec("update user set a = 2 where id = 3"))
+	txLog, _ := session.GetTxLog()
+	require.Len(t, txLog, 3)
+
+	retries := txRetries.Counts()[txRetryDeadlock]
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	sbc1.EphemeralShardErr = errDeadlock
+	require.NoError(t, exec("update user set a = 1 where id = 1"))
+	assert.Equal(t, retries+1, txRetries.Counts()[txRetryDeadlock])
+	assert.EqualValues(t, 1, sbc1.RollbackCount.Get())
+	assert.EqualValues(t, 1, sbc2.RollbackCount.Get())
+
+	// The transaction was replayed before the failed statement.
+	var queries []string
+	for _, q := range sbc1.Queries {
+		queries = append(queries, q.Sql)
+	}
+	assert.Equal(t, []string{
+		"update `user` set a = 1 where id = 1",
+		"select id from `user` where id = 1",
+		"update `user` set a = 1 where id = 1",
+	}, queries)
+	assert.True(t, session.InTransaction())
+	txLog, _ = session.GetTxLog()
+	assert.Len(t, txLog, 4)
+
+	require.NoError(t, exec("commit"))
+	txLog, unsafe := session.GetTxLog()
+	assert.Empty(t, txLog)
+	assert.Empty(t, unsafe)
+}
+
+func TestTxRetryFailover(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := newTxRetrySession(t, executor)
+	session.Autocommit = false
+
+	_, err := executor.Execute(context.Background(), "TestTxRetryFailover", session, "update user set a = 2 where id = 1", nil)
+	require.NoError(t, err)
+	retries := txRetries.Counts()[txRetryFailover]
+	sbc1.EphemeralShardErr = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "operation not allowed in state NOT_SERVING")
+	_, err = executor.Execute(context.Background(), "TestTxRetryFailover", session, "update user set a = 3 where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, retries+1, txRetries.Counts()[txRetryFailover])
+	assert.EqualValues(t, 1, sbc1.RollbackCount.Get())
+	assert.True(t, session.InTransaction())
+}
+
+func TestTxRetryDiverged(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := newTxRetrySession(t, executor)
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestTxRetryDiverged", session, sql, nil)
+		return err
+	}
+
+	require.NoError(t, exec("begin"))
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "2")})
+	require.NoError(t, exec("select id from user where id = 1"))
+
+	failures := txRetryFailures.Counts()["Diverged"]
+	sbc1.EphemeralShardErr = errDeadlock
+	err := exec("update user set a = 1 where id = 1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction cannot be retried because the result of its statement 2 changed on replay")
+	assert.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(err))
+	assert.Equal(t, failures+1, txRetryFailures.Counts()["Diverged"])
+	assert.False(t, session.InTransaction())
+	txLog, _ := session.GetTxLog()
+	assert.Empty(t, txLog)
+}
+
+func TestTxRetryBypassesResultCache(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.resultCache = newResultCache(1024*1024, newFakeResultCacheStreamer().VStream)
+	defer executor.resultCache.Close()
+	executor.consolidator = sync2.NewConsolidator()
+	session := newTxRetrySession(t, executor)
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestTxRetryBypassesResultCache", session, sql, nil)
+		return err
+	}
+
+	query := "select /*vt+ CACHE_TTL=60 */ id from user where id = 1"
+	require.NoError(t, exec(query))
+	require.NoError(t, exec("begin"))
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "2")
+	sbc1.SetResults([]*sqltypes.Result{result})
+	require.NoError(t, exec(query))
+
+	// The replayed read gets the result of the transaction again, and
+	// not the cached one.
+	sbc1.Queries = nil
+	sbc1.SetResults([]*sqltypes.Result{result})
+	sbc1.EphemeralShardErr = errDeadlock
+	require.NoError(t, exec("update user set a = 1 where id = 1"))
+	var queries []string
+	for _, q := range sbc1.Queries {
+		queries = append(queries, q.Sql)
+	}
+	assert.Equal(t, []string{
+		"update `user` set a = 1 where id = 1",
+		"select /*vt+ CACHE_TTL=60 */ id from `user` where id = 1",
+		"update `user` set a = 1 where id = 1",
+	}, queries)
+	assert.True(t, session.InTransaction())
+}
+
+func TestTxRetryCopiesBindVars(t *testing.T) {
+	bindVars := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.TestBindVariable([]interface{}{1, 2}),
+	}
+	copied := copyBindVars(bindVars)
+	bindVars["a"].Value = []byte("2")
+	bindVars["b"].Values[0] = sqltypes.ValueToProto(sqltypes.NewInt64(3))
+	assert.Equal(t, map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.TestBindVariable([]interface{}{1, 2}),
+	}, copied)
+}
+
+func TestTxRetryUnsafe(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := newTxRetrySession(t, executor)
+	defer func(size int) { *txRetryMaxLogSize = size }(*txRetryMaxLogSize)
+	*txRetryMaxLogSize = 64
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestTxRetryUnsafe", session, sql, nil)
+		return err
+	}
+
+	require.NoError(t, exec("begin"))
+	require.NoError(t, exec("select id from user where id = 1 and name = 'a long enough name to exceed the log size'"))
+	txLog, unsafe := session.GetTxLog()
+	assert.Empty(t, txLog)
+	assert.Contains(t, unsafe, "exceed the transaction_retry_max_log_size of 64 bytes")
+
+	retries := txRetries.Counts()[txRetryDeadlock]
+	sbc1.EphemeralShardErr = errDeadlock
+	err := exec("select id from user where id = 1 for update")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction cannot be retried because its statements exceed")
+	assert.Contains(t, err.Error(), "errno 1213")
+	assert.Equal(t, retries, txRetries.Counts()[txRetryDeadlock])
+	// vtgate rolls back the transactions aborted by a shard.
+	assert.False(t, session.InTransaction())
+
+	// The next transaction is recorded again.
+	require.NoError(t, exec("rollback"))
+	require.NoError(t, exec("begin"))
+	txLog, unsafe = session.GetTxLog()
+	assert.Len(t, txLog, 1)
+	assert.Empty(t, unsafe)
+}
+
+func TestTxRetryDisabled(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestTxRetryDisabled", session, sql, nil)
+		return err
+	}
+
+	require.NoError(t, exec("begin"))
+	require.NoError(t, exec("select id from user where id = 1"))
+	sbc1.EphemeralShardErr = errDeadlock
+	err := exec("update user set a = 1 where id = 1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "errno 1213")
+	txLog, _ := session.GetTxLog()
+	assert.Empty(t, txLog)
+}
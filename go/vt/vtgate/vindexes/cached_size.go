@@ -361,7 +361,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(169)
+		size += int64(184)
 	}
 	// field Type string
 	size += int64(len(cached.Type))
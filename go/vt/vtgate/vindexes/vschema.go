@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"sort"
+	"time"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -93,6 +94,8 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	ReadFromPrimary         bool                 `json:"read_from_primary,omitempty"`
+	CacheTTL                time.Duration        `json:"cache_ttl,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -243,6 +246,14 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Name:                    sqlparser.NewTableIdent(tname),
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
+			ReadFromPrimary:         table.ReadFromPrimary,
+		}
+		if table.CacheTtl != "" {
+			cacheTTL, err := time.ParseDuration(table.CacheTtl)
+			if err != nil || cacheTTL < 0 {
+				return fmt.Errorf("invalid cache_ttl %s for table %s", table.CacheTtl, tname)
+			}
+			t.CacheTTL = cacheTTL
 		}
 		switch table.Type {
 		case "", TypeReference:
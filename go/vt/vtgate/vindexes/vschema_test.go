@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -396,6 +397,43 @@ func TestVSchemaColumnsFail(t *testing.T) {
 	}
 }
 
+func TestVSchemaCacheTTL(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						CacheTtl: "1m30s",
+					},
+					"t2": {},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["unsharded"].Error)
+	assert.Equal(t, 90*time.Second, got.Keyspaces["unsharded"].Tables["t1"].CacheTTL)
+	assert.Zero(t, got.Keyspaces["unsharded"].Tables["t2"].CacheTTL)
+
+	for _, cacheTTL := range []string{"1", "-1s"} {
+		bad := vschemapb.SrvVSchema{
+			Keyspaces: map[string]*vschemapb.Keyspace{
+				"unsharded": {
+					Tables: map[string]*vschemapb.Table{
+						"t1": {
+							CacheTtl: cacheTTL,
+						},
+					},
+				},
+			},
+		}
+		got := BuildVSchema(&bad)
+		err := got.Keyspaces["unsharded"].Error
+		require.Error(t, err)
+		assert.Equal(t, "invalid cache_ttl "+cacheTTL+" for table t1", err.Error())
+	}
+}
+
 func TestVSchemaPinned(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
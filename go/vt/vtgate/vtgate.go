@@ -34,6 +34,7 @@ import (
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
@@ -70,6 +71,21 @@ var (
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
 	noScatter            = flag.Bool("no_scatter", false, "when set to true, the planner will fail instead of producing a plan that includes scatter queries")
 
+	// result cache
+	resultCacheMemory       = flag.Int64("result_cache_memory", 0, "gate server result cache size in bytes. The results of the SELECTs with a CACHE_TTL comment directive, or reading vschema tables with a cache_ttl, are cached up to this amount of memory. 0 disables the result cache.")
+	resultCacheInvalidation = flag.Bool("result_cache_invalidation", false, "invalidate the cached results of a table as soon as its rows change, by streaming its changes through VStream, instead of only expiring them with their TTL")
+
+	enableConsolidator = flag.Bool("gate_enable_consolidator", false, "consolidate the identical SELECTs in flight outside of transactions, so that they share a single execution. Sessions opt out with set @@enable_consolidator = 0")
+
+	// transaction retry
+	txRetryMaxLogSize      = flag.Int("transaction_retry_max_log_size", 1024*1024, "maximum size in bytes of the statements recorded to replay a transaction, for the sessions with @@enable_transaction_retry set. Larger transactions are not replayed")
+	txRetryMaxAttempts     = flag.Int("transaction_retry_max_attempts", 3, "maximum number of replays of a transaction that failed because of a deadlock or a failover")
+	txRetryDeadlockBackoff = flag.Duration("transaction_retry_deadlock_backoff", 50*time.Millisecond, "time to wait before replaying a transaction that failed because of a deadlock, multiplied by the number of the attempt")
+
+	// read/write split
+	readWriteSplit        = flag.Bool("read_write_split", false, "send the autocommit reads of the MySQL protocol sessions to the replicas, and their writes and transactions to the primaries. Sessions opt in or out with set @@read_write_split. The vttablets return the GTIDs of the writes with the CLIENT_SESSION_TRACK capability in -db_flags, or else read @@global.gtid_executed after each write")
+	readWriteSplitTimeout = flag.Duration("read_write_split_timeout", time.Second, "maximum time a replica waits until it executed the writes of a session that splits its reads and writes, when @@read_after_write_timeout is not set. The read is sent to the primary if the replica did not catch up")
+
 	// TODO(deepthi): change these two vars to unexported and move to healthcheck.go when LegacyHealthcheck is removed
 
 	// HealthCheckRetryDelay is the time to wait before retrying healthcheck
@@ -216,6 +232,16 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	}
 
 	executor := NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg, si, *noScatter)
+	if *resultCacheMemory > 0 {
+		var streamer resultCacheStreamer
+		if *resultCacheInvalidation {
+			streamer = vsm.VStream
+		}
+		executor.resultCache = newResultCache(*resultCacheMemory, streamer)
+	}
+	if *enableConsolidator {
+		executor.consolidator = sync2.NewConsolidator()
+	}
 
 	// connect the schema tracker with the vschema manager
 	if *enableSchemaChangeSignal {
@@ -178,7 +178,8 @@ func TestQueryRules(t *testing.T) {
 				"OnAbsent": false,
 				"Operator": ""
 			}],
-			"Action": "FAIL"
+			"Action": "FAIL",
+			"Hits": 0
 		}]
 	}`)
 	if rulesJSON != want {
@@ -142,6 +142,16 @@ func (client *FakeTabletManagerClient) ReloadSchema(ctx context.Context, tablet
 	return nil
 }
 
+// ListQueryQuarantines is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ListQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryQuarantine, error) {
+	return nil, nil
+}
+
+// ClearQueryQuarantines is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ClearQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet, fingerprint string) (int, error) {
+	return 0, nil
+}
+
 // PreflightSchema is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
 	return make([]*tabletmanagerdatapb.SchemaChangeResult, len(changes)), nil
@@ -321,7 +331,7 @@ func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topod
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
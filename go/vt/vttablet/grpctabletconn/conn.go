@@ -227,11 +227,11 @@ func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target,
 }
 
 // Commit commits the ongoing transaction.
-func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
+func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, string, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
-		return 0, tabletconn.ConnClosed
+		return 0, "", tabletconn.ConnClosed
 	}
 
 	req := &querypb.CommitRequest{
@@ -242,9 +242,9 @@ func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target,
 	}
 	resp, err := conn.c.Commit(ctx, req)
 	if err != nil {
-		return 0, tabletconn.ErrorFromGRPC(err)
+		return 0, "", tabletconn.ErrorFromGRPC(err)
 	}
-	return resp.ReservedId, nil
+	return resp.ReservedId, resp.SessionStateChanges, nil
 }
 
 // Rollback rolls back the ongoing transaction.
@@ -29,7 +29,7 @@ func (b *BenchmarkService) Begin(ctx context.Context, target *querypb.Target, op
 	panic("should not be called")
 }
 
-func (b *BenchmarkService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
+func (b *BenchmarkService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, string, error) {
 	panic("should not be called")
 }
 
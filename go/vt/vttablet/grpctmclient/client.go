@@ -349,6 +349,36 @@ func (client *Client) ReloadSchema(ctx context.Context, tablet *topodatapb.Table
 	return err
 }
 
+// ListQueryQuarantines is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ListQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryQuarantine, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.ListQueryQuarantines(ctx, &tabletmanagerdatapb.ListQueryQuarantinesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Quarantines, nil
+}
+
+// ClearQueryQuarantines is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ClearQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet, fingerprint string) (int, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+	response, err := c.ClearQueryQuarantines(ctx, &tabletmanagerdatapb.ClearQueryQuarantinesRequest{
+		Fingerprint: fingerprint,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(response.Cleared), nil
+}
+
 // PreflightSchema is part of the tmclient.TabletManagerClient interface.
 func (client *Client) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -907,13 +937,19 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	request := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: restoreToPos,
+	}
+	if !restoreToTimestamp.IsZero() {
+		request.RestoreToTimestamp = logutil.TimeToProto(restoreToTimestamp)
+	}
+	stream, err := c.RestoreFromBackup(ctx, request)
 	if err != nil {
 		closer.Close()
 		return nil, err
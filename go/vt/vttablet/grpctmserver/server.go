@@ -151,6 +151,28 @@ func (s *server) ReloadSchema(ctx context.Context, request *tabletmanagerdatapb.
 	return response, s.tm.ReloadSchema(ctx, request.WaitPosition)
 }
 
+func (s *server) ListQueryQuarantines(ctx context.Context, request *tabletmanagerdatapb.ListQueryQuarantinesRequest) (response *tabletmanagerdatapb.ListQueryQuarantinesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ListQueryQuarantines", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ListQueryQuarantinesResponse{}
+	quarantines, err := s.tm.ListQueryQuarantines(ctx)
+	if err == nil {
+		response.Quarantines = quarantines
+	}
+	return response, err
+}
+
+func (s *server) ClearQueryQuarantines(ctx context.Context, request *tabletmanagerdatapb.ClearQueryQuarantinesRequest) (response *tabletmanagerdatapb.ClearQueryQuarantinesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ClearQueryQuarantines", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ClearQueryQuarantinesResponse{}
+	cleared, err := s.tm.ClearQueryQuarantines(ctx, request.Fingerprint)
+	if err == nil {
+		response.Cleared = int64(cleared)
+	}
+	return response, err
+}
+
 func (s *server) PreflightSchema(ctx context.Context, request *tabletmanagerdatapb.PreflightSchemaRequest) (response *tabletmanagerdatapb.PreflightSchemaResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "PreflightSchema", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -488,7 +510,7 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.tm.RestoreFromBackup(ctx, logger)
+	return s.tm.RestoreFromBackup(ctx, logger, request.RestoreToPos, logutil.ProtoToTime(request.RestoreToTimestamp))
 }
 
 // registration glue
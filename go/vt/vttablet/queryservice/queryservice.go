@@ -45,8 +45,9 @@ type QueryService interface {
 	// Begin returns the transaction id to use for further operations
 	Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (int64, *topodatapb.TabletAlias, error)
 
-	// Commit commits the current transaction
-	Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error)
+	// Commit commits the current transaction. It also returns the session
+	// state changes of the commit, like the GTID of the transaction.
+	Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, string, error)
 
 	// Rollback aborts the current transaction
 	Rollback(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error)
@@ -92,6 +92,9 @@ type SandboxConn struct {
 	// ReadTransactionResults is used for returning results for ReadTransaction.
 	ReadTransactionResults []*querypb.TransactionMetadata
 
+	// CommitSessionStateChanges is returned by Commit.
+	CommitSessionStateChanges string
+
 	MessageIDs []*querypb.Value
 
 	// vstream expectations.
@@ -262,13 +265,16 @@ func (sbc *SandboxConn) begin(ctx context.Context, target *querypb.Target, preQu
 }
 
 // Commit is part of the QueryService interface.
-func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
+func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, string, error) {
 	sbc.CommitCount.Add(1)
 	reservedID := sbc.getTxReservedID(transactionID)
 	if reservedID != 0 {
 		reservedID = sbc.ReserveID.Add(1)
 	}
-	return reservedID, sbc.getError()
+	if err := sbc.getError(); err != nil {
+		return reservedID, "", err
+	}
+	return reservedID, sbc.CommitSessionStateChanges, nil
 }
 
 // Rollback is part of the QueryService interface.
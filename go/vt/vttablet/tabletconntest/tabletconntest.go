@@ -133,7 +133,7 @@ func testCommit(t *testing.T, conn queryservice.QueryService, f *FakeQueryServic
 	t.Log("testCommit")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	_, err := conn.Commit(ctx, TestTarget, commitTransactionID)
+	_, _, err := conn.Commit(ctx, TestTarget, commitTransactionID)
 	if err != nil {
 		t.Fatalf("Commit failed: %v", err)
 	}
@@ -143,7 +143,7 @@ func testCommitError(t *testing.T, conn queryservice.QueryService, f *FakeQueryS
 	t.Log("testCommitError")
 	f.HasError = true
 	testErrorHelper(t, f, "Commit", func(ctx context.Context) error {
-		_, err := conn.Commit(ctx, TestTarget, commitTransactionID)
+		_, _, err := conn.Commit(ctx, TestTarget, commitTransactionID)
 		return err
 	})
 	f.HasError = false
@@ -152,7 +152,7 @@ func testCommitError(t *testing.T, conn queryservice.QueryService, f *FakeQueryS
 func testCommitPanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testCommitPanics")
 	testPanicHelper(t, f, "Commit", func(ctx context.Context) error {
-		_, err := conn.Commit(ctx, TestTarget, commitTransactionID)
+		_, _, err := conn.Commit(ctx, TestTarget, commitTransactionID)
 		return err
 	})
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var binlogArchiveInterval = flag.Duration("binlog_archive_interval", 0, "if greater than 0, the master archives its rotated binary logs to the backup storage at this interval, so they can be replayed by point-in-time restores. Archived binary logs that are older than the oldest backup are removed.")
+
+// binlogArchiver periodically archives the rotated binary logs of the
+// master to the backup storage.
+type binlogArchiver struct {
+	ctx   context.Context
+	tm    *TabletManager
+	ticks *timer.Timer
+}
+
+func newBinlogArchiver(ctx context.Context, tm *TabletManager, interval time.Duration) *binlogArchiver {
+	return &binlogArchiver{
+		ctx:   ctx,
+		tm:    tm,
+		ticks: timer.NewTimer(interval),
+	}
+}
+
+func (ba *binlogArchiver) Open() {
+	log.Infof("Binlog archiver: archiving binary logs every %v", *binlogArchiveInterval)
+	ba.ticks.Start(ba.archive)
+}
+
+func (ba *binlogArchiver) Close() {
+	ba.ticks.Stop()
+}
+
+func (ba *binlogArchiver) archive() {
+	tablet := ba.tm.Tablet()
+	// The binary logs of the replicas contain the same transactions,
+	// so only the master archives them.
+	if tablet.Type != topodatapb.TabletType_MASTER || ba.tm.Cnf == nil {
+		return
+	}
+	logger := logutil.NewConsoleLogger()
+	count, err := mysqlctl.ArchiveBinlogs(ba.ctx, mysqlctl.BinlogArchiveParams{
+		Cnf:         ba.tm.Cnf,
+		Mysqld:      ba.tm.MysqlDaemon,
+		Logger:      logger,
+		Keyspace:    tablet.Keyspace,
+		Shard:       tablet.Shard,
+		TabletAlias: topoproto.TabletAliasString(tablet.Alias),
+	})
+	if err != nil {
+		log.Errorf("Binlog archiver: %v", err)
+	}
+	if count == 0 {
+		return
+	}
+	log.Infof("Binlog archiver: archived %v binary logs", count)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		log.Errorf("Binlog archiver: unable to get backup storage: %v", err)
+		return
+	}
+	defer bs.Close()
+	if _, err := mysqlctl.PruneBinlogArchive(ba.ctx, bs, tablet.Keyspace, tablet.Shard, logger); err != nil {
+		log.Errorf("Binlog archiver: %v", err)
+	}
+}
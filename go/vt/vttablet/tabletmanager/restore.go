@@ -119,7 +119,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 
 	startTime = time.Now()
 
-	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	err = tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, mysql.Position{}, time.Time{})
 	if err != nil {
 		return err
 	}
@@ -137,7 +137,10 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	return nil
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+// restoreDataLocked restores the most recent backup. If restoreToPos or
+// restoreToTimestamp is set, it restores the most recent backup before it,
+// then replays the archived binary logs up to it.
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, restoreToPos mysql.Position, restoreToTimestamp time.Time) error {
 
 	tablet := tm.Tablet()
 	originalType := tablet.Type
@@ -174,7 +177,10 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
 		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		RestoreToPos:        restoreToPos,
+		RestoreToTimestamp:  restoreToTimestamp,
 	}
+	pointInTime := !restoreToPos.IsZero() || !restoreToTimestamp.IsZero()
 
 	// Check whether we're going to restore before changing to RESTORE type,
 	// so we keep our MasterTermStartTime (if any) if we aren't actually restoring.
@@ -234,6 +240,12 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
+		if pointInTime {
+			// The data is in the past, so the tablet must neither
+			// replicate nor serve until an operator decides what to do.
+			params.Logger.Infof("Restore: restored to %v, changing type to DRAINED without starting replication", pos)
+			return tm.tmState.ChangeTabletType(ctx, topodatapb.TabletType_DRAINED, DBActionNone)
+		}
 		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to master only for "NORMAL" keyspaces
 			if err := tm.startReplication(context.Background(), pos, originalType); err != nil {
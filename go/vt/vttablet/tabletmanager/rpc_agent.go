@@ -62,6 +62,10 @@ type RPCTM interface {
 
 	ReloadSchema(ctx context.Context, waitPosition string) error
 
+	ListQueryQuarantines(ctx context.Context) ([]*tabletmanagerdatapb.QueryQuarantine, error)
+
+	ClearQueryQuarantines(ctx context.Context, fingerprint string) (int, error)
+
 	PreflightSchema(ctx context.Context, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error)
 
 	ApplySchema(ctx context.Context, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error)
@@ -149,7 +153,7 @@ type RPCTM interface {
 
 	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
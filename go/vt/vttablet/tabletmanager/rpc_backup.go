@@ -22,12 +22,14 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 const (
@@ -151,7 +153,20 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 }
 
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToPos or restoreToTimestamp is set, it restores the latest backup
+// before it and replays the archived binary logs up to it.
+func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error {
+	var pos mysql.Position
+	if restoreToPos != "" {
+		var err error
+		if pos, err = mysql.DecodePosition(restoreToPos); err != nil {
+			return vterrors.Wrapf(err, "invalid restore position %v", restoreToPos)
+		}
+	}
+	if !pos.IsZero() && !restoreToTimestamp.IsZero() {
+		return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "cannot restore to both a position and a timestamp")
+	}
+
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -169,7 +184,7 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, pos, restoreToTimestamp)
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
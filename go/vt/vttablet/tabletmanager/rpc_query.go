@@ -22,8 +22,10 @@ import (
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 // ExecuteFetchAsDba will execute the given query, possibly disabling binlogs and reload schema.
@@ -118,3 +120,25 @@ func (tm *TabletManager) ExecuteQuery(ctx context.Context, query []byte, dbName
 	result, err := tm.QueryServiceControl.QueryService().Execute(ctx, target, string(query), nil, 0, 0, nil)
 	return sqltypes.ResultToProto3(result), err
 }
+
+// ListQueryQuarantines returns the queries quarantined by the query service.
+func (tm *TabletManager) ListQueryQuarantines(ctx context.Context) ([]*tabletmanagerdatapb.QueryQuarantine, error) {
+	var quarantines []*tabletmanagerdatapb.QueryQuarantine
+	for _, q := range tm.QueryServiceControl.QueryQuarantines() {
+		quarantines = append(quarantines, &tabletmanagerdatapb.QueryQuarantine{
+			Fingerprint: q.Fingerprint,
+			Action:      q.Action,
+			Reason:      q.Reason,
+			StartTime:   logutil.TimeToProto(q.StartTime),
+			ExpireTime:  logutil.TimeToProto(q.ExpireTime),
+			Hits:        q.Hits,
+		})
+	}
+	return quarantines, nil
+}
+
+// ClearQueryQuarantines releases the quarantined query fingerprint, or all
+// the quarantined queries if fingerprint is empty.
+func (tm *TabletManager) ClearQueryQuarantines(ctx context.Context, fingerprint string) (int, error) {
+	return tm.QueryServiceControl.ClearQueryQuarantines(fingerprint), nil
+}
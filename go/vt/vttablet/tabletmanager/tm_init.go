@@ -144,6 +144,9 @@ type TabletManager struct {
 	// replManager manages replication.
 	replManager *replManager
 
+	// binlogArchiver archives the binary logs, if enabled.
+	binlogArchiver *binlogArchiver
+
 	// tabletAlias is saved away from tablet for read-only access
 	tabletAlias *topodatapb.TabletAlias
 
@@ -312,6 +315,10 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		go tm.orc.DiscoverLoop(tm)
 	}
 	servenv.OnRun(tm.registerTabletManager)
+	if *binlogArchiveInterval > 0 {
+		tm.binlogArchiver = newBinlogArchiver(tm.BatchCtx, tm, *binlogArchiveInterval)
+		tm.binlogArchiver.Open()
+	}
 
 	restoring, err := tm.handleRestore(tm.BatchCtx)
 	if err != nil {
@@ -336,6 +343,9 @@ func (tm *TabletManager) Close() {
 	// running during lame duck.
 	tm.stopShardSync()
 	tm.stopRebuildKeyspace()
+	if tm.binlogArchiver != nil {
+		tm.binlogArchiver.Close()
+	}
 
 	// cleanup initialized fields in the tablet entry
 	f := func(tablet *topodatapb.Tablet) error {
@@ -367,6 +377,9 @@ func (tm *TabletManager) Stop() {
 	// here in addition to in Close() because tests do not call Close().
 	tm.stopShardSync()
 	tm.stopRebuildKeyspace()
+	if tm.binlogArchiver != nil {
+		tm.binlogArchiver.Close()
+	}
 
 	if tm.UpdateStream != nil {
 		tm.UpdateStream.Disable()
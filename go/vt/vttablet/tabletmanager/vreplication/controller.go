@@ -237,18 +237,7 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		player := binlogplayer.NewBinlogPlayerKeyRange(dbClient, tablet, ct.source.KeyRange, ct.id, ct.blpStats)
 		return player.ApplyBinlogEvents(ctx)
 	case ct.source.Filter != nil:
-		// Timestamp fields from binlogs are always sent as UTC.
-		// So, we should set the timezone to be UTC for those values to be correctly inserted.
-		if _, err := dbClient.ExecuteFetch("set @@session.time_zone = '+00:00'", 10000); err != nil {
-			return err
-		}
-		// Tables may have varying character sets. To ship the bits without interpreting them
-		// we set the character set to be binary.
-		if _, err := dbClient.ExecuteFetch("set names binary", 10000); err != nil {
-			return err
-		}
-		// We must apply AUTO_INCREMENT values precisely as we got them. This include the 0 value, which is not recommended in AUTO_INCREMENT, and yet is valid.
-		if _, err := dbClient.ExecuteFetch("set @@session.sql_mode = CONCAT(@@session.sql_mode, ',NO_AUTO_VALUE_ON_ZERO')", 10000); err != nil {
+		if err := setSessionVariables(dbClient); err != nil {
 			return err
 		}
 
@@ -274,6 +263,26 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 	return fmt.Errorf("missing source")
 }
 
+// setSessionVariables prepares a connection for applying the rows and events
+// streamed by a vstreamer.
+func setSessionVariables(dbClient binlogplayer.DBClient) error {
+	// Timestamp fields from binlogs are always sent as UTC.
+	// So, we should set the timezone to be UTC for those values to be correctly inserted.
+	if _, err := dbClient.ExecuteFetch("set @@session.time_zone = '+00:00'", 10000); err != nil {
+		return err
+	}
+	// Tables may have varying character sets. To ship the bits without interpreting them
+	// we set the character set to be binary.
+	if _, err := dbClient.ExecuteFetch("set names binary", 10000); err != nil {
+		return err
+	}
+	// We must apply AUTO_INCREMENT values precisely as we got them. This include the 0 value, which is not recommended in AUTO_INCREMENT, and yet is valid.
+	if _, err := dbClient.ExecuteFetch("set @@session.sql_mode = CONCAT(@@session.sql_mode, ',NO_AUTO_VALUE_ON_ZERO')", 10000); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (ct *controller) setMessage(dbClient binlogplayer.DBClient, message string) error {
 	ct.blpStats.History.Add(&binlogplayer.StatsHistoryRecord{
 		Time:    time.Now(),
@@ -36,6 +36,9 @@ type controllerPlan struct {
 
 	// delCopyState is set of deletes.
 	delCopyState *sqlparser.ParsedQuery
+
+	// delVDiffTables is set for vdiffDeleteQuery.
+	delVDiffTables *sqlparser.ParsedQuery
 }
 
 const (
@@ -44,6 +47,9 @@ const (
 	deleteQuery
 	selectQuery
 	reshardingJournalQuery
+	vdiffInsertQuery
+	vdiffUpdateQuery
+	vdiffDeleteQuery
 )
 
 // buildControllerPlan parses the input query and returns an appropriate plan.
@@ -73,6 +79,7 @@ func buildControllerPlan(query string) (*controllerPlan, error) {
 }
 
 func buildInsertPlan(ins *sqlparser.Insert) (*controllerPlan, error) {
+	opcode := insertQuery
 	switch sqlparser.String(ins.Table) {
 	case reshardingJournalTableName:
 		return &controllerPlan{
@@ -80,6 +87,8 @@ func buildInsertPlan(ins *sqlparser.Insert) (*controllerPlan, error) {
 		}, nil
 	case vreplicationTableName:
 		// no-op
+	case vdiffTableName:
+		opcode = vdiffInsertQuery
 	default:
 		return nil, fmt.Errorf("invalid table name: %v", sqlparser.String(ins.Table))
 	}
@@ -120,12 +129,13 @@ func buildInsertPlan(ins *sqlparser.Insert) (*controllerPlan, error) {
 		}
 	}
 	return &controllerPlan{
-		opcode:     insertQuery,
+		opcode:     opcode,
 		numInserts: len(rows),
 	}, nil
 }
 
 func buildUpdatePlan(upd *sqlparser.Update) (*controllerPlan, error) {
+	opcode, tableName := updateQuery, vreplicationTableName
 	switch sqlparser.String(upd.TableExprs) {
 	case reshardingJournalTableName:
 		return &controllerPlan{
@@ -133,6 +143,8 @@ func buildUpdatePlan(upd *sqlparser.Update) (*controllerPlan, error) {
 		}, nil
 	case vreplicationTableName:
 		// no-op
+	case vdiffTableName:
+		opcode, tableName = vdiffUpdateQuery, vdiffTableName
 	default:
 		return nil, fmt.Errorf("invalid table name: %v", sqlparser.String(upd.TableExprs))
 	}
@@ -146,7 +158,7 @@ func buildUpdatePlan(upd *sqlparser.Update) (*controllerPlan, error) {
 	}
 
 	buf1 := sqlparser.NewTrackedBuffer(nil)
-	buf1.Myprintf("select id from %s%v", vreplicationTableName, upd.Where)
+	buf1.Myprintf("select id from %s%v", tableName, upd.Where)
 	upd.Where = &sqlparser.Where{
 		Type: sqlparser.WhereClause,
 		Expr: &sqlparser.ComparisonExpr{
@@ -160,13 +172,14 @@ func buildUpdatePlan(upd *sqlparser.Update) (*controllerPlan, error) {
 	buf2.Myprintf("%v", upd)
 
 	return &controllerPlan{
-		opcode:   updateQuery,
+		opcode:   opcode,
 		selector: buf1.String(),
 		applier:  buf2.ParsedQuery(),
 	}, nil
 }
 
 func buildDeletePlan(del *sqlparser.Delete) (*controllerPlan, error) {
+	isVDiff := false
 	switch sqlparser.String(del.TableExprs) {
 	case reshardingJournalTableName:
 		return &controllerPlan{
@@ -174,6 +187,8 @@ func buildDeletePlan(del *sqlparser.Delete) (*controllerPlan, error) {
 		}, nil
 	case vreplicationTableName:
 		// no-op
+	case vdiffTableName:
+		isVDiff = true
 	default:
 		return nil, fmt.Errorf("invalid table name: %v", sqlparser.String(del.TableExprs))
 	}
@@ -187,8 +202,12 @@ func buildDeletePlan(del *sqlparser.Delete) (*controllerPlan, error) {
 		return nil, fmt.Errorf("unsupported construct: %v", sqlparser.String(del))
 	}
 
+	tableName := vreplicationTableName
+	if isVDiff {
+		tableName = vdiffTableName
+	}
 	buf1 := sqlparser.NewTrackedBuffer(nil)
-	buf1.Myprintf("select id from %s%v", vreplicationTableName, del.Where)
+	buf1.Myprintf("select id from %s%v", tableName, del.Where)
 	del.Where = &sqlparser.Where{
 		Type: sqlparser.WhereClause,
 		Expr: &sqlparser.ComparisonExpr{
@@ -201,6 +220,25 @@ func buildDeletePlan(del *sqlparser.Delete) (*controllerPlan, error) {
 	buf2 := sqlparser.NewTrackedBuffer(nil)
 	buf2.Myprintf("%v", del)
 
+	if isVDiff {
+		vdiffTableWhere := &sqlparser.Where{
+			Type: sqlparser.WhereClause,
+			Expr: &sqlparser.ComparisonExpr{
+				Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent("vdiff_id")},
+				Operator: sqlparser.InOp,
+				Right:    sqlparser.ListArg("ids"),
+			},
+		}
+		buf3 := sqlparser.NewTrackedBuffer(nil)
+		buf3.Myprintf("delete from %s%v", vdiffTableTableName, vdiffTableWhere)
+		return &controllerPlan{
+			opcode:         vdiffDeleteQuery,
+			selector:       buf1.String(),
+			applier:        buf2.ParsedQuery(),
+			delVDiffTables: buf3.ParsedQuery(),
+		}, nil
+	}
+
 	copyStateWhere := &sqlparser.Where{
 		Type: sqlparser.WhereClause,
 		Expr: &sqlparser.ComparisonExpr{
@@ -222,7 +260,7 @@ func buildDeletePlan(del *sqlparser.Delete) (*controllerPlan, error) {
 
 func buildSelectPlan(sel *sqlparser.Select) (*controllerPlan, error) {
 	switch sqlparser.ToString(sel.From) {
-	case vreplicationTableName, reshardingJournalTableName, copyStateTableName, vreplicationLogTableName:
+	case vreplicationTableName, reshardingJournalTableName, copyStateTableName, vreplicationLogTableName, vdiffTableName, vdiffTableTableName:
 		return &controllerPlan{
 			opcode: selectQuery,
 		}, nil
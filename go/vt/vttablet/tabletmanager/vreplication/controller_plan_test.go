@@ -22,12 +22,13 @@ import (
 )
 
 type testControllerPlan struct {
-	query        string
-	opcode       int
-	numInserts   int
-	selector     string
-	applier      string
-	delCopyState string
+	query          string
+	opcode         int
+	numInserts     int
+	selector       string
+	applier        string
+	delCopyState   string
+	delVDiffTables string
 }
 
 func TestControllerPlan(t *testing.T) {
@@ -70,6 +71,19 @@ func TestControllerPlan(t *testing.T) {
 			query:  "insert into _vt.resharding_journal values (1)",
 			opcode: reshardingJournalQuery,
 		},
+	}, {
+		in: "insert into _vt.vdiff(vdiff_uuid, workflow, db_name, state) values('a', 'wf', 'db', 'pending')",
+		plan: &testControllerPlan{
+			query:      "insert into _vt.vdiff(vdiff_uuid, workflow, db_name, state) values('a', 'wf', 'db', 'pending')",
+			opcode:     vdiffInsertQuery,
+			numInserts: 1,
+		},
+	}, {
+		in:  "insert into _vt.vdiff(id, vdiff_uuid) values(1, 'a')",
+		err: "id should not have a value: insert into _vt.vdiff(id, vdiff_uuid) values (1, 'a')",
+	}, {
+		in:  "insert into _vt.vdiff_table(vdiff_id) values(1)",
+		err: "invalid table name: _vt.vdiff_table",
 	}, {
 		in:  "replace into _vt.vreplication values(null)",
 		err: "unsupported construct: replace into _vt.vreplication values (null)",
@@ -129,6 +143,14 @@ func TestControllerPlan(t *testing.T) {
 			query:  "update _vt.resharding_journal set col = 1",
 			opcode: reshardingJournalQuery,
 		},
+	}, {
+		in: "update _vt.vdiff set state='stopped' where vdiff_uuid = 'a'",
+		plan: &testControllerPlan{
+			query:    "update _vt.vdiff set state='stopped' where vdiff_uuid = 'a'",
+			opcode:   vdiffUpdateQuery,
+			selector: "select id from _vt.vdiff where vdiff_uuid = 'a'",
+			applier:  "update _vt.vdiff set state = 'stopped' where id in ::ids",
+		},
 	}, {
 		in:  "update a set state='Running' where id = 1",
 		err: "invalid table name: a",
@@ -176,6 +198,15 @@ func TestControllerPlan(t *testing.T) {
 			query:  "delete from _vt.resharding_journal where id = 1",
 			opcode: reshardingJournalQuery,
 		},
+	}, {
+		in: "delete from _vt.vdiff where vdiff_uuid = 'a'",
+		plan: &testControllerPlan{
+			query:          "delete from _vt.vdiff where vdiff_uuid = 'a'",
+			opcode:         vdiffDeleteQuery,
+			selector:       "select id from _vt.vdiff where vdiff_uuid = 'a'",
+			applier:        "delete from _vt.vdiff where id in ::ids",
+			delVDiffTables: "delete from _vt.vdiff_table where vdiff_id in ::ids",
+		},
 	}, {
 		in:  "delete from a where id = 1",
 		err: "invalid table name: a",
@@ -211,6 +242,12 @@ func TestControllerPlan(t *testing.T) {
 			opcode: selectQuery,
 			query:  "select * from _vt.copy_state",
 		},
+	}, {
+		in: "select * from _vt.vdiff_table",
+		plan: &testControllerPlan{
+			opcode: selectQuery,
+			query:  "select * from _vt.vdiff_table",
+		},
 	}, {
 		in:  "select * from a",
 		err: "invalid table name: a",
@@ -247,6 +284,9 @@ func TestControllerPlan(t *testing.T) {
 		if pl.delCopyState != nil {
 			gotPlan.delCopyState = pl.delCopyState.Query
 		}
+		if pl.delVDiffTables != nil {
+			gotPlan.delVDiffTables = pl.delVDiffTables.Query
+		}
 		if !reflect.DeepEqual(gotPlan, tcase.plan) {
 			t.Errorf("getPlan(%v):\n%+v, want\n%+v", tcase.in, gotPlan, tcase.plan)
 		}
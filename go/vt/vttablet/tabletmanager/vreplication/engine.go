@@ -59,8 +59,18 @@ const (
 	createCopyState = `create table if not exists _vt.copy_state (
   vrepl_id int,
   table_name varbinary(128),
+  range_id int not null default 0,
   lastpk varbinary(2000),
-  primary key (vrepl_id, table_name))`
+  range_end varbinary(2000),
+  pos varbinary(10000),
+  primary key (vrepl_id, table_name, range_id))`
+
+	alterCopyStateRanges = `alter table _vt.copy_state
+  add column range_id int not null default 0 after table_name,
+  add column range_end varbinary(2000),
+  add column pos varbinary(10000),
+  drop primary key,
+  add primary key (vrepl_id, table_name, range_id)`
 )
 
 var withDDL *withddl.WithDDL
@@ -73,8 +83,9 @@ const (
 func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
-	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, createReshardingJournalTable, createCopyState, alterCopyStateRanges)
 	allddls = append(allddls, createVReplicationLogTable)
+	allddls = append(allddls, createVDiffTable, createVDiffTableTable)
 	withDDL = withddl.New(allddls)
 
 	withDDLInitialQueries = append(withDDLInitialQueries, binlogplayer.WithDDLInitialQueries...)
@@ -98,7 +109,7 @@ var rowsCopiedUpdateInterval = 30 * time.Second
 
 // Engine is the engine for handling vreplication.
 type Engine struct {
-	// mu synchronizes isOpen, cancelRetry, controllers and wg.
+	// mu synchronizes isOpen, cancelRetry, controllers, vdiffs and wg.
 	mu     sync.Mutex
 	isOpen bool
 	// If cancelRetry is set, then a retry loop is running.
@@ -106,6 +117,8 @@ type Engine struct {
 	// no more retries.
 	cancelRetry context.CancelFunc
 	controllers map[int]*controller
+	// vdiffs are the running tablet vdiffs.
+	vdiffs map[int]*vdiffController
 	// wg is used by in-flight functions that can run for long periods.
 	wg sync.WaitGroup
 
@@ -138,6 +151,7 @@ type journalEvent struct {
 func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, lagThrottler *throttle.Throttler) *Engine {
 	vre := &Engine{
 		controllers:     make(map[int]*controller),
+		vdiffs:          make(map[int]*vdiffController),
 		ts:              ts,
 		cell:            cell,
 		mysqld:          mysqld,
@@ -168,6 +182,7 @@ func (vre *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
 func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, dbClientFactoryFiltered func() binlogplayer.DBClient, dbClientFactoryDba func() binlogplayer.DBClient, dbname string, externalConfig map[string]*dbconfigs.DBConfigs) *Engine {
 	vre := &Engine{
 		controllers:             make(map[int]*controller),
+		vdiffs:                  make(map[int]*vdiffController),
 		ts:                      ts,
 		cell:                    cell,
 		mysqld:                  mysqld,
@@ -217,6 +232,7 @@ func (vre *Engine) openLocked(ctx context.Context) error {
 	vre.ctx, vre.cancel = context.WithCancel(ctx)
 	vre.isOpen = true
 	vre.initControllers(rows)
+	vre.initVDiffs(ctx)
 	vre.updateStats()
 	return nil
 }
@@ -275,6 +291,10 @@ func (vre *Engine) IsOpen() bool {
 
 // Close closes the Engine service.
 func (vre *Engine) Close() {
+	// The vdiffs are stopped first, because they restart
+	// their workflow through the engine.
+	vre.stopVDiffs()
+
 	vre.mu.Lock()
 	defer vre.mu.Unlock()
 
@@ -477,6 +497,8 @@ func (vre *Engine) exec(query string, runAsAdmin bool) (*sqltypes.Result, error)
 			return nil, err
 		}
 		return qr, nil
+	case vdiffInsertQuery, vdiffUpdateQuery, vdiffDeleteQuery:
+		return vre.execVDiff(plan, dbClient)
 	case selectQuery, reshardingJournalQuery:
 		// select and resharding journal queries are passed through.
 		return withDDL.Exec(vre.ctx, plan.query, dbClient.ExecuteFetch)
@@ -51,6 +51,9 @@ type VStreamerClient interface {
 
 	// VStreamRows streams rows of a table from the specified starting point.
 	VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error
+
+	// VStreamResults streams the results of a query along with the gtid of its snapshot.
+	VStreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error
 }
 
 type externalConnector struct {
@@ -141,6 +144,10 @@ func (c *mysqlConnector) VStreamRows(ctx context.Context, query string, lastpk *
 	return c.vstreamer.StreamRows(ctx, query, row, send)
 }
 
+func (c *mysqlConnector) VStreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	return c.vstreamer.StreamResults(ctx, query, send)
+}
+
 //-----------------------------------------------------------
 
 type tabletConnector struct {
@@ -177,3 +184,7 @@ func (tc *tabletConnector) VStream(ctx context.Context, startPos string, tablePK
 func (tc *tabletConnector) VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
 	return tc.qs.VStreamRows(ctx, tc.target, query, lastpk, send)
 }
+
+func (tc *tabletConnector) VStreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	return tc.qs.VStreamResults(ctx, tc.target, query, send)
+}
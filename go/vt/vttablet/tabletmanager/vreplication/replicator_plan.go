@@ -26,6 +26,7 @@ import (
 
 	"vitess.io/vitess/go/bytes2"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -81,7 +82,7 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return &tplanv, nil
 	}
 	// select * construct was used. We need to use the field names.
-	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, fieldEvent.Fields)
+	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.copyRanges, prelim.idempotent, fieldEvent.Fields)
 	if err != nil {
 		return nil, err
 	}
@@ -92,12 +93,13 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 // buildFromFields builds a full TablePlan, but uses the field info as the
 // full column list. This happens when the query used was a 'select *', which
 // requires us to wait for the field info sent by the source.
-func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, fields []*querypb.Field) (*TablePlan, error) {
+func (rp *ReplicatorPlan) buildFromFields(tableName string, copyRanges []*copyRange, idempotent bool, fields []*querypb.Field) (*TablePlan, error) {
 	tpb := &tablePlanBuilder{
-		name:     sqlparser.NewTableIdent(tableName),
-		lastpk:   lastpk,
-		colInfos: rp.ColInfoMap[tableName],
-		stats:    rp.stats,
+		name:       sqlparser.NewTableIdent(tableName),
+		copyRanges: copyRanges,
+		idempotent: idempotent,
+		colInfos:   rp.ColInfoMap[tableName],
+		stats:      rp.stats,
 	}
 	for _, field := range fields {
 		colName := sqlparser.NewColIdent(field.Name)
@@ -172,9 +174,8 @@ type TablePlan struct {
 	// TargetName, SendRule will always be initialized.
 	TargetName string
 	SendRule   *binlogdatapb.Rule
-	// Lastpk will be initialized if it was specified, and
-	// will be used for building the final plan after field info
-	// is received.
+	// Lastpk will be initialized if the table is copied as a single
+	// range, and is used to skip events for rows that were not copied yet.
 	Lastpk *sqltypes.Result
 	// BulkInsertFront, BulkInsertValues and BulkInsertOnDup are used
 	// by vcopier. These three parts are combined to build bulk insert
@@ -200,6 +201,19 @@ type TablePlan struct {
 	FieldsToSkip            map[string]bool
 	ConvertCharset          map[string](*binlogdatapb.CharsetConversion)
 	HasExtraSourcePkColumns bool
+
+	// onInsert is the type of insert statements of the plan.
+	onInsert insertType
+	// copyRanges and idempotent are used for building the final
+	// plan after field info is received.
+	copyRanges []*copyRange
+	idempotent bool
+	// colExprs are the expressions of the columns. If the stream
+	// writes to a sink, they are used to build sinkColumns once the
+	// field info is received.
+	colExprs        []*colExpr
+	sinkColumns     []*sinkColumn
+	sinkColumnNames []string
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -281,7 +295,7 @@ func (tp *TablePlan) isOutsidePKRange(bindvars map[string]*querypb.BindVariable,
 		}
 
 		rowVal, _ := sqltypes.BindVariableToValue(bindvar)
-		result, err := evalengine.NullsafeCompare(rowVal, tp.Lastpk.Rows[0][0])
+		result, err := evalengine.NullsafeCompare(rowVal, tp.Lastpk.Rows[0][0], collations.Unknown)
 		// If rowVal is > last pk, transaction will be a noop, so don't apply this statement
 		if err == nil && result > 0 {
 			tp.Stats.NoopQueryCount.Add(stmtType, 1)
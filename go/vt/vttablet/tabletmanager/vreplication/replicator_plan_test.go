@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -414,6 +415,56 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// Expressions and filters evaluated on the source.
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, case when c2 > 0 then 'pos' else 'neg' end as c2 from t1 where c3 in (1, 2) and (c4 like 'x%' or c4 is null)",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2 from t1 where c3 in (1, 2) and (c4 like 'x%' or c4 is null)",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,case when :a_c2 > 0 then 'pos' else 'neg' end)",
+					Insert:       "insert into t1(c1,c2) values (:a_c1,case when :a_c2 > 0 then 'pos' else 'neg' end)",
+					Update:       "update t1 set c2=case when :a_c2 > 0 then 'pos' else 'neg' end where c1=:b_c1",
+					Delete:       "delete from t1 where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2, pk1, pk2 from t1 where c3 in (1, 2) and (c4 like 'x%' or c4 is null)",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,case when :a_c2 > 0 then 'pos' else 'neg' end)",
+					Insert:       "insert into t1(c1,c2) select :a_c1, case when :a_c2 > 0 then 'pos' else 'neg' end from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=case when :a_c2 > 0 then 'pos' else 'neg' end where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
 	}, {
 		// Keywords as names.
 		input: &binlogdatapb.Filter{
@@ -667,20 +718,39 @@ func TestBuildPlayerPlan(t *testing.T) {
 			}},
 		},
 		err: "group by expression is not allowed to reference an aggregate expression: a",
+	}, {
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, concat(c2, uuid()) as c2 from t1",
+			}},
+		},
+		err: "unsupported non-deterministic expression: uuid()",
+	}, {
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, c2 from t1 where c3 > now() - 1",
+			}},
+		},
+		err: "unsupported non-deterministic expression: now()",
 	}}
 
 	PrimaryKeyInfos := map[string][]*ColumnInfo{
 		"t1": {&ColumnInfo{Name: "c1", IsPK: true}},
 	}
 
-	copyState := map[string]*sqltypes.Result{
-		"t1": sqltypes.MakeTestResult(
-			sqltypes.MakeTestFields(
-				"pk1|pk2",
-				"int64|varchar",
+	copyState := map[string][]*copyRange{
+		"t1": {{
+			table: "t1",
+			lastpk: sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"pk1|pk2",
+					"int64|varchar",
+				),
+				"1|aaa",
 			),
-			"1|aaa",
-		),
+		}},
 	}
 
 	for _, tcase := range testcases {
@@ -768,3 +838,78 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestBuildPlayerPlanCopyRanges(t *testing.T) {
+	colInfos := map[string][]*ColumnInfo{
+		"t1": {{Name: "c1", IsPK: true}, {Name: "c2"}},
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, c2 from t1",
+		}},
+	}
+	pk := func(val string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1", "int64"), val)
+	}
+	pos, err := binlogplayer.DecodePosition("MariaDB/0-1-1083")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		ranges []*copyRange
+		insert string
+		update string
+		delete string
+	}{{
+		// Table not copied yet.
+		ranges: []*copyRange{{table: "t1"}},
+	}, {
+		// Single range.
+		ranges: []*copyRange{{table: "t1", lastpk: pk("10")}},
+		insert: "insert into t1(c1,c2) select :a_c1, :a_c2 from dual where (:a_c1) <= (10)",
+		update: "update t1 set c2=:a_c2 where c1=:b_c1 and (:b_c1) <= (10)",
+		delete: "delete from t1 where c1=:b_c1 and (:b_c1) <= (10)",
+	}, {
+		// Split table, with the first range done and the last one not started.
+		ranges: []*copyRange{
+			{table: "t1", lastpk: pk("100"), end: pk("100"), done: true},
+			{table: "t1", id: 1, lastpk: pk("150"), end: pk("200")},
+			{table: "t1", id: 2, lastpk: pk("200")},
+		},
+		insert: "insert into t1(c1,c2) select :a_c1, :a_c2 from dual where ((:a_c1) <= (150) or (:a_c1) > (200)) and (:a_c1) <= (200)",
+		update: "update t1 set c2=:a_c2 where c1=:b_c1 and ((:b_c1) <= (150) or (:b_c1) > (200)) and (:b_c1) <= (200)",
+		delete: "delete from t1 where c1=:b_c1 and ((:b_c1) <= (150) or (:b_c1) > (200)) and (:b_c1) <= (200)",
+	}, {
+		// First range not started, other ranges copied ahead.
+		ranges: []*copyRange{
+			{table: "t1", end: pk("100")},
+			{table: "t1", id: 1, lastpk: pk("200"), end: pk("200"), pos: pos, done: true},
+			{table: "t1", id: 2, lastpk: pk("250"), pos: pos},
+		},
+		insert: "insert into t1(c1,c2) select :a_c1, :a_c2 from dual where (:a_c1) > (100) and (:a_c1) <= (250) on duplicate key update c1=values(c1), c2=values(c2)",
+		update: "update t1 set c2=:a_c2 where c1=:b_c1 and (:b_c1) > (100) and (:b_c1) <= (250)",
+		delete: "delete from t1 where c1=:b_c1 and (:b_c1) > (100) and (:b_c1) <= (250)",
+	}, {
+		// All ranges copied, some of them ahead.
+		ranges: []*copyRange{
+			{table: "t1", lastpk: pk("100"), end: pk("100"), done: true},
+			{table: "t1", id: 1, lastpk: pk("200"), end: pk("200"), pos: pos, done: true},
+		},
+		insert: "insert into t1(c1,c2) values (:a_c1,:a_c2) on duplicate key update c1=values(c1), c2=values(c2)",
+		update: "update t1 set c2=:a_c2 where c1=:b_c1",
+		delete: "delete from t1 where c1=:b_c1",
+	}}
+	for _, tcase := range testcases {
+		plan, err := buildReplicatorPlan(filter, colInfos, map[string][]*copyRange{"t1": tcase.ranges}, binlogplayer.NewStats())
+		require.NoError(t, err)
+		tablePlan, ok := plan.TargetTables["t1"]
+		if tcase.insert == "" {
+			assert.False(t, ok, "plan for uncopied table")
+			continue
+		}
+		require.True(t, ok)
+		assert.Equal(t, tcase.insert, tablePlan.Insert.Query)
+		assert.Equal(t, tcase.update, tablePlan.Update.Query)
+		assert.Equal(t, tcase.delete, tablePlan.Delete.Query)
+	}
+}
@@ -0,0 +1,401 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var (
+	// sinkDirs are the directories the file sinks may write to.
+	// The file sinks are disabled if there are none.
+	sinkDirs []string
+	// sinkExecCommands are the commands the exec sink may run.
+	// The exec sink is disabled if there are none.
+	sinkExecCommands []string
+	sinkExecTimeout  = flag.Duration("vreplication_sink_exec_timeout", 1*time.Minute, "the maximum time a command of a vreplication exec sink may run for each flush")
+)
+
+func init() {
+	flagutil.StringListVar(&sinkDirs, "vreplication_sink_dirs", nil, "comma separated list of the directories, and their subdirectories, vreplication file sinks may write to. File sinks are disabled if empty")
+	flagutil.StringListVar(&sinkExecCommands, "vreplication_sink_exec_commands", nil, "comma separated list of the commands vreplication exec sinks may run, as the first element of the sink command. Exec sinks are disabled if empty")
+}
+
+// The following values are the operations of a SinkEvent.
+const (
+	SinkInsert = "insert"
+	SinkUpdate = "update"
+	SinkDelete = "delete"
+)
+
+// SinkEvent is the change of a row of a stream that writes to a sink.
+// The rows copied during the copy phase are inserts.
+type SinkEvent struct {
+	// Table is the name of the table in the filter rules.
+	Table string
+	// Op is SinkInsert, SinkUpdate or SinkDelete.
+	Op string
+	// Columns are the names of the values of Before and After.
+	Columns []string
+	// Before is nil for inserts, and After is nil for deletes.
+	Before []sqltypes.Value
+	After  []sqltypes.Value
+	// Timestamp is the time of the change on the source, in seconds.
+	// It's zero for copied rows.
+	Timestamp int64
+	// Copied is set if the row was copied during the copy phase.
+	Copied bool
+}
+
+// Sink is a target of vreplication other than the local database.
+// The position of the stream and the progress of the copy phase are
+// saved in the local database, right after the events that lead to them
+// are flushed. Events may therefore be written again after a restart:
+// a sink delivers them at least once.
+type Sink interface {
+	// Write writes an event. The event may be buffered until Flush.
+	Write(event *SinkEvent) error
+	// Flush makes all the events written so far durable.
+	Flush() error
+	// Close flushes the events and releases the sink.
+	Close() error
+}
+
+// SinkFactory creates the sink of the stream with the given id.
+// The context is canceled when the stream stops.
+type SinkFactory func(ctx context.Context, id uint32, params *binlogdatapb.Sink) (Sink, error)
+
+var sinkFactories = make(map[string]SinkFactory)
+
+// RegisterSink registers a sink implementation for the given type.
+// It must be invoked from an init function.
+func RegisterSink(typ string, factory SinkFactory) {
+	if _, ok := sinkFactories[typ]; ok {
+		log.Fatalf("sink type %s is already registered", typ)
+	}
+	sinkFactories[typ] = factory
+}
+
+// newSink creates the sink of a stream. Streams that write to a sink have
+// no target tables, so their DDLs can't be applied.
+func newSink(ctx context.Context, id uint32, source *binlogdatapb.BinlogSource) (Sink, error) {
+	factory, ok := sinkFactories[source.Sink.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown sink type: %q", source.Sink.Type)
+	}
+	switch source.OnDdl {
+	case binlogdatapb.OnDDLAction_EXEC, binlogdatapb.OnDDLAction_EXEC_IGNORE:
+		return nil, fmt.Errorf("on_ddl %v is not supported with a sink", source.OnDdl)
+	}
+	return factory(ctx, id, source.Sink)
+}
+
+// checkSinkDir returns an error if dir isn't one of the sink
+// directories, or one of their subdirectories.
+func checkSinkDir(dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	for _, allowed := range sinkDirs {
+		allowed, err := filepath.Abs(allowed)
+		if err != nil {
+			return err
+		}
+		if rel, err := filepath.Rel(allowed, dir); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("sink directory %s is not allowed by -vreplication_sink_dirs", dir)
+}
+
+// checkSinkExecCommand returns an error if command isn't one of the
+// sink exec commands.
+func checkSinkExecCommand(command string) error {
+	for _, allowed := range sinkExecCommands {
+		if command == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("sink command %s is not allowed by -vreplication_sink_exec_commands", command)
+}
+
+// sinkColInfoMap returns the tables of a stream that writes to a sink.
+// There is no target schema for these tables, so the filter rules must
+// name them explicitly.
+func sinkColInfoMap(filter *binlogdatapb.Filter) (map[string][]*ColumnInfo, error) {
+	colInfoMap := make(map[string][]*ColumnInfo)
+	for _, rule := range filter.GetRules() {
+		if strings.HasPrefix(rule.Match, "/") {
+			return nil, fmt.Errorf("the filter rules of a stream with a sink must name their tables: %s", rule.Match)
+		}
+		if rule.Filter == ExcludeStr {
+			continue
+		}
+		colInfoMap[rule.Match] = nil
+	}
+	return colInfoMap, nil
+}
+
+// sinkColumn computes the value of a column written to a sink.
+type sinkColumn struct {
+	name string
+	// index is the index of the field that holds the value
+	// if there is no expression to evaluate.
+	index int
+	expr  evalengine.Expr
+}
+
+// buildSinkColumns prepares the plan for writing to a sink, once
+// the fields are known. The columns are computed from the fields
+// in the same way as the target columns of the local database.
+func (tp *TablePlan) buildSinkColumns() error {
+	if tp.onInsert != insertNormal {
+		return fmt.Errorf("group by is not supported with a sink: %s", tp.SendRule.Filter)
+	}
+	lookup := func(col *sqlparser.ColName) (int, *querypb.Field, error) {
+		for i, field := range tp.Fields {
+			if col.Name.EqualString(field.Name) {
+				return i, field, nil
+			}
+		}
+		return 0, nil, fmt.Errorf("column %s not found in the fields of table %s", sqlparser.String(col), tp.TargetName)
+	}
+	tp.sinkColumns = make([]*sinkColumn, 0, len(tp.colExprs))
+	tp.sinkColumnNames = make([]string, 0, len(tp.colExprs))
+	for _, cexpr := range tp.colExprs {
+		if cexpr.operation != opExpr {
+			return fmt.Errorf("aggregate expressions are not supported with a sink: %v", cexpr.colName)
+		}
+		scol := &sinkColumn{name: cexpr.colName.String()}
+		switch expr := cexpr.expr.(type) {
+		case *sqlparser.ColName:
+			index, _, err := lookup(expr)
+			if err != nil {
+				return err
+			}
+			scol.index = index
+		case *sqlparser.ConvertUsingExpr:
+			// The conversion is performed by the source.
+			index, _, err := lookup(&sqlparser.ColName{Name: cexpr.colName})
+			if err != nil {
+				return err
+			}
+			scol.index = index
+		default:
+			converted, err := sqlparser.ConvertWithColumns(expr, lookup)
+			if err != nil {
+				return fmt.Errorf("unsupported expression with a sink: %v: %v", sqlparser.String(expr), err)
+			}
+			scol.expr = converted
+		}
+		tp.sinkColumns = append(tp.sinkColumns, scol)
+		tp.sinkColumnNames = append(tp.sinkColumnNames, scol.name)
+	}
+	return nil
+}
+
+// sinkRow computes the values written to a sink for a row of the stream.
+func (tp *TablePlan) sinkRow(vals []sqltypes.Value) ([]sqltypes.Value, error) {
+	row := make([]sqltypes.Value, len(tp.sinkColumns))
+	for i, scol := range tp.sinkColumns {
+		if scol.expr == nil {
+			row[i] = vals[scol.index]
+			continue
+		}
+		result, err := scol.expr.Evaluate(evalengine.ExpressionEnv{Row: vals})
+		if err != nil {
+			return nil, err
+		}
+		row[i] = result.Value()
+	}
+	return row, nil
+}
+
+// isCopied returns true if the row was already copied. Changes to rows
+// that were not copied yet must not be written since the copy of these
+// rows will reflect them. Streams that write to a sink copy their tables
+// as a single range, and the comparison is performed here because there
+// is no database to perform it.
+func (tp *TablePlan) isCopied(vals []sqltypes.Value) (bool, error) {
+	if len(tp.copyRanges) == 0 {
+		return true, nil
+	}
+	if tp.Lastpk == nil || len(tp.Lastpk.Rows) != 1 {
+		return false, fmt.Errorf("unexpected: table %s is not copied as a single range", tp.TargetName)
+	}
+	for i, pkfield := range tp.Lastpk.Fields {
+		index := fieldIndex(tp.Fields, pkfield.Name)
+		if index == -1 {
+			return false, fmt.Errorf("primary key column %s not found in the fields of table %s", pkfield.Name, tp.TargetName)
+		}
+		result, err := evalengine.NullsafeCompare(vals[index], tp.Lastpk.Rows[0][i], collations.Unknown)
+		if err != nil {
+			return false, err
+		}
+		if result != 0 {
+			return result < 0, nil
+		}
+	}
+	return true, nil
+}
+
+// applySinkChange writes a row change to the sink. During the copy phase,
+// an update that moves a row in or out of the copied rows is written as an
+// insert or a delete.
+func (tp *TablePlan) applySinkChange(rowChange *binlogdatapb.RowChange, timestamp int64, sink Sink) error {
+	event := &SinkEvent{
+		Table:     tp.TargetName,
+		Columns:   tp.sinkColumnNames,
+		Timestamp: timestamp,
+	}
+	for _, image := range []struct {
+		row    *querypb.Row
+		values *[]sqltypes.Value
+	}{{rowChange.Before, &event.Before}, {rowChange.After, &event.After}} {
+		if image.row == nil {
+			continue
+		}
+		vals := sqltypes.MakeRowTrusted(tp.Fields, image.row)
+		copied, err := tp.isCopied(vals)
+		if err != nil {
+			return err
+		}
+		if !copied {
+			continue
+		}
+		if *image.values, err = tp.sinkRow(vals); err != nil {
+			return err
+		}
+	}
+	switch {
+	case event.Before == nil && event.After == nil:
+		return nil
+	case event.Before == nil:
+		event.Op = SinkInsert
+	case event.After == nil:
+		event.Op = SinkDelete
+	default:
+		event.Op = SinkUpdate
+	}
+	return sink.Write(event)
+}
+
+// applySinkRows writes the rows copied during the copy phase to the sink.
+func (tp *TablePlan) applySinkRows(rows []*querypb.Row, sink Sink) error {
+	for _, row := range rows {
+		values, err := tp.sinkRow(sqltypes.MakeRowTrusted(tp.Fields, row))
+		if err != nil {
+			return err
+		}
+		if err := sink.Write(&SinkEvent{
+			Table:   tp.TargetName,
+			Op:      SinkInsert,
+			Columns: tp.sinkColumnNames,
+			After:   values,
+			Copied:  true,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendJSONEvent appends the JSON representation of an event to buf,
+// followed by a newline. Numbers and JSON documents are written as is,
+// binary values are base64 encoded, and other values are strings.
+func appendJSONEvent(buf []byte, event *SinkEvent) []byte {
+	buf = append(buf, `{"table":`...)
+	buf = appendJSONString(buf, event.Table)
+	buf = append(buf, `,"op":`...)
+	buf = appendJSONString(buf, event.Op)
+	buf = append(buf, `,"timestamp":`...)
+	buf = strconv.AppendInt(buf, event.Timestamp, 10)
+	buf = append(buf, `,"copied":`...)
+	buf = strconv.AppendBool(buf, event.Copied)
+	if event.Before != nil {
+		buf = append(buf, `,"before":`...)
+		buf = appendJSONRow(buf, event.Columns, event.Before)
+	}
+	if event.After != nil {
+		buf = append(buf, `,"after":`...)
+		buf = appendJSONRow(buf, event.Columns, event.After)
+	}
+	return append(buf, "}\n"...)
+}
+
+func appendJSONRow(buf []byte, columns []string, row []sqltypes.Value) []byte {
+	buf = append(buf, '{')
+	for i, val := range row {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendJSONString(buf, columns[i])
+		buf = append(buf, ':')
+		buf = appendJSONValue(buf, val)
+	}
+	return append(buf, '}')
+}
+
+func appendJSONValue(buf []byte, val sqltypes.Value) []byte {
+	switch {
+	case val.IsNull():
+		return append(buf, "null"...)
+	case val.IsIntegral():
+		if _, err := strconv.ParseInt(val.ToString(), 10, 64); err == nil {
+			return append(buf, val.Raw()...)
+		}
+		if _, err := strconv.ParseUint(val.ToString(), 10, 64); err == nil {
+			return append(buf, val.Raw()...)
+		}
+	case val.IsFloat():
+		if f, err := strconv.ParseFloat(val.ToString(), 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+			return strconv.AppendFloat(buf, f, 'g', -1, 64)
+		}
+	case val.Type() == sqltypes.TypeJSON:
+		if json.Valid(val.Raw()) {
+			return append(buf, val.Raw()...)
+		}
+	case val.IsBinary() || val.Type() == sqltypes.Bit || val.Type() == sqltypes.Geometry:
+		return appendJSONString(buf, base64.StdEncoding.EncodeToString(val.Raw()))
+	}
+	return appendJSONString(buf, val.ToString())
+}
+
+func appendJSONString(buf []byte, str string) []byte {
+	// Marshaling a string can't fail.
+	quoted, _ := json.Marshal(str)
+	return append(buf, quoted...)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func init() {
+	RegisterSink("exec", newExecSink)
+}
+
+// execSink runs a command whenever events are flushed, with the events
+// as JSON lines on its standard input. The events are flushed only if the
+// command succeeds, so that a failed command is retried with the same
+// events once the stream restarts. The id of the stream is passed to the
+// command in the VREPLICATION_ID environment variable. The command is
+// killed if it runs longer than -vreplication_sink_exec_timeout, or if the
+// stream stops.
+type execSink struct {
+	ctx     context.Context
+	id      uint32
+	command []string
+	pending []byte
+}
+
+func newExecSink(ctx context.Context, id uint32, params *binlogdatapb.Sink) (Sink, error) {
+	if len(params.Command) == 0 {
+		return nil, fmt.Errorf("exec sink requires a command")
+	}
+	if err := checkSinkExecCommand(params.Command[0]); err != nil {
+		return nil, err
+	}
+	return &execSink{
+		ctx:     ctx,
+		id:      id,
+		command: params.Command,
+	}, nil
+}
+
+// Write is part of the Sink interface.
+func (es *execSink) Write(event *SinkEvent) error {
+	es.pending = appendJSONEvent(es.pending, event)
+	return nil
+}
+
+// Flush is part of the Sink interface.
+func (es *execSink) Flush() error {
+	if len(es.pending) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(es.ctx, *sinkExecTimeout)
+	defer cancel()
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, es.command[0], es.command[1:]...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("VREPLICATION_ID=%d", es.id))
+	cmd.Stdin = bytes.NewReader(es.pending)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("sink command %s failed: %v: %s", strings.Join(es.command, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	es.pending = es.pending[:0]
+	return nil
+}
+
+// Close is part of the Sink interface. Nothing is run once the stream
+// stopped: the position of the pending events wasn't saved, so they're
+// sent again when the stream restarts.
+func (es *execSink) Close() error {
+	if es.ctx.Err() != nil {
+		return nil
+	}
+	return es.Flush()
+}
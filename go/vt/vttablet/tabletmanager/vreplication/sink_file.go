@@ -0,0 +1,326 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+const (
+	defaultSinkMaxFileSize = 64 * 1024 * 1024
+	defaultSinkMaxFileAge  = time.Hour
+)
+
+func init() {
+	RegisterSink("jsonl", func(ctx context.Context, id uint32, params *binlogdatapb.Sink) (Sink, error) {
+		return newFileSink(id, params, jsonLinesFormat{})
+	})
+	RegisterSink("avro", func(ctx context.Context, id uint32, params *binlogdatapb.Sink) (Sink, error) {
+		format, err := newAvroFormat()
+		if err != nil {
+			return nil, err
+		}
+		return newFileSink(id, params, format)
+	})
+}
+
+// sinkFormat encodes the events written to the files of a fileSink.
+type sinkFormat interface {
+	// extension returns the extension of the file names.
+	extension() string
+	// appendHeader appends the bytes a file starts with.
+	appendHeader(buf []byte) []byte
+	// appendEvent appends an event to the pending events.
+	appendEvent(buf []byte, event *SinkEvent) []byte
+	// appendBlock appends the pending events when they are flushed.
+	appendBlock(buf []byte, count int, events []byte) []byte
+}
+
+// fileSink writes the events of a stream to files of the sink directory.
+// The events are buffered until they're flushed. A file is rotated once it
+// grows beyond the maximum size, or becomes older than the maximum age.
+// This happens only when events are flushed, so that a file never contains
+// a partial transaction. The files of a stream are named after the stream
+// id and the time they were created at, so that they sort in the order of
+// the events.
+type fileSink struct {
+	id      uint32
+	dir     string
+	maxSize int64
+	maxAge  time.Duration
+	format  sinkFormat
+
+	file    *os.File
+	size    int64
+	created time.Time
+
+	pending []byte
+	count   int
+	buf     []byte
+}
+
+func newFileSink(id uint32, params *binlogdatapb.Sink, format sinkFormat) (*fileSink, error) {
+	if params.Directory == "" {
+		return nil, fmt.Errorf("%s sink requires a directory", params.Type)
+	}
+	if err := checkSinkDir(params.Directory); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(params.Directory, 0755); err != nil {
+		return nil, err
+	}
+	fs := &fileSink{
+		id:      id,
+		dir:     params.Directory,
+		maxSize: params.MaxFileSize,
+		maxAge:  time.Duration(params.MaxFileAgeSeconds) * time.Second,
+		format:  format,
+	}
+	if fs.maxSize <= 0 {
+		fs.maxSize = defaultSinkMaxFileSize
+	}
+	if fs.maxAge <= 0 {
+		fs.maxAge = defaultSinkMaxFileAge
+	}
+	return fs, nil
+}
+
+// Write is part of the Sink interface.
+func (fs *fileSink) Write(event *SinkEvent) error {
+	fs.pending = fs.format.appendEvent(fs.pending, event)
+	fs.count++
+	return nil
+}
+
+// Flush is part of the Sink interface.
+func (fs *fileSink) Flush() error {
+	if fs.count > 0 {
+		if fs.file == nil {
+			if err := fs.openFile(); err != nil {
+				return err
+			}
+		}
+		fs.buf = fs.format.appendBlock(fs.buf[:0], fs.count, fs.pending)
+		if err := fs.writeFile(fs.buf); err != nil {
+			return err
+		}
+		if err := fs.file.Sync(); err != nil {
+			return err
+		}
+		fs.pending = fs.pending[:0]
+		fs.count = 0
+	}
+	if fs.file != nil && (fs.size >= fs.maxSize || time.Since(fs.created) >= fs.maxAge) {
+		return fs.closeFile()
+	}
+	return nil
+}
+
+// Close is part of the Sink interface.
+func (fs *fileSink) Close() error {
+	if err := fs.Flush(); err != nil {
+		if fs.file != nil {
+			fs.file.Close()
+		}
+		return err
+	}
+	if fs.file == nil {
+		return nil
+	}
+	return fs.closeFile()
+}
+
+func (fs *fileSink) openFile() error {
+	fs.created = time.Now()
+	name := fmt.Sprintf("vreplication-%d-%s.%s", fs.id, fs.created.UTC().Format("20060102150405.000000"), fs.format.extension())
+	file, err := os.OpenFile(filepath.Join(fs.dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	fs.file = file
+	fs.size = 0
+	return fs.writeFile(fs.format.appendHeader(nil))
+}
+
+func (fs *fileSink) writeFile(data []byte) error {
+	n, err := fs.file.Write(data)
+	fs.size += int64(n)
+	return err
+}
+
+func (fs *fileSink) closeFile() error {
+	err := fs.file.Close()
+	fs.file = nil
+	return err
+}
+
+// jsonLinesFormat writes every event as a JSON object on its own line.
+type jsonLinesFormat struct{}
+
+func (jsonLinesFormat) extension() string {
+	return "jsonl"
+}
+
+func (jsonLinesFormat) appendHeader(buf []byte) []byte {
+	return buf
+}
+
+func (jsonLinesFormat) appendEvent(buf []byte, event *SinkEvent) []byte {
+	return appendJSONEvent(buf, event)
+}
+
+func (jsonLinesFormat) appendBlock(buf []byte, count int, events []byte) []byte {
+	return append(buf, events...)
+}
+
+// avroSchema is the schema of the events of the avro sink. The rows are maps
+// from column names to values, so that the events of all the tables share
+// the same schema. The values are nulls, longs, doubles, strings or bytes.
+const avroSchema = `{"type":"record","name":"ChangeEvent","namespace":"io.vitess.vreplication","fields":[` +
+	`{"name":"table","type":"string"},` +
+	`{"name":"op","type":"string"},` +
+	`{"name":"timestamp","type":"long"},` +
+	`{"name":"copied","type":"boolean"},` +
+	`{"name":"before","type":["null",{"type":"map","values":["null","long","double","string","bytes"]}]},` +
+	`{"name":"after","type":["null",{"type":"map","values":["null","long","double","string","bytes"]}]}]}`
+
+// The following values are the branches of the union of the row values.
+const (
+	avroNull = iota
+	avroLong
+	avroDouble
+	avroString
+	avroBytes
+)
+
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+// avroFormat writes the events as an Avro object container file.
+// Every flush writes a block of the file.
+type avroFormat struct {
+	sync [16]byte
+}
+
+func newAvroFormat() (*avroFormat, error) {
+	af := &avroFormat{}
+	if _, err := rand.Read(af.sync[:]); err != nil {
+		return nil, err
+	}
+	return af, nil
+}
+
+func (af *avroFormat) extension() string {
+	return "avro"
+}
+
+func (af *avroFormat) appendHeader(buf []byte) []byte {
+	buf = append(buf, avroMagic...)
+	buf = appendAvroLong(buf, 2)
+	buf = appendAvroString(buf, "avro.codec")
+	buf = appendAvroString(buf, "null")
+	buf = appendAvroString(buf, "avro.schema")
+	buf = appendAvroString(buf, avroSchema)
+	buf = appendAvroLong(buf, 0)
+	return append(buf, af.sync[:]...)
+}
+
+func (af *avroFormat) appendEvent(buf []byte, event *SinkEvent) []byte {
+	buf = appendAvroString(buf, event.Table)
+	buf = appendAvroString(buf, event.Op)
+	buf = appendAvroLong(buf, event.Timestamp)
+	if event.Copied {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	buf = appendAvroRow(buf, event.Columns, event.Before)
+	return appendAvroRow(buf, event.Columns, event.After)
+}
+
+func (af *avroFormat) appendBlock(buf []byte, count int, events []byte) []byte {
+	buf = appendAvroLong(buf, int64(count))
+	buf = appendAvroLong(buf, int64(len(events)))
+	buf = append(buf, events...)
+	return append(buf, af.sync[:]...)
+}
+
+func appendAvroRow(buf []byte, columns []string, row []sqltypes.Value) []byte {
+	if row == nil {
+		return appendAvroLong(buf, 0)
+	}
+	buf = appendAvroLong(buf, 1)
+	if len(row) != 0 {
+		buf = appendAvroLong(buf, int64(len(row)))
+		for i, val := range row {
+			buf = appendAvroString(buf, columns[i])
+			buf = appendAvroValue(buf, val)
+		}
+	}
+	return appendAvroLong(buf, 0)
+}
+
+func appendAvroValue(buf []byte, val sqltypes.Value) []byte {
+	switch {
+	case val.IsNull():
+		return appendAvroLong(buf, avroNull)
+	case val.IsIntegral():
+		// Unsigned values that overflow a long are written as strings.
+		if n, err := strconv.ParseInt(val.ToString(), 10, 64); err == nil {
+			buf = appendAvroLong(buf, avroLong)
+			return appendAvroLong(buf, n)
+		}
+	case val.IsFloat():
+		if f, err := strconv.ParseFloat(val.ToString(), 64); err == nil {
+			var tmp [8]byte
+			binary.LittleEndian.PutUint64(tmp[:], math.Float64bits(f))
+			buf = appendAvroLong(buf, avroDouble)
+			return append(buf, tmp[:]...)
+		}
+	case val.IsBinary() || val.Type() == sqltypes.Bit || val.Type() == sqltypes.Geometry:
+		buf = appendAvroLong(buf, avroBytes)
+		return appendAvroBytes(buf, val.Raw())
+	}
+	buf = appendAvroLong(buf, avroString)
+	return appendAvroBytes(buf, val.Raw())
+}
+
+// appendAvroLong appends a zig-zag encoded variable-length long.
+func appendAvroLong(buf []byte, n int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutVarint(tmp[:], n)]...)
+}
+
+func appendAvroString(buf []byte, str string) []byte {
+	buf = appendAvroLong(buf, int64(len(str)))
+	return append(buf, str...)
+}
+
+func appendAvroBytes(buf []byte, data []byte) []byte {
+	buf = appendAvroLong(buf, int64(len(data)))
+	return append(buf, data...)
+}
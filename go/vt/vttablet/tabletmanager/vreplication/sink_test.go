@@ -0,0 +1,513 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type testSink struct {
+	events []*SinkEvent
+}
+
+func (ts *testSink) Write(event *SinkEvent) error {
+	ts.events = append(ts.events, event)
+	return nil
+}
+
+func (ts *testSink) Flush() error {
+	return nil
+}
+
+func (ts *testSink) Close() error {
+	return nil
+}
+
+// buildSinkTablePlan builds the plan of table t1 for a stream that writes to a sink.
+func buildSinkTablePlan(t *testing.T, filter string, ranges []*copyRange, fields []*querypb.Field) *TablePlan {
+	t.Helper()
+	colInfoMap, err := sinkColInfoMap(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: filter}},
+	})
+	require.NoError(t, err)
+	var copyState map[string][]*copyRange
+	if ranges != nil {
+		copyState = map[string][]*copyRange{"t1": ranges}
+	}
+	plan, err := buildReplicatorPlan(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: filter}},
+	}, colInfoMap, copyState, binlogplayer.NewStats())
+	require.NoError(t, err)
+	require.Contains(t, plan.TargetTables, "t1")
+	fieldEvent := &binlogdatapb.FieldEvent{TableName: plan.TargetTables["t1"].SendRule.Match, Fields: fields}
+	tablePlan, err := plan.buildExecutionPlan(fieldEvent)
+	require.NoError(t, err)
+	require.NoError(t, tablePlan.buildSinkColumns())
+	return tablePlan
+}
+
+func sinkRowChange(fields []*querypb.Field, before, after string) *binlogdatapb.RowChange {
+	rowChange := &binlogdatapb.RowChange{}
+	if before != "" {
+		rowChange.Before = sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, before).Rows[0])
+	}
+	if after != "" {
+		rowChange.After = sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, after).Rows[0])
+	}
+	return rowChange
+}
+
+func TestSinkColInfoMap(t *testing.T) {
+	colInfoMap, err := sinkColInfoMap(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1"}, {Match: "t2", Filter: ExcludeStr}, {Match: "t3", Filter: "select * from src"}},
+	})
+	require.NoError(t, err)
+	var tables []string
+	for table := range colInfoMap {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	assert.Equal(t, []string{"t1", "t3"}, tables)
+
+	_, err = sinkColInfoMap(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "/.*"}},
+	})
+	assert.EqualError(t, err, "the filter rules of a stream with a sink must name their tables: /.*")
+}
+
+func TestSinkTablePlan(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	lastpk := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "2")
+	tablePlan := buildSinkTablePlan(t, "select id, val, id * 10 as ten from src", []*copyRange{{table: "t1", lastpk: lastpk}}, fields)
+
+	testcases := []struct {
+		before, after string
+		op            string
+		want          []string
+	}{{
+		after: "1|a",
+		op:    SinkInsert,
+		want:  []string{"", "1|a|10"},
+	}, {
+		// Not copied yet.
+		after: "3|a",
+	}, {
+		before: "1|a",
+		after:  "2|b",
+		op:     SinkUpdate,
+		want:   []string{"1|a|10", "2|b|20"},
+	}, {
+		// Moved out of the copied rows.
+		before: "1|a",
+		after:  "3|a",
+		op:     SinkDelete,
+		want:   []string{"1|a|10", ""},
+	}, {
+		// Moved into the copied rows.
+		before: "3|a",
+		after:  "1|a",
+		op:     SinkInsert,
+		want:   []string{"", "1|a|10"},
+	}, {
+		before: "2|b",
+		op:     SinkDelete,
+		want:   []string{"2|b|20", ""},
+	}, {
+		before: "3|a",
+	}}
+	format := func(row []sqltypes.Value) string {
+		if row == nil {
+			return ""
+		}
+		vals := make([]string, len(row))
+		for i, val := range row {
+			vals[i] = val.ToString()
+		}
+		return strings.Join(vals, "|")
+	}
+	for _, tcase := range testcases {
+		sink := &testSink{}
+		err := tablePlan.applySinkChange(sinkRowChange(fields, tcase.before, tcase.after), 1000, sink)
+		require.NoError(t, err)
+		if tcase.op == "" {
+			assert.Empty(t, sink.events, "%s -> %s", tcase.before, tcase.after)
+			continue
+		}
+		require.Len(t, sink.events, 1)
+		event := sink.events[0]
+		assert.Equal(t, "t1", event.Table)
+		assert.Equal(t, tcase.op, event.Op)
+		assert.Equal(t, []string{"id", "val", "ten"}, event.Columns)
+		assert.Equal(t, tcase.want, []string{format(event.Before), format(event.After)})
+		assert.Equal(t, int64(1000), event.Timestamp)
+		assert.False(t, event.Copied)
+	}
+
+	// Rows are copied as inserts.
+	sink := &testSink{}
+	rows := sqltypes.MakeTestResult(fields, "4|d", "5|e")
+	err := tablePlan.applySinkRows(sqltypes.RowsToProto3(rows.Rows), sink)
+	require.NoError(t, err)
+	require.Len(t, sink.events, 2)
+	assert.Equal(t, SinkInsert, sink.events[1].Op)
+	assert.Equal(t, "5|e|50", format(sink.events[1].After))
+	assert.True(t, sink.events[1].Copied)
+
+	// A fully copied table with select * writes all changes.
+	tablePlan = buildSinkTablePlan(t, "", nil, fields)
+	sink = &testSink{}
+	err = tablePlan.applySinkChange(sinkRowChange(fields, "", "3|c"), 0, sink)
+	require.NoError(t, err)
+	require.Len(t, sink.events, 1)
+	assert.Equal(t, []string{"id", "val"}, sink.events[0].Columns)
+	assert.Equal(t, "3|c", format(sink.events[0].After))
+}
+
+func TestSinkTablePlanErrors(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id, count(*) as cnt from src group by id",
+		err:    "group by is not supported with a sink: select id from src",
+	}, {
+		filter: "select id, sum(id) as s from src",
+		err:    "aggregate expressions are not supported with a sink: s",
+	}}
+	for _, tcase := range testcases {
+		colInfoMap := map[string][]*ColumnInfo{"t1": nil}
+		plan, err := buildReplicatorPlan(&binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+		}, colInfoMap, nil, binlogplayer.NewStats())
+		require.NoError(t, err)
+		tablePlan, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "src", Fields: fields})
+		require.NoError(t, err)
+		assert.EqualError(t, tablePlan.buildSinkColumns(), tcase.err, tcase.filter)
+	}
+}
+
+// allowSinks sets the sink directories and exec commands for the duration of a test.
+func allowSinks(t *testing.T, dirs, commands []string) {
+	t.Helper()
+	savedDirs, savedCommands := sinkDirs, sinkExecCommands
+	t.Cleanup(func() {
+		sinkDirs, sinkExecCommands = savedDirs, savedCommands
+	})
+	sinkDirs, sinkExecCommands = dirs, commands
+}
+
+func TestNewSink(t *testing.T) {
+	dir := t.TempDir()
+	allowSinks(t, []string{dir}, []string{"sh"})
+	_, err := newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "kafka"}})
+	assert.EqualError(t, err, `unknown sink type: "kafka"`)
+
+	_, err = newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "jsonl", Directory: dir}, OnDdl: binlogdatapb.OnDDLAction_EXEC})
+	assert.EqualError(t, err, "on_ddl EXEC is not supported with a sink")
+
+	_, err = newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "avro"}})
+	assert.EqualError(t, err, "avro sink requires a directory")
+
+	_, err = newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "exec"}})
+	assert.EqualError(t, err, "exec sink requires a command")
+}
+
+func TestNewSinkNotAllowed(t *testing.T) {
+	dir := t.TempDir()
+	allowSinks(t, nil, nil)
+	_, err := newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "jsonl", Directory: dir}})
+	assert.EqualError(t, err, "sink directory "+dir+" is not allowed by -vreplication_sink_dirs")
+	_, err = newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "exec", Command: []string{"sh"}}})
+	assert.EqualError(t, err, "sink command sh is not allowed by -vreplication_sink_exec_commands")
+
+	allowSinks(t, []string{filepath.Join(dir, "a")}, []string{"/bin/sh"})
+	for _, sub := range []string{"a", "a/b", "a/../a/b"} {
+		_, err = newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "jsonl", Directory: filepath.Join(dir, sub)}})
+		assert.NoError(t, err, sub)
+	}
+	for _, sub := range []string{"ab", "a/..", "a/../b"} {
+		_, err = newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "jsonl", Directory: filepath.Join(dir, sub)}})
+		assert.EqualError(t, err, "sink directory "+filepath.Join(dir, sub)+" is not allowed by -vreplication_sink_dirs", sub)
+	}
+	_, err = newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "exec", Command: []string{"sh"}}})
+	assert.EqualError(t, err, "sink command sh is not allowed by -vreplication_sink_exec_commands")
+}
+
+func testSinkEvents() []*SinkEvent {
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarChar(`"a"`),
+		sqltypes.NewFloat64(1.5),
+		sqltypes.MakeTrusted(sqltypes.VarBinary, []byte{0}),
+		sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"k": 1}`)),
+	}
+	return []*SinkEvent{{
+		Table:   "t1",
+		Op:      SinkInsert,
+		Columns: []string{"id", "name", "price", "data", "doc"},
+		After:   row,
+		Copied:  true,
+	}, {
+		Table:     "t1",
+		Op:        SinkDelete,
+		Columns:   []string{"id", "name", "price", "data", "doc"},
+		Before:    []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL},
+		Timestamp: 1600000000,
+	}}
+}
+
+func readSinkFiles(t *testing.T, dir string) [][]byte {
+	t.Helper()
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	var contents [][]byte
+	for _, file := range files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		require.NoError(t, err)
+		contents = append(contents, content)
+	}
+	return contents
+}
+
+func TestJSONLinesSink(t *testing.T) {
+	dir := t.TempDir()
+	allowSinks(t, []string{dir}, nil)
+	sink, err := newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "jsonl", Directory: dir}})
+	require.NoError(t, err)
+	events := testSinkEvents()
+	for _, event := range events {
+		require.NoError(t, sink.Write(event))
+	}
+	// Nothing is written before a flush.
+	assert.Empty(t, readSinkFiles(t, dir))
+	require.NoError(t, sink.Flush())
+	require.NoError(t, sink.Close())
+
+	want := `{"table":"t1","op":"insert","timestamp":0,"copied":true,"after":{"id":1,"name":"\"a\"","price":1.5,"data":"AA==","doc":{"k": 1}}}` + "\n" +
+		`{"table":"t1","op":"delete","timestamp":1600000000,"copied":false,"before":{"id":2,"name":null,"price":null,"data":null,"doc":null}}` + "\n"
+	contents := readSinkFiles(t, dir)
+	require.Len(t, contents, 1)
+	assert.Equal(t, want, string(contents[0]))
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	dir := t.TempDir()
+	allowSinks(t, []string{dir}, nil)
+	sink, err := newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "jsonl", Directory: dir, MaxFileSize: 10}})
+	require.NoError(t, err)
+	events := testSinkEvents()
+	// A file is rotated only on flushes.
+	require.NoError(t, sink.Write(events[0]))
+	require.NoError(t, sink.Write(events[1]))
+	require.NoError(t, sink.Flush())
+	require.NoError(t, sink.Write(events[1]))
+	require.NoError(t, sink.Flush())
+	require.NoError(t, sink.Flush())
+	require.NoError(t, sink.Close())
+
+	contents := readSinkFiles(t, dir)
+	require.Len(t, contents, 2)
+	assert.Equal(t, 2, bytes.Count(contents[0], []byte("\n")))
+	assert.Equal(t, 1, bytes.Count(contents[1], []byte("\n")))
+}
+
+// avroDecoder decodes the values of the avro sink.
+type avroDecoder struct {
+	data []byte
+}
+
+func (ad *avroDecoder) long() int64 {
+	n, size := binary.Varint(ad.data)
+	ad.data = ad.data[size:]
+	return n
+}
+
+func (ad *avroDecoder) bytes(n int) []byte {
+	b := ad.data[:n]
+	ad.data = ad.data[n:]
+	return b
+}
+
+func (ad *avroDecoder) string() string {
+	return string(ad.bytes(int(ad.long())))
+}
+
+func (ad *avroDecoder) row() map[string]interface{} {
+	if ad.long() == avroNull {
+		return nil
+	}
+	row := make(map[string]interface{})
+	for count := ad.long(); count != 0; count = ad.long() {
+		for i := int64(0); i < count; i++ {
+			name := ad.string()
+			switch ad.long() {
+			case avroNull:
+				row[name] = nil
+			case avroLong:
+				row[name] = ad.long()
+			case avroDouble:
+				row[name] = math.Float64frombits(binary.LittleEndian.Uint64(ad.bytes(8)))
+			case avroString:
+				row[name] = ad.string()
+			case avroBytes:
+				row[name] = ad.bytes(int(ad.long()))
+			}
+		}
+	}
+	return row
+}
+
+func TestAvroSink(t *testing.T) {
+	dir := t.TempDir()
+	allowSinks(t, []string{dir}, nil)
+	sink, err := newSink(context.Background(), 1, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{Type: "avro", Directory: dir}})
+	require.NoError(t, err)
+	events := testSinkEvents()
+	require.NoError(t, sink.Write(events[0]))
+	require.NoError(t, sink.Flush())
+	require.NoError(t, sink.Write(events[1]))
+	require.NoError(t, sink.Close())
+
+	contents := readSinkFiles(t, dir)
+	require.Len(t, contents, 1)
+	ad := &avroDecoder{data: contents[0]}
+	assert.Equal(t, avroMagic, ad.bytes(4))
+	require.Equal(t, int64(2), ad.long())
+	meta := map[string]string{}
+	for i := 0; i < 2; i++ {
+		key := ad.string()
+		meta[key] = ad.string()
+	}
+	assert.Equal(t, map[string]string{"avro.codec": "null", "avro.schema": avroSchema}, meta)
+	require.Equal(t, int64(0), ad.long())
+	sync := ad.bytes(16)
+
+	type decodedEvent struct {
+		table, op     string
+		timestamp     int64
+		copied        bool
+		before, after map[string]interface{}
+	}
+	var got []decodedEvent
+	for len(ad.data) > 0 {
+		count := ad.long()
+		size := ad.long()
+		block := &avroDecoder{data: ad.bytes(int(size))}
+		for i := int64(0); i < count; i++ {
+			event := decodedEvent{table: block.string(), op: block.string(), timestamp: block.long()}
+			event.copied = block.bytes(1)[0] == 1
+			event.before = block.row()
+			event.after = block.row()
+			got = append(got, event)
+		}
+		assert.Empty(t, block.data)
+		assert.Equal(t, sync, ad.bytes(16))
+	}
+	want := []decodedEvent{{
+		table:  "t1",
+		op:     "insert",
+		copied: true,
+		after:  map[string]interface{}{"id": int64(1), "name": `"a"`, "price": 1.5, "data": []byte{0}, "doc": `{"k": 1}`},
+	}, {
+		table:     "t1",
+		op:        "delete",
+		timestamp: 1600000000,
+		before:    map[string]interface{}{"id": int64(2), "name": nil, "price": nil, "data": nil, "doc": nil},
+	}}
+	assert.Equal(t, want, got)
+}
+
+func TestExecSink(t *testing.T) {
+	allowSinks(t, nil, []string{"sh"})
+	out := filepath.Join(t.TempDir(), "out")
+	sink, err := newSink(context.Background(), 7, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{
+		Type:    "exec",
+		Command: []string{"sh", "-c", `echo "$VREPLICATION_ID" >> "$0"; cat >> "$0"`, out},
+	}})
+	require.NoError(t, err)
+	events := testSinkEvents()
+	// Nothing is run if there are no events.
+	require.NoError(t, sink.Flush())
+	_, err = os.Stat(out)
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, sink.Write(events[1]))
+	require.NoError(t, sink.Close())
+	content, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "7\n"+string(appendJSONEvent(nil, events[1])), string(content))
+
+	sink, err = newSink(context.Background(), 7, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{
+		Type:    "exec",
+		Command: []string{"sh", "-c", "echo failed >&2; exit 1"},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(events[1]))
+	assert.EqualError(t, sink.Flush(), "sink command sh -c echo failed >&2; exit 1 failed: exit status 1: failed")
+	// The events are kept for the next attempt.
+	assert.NotEmpty(t, sink.(*execSink).pending)
+}
+
+func TestExecSinkTimeout(t *testing.T) {
+	allowSinks(t, nil, []string{"sleep"})
+	savedTimeout := *sinkExecTimeout
+	defer func() { *sinkExecTimeout = savedTimeout }()
+	*sinkExecTimeout = 10 * time.Millisecond
+
+	sink, err := newSink(context.Background(), 7, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{
+		Type:    "exec",
+		Command: []string{"sleep", "10"},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(testSinkEvents()[1]))
+	start := time.Now()
+	assert.EqualError(t, sink.Flush(), "sink command sleep 10 failed: signal: killed: ")
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+
+	// Nothing is run once the stream stopped.
+	*sinkExecTimeout = savedTimeout
+	ctx, cancel := context.WithCancel(context.Background())
+	sink, err = newSink(ctx, 7, &binlogdatapb.BinlogSource{Sink: &binlogdatapb.Sink{
+		Type:    "exec",
+		Command: []string{"sleep", "10"},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(testSinkEvents()[1]))
+	cancel()
+	start = time.Now()
+	assert.NoError(t, sink.Close())
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
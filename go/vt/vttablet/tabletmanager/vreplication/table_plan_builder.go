@@ -45,16 +45,23 @@ type tablePlanBuilder struct {
 	name       sqlparser.TableIdent
 	sendSelect *sqlparser.Select
 	// selColumns keeps track of the columns we want to pull from source.
-	// If Lastpk is set, we compare this list against the table's pk and
+	// If copyRanges is set, we compare this list against the table's pk and
 	// add missing references.
 	selColumns        map[string]bool
 	colExprs          []*colExpr
 	onInsert          insertType
 	pkCols            []*colExpr
 	extraSourcePkCols []*colExpr
-	lastpk            *sqltypes.Result
-	colInfos          []*ColumnInfo
-	stats             *binlogplayer.Stats
+	// copyRanges contains the ranges of the table that are still being copied.
+	// Events are applied only to rows that fall outside these ranges, or
+	// within the part of a range that was already copied.
+	copyRanges []*copyRange
+	// idempotent is set if some of the rows of the table may have been copied
+	// as of a position ahead of the current one. Inserts then overwrite the
+	// existing rows so that replaying events over these rows succeeds.
+	idempotent bool
+	colInfos   []*ColumnInfo
+	stats      *binlogplayer.Stats
 }
 
 // colExpr describes the processing to be performed to
@@ -117,14 +124,15 @@ const (
 // copyState is a map of tables that have not been fully copied yet.
 // If a table is not present in copyState, then it has been fully copied. If so,
 // all replication events are applied. The table still has to match a Filter.Rule.
-// If it has a non-nil entry, then the value is the last primary key (lastpk)
-// that was copied.  If so, only replication events < lastpk are applied.
-// If the entry is nil, then copying of the table has not started yet. If so,
-// no events are applied.
+// Otherwise, the entry contains the primary key ranges of the table that are
+// being copied, along with the last primary key (lastpk) that was copied for
+// each of them. Only replication events for rows that were already copied are
+// applied. If the table has a single unbounded range without a lastpk, then
+// copying of the table has not started yet. If so, no events are applied.
 // The TablePlan built is a partial plan. The full plan for a table is built
 // when we receive field information from events or rows sent by the source.
 // buildExecutionPlan is the function that builds the full plan.
-func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo, copyState map[string]*sqltypes.Result, stats *binlogplayer.Stats) (*ReplicatorPlan, error) {
+func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*ColumnInfo, copyState map[string][]*copyRange, stats *binlogplayer.Stats) (*ReplicatorPlan, error) {
 	plan := &ReplicatorPlan{
 		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: filter.FieldEventMode},
 		TargetTables:  make(map[string]*TablePlan),
@@ -133,8 +141,8 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*C
 		stats:         stats,
 	}
 	for tableName := range colInfoMap {
-		lastpk, ok := copyState[tableName]
-		if ok && lastpk == nil {
+		ranges := copyState[tableName]
+		if len(ranges) == 1 && ranges[0].lastpk == nil && ranges[0].end == nil {
 			// Don't replicate uncopied tables.
 			continue
 		}
@@ -149,7 +157,7 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, colInfoMap map[string][]*C
 		if !ok {
 			return nil, fmt.Errorf("table %s not found in schema", tableName)
 		}
-		tablePlan, err := buildTablePlan(tableName, rule, colInfos, lastpk, stats)
+		tablePlan, err := buildTablePlan(tableName, rule, colInfos, ranges, stats)
 		if err != nil {
 			return nil, err
 		}
@@ -188,7 +196,7 @@ func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Ru
 	return nil, nil
 }
 
-func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*ColumnInfo, lastpk *sqltypes.Result, stats *binlogplayer.Stats) (*TablePlan, error) {
+func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*ColumnInfo, ranges []*copyRange, stats *binlogplayer.Stats) (*TablePlan, error) {
 	filter := rule.Filter
 	query := filter
 	// generate equivalent select statement if filter is empty or a keyrange.
@@ -208,6 +216,12 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	if err != nil {
 		return nil, err
 	}
+	// The where clause is evaluated by the source stream.
+	if sel.Where != nil {
+		if nonDeterministic := sqlparser.FindNonDeterministicExpr(sel.Where); nonDeterministic != nil {
+			return nil, fmt.Errorf("unsupported non-deterministic expression: %v", sqlparser.String(nonDeterministic))
+		}
+	}
 	sendRule := &binlogdatapb.Rule{
 		Match: fromTable,
 	}
@@ -231,10 +245,12 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 		tablePlan := &TablePlan{
 			TargetName:     tableName,
 			SendRule:       sendRule,
-			Lastpk:         lastpk,
+			Lastpk:         singleRangeLastpk(ranges),
 			Stats:          stats,
 			EnumValuesMap:  enumValuesMap,
 			ConvertCharset: rule.ConvertCharset,
+			copyRanges:     pendingCopyRanges(ranges),
+			idempotent:     copyRangesAhead(ranges),
 		}
 
 		return tablePlan, nil
@@ -247,7 +263,8 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 			Where: sel.Where,
 		},
 		selColumns: make(map[string]bool),
-		lastpk:     lastpk,
+		copyRanges: pendingCopyRanges(ranges),
+		idempotent: copyRangesAhead(ranges),
 		colInfos:   colInfos,
 		stats:      stats,
 	}
@@ -262,10 +279,8 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	// the missing columns so we can compare against those values.
 	// If there is no lastpk to validate against, then we don't
 	// care.
-	if tpb.lastpk != nil {
-		for _, f := range tpb.lastpk.Fields {
-			tpb.addCol(sqlparser.NewColIdent(f.Name))
-		}
+	for _, f := range tpb.copyPKFields() {
+		tpb.addCol(sqlparser.NewColIdent(f.Name))
 	}
 	if err := tpb.analyzeGroupBy(sel.GroupBy); err != nil {
 		return nil, err
@@ -322,10 +337,8 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 			refmap[k] = true
 		}
 	}
-	if tpb.lastpk != nil {
-		for _, f := range tpb.lastpk.Fields {
-			refmap[f.Name] = true
-		}
+	for _, f := range tpb.copyPKFields() {
+		refmap[f.Name] = true
 	}
 	pkrefs := make([]string, 0, len(refmap))
 	for k := range refmap {
@@ -344,7 +357,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 
 	return &TablePlan{
 		TargetName:              tpb.name.String(),
-		Lastpk:                  tpb.lastpk,
+		Lastpk:                  singleRangeLastpk(tpb.copyRanges),
 		BulkInsertFront:         tpb.generateInsertPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
 		BulkInsertValues:        tpb.generateValuesPart(sqlparser.NewTrackedBuffer(bvf.formatter), bvf),
 		BulkInsertOnDup:         tpb.generateOnDupPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
@@ -355,7 +368,24 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		Stats:                   tpb.stats,
 		FieldsToSkip:            fieldsToSkip,
 		HasExtraSourcePkColumns: (len(tpb.extraSourcePkCols) > 0),
+		onInsert:                tpb.onInsert,
+		copyRanges:              tpb.copyRanges,
+		idempotent:              tpb.idempotent,
+		colExprs:                tpb.colExprs,
+	}
+}
+
+// copyPKFields returns the primary key fields of the ranges being copied.
+func (tpb *tablePlanBuilder) copyPKFields() []*querypb.Field {
+	for _, cr := range tpb.copyRanges {
+		if cr.lastpk != nil {
+			return cr.lastpk.Fields
+		}
+		if cr.end != nil {
+			return cr.end.Fields
+		}
 	}
+	return nil
 }
 
 func analyzeSelectFrom(query string) (sel *sqlparser.Select, from string, err error) {
@@ -465,6 +495,11 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			return cexpr, nil
 		}
 	}
+	// The expression is evaluated when a row is copied and again whenever
+	// it changes, so it must always produce the same value for the same row.
+	if nonDeterministic := sqlparser.FindNonDeterministicExpr(aliased.Expr); nonDeterministic != nil {
+		return nil, fmt.Errorf("unsupported non-deterministic expression: %v", sqlparser.String(nonDeterministic))
+	}
 	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
 		switch node := node.(type) {
 		case *sqlparser.ColName:
@@ -632,7 +667,7 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 
 	tpb.generateInsertPart(buf)
-	if tpb.lastpk == nil {
+	if len(tpb.copyRanges) == 0 {
 		// If there's no lastpk, generate straight values.
 		buf.Myprintf(" values ", tpb.name)
 		tpb.generateValuesPart(buf, bvf)
@@ -641,7 +676,11 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 		// where the pks < lastpk
 		tpb.generateSelectPart(buf, bvf)
 	}
-	tpb.generateOnDupPart(buf)
+	if tpb.idempotent && tpb.onInsert == insertNormal {
+		tpb.generateOverwritePart(buf)
+	} else {
+		tpb.generateOnDupPart(buf)
+	}
 
 	return buf.ParsedQuery()
 }
@@ -750,6 +789,21 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 	return buf.ParsedQuery()
 }
 
+// generateOverwritePart generates an on duplicate key clause that overwrites
+// all the columns of an existing row. This is used for replaying inserts
+// over rows that were copied as of a later position.
+func (tpb *tablePlanBuilder) generateOverwritePart(buf *sqlparser.TrackedBuffer) {
+	buf.Myprintf(" on duplicate key update ")
+	separator := ""
+	for _, cexpr := range tpb.colExprs {
+		if tpb.isColumnGenerated(cexpr.colName) {
+			continue
+		}
+		buf.Myprintf("%s%v=values(%v)", separator, cexpr.colName, cexpr.colName)
+		separator = ", "
+	}
+}
+
 func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 	if tpb.onInsert == insertIgnore {
 		return tpb.generateInsertStatement()
@@ -843,7 +897,7 @@ func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bi
 	}
 	addWhereColumns(tpb.pkCols)
 	addWhereColumns(tpb.extraSourcePkCols)
-	if tpb.lastpk != nil {
+	if len(tpb.copyRanges) != 0 {
 		buf.WriteString(" and ")
 		tpb.generatePKConstraint(buf, bvf)
 	}
@@ -863,21 +917,50 @@ func (tpb *tablePlanBuilder) getCharsetAndCollation(pkname string) (charSet stri
 	return charSet, collation
 }
 
+// generatePKConstraint generates the condition that is satisfied only by
+// the rows that were already copied. A range that has not been fully copied
+// excludes the rows between its lastpk and its end.
 func (tpb *tablePlanBuilder) generatePKConstraint(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
+	if len(tpb.copyRanges) == 1 && tpb.copyRanges[0].end == nil {
+		tpb.generatePKComparison(buf, tpb.copyRanges[0].lastpk, "<=")
+		return
+	}
+	separator := ""
+	for _, cr := range tpb.copyRanges {
+		buf.WriteString(separator)
+		separator = " and "
+		switch {
+		case cr.lastpk == nil:
+			tpb.generatePKComparison(buf, cr.end, ">")
+		case cr.end == nil:
+			tpb.generatePKComparison(buf, cr.lastpk, "<=")
+		default:
+			buf.WriteString("(")
+			tpb.generatePKComparison(buf, cr.lastpk, "<=")
+			buf.WriteString(" or ")
+			tpb.generatePKComparison(buf, cr.end, ">")
+			buf.WriteString(")")
+		}
+	}
+}
+
+// generatePKComparison compares the primary key columns against the
+// primary key values of pk using the specified operator.
+func (tpb *tablePlanBuilder) generatePKComparison(buf *sqlparser.TrackedBuffer, pk *sqltypes.Result, op string) {
 	type charSetCollation struct {
 		charSet   string
 		collation string
 	}
 	var charSetCollations []*charSetCollation
 	separator := "("
-	for _, pkname := range tpb.lastpk.Fields {
+	for _, pkname := range pk.Fields {
 		charSet, collation := tpb.getCharsetAndCollation(pkname.Name)
 		charSetCollations = append(charSetCollations, &charSetCollation{charSet: charSet, collation: collation})
 		buf.Myprintf("%s%s%v%s", separator, charSet, &sqlparser.ColName{Name: sqlparser.NewColIdent(pkname.Name)}, collation)
 		separator = ","
 	}
-	separator = ") <= ("
-	for i, val := range tpb.lastpk.Rows[0] {
+	separator = ") " + op + " ("
+	for i, val := range pk.Rows[0] {
 		buf.WriteString(separator)
 		buf.WriteString(charSetCollations[i].charSet)
 		separator = ","
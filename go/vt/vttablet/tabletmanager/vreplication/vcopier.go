@@ -19,8 +19,10 @@ package vreplication
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/encoding/prototext"
@@ -30,18 +32,102 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
+// minCopyRangeSize is the minimum number of primary key values
+// of the ranges a table is split into.
+const minCopyRangeSize = 10000
+
 type vcopier struct {
-	vr        *vreplicator
-	tablePlan *TablePlan
+	vr *vreplicator
+	// strictTables contains the tables whose rows can only be copied
+	// as of the current position of the stream. These are the tables
+	// that have unique keys other than their primary key, or whose
+	// rows are aggregated.
+	strictTables map[string]bool
+}
+
+// copyRange is a range of primary keys of a table that is being copied.
+// A table that is not split is copied as the single unbounded range 0.
+// Otherwise, range 0 contains the primary keys up to its end, every other
+// range contains the primary keys between its starting lastpk and its end,
+// and the last range has no end.
+type copyRange struct {
+	table string
+	id    int64
+	// lastpk is the last primary key that was copied. It's nil
+	// if copying of the range has not started yet.
+	lastpk *sqltypes.Result
+	// end is the last primary key of the range. It's nil if the
+	// range is unbounded.
+	end *sqltypes.Result
+	// pos is set if the rows of the range were copied as of a position
+	// ahead of the current position of the stream. These rows must be
+	// fast-forwarded to that position before the table is fully copied.
+	pos mysql.Position
+	// done is set if the range was fully copied, but its rows have not
+	// been fast-forwarded yet.
+	done bool
+}
+
+// pendingCopyRanges returns the ranges that have not been fully copied.
+func pendingCopyRanges(ranges []*copyRange) []*copyRange {
+	var pending []*copyRange
+	for _, cr := range ranges {
+		if !cr.done {
+			pending = append(pending, cr)
+		}
+	}
+	return pending
+}
+
+// copyRangesAhead returns true if the rows of some of the ranges were
+// copied as of a position ahead of the current position of the stream.
+func copyRangesAhead(ranges []*copyRange) bool {
+	for _, cr := range ranges {
+		if !cr.pos.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// singleRangeLastpk returns the lastpk of a table that is copied
+// as a single unbounded range.
+func singleRangeLastpk(ranges []*copyRange) *sqltypes.Result {
+	pending := pendingCopyRanges(ranges)
+	if len(pending) != 1 || pending[0].end != nil {
+		return nil
+	}
+	return pending[0].lastpk
+}
+
+func decodeCopyStatePK(text string) (*sqltypes.Result, error) {
+	if text == "" {
+		return nil, nil
+	}
+	var r querypb.QueryResult
+	if err := prototext.Unmarshal([]byte(text), &r); err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(&r), nil
+}
+
+func encodeCopyStatePK(fields []*querypb.Field, row *querypb.Row) ([]byte, error) {
+	return prototext.Marshal(&querypb.QueryResult{
+		Fields: fields,
+		Rows:   []*querypb.Row{row},
+	})
 }
 
 func newVCopier(vr *vreplicator) *vcopier {
@@ -104,45 +190,69 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 // This goes on until all rows are copied, or a timeout. In both cases, copyNext
 // returns, and the replicator decides whether to invoke copyNext again, or to
 // go to the next phase if all the copying is done.
-// Steps 2, 3 and 4 are performed by copyTable.
-// copyNext also builds the copyState metadata that contains the tables and their last
-// primary key that was copied. A nil Result means that nothing has been copied.
+// Steps 2, 3 and 4 are performed by copyRange.
+// If the copy phase is parallel, other ranges are copied concurrently once step 3
+// is done for the first one. Their rows are copied as of positions ahead of the
+// target, so they are fast-forwarded to these positions once copying stops.
+// copyNext also builds the copyState metadata that contains the ranges of tables and
+// their last primary key that was copied. A nil lastpk means that nothing has been copied.
 // A table that was fully copied is removed from copyState.
 func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSettings) error {
-	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	copyState, err := vc.readCopyState(ctx)
 	if err != nil {
 		return err
 	}
-	var tableToCopy string
-	copyState := make(map[string]*sqltypes.Result)
-	for _, row := range qr.Rows {
-		tableName := row[0].ToString()
-		lastpk := row[1].ToString()
-		if tableToCopy == "" {
-			tableToCopy = tableName
-		}
-		copyState[tableName] = nil
-		if lastpk != "" {
-			var r querypb.QueryResult
-			if err := prototext.Unmarshal([]byte(lastpk), &r); err != nil {
-				return err
-			}
-			copyState[tableName] = sqltypes.Proto3ToResult(&r)
-		}
-	}
 	if len(copyState) == 0 {
 		return fmt.Errorf("unexpected: there are no tables to copy")
 	}
 	if err := vc.catchup(ctx, copyState); err != nil {
 		return err
 	}
-	return vc.copyTable(ctx, tableToCopy, copyState)
+	if err := vc.copyRanges(ctx, copyState); err != nil {
+		return err
+	}
+	return vc.fastForwardCopiedRanges(ctx)
+}
+
+// readCopyState reads the ranges of the tables being copied from _vt.copy_state.
+func (vc *vcopier) readCopyState(ctx context.Context) (map[string][]*copyRange, error) {
+	query := fmt.Sprintf("select table_name, range_id, lastpk, range_end, pos from _vt.copy_state where vrepl_id=%d order by table_name, range_id", vc.vr.id)
+	qr, err := withDDL.Exec(ctx, query, vc.vr.dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	copyState := make(map[string][]*copyRange)
+	for _, row := range qr.Rows {
+		id, err := evalengine.ToInt64(row[1])
+		if err != nil {
+			return nil, err
+		}
+		lastpk, end := row[2].ToString(), row[3].ToString()
+		cr := &copyRange{
+			table: row[0].ToString(),
+			id:    id,
+			done:  lastpk != "" && lastpk == end,
+		}
+		if cr.lastpk, err = decodeCopyStatePK(lastpk); err != nil {
+			return nil, err
+		}
+		if cr.end, err = decodeCopyStatePK(end); err != nil {
+			return nil, err
+		}
+		if pos := row[4].ToString(); pos != "" {
+			if cr.pos, err = binlogplayer.DecodePosition(pos); err != nil {
+				return nil, err
+			}
+		}
+		copyState[cr.table] = append(copyState[cr.table], cr)
+	}
+	return copyState, nil
 }
 
 // catchup replays events to the subset of the tables that have been copied
 // until replication is caught up. In order to stop, the seconds behind master has
 // to fall below replicationLagTolerance.
-func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.Result) error {
+func (vc *vcopier) catchup(ctx context.Context, copyState map[string][]*copyRange) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	defer vc.vr.stats.PhaseTimings.Record("catchup", time.Now())
@@ -190,47 +300,224 @@ func (vc *vcopier) catchup(ctx context.Context, copyState map[string]*sqltypes.R
 	}
 }
 
-// copyTable performs the synchronized copy of the next set of rows from
-// the current table being copied. Each packet received is transactionally
-// committed with the lastpk. This allows for consistent resumability.
-func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState map[string]*sqltypes.Result) error {
-	defer vc.vr.dbClient.Rollback()
+// copyRanges copies the ranges that have not been fully copied yet.
+// Without parallelism, only the first pending range is copied. Otherwise,
+// the first range is copied after fast-forwarding the target to its snapshot,
+// and the other ranges are then copied concurrently as of their own snapshots.
+// The ranges of strict tables can only be copied in the former fashion.
+func (vc *vcopier) copyRanges(ctx context.Context, copyState map[string][]*copyRange) error {
 	defer vc.vr.stats.PhaseTimings.Record("copy", time.Now())
 	defer vc.vr.stats.CopyLoopCount.Add(1)
 
-	log.Infof("Copying table %s, lastpk: %v", tableName, copyState[tableName])
+	ctx, cancel := context.WithTimeout(ctx, *copyPhaseDuration)
+	defer cancel()
+
+	tables := make([]string, 0, len(copyState))
+	for table := range copyState {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	var pending []*copyRange
+	for _, table := range tables {
+		pending = append(pending, pendingCopyRanges(copyState[table])...)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// The ranges of a stream that writes to a sink are copied one at
+	// a time since events are filtered against a single lastpk.
+	if *copyPhaseParallelism <= 1 || vc.vr.sink != nil {
+		return vc.copyRange(ctx, vc.vr.dbClient, pending[0], copyState, false, nil, nil)
+	}
+
+	if err := vc.loadStrictTables(ctx, tables); err != nil {
+		return err
+	}
+	// The first range is preferably one of a strict table since
+	// these can't be copied concurrently with other ranges.
+	first := pending[0]
+	for _, cr := range pending {
+		if vc.strictTables[cr.table] {
+			first = cr
+			break
+		}
+	}
+	var ranges []*copyRange
+	for _, cr := range pending {
+		if cr != first && !vc.strictTables[cr.table] {
+			ranges = append(ranges, cr)
+		}
+	}
+
+	ctx, cancel = context.WithCancel(ctx)
+	defer cancel()
+	q := newCopyQueue(ctx, ranges, vc.canSplit)
+	rec := &concurrency.AllErrorRecorder{}
+	var wg sync.WaitGroup
+	var once sync.Once
+	started := func() {
+		once.Do(func() {
+			for i := 1; i < *copyPhaseParallelism; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := vc.copyWorker(ctx, q, copyState); err != nil {
+						rec.RecordError(err)
+						cancel()
+					}
+				}()
+			}
+		})
+	}
+	q.take(first)
+	err := vc.copyRange(ctx, vc.vr.dbClient, first, copyState, false, q, started)
+	if err == nil && ctx.Err() == nil {
+		started()
+		err = vc.copyQueuedRanges(ctx, vc.vr.dbClient, q, copyState)
+	}
+	if err != nil {
+		rec.RecordError(err)
+		cancel()
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// copyWorker copies ranges from the queue on its own connection.
+func (vc *vcopier) copyWorker(ctx context.Context, q *copyQueue, copyState map[string][]*copyRange) error {
+	dbClient := vc.vr.vre.dbClientFactoryFiltered()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+	if err := setSessionVariables(dbClient); err != nil {
+		return err
+	}
+	if _, err := dbClient.ExecuteFetch("set foreign_key_checks=0", 1); err != nil {
+		return err
+	}
+	return vc.copyQueuedRanges(ctx, newVDBClient(dbClient, vc.vr.stats), q, copyState)
+}
+
+// copyQueuedRanges copies ranges from the queue until it's empty.
+// These ranges are copied as of positions ahead of the target.
+func (vc *vcopier) copyQueuedRanges(ctx context.Context, dbClient *vdbClient, q *copyQueue, copyState map[string][]*copyRange) error {
+	for {
+		cr := q.next()
+		if cr == nil {
+			return nil
+		}
+		if err := vc.copyRange(ctx, dbClient, cr, copyState, true, q, nil); err != nil {
+			return err
+		}
+	}
+}
 
+// loadStrictTables identifies the strict tables among the tables being copied.
+func (vc *vcopier) loadStrictTables(ctx context.Context, tables []string) error {
 	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, vc.vr.stats)
 	if err != nil {
 		return err
 	}
+	schema, err := vc.vr.mysqld.GetSchema(ctx, vc.vr.dbClient.DBName(), tables, nil, false)
+	if err != nil {
+		return err
+	}
+	loose := make(map[string]bool)
+	for _, td := range schema.TableDefinitions {
+		loose[td.Name] = !hasSecondaryUniqueKey(td.Schema)
+	}
+	vc.strictTables = make(map[string]bool)
+	for _, table := range tables {
+		tablePlan, ok := plan.TargetTables[table]
+		vc.strictTables[table] = !ok || !loose[table] || tablePlan.onInsert != insertNormal
+	}
+	return nil
+}
 
-	initialPlan, ok := plan.TargetTables[tableName]
-	if !ok {
-		return fmt.Errorf("plan not found for table: %s, current plans are: %#v", tableName, plan.TargetTables)
+// hasSecondaryUniqueKey returns true if the table has a unique key
+// other than its primary key, or has no primary key.
+func hasSecondaryUniqueKey(schema string) bool {
+	stmt, err := sqlparser.ParseStrictDDL(schema)
+	if err != nil {
+		return true
+	}
+	create, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || create.TableSpec == nil {
+		return true
 	}
+	hasPrimary := false
+	for _, index := range create.TableSpec.Indexes {
+		if index.Info.Primary {
+			hasPrimary = true
+		} else if index.Info.Unique {
+			return true
+		}
+	}
+	return !hasPrimary
+}
 
-	ctx, cancel := context.WithTimeout(ctx, *copyPhaseDuration)
-	defer cancel()
+// canSplit returns true if the range is a table that can be split
+// into ranges that are copied concurrently.
+func (vc *vcopier) canSplit(cr *copyRange) bool {
+	return *copyPhaseTableRanges > 1 && cr.id == 0 && cr.lastpk == nil && cr.end == nil && !vc.strictTables[cr.table]
+}
+
+// copyRange performs the synchronized copy of the next set of rows from
+// the range being copied. Each packet received is transactionally
+// committed with the lastpk. This allows for consistent resumability.
+// If ahead is set, the target is not fast-forwarded to the snapshot of
+// the range, whose position is recorded along with the lastpk instead.
+// If the queue is set, the range may be split into ranges that are pushed
+// to the queue. started is invoked once the snapshot was obtained.
+func (vc *vcopier) copyRange(ctx context.Context, dbClient *vdbClient, cr *copyRange, copyState map[string][]*copyRange, ahead bool, q *copyQueue, started func()) error {
+	defer dbClient.Rollback()
+
+	log.Infof("Copying table %s, range %d, lastpk: %v", cr.table, cr.id, cr.lastpk)
+
+	splitting := q != nil && vc.canSplit(cr)
+	defer func() {
+		if splitting {
+			q.split(nil)
+		}
+	}()
+
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, vc.vr.stats)
+	if err != nil {
+		return err
+	}
+
+	initialPlan, ok := plan.TargetTables[cr.table]
+	if !ok {
+		return fmt.Errorf("plan not found for table: %s, current plans are: %#v", cr.table, plan.TargetTables)
+	}
 
 	var lastpkpb *querypb.QueryResult
-	if lastpkqr := copyState[tableName]; lastpkqr != nil {
-		lastpkpb = sqltypes.ResultToProto3(lastpkqr)
+	if cr.lastpk != nil {
+		lastpkpb = sqltypes.ResultToProto3(cr.lastpk)
 	}
 
 	rowsCopiedTicker := time.NewTicker(rowsCopiedUpdateInterval)
 	defer rowsCopiedTicker.Stop()
 
-	var pkfields []*querypb.Field
+	var tablePlan *TablePlan
+	var fields, pkfields []*querypb.Field
 	var updateCopyState *sqlparser.ParsedQuery
 	var bv map[string]*querypb.BindVariable
 	var sqlbuffer bytes2.Buffer
+	var pos string
+	// pkIndex is the index of the primary key within the fields
+	// if the range has an end, in which case copying stops past it.
+	pkIndex := -1
+	copiedAhead := false
+	finished := false
 	err = vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
 		for {
 			select {
 			case <-rowsCopiedTicker.C:
 				update := binlogplayer.GenerateUpdateRowsCopied(vc.vr.id, vc.vr.stats.CopyRowCount.Get())
-				_, _ = vc.vr.dbClient.Execute(update)
+				_, _ = dbClient.Execute(update)
 			case <-ctx.Done():
 				return io.EOF
 			default:
@@ -240,59 +527,107 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 				break
 			}
 		}
-		if vc.tablePlan == nil {
+		if tablePlan == nil {
 			if len(rows.Fields) == 0 {
 				return fmt.Errorf("expecting field event first, got: %v", rows)
 			}
-			if err := vc.fastForward(ctx, copyState, rows.Gtid); err != nil {
+			if ahead {
+				pos = rows.Gtid
+			} else if err := vc.fastForward(ctx, copyState, rows.Gtid); err != nil {
 				return err
 			}
+			fields = append(fields, rows.Fields...)
+			pkfields = append(pkfields, rows.Pkfields...)
+			if splitting {
+				ranges, err := vc.splitRange(ctx, dbClient, cr, initialPlan, fields, pkfields)
+				splitting = false
+				q.split(ranges)
+				if err != nil {
+					return err
+				}
+			}
+			if started != nil {
+				started()
+			}
 			fieldEvent := &binlogdatapb.FieldEvent{
 				TableName: initialPlan.SendRule.Match,
 			}
 			fieldEvent.Fields = append(fieldEvent.Fields, rows.Fields...)
-			vc.tablePlan, err = plan.buildExecutionPlan(fieldEvent)
+			tablePlan, err = plan.buildExecutionPlan(fieldEvent)
 			if err != nil {
 				return err
 			}
-			pkfields = append(pkfields, rows.Pkfields...)
+			if vc.vr.sink != nil {
+				if err := tablePlan.buildSinkColumns(); err != nil {
+					return err
+				}
+			}
+			if cr.end != nil {
+				if pkIndex = fieldIndex(fields, cr.end.Fields[0].Name); pkIndex == -1 {
+					return fmt.Errorf("primary key %s of range %d of table %s is not copied", cr.end.Fields[0].Name, cr.id, cr.table)
+				}
+			}
 			buf := sqlparser.NewTrackedBuffer(nil)
-			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+			if ahead {
+				buf.Myprintf("update _vt.copy_state set lastpk=%a, pos=%a where vrepl_id=%s and table_name=%s and range_id=%s", ":lastpk", ":pos", strconv.Itoa(int(vc.vr.id)), encodeString(cr.table), strconv.FormatInt(cr.id, 10))
+			} else {
+				buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s and range_id=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(cr.table), strconv.FormatInt(cr.id, 10))
+			}
 			updateCopyState = buf.ParsedQuery()
 		}
 		if len(rows.Rows) == 0 {
 			return nil
 		}
+		lastpk := rows.Lastpk
+		if pkIndex != -1 {
+			n, err := rowsInRange(rows.Rows, fields, pkIndex, cr.end.Rows[0][0])
+			if err != nil {
+				return err
+			}
+			if n < len(rows.Rows) {
+				// The rest of the rows belong to the next range.
+				finished = true
+				if n == 0 {
+					return io.EOF
+				}
+				rows.Rows = rows.Rows[:n]
+				lastpk = &querypb.Row{}
+				vals := sqltypes.MakeRowTrusted(fields, rows.Rows[n-1])
+				lastpk.Lengths = []int64{int64(vals[pkIndex].Len())}
+				lastpk.Values = vals[pkIndex].Raw()
+			}
+		}
 
 		// The number of rows we receive depends on the packet size set
 		// for the row streamer. Since the packet size is roughly equivalent
 		// to data size, this should map to a uniform amount of pages affected
 		// per statement. A packet size of 30K will roughly translate to 8
 		// mysql pages of 4K each.
-		if err := vc.vr.dbClient.Begin(); err != nil {
+		if err := dbClient.Begin(); err != nil {
 			return err
 		}
-		_, err = vc.tablePlan.applyBulkInsert(&sqlbuffer, rows, func(sql string) (*sqltypes.Result, error) {
-			start := time.Now()
-
-			qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
-			if err != nil {
-				return nil, err
-			}
-			vc.vr.stats.QueryTimings.Record("copy", start)
-			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
-			vc.vr.stats.QueryCount.Add("copy", 1)
-			return qr, err
-		})
+		if vc.vr.sink != nil {
+			err = vc.applySinkRows(tablePlan, rows.Rows)
+		} else {
+			_, err = tablePlan.applyBulkInsert(&sqlbuffer, rows, func(sql string) (*sqltypes.Result, error) {
+				start := time.Now()
+
+				qr, err := dbClient.ExecuteWithRetry(ctx, sql)
+				if err != nil {
+					return nil, err
+				}
+				vc.vr.stats.QueryTimings.Record("copy", start)
+				vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
+				vc.vr.stats.QueryCount.Add("copy", 1)
+				return qr, err
+			})
+		}
 		if err != nil {
 			return err
 		}
 
 		var buf []byte
-		buf, err = prototext.Marshal(&querypb.QueryResult{
-			Fields: pkfields,
-			Rows:   []*querypb.Row{rows.Lastpk},
-		})
+		buf, err = encodeCopyStatePK(pkfields, lastpk)
 		if err != nil {
 			return err
 		}
@@ -302,39 +637,225 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 				Value: buf,
 			},
 		}
+		if ahead {
+			bv["pos"] = sqltypes.StringBindVariable(pos)
+		}
 		updateState, err := updateCopyState.GenerateQuery(bv, nil)
 		if err != nil {
 			return err
 		}
-		if _, err := vc.vr.dbClient.Execute(updateState); err != nil {
+		if _, err := dbClient.Execute(updateState); err != nil {
 			return err
 		}
 
-		if err := vc.vr.dbClient.Commit(); err != nil {
+		if err := dbClient.Commit(); err != nil {
 			return err
 		}
+		copiedAhead = copiedAhead || ahead
+		if finished {
+			return io.EOF
+		}
 		return nil
 	})
-	// If there was a timeout, return without an error.
-	select {
-	case <-ctx.Done():
-		log.Infof("Copy of %v stopped at lastpk: %v", tableName, bv)
-		return nil
-	default:
+	if finished {
+		// The stream was stopped at the end of the range.
+		err = nil
+	} else {
+		// If there was a timeout, return without an error.
+		select {
+		case <-ctx.Done():
+			log.Infof("Copy of %v range %d stopped at lastpk: %v", cr.table, cr.id, bv)
+			return nil
+		default:
+		}
 	}
 	if err != nil {
 		return err
 	}
-	log.Infof("Copy of %v finished at lastpk: %v", tableName, bv)
+	log.Infof("Copy of %v range %d finished at lastpk: %v", cr.table, cr.id, bv)
+	return vc.finishRange(dbClient, cr, copiedAhead)
+}
+
+// applySinkRows writes copied rows to the sink of the stream, and flushes
+// them before the copy state is saved.
+func (vc *vcopier) applySinkRows(tablePlan *TablePlan, rows []*querypb.Row) error {
+	start := time.Now()
+	if err := tablePlan.applySinkRows(rows, vc.vr.sink); err != nil {
+		return err
+	}
+	if err := vc.vr.sink.Flush(); err != nil {
+		return err
+	}
+	vc.vr.stats.QueryTimings.Record("copy", start)
+	vc.vr.stats.CopyRowCount.Add(int64(len(rows)))
+	vc.vr.stats.QueryCount.Add("copy", 1)
+	return nil
+}
+
+// finishRange removes a fully copied range from the copy state. If some
+// of its rows were copied ahead of the target, the range is only marked as
+// done: it's removed once these rows were fast-forwarded.
+func (vc *vcopier) finishRange(dbClient *vdbClient, cr *copyRange, copiedAhead bool) error {
 	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
-	if _, err := vc.vr.dbClient.Execute(buf.String()); err != nil {
+	switch {
+	case !copiedAhead && cr.pos.IsZero():
+		buf.Myprintf("delete from _vt.copy_state where vrepl_id=%s and table_name=%s and range_id=%s", strconv.Itoa(int(vc.vr.id)), encodeString(cr.table), strconv.FormatInt(cr.id, 10))
+	case cr.end == nil:
+		buf.Myprintf("update _vt.copy_state set range_end=lastpk where vrepl_id=%s and table_name=%s and range_id=%s", strconv.Itoa(int(vc.vr.id)), encodeString(cr.table), strconv.FormatInt(cr.id, 10))
+	default:
+		buf.Myprintf("update _vt.copy_state set lastpk=range_end where vrepl_id=%s and table_name=%s and range_id=%s", strconv.Itoa(int(vc.vr.id)), encodeString(cr.table), strconv.FormatInt(cr.id, 10))
+	}
+	if _, err := dbClient.Execute(buf.String()); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, gtid string) error {
+// splitRange splits the table of the range into ranges of similar sizes
+// if the table has a single integral primary key. The range becomes the
+// first of these ranges, and the others are returned.
+func (vc *vcopier) splitRange(ctx context.Context, dbClient *vdbClient, cr *copyRange, tablePlan *TablePlan, fields, pkfields []*querypb.Field) ([]*copyRange, error) {
+	if len(pkfields) != 1 || !sqltypes.IsIntegral(pkfields[0].Type) || fieldIndex(fields, pkfields[0].Name) == -1 {
+		return nil, nil
+	}
+	pkcol := sqlparser.NewColIdent(pkfields[0].Name)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select min(%v), max(%v) from %v", pkcol, pkcol, sqlparser.NewTableIdent(tablePlan.SendRule.Match))
+	var resultFields []*querypb.Field
+	var bounds []sqltypes.Value
+	err := vc.vr.sourceVStreamer.VStreamResults(ctx, buf.String(), func(vrr *binlogdatapb.VStreamResultsResponse) error {
+		if len(vrr.Fields) != 0 {
+			resultFields = vrr.Fields
+		}
+		if len(vrr.Rows) != 0 {
+			bounds = sqltypes.MakeRowTrusted(resultFields, vrr.Rows[0])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(bounds) != 2 || bounds[0].IsNull() || bounds[1].IsNull() {
+		return nil, nil
+	}
+	min, err := evalengine.ToInt64(bounds[0])
+	if err != nil {
+		// Unsigned values beyond the int64 range are not split.
+		return nil, nil
+	}
+	max, err := evalengine.ToInt64(bounds[1])
+	if err != nil {
+		return nil, nil
+	}
+	ends := splitCopyRange(min, max, *copyPhaseTableRanges)
+	if len(ends) == 0 {
+		return nil, nil
+	}
+
+	pks := make([]*sqltypes.Result, 0, len(ends))
+	encoded := make([]string, 0, len(ends))
+	for _, end := range ends {
+		pk := &sqltypes.Result{
+			Fields: pkfields,
+			Rows:   [][]sqltypes.Value{{sqltypes.MakeTrusted(pkfields[0].Type, strconv.AppendInt(nil, end, 10))}},
+		}
+		text, err := prototext.Marshal(sqltypes.ResultToProto3(pk))
+		if err != nil {
+			return nil, err
+		}
+		pks = append(pks, pk)
+		encoded = append(encoded, encodeString(string(text)))
+	}
+
+	var ranges []*copyRange
+	var insert strings.Builder
+	insert.WriteString("insert into _vt.copy_state(vrepl_id, table_name, range_id, lastpk, range_end) values ")
+	for i, pk := range pks {
+		next := &copyRange{
+			table:  cr.table,
+			id:     int64(i + 1),
+			lastpk: pk,
+		}
+		end := "null"
+		if i+1 < len(pks) {
+			next.end = pks[i+1]
+			end = encoded[i+1]
+		}
+		if i > 0 {
+			insert.WriteString(", ")
+		}
+		fmt.Fprintf(&insert, "(%d, %s, %d, %s, %s)", vc.vr.id, encodeString(cr.table), next.id, encoded[i], end)
+		ranges = append(ranges, next)
+	}
+	if err := dbClient.Begin(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Rollback()
+	update := fmt.Sprintf("update _vt.copy_state set range_end=%s where vrepl_id=%d and table_name=%s and range_id=%d", encoded[0], vc.vr.id, encodeString(cr.table), cr.id)
+	if _, err := dbClient.Execute(update); err != nil {
+		return nil, err
+	}
+	if _, err := dbClient.Execute(insert.String()); err != nil {
+		return nil, err
+	}
+	if err := dbClient.Commit(); err != nil {
+		return nil, err
+	}
+	log.Infof("Split table %s into %d ranges for copying", cr.table, len(ranges)+1)
+	cr.end = pks[0]
+	return ranges, nil
+}
+
+// splitCopyRange returns the ends of the ranges that evenly split the values
+// between min and max into n ranges. The last range is unbounded, and has no
+// end. Fewer ranges are returned if they would be smaller than minCopyRangeSize.
+func splitCopyRange(min, max int64, n int) []int64 {
+	if n < 2 || max <= min {
+		return nil
+	}
+	span := uint64(max) - uint64(min)
+	if count := span / minCopyRangeSize; count < uint64(n) {
+		n = int(count)
+	}
+	if n < 2 {
+		return nil
+	}
+	step := span / uint64(n)
+	ends := make([]int64, 0, n-1)
+	for i := 1; i < n; i++ {
+		ends = append(ends, int64(uint64(min)+step*uint64(i)))
+	}
+	return ends
+}
+
+// rowsInRange returns the number of leading rows whose primary key
+// does not exceed the end of the range. Rows are ordered by primary key.
+func rowsInRange(rows []*querypb.Row, fields []*querypb.Field, pkIndex int, end sqltypes.Value) (int, error) {
+	n := len(rows)
+	for n > 0 {
+		vals := sqltypes.MakeRowTrusted(fields, rows[n-1])
+		cmp, err := evalengine.NullsafeCompare(vals[pkIndex], end, collations.Unknown)
+		if err != nil {
+			return 0, err
+		}
+		if cmp <= 0 {
+			break
+		}
+		n--
+	}
+	return n, nil
+}
+
+func fieldIndex(fields []*querypb.Field, name string) int {
+	for i, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (vc *vcopier) fastForward(ctx context.Context, copyState map[string][]*copyRange, gtid string) error {
 	defer vc.vr.stats.PhaseTimings.Record("fastforward", time.Now())
 	pos, err := mysql.DecodePosition(gtid)
 	if err != nil {
@@ -351,3 +872,127 @@ func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltyp
 	}
 	return newVPlayer(vc.vr, settings, copyState, pos, "fastforward").play(ctx)
 }
+
+// fastForwardCopiedRanges fast-forwards the target to the positions as of
+// which rows were copied ahead of it. The events are applied idempotently
+// to these rows. The ranges that were fully copied are then removed.
+func (vc *vcopier) fastForwardCopiedRanges(ctx context.Context) error {
+	copyState, err := vc.readCopyState(ctx)
+	if err != nil {
+		return err
+	}
+	var positions []mysql.Position
+	done := false
+	for _, ranges := range copyState {
+		for _, cr := range ranges {
+			if !cr.pos.IsZero() {
+				positions = append(positions, cr.pos)
+			}
+			done = done || cr.done
+		}
+	}
+	if len(positions) == 0 && !done {
+		return nil
+	}
+	defer vc.vr.stats.PhaseTimings.Record("fastforward", time.Now())
+	for _, pos := range positions {
+		settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+		if err != nil {
+			return err
+		}
+		if settings.StartPos.AtLeast(pos) {
+			continue
+		}
+		if err := newVPlayer(vc.vr, settings, copyState, pos, "fastforward").play(ctx); err != nil {
+			return err
+		}
+		settings, err = binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+		if err != nil {
+			return err
+		}
+		if !settings.StartPos.AtLeast(pos) {
+			// Fast-forwarding was interrupted.
+			return nil
+		}
+	}
+
+	defer vc.vr.dbClient.Rollback()
+	if err := vc.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	if _, err := vc.vr.dbClient.Execute(fmt.Sprintf("delete from _vt.copy_state where vrepl_id=%d and lastpk=range_end", vc.vr.id)); err != nil {
+		return err
+	}
+	if _, err := vc.vr.dbClient.Execute(fmt.Sprintf("update _vt.copy_state set pos=null where vrepl_id=%d and pos is not null", vc.vr.id)); err != nil {
+		return err
+	}
+	return vc.vr.dbClient.Commit()
+}
+
+// copyQueue contains the ranges waiting to be copied concurrently.
+type copyQueue struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	ranges   []*copyRange
+	canSplit func(*copyRange) bool
+	// splitting is the number of ranges being copied that may still
+	// be split. The queue is not exhausted until they are.
+	splitting int
+	closed    bool
+}
+
+// newCopyQueue creates a copyQueue that is closed when the context is done.
+func newCopyQueue(ctx context.Context, ranges []*copyRange, canSplit func(*copyRange) bool) *copyQueue {
+	q := &copyQueue{
+		ranges:   ranges,
+		canSplit: canSplit,
+	}
+	q.cond = sync.NewCond(&q.mu)
+	go func() {
+		<-ctx.Done()
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.closed = true
+		q.cond.Broadcast()
+	}()
+	return q
+}
+
+// take accounts for a range that is being copied.
+func (q *copyQueue) take(cr *copyRange) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.takeLocked(cr)
+}
+
+func (q *copyQueue) takeLocked(cr *copyRange) {
+	if q.canSplit(cr) {
+		q.splitting++
+	}
+}
+
+// next returns the next range to copy. It returns nil once the queue
+// is exhausted or closed.
+func (q *copyQueue) next() *copyRange {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.ranges) == 0 && q.splitting > 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if len(q.ranges) == 0 || q.closed {
+		return nil
+	}
+	cr := q.ranges[0]
+	q.ranges = q.ranges[1:]
+	q.takeLocked(cr)
+	return cr
+}
+
+// split pushes the ranges a range was split into, if any.
+func (q *copyQueue) split(ranges []*copyRange) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.splitting--
+	q.ranges = append(q.ranges, ranges...)
+	q.cond.Broadcast()
+}
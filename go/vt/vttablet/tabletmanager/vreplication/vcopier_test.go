@@ -18,12 +18,14 @@ package vreplication
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 	"time"
 
 	"context"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -1361,3 +1363,212 @@ func TestPlayerCopyTablesWithGeneratedColumn(t *testing.T) {
 		{"bbb2", "bbb", "20"},
 	})
 }
+
+// TestPlayerCopyParallel validates that tables and ranges of tables are copied
+// concurrently, and that the events replayed over them are applied correctly.
+func TestPlayerCopyParallel(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedParallelism, savedTableRanges := *copyPhaseParallelism, *copyPhaseTableRanges
+	*copyPhaseParallelism, *copyPhaseTableRanges = 3, 2
+	defer func() { *copyPhaseParallelism, *copyPhaseTableRanges = savedParallelism, savedTableRanges }()
+
+	doNotLogDBQueries = true
+	defer func() { doNotLogDBQueries = false }()
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(1, 'aaa'), (50000, 'bbb'), (50001, 'ccc'), (100000, 'ddd')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		"create table src2(id int, val varbinary(128), primary key(id), unique key(val))",
+		"insert into src2 values(1, 'aaa'), (2, 'bbb')",
+		fmt.Sprintf("create table %s.dst2(id int, val varbinary(128), primary key(id), unique key(val))", vrepldb),
+		"create table src3(id int, val varbinary(128), primary key(id))",
+		"insert into src3 values(1, 'aaa'), (2, 'bbb')",
+		fmt.Sprintf("create table %s.dst3(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+		"drop table src2",
+		fmt.Sprintf("drop table %s.dst2", vrepldb),
+		"drop table src3",
+		fmt.Sprintf("drop table %s.dst3", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}, {
+			Match:  "dst2",
+			Filter: "select * from src2",
+		}, {
+			Match:  "dst3",
+			Filter: "select * from src3",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	query := binlogplayer.CreateVReplicationState("test", bls, "", binlogplayer.VReplicationInit, playerEngine.dbName)
+	qr, err := playerEngine.Exec(query)
+	require.NoError(t, err)
+	defer func() {
+		query := fmt.Sprintf("delete from _vt.vreplication where id = %d", qr.InsertID)
+		_, err := playerEngine.Exec(query)
+		require.NoError(t, err)
+	}()
+
+	waitForRunning := func() {
+		t.Helper()
+		query := fmt.Sprintf("select state from _vt.vreplication where id=%d", qr.InsertID)
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			qr, err := env.Mysqld.FetchSuperQuery(context.Background(), query)
+			require.NoError(t, err)
+			if len(qr.Rows) == 1 && qr.Rows[0][0].ToString() == binlogplayer.BlpRunning {
+				return
+			}
+			require.True(t, time.Now().Before(deadline), "stream is not running: %v", qr.Rows)
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+	waitForRunning()
+
+	expectData(t, "dst1", [][]string{
+		{"1", "aaa"},
+		{"50000", "bbb"},
+		{"50001", "ccc"},
+		{"100000", "ddd"},
+	})
+	expectData(t, "dst2", [][]string{
+		{"1", "aaa"},
+		{"2", "bbb"},
+	})
+	expectData(t, "dst3", [][]string{
+		{"1", "aaa"},
+		{"2", "bbb"},
+	})
+	qr2, err := env.Mysqld.FetchSuperQuery(context.Background(), fmt.Sprintf("select count(*) from _vt.copy_state where vrepl_id=%d", qr.InsertID))
+	require.NoError(t, err)
+	require.Equal(t, "0", qr2.Rows[0][0].ToString())
+
+	execStatements(t, []string{
+		"insert into src1 values(100001, 'eee')",
+		"update src1 set val='fff' where id=50000",
+		"delete from src3 where id=1",
+	})
+	time.Sleep(time.Second)
+	expectData(t, "dst1", [][]string{
+		{"1", "aaa"},
+		{"50000", "fff"},
+		{"50001", "ccc"},
+		{"100000", "ddd"},
+		{"100001", "eee"},
+	})
+	expectData(t, "dst3", [][]string{
+		{"2", "bbb"},
+	})
+}
+
+func TestSplitCopyRange(t *testing.T) {
+	testcases := []struct {
+		min, max int64
+		n        int
+		want     []int64
+	}{{
+		min: 1, max: 100000, n: 1,
+	}, {
+		min: 1, max: 100000, n: 4,
+		want: []int64{25000, 49999, 74998},
+	}, {
+		// Ranges are not smaller than minCopyRangeSize.
+		min: 1, max: 30001, n: 10,
+		want: []int64{10001, 20001},
+	}, {
+		min: 1, max: 5000, n: 10,
+	}, {
+		min: math.MinInt64, max: math.MaxInt64, n: 2,
+		want: []int64{-1},
+	}}
+	for _, tcase := range testcases {
+		got := splitCopyRange(tcase.min, tcase.max, tcase.n)
+		assert.Equal(t, tcase.want, got, "splitCopyRange(%d, %d, %d)", tcase.min, tcase.max, tcase.n)
+	}
+}
+
+func TestRowsInRange(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	rows := sqltypes.RowsToProto3([][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")},
+		{sqltypes.NewInt64(5), sqltypes.NewVarChar("b")},
+		{sqltypes.NewInt64(9), sqltypes.NewVarChar("c")},
+	})
+	for end, want := range map[int64]int{0: 0, 1: 1, 4: 1, 5: 2, 9: 3, 10: 3} {
+		got, err := rowsInRange(rows, fields, 0, sqltypes.NewInt64(end))
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "rowsInRange(%d)", end)
+	}
+}
+
+func TestHasSecondaryUniqueKey(t *testing.T) {
+	testcases := []struct {
+		schema string
+		want   bool
+	}{{
+		schema: "CREATE TABLE `t1` (\n  `id` int NOT NULL,\n  `val` varbinary(128) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  KEY `val` (`val`)\n) ENGINE=InnoDB",
+		want:   false,
+	}, {
+		schema: "CREATE TABLE `t1` (\n  `id` int NOT NULL,\n  `val` varbinary(128) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `val` (`val`)\n) ENGINE=InnoDB",
+		want:   true,
+	}, {
+		// Tables without a primary key can't be copied concurrently.
+		schema: "CREATE TABLE `t1` (\n  `id` int NOT NULL,\n  UNIQUE KEY `id` (`id`)\n) ENGINE=InnoDB",
+		want:   true,
+	}, {
+		schema: "not a table",
+		want:   true,
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, hasSecondaryUniqueKey(tcase.schema), tcase.schema)
+	}
+}
+
+func TestCopyQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := &copyRange{table: "t1"}
+	q := newCopyQueue(ctx, []*copyRange{{table: "t2"}}, func(cr *copyRange) bool {
+		return cr.table == "t1" && cr.id == 0 && cr.end == nil
+	})
+	q.take(first)
+	assert.Equal(t, "t2", q.next().table)
+
+	// The queue is not exhausted until the first range is split.
+	nextc := make(chan *copyRange)
+	go func() {
+		nextc <- q.next()
+	}()
+	select {
+	case cr := <-nextc:
+		t.Fatalf("unexpected range: %v", cr)
+	case <-time.After(100 * time.Millisecond):
+	}
+	first.end = sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "10")
+	q.split([]*copyRange{{table: "t1", id: 1, lastpk: first.end}})
+	cr := <-nextc
+	assert.Equal(t, int64(1), cr.id)
+	assert.Nil(t, q.next())
+
+	// A closed queue doesn't return ranges.
+	q = newCopyQueue(ctx, []*copyRange{{table: "t2"}}, func(*copyRange) bool { return false })
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	assert.Nil(t, q.next())
+}
@@ -0,0 +1,819 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	vdiffTableName      = "_vt.vdiff"
+	vdiffTableTableName = "_vt.vdiff_table"
+
+	createVDiffTable = `create table if not exists _vt.vdiff (
+  id int auto_increment,
+  vdiff_uuid varbinary(64) not null,
+  workflow varbinary(1000),
+  db_name varbinary(255),
+  state varbinary(64),
+  options varbinary(1024),
+  last_error varbinary(1024),
+  created_at timestamp default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  primary key (id),
+  unique key (vdiff_uuid))`
+
+	createVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id int,
+  table_name varbinary(128),
+  state varbinary(64),
+  lastpk varbinary(2000),
+  report mediumblob,
+  updated_at timestamp default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name))`
+
+	// vdiffStopMessage is the message of the streams stopped by a vdiff.
+	vdiffStopMessage = "for vdiff"
+)
+
+// The states of a tablet vdiff, and of the tables it compares.
+const (
+	VDiffStatePending   = "pending"
+	VDiffStateStarted   = "started"
+	VDiffStateStopped   = "stopped"
+	VDiffStateCompleted = "completed"
+	VDiffStateError     = "error"
+)
+
+var (
+	vdiffChunkDuration = flag.Duration("vdiff_chunk_duration", 5*time.Minute, "the maximum time a tablet vdiff compares rows with the same source streams, before it saves its progress and starts a new chunk")
+	vdiffWaitTime      = flag.Duration("vdiff_filtered_replication_wait_time", 30*time.Second, "the maximum time a tablet vdiff waits for the sources and the workflow to reach the snapshot of a chunk")
+)
+
+// How frequently a tablet vdiff saves the progress of the table it compares.
+var vdiffProgressInterval = 10 * time.Second
+
+// VDiffOptions are the options of a tablet vdiff. They are stored as JSON
+// in the options column of _vt.vdiff.
+type VDiffOptions struct {
+	// Tables restricts the vdiff to these tables of the workflow.
+	Tables []string `json:"tables,omitempty"`
+	// SourceCell and TabletTypes select the source tablets.
+	// They default to the settings of the workflow.
+	SourceCell  string `json:"source_cell,omitempty"`
+	TabletTypes string `json:"tablet_types,omitempty"`
+	// ChunkDuration and FilteredReplicationWaitTime override the
+	// -vdiff_chunk_duration and -vdiff_filtered_replication_wait_time flags.
+	ChunkDuration               time.Duration `json:"chunk_duration,omitempty"`
+	FilteredReplicationWaitTime time.Duration `json:"filtered_replication_wait_time,omitempty"`
+	// OnlyPKs reports only the primary key columns of the sample rows.
+	OnlyPKs bool `json:"only_pks,omitempty"`
+}
+
+// vdiffController runs the tablet vdiff of a workflow, one table and one
+// chunk at a time. The progress of every table is saved in _vt.vdiff_table,
+// which allows a vdiff to resume where it left off after it was stopped,
+// or after the tablet restarted.
+type vdiffController struct {
+	vre      *Engine
+	id       int
+	uuid     string
+	workflow string
+	options  *VDiffOptions
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// vdiffStream is a stream of the workflow on this tablet.
+type vdiffStream struct {
+	id          int
+	source      *binlogdatapb.BinlogSource
+	cell        string
+	tabletTypes string
+	// pos is the position at which the stream was stopped for the current chunk.
+	pos mysql.Position
+	// state is the state of the stream before it was stopped, to which
+	// it is restored after the chunk.
+	state string
+}
+
+// vdiffTableProgress is the saved progress of the diff of one table.
+type vdiffTableProgress struct {
+	state  string
+	lastpk *querypb.QueryResult
+	report *VDiffTableReport
+}
+
+// newVDiffController creates a vdiffController and launches the goroutine
+// that performs the vdiff. If previous is set, the vdiff waits for it to
+// exit before it starts.
+func newVDiffController(ctx context.Context, params map[string]string, vre *Engine, previous *vdiffController) (*vdiffController, error) {
+	id, err := strconv.Atoi(params["id"])
+	if err != nil {
+		return nil, err
+	}
+	options := &VDiffOptions{}
+	if v := params["options"]; v != "" {
+		if err := json.Unmarshal([]byte(v), options); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid options for vdiff %s", params["vdiff_uuid"])
+		}
+	}
+	vd := &vdiffController{
+		vre:      vre,
+		id:       id,
+		uuid:     params["vdiff_uuid"],
+		workflow: params["workflow"],
+		options:  options,
+		done:     make(chan struct{}),
+	}
+	ctx, vd.cancel = context.WithCancel(ctx)
+	go vd.run(ctx, previous)
+	return vd, nil
+}
+
+func (vd *vdiffController) run(ctx context.Context, previous *vdiffController) {
+	defer func() {
+		log.Infof("vdiff %s: stopped", vd.uuid)
+		close(vd.done)
+	}()
+	if previous != nil {
+		// The previous controller may still be restarting the workflow.
+		<-previous.done
+	}
+
+	err := vd.diff(ctx)
+	select {
+	case <-ctx.Done():
+		// The vdiff was stopped, or the engine is closing. The state is left
+		// as is: a vdiff that is still started resumes when the engine opens.
+		return
+	default:
+	}
+	if err != nil {
+		log.Errorf("vdiff %s of workflow %s: %v", vd.uuid, vd.workflow, err)
+		vd.setState(VDiffStateError, err.Error())
+		return
+	}
+	log.Infof("vdiff %s of workflow %s: completed", vd.uuid, vd.workflow)
+	vd.setState(VDiffStateCompleted, "")
+}
+
+// Stop stops the vdiff and waits for it to exit.
+func (vd *vdiffController) Stop() {
+	vd.cancel()
+	<-vd.done
+}
+
+// setState records the final state of a started vdiff.
+func (vd *vdiffController) setState(state, message string) {
+	dbClient := vd.vre.dbClientFactoryFiltered()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("vdiff %s: could not set state %s: %v", vd.uuid, state, err)
+		return
+	}
+	defer dbClient.Close()
+	completedAt := "null"
+	if state == VDiffStateCompleted {
+		completedAt = "now()"
+	}
+	query := fmt.Sprintf("update _vt.vdiff set state=%s, last_error=%s, completed_at=%s where id=%d and state=%s",
+		encodeString(state), encodeString(binlogplayer.MessageTruncate(message)), completedAt, vd.id, encodeString(VDiffStateStarted))
+	if _, err := dbClient.ExecuteFetch(query, 1); err != nil {
+		log.Errorf("vdiff %s: could not set state %s: %v", vd.uuid, state, err)
+	}
+}
+
+// diff compares the tables of the workflow that were not compared yet.
+func (vd *vdiffController) diff(ctx context.Context) error {
+	dbClient := vd.vre.dbClientFactoryFiltered()
+	if err := dbClient.Connect(); err != nil {
+		return vterrors.Wrap(err, "can't connect to database")
+	}
+	defer dbClient.Close()
+
+	query := fmt.Sprintf("update _vt.vdiff set state=%s, started_at=ifnull(started_at, now()), last_error='' where id=%d", encodeString(VDiffStateStarted), vd.id)
+	if _, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch); err != nil {
+		return err
+	}
+
+	streams, err := vd.readStreams(dbClient)
+	if err != nil {
+		return err
+	}
+	schm, err := vd.vre.mysqld.GetSchema(ctx, vd.vre.dbName, nil, nil, false)
+	if err != nil {
+		return vterrors.Wrap(err, "GetSchema")
+	}
+	differs, err := buildVDiffPlan(streams[0].source.Filter, schm, vd.options.Tables)
+	if err != nil {
+		return vterrors.Wrap(err, "buildVDiffPlan")
+	}
+	for _, td := range differs {
+		progress, err := vd.readTableProgress(ctx, dbClient, td.table)
+		if err != nil {
+			return err
+		}
+		for progress.state != VDiffStateCompleted {
+			if err := vd.diffChunk(ctx, dbClient, streams, td, progress); err != nil {
+				return vterrors.Wrapf(err, "table %s", td.table)
+			}
+		}
+	}
+	return nil
+}
+
+func (vd *vdiffController) readStreams(dbClient binlogplayer.DBClient) ([]*vdiffStream, error) {
+	query := fmt.Sprintf("select id, source, cell, tablet_types from _vt.vreplication where db_name=%s and workflow=%s order by id", encodeString(vd.vre.dbName), encodeString(vd.workflow))
+	qr, err := dbClient.ExecuteFetch(query, 10000)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("workflow %s not found", vd.workflow)
+	}
+	var streams []*vdiffStream
+	for i := range qr.Rows {
+		row, err := rowToMap(qr, i)
+		if err != nil {
+			return nil, err
+		}
+		id, err := strconv.Atoi(row["id"])
+		if err != nil {
+			return nil, err
+		}
+		stream := &vdiffStream{
+			id:          id,
+			source:      &binlogdatapb.BinlogSource{},
+			cell:        row["cell"],
+			tabletTypes: row["tablet_types"],
+		}
+		if err := prototext.Unmarshal([]byte(row["source"]), stream.source); err != nil {
+			return nil, err
+		}
+		if stream.source.GetExternalMysql() != "" || stream.source.Filter == nil {
+			return nil, fmt.Errorf("vdiff is not supported for stream %d of workflow %s", id, vd.workflow)
+		}
+		streams = append(streams, stream)
+	}
+	return streams, nil
+}
+
+// readTableProgress reads the progress of a table, and creates it
+// if the table was not compared yet.
+func (vd *vdiffController) readTableProgress(ctx context.Context, dbClient binlogplayer.DBClient, table string) (*vdiffTableProgress, error) {
+	query := fmt.Sprintf("select state, lastpk, report from _vt.vdiff_table where vdiff_id=%d and table_name=%s", vd.id, encodeString(table))
+	qr, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	progress := &vdiffTableProgress{
+		state:  VDiffStatePending,
+		report: &VDiffTableReport{TableName: table},
+	}
+	if len(qr.Rows) == 0 {
+		query := fmt.Sprintf("insert into _vt.vdiff_table(vdiff_id, table_name, state) values (%d, %s, %s)", vd.id, encodeString(table), encodeString(VDiffStatePending))
+		if _, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch); err != nil {
+			return nil, err
+		}
+		return progress, nil
+	}
+	row := qr.Rows[0]
+	progress.state = row[0].ToString()
+	if !row[1].IsNull() && row[1].ToString() != "" {
+		progress.lastpk = &querypb.QueryResult{}
+		if err := prototext.Unmarshal(row[1].ToBytes(), progress.lastpk); err != nil {
+			return nil, err
+		}
+	}
+	if !row[2].IsNull() && row[2].ToString() != "" {
+		if err := json.Unmarshal(row[2].ToBytes(), progress.report); err != nil {
+			return nil, err
+		}
+	}
+	return progress, nil
+}
+
+// saveTableProgress saves the progress of a table.
+func (vd *vdiffController) saveTableProgress(dbClient binlogplayer.DBClient, table string, progress *vdiffTableProgress) error {
+	lastpk := "null"
+	if progress.lastpk != nil {
+		buf, err := prototext.Marshal(progress.lastpk)
+		if err != nil {
+			return err
+		}
+		lastpk = encodeString(string(buf))
+	}
+	report, err := json.Marshal(progress.report)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("update _vt.vdiff_table set state=%s, lastpk=%s, report=%s where vdiff_id=%d and table_name=%s",
+		encodeString(progress.state), lastpk, encodeString(string(report)), vd.id, encodeString(table))
+	_, err = dbClient.ExecuteFetch(query, 1)
+	return err
+}
+
+// diffChunk compares the next chunk of a table. It stops the workflow,
+// starts the source streams at the last compared primary key once the
+// sources caught up with the workflow, fast-forwards the workflow to the
+// snapshots of the source streams, and reads the target in a consistent
+// snapshot. The workflow is restarted before the rows are compared.
+func (vd *vdiffController) diffChunk(ctx context.Context, dbClient binlogplayer.DBClient, streams []*vdiffStream, td *vdiffTable, progress *vdiffTableProgress) error {
+	if err := vd.stopWorkflow(dbClient, streams); err != nil {
+		return err
+	}
+	restarted := false
+	defer func() {
+		if !restarted {
+			if err := vd.restartWorkflow(dbClient, streams); err != nil {
+				log.Errorf("vdiff %s: could not restart workflow %s: %v", vd.uuid, vd.workflow, err)
+			}
+		}
+	}()
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, vd.waitTime())
+	defer waitCancel()
+	sources := make([]*vdiffSourceStream, 0, len(streams))
+	defer func() {
+		for _, source := range sources {
+			source.stop()
+		}
+	}()
+	for _, stream := range streams {
+		source, err := vd.startSourceStream(ctx, waitCtx, stream, td.sourceQuery, progress.lastpk)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, source)
+		if err := td.checkSourcePKs(source.pkfields); err != nil {
+			return err
+		}
+	}
+
+	// Fast-forward the workflow to the snapshots of the sources.
+	for i, stream := range streams {
+		query := fmt.Sprintf("update _vt.vreplication set state='Running', stop_pos=%s, message='synchronizing for vdiff' where id=%d", encodeString(sources[i].gtid), stream.id)
+		if _, err := vd.vre.Exec(query); err != nil {
+			return err
+		}
+		if err := vd.vre.WaitForPos(waitCtx, stream.id, sources[i].gtid); err != nil {
+			return vterrors.Wrapf(err, "WaitForPos for stream %d", stream.id)
+		}
+	}
+	target, err := newVDiffTargetReader(vd.vre.dbClientFactoryFiltered, td, progress.lastpk)
+	if err != nil {
+		return err
+	}
+	defer target.close()
+
+	restarted = true
+	if err := vd.restartWorkflow(dbClient, streams); err != nil {
+		return err
+	}
+	return vd.compareChunk(ctx, dbClient, td, progress, sources, target)
+}
+
+// compareChunk compares the rows of the sources and of the target until
+// both are exhausted or the chunk duration has elapsed, and saves the progress.
+func (vd *vdiffController) compareChunk(ctx context.Context, dbClient binlogplayer.DBClient, td *vdiffTable, progress *vdiffTableProgress, sources []*vdiffSourceStream, target *vdiffTargetReader) error {
+	deadline := time.Now().Add(vd.chunkDuration())
+	saveTicker := time.NewTicker(vdiffProgressInterval)
+	defer saveTicker.Stop()
+
+	fields, pkfields := sources[0].fields, sources[0].pkfields
+	progress.state = VDiffStateStarted
+	merger, err := newVDiffMerger(td, fields, sources)
+	if err != nil {
+		return err
+	}
+	targetRow, err := target.next()
+	if err != nil {
+		return err
+	}
+	report := progress.report
+	for {
+		select {
+		case <-ctx.Done():
+			// Save what was compared so far, the vdiff resumes from there.
+			if err := vd.saveTableProgress(dbClient, td.table, progress); err != nil {
+				log.Errorf("vdiff %s: could not save the progress of table %s: %v", vd.uuid, td.table, err)
+			}
+			return ctx.Err()
+		case <-saveTicker.C:
+			if err := vd.saveTableProgress(dbClient, td.table, progress); err != nil {
+				return err
+			}
+		default:
+		}
+		if time.Now().After(deadline) {
+			return vd.saveTableProgress(dbClient, td.table, progress)
+		}
+
+		sourceRow := merger.peek()
+		var c int
+		switch {
+		case sourceRow == nil && targetRow == nil:
+			progress.state = VDiffStateCompleted
+			return vd.saveTableProgress(dbClient, td.table, progress)
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			if c, err = td.compare(sourceRow, targetRow, fields, td.pkCols); err != nil {
+				return err
+			}
+		}
+
+		report.ProcessedRows++
+		var lastRow []sqltypes.Value
+		switch {
+		case c < 0:
+			report.ExtraRowsSource++
+			if len(report.ExtraRowsSourceSample) < maxVDiffReportSampleRows {
+				report.ExtraRowsSourceSample = append(report.ExtraRowsSourceSample, td.sampleRow(sourceRow, vd.options.OnlyPKs))
+			}
+			lastRow = sourceRow
+			err = merger.advance()
+		case c > 0:
+			report.ExtraRowsTarget++
+			if len(report.ExtraRowsTargetSample) < maxVDiffReportSampleRows {
+				report.ExtraRowsTargetSample = append(report.ExtraRowsTargetSample, td.sampleRow(targetRow, vd.options.OnlyPKs))
+			}
+			lastRow = targetRow
+			targetRow, err = target.next()
+		default:
+			if c, err = td.compare(sourceRow, targetRow, fields, td.compareCols); err != nil {
+				return err
+			}
+			if c != 0 {
+				report.MismatchedRows++
+				if len(report.MismatchedRowsSample) < maxVDiffReportSampleRows {
+					report.MismatchedRowsSample = append(report.MismatchedRowsSample, &VDiffMismatch{
+						Source: td.sampleRow(sourceRow, vd.options.OnlyPKs),
+						Target: td.sampleRow(targetRow, vd.options.OnlyPKs),
+					})
+				}
+			} else {
+				report.MatchingRows++
+			}
+			lastRow = sourceRow
+			if err = merger.advance(); err == nil {
+				targetRow, err = target.next()
+			}
+		}
+		progress.lastpk = td.lastPK(pkfields, lastRow)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// stopWorkflow stops the streams of the workflow, and records their states
+// and positions.
+func (vd *vdiffController) stopWorkflow(dbClient binlogplayer.DBClient, streams []*vdiffStream) error {
+	query := fmt.Sprintf("select id, state, message from _vt.vreplication where db_name=%s and workflow=%s", encodeString(vd.vre.dbName), encodeString(vd.workflow))
+	qr, err := dbClient.ExecuteFetch(query, 10000)
+	if err != nil {
+		return err
+	}
+	states := make(map[int]string, len(qr.Rows))
+	for i := range qr.Rows {
+		row, err := rowToMap(qr, i)
+		if err != nil {
+			return err
+		}
+		id, err := strconv.Atoi(row["id"])
+		if err != nil {
+			return err
+		}
+		state := row["state"]
+		// A stream stopped by a vdiff that didn't restart it, because
+		// its tablet shut down, was running before.
+		if state == binlogplayer.BlpStopped && row["message"] == vdiffStopMessage {
+			state = binlogplayer.BlpRunning
+		}
+		states[id] = state
+	}
+
+	for _, stream := range streams {
+		state, ok := states[stream.id]
+		if !ok {
+			return fmt.Errorf("stream %d of workflow %s not found", stream.id, vd.workflow)
+		}
+		stream.state = state
+	}
+
+	query = fmt.Sprintf("update _vt.vreplication set state='Stopped', message=%s where db_name=%s and workflow=%s", encodeString(vdiffStopMessage), encodeString(vd.vre.dbName), encodeString(vd.workflow))
+	if _, err := vd.vre.Exec(query); err != nil {
+		return err
+	}
+	for _, stream := range streams {
+		settings, err := binlogplayer.ReadVRSettings(dbClient, uint32(stream.id))
+		if err == nil && settings.StartPos.IsZero() {
+			err = fmt.Errorf("stream %d of workflow %s has not started", stream.id, vd.workflow)
+		}
+		if err != nil {
+			if err := vd.restartWorkflow(dbClient, streams); err != nil {
+				log.Errorf("vdiff %s: could not restart workflow %s: %v", vd.uuid, vd.workflow, err)
+			}
+			return err
+		}
+		stream.pos = settings.StartPos
+	}
+	return nil
+}
+
+// restartWorkflow restores the states of the streams of the workflow from
+// before they were stopped. If the engine is closed, the streams are only
+// updated, and start when it opens.
+func (vd *vdiffController) restartWorkflow(dbClient binlogplayer.DBClient, streams []*vdiffStream) error {
+	for _, stream := range streams {
+		query := fmt.Sprintf("update _vt.vreplication set state=%s, message='', stop_pos='' where id=%d", encodeString(stream.state), stream.id)
+		if _, err := vd.vre.Exec(query); err != nil {
+			log.Warningf("vdiff %s: could not restart stream %d of workflow %s through the engine: %v", vd.uuid, stream.id, vd.workflow, err)
+			if _, err := dbClient.ExecuteFetch(query, 1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// startSourceStream streams the rows of a table from a source tablet whose
+// snapshot is at or after the position at which the stream was stopped.
+// If the tablet is behind, the stream is restarted until it caught up.
+func (vd *vdiffController) startSourceStream(ctx, waitCtx context.Context, stream *vdiffStream, query string, lastpk *querypb.QueryResult) (*vdiffSourceStream, error) {
+	for {
+		tablet, err := vd.pickSourceTablet(waitCtx, stream)
+		if err != nil {
+			return nil, err
+		}
+		source := newVDiffSourceStream(ctx, tablet, query, lastpk)
+		if err := source.waitForFields(waitCtx); err != nil {
+			source.stop()
+			return nil, vterrors.Wrapf(err, "VStreamRows for tablet %v", topoproto.TabletAliasString(tablet.Alias))
+		}
+		pos, err := mysql.DecodePosition(source.gtid)
+		if err != nil {
+			source.stop()
+			return nil, err
+		}
+		if pos.AtLeast(stream.pos) {
+			return source, nil
+		}
+		source.stop()
+		log.Infof("vdiff %s: tablet %v is behind the position %v of stream %d, retrying", vd.uuid, topoproto.TabletAliasString(tablet.Alias), stream.pos, stream.id)
+		select {
+		case <-waitCtx.Done():
+			return nil, fmt.Errorf("source tablets of stream %d did not reach the position %v: %v", stream.id, stream.pos, waitCtx.Err())
+		case <-time.After(waitRetryTime):
+		}
+	}
+}
+
+func (vd *vdiffController) pickSourceTablet(ctx context.Context, stream *vdiffStream) (*topodatapb.Tablet, error) {
+	cell := vd.options.SourceCell
+	if cell == "" {
+		cell = stream.cell
+	}
+	if cell == "" {
+		cell = vd.vre.cell
+	}
+	tabletTypes := vd.options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = stream.tabletTypes
+	}
+	if tabletTypes == "" {
+		tabletTypes = *tabletTypesStr
+	}
+	sourceTopo := vd.vre.ts
+	if stream.source.ExternalCluster != "" {
+		var err error
+		sourceTopo, err = sourceTopo.OpenExternalVitessClusterServer(ctx, stream.source.ExternalCluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tp, err := discovery.NewTabletPicker(sourceTopo, strings.Split(cell, ","), stream.source.Keyspace, stream.source.Shard, tabletTypes)
+	if err != nil {
+		return nil, err
+	}
+	return tp.PickForStreaming(ctx)
+}
+
+func (vd *vdiffController) chunkDuration() time.Duration {
+	if vd.options.ChunkDuration != 0 {
+		return vd.options.ChunkDuration
+	}
+	return *vdiffChunkDuration
+}
+
+func (vd *vdiffController) waitTime() time.Duration {
+	if vd.options.FilteredReplicationWaitTime != 0 {
+		return vd.options.FilteredReplicationWaitTime
+	}
+	return *vdiffWaitTime
+}
+
+// initVDiffs starts the vdiffs that were running when the engine closed.
+// It must be called with the lock held.
+func (vre *Engine) initVDiffs(ctx context.Context) {
+	dbClient := vre.dbClientFactoryFiltered()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("could not read vdiffs: %v", err)
+		return
+	}
+	defer dbClient.Close()
+	query := fmt.Sprintf("select * from _vt.vdiff where db_name=%s and state in (%s, %s)", encodeString(vre.dbName), encodeString(VDiffStatePending), encodeString(VDiffStateStarted))
+	qr, err := withDDL.ExecIgnore(ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		log.Errorf("could not read vdiffs: %v", err)
+		return
+	}
+	for i := range qr.Rows {
+		row, err := rowToMap(qr, i)
+		if err != nil {
+			log.Errorf("could not read vdiffs: %v", err)
+			return
+		}
+		vd, err := newVDiffController(vre.ctx, row, vre, nil)
+		if err != nil {
+			log.Errorf("vdiff could not be initialized: %v: %v", row, err)
+			continue
+		}
+		vre.vdiffs[vd.id] = vd
+	}
+}
+
+// stopVDiffs stops the running vdiffs. It must be called without the lock,
+// because the vdiffs restart their workflow through the engine.
+func (vre *Engine) stopVDiffs() {
+	vre.mu.Lock()
+	vdiffs := vre.vdiffs
+	vre.vdiffs = make(map[int]*vdiffController)
+	vre.mu.Unlock()
+	for _, vd := range vdiffs {
+		vd.Stop()
+	}
+}
+
+// execVDiff executes a statement on _vt.vdiff, and starts or stops the
+// affected vdiffs. The previous controller of a vdiff is not waited for,
+// because it restarts its workflow through the engine, which is locked.
+func (vre *Engine) execVDiff(plan *controllerPlan, dbClient binlogplayer.DBClient) (*sqltypes.Result, error) {
+	switch plan.opcode {
+	case vdiffInsertQuery:
+		qr, err := withDDL.Exec(vre.ctx, plan.query, dbClient.ExecuteFetch)
+		if err != nil {
+			return nil, err
+		}
+		if qr.InsertID == 0 {
+			return nil, fmt.Errorf("insert failed to generate an id")
+		}
+		for id := int(qr.InsertID); id < int(qr.InsertID)+plan.numInserts; id++ {
+			if err := vre.startVDiff(dbClient, id, nil); err != nil {
+				return nil, err
+			}
+		}
+		return qr, nil
+	case vdiffUpdateQuery:
+		ids, bv, err := vre.fetchIDs(dbClient, plan.selector)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return &sqltypes.Result{}, nil
+		}
+		previous := make(map[int]*vdiffController)
+		for _, id := range ids {
+			if vd := vre.vdiffs[id]; vd != nil {
+				vd.cancel()
+				previous[id] = vd
+				delete(vre.vdiffs, id)
+			}
+		}
+		query, err := plan.applier.GenerateQuery(bv, nil)
+		if err != nil {
+			return nil, err
+		}
+		qr, err := withDDL.Exec(vre.ctx, query, dbClient.ExecuteFetch)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if err := vre.startVDiff(dbClient, id, previous[id]); err != nil {
+				return nil, err
+			}
+		}
+		return qr, nil
+	case vdiffDeleteQuery:
+		ids, bv, err := vre.fetchIDs(dbClient, plan.selector)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return &sqltypes.Result{}, nil
+		}
+		for _, id := range ids {
+			if vd := vre.vdiffs[id]; vd != nil {
+				vd.cancel()
+				delete(vre.vdiffs, id)
+			}
+		}
+		if err := dbClient.Begin(); err != nil {
+			return nil, err
+		}
+		qr, err := vre.deleteVDiffs(dbClient, plan, bv)
+		if err != nil {
+			dbClient.Rollback()
+			return nil, err
+		}
+		if err := dbClient.Commit(); err != nil {
+			return nil, err
+		}
+		return qr, nil
+	}
+	panic("unreachable")
+}
+
+// deleteVDiffs deletes the vdiffs of the ids in bv and their tables, in
+// the transaction of dbClient.
+func (vre *Engine) deleteVDiffs(dbClient binlogplayer.DBClient, plan *controllerPlan, bv map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	query, err := plan.applier.GenerateQuery(bv, nil)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := withDDL.Exec(vre.ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	delQuery, err := plan.delVDiffTables.GenerateQuery(bv, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := withDDL.Exec(vre.ctx, delQuery, dbClient.ExecuteFetch); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// startVDiff starts a controller for a vdiff that is pending or started.
+func (vre *Engine) startVDiff(dbClient binlogplayer.DBClient, id int, previous *vdiffController) error {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf("select * from _vt.vdiff where id = %d", id), 10)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 {
+		return fmt.Errorf("unexpected number of rows: %v", qr)
+	}
+	row, err := rowToMap(qr, 0)
+	if err != nil {
+		return err
+	}
+	if state := row["state"]; state != VDiffStatePending && state != VDiffStateStarted {
+		return nil
+	}
+	vd, err := newVDiffController(vre.ctx, row, vre, previous)
+	if err != nil {
+		return err
+	}
+	vre.vdiffs[id] = vd
+	return nil
+}
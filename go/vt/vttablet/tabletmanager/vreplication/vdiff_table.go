@@ -0,0 +1,556 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// maxVDiffReportSampleRows is the maximum number of sample rows
+	// of every kind of difference in the report of a table.
+	maxVDiffReportSampleRows = 10
+	// vdiffTargetBatchSize is the number of rows read from the target at once.
+	vdiffTargetBatchSize = 10000
+)
+
+// VDiffTableReport is the result of the tablet vdiff of one table.
+// It is stored as JSON in the report column of _vt.vdiff_table.
+type VDiffTableReport struct {
+	TableName             string
+	ProcessedRows         int64
+	MatchingRows          int64
+	MismatchedRows        int64
+	ExtraRowsSource       int64
+	ExtraRowsSourceSample []VDiffRow `json:",omitempty"`
+	ExtraRowsTarget       int64
+	ExtraRowsTargetSample []VDiffRow       `json:",omitempty"`
+	MismatchedRowsSample  []*VDiffMismatch `json:",omitempty"`
+}
+
+// VDiffRow is a sample row of a VDiffTableReport. The values are SQL literals.
+type VDiffRow map[string]string
+
+// VDiffMismatch is a sample of a row that differs between the source and the target.
+type VDiffMismatch struct {
+	Source VDiffRow
+	Target VDiffRow
+}
+
+// vdiffTable is the plan to compare one table of the workflow.
+// The source and target queries select the same columns in the same order.
+type vdiffTable struct {
+	table string
+	// sourceQuery is sent to the source tablets. The row streamer
+	// evaluates its where clause, including in_keyrange.
+	sourceQuery string
+	// targetQuery selects the columns of the target table. The where and
+	// order by clauses are added for every batch.
+	targetQuery string
+	// columns are the names of the target columns.
+	columns []string
+	// sourceColumns are the names of the source columns, or "" for
+	// the expressions that are not a column.
+	sourceColumns []string
+	// pkCols are the indexes of the primary key columns of the target
+	// table in the select list, in the order of the primary key.
+	pkCols []int
+	// compareCols are the indexes of the other columns.
+	compareCols []int
+}
+
+// buildVDiffPlan builds the plans of the tables of the workflow, sorted by name.
+func buildVDiffPlan(filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, tablesToInclude []string) ([]*vdiffTable, error) {
+	var differs []*vdiffTable
+	for _, table := range schm.TableDefinitions {
+		if schema.IsInternalOperationTableName(table.Name) {
+			continue
+		}
+		rule, err := MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == "exclude" {
+			continue
+		}
+		if len(tablesToInclude) > 0 {
+			include := false
+			for _, t := range tablesToInclude {
+				if t == table.Name {
+					include = true
+					break
+				}
+			}
+			if !include {
+				continue
+			}
+		}
+		query := rule.Filter
+		switch {
+		case rule.Filter == "":
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+			query = buf.String()
+		case key.IsKeyRange(rule.Filter):
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table.Name), sqlparser.NewStrLiteral(rule.Filter))
+			query = buf.String()
+		}
+		td, err := buildVDiffTablePlan(table, query)
+		if err != nil {
+			return nil, err
+		}
+		differs = append(differs, td)
+	}
+	if len(tablesToInclude) > 0 && len(tablesToInclude) != len(differs) {
+		return nil, fmt.Errorf("one or more tables provided are not present in the workflow: %v", tablesToInclude)
+	}
+	sort.Slice(differs, func(i, j int) bool {
+		return differs[i].table < differs[j].table
+	})
+	return differs, nil
+}
+
+// buildVDiffTablePlan builds the plan of one table from the filter of its rule.
+func buildVDiffTablePlan(table *tabletmanagerdatapb.TableDefinition, query string) (*vdiffTable, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	if len(sel.GroupBy) != 0 {
+		return nil, fmt.Errorf("group by is not supported by the tablet vdiff: %v", sqlparser.String(sel))
+	}
+	td := &vdiffTable{
+		table: table.Name,
+	}
+	sourceSelect := &sqlparser.Select{}
+	targetSelect := &sqlparser.Select{}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				aliased := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, aliased)
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, aliased)
+				td.columns = append(td.columns, fld.Name)
+				td.sourceColumns = append(td.sourceColumns, fld.Name)
+			}
+		case *sqlparser.AliasedExpr:
+			var targetCol *sqlparser.ColName
+			if !selExpr.As.IsEmpty() {
+				targetCol = &sqlparser.ColName{Name: selExpr.As}
+			} else {
+				if colAs, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+					targetCol = colAs
+				} else {
+					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
+				}
+			}
+			if expr, ok := selExpr.Expr.(*sqlparser.FuncExpr); ok && expr.IsAggregate() {
+				return nil, fmt.Errorf("aggregate expressions are not supported by the tablet vdiff: %v", sqlparser.String(selExpr))
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+			td.columns = append(td.columns, targetCol.Name.String())
+			sourceColumn := ""
+			if col, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+				sourceColumn = col.Name.String()
+			}
+			td.sourceColumns = append(td.sourceColumns, sourceColumn)
+		default:
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+		}
+	}
+	for _, col := range td.columns {
+		found := false
+		for _, fld := range table.Fields {
+			if strings.EqualFold(fld.Name, col) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("column %v not found in table %v", col, table.Name)
+		}
+	}
+
+	isPK := make(map[int]bool)
+	for _, pk := range table.PrimaryKeyColumns {
+		found := false
+		for i, col := range td.columns {
+			if strings.EqualFold(pk, col) {
+				td.pkCols = append(td.pkCols, i)
+				isPK[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("primary key column %v of table %v is not selected by the workflow", pk, table.Name)
+		}
+	}
+	if len(td.pkCols) == 0 {
+		return nil, fmt.Errorf("table %v has no primary key", table.Name)
+	}
+	for i := range td.columns {
+		if !isPK[i] {
+			td.compareCols = append(td.compareCols, i)
+		}
+	}
+
+	sourceSelect.From = sel.From
+	sourceSelect.Where = sel.Where
+	targetSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: &sqlparser.TableName{
+				Name: sqlparser.NewTableIdent(table.Name),
+			},
+		},
+	}
+	td.sourceQuery = sqlparser.String(sourceSelect)
+	td.targetQuery = sqlparser.String(targetSelect)
+	return td, nil
+}
+
+// checkSourcePKs verifies that the source streams the rows in the
+// order of the primary key of the target.
+func (td *vdiffTable) checkSourcePKs(pkfields []*querypb.Field) error {
+	if len(pkfields) == len(td.pkCols) {
+		match := true
+		for i, pkfield := range pkfields {
+			if !strings.EqualFold(td.sourceColumns[td.pkCols[i]], pkfield.Name) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return nil
+		}
+	}
+	return fmt.Errorf("the primary key of the source table of %s does not match the primary key of the target table", td.table)
+}
+
+// compare compares the columns cols of a source and a target row.
+func (td *vdiffTable) compare(sourceRow, targetRow []sqltypes.Value, fields []*querypb.Field, cols []int) (int, error) {
+	for _, col := range cols {
+		c, err := compareVDiffValues(sourceRow[col], targetRow[col], collations.ID(fields[col].Charset))
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// compareVDiffValues compares text values with their collation,
+// which is the order of the rows streamed by mysql.
+func compareVDiffValues(v1, v2 sqltypes.Value, collationID collations.ID) (int, error) {
+	if v1.IsText() && v2.IsText() && collations.LookupByID(collationID) == nil {
+		return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
+	}
+	return evalengine.NullsafeCompare(v1, v2, collationID)
+}
+
+// sampleRow returns the sample of a row for the report.
+func (td *vdiffTable) sampleRow(row []sqltypes.Value, onlyPKs bool) VDiffRow {
+	sample := make(VDiffRow)
+	cols := td.pkCols
+	if !onlyPKs {
+		cols = append(append([]int{}, td.pkCols...), td.compareCols...)
+	}
+	for _, col := range cols {
+		buf := &strings.Builder{}
+		row[col].EncodeSQL(buf)
+		sample[td.columns[col]] = buf.String()
+	}
+	return sample
+}
+
+// lastPK returns the lastpk of the row streamer for a row.
+func (td *vdiffTable) lastPK(pkfields []*querypb.Field, row []sqltypes.Value) *querypb.QueryResult {
+	values := make([]sqltypes.Value, 0, len(td.pkCols))
+	for _, col := range td.pkCols {
+		values = append(values, row[col])
+	}
+	return &querypb.QueryResult{
+		Fields: pkfields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(values)},
+	}
+}
+
+// targetBatchQuery returns the query that reads the next batch of
+// rows of the target after lastpk.
+func (td *vdiffTable) targetBatchQuery(lastpk []sqltypes.Value) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString(td.targetQuery)
+	if len(lastpk) != 0 {
+		// As in the row streamer, if lastpk was (1,2), the where clause is:
+		// (col1 = 1 and col2 > 2) or (col1 > 1).
+		buf.WriteString(" where ")
+		prefix := ""
+		for lastcol := len(td.pkCols) - 1; lastcol >= 0; lastcol-- {
+			buf.Myprintf("%s(", prefix)
+			prefix = " or "
+			for i, pk := range td.pkCols[:lastcol] {
+				buf.Myprintf("%v = ", sqlparser.NewColIdent(td.columns[pk]))
+				lastpk[i].EncodeSQL(buf)
+				buf.Myprintf(" and ")
+			}
+			buf.Myprintf("%v > ", sqlparser.NewColIdent(td.columns[td.pkCols[lastcol]]))
+			lastpk[lastcol].EncodeSQL(buf)
+			buf.Myprintf(")")
+		}
+	}
+	buf.Myprintf(" order by ")
+	prefix := ""
+	for _, pk := range td.pkCols {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(td.columns[pk]))
+		prefix = ", "
+	}
+	fmt.Fprintf(buf, " limit %d", vdiffTargetBatchSize)
+	return buf.String()
+}
+
+// vdiffSourceStream streams the rows of a table from one source tablet.
+type vdiffSourceStream struct {
+	fields   []*querypb.Field
+	pkfields []*querypb.Field
+	gtid     string
+
+	cancel context.CancelFunc
+	// responses is closed when the stream ends. err is set before.
+	responses chan *binlogdatapb.VStreamRowsResponse
+	err       error
+	rows      []*querypb.Row
+}
+
+func newVDiffSourceStream(ctx context.Context, tablet *topodatapb.Tablet, query string, lastpk *querypb.QueryResult) *vdiffSourceStream {
+	source := &vdiffSourceStream{
+		responses: make(chan *binlogdatapb.VStreamRowsResponse, 1),
+	}
+	ctx, source.cancel = context.WithCancel(ctx)
+	go func() {
+		defer close(source.responses)
+		source.err = func() error {
+			vsClient := newTabletConnector(tablet)
+			if err := vsClient.Open(ctx); err != nil {
+				return err
+			}
+			defer vsClient.Close(ctx)
+			return vsClient.VStreamRows(ctx, query, lastpk, func(rows *binlogdatapb.VStreamRowsResponse) error {
+				select {
+				case source.responses <- rows:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+		}()
+	}()
+	return source
+}
+
+// waitForFields waits for the fields, and the gtid of the snapshot of the stream.
+func (source *vdiffSourceStream) waitForFields(ctx context.Context) error {
+	select {
+	case rows, ok := <-source.responses:
+		if !ok {
+			if source.err != nil {
+				return source.err
+			}
+			return fmt.Errorf("stream ended before sending the fields")
+		}
+		if len(rows.Fields) == 0 {
+			return fmt.Errorf("expecting field event first, got: %v", rows)
+		}
+		source.fields, source.pkfields, source.gtid = rows.Fields, rows.Pkfields, rows.Gtid
+		source.rows = rows.Rows
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// next returns the next row, or nil at the end of the stream.
+func (source *vdiffSourceStream) next() ([]sqltypes.Value, error) {
+	for len(source.rows) == 0 {
+		rows, ok := <-source.responses
+		if !ok {
+			return nil, source.err
+		}
+		source.rows = rows.Rows
+	}
+	row := sqltypes.MakeRowTrusted(source.fields, source.rows[0])
+	source.rows = source.rows[1:]
+	return row, nil
+}
+
+// stop ends the stream and waits for it to exit.
+func (source *vdiffSourceStream) stop() {
+	source.cancel()
+	for range source.responses {
+	}
+}
+
+// vdiffMerger merges the rows of the source streams in the order of the primary key.
+type vdiffMerger struct {
+	td      *vdiffTable
+	fields  []*querypb.Field
+	sources []*vdiffSourceStream
+	heads   [][]sqltypes.Value
+	current int
+}
+
+func newVDiffMerger(td *vdiffTable, fields []*querypb.Field, sources []*vdiffSourceStream) (*vdiffMerger, error) {
+	merger := &vdiffMerger{
+		td:      td,
+		fields:  fields,
+		sources: sources,
+		heads:   make([][]sqltypes.Value, len(sources)),
+	}
+	for i, source := range sources {
+		row, err := source.next()
+		if err != nil {
+			return nil, err
+		}
+		merger.heads[i] = row
+	}
+	return merger, merger.pick()
+}
+
+// pick selects the source with the lowest primary key.
+func (merger *vdiffMerger) pick() error {
+	merger.current = -1
+	for i, row := range merger.heads {
+		if row == nil {
+			continue
+		}
+		if merger.current >= 0 {
+			c, err := merger.td.compare(row, merger.heads[merger.current], merger.fields, merger.td.pkCols)
+			if err != nil {
+				return err
+			}
+			if c >= 0 {
+				continue
+			}
+		}
+		merger.current = i
+	}
+	return nil
+}
+
+// peek returns the current row, or nil once all sources are exhausted.
+func (merger *vdiffMerger) peek() []sqltypes.Value {
+	if merger.current < 0 {
+		return nil
+	}
+	return merger.heads[merger.current]
+}
+
+// advance moves past the current row.
+func (merger *vdiffMerger) advance() error {
+	row, err := merger.sources[merger.current].next()
+	if err != nil {
+		return err
+	}
+	merger.heads[merger.current] = row
+	return merger.pick()
+}
+
+// vdiffTargetReader reads the rows of a table from the target in batches,
+// in a consistent snapshot.
+type vdiffTargetReader struct {
+	dbClient binlogplayer.DBClient
+	td       *vdiffTable
+	lastpk   []sqltypes.Value
+	rows     [][]sqltypes.Value
+	done     bool
+}
+
+// newVDiffTargetReader starts the snapshot, after the rows at or before lastpk.
+func newVDiffTargetReader(dbClientFactory func() binlogplayer.DBClient, td *vdiffTable, lastpk *querypb.QueryResult) (*vdiffTargetReader, error) {
+	target := &vdiffTargetReader{
+		dbClient: dbClientFactory(),
+		td:       td,
+	}
+	if lastpk != nil && len(lastpk.Rows) == 1 {
+		target.lastpk = sqltypes.MakeRowTrusted(lastpk.Fields, lastpk.Rows[0])
+	}
+	if err := target.dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	if _, err := target.dbClient.ExecuteFetch("start transaction with consistent snapshot", 0); err != nil {
+		target.dbClient.Close()
+		return nil, err
+	}
+	return target, nil
+}
+
+// next returns the next row, or nil at the end of the table.
+func (target *vdiffTargetReader) next() ([]sqltypes.Value, error) {
+	if len(target.rows) == 0 && !target.done {
+		qr, err := target.dbClient.ExecuteFetch(target.td.targetBatchQuery(target.lastpk), vdiffTargetBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		target.rows = qr.Rows
+		target.done = len(qr.Rows) < vdiffTargetBatchSize
+		if len(qr.Rows) != 0 {
+			last := qr.Rows[len(qr.Rows)-1]
+			target.lastpk = make([]sqltypes.Value, 0, len(target.td.pkCols))
+			for _, col := range target.td.pkCols {
+				target.lastpk = append(target.lastpk, last[col])
+			}
+		}
+	}
+	if len(target.rows) == 0 {
+		return nil, nil
+	}
+	row := target.rows[0]
+	target.rows = target.rows[1:]
+	return row, nil
+}
+
+func (target *vdiffTargetReader) close() {
+	target.dbClient.Rollback()
+	target.dbClient.Close()
+}
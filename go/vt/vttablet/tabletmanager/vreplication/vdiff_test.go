@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestBuildVDiffPlan(t *testing.T) {
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|varchar"),
+		}, {
+			Name:              "t2",
+			Columns:           []string{"c1", "c2", "c3"},
+			PrimaryKeyColumns: []string{"c2", "c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|int64|varchar"),
+		}, {
+			Name:              "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+			Columns:           []string{"c1"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1", "int64"),
+		}},
+	}
+	testcases := []struct {
+		filter        *binlogdatapb.Filter
+		tables        []string
+		sourceQueries []string
+		targetQueries []string
+		err           string
+	}{{
+		filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/.*", Filter: "-80"}}},
+		sourceQueries: []string{
+			"select c1, c2 from t1 where in_keyrange('-80')",
+			"select c1, c2, c3 from t2 where in_keyrange('-80')",
+		},
+		targetQueries: []string{
+			"select c1, c2 from t1",
+			"select c1, c2, c3 from t2",
+		},
+	}, {
+		filter:        &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/.*"}}},
+		tables:        []string{"t2"},
+		sourceQueries: []string{"select c1, c2, c3 from t2"},
+		targetQueries: []string{"select c1, c2, c3 from t2"},
+	}, {
+		filter:        &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select c1, c3 as c2 from src where in_keyrange(c1, 'hash', '-80')"}}},
+		sourceQueries: []string{"select c1, c3 as c2 from src where in_keyrange(c1, 'hash', '-80')"},
+		targetQueries: []string{"select c1, c2 from t1"},
+	}, {
+		filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select c2 from t1"}}},
+		err:    "primary key column c1 of table t1 is not selected by the workflow",
+	}, {
+		filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select c1, count(*) as c2 from t1 group by c1"}}},
+		err:    "group by is not supported by the tablet vdiff: select c1, count(*) as c2 from t1 group by c1",
+	}, {
+		filter: &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/.*"}}},
+		tables: []string{"t3"},
+		err:    "one or more tables provided are not present in the workflow: [t3]",
+	}}
+	for _, tcase := range testcases {
+		differs, err := buildVDiffPlan(tcase.filter, schm, tcase.tables)
+		if tcase.err != "" {
+			assert.EqualError(t, err, tcase.err)
+			continue
+		}
+		require.NoError(t, err)
+		var sourceQueries, targetQueries []string
+		for _, td := range differs {
+			sourceQueries = append(sourceQueries, td.sourceQuery)
+			targetQueries = append(targetQueries, td.targetQuery)
+		}
+		assert.Equal(t, tcase.sourceQueries, sourceQueries)
+		assert.Equal(t, tcase.targetQueries, targetQueries)
+	}
+}
+
+func TestVDiffTable(t *testing.T) {
+	differs, err := buildVDiffPlan(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t2"}}}, &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t2",
+			Columns:           []string{"c1", "c2", "c3"},
+			PrimaryKeyColumns: []string{"c2", "c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|int64|varchar"),
+		}},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, differs, 1)
+	td := differs[0]
+	assert.Equal(t, []int{1, 0}, td.pkCols)
+	assert.Equal(t, []int{2}, td.compareCols)
+
+	// The source must stream the rows in the order of the target primary key.
+	assert.NoError(t, td.checkSourcePKs(sqltypes.MakeTestFields("c2|c1", "int64|int64")))
+	assert.EqualError(t, td.checkSourcePKs(sqltypes.MakeTestFields("c1", "int64")), "the primary key of the source table of t2 does not match the primary key of the target table")
+
+	assert.Equal(t, "select c1, c2, c3 from t2 order by c2, c1 limit 10000", td.targetBatchQuery(nil))
+	lastpk := []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(1)}
+	assert.Equal(t, "select c1, c2, c3 from t2 where (c2 = 2 and c1 > 1) or (c2 > 2) order by c2, c1 limit 10000", td.targetBatchQuery(lastpk))
+
+	fields := sqltypes.MakeTestFields("c1|c2|c3", "int64|int64|varchar")
+	fields[2].Charset = uint32(collations.LookupByName("utf8mb4_general_ci").ID())
+	row := func(c1, c2 int64, c3 string) []sqltypes.Value {
+		return []sqltypes.Value{sqltypes.NewInt64(c1), sqltypes.NewInt64(c2), sqltypes.NewVarChar(c3)}
+	}
+	c, err := td.compare(row(1, 2, "a"), row(2, 1, "a"), fields, td.pkCols)
+	require.NoError(t, err)
+	assert.Equal(t, 1, c)
+	// Text columns are compared with their collation.
+	c, err = td.compare(row(1, 2, "abc"), row(1, 2, "ABC"), fields, td.compareCols)
+	require.NoError(t, err)
+	assert.Equal(t, 0, c)
+	c, err = td.compare(row(1, 2, "abc"), row(1, 2, "abd"), fields, td.compareCols)
+	require.NoError(t, err)
+	assert.Equal(t, -1, c)
+
+	assert.Equal(t, VDiffRow{"c1": "1", "c2": "2", "c3": "'a'"}, td.sampleRow(row(1, 2, "a"), false))
+	assert.Equal(t, VDiffRow{"c1": "1", "c2": "2"}, td.sampleRow(row(1, 2, "a"), true))
+
+	pkfields := sqltypes.MakeTestFields("c2|c1", "int64|int64")
+	assert.Equal(t, &querypb.QueryResult{
+		Fields: pkfields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(1)})},
+	}, td.lastPK(pkfields, row(1, 2, "a")))
+}
+
+func TestVDiffRestartWorkflow(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	// The engine is closed, so the streams are updated through dbClient.
+	vd := &vdiffController{
+		vre:      NewTestEngine(nil, "", nil, nil, nil, "db", nil),
+		uuid:     "a",
+		workflow: "wf",
+	}
+	streams := []*vdiffStream{{id: 1, state: "Running"}, {id: 2, state: "Stopped"}}
+
+	dbClient.ExpectRequest("update _vt.vreplication set state='Running', message='', stop_pos='' where id=1", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vreplication set state='Stopped', message='', stop_pos='' where id=2", &sqltypes.Result{}, nil)
+	require.NoError(t, vd.restartWorkflow(dbClient, streams))
+	dbClient.Wait()
+}
@@ -42,7 +42,7 @@ type vplayer struct {
 	startPos  mysql.Position
 	stopPos   mysql.Position
 	saveStop  bool
-	copyState map[string]*sqltypes.Result
+	copyState map[string][]*copyRange
 
 	replicatorPlan *ReplicatorPlan
 	tablePlans     map[string]*TablePlan
@@ -77,7 +77,7 @@ type vplayer struct {
 //   replication is only applied to parts that have been copied so far.
 // pausePos: if set, replication will stop at that position without updating the state to "Stopped".
 //   This is used by the fastForward function during copying.
-func newVPlayer(vr *vreplicator, settings binlogplayer.VRSettings, copyState map[string]*sqltypes.Result, pausePos mysql.Position, phase string) *vplayer {
+func newVPlayer(vr *vreplicator, settings binlogplayer.VRSettings, copyState map[string][]*copyRange, pausePos mysql.Position, phase string) *vplayer {
 	saveStop := true
 	if !pausePos.IsZero() {
 		settings.StopPos = pausePos
@@ -208,11 +208,19 @@ func (vp *vplayer) applyStmtEvent(ctx context.Context, event *binlogdatapb.VEven
 	return fmt.Errorf("filter rules are not supported for SBR replication: %v", vp.vr.source.Filter.GetRules())
 }
 
-func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.RowEvent) error {
+func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.RowEvent, timestamp int64) error {
 	tplan := vp.tablePlans[rowEvent.TableName]
 	if tplan == nil {
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
+	if vp.vr.sink != nil {
+		for _, change := range rowEvent.RowChanges {
+			if err := tplan.applySinkChange(change, timestamp, vp.vr.sink); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	for _, change := range rowEvent.RowChanges {
 		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
 			stats := NewVrLogStats("ROWCHANGE")
@@ -232,6 +240,12 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 
 func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 	vp.numAccumulatedHeartbeats = 0
+	// The events of a sink must be durable before the position is saved.
+	if vp.vr.sink != nil {
+		if err := vp.vr.sink.Flush(); err != nil {
+			return false, fmt.Errorf("error %v flushing sink", err)
+		}
+	}
 	update := binlogplayer.GenerateUpdatePos(vp.vr.id, vp.pos, time.Now().Unix(), ts, vp.vr.stats.CopyRowCount.Get(), *vreplicationStoreCompressedGTID)
 	if _, err := vp.vr.dbClient.Execute(update); err != nil {
 		return false, fmt.Errorf("error %v updating position", err)
@@ -474,6 +488,11 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err != nil {
 			return err
 		}
+		if vp.vr.sink != nil {
+			if err := tplan.buildSinkColumns(); err != nil {
+				return err
+			}
+		}
 		vp.tablePlans[event.FieldEvent.TableName] = tplan
 		stats.Send(fmt.Sprintf("%v", event.FieldEvent))
 
@@ -500,7 +519,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err := vp.vr.dbClient.Begin(); err != nil {
 			return err
 		}
-		if err := vp.applyRowEvent(ctx, event.RowEvent); err != nil {
+		if err := vp.applyRowEvent(ctx, event.RowEvent, event.Timestamp); err != nil {
 			return err
 		}
 		//Row event is logged AFTER RowChanges are applied so as to calculate the total elapsed time for the Row event
@@ -52,6 +52,11 @@ var (
 	copyPhaseDuration   = flag.Duration("vreplication_copy_phase_duration", 1*time.Hour, "Duration for each copy phase loop (before running the next catchup: default 1h)")
 	replicaLagTolerance = flag.Duration("vreplication_replica_lag_tolerance", 1*time.Minute, "Replica lag threshold duration: once lag is below this we switch from copy phase to the replication (streaming) phase")
 
+	// copyPhaseParallelism is the number of tables, or ranges of tables, that are copied concurrently.
+	copyPhaseParallelism = flag.Int("vreplication_copy_phase_parallelism", 1, "Number of tables, or primary key ranges of tables, copied concurrently during the copy phase")
+	// copyPhaseTableRanges is the number of primary key ranges a table is split into if copied concurrently.
+	copyPhaseTableRanges = flag.Int("vreplication_copy_phase_table_ranges", 1, "Number of primary key ranges each table is split into during the copy phase, if vreplication_copy_phase_parallelism allows it. Only tables with a single integral primary key column are split")
+
 	// vreplicationHeartbeatUpdateInterval determines how often the time_updated column is updated if there are no real events on the source and the source
 	// vstream is only sending heartbeats for this long. Keep this low if you expect high QPS and are monitoring this column to alert about potential
 	// outages. Keep this high if
@@ -82,6 +87,9 @@ type vreplicator struct {
 	// mysqld is used to fetch the local schema.
 	mysqld     mysqlctl.MysqlDaemon
 	colInfoMap map[string][]*ColumnInfo
+	// sink is set if the rows are written to a sink
+	// instead of the tables of the local database.
+	sink Sink
 
 	originalFKCheckSetting int64
 }
@@ -107,6 +115,8 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
+// If the source has a Sink, the rows are written to it instead of the
+// tables of the local database. See the Sink interface for more info.
 func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
 	if *vreplicationHeartbeatUpdateInterval > vreplicationMinimumHeartbeatUpdateInterval {
 		log.Warningf("the supplied value for vreplication_heartbeat_update_interval:%d seconds is larger than the maximum allowed:%d seconds, vreplication will fallback to %d",
@@ -156,11 +166,42 @@ func (vr *vreplicator) Replicate(ctx context.Context) error {
 }
 
 func (vr *vreplicator) replicate(ctx context.Context) error {
+	if vr.source.Sink != nil {
+		return vr.replicateToSink(ctx)
+	}
 	colInfo, err := vr.buildColInfoMap(ctx)
 	if err != nil {
 		return err
 	}
 	vr.colInfoMap = colInfo
+	return vr.replicatePhases(ctx)
+}
+
+// replicateToSink replicates the stream to its sink. The phases are the
+// same as for the local database, but the rows are written to the sink.
+// The sink is flushed every time the position or the copy state is saved,
+// right before the transaction that saves them is committed.
+func (vr *vreplicator) replicateToSink(ctx context.Context) (err error) {
+	colInfo, err := sinkColInfoMap(vr.source.Filter)
+	if err != nil {
+		return err
+	}
+	vr.colInfoMap = colInfo
+	sink, err := newSink(ctx, vr.id, vr.source)
+	if err != nil {
+		return err
+	}
+	vr.sink = sink
+	defer func() {
+		if closeErr := sink.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		vr.sink = nil
+	}()
+	return vr.replicatePhases(ctx)
+}
+
+func (vr *vreplicator) replicatePhases(ctx context.Context) error {
 	if err := vr.getSettingFKCheck(); err != nil {
 		return err
 	}
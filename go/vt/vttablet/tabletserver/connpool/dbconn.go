@@ -54,6 +54,10 @@ type DBConn struct {
 	stats   *tabletenv.Stats
 	current sync2.AtomicString
 
+	// tracksGtids is set once the connection returns the GTIDs of its
+	// transactions in the OK packets.
+	tracksGtids bool
+
 	// err will be set if a query is killed through a Kill.
 	errmu sync.Mutex
 	err   error
@@ -172,6 +176,40 @@ func (dbc *DBConn) ExecOnce(ctx context.Context, query string, maxrows int, want
 	return dbc.execOnce(ctx, query, maxrows, wantfields)
 }
 
+// TrackGtids makes MySQL return the GTID of each transaction committed
+// by the connection in the session state changes of its OK packet. It
+// does nothing if the connection doesn't have the CLIENT_SESSION_TRACK
+// capability, see db_flags and TracksGtids.
+func (dbc *DBConn) TrackGtids(ctx context.Context) error {
+	if dbc.tracksGtids || dbc.conn.Capabilities&mysql.CapabilityClientSessionTrack == 0 {
+		return nil
+	}
+	if _, err := dbc.execOnce(ctx, trackOwnGtids, 1, false); err != nil {
+		return err
+	}
+	dbc.tracksGtids = true
+	return nil
+}
+
+// TracksGtids returns true if MySQL returns the GTIDs of the transactions
+// of the connection.
+func (dbc *DBConn) TracksGtids() bool {
+	return dbc.tracksGtids
+}
+
+// ExecutedGtids returns the GTID set executed by MySQL. It includes the
+// GTIDs of the transactions committed by the connection.
+func (dbc *DBConn) ExecutedGtids(ctx context.Context) (string, error) {
+	qr, err := dbc.execOnce(ctx, selectExecutedGtids, 1, false)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s: %v", selectExecutedGtids, qr.Rows)
+	}
+	return strings.ReplaceAll(qr.Rows[0][0].ToString(), "\n", ""), nil
+}
+
 // FetchNext returns the next result set.
 func (dbc *DBConn) FetchNext(ctx context.Context, maxrows int, wantfields bool) (*sqltypes.Result, error) {
 	// Check if the context is already past its deadline before
@@ -260,9 +298,11 @@ func (dbc *DBConn) streamOnce(ctx context.Context, query string, callback func(*
 }
 
 var (
-	getModeSQL    = "select @@global.sql_mode"
-	getAutocommit = "select @@autocommit"
-	getAutoIsNull = "select @@sql_auto_is_null"
+	getModeSQL          = "select @@global.sql_mode"
+	getAutocommit       = "select @@autocommit"
+	getAutoIsNull       = "select @@sql_auto_is_null"
+	trackOwnGtids       = "set @@session.session_track_gtids = 'OWN_GTID'"
+	selectExecutedGtids = "select @@global.gtid_executed"
 )
 
 // VerifyMode is a helper method to verify mysql is running with
@@ -391,6 +431,7 @@ func (dbc *DBConn) reconnect(ctx context.Context) error {
 	dbc.err = nil
 	dbc.errmu.Unlock()
 	dbc.conn = newConn
+	dbc.tracksGtids = false
 	return nil
 }
 
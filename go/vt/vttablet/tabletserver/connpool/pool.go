@@ -57,6 +57,15 @@ type Pool struct {
 	waiterCount        sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+	admission          Admission
+}
+
+// Admission controls the access of a Pool to a resource that it shares
+// with other pools. Acquire is called before a connection is taken from
+// the pool, and Release once it is put back.
+type Admission interface {
+	Acquire(ctx context.Context) error
+	Release()
 }
 
 // NewPool creates a new Pool. The name is used
@@ -89,6 +98,12 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 	return cp
 }
 
+// SetAdmission sets the Admission of the pool.
+// It must be called before Open.
+func (cp *Pool) SetAdmission(admission Admission) {
+	cp.admission = admission
+}
+
 func (cp *Pool) pool() (p *pools.ResourcePool) {
 	cp.mu.Lock()
 	p = cp.connections
@@ -171,8 +186,16 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 		ctx, cancel = context.WithTimeout(ctx, cp.timeout)
 		defer cancel()
 	}
+	if cp.admission != nil {
+		if err := cp.admission.Acquire(ctx); err != nil {
+			return nil, err
+		}
+	}
 	r, err := p.Get(ctx)
 	if err != nil {
+		if cp.admission != nil {
+			cp.admission.Release()
+		}
 		return nil, err
 	}
 	return r.(*DBConn), nil
@@ -184,6 +207,9 @@ func (cp *Pool) Put(conn *DBConn) {
 	if p == nil {
 		panic(ErrConnPoolClosed)
 	}
+	if cp.admission != nil {
+		defer cp.admission.Release()
+	}
 	if conn == nil {
 		p.Put(nil)
 	} else {
@@ -79,6 +79,12 @@ type Controller interface {
 	// SetQueryRules sets the query rules for this QueryService
 	SetQueryRules(ruleSource string, qrs *rules.Rules) error
 
+	// QueryQuarantines returns the queries currently quarantined
+	QueryQuarantines() []*tabletenv.QuarantinedQuery
+
+	// ClearQueryQuarantines releases quarantined queries
+	ClearQueryQuarantines(fingerprint string) int
+
 	// QueryService returns the QueryService object used by this Controller
 	QueryService() queryservice.QueryService
 
@@ -119,8 +119,9 @@ type QueryEngine struct {
 	queryRuleSources *rules.Map
 
 	// Pools
-	conns       *connpool.Pool
-	streamConns *connpool.Pool
+	conns         *connpool.Pool
+	streamConns   *connpool.Pool
+	workloadPools *workloadPools
 
 	// Services
 	consolidator       *sync2.Consolidator
@@ -131,6 +132,9 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	// quarantine installs the rules that reject or throttle the
+	// queries that repeatedly time out or run too long.
+	quarantine *queryQuarantine
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -177,6 +181,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
+	qe.workloadPools = newWorkloadPools(env)
 	qe.consolidatorMode.Set(config.Consolidator)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
@@ -184,6 +189,10 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
 	}
 	qe.txSerializer = txserializer.New(env)
+	qe.quarantine = newQueryQuarantine(env, qe.setQuarantineRules)
+	if qe.quarantine.enabled() {
+		qe.queryRuleSources.RegisterSource(QueryQuarantineRules)
+	}
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -233,6 +242,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	env.Exporter().HandleFunc("/debug/query_rules", qe.handleHTTPQueryRules)
 	env.Exporter().HandleFunc("/debug/consolidations", qe.handleHTTPConsolidations)
 	env.Exporter().HandleFunc("/debug/acl", qe.handleHTTPAclJSON)
+	env.Exporter().HandleFunc("/debug/query_quarantine", qe.quarantine.ServeHTTP)
 
 	return qe
 }
@@ -262,6 +272,7 @@ func (qe *QueryEngine) Open() error {
 	}
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	qe.workloadPools.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
 	qe.isOpen = true
 	return nil
@@ -278,6 +289,7 @@ func (qe *QueryEngine) Close() {
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
 	qe.tables = make(map[string]*schema.Table)
+	qe.workloadPools.Close()
 	qe.streamConns.Close()
 	qe.conns.Close()
 	qe.isOpen = false
@@ -368,6 +380,15 @@ func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 	return plan, nil
 }
 
+// setQuarantineRules installs the rules of the quarantined queries.
+func (qe *QueryEngine) setQuarantineRules(qrs *rules.Rules) error {
+	if err := qe.queryRuleSources.SetRules(QueryQuarantineRules, qrs); err != nil {
+		return err
+	}
+	qe.ClearQueryPlanCache()
+	return nil
+}
+
 // ClearQueryPlanCache should be called if query plan cache is potentially obsolete
 func (qe *QueryEngine) ClearQueryPlanCache() {
 	qe.plans.Clear()
@@ -497,13 +518,18 @@ func (qe *QueryEngine) handleHTTPQueryRules(response http.ResponseWriter, reques
 		return
 	}
 	response.Header().Set("Content-Type", "application/json; charset=utf-8")
-	b, err := json.MarshalIndent(qe.queryRuleSources, "", " ")
+	rulesJSON, err := qe.queryRuleSources.MarshalJSONWithStats()
 	if err != nil {
 		response.Write([]byte(err.Error()))
 		return
 	}
+	var b bytes.Buffer
+	if err := json.Indent(&b, rulesJSON, "", " "); err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
 	buf := bytes.NewBuffer(nil)
-	json.HTMLEscape(buf, b)
+	json.HTMLEscape(buf, b.Bytes())
 	response.Write(buf.Bytes())
 }
 
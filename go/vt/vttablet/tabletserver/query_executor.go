@@ -19,6 +19,7 @@ package tabletserver
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -59,6 +60,14 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// olapPool is set by a query rule to execute
+	// the query with the OLAP connection pool. Only the
+	// pool changes: the timeout and the result size limits
+	// of the query are the ones of its own workload.
+	olapPool bool
+	// workloadPool is the workload pool the query is assigned to, if any.
+	workloadPool *connpool.Pool
 }
 
 const streamRowsSize = 256
@@ -92,6 +101,11 @@ var sequenceFields = []*querypb.Field{
 }
 
 func (qre *QueryExecutor) shouldConsolidate() bool {
+	// A read after a write must not reuse the result of a query
+	// that may have started before the write was replicated.
+	if qre.options.GetReadAfterWriteGtid() != "" {
+		return false
+	}
 	cm := qre.tsv.qe.consolidatorMode.Get()
 	return cm == tabletenv.Enable || (cm == tabletenv.NotOnMaster && qre.tabletType != topodatapb.TabletType_MASTER)
 }
@@ -111,6 +125,11 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			tableName = "Join"
 		}
 
+		// Only the queries that reached MySQL are accountable for their
+		// timeouts, not the ones that timed out waiting for a connection.
+		timedOut := err != nil && mysqlTime > 0 && qre.ctx.Err() == context.DeadlineExceeded
+		qre.tsv.qe.quarantine.Record(qre.query, mysqlTime, timedOut)
+
 		if reply == nil {
 			qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, 0, 1)
 			qre.plan.AddStats(1, duration, mysqlTime, 0, 0, 1)
@@ -126,6 +145,14 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	release, err := qre.applyRules()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if qre.workloadPool, err = qre.tsv.qe.workloadPools.Get(qre.ctx, qre.marginComments); err != nil {
+		return nil, err
+	}
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -221,9 +248,14 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*s
 	}
 
 	defer qre.logStats.AddRewrittenSQL("commit", time.Now())
-	if _, err := qre.tsv.te.txPool.Commit(qre.ctx, conn); err != nil {
+	_, sessionStateChanges, err := qre.tsv.te.txPool.Commit(qre.ctx, conn)
+	if err != nil {
 		return nil, err
 	}
+	// In autocommit mode, the result of the statement already has its GTID.
+	if result != nil && sessionStateChanges != "" {
+		result.SessionStateChanges = sessionStateChanges
+	}
 	return result, nil
 }
 
@@ -265,17 +297,30 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 }
 
 // Stream performs a streaming query execution.
-func (qre *QueryExecutor) Stream(callback StreamCallback) error {
+func (qre *QueryExecutor) Stream(callback StreamCallback) (err error) {
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
 	defer func(start time.Time) {
 		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
+
+		// Streaming queries are expected to run long, so only their
+		// timeouts count toward their quarantine, not their duration.
+		timedOut := err != nil && qre.logStats.MysqlResponseTime > 0 && qre.ctx.Err() == context.DeadlineExceeded
+		qre.tsv.qe.quarantine.Record(qre.query, 0, timedOut)
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	release, err := qre.applyRules()
+	if err != nil {
+		return err
+	}
+	defer release()
+	if qre.workloadPool, err = qre.tsv.qe.workloadPools.Get(qre.ctx, qre.marginComments); err != nil {
+		return err
+	}
 
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -353,6 +398,11 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	release, err := qre.applyRules()
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
 		select {
@@ -369,15 +419,19 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 	return nil
 }
 
-// checkPermissions returns an error if the query does not pass all checks
-// (query blacklisting, table ACL).
-func (qre *QueryExecutor) checkPermissions() error {
-	// Skip permissions check if the context is local.
+// applyRules performs the action of the first query rule that fires
+// for the query: it returns an error if the query is blacklisted or
+// exceeds a limit, delays it, or overrides its workload and timeout.
+// The returned function must be called once the query is done.
+// It's called after checkPermissions, so that the queries denied by
+// the table ACL don't count toward the limits of the rules.
+func (qre *QueryExecutor) applyRules() (release func(), err error) {
+	release = func() {}
+	// Skip the rules if the context is local.
 	if tabletenv.IsLocalContext(qre.ctx) {
-		return nil
+		return release, nil
 	}
 
-	// Check if the query is blacklisted.
 	remoteAddr := ""
 	username := ""
 	ci, ok := callinfo.FromContext(qre.ctx)
@@ -385,12 +439,54 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
-	switch action {
+	qr := qre.plan.Rules.GetRule(remoteAddr, username, qre.bindVars)
+	if qr == nil {
+		return release, nil
+	}
+	switch qr.Action() {
 	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
 	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+	case rules.QRRateLimit:
+		if !qr.AllowRequest(remoteAddr, username) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limit exceeded due to rule: %s", qr.Description)
+		}
+	case rules.QRConcurrencyLimit:
+		if !qr.AcquireConcurrency() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "concurrency limit exceeded due to rule: %s", qr.Description)
+		}
+		release = qr.ReleaseConcurrency
+	case rules.QRDelay:
+		tmr := time.NewTimer(qr.Delay())
+		defer tmr.Stop()
+		select {
+		case <-tmr.C:
+		case <-qre.ctx.Done():
+			return nil, vterrors.Wrapf(qre.ctx.Err(), "query delayed due to rule: %s", qr.Description)
+		}
+	case rules.QROverride:
+		qre.olapPool = qr.Workload() == querypb.ExecuteOptions_OLAP
+		if qr.Timeout() != 0 {
+			// The timeout can only be shortened: the deadline
+			// of the request context still applies.
+			qre.ctx, release = context.WithTimeout(qre.ctx, qr.Timeout())
+		}
+	}
+	return release, nil
+}
+
+// checkPermissions returns an error if the query does not pass the table ACL.
+func (qre *QueryExecutor) checkPermissions() error {
+	// Skip permissions check if the context is local.
+	if tabletenv.IsLocalContext(qre.ctx) {
+		return nil
+	}
+
+	username := ""
+	ci, ok := callinfo.FromContext(qre.ctx)
+	if ok {
+		username = ci.Username()
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -653,15 +749,53 @@ func (qre *QueryExecutor) execOther() (*sqltypes.Result, error) {
 	return qre.execDBConn(conn, qre.query, true)
 }
 
+// waitForReadAfterWrite waits until MySQL executed the GTID set of the
+// read_after_write_gtid option, so that a read outside of a transaction
+// sees the writes that vtgate sent to the primary before it. It waits on
+// the connection that executes the read.
+func (qre *QueryExecutor) waitForReadAfterWrite(conn *connpool.DBConn) error {
+	gtid := qre.options.GetReadAfterWriteGtid()
+	if gtid == "" || qre.connID != 0 {
+		return nil
+	}
+	timeout := qre.options.GetReadAfterWriteTimeout()
+	if timeout <= 0 {
+		timeout = 1
+	}
+	query := fmt.Sprintf("select wait_for_executed_gtid_set(%s, %s)", sqltypes.EncodeStringSQL(gtid), strconv.FormatFloat(timeout, 'f', -1, 64))
+	qr, err := qre.execDBConn(conn, query, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s: %v", query, qr.Rows)
+	}
+	if waited, err := evalengine.ToInt64(qr.Rows[0][0]); err != nil || waited != 0 {
+		return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "tablet did not execute the GTID set %s within %vs", gtid, timeout)
+	}
+	return nil
+}
+
 func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.getConn")
 	defer span.Finish()
 
+	pool := qre.tsv.qe.conns
+	switch {
+	case qre.olapPool:
+		pool = qre.tsv.qe.streamConns
+	case qre.workloadPool != nil:
+		pool = qre.workloadPool
+	}
 	start := time.Now()
-	conn, err := qre.tsv.qe.conns.Get(ctx)
+	conn, err := pool.Get(ctx)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
+		if err := qre.waitForReadAfterWrite(conn); err != nil {
+			conn.Recycle()
+			return nil, err
+		}
 		return conn, nil
 	case connpool.ErrConnPoolClosed:
 		return nil, err
@@ -673,11 +807,19 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.getStreamConn")
 	defer span.Finish()
 
+	pool := qre.tsv.qe.streamConns
+	if qre.workloadPool != nil {
+		pool = qre.workloadPool
+	}
 	start := time.Now()
-	conn, err := qre.tsv.qe.streamConns.Get(ctx)
+	conn, err := pool.Get(ctx)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
+		if err := qre.waitForReadAfterWrite(conn); err != nil {
+			conn.Recycle()
+			return nil, err
+		}
 		return conn, nil
 	case connpool.ErrConnPoolClosed:
 		return nil, err
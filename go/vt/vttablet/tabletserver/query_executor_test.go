@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -41,6 +42,7 @@ import (
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -1132,6 +1134,344 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorLimitRules(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	callInfo := &fakecallinfo.FakeCallInfo{
+		Remote: "127.0.0.1",
+		User:   "u1",
+	}
+	ctx := callinfo.NewContext(context.Background(), callInfo)
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rulesName := "limitRules"
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	setRule := func(qr *rules.Rule) {
+		t.Helper()
+		qr.SetQueryCond("select.*")
+		qrs := rules.New()
+		qrs.Add(qr)
+		require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+		tsv.qe.ClearQueryPlanCache()
+	}
+
+	qr := rules.NewQueryRule("limit selects", "ratelimit", rules.QRRateLimit)
+	qr.SetRateLimit(0.001, 1, rules.LimitByUser)
+	setRule(qr)
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualError(t, err, "rate limit exceeded due to rule: limit selects")
+	// Another user has its own limit.
+	otherCtx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{Remote: "127.0.0.1", User: "u2"})
+	_, err = newTestQueryExecutor(otherCtx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+
+	qr = rules.NewQueryRule("cap selects", "concurrency", rules.QRConcurrencyLimit)
+	qr.SetMaxConcurrency(1)
+	setRule(qr)
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	planRule := qre.plan.Rules.GetRule("127.0.0.1", "u1", nil)
+	require.True(t, planRule.AcquireConcurrency())
+	_, err = qre.Execute()
+	assert.EqualError(t, err, "concurrency limit exceeded due to rule: cap selects")
+	planRule.ReleaseConcurrency()
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, qr.Hits())
+	assert.EqualValues(t, 1, qr.Rejected())
+
+	qr = rules.NewQueryRule("delay selects", "delay", rules.QRDelay)
+	qr.SetDelay(10 * time.Millisecond)
+	setRule(qr)
+	start := time.Now()
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(10*time.Millisecond))
+	qr = rules.NewQueryRule("delay selects", "delay", rules.QRDelay)
+	qr.SetDelay(time.Hour)
+	setRule(qr)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	qre.ctx = cancelCtx
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_CANCELED, vterrors.Code(err))
+
+	qr = rules.NewQueryRule("run selects as olap", "override", rules.QROverride)
+	qr.SetOverride(querypb.ExecuteOptions_OLAP, time.Minute)
+	setRule(qr)
+	olapActive := tsv.qe.streamConns.Active()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.True(t, qre.olapPool)
+	assert.Greater(t, tsv.qe.streamConns.Active(), olapActive)
+	deadline, ok := qre.ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 10*time.Second)
+}
+
+func TestQueryExecutorRulesAfterTableACL(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group02",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"u1"},
+		}},
+	}
+	require.NoError(t, tableacl.InitFromProto(config))
+
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	tsv := newTestTabletServer(context.Background(), enableStrictTableACL, db)
+	defer tsv.StopService()
+	rulesName := "limitRules"
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	qr := rules.NewQueryRule("limit selects", "ratelimit", rules.QRRateLimit)
+	qr.SetQueryCond("select.*")
+	qr.SetRateLimit(0.001, 1, rules.LimitByUser)
+	qrs := rules.New()
+	qrs.Add(qr)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	// The queries denied by the table ACL don't use the rate limit.
+	deniedCtx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u2"})
+	for i := 0; i < 2; i++ {
+		_, err := newTestQueryExecutor(deniedCtx, tsv, query, 0).Execute()
+		assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	}
+	assert.EqualValues(t, 0, qr.Hits())
+
+	allowedCtx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "u1"})
+	_, err := newTestQueryExecutor(allowedCtx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, qr.Hits())
+}
+
+func TestQueryExecutorWorkloadPools(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("/*vt+ WORKLOAD_POOL=batch */ "+query, &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	tsv := newTestTabletServer(context.Background(), withWorkloadPools, db)
+	defer tsv.StopService()
+	batchPool := tsv.qe.workloadPools.pools["batch"]
+	require.NotNil(t, batchPool)
+
+	testcases := []struct {
+		name           string
+		principal      string
+		component      string
+		leadingComment string
+		want           *connpool.Pool
+		wantErr        string
+	}{{
+		name: "no workload pool",
+	}, {
+		name:      "principal",
+		principal: "etl",
+		want:      batchPool,
+	}, {
+		name:      "component",
+		component: "reports",
+		want:      batchPool,
+	}, {
+		name:           "directive",
+		principal:      "app",
+		leadingComment: "/*vt+ WORKLOAD_POOL=batch */ ",
+		want:           batchPool,
+	}, {
+		name:           "directive of a principal that is not allowed",
+		principal:      "other",
+		leadingComment: "/*vt+ WORKLOAD_POOL=batch */ ",
+	}, {
+		name:           "directive without principal",
+		leadingComment: "/*vt+ WORKLOAD_POOL=batch */ ",
+	}, {
+		name:           "unknown pool",
+		leadingComment: "/*vt+ WORKLOAD_POOL=unknown */ ",
+		wantErr:        "unknown workload pool: unknown",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID(tcase.principal, tcase.component, ""), nil)
+			qre := newTestQueryExecutor(ctx, tsv, query, 0)
+			qre.marginComments.Leading = tcase.leadingComment
+			waitCount := batchPool.WaitCount()
+			_, err := qre.Execute()
+			if tcase.wantErr != "" {
+				assert.EqualError(t, err, tcase.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, qre.workloadPool)
+			assert.Equal(t, waitCount, batchPool.WaitCount())
+		})
+	}
+
+	// The batch pool is isolated from the other pools.
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("etl", "", ""), nil)
+	conn1, err := batchPool.Get(ctx)
+	require.NoError(t, err)
+	defer conn1.Recycle()
+	conn2, err := batchPool.Get(ctx)
+	require.NoError(t, err)
+	defer conn2.Recycle()
+	_, err = newTestQueryExecutor(context.Background(), tsv, query, 0).Execute()
+	require.NoError(t, err)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.ctx = timeoutCtx
+	_, err = qre.Execute()
+	assert.EqualError(t, err, "resource pool timed out")
+}
+
+func TestQueryExecutorQuarantine(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	db.SetBeforeFunc(query, func() {
+		time.Sleep(20 * time.Millisecond)
+	})
+	tsv := newTestTabletServer(context.Background(), withQueryQuarantine, db)
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	// The query is quarantined after its second slow execution.
+	for i := 0; i < 2; i++ {
+		_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+		require.NoError(t, err)
+	}
+	quarantines := tsv.QueryQuarantines()
+	require.Len(t, quarantines, 1)
+	assert.Equal(t, query, quarantines[0].Fingerprint)
+	assert.Equal(t, "FAIL", quarantines[0].Action)
+	assert.Equal(t, "2 timeouts or executions slower than 10ms within 1m0s", quarantines[0].Reason)
+
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.EqualError(t, err, "disallowed due to rule: quarantined after 2 timeouts or executions slower than 10ms within 1m0s")
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	assert.EqualValues(t, 1, tsv.QueryQuarantines()[0].Hits)
+
+	assert.Equal(t, 1, tsv.ClearQueryQuarantines(""))
+	assert.Empty(t, tsv.QueryQuarantines())
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+}
+
+func TestQueryExecutorStreamQuarantine(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	db.SetBeforeFunc(query, func() {
+		time.Sleep(20 * time.Millisecond)
+	})
+	tsv := newTestTabletServer(context.Background(), withQueryQuarantine, db)
+	defer tsv.StopService()
+	ctx := context.Background()
+	newStreamExecutor := func(ctx context.Context) *QueryExecutor {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		plan, err := tsv.qe.GetStreamPlan(query, false /* isReservedConn */)
+		require.NoError(t, err)
+		qre.plan = plan
+		return qre
+	}
+	callback := func(*sqltypes.Result) error { return nil }
+
+	// Slow streaming queries are not quarantined.
+	for i := 0; i < 2; i++ {
+		err := newStreamExecutor(ctx).Stream(callback)
+		require.NoError(t, err)
+	}
+	assert.Empty(t, tsv.QueryQuarantines())
+
+	// The query is quarantined after its second timeout.
+	for i := 0; i < 2; i++ {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		err := newStreamExecutor(timeoutCtx).Stream(callback)
+		cancel()
+		require.Error(t, err)
+	}
+	quarantines := tsv.QueryQuarantines()
+	require.Len(t, quarantines, 1)
+	assert.Equal(t, query, quarantines[0].Fingerprint)
+
+	err := newStreamExecutor(ctx).Stream(callback)
+	assert.EqualError(t, err, "disallowed due to rule: quarantined after 2 timeouts or executions slower than 10ms within 1m0s")
+}
+
+func TestQueryExecutorReadAfterWrite(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	waitResult := func(waited string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), waited)
+	}
+	db.AddQuery("select wait_for_executed_gtid_set('uuid:1-5', 0.5)", waitResult("0"))
+	db.AddQuery("select wait_for_executed_gtid_set('uuid:1-6', 1)", waitResult("1"))
+	tsv := newTestTabletServer(context.Background(), noFlags, db)
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{ReadAfterWriteGtid: "uuid:1-5", ReadAfterWriteTimeout: 0.5}
+	_, err := qre.Execute()
+	require.NoError(t, err)
+
+	// The tablet didn't catch up within the default timeout of 1s.
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{ReadAfterWriteGtid: "uuid:1-6"}
+	_, err = qre.Execute()
+	assert.EqualError(t, err, "tablet did not execute the GTID set uuid:1-6 within 1s")
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+
+	// The wait runs on the connection of the query, which comes
+	// from the stream pool for a streaming query.
+	var oltpInUse, olapInUse int64
+	db.SetBeforeFunc("select wait_for_executed_gtid_set('uuid:1-6', 1)", func() {
+		oltpInUse, olapInUse = tsv.qe.conns.InUse(), tsv.qe.streamConns.InUse()
+	})
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{ReadAfterWriteGtid: "uuid:1-6"}
+	err = qre.Stream(func(*sqltypes.Result) error { return nil })
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+	assert.EqualValues(t, 0, oltpInUse)
+	assert.EqualValues(t, 1, olapInUse)
+}
+
 type executorFlags int64
 
 const (
@@ -1142,6 +1482,8 @@ const (
 	shortTwopcAge
 	smallResultSize
 	disableOnlineDDL
+	withWorkloadPools
+	withQueryQuarantine
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1177,6 +1519,24 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&withWorkloadPools > 0 {
+		config.WorkloadPools = []tabletenv.WorkloadPoolConfig{{
+			Name:                "batch",
+			ConnPoolConfig:      tabletenv.ConnPoolConfig{Size: 2},
+			Principals:          []string{"etl"},
+			Components:          []string{"reports"},
+			DirectivePrincipals: []string{"app"},
+		}}
+	}
+	if flags&withQueryQuarantine > 0 {
+		config.QueryQuarantine = tabletenv.QueryQuarantineConfig{
+			Mode:             tabletenv.Fail,
+			Threshold:        2,
+			SlowQuerySeconds: 0.01,
+			WindowSeconds:    60,
+			CoolOffSeconds:   60,
+		}
+	}
 	dbconfigs := newDBConfigs(db)
 	config.DB = dbconfigs
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
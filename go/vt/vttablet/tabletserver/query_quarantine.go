@@ -0,0 +1,277 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// QueryQuarantineRules is the query rule source of the rules
+// installed for the quarantined queries.
+const QueryQuarantineRules = "QueryQuarantineRules"
+
+// maxQuarantineCandidates is the number of queries whose recent
+// timeouts and slow executions are tracked.
+const maxQuarantineCandidates = 10000
+
+// queryQuarantine quarantines the queries that repeatedly time out or
+// run too long: once a query crosses the threshold of the config, a
+// query rule that rejects or throttles it is installed for the
+// cool-off period.
+type queryQuarantine struct {
+	config tabletenv.QueryQuarantineConfig
+	action rules.Action
+
+	// setRules installs the quarantine rules in the query engine.
+	setRules func(*rules.Rules) error
+
+	quarantinedCount *stats.Counter
+
+	mu sync.Mutex
+	// candidates has the times of the last timeouts and slow
+	// executions of the queries, at most config.Threshold per query.
+	candidates  map[string][]time.Time
+	quarantines map[string]*quarantine
+}
+
+type quarantine struct {
+	tabletenv.QuarantinedQuery
+	rule  *rules.Rule
+	timer *time.Timer
+}
+
+func newQueryQuarantine(env tabletenv.Env, setRules func(*rules.Rules) error) *queryQuarantine {
+	config := env.Config().QueryQuarantine
+	qq := &queryQuarantine{
+		config:      config,
+		action:      rules.QRFail,
+		setRules:    setRules,
+		candidates:  make(map[string][]time.Time),
+		quarantines: make(map[string]*quarantine),
+	}
+	if config.Mode == tabletenv.Throttle {
+		qq.action = rules.QRRateLimit
+	}
+	qq.quarantinedCount = env.Exporter().NewCounter("QueryQuarantined", "Number of times a query was quarantined")
+	env.Exporter().NewGaugeFunc("QueryQuarantineActive", "Number of queries currently quarantined", func() int64 {
+		qq.mu.Lock()
+		defer qq.mu.Unlock()
+		return int64(len(qq.quarantines))
+	})
+	return qq
+}
+
+// enabled returns true if the queries can be quarantined.
+func (qq *queryQuarantine) enabled() bool {
+	return qq.config.Mode == tabletenv.Fail || qq.config.Mode == tabletenv.Throttle
+}
+
+// Record records an execution of query that took mysqlTime in MySQL,
+// and quarantines the query if it timed out or was slow too often.
+func (qq *queryQuarantine) Record(query string, mysqlTime time.Duration, timedOut bool) {
+	if !qq.enabled() {
+		return
+	}
+	slowQuery := qq.config.SlowQuerySeconds.Get()
+	if !timedOut && (slowQuery == 0 || mysqlTime < slowQuery) {
+		return
+	}
+	now := time.Now()
+
+	qq.mu.Lock()
+	if _, ok := qq.quarantines[query]; ok {
+		qq.mu.Unlock()
+		return
+	}
+	events, ok := qq.candidates[query]
+	if !ok && len(qq.candidates) >= maxQuarantineCandidates {
+		qq.discardCandidatesLocked(now)
+		if len(qq.candidates) >= maxQuarantineCandidates {
+			qq.mu.Unlock()
+			return
+		}
+	}
+	events = append(events, now)
+	if len(events) > qq.config.Threshold {
+		events = events[1:]
+	}
+	window := qq.config.WindowSeconds.Get()
+	if len(events) < qq.config.Threshold || now.Sub(events[0]) > window {
+		qq.candidates[query] = events
+		qq.mu.Unlock()
+		return
+	}
+	delete(qq.candidates, query)
+	reason := fmt.Sprintf("%d timeouts or executions slower than %v within %v", len(events), slowQuery, window)
+	if slowQuery == 0 {
+		reason = fmt.Sprintf("%d timeouts within %v", len(events), window)
+	}
+	qq.quarantineLocked(query, reason, now)
+	qq.installRulesLocked()
+	qq.mu.Unlock()
+
+	log.Warningf("Quarantining query for %v after %s: %s", qq.config.CoolOffSeconds.Get(), reason, sqlparser.TruncateForLog(query))
+	qq.quarantinedCount.Add(1)
+}
+
+// discardCandidatesLocked discards the queries that
+// have not timed out or been slow within the window.
+func (qq *queryQuarantine) discardCandidatesLocked(now time.Time) {
+	window := qq.config.WindowSeconds.Get()
+	for query, events := range qq.candidates {
+		if now.Sub(events[len(events)-1]) > window {
+			delete(qq.candidates, query)
+		}
+	}
+}
+
+func (qq *queryQuarantine) quarantineLocked(query, reason string, now time.Time) {
+	coolOff := qq.config.CoolOffSeconds.Get()
+	rule := rules.NewQueryRule("quarantined after "+reason, fmt.Sprintf("quarantine_%d", now.UnixNano()), qq.action)
+	// The query is a regexp of the rule: it must match only itself.
+	if err := rule.SetQueryCond(regexp.QuoteMeta(query)); err != nil {
+		log.Errorf("Cannot quarantine query %s: %v", sqlparser.TruncateForLog(query), err)
+		return
+	}
+	action := "FAIL"
+	if qq.action == rules.QRRateLimit {
+		rule.SetRateLimit(qq.config.ThrottleQPS, 0, rules.LimitByRule)
+		action = "RATE_LIMIT"
+	}
+	q := &quarantine{
+		QuarantinedQuery: tabletenv.QuarantinedQuery{
+			Fingerprint: query,
+			Action:      action,
+			Reason:      reason,
+			StartTime:   now,
+			ExpireTime:  now.Add(coolOff),
+		},
+		rule: rule,
+	}
+	q.timer = time.AfterFunc(coolOff, func() {
+		qq.expire(q)
+	})
+	qq.quarantines[query] = q
+}
+
+// expire releases q at the end of its cool-off period.
+func (qq *queryQuarantine) expire(q *quarantine) {
+	qq.mu.Lock()
+	if qq.quarantines[q.Fingerprint] != q {
+		qq.mu.Unlock()
+		return
+	}
+	delete(qq.quarantines, q.Fingerprint)
+	qq.installRulesLocked()
+	qq.mu.Unlock()
+
+	log.Infof("Releasing quarantined query: %s", sqlparser.TruncateForLog(q.Fingerprint))
+}
+
+// installRulesLocked installs the rules of the current quarantines.
+// It's called with mu locked so that the rules are installed in the
+// order of the changes.
+func (qq *queryQuarantine) installRulesLocked() {
+	qrs := rules.New()
+	for _, q := range qq.quarantines {
+		qrs.Add(q.rule)
+	}
+	if err := qq.setRules(qrs); err != nil {
+		log.Errorf("Cannot install the query quarantine rules: %v", err)
+	}
+}
+
+// List returns the quarantined queries, sorted by start time.
+func (qq *queryQuarantine) List() []*tabletenv.QuarantinedQuery {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	list := make([]*tabletenv.QuarantinedQuery, 0, len(qq.quarantines))
+	for _, q := range qq.quarantines {
+		qd := q.QuarantinedQuery
+		qd.Hits = q.rule.Hits()
+		list = append(list, &qd)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].StartTime.Before(list[j].StartTime)
+	})
+	return list
+}
+
+// Clear releases the quarantined query fingerprint, or all the
+// quarantined queries if fingerprint is empty. It returns the
+// number of queries released.
+func (qq *queryQuarantine) Clear(fingerprint string) int {
+	qq.mu.Lock()
+	defer qq.mu.Unlock()
+	cleared := 0
+	for query, q := range qq.quarantines {
+		if fingerprint != "" && query != fingerprint {
+			continue
+		}
+		q.timer.Stop()
+		delete(qq.quarantines, query)
+		cleared++
+	}
+	if fingerprint == "" {
+		qq.candidates = make(map[string][]time.Time)
+	} else {
+		delete(qq.candidates, fingerprint)
+	}
+	if cleared > 0 {
+		qq.installRulesLocked()
+	}
+	return cleared
+}
+
+// ServeHTTP lists the quarantined queries. A POST request releases
+// the query of its fingerprint parameter, or all of them if it's empty.
+func (qq *queryQuarantine) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	role := acl.DEBUGGING
+	if request.Method == http.MethodPost {
+		role = acl.ADMIN
+	}
+	if err := acl.CheckAccessHTTP(request, role); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	if request.Method == http.MethodPost {
+		qq.Clear(request.FormValue("fingerprint"))
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(qq.List(), "", " ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	json.HTMLEscape(buf, b)
+	response.Write(buf.Bytes())
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// fakeQuarantineRules records the rules installed by a queryQuarantine.
+type fakeQuarantineRules struct {
+	mu  sync.Mutex
+	qrs *rules.Rules
+}
+
+func (f *fakeQuarantineRules) setRules(qrs *rules.Rules) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.qrs = qrs
+	return nil
+}
+
+// rule returns the rule installed for query, if any.
+func (f *fakeQuarantineRules) rule(query string) *rules.Rule {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.qrs == nil {
+		return nil
+	}
+	return f.qrs.FilterByPlan(query, planbuilder.PlanSelect, "t").GetRule("", "", nil)
+}
+
+func newTestQueryQuarantine(cfg tabletenv.QueryQuarantineConfig) (*queryQuarantine, *fakeQuarantineRules) {
+	config := tabletenv.NewDefaultConfig()
+	config.QueryQuarantine = cfg
+	f := &fakeQuarantineRules{}
+	return newQueryQuarantine(tabletenv.NewEnv(config, "QueryQuarantineTest"), f.setRules), f
+}
+
+func TestQueryQuarantine(t *testing.T) {
+	qq, f := newTestQueryQuarantine(tabletenv.QueryQuarantineConfig{
+		Mode:             tabletenv.Fail,
+		Threshold:        3,
+		SlowQuerySeconds: 1,
+		WindowSeconds:    60,
+		CoolOffSeconds:   0.1,
+	})
+	query := "select * from t where id in ::vtg1"
+
+	// Fast executions don't count.
+	for i := 0; i < 5; i++ {
+		qq.Record(query, 10*time.Millisecond, false)
+	}
+	qq.Record(query, time.Second, false)
+	qq.Record(query, 0, true)
+	assert.Empty(t, qq.List())
+	assert.Nil(t, f.rule(query))
+
+	qq.Record(query, 2*time.Second, false)
+	list := qq.List()
+	require.Len(t, list, 1)
+	assert.Equal(t, query, list[0].Fingerprint)
+	assert.Equal(t, "FAIL", list[0].Action)
+	assert.Equal(t, "3 timeouts or executions slower than 1s within 1m0s", list[0].Reason)
+	assert.Equal(t, 100*time.Millisecond, list[0].ExpireTime.Sub(list[0].StartTime))
+
+	// The rule matches the query exactly, although it
+	// contains regexp metacharacters.
+	rule := f.rule(query)
+	require.NotNil(t, rule)
+	assert.Equal(t, rules.QRFail, rule.Action())
+	assert.Nil(t, f.rule("select a from t where id in ::vtg1"))
+	assert.Nil(t, f.rule(query+" for update"))
+
+	// The quarantine expires after the cool-off period.
+	assert.Eventually(t, func() bool {
+		return len(qq.List()) == 0 && f.rule(query) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The executions before the quarantine don't count anymore.
+	qq.Record(query, 0, true)
+	assert.Empty(t, qq.List())
+}
+
+func TestQueryQuarantineWindow(t *testing.T) {
+	qq, _ := newTestQueryQuarantine(tabletenv.QueryQuarantineConfig{
+		Mode:           tabletenv.Fail,
+		Threshold:      2,
+		WindowSeconds:  0.05,
+		CoolOffSeconds: 60,
+	})
+	query := "select sleep(:vtg1) from dual"
+
+	// Without a slow query time, only the timeouts count.
+	qq.Record(query, time.Hour, false)
+	qq.Record(query, time.Hour, false)
+	assert.Empty(t, qq.List())
+
+	qq.Record(query, 0, true)
+	time.Sleep(100 * time.Millisecond)
+	qq.Record(query, 0, true)
+	assert.Empty(t, qq.List())
+	qq.Record(query, 0, true)
+	list := qq.List()
+	require.Len(t, list, 1)
+	assert.Equal(t, "2 timeouts within 50ms", list[0].Reason)
+	qq.Clear("")
+}
+
+func TestQueryQuarantineThrottle(t *testing.T) {
+	qq, f := newTestQueryQuarantine(tabletenv.QueryQuarantineConfig{
+		Mode:           tabletenv.Throttle,
+		Threshold:      1,
+		WindowSeconds:  60,
+		CoolOffSeconds: 60,
+		ThrottleQPS:    0.001,
+	})
+	query := "select * from t"
+	qq.Record(query, 0, true)
+	list := qq.List()
+	require.Len(t, list, 1)
+	assert.Equal(t, "RATE_LIMIT", list[0].Action)
+
+	rule := f.rule(query)
+	require.NotNil(t, rule)
+	assert.Equal(t, rules.QRRateLimit, rule.Action())
+	assert.True(t, rule.AllowRequest("ip1", "user1"))
+	assert.False(t, rule.AllowRequest("ip2", "user2"))
+	qq.Clear("")
+}
+
+func TestQueryQuarantineClear(t *testing.T) {
+	qq, f := newTestQueryQuarantine(tabletenv.QueryQuarantineConfig{
+		Mode:           tabletenv.Fail,
+		Threshold:      1,
+		WindowSeconds:  60,
+		CoolOffSeconds: 60,
+	})
+	qq.Record("select 1 from dual", 0, true)
+	qq.Record("select 2 from dual", 0, true)
+	qq.Record("select 3 from dual", 0, true)
+	require.Len(t, qq.List(), 3)
+
+	assert.Equal(t, 0, qq.Clear("select 4 from dual"))
+	assert.Equal(t, 1, qq.Clear("select 1 from dual"))
+	assert.Nil(t, f.rule("select 1 from dual"))
+	assert.NotNil(t, f.rule("select 2 from dual"))
+	require.Len(t, qq.List(), 2)
+
+	// The debug page releases quarantined queries on POST requests.
+	response := httptest.NewRecorder()
+	qq.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/debug/query_quarantine", nil))
+	var list []*tabletenv.QuarantinedQuery
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &list))
+	require.Len(t, list, 2)
+	assert.Equal(t, "select 2 from dual", list[0].Fingerprint)
+
+	form := url.Values{"fingerprint": []string{"select 2 from dual"}}
+	request := httptest.NewRequest(http.MethodPost, "/debug/query_quarantine", strings.NewReader(form.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	response = httptest.NewRecorder()
+	qq.ServeHTTP(response, request)
+	list = nil
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &list))
+	require.Len(t, list, 1)
+	assert.Equal(t, "select 3 from dual", list[0].Fingerprint)
+
+	assert.Equal(t, 1, qq.Clear(""))
+	assert.Empty(t, qq.List())
+	assert.Nil(t, f.rule("select 3 from dual"))
+}
+
+func TestQueryQuarantineDisabled(t *testing.T) {
+	qq, f := newTestQueryQuarantine(tabletenv.QueryQuarantineConfig{
+		Mode:           tabletenv.Disable,
+		Threshold:      1,
+		WindowSeconds:  60,
+		CoolOffSeconds: 60,
+	})
+	qq.Record("select 1 from dual", 0, true)
+	assert.Empty(t, qq.List())
+	assert.Nil(t, f.qrs)
+}
@@ -43,7 +43,7 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(184)
+		size += int64(248)
 	}
 	// field Description string
 	size += int64(len(cached.Description))
@@ -73,6 +73,10 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field state *vitess.io/vitess/go/vt/vttablet/tabletserver/rules.ruleState
+	if cached.state != nil {
+		size += int64(40)
+	}
 	return size
 }
 func (cached *Rules) CachedSize(alloc bool) int64 {
@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"bytes"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/sync2"
+)
+
+// maxRateLimiters is the number of rate limiters a rule keeps when its
+// rate limit is applied per user or per IP. Beyond that, the limiters
+// that have been idle long enough to be full again are discarded.
+const maxRateLimiters = 10000
+
+// ruleState holds the counters and limiters of a Rule.
+// It's shared by all the copies of the rule, so that the
+// rules of the query plans update the rules they come from.
+type ruleState struct {
+	hits     sync2.AtomicInt64
+	rejected sync2.AtomicInt64
+	inFlight sync2.AtomicInt64
+
+	mu       sync.Mutex
+	limiters map[string]*keyLimiter
+}
+
+type keyLimiter struct {
+	*rate.Limiter
+	lastUsed time.Time
+}
+
+func newRuleState() *ruleState {
+	return &ruleState{}
+}
+
+// The methods of ruleState accept a nil receiver, for the rules that
+// were not created by NewQueryRule. Such rules have no limits.
+
+func (rs *ruleState) hit() {
+	if rs != nil {
+		rs.hits.Add(1)
+	}
+}
+
+// marshalJSON writes the counters relevant to act.
+func (rs *ruleState) marshalJSON(b *bytes.Buffer, act Action) {
+	if rs == nil {
+		rs = newRuleState()
+	}
+	safeEncode(b, `,"Hits":`, rs.hits.Get())
+	switch act {
+	case QRRateLimit:
+		safeEncode(b, `,"Rejected":`, rs.rejected.Get())
+	case QRConcurrencyLimit:
+		safeEncode(b, `,"Rejected":`, rs.rejected.Get())
+		safeEncode(b, `,"InFlight":`, rs.inFlight.Get())
+	}
+}
+
+// AllowRequest returns true if a query sent by user from ip is within
+// the rate limit of the rule. Otherwise, it returns false and
+// increments the rejected counter of the rule.
+// It always returns true if the action of the rule is not QRRateLimit.
+func (qr *Rule) AllowRequest(ip, user string) bool {
+	if qr.act != QRRateLimit || qr.state == nil {
+		return true
+	}
+	key := ""
+	switch qr.rateLimitKey {
+	case LimitByUser:
+		key = user
+	case LimitByIP:
+		key = ip
+	}
+	burst := qr.rateLimitBurst
+	if burst == 0 {
+		burst = int(math.Ceil(qr.rateLimit))
+	}
+	if qr.state.limiter(key, qr.rateLimit, burst, time.Now()).Allow() {
+		return true
+	}
+	qr.state.rejected.Add(1)
+	return false
+}
+
+func (rs *ruleState) limiter(key string, qps float64, burst int, now time.Time) *rate.Limiter {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if l, ok := rs.limiters[key]; ok {
+		l.lastUsed = now
+		return l.Limiter
+	}
+	if rs.limiters == nil {
+		rs.limiters = make(map[string]*keyLimiter)
+	}
+	if len(rs.limiters) >= maxRateLimiters {
+		// A limiter that has been idle for the time it takes to
+		// refill its bucket behaves like a new one.
+		refill := time.Duration(float64(burst) / qps * float64(time.Second))
+		for k, l := range rs.limiters {
+			if now.Sub(l.lastUsed) > refill {
+				delete(rs.limiters, k)
+			}
+		}
+	}
+	l := &keyLimiter{Limiter: rate.NewLimiter(rate.Limit(qps), burst), lastUsed: now}
+	rs.limiters[key] = l
+	return l.Limiter
+}
+
+// AcquireConcurrency returns true if another query can run under the
+// concurrency limit of the rule. The caller must then call
+// ReleaseConcurrency once the query is done. Otherwise, it returns
+// false and increments the rejected counter of the rule.
+// It always returns true if the action of the rule is not QRConcurrencyLimit.
+func (qr *Rule) AcquireConcurrency() bool {
+	if qr.act != QRConcurrencyLimit || qr.state == nil {
+		return true
+	}
+	if qr.state.inFlight.Add(1) <= qr.maxConcurrency {
+		return true
+	}
+	qr.state.inFlight.Add(-1)
+	qr.state.rejected.Add(1)
+	return false
+}
+
+// ReleaseConcurrency releases a query acquired by AcquireConcurrency.
+func (qr *Rule) ReleaseConcurrency() {
+	if qr.act != QRConcurrencyLimit || qr.state == nil {
+		return
+	}
+	qr.state.inFlight.Add(-1)
+}
+
+// Hits returns the number of queries the rule fired for.
+func (qr *Rule) Hits() int64 {
+	if qr.state == nil {
+		return 0
+	}
+	return qr.state.hits.Get()
+}
+
+// Rejected returns the number of queries that were rejected
+// because of the rate or concurrency limit of the rule.
+func (qr *Rule) Rejected() int64 {
+	if qr.state == nil {
+		return 0
+	}
+	return qr.state.rejected.Get()
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+)
+
+func TestRateLimit(t *testing.T) {
+	qr := NewQueryRule("rate limit", "r1", QRRateLimit)
+	qr.SetRateLimit(0.001, 2, LimitByRule)
+	assert.True(t, qr.AllowRequest("ip1", "user1"))
+	assert.True(t, qr.AllowRequest("ip2", "user2"))
+	assert.False(t, qr.AllowRequest("ip3", "user3"))
+	assert.EqualValues(t, 1, qr.Rejected())
+
+	// The copies of the rule share its limiter.
+	qrCopy := qr.Copy()
+	assert.False(t, qrCopy.AllowRequest("ip1", "user1"))
+	assert.EqualValues(t, 2, qr.Rejected())
+
+	qr = NewQueryRule("rate limit per user", "r2", QRRateLimit)
+	qr.SetRateLimit(0.001, 1, LimitByUser)
+	assert.True(t, qr.AllowRequest("ip1", "user1"))
+	assert.True(t, qr.AllowRequest("ip1", "user2"))
+	assert.False(t, qr.AllowRequest("ip2", "user1"))
+
+	qr = NewQueryRule("rate limit per ip", "r3", QRRateLimit)
+	qr.SetRateLimit(0.001, 1, LimitByIP)
+	assert.True(t, qr.AllowRequest("ip1", "user1"))
+	assert.True(t, qr.AllowRequest("ip2", "user1"))
+	assert.False(t, qr.AllowRequest("ip1", "user2"))
+
+	// Other actions are not rate limited.
+	qr = NewQueryRule("fail", "r4", QRFail)
+	assert.True(t, qr.AllowRequest("ip1", "user1"))
+	assert.True(t, qr.AllowRequest("ip1", "user1"))
+}
+
+func TestRateLimitDiscardsIdleLimiters(t *testing.T) {
+	rs := newRuleState()
+	now := time.Now()
+	for i := 0; i < maxRateLimiters; i++ {
+		rs.limiter(string(rune(i)), 1, 1, now)
+	}
+	rs.limiter("busy", 1, 1, now.Add(1500*time.Millisecond))
+	rs.limiter("new", 1, 1, now.Add(2*time.Second))
+	assert.Len(t, rs.limiters, 2)
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	qr := NewQueryRule("concurrency limit", "r1", QRConcurrencyLimit)
+	qr.SetMaxConcurrency(2)
+	assert.True(t, qr.AcquireConcurrency())
+	assert.True(t, qr.AcquireConcurrency())
+	assert.False(t, qr.AcquireConcurrency())
+	assert.EqualValues(t, 1, qr.Rejected())
+	qr.ReleaseConcurrency()
+	assert.True(t, qr.AcquireConcurrency())
+}
+
+func TestGetRuleHits(t *testing.T) {
+	qrs := New()
+	qr1 := NewQueryRule("rule 1", "r1", QRDelay)
+	qr1.SetDelay(time.Second)
+	qr1.SetUserCond("user1")
+	qr2 := NewQueryRule("rule 2", "r2", QRConcurrencyLimit)
+	qr2.SetMaxConcurrency(1)
+	qrs.Add(qr1)
+	qrs.Add(qr2)
+
+	qr := qrs.GetRule("ip1", "user1", nil)
+	require.NotNil(t, qr)
+	assert.Equal(t, "r1", qr.Name)
+	assert.Equal(t, time.Second, qr.Delay())
+
+	// The hits of the rules filtered by plan are counted
+	// in the original rules.
+	planRules := qrs.FilterByPlan("select 1", planbuilder.PlanSelect, "")
+	qr = planRules.GetRule("ip1", "user2", nil)
+	require.NotNil(t, qr)
+	assert.Equal(t, "r2", qr.Name)
+	assert.True(t, qr.AcquireConcurrency())
+	assert.False(t, qr.AcquireConcurrency())
+
+	assert.EqualValues(t, 1, qr1.Hits())
+	assert.EqualValues(t, 1, qr2.Hits())
+	assert.Equal(t, `[{"Description":"rule 1","Name":"r1","User":"user1","Action":"DELAY","Delay":"1s","Hits":1},`+
+		`{"Description":"rule 2","Name":"r2","Action":"CONCURRENCY_LIMIT","MaxConcurrency":1,"Hits":1,"Rejected":1,"InFlight":1}]`,
+		compacted(string(qrs.marshalJSON(true))))
+}
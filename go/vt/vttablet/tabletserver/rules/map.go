@@ -67,8 +67,15 @@ func (qri *Map) SetRules(ruleSource string, newRules *Rules) error {
 	}
 	qri.mu.Lock()
 	defer qri.mu.Unlock()
-	if _, ok := qri.queryRulesMap[ruleSource]; ok {
-		qri.queryRulesMap[ruleSource] = newRules.Copy()
+	if oldRules, ok := qri.queryRulesMap[ruleSource]; ok {
+		newRules = newRules.Copy()
+		// Rules that didn't change keep their counters and limiters.
+		for _, qr := range newRules.rules {
+			if oldqr := oldRules.Find(qr.Name); oldqr != nil && oldqr.Equal(qr) {
+				qr.state = oldqr.state
+			}
+		}
+		qri.queryRulesMap[ruleSource] = newRules
 		return nil
 	}
 	return errors.New("Rule source identifier " + ruleSource + " is not valid")
@@ -102,3 +109,15 @@ func (qri *Map) MarshalJSON() ([]byte, error) {
 	defer qri.mu.Unlock()
 	return json.Marshal(qri.queryRulesMap)
 }
+
+// MarshalJSONWithStats marshals to JSON like MarshalJSON,
+// and includes the counters of the rules.
+func (qri *Map) MarshalJSONWithStats() ([]byte, error) {
+	qri.mu.Lock()
+	defer qri.mu.Unlock()
+	rulesMap := make(map[string]json.RawMessage, len(qri.queryRulesMap))
+	for ruleSource, rules := range qri.queryRulesMap {
+		rulesMap[ruleSource] = rules.marshalJSON(true)
+	}
+	return json.Marshal(rulesMap)
+}
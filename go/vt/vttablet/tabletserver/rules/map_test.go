@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 )
@@ -230,3 +231,57 @@ func TestMapJSON(t *testing.T) {
 		t.Errorf("MapJSON:\n%v, want\n%v", got, want)
 	}
 }
+
+func TestMapJSONWithStats(t *testing.T) {
+	newRules := func(delay time.Duration) *Rules {
+		qrs := New()
+		qr := NewQueryRule("delay selects", "delay", QRDelay)
+		qr.SetDelay(delay)
+		qrs.Add(qr)
+		qr = NewQueryRule("limit inserts", "ratelimit", QRRateLimit)
+		qr.SetRateLimit(100, 0, LimitByIP)
+		qrs.Add(qr)
+		return qrs
+	}
+	qri := NewMap()
+	qri.RegisterSource(customQueryRules)
+	_ = qri.SetRules(customQueryRules, newRules(10*time.Millisecond))
+
+	planRules := qri.FilterByPlan("select * from t", planbuilder.PlanSelect, "t")
+	planRules.GetRule("", "", nil)
+	planRules.GetRule("", "", nil)
+
+	// Rules that didn't change keep their counters.
+	_ = qri.SetRules(customQueryRules, newRules(10*time.Millisecond))
+	got, err := qri.MarshalJSONWithStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := compacted(`{
+		"CUSTOM_QUERY_RULES":[{
+			"Description":"delay selects",
+			"Name":"delay",
+			"Action":"DELAY",
+			"Delay":"10ms",
+			"Hits":2
+		},{
+			"Description":"limit inserts",
+			"Name":"ratelimit",
+			"Action":"RATE_LIMIT",
+			"QPS":100,
+			"LimitKey":"ip",
+			"Hits":0,
+			"Rejected":0
+		}]
+	}`)
+	if string(got) != want {
+		t.Errorf("MapJSONWithStats:\n%s, want\n%v", got, want)
+	}
+
+	// Changed rules start with new counters.
+	_ = qri.SetRules(customQueryRules, newRules(20*time.Millisecond))
+	qrs, _ := qri.Get(customQueryRules)
+	if hits := qrs.Find("delay").Hits(); hits != 0 {
+		t.Errorf("Hits after the rule changed: %d, want 0", hits)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -140,16 +141,20 @@ func (qrs *Rules) UnmarshalJSON(data []byte) (err error) {
 
 // MarshalJSON marshals to JSON.
 func (qrs *Rules) MarshalJSON() ([]byte, error) {
+	return qrs.marshalJSON(false), nil
+}
+
+func (qrs *Rules) marshalJSON(withStats bool) []byte {
 	b := bytes.NewBuffer(nil)
 	_, _ = b.WriteString("[")
 	for i, rule := range qrs.rules {
 		if i != 0 {
 			_, _ = b.WriteString(",")
 		}
-		safeEncode(b, "", rule)
+		_, _ = b.Write(rule.marshalJSON(withStats))
 	}
 	_, _ = b.WriteString("]")
-	return b.Bytes(), nil
+	return b.Bytes()
 }
 
 // FilterByPlan creates a new Rules by prefiltering on the query and planId. This allows
@@ -175,6 +180,19 @@ func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVa
 	return QRContinue, ""
 }
 
+// GetRule runs the input against the rules engine and returns the first
+// rule that fires, or nil if none does. The hit counter of the returned
+// rule is incremented.
+func (qrs *Rules) GetRule(ip, user string, bindVars map[string]*querypb.BindVariable) *Rule {
+	for _, qr := range qrs.rules {
+		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
+			qr.state.hit()
+			return qr
+		}
+	}
+	return nil
+}
+
 //-----------------------------------------------
 
 // Rule represents one rule (conditions-action).
@@ -205,6 +223,25 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the QRRateLimit action.
+	rateLimit      float64
+	rateLimitBurst int
+	rateLimitKey   LimitKey
+
+	// Parameter of the QRConcurrencyLimit action.
+	maxConcurrency int64
+
+	// Parameter of the QRDelay action.
+	delay time.Duration
+
+	// Parameters of the QROverride action.
+	workload querypb.ExecuteOptions_Workload
+	timeout  time.Duration
+
+	// state holds the counters and limiters of the rule. It's shared
+	// by all the copies of the rule.
+	state *ruleState
 }
 
 type namedRegexp struct {
@@ -227,8 +264,7 @@ func (nr namedRegexp) Equal(other namedRegexp) bool {
 
 // NewQueryRule creates a new Rule.
 func NewQueryRule(description, name string, act Action) (qr *Rule) {
-	// We ignore act because there's only one action right now
-	return &Rule{Description: description, Name: name, act: act}
+	return &Rule{Description: description, Name: name, act: act, state: newRuleState()}
 }
 
 // Equal returns true if other is equal to this Rule, otherwise false.
@@ -244,18 +280,34 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.rateLimit == other.rateLimit &&
+		qr.rateLimitBurst == other.rateLimitBurst &&
+		qr.rateLimitKey == other.rateLimitKey &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.delay == other.delay &&
+		qr.workload == other.workload &&
+		qr.timeout == other.timeout)
 }
 
-// Copy performs a deep copy of a Rule.
+// Copy performs a deep copy of a Rule. The copy shares
+// the counters and limiters of the original rule.
 func (qr *Rule) Copy() (newqr *Rule) {
 	newqr = &Rule{
-		Description: qr.Description,
-		Name:        qr.Name,
-		requestIP:   qr.requestIP,
-		user:        qr.user,
-		query:       qr.query,
-		act:         qr.act,
+		Description:    qr.Description,
+		Name:           qr.Name,
+		requestIP:      qr.requestIP,
+		user:           qr.user,
+		query:          qr.query,
+		act:            qr.act,
+		rateLimit:      qr.rateLimit,
+		rateLimitBurst: qr.rateLimitBurst,
+		rateLimitKey:   qr.rateLimitKey,
+		maxConcurrency: qr.maxConcurrency,
+		delay:          qr.delay,
+		workload:       qr.workload,
+		timeout:        qr.timeout,
+		state:          qr.state,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -274,6 +326,12 @@ func (qr *Rule) Copy() (newqr *Rule) {
 
 // MarshalJSON marshals to JSON.
 func (qr *Rule) MarshalJSON() ([]byte, error) {
+	return qr.marshalJSON(false), nil
+}
+
+// marshalJSON marshals the rule to JSON. If withStats is set,
+// the counters of the rule are included.
+func (qr *Rule) marshalJSON(withStats bool) []byte {
 	b := bytes.NewBuffer(nil)
 	safeEncode(b, `{"Description":`, qr.Description)
 	safeEncode(b, `,"Name":`, qr.Name)
@@ -298,8 +356,32 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.rateLimit != 0 {
+		safeEncode(b, `,"QPS":`, qr.rateLimit)
+	}
+	if qr.rateLimitBurst != 0 {
+		safeEncode(b, `,"Burst":`, qr.rateLimitBurst)
+	}
+	if qr.rateLimitKey != LimitByRule {
+		safeEncode(b, `,"LimitKey":`, qr.rateLimitKey)
+	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.delay != 0 {
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	}
+	if qr.workload != querypb.ExecuteOptions_UNSPECIFIED {
+		safeEncode(b, `,"Workload":`, qr.workload.String())
+	}
+	if qr.timeout != 0 {
+		safeEncode(b, `,"Timeout":`, qr.timeout.String())
+	}
+	if withStats {
+		qr.state.marshalJSON(b, qr.act)
+	}
 	_, _ = b.WriteString("}")
-	return b.Bytes(), nil
+	return b.Bytes()
 }
 
 // SetIPCond adds a regular expression condition for the client IP.
@@ -339,6 +421,86 @@ func (qr *Rule) SetQueryCond(pattern string) (err error) {
 	return
 }
 
+// SetRateLimit sets the parameters of the QRRateLimit action.
+// qps is the number of queries per second allowed by the rule, and
+// burst the number of queries that can be sent at once. If burst is 0,
+// it defaults to one second worth of queries. key specifies whether
+// the limit applies to all the queries that match the rule, or
+// separately to each user or client IP.
+func (qr *Rule) SetRateLimit(qps float64, burst int, key LimitKey) {
+	qr.rateLimit = qps
+	qr.rateLimitBurst = burst
+	qr.rateLimitKey = key
+}
+
+// SetMaxConcurrency sets the number of queries matching the rule that
+// can run at the same time for the QRConcurrencyLimit action.
+func (qr *Rule) SetMaxConcurrency(maxConcurrency int64) {
+	qr.maxConcurrency = maxConcurrency
+}
+
+// SetDelay sets how long the queries matching the rule are held
+// before they're executed for the QRDelay action.
+func (qr *Rule) SetDelay(delay time.Duration) {
+	qr.delay = delay
+}
+
+// SetOverride sets the parameters of the QROverride action.
+// If workload is OLAP, the queries matching the rule are executed
+// with the OLAP connection pool. Only the pool changes: the queries
+// keep the timeout and the result size limits of their own workload,
+// since their results are still returned in a single response.
+// If timeout is not 0, it is used as the timeout of the queries, if
+// it's shorter than the one they have.
+func (qr *Rule) SetOverride(workload querypb.ExecuteOptions_Workload, timeout time.Duration) {
+	qr.workload = workload
+	qr.timeout = timeout
+}
+
+// Action returns the action of the rule.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// Delay returns the delay of the QRDelay action.
+func (qr *Rule) Delay() time.Duration {
+	return qr.delay
+}
+
+// Workload returns the workload of the QROverride action.
+func (qr *Rule) Workload() querypb.ExecuteOptions_Workload {
+	return qr.workload
+}
+
+// Timeout returns the query timeout of the QROverride action.
+func (qr *Rule) Timeout() time.Duration {
+	return qr.timeout
+}
+
+// validate checks that the parameters of the rule are consistent
+// with its action.
+func (qr *Rule) validate() error {
+	if qr.rateLimit < 0 || qr.rateLimitBurst < 0 || qr.maxConcurrency < 0 || qr.delay < 0 || qr.timeout < 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "negative parameter in rule %s", qr.Name)
+	}
+	if (qr.act == QRRateLimit) != (qr.rateLimit != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "QPS must be set with, and only with, the RATE_LIMIT action")
+	}
+	if qr.act != QRRateLimit && (qr.rateLimitBurst != 0 || qr.rateLimitKey != LimitByRule) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Burst and LimitKey are only valid with the RATE_LIMIT action")
+	}
+	if (qr.act == QRConcurrencyLimit) != (qr.maxConcurrency != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency must be set with, and only with, the CONCURRENCY_LIMIT action")
+	}
+	if (qr.act == QRDelay) != (qr.delay != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay must be set with, and only with, the DELAY action")
+	}
+	if (qr.act == QROverride) != (qr.workload != querypb.ExecuteOptions_UNSPECIFIED || qr.timeout != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Workload or Timeout must be set with, and only with, the OVERRIDE action")
+	}
+	return nil
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -490,23 +652,61 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	// QRRateLimit fails the queries that exceed the rate limit of the rule.
+	QRRateLimit
+	// QRConcurrencyLimit fails the queries that exceed the number of
+	// queries the rule allows to run at the same time.
+	QRConcurrencyLimit
+	// QRDelay holds the queries for the delay of the rule.
+	QRDelay
+	// QROverride executes the queries with the connection pool
+	// of the workload and the timeout of the rule.
+	QROverride
 )
 
+var actionNames = map[Action]string{
+	QRFail:             "FAIL",
+	QRFailRetry:        "FAIL_RETRY",
+	QRRateLimit:        "RATE_LIMIT",
+	QRConcurrencyLimit: "CONCURRENCY_LIMIT",
+	QRDelay:            "DELAY",
+	QROverride:         "OVERRIDE",
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
-	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
+	str, ok := actionNames[act]
+	if !ok {
 		str = "INVALID"
 	}
 	return json.Marshal(str)
 }
 
+// LimitKey specifies how the queries matching a
+// QRRateLimit rule are grouped for rate limiting.
+type LimitKey int
+
+// These are the limit keys.
+const (
+	// LimitByRule applies the rate limit to all the queries of the rule.
+	LimitByRule = LimitKey(iota)
+	// LimitByUser applies the rate limit to each user separately.
+	LimitByUser
+	// LimitByIP applies the rate limit to each client IP separately.
+	LimitByIP
+)
+
+var limitKeyNames = map[LimitKey]string{
+	LimitByRule: "",
+	LimitByUser: "user",
+	LimitByIP:   "ip",
+}
+
+// MarshalJSON marshals to JSON.
+func (key LimitKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(limitKeyNames[key])
+}
+
 // BindVarCond represents a bind var condition.
 type BindVarCond struct {
 	name       string
@@ -789,6 +989,7 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var nv json.Number
 		var ok bool
 		switch k {
 		case "Name", "Description", "RequestIP", "User", "Query", "Action":
@@ -796,11 +997,21 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
+		case "LimitKey", "Delay", "Workload", "Timeout":
+			sv, ok = v.(string)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
+			}
 		case "Plans", "BindVarConds", "TableNames":
 			lv, ok = v.([]interface{})
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
 			}
+		case "QPS", "Burst", "MaxConcurrency":
+			nv, ok = getNumber(v)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
 		default:
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s", k)
 		}
@@ -861,14 +1072,77 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "RATE_LIMIT":
+				qr.act = QRRateLimit
+			case "CONCURRENCY_LIMIT":
+				qr.act = QRConcurrencyLimit
+			case "DELAY":
+				qr.act = QRDelay
+			case "OVERRIDE":
+				qr.act = QROverride
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "QPS":
+			qr.rateLimit, err = nv.Float64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for QPS: %v", v)
+			}
+		case "Burst":
+			burst, err := nv.Int64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64 for Burst: %v", v)
+			}
+			qr.rateLimitBurst = int(burst)
+		case "LimitKey":
+			switch sv {
+			case "user":
+				qr.rateLimitKey = LimitByUser
+			case "ip":
+				qr.rateLimitKey = LimitByIP
+			default:
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid LimitKey %s", sv)
+			}
+		case "MaxConcurrency":
+			qr.maxConcurrency, err = nv.Int64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64 for MaxConcurrency: %v", v)
+			}
+		case "Delay":
+			qr.delay, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Delay %s: %v", sv, err)
+			}
+		case "Workload":
+			if sv != querypb.ExecuteOptions_OLAP.String() {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Workload %s, only OLAP is supported", sv)
+			}
+			qr.workload = querypb.ExecuteOptions_OLAP
+		case "Timeout":
+			qr.timeout, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Timeout %s: %v", sv, err)
+			}
 		}
 	}
+	if err := qr.validate(); err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
+// getNumber returns v as a json.Number. v is a float64 instead
+// if the rule was not decoded with json.Decoder.UseNumber.
+func getNumber(v interface{}) (json.Number, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		return v, true
+	case float64:
+		return json.Number(strconv.FormatFloat(v, 'f', -1, 64)), true
+	}
+	return "", false
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
@@ -550,6 +550,29 @@ func TestImport(t *testing.T) {
 		"Description": "desc2",
 		"Name": "name2",
 		"Action": "FAIL"
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"Action": "RATE_LIMIT",
+		"QPS": 2.5,
+		"Burst": 5,
+		"LimitKey": "user"
+	},{
+		"Description": "desc4",
+		"Name": "name4",
+		"Action": "CONCURRENCY_LIMIT",
+		"MaxConcurrency": 10
+	},{
+		"Description": "desc5",
+		"Name": "name5",
+		"Action": "DELAY",
+		"Delay": "100ms"
+	},{
+		"Description": "desc6",
+		"Name": "name6",
+		"Action": "OVERRIDE",
+		"Workload": "OLAP",
+		"Timeout": "1m0s"
 	}]`
 	err := qrs.UnmarshalJSON([]byte(jsondata))
 	if err != nil {
@@ -672,6 +695,19 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"Action": "RATE_LIMIT", "QPS": "1"}]`, "want number for QPS"},
+	{`[{"Action": "RATE_LIMIT", "QPS": 1, "Burst": 1.5}]`, "want int64 for Burst: 1.5"},
+	{`[{"Action": "RATE_LIMIT", "QPS": 1, "LimitKey": "foo"}]`, "invalid LimitKey foo"},
+	{`[{"Action": "RATE_LIMIT"}]`, "QPS must be set with, and only with, the RATE_LIMIT action"},
+	{`[{"Action": "FAIL", "QPS": 1}]`, "QPS must be set with, and only with, the RATE_LIMIT action"},
+	{`[{"Action": "FAIL", "LimitKey": "ip"}]`, "Burst and LimitKey are only valid with the RATE_LIMIT action"},
+	{`[{"Action": "CONCURRENCY_LIMIT", "MaxConcurrency": -1}]`, "negative parameter in rule "},
+	{`[{"Action": "CONCURRENCY_LIMIT"}]`, "MaxConcurrency must be set with, and only with, the CONCURRENCY_LIMIT action"},
+	{`[{"Action": "DELAY", "Delay": "1"}]`, "invalid Delay 1: time: missing unit in duration \"1\""},
+	{`[{"Action": "DELAY"}]`, "Delay must be set with, and only with, the DELAY action"},
+	{`[{"Action": "OVERRIDE", "Workload": "DBA"}]`, "invalid Workload DBA, only OLAP is supported"},
+	{`[{"Action": "OVERRIDE"}]`, "Workload or Timeout must be set with, and only with, the OVERRIDE action"},
+	{`[{"Action": "FAIL", "Timeout": "1s"}]`, "Workload or Timeout must be set with, and only with, the OVERRIDE action"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -40,6 +40,8 @@ const (
 	NotOnMaster = "notOnMaster"
 	Polling     = "polling"
 	Heartbeat   = "heartbeat"
+	Fail        = "fail"
+	Throttle    = "throttle"
 )
 
 var (
@@ -138,6 +140,13 @@ func init() {
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
 
+	flag.StringVar(&currentConfig.QueryQuarantine.Mode, "query_quarantine_mode", defaultConfig.QueryQuarantine.Mode, "Quarantine of the queries that repeatedly time out or run too long: disable, fail or throttle. In fail mode, the quarantined queries are rejected. In throttle mode, they are rate limited to -query_quarantine_throttle_qps.")
+	flag.IntVar(&currentConfig.QueryQuarantine.Threshold, "query_quarantine_threshold", defaultConfig.QueryQuarantine.Threshold, "Number of timeouts or slow executions of a query within -query_quarantine_window after which the query is quarantined.")
+	SecondsVar(&currentConfig.QueryQuarantine.SlowQuerySeconds, "query_quarantine_slow_query_time", defaultConfig.QueryQuarantine.SlowQuerySeconds, "Execution time (in seconds) above which a query execution counts toward its quarantine. If 0, only the query timeouts count. Streaming queries only count their timeouts.")
+	SecondsVar(&currentConfig.QueryQuarantine.WindowSeconds, "query_quarantine_window", defaultConfig.QueryQuarantine.WindowSeconds, "Time window (in seconds) in which the timeouts and slow executions of a query are counted.")
+	SecondsVar(&currentConfig.QueryQuarantine.CoolOffSeconds, "query_quarantine_cool_off", defaultConfig.QueryQuarantine.CoolOffSeconds, "How long (in seconds) a query stays quarantined.")
+	flag.Float64Var(&currentConfig.QueryQuarantine.ThrottleQPS, "query_quarantine_throttle_qps", defaultConfig.QueryQuarantine.ThrottleQPS, "Number of queries per second allowed for a quarantined query in throttle mode.")
+
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
 	flag.Float64Var(&currentConfig.TransactionLimitPerUser, "transaction_limit_per_user", defaultConfig.TransactionLimitPerUser, "Maximum number of transactions a single user is allowed to use at any time, represented as fraction of -transaction_cap.")
@@ -236,8 +245,15 @@ type TabletConfig struct {
 	OlapReadPool ConnPoolConfig `json:"olapReadPool,omitempty"`
 	TxPool       ConnPoolConfig `json:"txPool,omitempty"`
 
+	// WorkloadPools are the named pools that isolate the non-transactional
+	// queries of some callers from the other ones. They share
+	// WorkloadPoolsCapacity connections, the sum of their sizes if 0.
+	WorkloadPools         []WorkloadPoolConfig `json:"workloadPools,omitempty"`
+	WorkloadPoolsCapacity int                  `json:"workloadPoolsCapacity,omitempty"`
+
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
+	QueryQuarantine  QueryQuarantineConfig  `json:"queryQuarantine,omitempty"`
 
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
@@ -292,6 +308,18 @@ type ConnPoolConfig struct {
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
 }
 
+// WorkloadPoolConfig contains the config for a workload pool.
+// A workload pool is a conn pool dedicated to the queries that are sent
+// by one of its principals or components, or by one of its directive
+// principals with the WORKLOAD_POOL comment directive.
+type WorkloadPoolConfig struct {
+	Name string `json:"name,omitempty"`
+	ConnPoolConfig
+	Principals          []string `json:"principals,omitempty"`
+	Components          []string `json:"components,omitempty"`
+	DirectivePrincipals []string `json:"directivePrincipals,omitempty"`
+}
+
 // OltpConfig contains the config for oltp settings.
 type OltpConfig struct {
 	QueryTimeoutSeconds Seconds `json:"queryTimeoutSeconds,omitempty"`
@@ -300,6 +328,35 @@ type OltpConfig struct {
 	WarnRows            int     `json:"warnRows,omitempty"`
 }
 
+// QueryQuarantineConfig contains the config for the quarantine of the
+// queries that repeatedly time out or run too long.
+type QueryQuarantineConfig struct {
+	// Mode can be disable, fail or throttle. Default is disable.
+	Mode string `json:"mode,omitempty"`
+	// Threshold is the number of timeouts or slow executions of a
+	// query within WindowSeconds after which it's quarantined.
+	Threshold int `json:"threshold,omitempty"`
+	// SlowQuerySeconds is the execution time above which an execution
+	// counts as slow. If 0, only the timeouts count. The streaming
+	// queries only count their timeouts.
+	SlowQuerySeconds Seconds `json:"slowQuerySeconds,omitempty"`
+	WindowSeconds    Seconds `json:"windowSeconds,omitempty"`
+	CoolOffSeconds   Seconds `json:"coolOffSeconds,omitempty"`
+	ThrottleQPS      float64 `json:"throttleQPS,omitempty"`
+}
+
+// QuarantinedQuery describes a query quarantined by the tablet server.
+type QuarantinedQuery struct {
+	Fingerprint string
+	// Action is the action of the quarantine rule, FAIL or RATE_LIMIT.
+	Action     string
+	Reason     string
+	StartTime  time.Time
+	ExpireTime time.Time
+	// Hits is the number of queries that hit the quarantine rule.
+	Hits int64
+}
+
 // HotRowProtectionConfig contains the config for hot row protection.
 type HotRowProtectionConfig struct {
 	// Mode can be disable, dryRun or enable. Default is disable.
@@ -358,6 +415,10 @@ func (c *TabletConfig) Clone() *TabletConfig {
 	if tc.DB != nil {
 		tc.DB = c.DB.Clone()
 	}
+	if tc.WorkloadPools != nil {
+		tc.WorkloadPools = make([]WorkloadPoolConfig, len(c.WorkloadPools))
+		copy(tc.WorkloadPools, c.WorkloadPools)
+	}
 	return &tc
 }
 
@@ -378,6 +439,72 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if err := c.verifyQueryQuarantine(); err != nil {
+		return err
+	}
+	return c.verifyWorkloadPools()
+}
+
+// verifyQueryQuarantine checks the query quarantine settings
+// if the quarantine is enabled.
+func (c *TabletConfig) verifyQueryQuarantine() error {
+	qq := c.QueryQuarantine
+	switch qq.Mode {
+	case "", Disable:
+		return nil
+	case Fail:
+	case Throttle:
+		if qq.ThrottleQPS <= 0 {
+			return fmt.Errorf("-query_quarantine_throttle_qps must be > 0 (specified value: %v)", qq.ThrottleQPS)
+		}
+	default:
+		return fmt.Errorf("-query_quarantine_mode must be %v, %v or %v (specified value: %v)", Disable, Fail, Throttle, qq.Mode)
+	}
+	if qq.Threshold <= 0 {
+		return fmt.Errorf("-query_quarantine_threshold must be > 0 (specified value: %v)", qq.Threshold)
+	}
+	if qq.WindowSeconds <= 0 {
+		return fmt.Errorf("-query_quarantine_window must be > 0 (specified value: %v)", qq.WindowSeconds)
+	}
+	if qq.CoolOffSeconds <= 0 {
+		return fmt.Errorf("-query_quarantine_cool_off must be > 0 (specified value: %v)", qq.CoolOffSeconds)
+	}
+	return nil
+}
+
+// verifyWorkloadPools checks that the workload pools have distinct
+// names and callers.
+func (c *TabletConfig) verifyWorkloadPools() error {
+	if c.WorkloadPoolsCapacity < 0 {
+		return fmt.Errorf("workloadPoolsCapacity must be >= 0 (specified value: %v)", c.WorkloadPoolsCapacity)
+	}
+	names := make(map[string]bool)
+	principals := make(map[string]string)
+	components := make(map[string]string)
+	for _, wp := range c.WorkloadPools {
+		if wp.Name == "" {
+			return errors.New("workload pools must have a name")
+		}
+		if names[wp.Name] {
+			return fmt.Errorf("duplicate workload pool %v", wp.Name)
+		}
+		names[wp.Name] = true
+		if wp.Size <= 0 {
+			return fmt.Errorf("size of workload pool %v must be > 0 (specified value: %v)", wp.Name, wp.Size)
+		}
+		for _, principal := range wp.Principals {
+			if other, ok := principals[principal]; ok {
+				return fmt.Errorf("principal %v is assigned to workload pools %v and %v", principal, other, wp.Name)
+			}
+			principals[principal] = wp.Name
+		}
+		for _, component := range wp.Components {
+			if other, ok := components[component]; ok {
+				return fmt.Errorf("component %v is assigned to workload pools %v and %v", component, other, wp.Name)
+			}
+			components[component] = wp.Name
+		}
+	}
 	return nil
 }
 
@@ -452,6 +579,13 @@ var defaultConfig = TabletConfig{
 		// of them ready in MySQL and profit from a pipelining effect.
 		MaxConcurrency: 5,
 	},
+	QueryQuarantine: QueryQuarantineConfig{
+		Mode:           Disable,
+		Threshold:      5,
+		WindowSeconds:  60,
+		CoolOffSeconds: 5 * 60,
+		ThrottleQPS:    1,
+	},
 	Consolidator:                Enable,
 	ConsolidatorStreamTotalSize: 128 * 1024 * 1024,
 	ConsolidatorStreamQuerySize: 2 * 1024 * 1024,
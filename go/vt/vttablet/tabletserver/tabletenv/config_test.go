@@ -78,6 +78,7 @@ oltpReadPool:
   prefillParallelism: 30
   size: 16
   timeoutSeconds: 10
+queryQuarantine: {}
 replicationTracker: {}
 txPool: {}
 `
@@ -138,6 +139,12 @@ oltpReadPool:
 queryCacheLFU: true
 queryCacheMemory: 33554432
 queryCacheSize: 5000
+queryQuarantine:
+  coolOffSeconds: 300
+  mode: disable
+  threshold: 5
+  throttleQPS: 1
+  windowSeconds: 60
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
@@ -197,6 +204,13 @@ func TestFlags(t *testing.T) {
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		QueryQuarantine: QueryQuarantineConfig{
+			Mode:           Disable,
+			Threshold:      5,
+			WindowSeconds:  60,
+			CoolOffSeconds: 300,
+			ThrottleQPS:    1,
+		},
 		StreamBufferSize:                        32768,
 		QueryCacheSize:                          int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:                        cache.DefaultConfig.MaxMemoryUsage,
@@ -333,3 +347,83 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestWorkloadPoolsConfig(t *testing.T) {
+	inBytes := []byte(`workloadPools:
+- name: batch
+  size: 4
+  maxWaiters: 10
+  timeoutSeconds: 2
+  principals: [etl]
+  components: [reports, backfill]
+  directivePrincipals: [app]
+- name: admin
+  size: 1
+workloadPoolsCapacity: 3
+`)
+	var cfg TabletConfig
+	err := yaml2.Unmarshal(inBytes, &cfg)
+	require.NoError(t, err)
+	want := []WorkloadPoolConfig{{
+		Name: "batch",
+		ConnPoolConfig: ConnPoolConfig{
+			Size:           4,
+			MaxWaiters:     10,
+			TimeoutSeconds: 2,
+		},
+		Principals:          []string{"etl"},
+		Components:          []string{"reports", "backfill"},
+		DirectivePrincipals: []string{"app"},
+	}, {
+		Name:           "admin",
+		ConnPoolConfig: ConnPoolConfig{Size: 1},
+	}}
+	assert.Equal(t, want, cfg.WorkloadPools)
+	assert.Equal(t, 3, cfg.WorkloadPoolsCapacity)
+	assert.NoError(t, cfg.verifyWorkloadPools())
+
+	// The clone doesn't share the pools.
+	clone := cfg.Clone()
+	clone.WorkloadPools[1].Size = 2
+	assert.Equal(t, 1, cfg.WorkloadPools[1].Size)
+
+	cfg.WorkloadPools[1].Principals = []string{"etl"}
+	assert.EqualError(t, cfg.verifyWorkloadPools(), "principal etl is assigned to workload pools batch and admin")
+	cfg.WorkloadPools[1].Principals = nil
+	cfg.WorkloadPools[1].Components = []string{"reports"}
+	assert.EqualError(t, cfg.verifyWorkloadPools(), "component reports is assigned to workload pools batch and admin")
+	cfg.WorkloadPools[1].Components = nil
+	cfg.WorkloadPools[1].Name = "batch"
+	assert.EqualError(t, cfg.verifyWorkloadPools(), "duplicate workload pool batch")
+	cfg.WorkloadPools[1].Name = ""
+	assert.EqualError(t, cfg.verifyWorkloadPools(), "workload pools must have a name")
+	cfg.WorkloadPools[1].Name = "admin"
+	cfg.WorkloadPools[1].Size = 0
+	assert.EqualError(t, cfg.verifyWorkloadPools(), "size of workload pool admin must be > 0 (specified value: 0)")
+	cfg.WorkloadPools[1].Size = 1
+	cfg.WorkloadPoolsCapacity = -1
+	assert.EqualError(t, cfg.verifyWorkloadPools(), "workloadPoolsCapacity must be >= 0 (specified value: -1)")
+}
+
+func TestQueryQuarantineConfig(t *testing.T) {
+	cfg := NewDefaultConfig()
+	require.NoError(t, cfg.Verify())
+
+	cfg.QueryQuarantine.Mode = Throttle
+	require.NoError(t, cfg.Verify())
+
+	cfg.QueryQuarantine.ThrottleQPS = 0
+	assert.EqualError(t, cfg.Verify(), "-query_quarantine_throttle_qps must be > 0 (specified value: 0)")
+
+	cfg.QueryQuarantine.Mode = Fail
+	require.NoError(t, cfg.Verify())
+
+	cfg.QueryQuarantine.Threshold = 0
+	assert.EqualError(t, cfg.Verify(), "-query_quarantine_threshold must be > 0 (specified value: 0)")
+
+	cfg.QueryQuarantine.Mode = Disable
+	require.NoError(t, cfg.Verify())
+
+	cfg.QueryQuarantine.Mode = "reject"
+	assert.EqualError(t, cfg.Verify(), "-query_quarantine_mode must be disable, fail or throttle (specified value: reject)")
+}
@@ -435,6 +435,18 @@ func (tsv *TabletServer) ClearQueryPlanCache() {
 	tsv.qe.ClearQueryPlanCache()
 }
 
+// QueryQuarantines returns the queries currently quarantined.
+func (tsv *TabletServer) QueryQuarantines() []*tabletenv.QuarantinedQuery {
+	return tsv.qe.quarantine.List()
+}
+
+// ClearQueryQuarantines releases the quarantined query fingerprint, or all
+// the quarantined queries if fingerprint is empty. It returns the number
+// of queries released.
+func (tsv *TabletServer) ClearQueryQuarantines(fingerprint string) int {
+	return tsv.qe.quarantine.Clear(fingerprint)
+}
+
 // QueryService returns the QueryService part of TabletServer.
 func (tsv *TabletServer) QueryService() queryservice.QueryService {
 	return tsv
@@ -502,7 +514,7 @@ func (tsv *TabletServer) begin(ctx context.Context, target *querypb.Target, preQ
 }
 
 // Commit commits the specified transaction.
-func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (newReservedID int64, err error) {
+func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (newReservedID int64, sessionStateChanges string, err error) {
 	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"Commit", "commit", nil,
@@ -512,7 +524,7 @@ func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, tra
 			logStats.TransactionID = transactionID
 
 			var commitSQL string
-			newReservedID, commitSQL, err = tsv.te.Commit(ctx, transactionID)
+			newReservedID, commitSQL, sessionStateChanges, err = tsv.te.Commit(ctx, transactionID)
 			if newReservedID > 0 {
 				// commit executed on old reserved id.
 				logStats.ReservedID = transactionID
@@ -529,7 +541,7 @@ func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, tra
 			return err
 		},
 	)
-	return newReservedID, err
+	return newReservedID, sessionStateChanges, err
 }
 
 // Rollback rollsback the specified transaction.
@@ -899,7 +911,7 @@ func (tsv *TabletServer) ExecuteBatch(ctx context.Context, target *querypb.Targe
 		results = append(results, *localReply)
 	}
 	if asTransaction {
-		if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
+		if _, _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 			transactionID = 0
 			return nil, err
 		}
@@ -1105,7 +1117,7 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 	if err != nil {
 		return 0, err
 	}
-	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
+	if _, _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
 		return 0, err
 	}
@@ -265,6 +265,31 @@ func TestTabletServerRedoLogIsKeptBetweenRestarts(t *testing.T) {
 	assert.Empty(t, tsv.te.preparedPool.conns, "tsv.te.preparedPool.conns")
 }
 
+func TestTabletServerRedoLogFailure(t *testing.T) {
+	txe, tsv, db := newTestTxExecutor(t)
+	defer tsv.StopService()
+	defer db.Close()
+	tsv.SetServingType(topodatapb.TabletType_REPLICA, time.Time{}, true, "")
+
+	// The prepared transactions can't be committed if they
+	// couldn't be prepared again from the redo log.
+	db.AddRejectedQuery(tsv.te.twoPC.readAllRedo, errors.New("redo log unavailable"))
+	tsv.SetServingType(topodatapb.TabletType_MASTER, time.Time{}, true, "")
+	tsv.TwoPCEngineWait()
+	assert.False(t, tsv.te.preparedPool.IsOpen())
+	err := txe.CommitPrepared("aa")
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	tsv.SetServingType(topodatapb.TabletType_REPLICA, time.Time{}, true, "")
+	db.DeleteRejectedQuery(tsv.te.twoPC.readAllRedo)
+	db.AddQuery(tsv.te.twoPC.readAllRedo, &sqltypes.Result{})
+	tsv.SetServingType(topodatapb.TabletType_MASTER, time.Time{}, true, "")
+	tsv.TwoPCEngineWait()
+	assert.True(t, tsv.te.preparedPool.IsOpen())
+	err = txe.CommitPrepared("aa")
+	require.NoError(t, err)
+}
+
 func TestTabletServerCreateTransaction(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer tsv.StopService()
@@ -457,7 +482,7 @@ func TestTabletServerCommitTransaction(t *testing.T) {
 	require.NoError(t, err)
 	_, err = tsv.Execute(ctx, &target, executeSQL, nil, transactionID, 0, nil)
 	require.NoError(t, err)
-	_, err = tsv.Commit(ctx, &target, transactionID)
+	_, _, err = tsv.Commit(ctx, &target, transactionID)
 	require.NoError(t, err)
 }
 
@@ -467,7 +492,7 @@ func TestTabletServerCommiRollbacktFail(t *testing.T) {
 	defer db.Close()
 
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
-	_, err := tsv.Commit(ctx, &target, -1)
+	_, _, err := tsv.Commit(ctx, &target, -1)
 	want := "transaction -1: not found"
 	require.Equal(t, want, err.Error())
 	_, err = tsv.Rollback(ctx, &target, -1)
@@ -655,7 +680,7 @@ func TestTabletServerReserveAndBeginCommit(t *testing.T) {
 	require.Error(t, err)
 
 	// commit
-	newRID, err := tsv.Commit(ctx, &target, txID)
+	newRID, _, err := tsv.Commit(ctx, &target, txID)
 	require.NoError(t, err)
 	assert.NotEqual(t, rID, newRID)
 	rID = newRID
@@ -998,7 +1023,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
-		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1018,7 +1043,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		// open a second connection while the request of the first connection is
 		// still pending.
 		<-tx3Finished
-		if _, err := tsv.Commit(ctx, &target, tx2); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx2); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1033,7 +1058,7 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
-		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 		close(tx3Finished)
@@ -1064,7 +1089,7 @@ func TestDMLQueryWithoutWhereClause(t *testing.T) {
 
 	_, txid, _, err := tsv.BeginExecute(ctx, &target, nil, q, nil, 0, nil)
 	require.NoError(t, err)
-	_, err = tsv.Commit(ctx, &target, txid)
+	_, _, err = tsv.Commit(ctx, &target, txid)
 	require.NoError(t, err)
 }
 
@@ -1247,7 +1272,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
 
-		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1266,7 +1291,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q2, err)
 		}
 
-		if _, err := tsv.Commit(ctx, &target, tx2); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx2); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1285,7 +1310,7 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
 
-		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1378,7 +1403,7 @@ func TestSerializeTransactionsSameRow_TooManyPendingRequests(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
-		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1554,7 +1579,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q1, err)
 		}
 
-		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx1); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -1591,7 +1616,7 @@ func TestSerializeTransactionsSameRow_RequestCanceled(t *testing.T) {
 			t.Errorf("failed to execute query: %s: %s", q3, err)
 		}
 
-		if _, err := tsv.Commit(ctx, &target, tx3); err != nil {
+		if _, _, err := tsv.Commit(ctx, &target, tx3); err != nil {
 			t.Errorf("call TabletServer.Commit failed: %v", err)
 		}
 	}()
@@ -2263,7 +2288,7 @@ func TestDatabaseNameReplaceByKeyspaceNameExecuteMethod(t *testing.T) {
 	for _, field := range res.Fields {
 		require.Equal(t, "keyspaceName", field.Database)
 	}
-	_, err = tsv.Commit(ctx, target, transactionID)
+	_, _, err = tsv.Commit(ctx, target, transactionID)
 	require.NoError(t, err)
 }
 
@@ -2371,7 +2396,7 @@ func TestDatabaseNameReplaceByKeyspaceNameBeginExecuteMethod(t *testing.T) {
 	for _, field := range res.Fields {
 		require.Equal(t, "keyspaceName", field.Database)
 	}
-	_, err = tsv.Commit(ctx, target, transactionID)
+	_, _, err = tsv.Commit(ctx, target, transactionID)
 	require.NoError(t, err)
 }
 
@@ -2419,7 +2444,7 @@ func TestDatabaseNameReplaceByKeyspaceNameBeginExecuteBatchMethod(t *testing.T)
 			require.Equal(t, "keyspaceName", field.Database)
 		}
 	}
-	_, err = tsv.Commit(ctx, target, transactionID)
+	_, _, err = tsv.Commit(ctx, target, transactionID)
 	require.NoError(t, err)
 }
 
@@ -19,6 +19,7 @@ type CheckResult struct {
 	Threshold  float64 `json:"Threshold"`
 	Error      error   `json:"-"`
 	Message    string  `json:"Message"`
+	MetricName string  `json:"MetricName"`
 }
 
 // NewCheckResult returns a CheckResult
@@ -35,6 +36,13 @@ func NewCheckResult(statusCode int, value float64, threshold float64, err error)
 	return result
 }
 
+// withMetricName returns a copy of this result, for the given metric
+func (result *CheckResult) withMetricName(metricName string) *CheckResult {
+	namedResult := *result
+	namedResult.MetricName = metricName
+	return &namedResult
+}
+
 // NewErrorCheckResult returns a check result that indicates an error
 func NewErrorCheckResult(statusCode int, err error) *CheckResult {
 	return NewCheckResult(statusCode, 0, 0, err)
@@ -6,12 +6,25 @@
 
 package config
 
-// Instance is the one configuration for the throttler
-var Instance = &ConfigurationSettings{}
+import "sync/atomic"
+
+// instance is the one configuration for the throttler. It is replaced
+// whenever the configuration changes, and read concurrently.
+var instance atomic.Value
+
+func init() {
+	instance.Store(&ConfigurationSettings{})
+}
 
 // Settings returns the settings of the global instance of Configuration
 func Settings() *ConfigurationSettings {
-	return Instance
+	return instance.Load().(*ConfigurationSettings)
+}
+
+// SetSettings replaces the settings of the global instance of Configuration.
+// The settings must not be modified afterwards.
+func SetSettings(settings *ConfigurationSettings) {
+	instance.Store(settings)
 }
 
 // ConfigurationSettings models a set of configurable values, that can be
@@ -31,6 +31,17 @@ const (
 	MetricsQueryTypeUnknown
 )
 
+// LagMetricName is the name of the replication lag metric, which the
+// throttler always checks. Its query and threshold are set by flags.
+const LagMetricName = "lag"
+
+// BuiltinMetricQueries are the queries of the named metrics that the
+// throttler knows how to read without a query configured
+var BuiltinMetricQueries = map[string]string{
+	"threads_running":     "show global status like 'threads_running'",
+	"history_list_length": "select count from information_schema.innodb_metrics where name = 'trx_rseg_history_len'",
+}
+
 var mysqlMetricCache = cache.New(cache.NoExpiration, 10*time.Millisecond)
 
 func getMySQLMetricCacheKey(probe *Probe) string {
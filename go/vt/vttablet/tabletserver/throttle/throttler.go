@@ -13,6 +13,7 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
 
 	"github.com/patrickmn/go-cache"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -78,6 +80,9 @@ var (
 	}
 	sqlGrantThrottlerUser = []string{
 		`GRANT SELECT ON _vt.heartbeat TO %s`,
+		// PROCESS is needed to read information_schema.innodb_metrics. Custom
+		// metric queries may need more grants, given to the throttler user manually.
+		`GRANT PROCESS ON *.* TO %s`,
 	}
 	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
 )
@@ -121,7 +126,14 @@ type Throttler struct {
 
 	metricsQuery     string
 	MetricsThreshold sync2.AtomicFloat64
-	metricsQueryType mysql.MetricsQueryType
+
+	// throttlerConfig is the throttler config of the keyspace in topo,
+	// and shardPassword is the password of the throttler user when this
+	// tablet created it. Both are protected by initMutex.
+	throttlerConfig *topodatapb.ThrottlerConfig
+	shardPassword   string
+	// metricNames has the names of the checked metrics, lag first.
+	metricNames atomic.Value
 
 	mysqlClusterThresholds *cache.Cache
 	aggregatedMetrics      *cache.Cache
@@ -224,7 +236,8 @@ func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 // initThrottler initializes config
 func (throttler *Throttler) initConfig(password string) {
 	log.Infof("Throttler: initializing config")
-	config.Instance = &config.ConfigurationSettings{
+	throttler.shardPassword = password
+	settings := &config.ConfigurationSettings{
 		Stores: config.StoresSettings{
 			MySQL: config.MySQLConfigurationSettings{
 				IgnoreDialTCPErrors: true,
@@ -236,26 +249,96 @@ func (throttler *Throttler) initConfig(password string) {
 		throttler.metricsQuery = *throttleMetricQuery
 	}
 	if *throttleMetricThreshold != math.MaxFloat64 {
-		throttler.MetricsThreshold = sync2.NewAtomicFloat64(*throttleMetricThreshold)
-	}
-	throttler.metricsQueryType = mysql.GetMetricsQueryType(throttler.metricsQuery)
-
-	config.Instance.Stores.MySQL.Clusters[selfStoreName] = &config.MySQLClusterConfigurationSettings{
-		User:              "", // running on local tablet server, will use vttablet DBA user
-		Password:          "", // running on local tablet server, will use vttablet DBA user
-		MetricQuery:       throttler.metricsQuery,
-		ThrottleThreshold: throttler.MetricsThreshold.Get(),
-		IgnoreHostsCount:  0,
-	}
-	if password != "" {
-		config.Instance.Stores.MySQL.Clusters[shardStoreName] = &config.MySQLClusterConfigurationSettings{
-			User:              throttlerUser,
-			Password:          password,
-			MetricQuery:       throttler.metricsQuery,
-			ThrottleThreshold: throttler.MetricsThreshold.Get(),
+		throttler.MetricsThreshold.Set(*throttleMetricThreshold)
+	}
+
+	// Each metric is probed by its own "self" and "shard" clusters
+	addMetric := func(metricName string, query string, threshold float64) {
+		settings.Stores.MySQL.Clusters[metricClusterName(selfStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+			User:              "", // running on local tablet server, will use vttablet DBA user
+			Password:          "", // running on local tablet server, will use vttablet DBA user
+			MetricQuery:       query,
+			ThrottleThreshold: threshold,
 			IgnoreHostsCount:  0,
 		}
+		if password != "" {
+			settings.Stores.MySQL.Clusters[metricClusterName(shardStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+				User:              throttlerUser,
+				Password:          password,
+				MetricQuery:       query,
+				ThrottleThreshold: threshold,
+				IgnoreHostsCount:  0,
+			}
+		}
+	}
+	keyspaceMetrics := throttler.throttlerConfig.GetMetrics()
+	lagQuery := throttler.metricsQuery
+	lagThreshold := throttler.MetricsThreshold.Get()
+	if lagMetric, ok := keyspaceMetrics[mysql.LagMetricName]; ok {
+		// The keyspace config overrides the flags
+		if lagMetric.Query != "" {
+			lagQuery = lagMetric.Query
+		}
+		if lagMetric.Threshold > 0 {
+			lagThreshold = lagMetric.Threshold
+		}
+	}
+	addMetric(mysql.LagMetricName, lagQuery, lagThreshold)
+	metricNames := []string{mysql.LagMetricName}
+
+	keyspaceMetricNames := make([]string, 0, len(keyspaceMetrics))
+	for metricName := range keyspaceMetrics {
+		if metricName != mysql.LagMetricName {
+			keyspaceMetricNames = append(keyspaceMetricNames, metricName)
+		}
 	}
+	sort.Strings(keyspaceMetricNames)
+	for _, metricName := range keyspaceMetricNames {
+		metric := keyspaceMetrics[metricName]
+		query := metric.Query
+		if query == "" {
+			query = mysql.BuiltinMetricQueries[metricName]
+		}
+		if metricsQueryType := mysql.GetMetricsQueryType(query); metricsQueryType != mysql.MetricsQueryTypeSelect && metricsQueryType != mysql.MetricsQueryTypeShowGlobal {
+			log.Errorf("Throttler: ignoring metric %s with unsupported query: %s", metricName, query)
+			continue
+		}
+		addMetric(metricName, query, metric.Threshold)
+		metricNames = append(metricNames, metricName)
+	}
+
+	config.SetSettings(settings)
+	throttler.metricNames.Store(metricNames)
+}
+
+// metricClusterName returns the name of the MySQL cluster probing the given metric
+// in the given store. The lag metric clusters are named after their store.
+func metricClusterName(storeName string, metricName string) string {
+	if metricName == mysql.LagMetricName {
+		return storeName
+	}
+	return fmt.Sprintf("%s:%s", storeName, metricName)
+}
+
+// refreshThrottlerConfig reads the throttler config of the keyspace from topo, and
+// reinitializes the config when it has changed
+func (throttler *Throttler) refreshThrottlerConfig(ctx context.Context) {
+	if throttler.ts == nil || throttler.keyspace == "" {
+		return
+	}
+	ki, err := throttler.ts.GetKeyspace(ctx, throttler.keyspace)
+	if err != nil {
+		log.Errorf("refreshThrottlerConfig: %+v", err)
+		return
+	}
+	throttler.initMutex.Lock()
+	defer throttler.initMutex.Unlock()
+	if proto.Equal(ki.ThrottlerConfig, throttler.throttlerConfig) {
+		return
+	}
+	log.Infof("Throttler: keyspace throttler config changed: %v", ki.ThrottlerConfig)
+	throttler.throttlerConfig = ki.ThrottlerConfig
+	throttler.initConfig(throttler.shardPassword)
 }
 
 // Open opens database pool and initializes the schema
@@ -353,10 +436,10 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 	return password, nil
 }
 
-// readSelfMySQLThrottleMetric reads the mysql metric from thi very tablet's backend mysql.
-func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMetric {
+// readSelfMySQLThrottleMetric reads a mysql metric of the given "self" cluster from thi very tablet's backend mysql.
+func (throttler *Throttler) readSelfMySQLThrottleMetric(clusterName string, query string) *mysql.MySQLThrottleMetric {
 	metric := &mysql.MySQLThrottleMetric{
-		ClusterName: selfStoreName,
+		ClusterName: clusterName,
 		Key:         *mysql.SelfInstanceKey,
 		Value:       0,
 		Err:         nil,
@@ -369,7 +452,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	}
 	defer conn.Recycle()
 
-	tm, err := conn.Exec(ctx, throttler.metricsQuery, 1, true)
+	tm, err := conn.Exec(ctx, query, 1, true)
 	if err != nil {
 		metric.Err = err
 		return metric
@@ -380,7 +463,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 		return metric
 	}
 
-	switch throttler.metricsQueryType {
+	switch mysql.GetMetricsQueryType(query) {
 	case mysql.MetricsQueryTypeSelect:
 		// We expect a single row, single column result.
 		// The "for" iteration below is just a way to get first result without knowning column name
@@ -390,7 +473,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	case mysql.MetricsQueryTypeShowGlobal:
 		metric.Value, metric.Err = strconv.ParseFloat(row["Value"].ToString(), 64)
 	default:
-		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", throttler.metricsQuery)
+		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", query)
 	}
 
 	return metric
@@ -457,7 +540,9 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					if shouldCreateThrottlerUser {
 						password, err := throttler.createThrottlerUser(ctx)
 						if err == nil {
+							throttler.initMutex.Lock()
 							throttler.initConfig(password)
+							throttler.initMutex.Unlock()
 							shouldCreateThrottlerUser = false
 							// transitioned into leadership, let's speed up the next 'refresh' and 'collect' ticks
 							go mysqlRefreshTicker.TickNow()
@@ -536,11 +621,13 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 					}
 					defer atomic.StoreInt64(&probe.QueryInProgress, 0)
 
-					// Apply an override to metrics read, if this is the special "self" cluster
+					// Apply an override to metrics read, if this is one of the special "self" clusters
 					// (where we incidentally know there's a single probe)
-					overrideGetMySQLThrottleMetricFunc := throttler.readSelfMySQLThrottleMetric
-					if clusterName != selfStoreName {
-						overrideGetMySQLThrottleMetricFunc = nil
+					var overrideGetMySQLThrottleMetricFunc func() *mysql.MySQLThrottleMetric
+					if probe.Key.IsSelf() {
+						overrideGetMySQLThrottleMetricFunc = func() *mysql.MySQLThrottleMetric {
+							return throttler.readSelfMySQLThrottleMetric(clusterName, probe.MetricQuery)
+						}
 					}
 					throttleMetrics := mysql.ReadThrottleMetric(probe, clusterName, overrideGetMySQLThrottleMetricFunc)
 					throttler.mysqlThrottleMetricChan <- throttleMetrics
@@ -553,6 +640,7 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 
 // refreshMySQLInventory will re-structure the inventory based on reading config settings
 func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
+	throttler.refreshThrottlerConfig(ctx)
 
 	addInstanceKey := func(key *mysql.InstanceKey, clusterName string, clusterSettings *config.MySQLClusterConfigurationSettings, probes *mysql.Probes) {
 		for _, ignore := range clusterSettings.IgnoreHosts {
@@ -589,7 +677,7 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 				InstanceProbes:   mysql.NewProbes(),
 			}
 
-			if clusterName == selfStoreName {
+			if clusterName == selfStoreName || strings.HasPrefix(clusterName, selfStoreName+":") {
 				// special case: just looking at this tablet's MySQL server
 				// We will probe this "cluster" (of one server) is a special way.
 				addInstanceKey(mysql.SelfInstanceKey, clusterName, clusterSettings, clusterProbes.InstanceProbes)
@@ -637,8 +725,15 @@ func (throttler *Throttler) updateMySQLClusterProbes(ctx context.Context, cluste
 
 // synchronous aggregation of collected data
 func (throttler *Throttler) aggregateMySQLMetrics(ctx context.Context) error {
+	clusters := config.Settings().Stores.MySQL.Clusters
 	for clusterName, probes := range throttler.mysqlInventory.ClustersProbes {
 		metricName := fmt.Sprintf("mysql/%s", clusterName)
+		if _, ok := clusters[clusterName]; !ok {
+			// The metric was removed from the keyspace throttler config
+			throttler.removeMySQLCluster(clusterName)
+			throttler.aggregatedMetrics.Delete(metricName)
+			continue
+		}
 		ignoreHostsCount := throttler.mysqlInventory.IgnoreHostsCount[clusterName]
 		ignoreHostsThreshold := throttler.mysqlInventory.IgnoreHostsThreshold[clusterName]
 		aggregatedMetric := aggregateMySQLProbes(ctx, probes, clusterName, throttler.mysqlInventory.InstanceKeyMetrics, ignoreHostsCount, config.Settings().Stores.MySQL.IgnoreDialTCPErrors, ignoreHostsThreshold)
@@ -647,6 +742,19 @@ func (throttler *Throttler) aggregateMySQLMetrics(ctx context.Context) error {
 	return nil
 }
 
+// synchronous removal of a cluster from the inventory
+func (throttler *Throttler) removeMySQLCluster(clusterName string) {
+	delete(throttler.mysqlInventory.ClustersProbes, clusterName)
+	delete(throttler.mysqlInventory.IgnoreHostsCount, clusterName)
+	delete(throttler.mysqlInventory.IgnoreHostsThreshold, clusterName)
+	for key := range throttler.mysqlInventory.InstanceKeyMetrics {
+		if key.ClusterName == clusterName {
+			delete(throttler.mysqlInventory.InstanceKeyMetrics, key)
+		}
+	}
+	throttler.mysqlClusterThresholds.Delete(clusterName)
+}
+
 func (throttler *Throttler) getNamedMetric(metricName string) base.MetricResult {
 	if metricResultVal, found := throttler.aggregatedMetrics.Get(metricName); found {
 		return metricResultVal.(base.MetricResult)
@@ -813,12 +921,36 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 	return metricResultFunc()
 }
 
-// checkStore checks the aggregated value of given MySQL store
+// checkStore checks the aggregated values of the metrics of given MySQL store. The first
+// metric to fail its check, if any, denies the check and is named by the result.
 func (throttler *Throttler) checkStore(ctx context.Context, appName string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
 	if !throttler.env.Config().EnableLagThrottler {
 		return okMetricCheckResult
 	}
-	return throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
+	metricNames, _ := throttler.metricNames.Load().([]string)
+	for _, metricName := range metricNames {
+		metricFlags := flags
+		if metricName != mysql.LagMetricName && flags.OverrideThreshold > 0 {
+			// The override threshold applies to the replication lag only
+			lagFlags := *flags
+			lagFlags.OverrideThreshold = 0
+			metricFlags = &lagFlags
+		}
+		metricCheckResult := throttler.check.Check(ctx, appName, "mysql", metricClusterName(storeName, metricName), remoteAddr, metricFlags).withMetricName(metricName)
+		if metricName == mysql.LagMetricName {
+			checkResult = metricCheckResult
+		} else if metricCheckResult.StatusCode == http.StatusNotFound {
+			// The metric was just added to the config, and is not collected yet
+			continue
+		}
+		if metricCheckResult.StatusCode != http.StatusOK {
+			return metricCheckResult
+		}
+	}
+	if checkResult == nil {
+		return NoSuchMetricCheckResult
+	}
+	return checkResult
 }
 
 // checkShard checks the health of the shard, and runs on the primary tablet only
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/config"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestThrottler(name string) *Throttler {
+	cfg := tabletenv.NewDefaultConfig()
+	cfg.EnableLagThrottler = true
+	return NewThrottler(tabletenv.NewEnv(cfg, name), nil, func() topodatapb.TabletType {
+		return topodatapb.TabletType_MASTER
+	})
+}
+
+func TestInitConfigKeyspaceMetrics(t *testing.T) {
+	throttler := newTestThrottler("InitConfigKeyspaceMetricsTest")
+	throttler.throttlerConfig = &topodatapb.ThrottlerConfig{
+		Metrics: map[string]*topodatapb.ThrottlerMetric{
+			"lag":             {Threshold: 5},
+			"threads_running": {Threshold: 50},
+			"custom":          {Query: "select 1", Threshold: 2},
+			"unsupported":     {Query: "delete from t", Threshold: 2},
+		},
+	}
+	throttler.initConfig("password")
+
+	assert.Equal(t, []string{"lag", "custom", "threads_running"}, throttler.metricNames.Load())
+	clusters := config.Settings().Stores.MySQL.Clusters
+	assert.Len(t, clusters, 6)
+	for _, storeName := range []string{selfStoreName, shardStoreName} {
+		cluster := clusters[storeName]
+		require.NotNil(t, cluster)
+		assert.Equal(t, replicationLagQuery, cluster.MetricQuery)
+		assert.Equal(t, 5.0, cluster.ThrottleThreshold)
+
+		cluster = clusters[storeName+":threads_running"]
+		require.NotNil(t, cluster)
+		assert.Equal(t, "show global status like 'threads_running'", cluster.MetricQuery)
+		assert.Equal(t, 50.0, cluster.ThrottleThreshold)
+
+		cluster = clusters[storeName+":custom"]
+		require.NotNil(t, cluster)
+		assert.Equal(t, "select 1", cluster.MetricQuery)
+		assert.Equal(t, 2.0, cluster.ThrottleThreshold)
+	}
+	assert.Equal(t, "", clusters[selfStoreName].User)
+	assert.Equal(t, throttlerUser, clusters[shardStoreName+":custom"].User)
+
+	// Without the throttler user, there are no shard clusters
+	throttler.throttlerConfig = nil
+	throttler.initConfig("")
+	assert.Equal(t, []string{"lag"}, throttler.metricNames.Load())
+	assert.Len(t, config.Settings().Stores.MySQL.Clusters, 1)
+	assert.Equal(t, throttleThreshold.Seconds(), config.Settings().Stores.MySQL.Clusters[selfStoreName].ThrottleThreshold)
+}
+
+func TestCheckStoreMetrics(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler("CheckStoreMetricsTest")
+	throttler.throttlerConfig = &topodatapb.ThrottlerConfig{
+		Metrics: map[string]*topodatapb.ThrottlerMetric{
+			"threads_running": {Threshold: 50},
+			"custom":          {Query: "select 1", Threshold: 2},
+		},
+	}
+	throttler.initConfig("password")
+	setMetric := func(clusterName string, threshold float64, value float64) {
+		throttler.mysqlClusterThresholds.Set(clusterName, threshold, cache.DefaultExpiration)
+		throttler.aggregatedMetrics.Set("mysql/"+clusterName, base.NewSimpleMetricResult(value), cache.DefaultExpiration)
+	}
+	// The custom metric is not collected yet, and is skipped
+	setMetric("shard", 1, 0.5)
+	setMetric("shard:threads_running", 50, 80)
+
+	checkResult := throttler.CheckByType(ctx, "test", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, "threads_running", checkResult.MetricName)
+	assert.Equal(t, 80.0, checkResult.Value)
+	assert.Equal(t, 50.0, checkResult.Threshold)
+
+	setMetric("shard:threads_running", 50, 10)
+	checkResult = throttler.CheckByType(ctx, "test", "", StandardCheckFlags, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Equal(t, "lag", checkResult.MetricName)
+	assert.Equal(t, 0.5, checkResult.Value)
+
+	// The override threshold applies to the lag only
+	checkResult = throttler.CheckByType(ctx, "test", "", &CheckFlags{OverrideThreshold: 20}, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	setMetric("shard:custom", 2, 3)
+	checkResult = throttler.CheckByType(ctx, "test", "", &CheckFlags{OverrideThreshold: 20}, ThrottleCheckPrimaryWrite)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+	assert.Equal(t, "custom", checkResult.MetricName)
+
+	// The self checks only look at the self clusters
+	setMetric("self", 1, 0.1)
+	checkResult = throttler.CheckByType(ctx, "test", "", StandardCheckFlags, ThrottleCheckSelf)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	assert.Equal(t, "lag", checkResult.MetricName)
+	assert.Equal(t, 0.1, checkResult.Value)
+}
+
+func TestAggregateRemovedMetrics(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler("AggregateRemovedMetricsTest")
+	throttler.initConfig("")
+	for _, clusterName := range []string{"self", "self:removed"} {
+		probes := mysql.NewProbes()
+		(*probes)[*mysql.SelfInstanceKey] = &mysql.Probe{Key: *mysql.SelfInstanceKey}
+		throttler.mysqlInventory.ClustersProbes[clusterName] = probes
+		metric := &mysql.MySQLThrottleMetric{ClusterName: clusterName, Key: *mysql.SelfInstanceKey, Value: 1}
+		throttler.mysqlInventory.InstanceKeyMetrics[metric.GetClusterInstanceKey()] = metric
+		throttler.mysqlClusterThresholds.Set(clusterName, 1.0, cache.DefaultExpiration)
+	}
+	throttler.aggregatedMetrics.Set("mysql/self:removed", base.NewSimpleMetricResult(1), cache.DefaultExpiration)
+
+	require.NoError(t, throttler.aggregateMySQLMetrics(ctx))
+	assert.Len(t, throttler.mysqlInventory.ClustersProbes, 1)
+	assert.Len(t, throttler.mysqlInventory.InstanceKeyMetrics, 1)
+	_, found := throttler.mysqlClusterThresholds.Get("self:removed")
+	assert.False(t, found)
+	metrics := throttler.aggregatedMetricsSnapshot()
+	assert.Len(t, metrics, 1)
+	assert.Contains(t, metrics, "mysql/self")
+}
+
+func TestConfigRefreshWhileAggregating(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler("ConfigRefreshWhileAggregatingTest")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			throttler.initMutex.Lock()
+			throttler.initConfig("password")
+			throttler.initMutex.Unlock()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, throttler.aggregateMySQLMetrics(ctx))
+	}
+	<-done
+	assert.Len(t, config.Settings().Stores.MySQL.Clusters, 2)
+}
@@ -95,6 +95,9 @@ const (
 	from %s.dt_state t
   join %s.dt_participant p on t.dtid = p.dtid
 	order by t.dtid, p.id`
+
+	sqlCountTransactions = "select state, count(*) from %s.dt_state group by state"
+	sqlCountRedo         = "select state, count(*) from %s.redo_state group by state"
 )
 
 // TwoPC performs 2PC metadata management (MM) functions.
@@ -118,6 +121,9 @@ type TwoPC struct {
 	readParticipants    *sqlparser.ParsedQuery
 	readAbandoned       *sqlparser.ParsedQuery
 	readAllTransactions string
+
+	countTransactions string
+	countRedo         string
 }
 
 // NewTwoPC creates a TwoPC variable.
@@ -169,6 +175,8 @@ func NewTwoPC(readPool *connpool.Pool) *TwoPC {
 		"select dtid, time_created from %s.dt_state where time_created < %a",
 		dbname, ":time_created")
 	tpc.readAllTransactions = fmt.Sprintf(sqlReadAllTransactions, dbname, dbname)
+	tpc.countTransactions = fmt.Sprintf(sqlCountTransactions, dbname)
+	tpc.countRedo = fmt.Sprintf(sqlCountRedo, dbname)
 	return tpc
 }
 
@@ -512,6 +520,56 @@ func (tpc *TwoPC) ReadAllTransactions(ctx context.Context) ([]*tx.DistributedTx,
 	return distributed, nil
 }
 
+// CountByState returns the number of distributed transactions by state,
+// and the number of prepared transactions by redo log state. The states
+// are the labels of the TwoPCTransactions stat.
+func (tpc *TwoPC) CountByState(ctx context.Context) (map[string]int64, error) {
+	conn, err := tpc.readPool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+
+	counts := map[string]int64{
+		"Prepare":  0,
+		"Commit":   0,
+		"Rollback": 0,
+		"Prepared": 0,
+		"Failed":   0,
+	}
+	qr, err := conn.Exec(ctx, tpc.countTransactions, 10, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		st, _ := evalengine.ToInt64(row[0])
+		count, _ := evalengine.ToInt64(row[1])
+		switch querypb.TransactionState(st) {
+		case DTStatePrepare:
+			counts["Prepare"] += count
+		case DTStateCommit:
+			counts["Commit"] += count
+		case DTStateRollback:
+			counts["Rollback"] += count
+		}
+	}
+
+	qr, err = conn.Exec(ctx, tpc.countRedo, 10, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		st, _ := evalengine.ToInt64(row[0])
+		count, _ := evalengine.ToInt64(row[1])
+		if st == RedoStatePrepared {
+			counts["Prepared"] += count
+		} else {
+			counts["Failed"] += count
+		}
+	}
+	return counts, nil
+}
+
 func (tpc *TwoPC) exec(ctx context.Context, conn *StatefulConnection, pq *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	q, err := pq.GenerateQuery(bindVars, nil)
 	if err != nil {
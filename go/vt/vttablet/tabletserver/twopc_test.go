@@ -391,6 +391,41 @@ func TestReadAllTransactions(t *testing.T) {
 	}
 }
 
+func TestCountByState(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	tpc := tsv.te.twoPC
+	ctx := context.Background()
+
+	db.AddQuery(tpc.countTransactions, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"state|count(*)",
+		"int64|int64"),
+		"1|2",
+		"2|1",
+	))
+	db.AddQuery(tpc.countRedo, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"state|count(*)",
+		"int64|int64"),
+		"0|1",
+		"1|3",
+	))
+	counts, err := tpc.CountByState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int64{
+		"Prepare":  2,
+		"Commit":   1,
+		"Rollback": 0,
+		"Prepared": 3,
+		"Failed":   1,
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("CountByState: %v, want %v", counts, want)
+	}
+}
+
 func jsonStr(v interface{}) string {
 	out, _ := json.Marshal(v)
 	return string(out)
@@ -57,6 +57,10 @@ type (
 		Conclusion      string
 		LogToFile       bool
 
+		// ReadExecutedGtids is set if the GTIDs of the transaction were
+		// requested, but the connection can't track them.
+		ReadExecutedGtids bool
+
 		Stats *servenv.TimingsWrapper
 	}
 )
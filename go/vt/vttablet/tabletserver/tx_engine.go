@@ -172,6 +172,9 @@ func (te *TxEngine) transition(state txEngineState) {
 		// than blocking everything for the sake of a few transactions.
 		// We do this async; so we do not end up blocking writes on
 		// failover for our setup tasks if using semi-sync replication.
+		// The prepared transactions can't be resolved until they're
+		// prepared again from the redo log.
+		te.preparedPool.Close()
 		te.twoPCReady.Add(1)
 		go func() {
 			defer te.twoPCReady.Done()
@@ -179,9 +182,14 @@ func (te *TxEngine) transition(state txEngineState) {
 				te.env.Stats().InternalErrors.Add("TwopcOpen", 1)
 				log.Errorf("Could not open TwoPC engine: %v", err)
 			}
+			// The prepared pool stays closed if some transactions
+			// couldn't be prepared again, so that they're not
+			// reported as committed by a CommitPrepared.
 			if err := te.prepareFromRedo(); err != nil {
 				te.env.Stats().InternalErrors.Add("TwopcResurrection", 1)
 				log.Errorf("Could not prepare transactions: %v", err)
+			} else {
+				te.preparedPool.Open()
 			}
 			te.startWatchdog()
 		}()
@@ -238,17 +246,18 @@ func (te *TxEngine) Begin(ctx context.Context, preQueries []string, reservedID i
 }
 
 // Commit commits the specified transaction and renews connection id if one exists.
-func (te *TxEngine) Commit(ctx context.Context, transactionID int64) (int64, string, error) {
+// It also returns the statement executed to commit, and its session state changes.
+func (te *TxEngine) Commit(ctx context.Context, transactionID int64) (int64, string, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxEngine.Commit")
 	defer span.Finish()
-	var query string
+	var query, sessionStateChanges string
 	var err error
 	connID, err := te.txFinish(transactionID, tx.TxCommit, func(conn *StatefulConnection) error {
-		query, err = te.txPool.Commit(ctx, conn)
+		query, sessionStateChanges, err = te.txPool.Commit(ctx, conn)
 		return err
 	})
 
-	return connID, query, err
+	return connID, query, sessionStateChanges, err
 }
 
 // Rollback rolls back the specified transaction.
@@ -322,14 +331,14 @@ func (te *TxEngine) shutdownLocked() {
 		// If not immediate, we start with shutting down non-tx (reserved)
 		// connections.
 		te.txPool.scp.ShutdownNonTx()
+		// The prepared transactions hold on to connections from the tx
+		// pool until they're resolved, so the pool would not become empty.
+		// They're rolled back now, and their redo logs are kept: the next
+		// primary, or this tablet if it becomes primary again, prepares
+		// them again before accepting their resolution.
+		te.rollbackPrepared()
 		if te.shutdownGracePeriod <= 0 {
 			// No grace period was specified. Wait indefinitely for transactions to be concluded.
-			// TODO(sougou): invoking rollbackPrepared is incorrect here. Prepared statements should
-			// actually be rolled back last. But this will cause the shutdown to hang because the
-			// tx pool will never become empty, because the prepared pool is holding on to connections
-			// from the tx pool. But we plan to deprecate this approach to 2PC. So, this
-			// should eventually be deleted.
-			te.rollbackPrepared()
 			log.Info("No grace period specified: performing normal wait.")
 			return
 		}
@@ -451,6 +460,15 @@ func (te *TxEngine) startWatchdog() {
 		}
 		te.env.Stats().Unresolved.Set("Prepares", count)
 
+		counts, err := te.twoPC.CountByState(ctx)
+		if err != nil {
+			te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
+			log.Errorf("Error counting distributed transactions: %v", err)
+		}
+		for state, count := range counts {
+			te.env.Stats().TwoPCTransactions.Set(state, count)
+		}
+
 		// Resolve lingering distributed transactions.
 		txs, err := te.twoPC.ReadAbandoned(ctx, time.Now().Add(-te.abandonAge))
 		if err != nil {
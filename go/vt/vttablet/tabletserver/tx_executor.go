@@ -51,6 +51,9 @@ func (txe *TxExecutor) Prepare(transactionID int64, dtid string) error {
 	}
 	defer txe.te.env.Stats().QueryTimings.Record("PREPARE", time.Now())
 	txe.logStats.TransactionID = transactionID
+	if !txe.te.preparedPool.IsOpen() {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot prepare dtid %s, state: %v", dtid, errPrepClosed)
+	}
 
 	conn, err := txe.te.txPool.GetAndLock(transactionID, "for prepare")
 	if err != nil {
@@ -84,6 +87,11 @@ func (txe *TxExecutor) CommitPrepared(dtid string) error {
 	}
 	defer txe.te.env.Stats().QueryTimings.Record("COMMIT_PREPARED", time.Now())
 	conn, err := txe.te.preparedPool.FetchForCommit(dtid)
+	if err == errPrepClosed {
+		// The commit must be retried once the transaction was
+		// prepared again, possibly by another primary.
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot commit dtid %s, state: %v", dtid, err)
+	}
 	if err != nil {
 		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot commit dtid %s, state: %v", dtid, err)
 	}
@@ -99,7 +107,7 @@ func (txe *TxExecutor) CommitPrepared(dtid string) error {
 		txe.markFailed(ctx, dtid)
 		return err
 	}
-	_, err = txe.te.txPool.Commit(ctx, conn)
+	_, _, err = txe.te.txPool.Commit(ctx, conn)
 	if err != nil {
 		txe.markFailed(ctx, dtid)
 		return err
@@ -130,7 +138,7 @@ func (txe *TxExecutor) markFailed(ctx context.Context, dtid string) {
 		return
 	}
 
-	if _, err = txe.te.txPool.Commit(ctx, conn); err != nil {
+	if _, _, err = txe.te.txPool.Commit(ctx, conn); err != nil {
 		log.Errorf("markFailed: Commit failed for dtid %s: %v", dtid, err)
 	}
 }
@@ -158,6 +166,12 @@ func (txe *TxExecutor) RollbackPrepared(dtid string, originalID int64) error {
 		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "2pc is not enabled")
 	}
 	defer txe.te.env.Stats().QueryTimings.Record("ROLLBACK_PREPARED", time.Now())
+	if !txe.te.preparedPool.IsOpen() {
+		if originalID != 0 {
+			txe.te.Rollback(txe.ctx, originalID)
+		}
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot rollback dtid %s, state: %v", dtid, errPrepClosed)
+	}
 	defer func() {
 		if preparedConn := txe.te.preparedPool.FetchForRollback(dtid); preparedConn != nil {
 			txe.te.txPool.RollbackAndRelease(txe.ctx, preparedConn)
@@ -201,7 +215,7 @@ func (txe *TxExecutor) StartCommit(transactionID int64, dtid string) error {
 	if err != nil {
 		return err
 	}
-	_, err = txe.te.txPool.Commit(txe.ctx, conn)
+	_, _, err = txe.te.txPool.Commit(txe.ctx, conn)
 	return err
 }
 
@@ -272,7 +286,7 @@ func (txe *TxExecutor) inTransaction(f func(*StatefulConnection) error) error {
 		return err
 	}
 
-	_, err = txe.te.txPool.Commit(txe.ctx, conn)
+	_, _, err = txe.te.txPool.Commit(txe.ctx, conn)
 	if err != nil {
 		return err
 	}
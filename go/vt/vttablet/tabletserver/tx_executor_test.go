@@ -32,12 +32,14 @@ import (
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/fakerpcvtgateconn"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 func TestTxExecutorEmptyPrepare(t *testing.T) {
@@ -139,6 +141,28 @@ func TestTxExecutorCommit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTxExecutorCommitClosed(t *testing.T) {
+	txe, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	txid := newTxForPrep(tsv)
+	err := txe.Prepare(txid, "aa")
+	require.NoError(t, err)
+	// The prepared transactions are rolled back until they're
+	// prepared again from the redo log.
+	tsv.te.rollbackPrepared()
+	err = txe.CommitPrepared("aa")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot commit dtid aa, state: closed")
+	require.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	err = txe.RollbackPrepared("aa", 0)
+	require.Error(t, err)
+	require.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	err = txe.Prepare(newTxForPrep(tsv), "bb")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot prepare dtid bb, state: closed")
+}
+
 func TestTxExecutorCommitRedoFail(t *testing.T) {
 	txe, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
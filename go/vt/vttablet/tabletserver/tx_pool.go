@@ -172,23 +172,45 @@ func (tp *TxPool) GetAndLock(connID tx.ConnID, reason string) (*StatefulConnecti
 	return conn, nil
 }
 
-// Commit commits the transaction on the connection.
-func (tp *TxPool) Commit(ctx context.Context, txConn *StatefulConnection) (string, error) {
+// Commit commits the transaction on the connection. It returns the
+// statement executed to commit, and the session state changes that MySQL
+// returned for it, like the GTID of the transaction.
+func (tp *TxPool) Commit(ctx context.Context, txConn *StatefulConnection) (string, string, error) {
 	if !txConn.IsInTransaction() {
-		return "", vterrors.New(vtrpcpb.Code_INTERNAL, "not in a transaction")
+		return "", "", vterrors.New(vtrpcpb.Code_INTERNAL, "not in a transaction")
 	}
 	span, ctx := trace.NewSpan(ctx, "TxPool.Commit")
 	defer span.Finish()
 	defer tp.txComplete(txConn, tx.TxCommit)
 	if txConn.TxProperties().Autocommit {
-		return "", nil
+		return "", executedGtids(ctx, txConn), nil
 	}
 
-	if _, err := txConn.Exec(ctx, "commit", 1, false); err != nil {
+	qr, err := txConn.Exec(ctx, "commit", 1, false)
+	if err != nil {
 		txConn.Close()
-		return "", err
+		return "", "", err
+	}
+	if txConn.TxProperties().ReadExecutedGtids {
+		return "commit", executedGtids(ctx, txConn), nil
+	}
+	return "commit", qr.SessionStateChanges, nil
+}
+
+// executedGtids returns the GTID set executed by MySQL after a commit
+// whose GTIDs were requested, but not tracked by the connection. It's a
+// superset of the GTIDs of the transaction, so the reads that wait for it
+// still see its writes.
+func executedGtids(ctx context.Context, txConn *StatefulConnection) string {
+	if !txConn.TxProperties().ReadExecutedGtids {
+		return ""
 	}
-	return "commit", nil
+	gtids, err := txConn.dbConn.ExecutedGtids(ctx)
+	if err != nil {
+		log.Warningf("cannot read the GTIDs executed after the commit of %v: %v", txConn.ConnID, err)
+		return ""
+	}
+	return gtids
 }
 
 // RollbackAndRelease rolls back the transaction on the specified connection, and releases the connection when done
@@ -270,6 +292,7 @@ func (tp *TxPool) begin(ctx context.Context, options *querypb.ExecuteOptions, re
 	}
 
 	conn.txProps = tp.NewTxProps(immediateCaller, effectiveCaller, autocommit)
+	conn.txProps.ReadExecutedGtids = options.GetSessionTrackGtids() && !conn.dbConn.TracksGtids()
 
 	return beginQueries, nil
 }
@@ -293,6 +316,12 @@ func (tp *TxPool) createConn(ctx context.Context, options *querypb.ExecuteOption
 func createTransaction(ctx context.Context, options *querypb.ExecuteOptions, conn *StatefulConnection, readOnly bool, preQueries []string) (string, bool, error) {
 	beginQueries := ""
 
+	if options.GetSessionTrackGtids() {
+		if err := conn.dbConn.TrackGtids(ctx); err != nil {
+			return "", false, err
+		}
+	}
+
 	autocommitTransaction := false
 	if queries, ok := txIsolations[options.GetTransactionIsolation()]; ok {
 		if queries.setIsolationLevel != "" {
@@ -62,11 +62,11 @@ func TestTxPoolExecuteCommit(t *testing.T) {
 	conn3, err := txPool.GetAndLock(id, "")
 	require.NoError(t, err)
 
-	_, err = txPool.Commit(ctx, conn3)
+	_, _, err = txPool.Commit(ctx, conn3)
 	require.NoError(t, err)
 
 	// try committing again. this should fail
-	_, err = txPool.Commit(ctx, conn)
+	_, _, err = txPool.Commit(ctx, conn)
 	require.EqualError(t, err, "not in a transaction")
 
 	// wrap everything up and assert
@@ -124,7 +124,7 @@ func TestTxPoolRollbackNonBusy(t *testing.T) {
 	txPool.Shutdown(ctx)
 
 	// committing tx1 should not be an issue
-	_, err = txPool.Commit(ctx, conn1)
+	_, _, err = txPool.Commit(ctx, conn1)
 	require.NoError(t, err)
 
 	// Trying to get back to conn2 should not work since the transaction has been rolled back
@@ -161,7 +161,7 @@ func TestTxPoolAutocommit(t *testing.T) {
 	query := "select 3"
 	conn1.Exec(ctx, query, 1, false)
 
-	_, err = txPool.Commit(ctx, conn1)
+	_, _, err = txPool.Commit(ctx, conn1)
 	require.NoError(t, err)
 	conn1.Release(tx.TxCommit)
 
@@ -254,6 +254,40 @@ func TestTxPoolBeginWithPreQueryError(t *testing.T) {
 	require.Equal(t, vtrpcpb.Code_UNKNOWN, vterrors.Code(err), "wrong error code for Begin error")
 }
 
+func TestTxPoolCommitGtidsWithoutSessionTrack(t *testing.T) {
+	db, txPool, _, closer := setup(t)
+	defer closer()
+	// The connections don't have the CLIENT_SESSION_TRACK capability, so
+	// the commits return the GTID set executed by MySQL instead.
+	db.AddQuery("select @@global.gtid_executed", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("@@global.gtid_executed", "varchar"),
+		"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5,\n4e11fa47-71ca-11e1-9e33-c80aa9429562:1-3",
+	))
+	wantGtids := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5,4e11fa47-71ca-11e1-9e33-c80aa9429562:1-3"
+
+	for _, isolation := range []querypb.ExecuteOptions_TransactionIsolation{querypb.ExecuteOptions_DEFAULT, querypb.ExecuteOptions_AUTOCOMMIT} {
+		db.ResetQueryLog()
+		conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{SessionTrackGtids: true, TransactionIsolation: isolation}, false, 0, nil)
+		require.NoError(t, err)
+		require.False(t, conn.dbConn.TracksGtids())
+		_, sessionStateChanges, err := txPool.Commit(ctx, conn)
+		require.NoError(t, err)
+		conn.Release(tx.TxCommit)
+		require.Equal(t, wantGtids, sessionStateChanges, "isolation %v", isolation)
+		require.NotContains(t, db.QueryLog(), "session_track_gtids")
+	}
+
+	// The GTIDs are not read if they were not requested.
+	db.ResetQueryLog()
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	_, sessionStateChanges, err := txPool.Commit(ctx, conn)
+	require.NoError(t, err)
+	conn.Release(tx.TxCommit)
+	require.Empty(t, sessionStateChanges)
+	require.NotContains(t, db.QueryLog(), "gtid_executed")
+}
+
 func TestTxPoolCancelledContextError(t *testing.T) {
 	// given
 	db, txPool, _, closer := setup(t)
@@ -343,7 +377,7 @@ func TestTxPoolGetConnRecentlyRemovedTransaction(t *testing.T) {
 
 	conn1, _, _ = txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
 	id = conn1.ReservedID()
-	_, err := txPool.Commit(ctx, conn1)
+	_, _, err := txPool.Commit(ctx, conn1)
 	require.NoError(t, err)
 
 	conn1.Releasef("transaction committed")
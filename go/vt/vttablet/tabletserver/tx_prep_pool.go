@@ -25,16 +25,23 @@ import (
 var (
 	errPrepCommitting = errors.New("committing")
 	errPrepFailed     = errors.New("failed")
+	errPrepClosed     = errors.New("closed")
 )
 
 // TxPreparedPool manages connections for prepared transactions.
 // The Prepare functionality and associated orchestration
 // is done by TxPool.
+//
+// The pool is closed while the prepared transactions are not
+// in it: after they were rolled back by a shutdown, and until
+// they're prepared again from the redo log. Committing them
+// would otherwise be mistaken for a retry of a completed commit.
 type TxPreparedPool struct {
 	mu       sync.Mutex
 	conns    map[string]*StatefulConnection
 	reserved map[string]error
 	capacity int
+	closed   bool
 }
 
 // NewTxPreparedPool creates a new TxPreparedPool.
@@ -95,6 +102,9 @@ func (pp *TxPreparedPool) FetchForRollback(dtid string) *StatefulConnection {
 func (pp *TxPreparedPool) FetchForCommit(dtid string) (*StatefulConnection, error) {
 	pp.mu.Lock()
 	defer pp.mu.Unlock()
+	if pp.closed {
+		return nil, errPrepClosed
+	}
 	if err, ok := pp.reserved[dtid]; ok {
 		return nil, err
 	}
@@ -122,10 +132,11 @@ func (pp *TxPreparedPool) Forget(dtid string) {
 }
 
 // FetchAll removes all connections and returns them as a list.
-// It also forgets all reserved dtids.
+// It also forgets all reserved dtids, and closes the pool.
 func (pp *TxPreparedPool) FetchAll() []*StatefulConnection {
 	pp.mu.Lock()
 	defer pp.mu.Unlock()
+	pp.closed = true
 	conns := make([]*StatefulConnection, 0, len(pp.conns))
 	for _, c := range pp.conns {
 		conns = append(conns, c)
@@ -134,3 +145,26 @@ func (pp *TxPreparedPool) FetchAll() []*StatefulConnection {
 	pp.reserved = make(map[string]error)
 	return conns
 }
+
+// Close closes the pool until the prepared transactions are
+// put back in it.
+func (pp *TxPreparedPool) Close() {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.closed = true
+}
+
+// Open opens the pool, once it contains the prepared transactions.
+func (pp *TxPreparedPool) Open() {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.closed = false
+}
+
+// IsOpen returns true if the prepared transactions can be
+// committed or rolled back.
+func (pp *TxPreparedPool) IsOpen() bool {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return !pp.closed
+}
@@ -130,3 +130,20 @@ func TestPrepFetchAll(t *testing.T) {
 		t.Errorf("len(pp.conns): %d, want 0", len(pp.conns))
 	}
 }
+
+func TestPrepClosed(t *testing.T) {
+	pp := NewTxPreparedPool(2)
+	pp.Put(&StatefulConnection{}, "aa")
+	require.True(t, pp.IsOpen())
+	pp.FetchAll()
+	require.False(t, pp.IsOpen())
+	// An absent transaction can't be mistaken for a committed one.
+	_, err := pp.FetchForCommit("aa")
+	require.Equal(t, errPrepClosed, err)
+
+	pp.Put(&StatefulConnection{}, "aa")
+	pp.Open()
+	got, err := pp.FetchForCommit("aa")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
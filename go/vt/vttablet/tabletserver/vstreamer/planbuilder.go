@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -73,6 +74,9 @@ const (
 	GreaterThanEqual
 	// NotEqual is used to filter a comparable column if != specific value
 	NotEqual
+	// Expression is used to filter the row on any other boolean expression,
+	// such as an IN, a LIKE or an OR of other conditions
+	Expression
 )
 
 // Filter contains opcodes for filtering.
@@ -89,6 +93,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the condition for Expression. It is evaluated against
+	// the columns of the table.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -103,6 +111,10 @@ type ColExpr struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 
+	// Expr, if set, is evaluated against the columns of the table
+	// to compute the value. If so, ColNum is ignored.
+	Expr evalengine.Expr
+
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
@@ -164,7 +176,7 @@ func compare(comparison Opcode, columnValue, filterValue sqltypes.Value) (bool,
 	}
 	// at this point neither values can be null
 	// NullsafeCompare returns 0 if values match, -1 if columnValue < filterValue, 1 if columnValue > filterValue
-	result, err := evalengine.NullsafeCompare(columnValue, filterValue)
+	result, err := evalengine.NullsafeCompare(columnValue, filterValue, collations.Unknown)
 	if err != nil {
 		return false, err
 	}
@@ -218,6 +230,14 @@ func (plan *Plan) filter(values, result []sqltypes.Value) (bool, error) {
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil
 			}
+		case Expression:
+			match, err := filter.Expr.Evaluate(evalengine.ExpressionEnv{Row: values})
+			if err != nil {
+				return false, err
+			}
+			if !match.ToBoolean() {
+				return false, nil
+			}
 		default:
 			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value)
 			if err != nil {
@@ -229,6 +249,18 @@ func (plan *Plan) filter(values, result []sqltypes.Value) (bool, error) {
 		}
 	}
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			val, err := colExpr.Expr.Evaluate(evalengine.ExpressionEnv{Row: values})
+			if err != nil {
+				return false, err
+			}
+			// The type of the value must match the one announced in the field.
+			result[i], err = evalengine.Cast(val.Value(), colExpr.Field.Type)
+			if err != nil {
+				return false, err
+			}
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -479,56 +511,100 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			opcode, err := getOpcode(expr)
-			if err != nil {
-				return err
-			}
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
-			if err != nil {
-				return err
-			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := sqlparser.NewPlanValue(val)
+			filter, err := plan.analyzeComparison(expr)
 			if err != nil {
 				return err
 			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
-				return err
+			if filter != nil {
+				plan.Filters = append(plan.Filters, *filter)
+				continue
 			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: opcode,
-				ColNum: colnum,
-				Value:  resolved,
-			})
 		case *sqlparser.FuncExpr:
-			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
-			}
-			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
-				return err
+			if expr.Name.EqualString("in_keyrange") {
+				if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
+					return err
+				}
+				continue
 			}
-		default:
+		}
+		// Any other condition is evaluated as an expression.
+		cond, err := plan.convertExpr(expr)
+		if err == sqlparser.ErrExprNotSupported {
 			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
 		}
+		if err != nil {
+			return err
+		}
+		plan.Filters = append(plan.Filters, Filter{
+			Opcode: Expression,
+			Expr:   cond,
+		})
 	}
 	return nil
 }
 
+// analyzeComparison returns the filter for a comparison between a column
+// and a literal, like "id = 1". It returns nil if the comparison is not of
+// that form, in which case it must be evaluated as an expression.
+func (plan *Plan) analyzeComparison(expr *sqlparser.ComparisonExpr) (*Filter, error) {
+	opcode, err := getOpcode(expr)
+	if err != nil {
+		return nil, nil
+	}
+	qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return nil, err
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	if !ok {
+		return nil, nil
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
+		return nil, nil
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := pv.ResolveValue(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{
+		Opcode: opcode,
+		ColNum: colnum,
+		Value:  resolved,
+	}, nil
+}
+
+// convertExpr converts an expression of the filter into one that is evaluated
+// against the columns of the table. Expressions that are not deterministic,
+// like now() or rand(), are rejected: they would not evaluate to the same value
+// when a row is copied and when its changes are replicated.
+func (plan *Plan) convertExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	if nonDeterministic := sqlparser.FindNonDeterministicExpr(expr); nonDeterministic != nil {
+		return nil, fmt.Errorf("unsupported non-deterministic expression: %v", sqlparser.String(nonDeterministic))
+	}
+	return sqlparser.ConvertWithColumns(expr, func(col *sqlparser.ColName) (int, *querypb.Field, error) {
+		if !col.Qualifier.IsEmpty() {
+			return 0, nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+		}
+		colnum, err := findColumn(plan.Table, col.Name)
+		if err != nil {
+			return 0, nil, err
+		}
+		return colnum, plan.Table.Fields[colnum], nil
+	})
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -590,7 +666,11 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			cExpr, err := plan.analyzeProjection(aliased)
+			if err == sqlparser.ErrExprNotSupported {
+				return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			}
+			return cExpr, err
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -643,9 +723,38 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Field:  field,
 		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
-		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		cExpr, err := plan.analyzeProjection(aliased)
+		if err == sqlparser.ErrExprNotSupported {
+			log.Infof("Unsupported expression: %v", inner)
+			return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		}
+		return cExpr, err
+	}
+}
+
+// analyzeProjection builds the column expression for any other expression,
+// which gets evaluated against the columns of the table. The field is named
+// after the alias of the expression, if there is one.
+func (plan *Plan) analyzeProjection(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	expr, err := plan.convertExpr(aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
 	}
+	typ, err := expr.Type(evalengine.ExpressionEnv{})
+	if err != nil {
+		return ColExpr{}, err
+	}
+	name := aliased.As.String()
+	if name == "" {
+		name = sqlparser.String(aliased.Expr)
+	}
+	return ColExpr{
+		Expr: expr,
+		Field: &querypb.Field{
+			Name: name,
+			Type: typ,
+		},
+	}, nil
 }
 
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
@@ -30,7 +30,9 @@ import (
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -397,6 +399,31 @@ func TestPlanBuilder(t *testing.T) {
 				KeyRange:      nil,
 			}},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id + 1 as next_id, val is null from t1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				Expr: &evalengine.BinaryOp{
+					Expr:  &evalengine.Addition{},
+					Left:  evalengine.NewTypedColumn(0, sqltypes.Int64, collations.Unknown),
+					Right: evalengine.NewLiteralInt(1),
+				},
+				Field: &querypb.Field{
+					Name: "next_id",
+					Type: sqltypes.Int64,
+				},
+			}, {
+				Expr: &evalengine.IsExpr{
+					Inner: evalengine.NewTypedColumn(1, sqltypes.VarBinary, collations.Unknown),
+					Op:    evalengine.IsNull,
+				},
+				Field: &querypb.Field{
+					Name: "val is null",
+					Type: sqltypes.Int64,
+				},
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -536,8 +563,24 @@ func TestPlanBuilder(t *testing.T) {
 		outErr:  `unsupported function: max(val)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, now() as ts from t1"},
+		outErr:  `unsupported non-deterministic expression: now()`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, (select 1 from t2) as x from t1"},
+		outErr:  `unsupported: (select 1 from t2)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = 1 or rand() > 0.5"},
+		outErr:  `unsupported non-deterministic expression: rand()`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where val = @v"},
+		outErr:  `unsupported non-deterministic expression: @v`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where none in (1, 2)"},
+		outErr:  "column `none` not found in table t1",
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -697,3 +740,80 @@ func TestCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanFilterExpressions(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}, {
+			Name: "doc",
+			Type: sqltypes.TypeJSON,
+		}},
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("apple"), sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"color": "red", "size": 3}`))},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("banana"), sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"color": "yellow", "size": 5}`))},
+		{sqltypes.NewInt64(3), sqltypes.NULL, sqltypes.NULL},
+	}
+	testcases := []struct {
+		filter string
+		fields []*querypb.Field
+		result [][]sqltypes.Value
+	}{{
+		filter: "select id from t1 where id in (1, 3)",
+		fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+		result: [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(3)}},
+	}, {
+		filter: "select id from t1 where val like 'b%' or val is null",
+		fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+		result: [][]sqltypes.Value{{sqltypes.NewInt64(2)}, {sqltypes.NewInt64(3)}},
+	}, {
+		filter: "select id from t1 where id > 1 and not (val is null)",
+		fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}},
+		result: [][]sqltypes.Value{{sqltypes.NewInt64(2)}},
+	}, {
+		filter: "select id, concat(upper(val), '-', id) as name from t1 where id < 3",
+		fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}, {Name: "name", Type: sqltypes.VarBinary}},
+		result: [][]sqltypes.Value{
+			{sqltypes.NewInt64(1), sqltypes.NewVarBinary("APPLE-1")},
+			{sqltypes.NewInt64(2), sqltypes.NewVarBinary("BANANA-2")},
+		},
+	}, {
+		filter: "select id, case when id = 1 then 'one' else 'many' end as n, cast(id * 1.5 as signed) as m from t1",
+		fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}, {Name: "n", Type: sqltypes.VarBinary}, {Name: "m", Type: sqltypes.Int64}},
+		result: [][]sqltypes.Value{
+			{sqltypes.NewInt64(1), sqltypes.NewVarBinary("one"), sqltypes.NewInt64(2)},
+			{sqltypes.NewInt64(2), sqltypes.NewVarBinary("many"), sqltypes.NewInt64(3)},
+			{sqltypes.NewInt64(3), sqltypes.NewVarBinary("many"), sqltypes.NewInt64(5)},
+		},
+	}, {
+		filter: "select id, doc->>'$.color' as color from t1 where json_extract(doc, '$.size') > 4",
+		fields: []*querypb.Field{{Name: "id", Type: sqltypes.Int64}, {Name: "color", Type: sqltypes.VarBinary}},
+		result: [][]sqltypes.Value{{sqltypes.NewInt64(2), sqltypes.NewVarBinary("yellow")}},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+			})
+			require.NoError(t, err)
+			utils.MustMatch(t, tcase.fields, plan.fields())
+
+			var result [][]sqltypes.Value
+			for _, row := range rows {
+				filtered := make([]sqltypes.Value, len(plan.ColExprs))
+				ok, err := plan.filter(row, filtered)
+				require.NoError(t, err)
+				if ok {
+					result = append(result, filtered)
+				}
+			}
+			assert.Equal(t, tcase.result, result)
+		})
+	}
+}
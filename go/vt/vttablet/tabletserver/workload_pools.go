@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// workloadPools are the conn pools of the workloads configured by
+// TabletConfig.WorkloadPools. Every workload pool has its own quota
+// of connections and queues its own waiters, so that a workload that
+// saturates its pool doesn't delay the queries of the other pools.
+//
+// The workload pools also share TabletConfig.WorkloadPoolsCapacity
+// connections, the sum of their sizes by default. When all of them are
+// in use, the queries of every pool wait in a fairShareQueue, which hands
+// each released connection to the waiting pool that uses the smallest
+// part of its size. Only non-transactional queries are isolated, the
+// TxPool is shared.
+type workloadPools struct {
+	// pools, byPrincipal, byComponent and directivePrincipals
+	// are read-only after newWorkloadPools.
+	pools       map[string]*connpool.Pool
+	byPrincipal map[string]*connpool.Pool
+	byComponent map[string]*connpool.Pool
+	// directivePrincipals are the principals allowed to request
+	// each pool with the WORKLOAD_POOL directive.
+	directivePrincipals map[string]map[string]bool
+
+	queue  *fairShareQueue
+	shares map[string]*workloadShare
+}
+
+func newWorkloadPools(env tabletenv.Env) *workloadPools {
+	config := env.Config()
+	wp := &workloadPools{
+		pools:               make(map[string]*connpool.Pool),
+		byPrincipal:         make(map[string]*connpool.Pool),
+		byComponent:         make(map[string]*connpool.Pool),
+		directivePrincipals: make(map[string]map[string]bool),
+		queue:               &fairShareQueue{capacity: config.WorkloadPoolsCapacity},
+		shares:              make(map[string]*workloadShare),
+	}
+	for _, cfg := range config.WorkloadPools {
+		poolConfig := cfg.ConnPoolConfig
+		if poolConfig.IdleTimeoutSeconds == 0 {
+			poolConfig.IdleTimeoutSeconds = config.OltpReadPool.IdleTimeoutSeconds
+		}
+		// The stats of the workload pools are published
+		// below, with the pool name as label.
+		pool := connpool.NewPool(env, "", poolConfig)
+		share := wp.queue.addShare(cfg.Size)
+		pool.SetAdmission(share)
+		wp.pools[cfg.Name] = pool
+		wp.shares[cfg.Name] = share
+		if config.WorkloadPoolsCapacity == 0 {
+			wp.queue.capacity += cfg.Size
+		}
+		for _, principal := range cfg.Principals {
+			wp.byPrincipal[principal] = pool
+		}
+		for _, component := range cfg.Components {
+			wp.byComponent[component] = pool
+		}
+		wp.directivePrincipals[cfg.Name] = make(map[string]bool)
+		for _, principal := range cfg.DirectivePrincipals {
+			wp.directivePrincipals[cfg.Name][principal] = true
+		}
+	}
+
+	labels := []string{"Pool"}
+	env.Exporter().NewGaugeFunc("WorkloadPoolsCapacity", "Tablet server connections shared by the workload pools", func() int64 {
+		return int64(wp.queue.capacity)
+	})
+	env.Exporter().NewGaugesFuncWithMultiLabels("WorkloadPoolCapacity", "Tablet server workload pool capacity", labels, wp.statsFunc((*connpool.Pool).Capacity))
+	env.Exporter().NewGaugesFuncWithMultiLabels("WorkloadPoolAvailable", "Tablet server workload pool available", labels, wp.statsFunc((*connpool.Pool).Available))
+	env.Exporter().NewGaugesFuncWithMultiLabels("WorkloadPoolInUse", "Tablet server workload pool in use", labels, wp.statsFunc((*connpool.Pool).InUse))
+	env.Exporter().NewCountersFuncWithMultiLabels("WorkloadPoolWaitCount", "Tablet server workload pool wait count", labels, wp.statsFunc((*connpool.Pool).WaitCount))
+	env.Exporter().NewCountersFuncWithMultiLabels("WorkloadPoolWaitTimeNs", "Tablet server workload pool wait time in ns", labels, wp.statsFunc(func(pool *connpool.Pool) int64 {
+		return int64(pool.WaitTime())
+	}))
+	env.Exporter().NewCountersFuncWithMultiLabels("WorkloadPoolExhausted", "Number of times the workload pool had zero available slots", labels, wp.statsFunc((*connpool.Pool).Exhausted))
+	env.Exporter().NewCountersFuncWithMultiLabels("WorkloadPoolFairShareWaitCount", "Number of times the workload pool waited for a shared connection", labels, wp.shareStatsFunc(func(share *workloadShare) int64 {
+		return share.waitCount.Get()
+	}))
+	env.Exporter().NewCountersFuncWithMultiLabels("WorkloadPoolFairShareWaitTimeNs", "Time the workload pool waited for shared connections in ns", labels, wp.shareStatsFunc(func(share *workloadShare) int64 {
+		return int64(share.waitTime.Get())
+	}))
+	return wp
+}
+
+func (wp *workloadPools) statsFunc(f func(*connpool.Pool) int64) func() map[string]int64 {
+	return func() map[string]int64 {
+		values := make(map[string]int64, len(wp.pools))
+		for name, pool := range wp.pools {
+			values[name] = f(pool)
+		}
+		return values
+	}
+}
+
+func (wp *workloadPools) shareStatsFunc(f func(*workloadShare) int64) func() map[string]int64 {
+	return func() map[string]int64 {
+		values := make(map[string]int64, len(wp.shares))
+		for name, share := range wp.shares {
+			values[name] = f(share)
+		}
+		return values
+	}
+}
+
+// Open opens all the workload pools.
+func (wp *workloadPools) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
+	for _, pool := range wp.pools {
+		pool.Open(appParams, dbaParams, appDebugParams)
+	}
+}
+
+// Close closes all the workload pools.
+func (wp *workloadPools) Close() {
+	for _, pool := range wp.pools {
+		pool.Close()
+	}
+}
+
+// Get returns the workload pool of a query. The pool named by the
+// WORKLOAD_POOL directive of the query comments has precedence over the
+// pools of the principal and of the component of the effective caller,
+// but the directive is ignored unless the principal is allowed to request
+// that pool. It returns nil if the query is not assigned to a workload pool.
+func (wp *workloadPools) Get(ctx context.Context, comments sqlparser.MarginComments) (*connpool.Pool, error) {
+	if len(wp.pools) == 0 {
+		return nil, nil
+	}
+	ef := callerid.EffectiveCallerIDFromContext(ctx)
+	if strings.HasPrefix(comments.Leading, "/*vt+") {
+		directives := sqlparser.ExtractCommentDirectives(sqlparser.Comments{comments.Leading})
+		if name := directives.GetString(sqlparser.DirectiveWorkloadPool, ""); name != "" {
+			pool, ok := wp.pools[name]
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown workload pool: %s", name)
+			}
+			if ef != nil && wp.directivePrincipals[name][ef.Principal] {
+				return pool, nil
+			}
+		}
+	}
+	if ef == nil {
+		return nil, nil
+	}
+	if pool, ok := wp.byPrincipal[ef.Principal]; ok {
+		return pool, nil
+	}
+	if pool, ok := wp.byComponent[ef.Component]; ok {
+		return pool, nil
+	}
+	return nil, nil
+}
+
+// fairShareQueue limits the number of connections in use across the
+// workload pools. Every pool has a workloadShare of the queue, weighted
+// by the size of the pool.
+type fairShareQueue struct {
+	// capacity and shares are read-only after newWorkloadPools.
+	capacity int
+	shares   []*workloadShare
+
+	mu    sync.Mutex
+	inUse int
+}
+
+func (fq *fairShareQueue) addShare(weight int) *workloadShare {
+	share := &workloadShare{queue: fq, weight: weight}
+	fq.shares = append(fq.shares, share)
+	return share
+}
+
+// admit must be called with mu held.
+func (fq *fairShareQueue) admit(share *workloadShare) {
+	fq.inUse++
+	share.inUse++
+}
+
+// release must be called with mu held. It hands the released connection
+// to the waiter of the share that uses the smallest part of its weight.
+func (fq *fairShareQueue) release(share *workloadShare) {
+	fq.inUse--
+	share.inUse--
+	for fq.inUse < fq.capacity {
+		var next *workloadShare
+		for _, s := range fq.shares {
+			if len(s.waiters) == 0 {
+				continue
+			}
+			if next == nil || s.inUse*next.weight < next.inUse*s.weight {
+				next = s
+			}
+		}
+		if next == nil {
+			return
+		}
+		ready := next.waiters[0]
+		next.waiters = next.waiters[1:]
+		fq.admit(next)
+		close(ready)
+	}
+}
+
+// workloadShare is the connpool.Admission of a workload pool.
+type workloadShare struct {
+	queue  *fairShareQueue
+	weight int
+
+	// inUse and waiters are protected by queue.mu.
+	inUse   int
+	waiters []chan struct{}
+
+	waitCount sync2.AtomicInt64
+	waitTime  sync2.AtomicDuration
+}
+
+// Acquire waits until the share can use one of the connections of the queue.
+func (share *workloadShare) Acquire(ctx context.Context) error {
+	fq := share.queue
+	fq.mu.Lock()
+	if fq.inUse < fq.capacity {
+		fq.admit(share)
+		fq.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	share.waiters = append(share.waiters, ready)
+	fq.mu.Unlock()
+
+	start := time.Now()
+	defer func() {
+		share.waitCount.Add(1)
+		share.waitTime.Add(time.Since(start))
+	}()
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+	fq.mu.Lock()
+	defer fq.mu.Unlock()
+	for i, waiter := range share.waiters {
+		if waiter == ready {
+			share.waiters = append(share.waiters[:i], share.waiters[i+1:]...)
+			return pools.ErrTimeout
+		}
+	}
+	// The connection was handed to us after the context expired.
+	fq.release(share)
+	return pools.ErrTimeout
+}
+
+// Release gives back the connection taken by Acquire.
+func (share *workloadShare) Release() {
+	share.queue.mu.Lock()
+	defer share.queue.mu.Unlock()
+	share.queue.release(share)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/pools"
+)
+
+// acquireAsync calls Acquire in a goroutine, and waits until it is queued.
+func acquireAsync(t *testing.T, share *workloadShare) chan error {
+	t.Helper()
+	fq := share.queue
+	fq.mu.Lock()
+	waiters := len(share.waiters)
+	fq.mu.Unlock()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- share.Acquire(context.Background())
+	}()
+	for {
+		fq.mu.Lock()
+		queued := len(share.waiters) > waiters
+		fq.mu.Unlock()
+		if queued {
+			return done
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func assertAdmitted(t *testing.T, done chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("waiter was not admitted")
+	}
+}
+
+func assertWaiting(t *testing.T, done chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Fatalf("waiter was admitted: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestFairShareQueue(t *testing.T) {
+	fq := &fairShareQueue{capacity: 3}
+	batch := fq.addShare(2)
+	reports := fq.addShare(1)
+	ctx := context.Background()
+
+	// An idle queue lets a share use more than its weight.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, batch.Acquire(ctx))
+	}
+	reportsDone := acquireAsync(t, reports)
+	batchDone := acquireAsync(t, batch)
+	assertWaiting(t, reportsDone)
+
+	// The released connection goes to reports, which uses none of its
+	// weight, even though batch queued after it.
+	batch.Release()
+	assertAdmitted(t, reportsDone)
+	assertWaiting(t, batchDone)
+
+	batch.Release()
+	assertAdmitted(t, batchDone)
+
+	// Once batch releases a connection, it uses 1/2 of its weight
+	// and reports 1/1.
+	reportsDone = acquireAsync(t, reports)
+	batchDone = acquireAsync(t, batch)
+	batch.Release()
+	assertAdmitted(t, batchDone)
+	assertWaiting(t, reportsDone)
+	reports.Release()
+	assertAdmitted(t, reportsDone)
+
+	assert.EqualValues(t, 4, batch.waitCount.Get()+reports.waitCount.Get())
+}
+
+func TestFairShareQueueTimeout(t *testing.T) {
+	fq := &fairShareQueue{capacity: 1}
+	share := fq.addShare(1)
+	require.NoError(t, share.Acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, pools.ErrTimeout, share.Acquire(ctx))
+	assert.Empty(t, share.waiters)
+
+	share.Release()
+	assert.Equal(t, 0, fq.inUse)
+	require.NoError(t, share.Acquire(context.Background()))
+}
@@ -201,6 +201,16 @@ func (tqsc *Controller) SetQueryRules(ruleSource string, qrs *rules.Rules) error
 	return nil
 }
 
+// QueryQuarantines is part of the tabletserver.Controller interface
+func (tqsc *Controller) QueryQuarantines() []*tabletenv.QuarantinedQuery {
+	return nil
+}
+
+// ClearQueryQuarantines is part of the tabletserver.Controller interface
+func (tqsc *Controller) ClearQueryQuarantines(fingerprint string) int {
+	return 0
+}
+
 // QueryService is part of the tabletserver.Controller interface
 func (tqsc *Controller) QueryService() queryservice.QueryService {
 	return nil
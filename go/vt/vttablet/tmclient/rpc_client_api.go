@@ -83,6 +83,14 @@ type TabletManagerClient interface {
 	// ReloadSchema asks the remote tablet to reload its schema
 	ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error
 
+	// ListQueryQuarantines returns the queries quarantined by the remote tablet
+	ListQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryQuarantine, error)
+
+	// ClearQueryQuarantines releases the query fingerprint quarantined by the
+	// remote tablet, or all its quarantined queries if fingerprint is empty.
+	// It returns the number of queries released.
+	ClearQueryQuarantines(ctx context.Context, tablet *topodatapb.Tablet, fingerprint string) (int, error)
+
 	// PreflightSchema will test a list of schema changes.
 	PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error)
 
@@ -203,8 +211,10 @@ type TabletManagerClient interface {
 	// Backup creates a database backup
 	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToPos or restoreToTimestamp is set, it restores the backup
+	// before it and replays the archived binary logs up to it.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error)
 
 	//
 	// Management methods
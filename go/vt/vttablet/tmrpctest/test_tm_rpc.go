@@ -40,6 +40,7 @@ import (
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // fakeRPCTM implements tabletmanager.RPCTM and fills in all
@@ -542,6 +543,54 @@ func tmRPCTestReloadSchemaPanic(ctx context.Context, t *testing.T, client tmclie
 	expectHandleRPCPanic(t, "ReloadSchema", false /*verbose*/, err)
 }
 
+var testQueryQuarantines = []*tabletmanagerdatapb.QueryQuarantine{
+	{
+		Fingerprint: "select sleep(:vtg1) from dual",
+		Action:      "FAIL",
+		Reason:      "5 timeouts within 1m0s",
+		StartTime:   &vttimepb.Time{Seconds: 1000},
+		ExpireTime:  &vttimepb.Time{Seconds: 1300},
+		Hits:        12,
+	},
+}
+
+func (fra *fakeRPCTM) ListQueryQuarantines(ctx context.Context) ([]*tabletmanagerdatapb.QueryQuarantine, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testQueryQuarantines, nil
+}
+
+func tmRPCTestListQueryQuarantines(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	quarantines, err := client.ListQueryQuarantines(ctx, tablet)
+	compareError(t, "ListQueryQuarantines", err, quarantines, testQueryQuarantines)
+}
+
+func tmRPCTestListQueryQuarantinesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ListQueryQuarantines(ctx, tablet)
+	expectHandleRPCPanic(t, "ListQueryQuarantines", false /*verbose*/, err)
+}
+
+var testClearQueryQuarantinesFingerprint = "select sleep(:vtg1) from dual"
+
+func (fra *fakeRPCTM) ClearQueryQuarantines(ctx context.Context, fingerprint string) (int, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ClearQueryQuarantines fingerprint", fingerprint, testClearQueryQuarantinesFingerprint)
+	return 1, nil
+}
+
+func tmRPCTestClearQueryQuarantines(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	cleared, err := client.ClearQueryQuarantines(ctx, tablet, testClearQueryQuarantinesFingerprint)
+	compareError(t, "ClearQueryQuarantines", err, cleared, 1)
+}
+
+func tmRPCTestClearQueryQuarantinesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ClearQueryQuarantines(ctx, tablet, testClearQueryQuarantinesFingerprint)
+	expectHandleRPCPanic(t, "ClearQueryQuarantines", true /*verbose*/, err)
+}
+
 var testPreflightSchema = []string{"change table add table cloth"}
 var testSchemaChangeResult = []*tabletmanagerdatapb.SchemaChangeResult{
 	{
@@ -1250,17 +1299,22 @@ func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.Tab
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+var testRestoreToPos = "MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-100"
+var testRestoreToTimestamp = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup args", restoreToPos, testRestoreToPos)
+	compare(fra.t, "RestoreFromBackup args", restoreToTimestamp.UTC(), testRestoreToTimestamp)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToPos, testRestoreToTimestamp)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1269,7 +1323,7 @@ func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclie
 }
 
 func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToPos, testRestoreToTimestamp)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1319,6 +1373,8 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestRunHealthCheck(ctx, t, client, tablet)
 	tmRPCTestIgnoreHealthError(ctx, t, client, tablet)
 	tmRPCTestReloadSchema(ctx, t, client, tablet)
+	tmRPCTestListQueryQuarantines(ctx, t, client, tablet)
+	tmRPCTestClearQueryQuarantines(ctx, t, client, tablet)
 	tmRPCTestPreflightSchema(ctx, t, client, tablet)
 	tmRPCTestApplySchema(ctx, t, client, tablet)
 	tmRPCTestExecuteFetch(ctx, t, client, tablet)
@@ -1375,6 +1431,8 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestRunHealthCheckPanic(ctx, t, client, tablet)
 	tmRPCTestIgnoreHealthErrorPanic(ctx, t, client, tablet)
 	tmRPCTestReloadSchemaPanic(ctx, t, client, tablet)
+	tmRPCTestListQueryQuarantinesPanic(ctx, t, client, tablet)
+	tmRPCTestClearQueryQuarantinesPanic(ctx, t, client, tablet)
 	tmRPCTestPreflightSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestApplySchemaPanic(ctx, t, client, tablet)
 	tmRPCTestExecuteFetchPanic(ctx, t, client, tablet)
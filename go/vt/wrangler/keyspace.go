@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"flag"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -39,6 +40,7 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/topotools/events"
 	"vitess.io/vitess/go/vt/vterrors"
+	throttlemysql "vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
 )
 
 const (
@@ -52,6 +54,10 @@ var (
 	waitForDrainSleepReplica = flag.Duration("wait_for_drain_sleep_replica", 15*time.Second, "time to wait before shutting the query service on old REPLICA tablets during MigrateServedTypes")
 )
 
+// throttlerMetricNameRegexp matches the valid names of throttler metrics,
+// which are part of the names of the throttler checks and stats.
+var throttlerMetricNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
 // keyspace related methods for Wrangler
 
 // SetKeyspaceShardingInfo locks a keyspace and sets its ShardingColumnName
@@ -1357,6 +1363,61 @@ func (wr *Wrangler) SetKeyspaceServedFrom(ctx context.Context, keyspace string,
 	return wr.ts.UpdateKeyspace(ctx, ki)
 }
 
+// UpdateThrottlerMetric locks a keyspace and adds, updates or removes a
+// metric of its throttler config. The tablet throttlers of the keyspace
+// pick up the change on their next inventory refresh.
+func (wr *Wrangler) UpdateThrottlerMetric(ctx context.Context, keyspace, metricName, query string, threshold float64, remove bool) (err error) {
+	if !throttlerMetricNameRegexp.MatchString(metricName) {
+		return fmt.Errorf("invalid throttler metric name: %v", metricName)
+	}
+	if !remove {
+		if threshold <= 0 {
+			return fmt.Errorf("the threshold of throttler metric %v must be positive", metricName)
+		}
+		_, builtin := throttlemysql.BuiltinMetricQueries[metricName]
+		switch throttlemysql.GetMetricsQueryType(query) {
+		case throttlemysql.MetricsQueryTypeSelect, throttlemysql.MetricsQueryTypeShowGlobal:
+		case throttlemysql.MetricsQueryTypeDefault:
+			if !builtin && metricName != throttlemysql.LagMetricName {
+				return fmt.Errorf("throttler metric %v is not builtin, and requires a query", metricName)
+			}
+		default:
+			return fmt.Errorf("throttler metric query must be a SELECT or a SHOW GLOBAL query: %v", query)
+		}
+	}
+
+	// Lock the keyspace
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "UpdateThrottlerMetric")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	// and update it
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if remove {
+		if _, ok := ki.ThrottlerConfig.GetMetrics()[metricName]; !ok {
+			return fmt.Errorf("keyspace %v has no throttler metric %v", keyspace, metricName)
+		}
+		delete(ki.ThrottlerConfig.Metrics, metricName)
+	} else {
+		if ki.ThrottlerConfig == nil {
+			ki.ThrottlerConfig = &topodatapb.ThrottlerConfig{}
+		}
+		if ki.ThrottlerConfig.Metrics == nil {
+			ki.ThrottlerConfig.Metrics = make(map[string]*topodatapb.ThrottlerMetric)
+		}
+		ki.ThrottlerConfig.Metrics[metricName] = &topodatapb.ThrottlerMetric{
+			Query:     query,
+			Threshold: threshold,
+		}
+	}
+	return wr.ts.UpdateKeyspace(ctx, ki)
+}
+
 // RefreshTabletsByShard calls RefreshState on all the tablets in a given shard.
 func (wr *Wrangler) RefreshTabletsByShard(ctx context.Context, si *topo.ShardInfo, cells []string) error {
 	_, err := topotools.RefreshTabletsByShard(ctx, wr.ts, wr.tmc, si, cells, wr.Logger())
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestUpdateThrottlerMetric(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	wr := New(logutil.NewConsoleLogger(), ts, newTestWranglerTMClient())
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+
+	require.NoError(t, wr.UpdateThrottlerMetric(ctx, "ks", "threads_running", "", 50, false))
+	require.NoError(t, wr.UpdateThrottlerMetric(ctx, "ks", "lag", "", 5, false))
+	require.NoError(t, wr.UpdateThrottlerMetric(ctx, "ks", "open_tables", "show global status like 'open_tables'", 1000, false))
+	require.NoError(t, wr.UpdateThrottlerMetric(ctx, "ks", "open_tables", "show global status like 'open_tables'", 2000, false))
+	require.NoError(t, wr.UpdateThrottlerMetric(ctx, "ks", "lag", "", 0, true))
+
+	ki, err := ts.GetKeyspace(ctx, "ks")
+	require.NoError(t, err)
+	utils.MustMatch(t, &topodatapb.ThrottlerConfig{
+		Metrics: map[string]*topodatapb.ThrottlerMetric{
+			"threads_running": {Threshold: 50},
+			"open_tables":     {Query: "show global status like 'open_tables'", Threshold: 2000},
+		},
+	}, ki.ThrottlerConfig)
+
+	testcases := []struct {
+		metricName string
+		query      string
+		threshold  float64
+		remove     bool
+		err        string
+	}{{
+		metricName: "mysql/shard",
+		threshold:  1,
+		err:        "invalid throttler metric name: mysql/shard",
+	}, {
+		metricName: "custom",
+		threshold:  1,
+		err:        "throttler metric custom is not builtin, and requires a query",
+	}, {
+		metricName: "custom",
+		query:      "delete from t",
+		threshold:  1,
+		err:        "throttler metric query must be a SELECT or a SHOW GLOBAL query: delete from t",
+	}, {
+		metricName: "custom",
+		query:      "select 1",
+		err:        "the threshold of throttler metric custom must be positive",
+	}, {
+		metricName: "lag",
+		remove:     true,
+		err:        "keyspace ks has no throttler metric lag",
+	}}
+	for _, tcase := range testcases {
+		err := wr.UpdateThrottlerMetric(ctx, "ks", tcase.metricName, tcase.query, tcase.threshold, tcase.remove)
+		assert.EqualError(t, err, tcase.err)
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+)
+
+// A tablet vdiff runs on the primaries of the target shards of a workflow,
+// which compare their rows with the sources one chunk at a time, and save
+// their progress in _vt.vdiff and _vt.vdiff_table. Unlike VDiff, it survives
+// the restarts of vtctld and of the tablets, and can be stopped and resumed.
+
+// TabletVDiffReport is the state of a tablet vdiff of a workflow.
+type TabletVDiffReport struct {
+	UUID     string
+	Workflow string
+	// State is the least advanced state of the shards.
+	State  string
+	Shards map[string]*TabletVDiffShardReport
+	// Tables sums the reports of the shards.
+	Tables map[string]*vreplication.VDiffTableReport
+}
+
+// TabletVDiffShardReport is the state of a tablet vdiff on one target shard.
+type TabletVDiffShardReport struct {
+	State       string
+	LastError   string `json:",omitempty"`
+	CreatedAt   string
+	StartedAt   string `json:",omitempty"`
+	CompletedAt string `json:",omitempty"`
+	// TableStates are the states of the tables.
+	TableStates map[string]string
+}
+
+// The states of the shards, from the least to the most advanced.
+var tabletVDiffStateOrder = []string{
+	vreplication.VDiffStateError,
+	vreplication.VDiffStateStopped,
+	vreplication.VDiffStatePending,
+	vreplication.VDiffStateStarted,
+	vreplication.VDiffStateCompleted,
+}
+
+// StartTabletVDiff starts a tablet vdiff of a workflow on its target shards,
+// and returns its uuid.
+func (wr *Wrangler) StartTabletVDiff(ctx context.Context, targetKeyspace, workflowName string, options *vreplication.VDiffOptions) (string, error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflowName)
+	if err != nil {
+		return "", err
+	}
+	buf, err := json.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+	vdiffUUID := uuid.New().String()
+	err = ts.forAllTargets(func(target *workflow.MigrationTarget) error {
+		query := fmt.Sprintf("insert into _vt.vdiff(vdiff_uuid, workflow, db_name, state, options) values (%s, %s, %s, %s, %s)",
+			encodeString(vdiffUUID), encodeString(workflowName), encodeString(target.GetPrimary().DbName()), encodeString(vreplication.VDiffStatePending), encodeString(string(buf)))
+		_, err := wr.tmc.VReplicationExec(ctx, target.GetPrimary().Tablet, query)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return vdiffUUID, nil
+}
+
+// StopTabletVDiff stops a running tablet vdiff. It keeps its progress.
+func (wr *Wrangler) StopTabletVDiff(ctx context.Context, targetKeyspace, workflowName, vdiffUUID string) error {
+	return wr.execTabletVDiff(ctx, targetKeyspace, workflowName, func(dbName string) string {
+		return fmt.Sprintf("update _vt.vdiff set state=%s where db_name=%s and workflow=%s and vdiff_uuid=%s and state in (%s, %s)",
+			encodeString(vreplication.VDiffStateStopped), encodeString(dbName), encodeString(workflowName), encodeString(vdiffUUID),
+			encodeString(vreplication.VDiffStatePending), encodeString(vreplication.VDiffStateStarted))
+	})
+}
+
+// ResumeTabletVDiff resumes a stopped or failed tablet vdiff where it left off.
+func (wr *Wrangler) ResumeTabletVDiff(ctx context.Context, targetKeyspace, workflowName, vdiffUUID string) error {
+	return wr.execTabletVDiff(ctx, targetKeyspace, workflowName, func(dbName string) string {
+		return fmt.Sprintf("update _vt.vdiff set state=%s, last_error='' where db_name=%s and workflow=%s and vdiff_uuid=%s and state in (%s, %s)",
+			encodeString(vreplication.VDiffStatePending), encodeString(dbName), encodeString(workflowName), encodeString(vdiffUUID),
+			encodeString(vreplication.VDiffStateStopped), encodeString(vreplication.VDiffStateError))
+	})
+}
+
+// DeleteTabletVDiff stops a tablet vdiff and deletes its progress and reports.
+func (wr *Wrangler) DeleteTabletVDiff(ctx context.Context, targetKeyspace, workflowName, vdiffUUID string) error {
+	return wr.execTabletVDiff(ctx, targetKeyspace, workflowName, func(dbName string) string {
+		return fmt.Sprintf("delete from _vt.vdiff where db_name=%s and workflow=%s and vdiff_uuid=%s",
+			encodeString(dbName), encodeString(workflowName), encodeString(vdiffUUID))
+	})
+}
+
+func (wr *Wrangler) execTabletVDiff(ctx context.Context, targetKeyspace, workflowName string, query func(dbName string) string) error {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflowName)
+	if err != nil {
+		return err
+	}
+	return ts.forAllTargets(func(target *workflow.MigrationTarget) error {
+		_, err := wr.tmc.VReplicationExec(ctx, target.GetPrimary().Tablet, query(target.GetPrimary().DbName()))
+		return err
+	})
+}
+
+// ShowTabletVDiff returns the reports of the tablet vdiffs of a workflow,
+// or of the one with the uuid if it's set, in the order they were created.
+func (wr *Wrangler) ShowTabletVDiff(ctx context.Context, targetKeyspace, workflowName, vdiffUUID string) ([]*TabletVDiffReport, error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflowName)
+	if err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	var reports []*TabletVDiffReport
+	byUUID := make(map[string]*TabletVDiffReport)
+	err = ts.forAllTargets(func(target *workflow.MigrationTarget) error {
+		query := fmt.Sprintf("select id, vdiff_uuid, state, last_error, created_at, started_at, completed_at from _vt.vdiff where db_name=%s and workflow=%s",
+			encodeString(target.GetPrimary().DbName()), encodeString(workflowName))
+		if vdiffUUID != "" {
+			query += fmt.Sprintf(" and vdiff_uuid=%s", encodeString(vdiffUUID))
+		}
+		p3qr, err := wr.tmc.VReplicationExec(ctx, target.GetPrimary().Tablet, query+" order by id")
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		for _, row := range qr.Rows {
+			shardReport := &TabletVDiffShardReport{
+				State:       row[2].ToString(),
+				LastError:   row[3].ToString(),
+				CreatedAt:   row[4].ToString(),
+				StartedAt:   row[5].ToString(),
+				CompletedAt: row[6].ToString(),
+				TableStates: make(map[string]string),
+			}
+			query := fmt.Sprintf("select table_name, state, report from _vt.vdiff_table where vdiff_id=%s", row[0].ToString())
+			p3qr, err := wr.tmc.VReplicationExec(ctx, target.GetPrimary().Tablet, query)
+			if err != nil {
+				return err
+			}
+			var tableReports []*vreplication.VDiffTableReport
+			for _, tableRow := range sqltypes.Proto3ToResult(p3qr).Rows {
+				table := tableRow[0].ToString()
+				shardReport.TableStates[table] = tableRow[1].ToString()
+				tableReport := &vreplication.VDiffTableReport{TableName: table}
+				if report := tableRow[2].ToBytes(); len(report) != 0 {
+					if err := json.Unmarshal(report, tableReport); err != nil {
+						return err
+					}
+				}
+				tableReports = append(tableReports, tableReport)
+			}
+
+			mu.Lock()
+			report, ok := byUUID[row[1].ToString()]
+			if !ok {
+				report = &TabletVDiffReport{
+					UUID:     row[1].ToString(),
+					Workflow: workflowName,
+					Shards:   make(map[string]*TabletVDiffShardReport),
+					Tables:   make(map[string]*vreplication.VDiffTableReport),
+				}
+				byUUID[report.UUID] = report
+				reports = append(reports, report)
+			}
+			report.Shards[target.GetShard().ShardName()] = shardReport
+			for _, tableReport := range tableReports {
+				addVDiffTableReport(report, tableReport)
+			}
+			mu.Unlock()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, report := range reports {
+		report.State = vreplication.VDiffStateCompleted
+		for _, shardReport := range report.Shards {
+			if tabletVDiffStateRank(shardReport.State) < tabletVDiffStateRank(report.State) {
+				report.State = shardReport.State
+			}
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		ci, cj := reports[i].createdAt(), reports[j].createdAt()
+		if ci != cj {
+			return ci < cj
+		}
+		return reports[i].UUID < reports[j].UUID
+	})
+	return reports, nil
+}
+
+func (report *TabletVDiffReport) createdAt() string {
+	createdAt := ""
+	for _, shardReport := range report.Shards {
+		if createdAt == "" || shardReport.CreatedAt < createdAt {
+			createdAt = shardReport.CreatedAt
+		}
+	}
+	return createdAt
+}
+
+func tabletVDiffStateRank(state string) int {
+	for i, s := range tabletVDiffStateOrder {
+		if s == state {
+			return i
+		}
+	}
+	// Unknown states come first.
+	return -1
+}
+
+func addVDiffTableReport(report *TabletVDiffReport, tableReport *vreplication.VDiffTableReport) {
+	total, ok := report.Tables[tableReport.TableName]
+	if !ok {
+		total = &vreplication.VDiffTableReport{TableName: tableReport.TableName}
+		report.Tables[tableReport.TableName] = total
+	}
+	total.ProcessedRows += tableReport.ProcessedRows
+	total.MatchingRows += tableReport.MatchingRows
+	total.MismatchedRows += tableReport.MismatchedRows
+	total.ExtraRowsSource += tableReport.ExtraRowsSource
+	total.ExtraRowsTarget += tableReport.ExtraRowsTarget
+	total.ExtraRowsSourceSample = append(total.ExtraRowsSourceSample, tableReport.ExtraRowsSourceSample...)
+	total.ExtraRowsTargetSample = append(total.ExtraRowsTargetSample, tableReport.ExtraRowsTargetSample...)
+	total.MismatchedRowsSample = append(total.MismatchedRowsSample, tableReport.MismatchedRowsSample...)
+}
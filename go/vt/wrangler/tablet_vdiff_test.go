@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+)
+
+// inserts are accepted and recorded, because their uuid is random.
+type testTabletVDiffTMClient struct {
+	*testVDiffTMClient
+
+	mu      sync.Mutex
+	inserts map[int]string
+}
+
+func (tmc *testTabletVDiffTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	if strings.HasPrefix(query, "insert into _vt.vdiff") {
+		tmc.mu.Lock()
+		defer tmc.mu.Unlock()
+		tmc.inserts[int(tablet.Alias.Uid)] = query
+		return &querypb.QueryResult{RowsAffected: 1}, nil
+	}
+	return tmc.testVDiffTMClient.VReplicationExec(ctx, tablet, query)
+}
+
+func TestTabletVDiffStart(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+	tmc := &testTabletVDiffTMClient{testVDiffTMClient: env.tmc, inserts: make(map[int]string)}
+	wr := New(logutil.NewConsoleLogger(), env.topoServ, tmc)
+
+	vdiffUUID, err := wr.StartTabletVDiff(context.Background(), "target", env.workflow, &vreplication.VDiffOptions{
+		Tables:        []string{"t1"},
+		ChunkDuration: time.Minute,
+	})
+	require.NoError(t, err)
+	want := fmt.Sprintf(`insert into _vt.vdiff(vdiff_uuid, workflow, db_name, state, options) values ('%s', 'vdiffTest', 'vt_target', 'pending', '{\"tables\":[\"t1\"],\"chunk_duration\":60000000000}')`, vdiffUUID)
+	assert.Equal(t, map[int]string{200: want, 210: want}, tmc.inserts)
+}
+
+func TestTabletVDiffStopResumeDelete(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+	ctx := context.Background()
+
+	for _, tabletID := range []int{200, 210} {
+		master := env.tablets[tabletID].tablet
+		env.tmc.setVRResults(master, "update _vt.vdiff set state='stopped' where db_name='vt_target' and workflow='vdiffTest' and vdiff_uuid='u1' and state in ('pending', 'started')", &sqltypes.Result{RowsAffected: 1})
+		env.tmc.setVRResults(master, "update _vt.vdiff set state='pending', last_error='' where db_name='vt_target' and workflow='vdiffTest' and vdiff_uuid='u1' and state in ('stopped', 'error')", &sqltypes.Result{RowsAffected: 1})
+		env.tmc.setVRResults(master, "delete from _vt.vdiff where db_name='vt_target' and workflow='vdiffTest' and vdiff_uuid='u1'", &sqltypes.Result{RowsAffected: 1})
+	}
+	require.NoError(t, env.wr.StopTabletVDiff(ctx, "target", env.workflow, "u1"))
+	require.NoError(t, env.wr.ResumeTabletVDiff(ctx, "target", env.workflow, "u1"))
+	require.NoError(t, env.wr.DeleteTabletVDiff(ctx, "target", env.workflow, "u1"))
+
+	err := env.wr.StopTabletVDiff(ctx, "target", env.workflow, "u2")
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestTabletVDiffShow(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+
+	vdiffFields := sqltypes.MakeTestFields(
+		"id|vdiff_uuid|state|last_error|created_at|started_at|completed_at",
+		"int64|varchar|varchar|varchar|varchar|varchar|varchar")
+	tableFields := sqltypes.MakeTestFields("table_name|state|report", "varchar|varchar|varbinary")
+	query := "select id, vdiff_uuid, state, last_error, created_at, started_at, completed_at from _vt.vdiff where db_name='vt_target' and workflow='vdiffTest' order by id"
+
+	env.tmc.setVRResults(env.tablets[200].tablet, query, sqltypes.MakeTestResult(vdiffFields,
+		"1|u1|completed||2021-01-01 00:00:00|2021-01-01 00:00:01|2021-01-01 00:01:00",
+		"2|u2|started||2021-01-02 00:00:00|2021-01-02 00:00:01|",
+	))
+	env.tmc.setVRResults(env.tablets[200].tablet, "select table_name, state, report from _vt.vdiff_table where vdiff_id=1", sqltypes.MakeTestResult(tableFields,
+		`t1|completed|{"TableName":"t1","ProcessedRows":3,"MatchingRows":2,"MismatchedRows":1,"MismatchedRowsSample":[{"Source":{"c1":"1","c2":"2"},"Target":{"c1":"1","c2":"3"}}]}`,
+	))
+	env.tmc.setVRResults(env.tablets[200].tablet, "select table_name, state, report from _vt.vdiff_table where vdiff_id=2", sqltypes.MakeTestResult(tableFields,
+		"t1|pending|",
+	))
+	env.tmc.setVRResults(env.tablets[210].tablet, query, sqltypes.MakeTestResult(vdiffFields,
+		"3|u1|error|source is gone|2021-01-01 00:00:00|2021-01-01 00:00:01|",
+	))
+	env.tmc.setVRResults(env.tablets[210].tablet, "select table_name, state, report from _vt.vdiff_table where vdiff_id=3", sqltypes.MakeTestResult(tableFields,
+		`t1|started|{"TableName":"t1","ProcessedRows":2,"MatchingRows":1,"ExtraRowsTarget":1,"ExtraRowsTargetSample":[{"c1":"5","c2":"6"}]}`,
+	))
+
+	reports, err := env.wr.ShowTabletVDiff(context.Background(), "target", env.workflow, "")
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	assert.Equal(t, &TabletVDiffReport{
+		UUID:     "u1",
+		Workflow: "vdiffTest",
+		State:    vreplication.VDiffStateError,
+		Shards: map[string]*TabletVDiffShardReport{
+			"-80": {
+				State:       "completed",
+				CreatedAt:   "2021-01-01 00:00:00",
+				StartedAt:   "2021-01-01 00:00:01",
+				CompletedAt: "2021-01-01 00:01:00",
+				TableStates: map[string]string{"t1": "completed"},
+			},
+			"80-": {
+				State:       "error",
+				LastError:   "source is gone",
+				CreatedAt:   "2021-01-01 00:00:00",
+				StartedAt:   "2021-01-01 00:00:01",
+				TableStates: map[string]string{"t1": "started"},
+			},
+		},
+		Tables: map[string]*vreplication.VDiffTableReport{
+			"t1": {
+				TableName:             "t1",
+				ProcessedRows:         5,
+				MatchingRows:          3,
+				MismatchedRows:        1,
+				ExtraRowsTarget:       1,
+				ExtraRowsTargetSample: []vreplication.VDiffRow{{"c1": "5", "c2": "6"}},
+				MismatchedRowsSample: []*vreplication.VDiffMismatch{{
+					Source: vreplication.VDiffRow{"c1": "1", "c2": "2"},
+					Target: vreplication.VDiffRow{"c1": "1", "c2": "3"},
+				}},
+			},
+		},
+	}, reports[0])
+
+	// u2 only exists on -80.
+	assert.Equal(t, "u2", reports[1].UUID)
+	assert.Equal(t, vreplication.VDiffStateStarted, reports[1].State)
+	assert.Len(t, reports[1].Shards, 1)
+	assert.Equal(t, &vreplication.VDiffTableReport{TableName: "t1"}, reports[1].Tables["t1"])
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the tooling to inspect and resolve the distributed
+// transactions of the atomic (2PC) transaction mode. The metadata of a
+// distributed transaction is stored in the _vt.dt_state and
+// _vt.dt_participant tables of its metadata manager shard, and its
+// prepared transactions are stored in the _vt.redo_state and
+// _vt.redo_statement tables of its participant shards.
+
+const (
+	sqlListDistributedTransactions = `select t.dtid, t.state, t.time_created, p.keyspace, p.shard
+	from _vt.dt_state t
+	join _vt.dt_participant p on t.dtid = p.dtid
+	order by t.dtid, p.id`
+	sqlReadDistributedTransaction = `select t.dtid, t.state, t.time_created, p.keyspace, p.shard
+	from _vt.dt_state t
+	join _vt.dt_participant p on t.dtid = p.dtid
+	where t.dtid = %s
+	order by p.id`
+	sqlReadRedoLog = `select t.state, s.statement
+	from _vt.redo_state t
+	join _vt.redo_statement s on t.dtid = s.dtid
+	where t.dtid = %s
+	order by s.id`
+)
+
+// DistributedTransaction is a distributed transaction, as recorded by its
+// metadata manager shard and its participants.
+type DistributedTransaction struct {
+	Dtid string
+	// State is the state of the transaction in the metadata manager:
+	// PREPARE, COMMIT or ROLLBACK, or empty if it was concluded.
+	State        string
+	TimeCreated  time.Time
+	Participants []*TransactionParticipant
+}
+
+// TransactionParticipant is a participant shard of a distributed
+// transaction.
+type TransactionParticipant struct {
+	Keyspace string
+	Shard    string
+	// RedoState is the state of the prepared transaction on the
+	// primary of the shard: Prepared or Failed, or empty if it was not
+	// prepared or was already resolved.
+	RedoState  string
+	Statements []string `json:",omitempty"`
+}
+
+// ListDistributedTransactions returns the unresolved distributed
+// transactions whose metadata is managed by the shards of keyspace, or
+// by all shards if keyspace is empty.
+func (wr *Wrangler) ListDistributedTransactions(ctx context.Context, keyspace string) ([]*DistributedTransaction, error) {
+	keyspaces := []string{keyspace}
+	if keyspace == "" {
+		var err error
+		if keyspaces, err = wr.ts.GetKeyspaces(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var transactions []*DistributedTransaction
+	for _, keyspace := range keyspaces {
+		shards, err := wr.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range shards {
+			qr, err := wr.execOnPrimary(ctx, keyspace, shard, sqlListDistributedTransactions)
+			if err != nil {
+				return nil, err
+			}
+			transactions = append(transactions, distributedTransactions(qr)...)
+		}
+	}
+	sort.Slice(transactions, func(i, j int) bool {
+		return transactions[i].Dtid < transactions[j].Dtid
+	})
+	return transactions, nil
+}
+
+// ReadDistributedTransaction returns the distributed transaction dtid,
+// with the redo logs of its participants. If the metadata of the
+// transaction is missing, the participants are the shards that have a
+// redo log for it.
+func (wr *Wrangler) ReadDistributedTransaction(ctx context.Context, dtid string) (*DistributedTransaction, error) {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := wr.execOnPrimary(ctx, mmShard.Target.Keyspace, mmShard.Target.Shard, fmt.Sprintf(sqlReadDistributedTransaction, encodeString(dtid)))
+	if err != nil {
+		return nil, err
+	}
+	if transactions := distributedTransactions(qr); len(transactions) != 0 {
+		transaction := transactions[0]
+		for _, p := range transaction.Participants {
+			if err := wr.readRedoLog(ctx, dtid, p); err != nil {
+				return nil, err
+			}
+		}
+		return transaction, nil
+	}
+
+	transaction := &DistributedTransaction{Dtid: dtid}
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyspace := range keyspaces {
+		shards, err := wr.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range shards {
+			p := &TransactionParticipant{Keyspace: keyspace, Shard: shard}
+			if err := wr.readRedoLog(ctx, dtid, p); err != nil {
+				return nil, err
+			}
+			if p.RedoState != "" {
+				transaction.Participants = append(transaction.Participants, p)
+			}
+		}
+	}
+	return transaction, nil
+}
+
+// ConcludeDistributedTransaction resolves the distributed transaction
+// dtid the way vtgate does: a transaction that is still in the PREPARE
+// state is rolled back, and the decision of a transaction in the COMMIT
+// or ROLLBACK state is applied to its participants. The metadata of the
+// transaction is then deleted.
+func (wr *Wrangler) ConcludeDistributedTransaction(ctx context.Context, dtid string) error {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+	mm, err := wr.primaryQueryService(ctx, mmShard.Target)
+	if err != nil {
+		return err
+	}
+	defer mm.Close(ctx)
+
+	transaction, err := mm.ReadTransaction(ctx, mmShard.Target, dtid)
+	if err != nil {
+		return err
+	}
+	if transaction == nil || transaction.Dtid == "" {
+		// It was already resolved.
+		return nil
+	}
+	switch transaction.State {
+	case querypb.TransactionState_PREPARE:
+		if err := mm.SetRollback(ctx, mmShard.Target, dtid, mmShard.TransactionId); err != nil {
+			return err
+		}
+		fallthrough
+	case querypb.TransactionState_ROLLBACK:
+		err = wr.resolveParticipants(ctx, dtid, transaction.Participants, false)
+	case querypb.TransactionState_COMMIT:
+		err = wr.resolveParticipants(ctx, dtid, transaction.Participants, true)
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid state: %v", transaction.State)
+	}
+	if err != nil {
+		return err
+	}
+	return mm.ConcludeTransaction(ctx, mmShard.Target, dtid)
+}
+
+// ForceResolveDistributedTransaction commits or rolls back the prepared
+// transactions of dtid on its participants, regardless of the decision
+// recorded by the metadata manager, and deletes the metadata of the
+// transaction. It is meant for the transactions that can't be
+// concluded, such as the ones whose metadata was lost.
+func (wr *Wrangler) ForceResolveDistributedTransaction(ctx context.Context, dtid string, commit bool) error {
+	transaction, err := wr.ReadDistributedTransaction(ctx, dtid)
+	if err != nil {
+		return err
+	}
+	var participants []*querypb.Target
+	for _, p := range transaction.Participants {
+		if p.RedoState != "" {
+			participants = append(participants, &querypb.Target{Keyspace: p.Keyspace, Shard: p.Shard, TabletType: topodatapb.TabletType_MASTER})
+		}
+	}
+	if err := wr.resolveParticipants(ctx, dtid, participants, commit); err != nil {
+		return err
+	}
+	if transaction.State == "" {
+		return nil
+	}
+
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+	mm, err := wr.primaryQueryService(ctx, mmShard.Target)
+	if err != nil {
+		return err
+	}
+	defer mm.Close(ctx)
+	return mm.ConcludeTransaction(ctx, mmShard.Target, dtid)
+}
+
+// resolveParticipants commits or rolls back the prepared transactions of
+// dtid on the primaries of participants.
+func (wr *Wrangler) resolveParticipants(ctx context.Context, dtid string, participants []*querypb.Target, commit bool) error {
+	for _, target := range participants {
+		target = &querypb.Target{Keyspace: target.Keyspace, Shard: target.Shard, TabletType: topodatapb.TabletType_MASTER}
+		qs, err := wr.primaryQueryService(ctx, target)
+		if err != nil {
+			return err
+		}
+		if commit {
+			err = qs.CommitPrepared(ctx, target, dtid)
+		} else {
+			err = qs.RollbackPrepared(ctx, target, dtid, 0)
+		}
+		qs.Close(ctx)
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot resolve dtid %s on %s/%s", dtid, target.Keyspace, target.Shard)
+		}
+		wr.Logger().Infof("Resolved dtid %s on %s/%s", dtid, target.Keyspace, target.Shard)
+	}
+	return nil
+}
+
+// readRedoLog reads the redo log of dtid on the primary of p.
+func (wr *Wrangler) readRedoLog(ctx context.Context, dtid string, p *TransactionParticipant) error {
+	qr, err := wr.execOnPrimary(ctx, p.Keyspace, p.Shard, fmt.Sprintf(sqlReadRedoLog, encodeString(dtid)))
+	if err != nil {
+		return err
+	}
+	p.RedoState = ""
+	p.Statements = nil
+	for _, row := range qr.Rows {
+		state, err := evalengine.ToInt64(row[0])
+		if err != nil {
+			return err
+		}
+		// The redo states are defined by the tabletserver twopc.
+		p.RedoState = "Failed"
+		if state == 1 {
+			p.RedoState = "Prepared"
+		}
+		p.Statements = append(p.Statements, row[1].ToString())
+	}
+	return nil
+}
+
+// execOnPrimary executes query on the primary of keyspace/shard. The
+// result is empty if the 2PC tables don't exist, which is the case if
+// the tablet doesn't enable 2PC.
+func (wr *Wrangler) execOnPrimary(ctx context.Context, keyspace, shard, query string) (*sqltypes.Result, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if si.MasterAlias == nil {
+		return nil, fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+	}
+	ti, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, false, []byte(query), 10000, false, false)
+	if err != nil {
+		if sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERNoSuchTable {
+			return &sqltypes.Result{}, nil
+		}
+		return nil, vterrors.Wrapf(err, "ExecuteFetchAsDba(%v, %s) failed", topoproto.TabletAliasString(si.MasterAlias), query)
+	}
+	return sqltypes.Proto3ToResult(p3qr), nil
+}
+
+// primaryQueryService returns a connection to the query service of the
+// primary of target.
+func (wr *Wrangler) primaryQueryService(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error) {
+	si, err := wr.ts.GetShard(ctx, target.Keyspace, target.Shard)
+	if err != nil {
+		return nil, err
+	}
+	if si.MasterAlias == nil {
+		return nil, fmt.Errorf("shard %v/%v has no master", target.Keyspace, target.Shard)
+	}
+	ti, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+	return tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+}
+
+// distributedTransactions returns the transactions of a result of
+// sqlListDistributedTransactions.
+func distributedTransactions(qr *sqltypes.Result) []*DistributedTransaction {
+	var transactions []*DistributedTransaction
+	var current *DistributedTransaction
+	for _, row := range qr.Rows {
+		dtid := row[0].ToString()
+		if current == nil || current.Dtid != dtid {
+			state, _ := evalengine.ToInt64(row[1])
+			created, _ := evalengine.ToInt64(row[2])
+			current = &DistributedTransaction{
+				Dtid:        dtid,
+				State:       querypb.TransactionState(state).String(),
+				TimeCreated: time.Unix(0, created),
+			}
+			transactions = append(transactions, current)
+		}
+		current.Participants = append(current.Participants, &TransactionParticipant{
+			Keyspace: row[3].ToString(),
+			Shard:    row[4].ToString(),
+		})
+	}
+	return transactions
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type testTwoPCEnv struct {
+	wr *Wrangler
+
+	mu      sync.Mutex
+	tablets map[string]*testTwoPCTablet
+}
+
+// twoPCEnv has to be a global for RegisterDialer to work.
+var twoPCEnv *testTwoPCEnv
+
+func init() {
+	tabletconn.RegisterDialer("TwoPCTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		twoPCEnv.mu.Lock()
+		defer twoPCEnv.mu.Unlock()
+		return twoPCEnv.tablets[tablet.Shard], nil
+	})
+}
+
+func newTestTwoPCEnv(t *testing.T, shards ...string) *testTwoPCEnv {
+	flag.Set("tablet_protocol", "TwoPCTest")
+	env := &testTwoPCEnv{tablets: make(map[string]*testTwoPCTablet)}
+	env.wr = New(logutil.NewMemoryLogger(), memorytopo.NewServer("zone1"), &testTwoPCTMClient{env: env})
+	for i, shard := range shards {
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: uint32(100 + i)},
+			Keyspace: "ks",
+			Shard:    shard,
+			Type:     topodatapb.TabletType_MASTER,
+			PortMap:  map[string]int32{"test": int32(100 + i)},
+		}
+		require.NoError(t, env.wr.InitTablet(context.Background(), tablet, false, true, false))
+		_, err := env.wr.ts.UpdateShardFields(context.Background(), "ks", shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+		env.tablets[shard] = &testTwoPCTablet{QueryService: fakes.ErrorQueryService, results: make(map[string]*sqltypes.Result)}
+	}
+	twoPCEnv = env
+	return env
+}
+
+// testTwoPCTablet is the query service of a shard primary. It records the
+// 2PC calls.
+type testTwoPCTablet struct {
+	queryservice.QueryService
+	results  map[string]*sqltypes.Result
+	metadata *querypb.TransactionMetadata
+	calls    []string
+}
+
+func (tablet *testTwoPCTablet) ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (*querypb.TransactionMetadata, error) {
+	return tablet.metadata, nil
+}
+
+func (tablet *testTwoPCTablet) SetRollback(ctx context.Context, target *querypb.Target, dtid string, transactionID int64) error {
+	tablet.calls = append(tablet.calls, fmt.Sprintf("SetRollback %s %d", dtid, transactionID))
+	return nil
+}
+
+func (tablet *testTwoPCTablet) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
+	tablet.calls = append(tablet.calls, "CommitPrepared "+dtid)
+	return nil
+}
+
+func (tablet *testTwoPCTablet) RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) error {
+	tablet.calls = append(tablet.calls, "RollbackPrepared "+dtid)
+	return nil
+}
+
+func (tablet *testTwoPCTablet) ConcludeTransaction(ctx context.Context, target *querypb.Target, dtid string) error {
+	tablet.calls = append(tablet.calls, "ConcludeTransaction "+dtid)
+	return nil
+}
+
+func (tablet *testTwoPCTablet) Close(ctx context.Context) error {
+	return nil
+}
+
+type testTwoPCTMClient struct {
+	tmclient.TabletManagerClient
+	env *testTwoPCEnv
+}
+
+func (tmc *testTwoPCTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	result, ok := tmc.env.tablets[tablet.Shard].results[string(query)]
+	if !ok {
+		return nil, fmt.Errorf("Table '_vt.dt_state' doesn't exist (errno 1146) (sqlstate 42S02) during query: %s", query)
+	}
+	return sqltypes.ResultToProto3(result), nil
+}
+
+func TestListDistributedTransactions(t *testing.T) {
+	env := newTestTwoPCEnv(t, "-80", "80-")
+	created := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	env.tablets["80-"].results[sqlListDistributedTransactions] = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"dtid|state|time_created|keyspace|shard",
+		"varbinary|int64|int64|varchar|varchar"),
+		fmt.Sprintf("ks:80-:2|1|%d|ks|-80", created.UnixNano()),
+		fmt.Sprintf("ks:80-:2|1|%d|ks2|0", created.UnixNano()),
+		fmt.Sprintf("ks:80-:3|2|%d|ks|-80", created.UnixNano()),
+	)
+
+	// The shard -80 doesn't have the 2PC tables.
+	transactions, err := env.wr.ListDistributedTransactions(context.Background(), "ks")
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, &DistributedTransaction{
+		Dtid:        "ks:80-:2",
+		State:       "PREPARE",
+		TimeCreated: time.Unix(0, created.UnixNano()),
+		Participants: []*TransactionParticipant{
+			{Keyspace: "ks", Shard: "-80"},
+			{Keyspace: "ks2", Shard: "0"},
+		},
+	}, transactions[0])
+	assert.Equal(t, "COMMIT", transactions[1].State)
+}
+
+func TestReadDistributedTransaction(t *testing.T) {
+	env := newTestTwoPCEnv(t, "-80", "80-")
+	env.tablets["80-"].results[fmt.Sprintf(sqlReadDistributedTransaction, "'ks:80-:2'")] = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"dtid|state|time_created|keyspace|shard",
+		"varbinary|int64|int64|varchar|varchar"),
+		"ks:80-:2|2|1|ks|-80",
+	)
+	env.tablets["-80"].results[fmt.Sprintf(sqlReadRedoLog, "'ks:80-:2'")] = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"state|statement",
+		"int64|varbinary"),
+		"0|insert into t1 values (1)",
+		"0|insert into t1 values (2)",
+	)
+
+	transaction, err := env.wr.ReadDistributedTransaction(context.Background(), "ks:80-:2")
+	require.NoError(t, err)
+	assert.Equal(t, "COMMIT", transaction.State)
+	assert.Equal(t, []*TransactionParticipant{{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		RedoState:  "Failed",
+		Statements: []string{"insert into t1 values (1)", "insert into t1 values (2)"},
+	}}, transaction.Participants)
+
+	// Without metadata, the participants are the shards with a redo log.
+	env.tablets["80-"].results[fmt.Sprintf(sqlReadDistributedTransaction, "'ks:80-:2'")] = &sqltypes.Result{}
+	env.tablets["-80"].results[fmt.Sprintf(sqlReadRedoLog, "'ks:80-:2'")] = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"state|statement",
+		"int64|varbinary"),
+		"1|insert into t1 values (1)",
+	)
+	transaction, err = env.wr.ReadDistributedTransaction(context.Background(), "ks:80-:2")
+	require.NoError(t, err)
+	assert.Empty(t, transaction.State)
+	require.Len(t, transaction.Participants, 1)
+	assert.Equal(t, "Prepared", transaction.Participants[0].RedoState)
+}
+
+func TestConcludeDistributedTransaction(t *testing.T) {
+	testcases := []struct {
+		state      querypb.TransactionState
+		wantMM     []string
+		wantShard0 []string
+	}{{
+		state:      querypb.TransactionState_PREPARE,
+		wantMM:     []string{"SetRollback ks:80-:2 2", "ConcludeTransaction ks:80-:2"},
+		wantShard0: []string{"RollbackPrepared ks:80-:2"},
+	}, {
+		state:      querypb.TransactionState_ROLLBACK,
+		wantMM:     []string{"ConcludeTransaction ks:80-:2"},
+		wantShard0: []string{"RollbackPrepared ks:80-:2"},
+	}, {
+		state:      querypb.TransactionState_COMMIT,
+		wantMM:     []string{"ConcludeTransaction ks:80-:2"},
+		wantShard0: []string{"CommitPrepared ks:80-:2"},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.state.String(), func(t *testing.T) {
+			env := newTestTwoPCEnv(t, "-80", "80-")
+			env.tablets["80-"].metadata = &querypb.TransactionMetadata{
+				Dtid:         "ks:80-:2",
+				State:        tcase.state,
+				Participants: []*querypb.Target{{Keyspace: "ks", Shard: "-80"}},
+			}
+			require.NoError(t, env.wr.ConcludeDistributedTransaction(context.Background(), "ks:80-:2"))
+			assert.Equal(t, tcase.wantMM, env.tablets["80-"].calls)
+			assert.Equal(t, tcase.wantShard0, env.tablets["-80"].calls)
+		})
+	}
+
+	// A concluded transaction is a no-op.
+	env := newTestTwoPCEnv(t, "-80", "80-")
+	require.NoError(t, env.wr.ConcludeDistributedTransaction(context.Background(), "ks:80-:2"))
+	assert.Empty(t, env.tablets["80-"].calls)
+}
+
+func TestForceResolveDistributedTransaction(t *testing.T) {
+	env := newTestTwoPCEnv(t, "-80", "80-")
+	env.tablets["80-"].results[fmt.Sprintf(sqlReadDistributedTransaction, "'ks:80-:2'")] = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"dtid|state|time_created|keyspace|shard",
+		"varbinary|int64|int64|varchar|varchar"),
+		"ks:80-:2|1|1|ks|-80",
+		"ks:80-:2|1|1|ks|80-",
+	)
+	env.tablets["80-"].results[fmt.Sprintf(sqlReadRedoLog, "'ks:80-:2'")] = &sqltypes.Result{}
+	env.tablets["-80"].results[fmt.Sprintf(sqlReadRedoLog, "'ks:80-:2'")] = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"state|statement",
+		"int64|varbinary"),
+		"1|insert into t1 values (1)",
+	)
+
+	// Only the participants with a redo log are resolved.
+	require.NoError(t, env.wr.ForceResolveDistributedTransaction(context.Background(), "ks:80-:2", true))
+	assert.Equal(t, []string{"CommitPrepared ks:80-:2"}, env.tablets["-80"].calls)
+	assert.Equal(t, []string{"ConcludeTransaction ks:80-:2"}, env.tablets["80-"].calls)
+}
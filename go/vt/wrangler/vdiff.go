@@ -29,6 +29,7 @@ import (
 	"google.golang.org/protobuf/encoding/prototext"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -65,6 +66,10 @@ type DiffReport struct {
 	ExtraRowsTargetSample []*RowDiff
 	MismatchedRowsSample  []*DiffMismatch
 	TableName             string
+	// Chunks are the results of the ranges of the table, if it was compared in chunks.
+	Chunks []*DiffChunk `json:",omitempty"`
+	// Repairs are the statements that fix the differences on the target.
+	Repairs []*RepairStatement `json:",omitempty"`
 }
 
 // DiffMismatch is a sample of row diffs between source and target.
@@ -97,6 +102,9 @@ type vdiff struct {
 	workflow       string
 	targetKeyspace string
 	tables         []string
+
+	// schema is the schema of the target tables.
+	schema *tabletmanagerdatapb.SchemaDefinition
 }
 
 // compareColInfo contains the metadata for a column of the table being diffed
@@ -129,6 +137,9 @@ type tableDiffer struct {
 	// source Primitive and targetPrimitive are used for streaming
 	sourcePrimitive engine.Primitive
 	targetPrimitive engine.Primitive
+
+	// repairs collects the differences, if they are to be repaired.
+	repairs *vdiffRepairer
 }
 
 // shardStreamer streams rows from one shard. This works for
@@ -152,6 +163,48 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	filteredReplicationWaitTime time.Duration, format string, maxRows int64, tables string, debug, onlyPks bool) (map[string]*DiffReport, error) {
 	log.Infof("Starting VDiff for %s.%s, sourceCell %s, targetCell %s, tabletTypes %s, timeout %s",
 		targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr, filteredReplicationWaitTime.String())
+	df, err := wr.newVDiff(ctx, targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr, tables)
+	if err != nil {
+		return nil, err
+	}
+	defer func(ctx context.Context) {
+		if err := df.restartTargets(ctx); err != nil {
+			wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", workflowName, err)
+		}
+	}(ctx)
+
+	// Perform the diffs.
+	// We need a cancelable context to abort all running streams
+	// if one stream returns an error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// TODO(sougou): parallelize
+	rowsToCompare := maxRows
+	diffReports := make(map[string]*DiffReport)
+	for table, td := range df.differs {
+		// Skip internal operation tables for vdiff
+		if schema.IsInternalOperationTableName(table) {
+			continue
+		}
+		if err := df.diffTable(ctx, wr, table, td, filteredReplicationWaitTime); err != nil {
+			return nil, err
+		}
+		// Perform the diff of source and target streams.
+		dr, err := td.diff(ctx, df.ts.wr, &rowsToCompare, debug, onlyPks)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "diff")
+		}
+		dr.TableName = table
+		diffReports[table] = dr
+	}
+	wr.printDiffReports(diffReports, format, debug)
+	return diffReports, nil
+}
+
+// newVDiff fetches the metadata of the workflow, builds the plans of its tables
+// and selects the tablets to stream from.
+func (wr *Wrangler) newVDiff(ctx context.Context, targetKeyspace, workflowName, sourceCell, targetCell, tabletTypesStr, tables string) (*vdiff, error) {
 	// Assign defaults to sourceCell and targetCell if not specified.
 	if sourceCell == "" && targetCell == "" {
 		cells, err := wr.ts.GetCellInfoNames(ctx)
@@ -223,42 +276,17 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 	if err = df.buildVDiffPlan(ctx, oneFilter, schm, df.tables); err != nil {
 		return nil, vterrors.Wrap(err, "buildVDiffPlan")
 	}
+	df.schema = schm
 
 	if err := df.selectTablets(ctx, ts); err != nil {
 		return nil, vterrors.Wrap(err, "selectTablets")
 	}
-	defer func(ctx context.Context) {
-		if err := df.restartTargets(ctx); err != nil {
-			wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", workflowName, err)
-		}
-	}(ctx)
-
-	// Perform the diffs.
-	// We need a cancelable context to abort all running streams
-	// if one stream returns an error.
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	return df, nil
+}
 
-	// TODO(sougou): parallelize
-	rowsToCompare := maxRows
-	diffReports := make(map[string]*DiffReport)
+// printDiffReports logs the reports of a vdiff.
+func (wr *Wrangler) printDiffReports(diffReports map[string]*DiffReport, format string, debug bool) {
 	jsonOutput := ""
-	for table, td := range df.differs {
-		// Skip internal operation tables for vdiff
-		if schema.IsInternalOperationTableName(table) {
-			continue
-		}
-		if err := df.diffTable(ctx, wr, table, td, filteredReplicationWaitTime); err != nil {
-			return nil, err
-		}
-		// Perform the diff of source and target streams.
-		dr, err := td.diff(ctx, df.ts.wr, &rowsToCompare, debug, onlyPks)
-		if err != nil {
-			return nil, vterrors.Wrap(err, "diff")
-		}
-		dr.TableName = table
-		diffReports[table] = dr
-	}
 	if format == "json" {
 		json, err := json.MarshalIndent(diffReports, "", "")
 		if err != nil {
@@ -289,9 +317,20 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflowName, sou
 				wr.Logger().Printf("\t\tTarget row:\n")
 				formatSampleRow(wr.Logger(), rs.Target, debug)
 			}
+			if len(dr.Chunks) != 0 {
+				reused := 0
+				for _, chunk := range dr.Chunks {
+					if chunk.Reused {
+						reused++
+					}
+				}
+				wr.Logger().Printf("\tChunks: %v, reused from the previous run: %v\n", len(dr.Chunks), reused)
+			}
+			for _, repair := range dr.Repairs {
+				wr.Logger().Printf("\tRepair: %v;\n", repair)
+			}
 		}
 	}
-	return diffReports, nil
 }
 
 func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *tableDiffer, filteredReplicationWaitTime time.Duration) error {
@@ -852,7 +891,8 @@ func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
 	return row, nil
 }
 
-func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
+// drain consumes the remaining rows, and passes them to f if it's set.
+func (pe *primitiveExecutor) drain(ctx context.Context, f func([]sqltypes.Value) error) (int, error) {
 	count := 0
 	for {
 		row, err := pe.next()
@@ -862,6 +902,11 @@ func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
 		if row == nil {
 			return count, nil
 		}
+		if f != nil {
+			if err := f(row); err != nil {
+				return 0, err
+			}
+		}
 		count++
 	}
 }
@@ -947,9 +992,12 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				return nil, vterrors.Wrap(err, "unexpected error generating diff")
 			}
 			dr.ExtraRowsTargetSample = append(dr.ExtraRowsTargetSample, diffRow)
+			if err := td.repairs.extraTarget(targetRow); err != nil {
+				return nil, err
+			}
 
 			// drain target, update count
-			count, err := targetExecutor.drain(ctx)
+			count, err := targetExecutor.drain(ctx, td.repairs.extraTargetFunc())
 			if err != nil {
 				return nil, err
 			}
@@ -965,8 +1013,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				return nil, vterrors.Wrap(err, "unexpected error generating diff")
 			}
 			dr.ExtraRowsSourceSample = append(dr.ExtraRowsTargetSample, diffRow)
+			if err := td.repairs.extraSource(sourceRow); err != nil {
+				return nil, err
+			}
 
-			count, err := sourceExecutor.drain(ctx)
+			count, err := sourceExecutor.drain(ctx, td.repairs.extraSourceFunc())
 			if err != nil {
 				return nil, err
 			}
@@ -991,6 +1042,9 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				dr.ExtraRowsSourceSample = append(dr.ExtraRowsTargetSample, diffRow)
 			}
 			dr.ExtraRowsSource++
+			if err := td.repairs.extraSource(sourceRow); err != nil {
+				return nil, err
+			}
 			advanceTarget = false
 			continue
 		case c > 0:
@@ -1002,6 +1056,9 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				dr.ExtraRowsTargetSample = append(dr.ExtraRowsTargetSample, diffRow)
 			}
 			dr.ExtraRowsTarget++
+			if err := td.repairs.extraTarget(targetRow); err != nil {
+				return nil, err
+			}
 			advanceSource = false
 			continue
 		}
@@ -1025,6 +1082,9 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, rowsToCompare *in
 				dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &DiffMismatch{Source: sourceDiffRow, Target: targetDiffRow})
 			}
 			dr.MismatchedRows++
+			if err := td.repairs.mismatch(sourceRow); err != nil {
+				return nil, err
+			}
 		default:
 			dr.MatchingRows++
 		}
@@ -1049,7 +1109,7 @@ func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []com
 		if sourceRow[compareIndex].IsText() && targetRow[compareIndex].IsText() {
 			c = bytes.Compare(sourceRow[compareIndex].ToBytes(), targetRow[compareIndex].ToBytes())
 		} else {
-			c, err = evalengine.NullsafeCompare(sourceRow[compareIndex], targetRow[compareIndex])
+			c, err = evalengine.NullsafeCompare(sourceRow[compareIndex], targetRow[compareIndex], collations.Unknown)
 		}
 		if err != nil {
 			return 0, err
@@ -0,0 +1,941 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// A chunked vdiff splits every table into ranges of its first primary key
+// column. For each range, it first compares the checksums of the rows, which
+// mysql computes on the sources and the targets, and streams the rows only if
+// they differ. The ranges are compared in parallel: each one synchronizes the
+// targets with the sources and starts its streams in turn, and then compares
+// its rows while the next ones synchronize.
+
+const (
+	// VDiffRepairPrint reports the statements that fix the differences.
+	VDiffRepairPrint = "print"
+	// VDiffRepairApply executes the statements that fix the differences on the target primaries.
+	VDiffRepairApply = "apply"
+
+	// vdiffRepairBatchSize is the number of rows that are checked again
+	// and repaired while the workflow is stopped.
+	vdiffRepairBatchSize = 500
+	// maxDiffSampleRows is the number of sample rows of each kind in a DiffReport.
+	maxDiffSampleRows = 10
+)
+
+// ChunkedVDiffParams are the parameters of VDiffChunked.
+type ChunkedVDiffParams struct {
+	SourceCell                  string
+	TargetCell                  string
+	TabletTypes                 string
+	FilteredReplicationWaitTime time.Duration
+	// Tables is a comma separated list of the tables to compare. All the tables are compared if it's empty.
+	Tables  string
+	Format  string
+	Debug   bool
+	OnlyPks bool
+
+	// Chunks is the number of ranges of the first primary key column each table is split into.
+	// Only tables whose first primary key column is an integer can be split.
+	Chunks int
+	// Parallelism is the number of ranges that are compared at the same time.
+	Parallelism int
+	// PreviousChunks are the chunks of each table in the report of an earlier run.
+	// The ranges are reused, and the ones whose checksums did not change keep their earlier results.
+	PreviousChunks map[string][]*DiffChunk
+	// Repair is empty, VDiffRepairPrint or VDiffRepairApply.
+	Repair string
+	// MaxRepairs is the maximum number of rows of a table that can be repaired.
+	MaxRepairs int
+}
+
+// DiffChunk is the result of the comparison of a range of the first primary
+// key column of a table. Start is inclusive and End is exclusive. A nil bound
+// is open.
+type DiffChunk struct {
+	Start *int64 `json:",omitempty"`
+	End   *int64 `json:",omitempty"`
+	// SourceChecksum and TargetChecksum are the number of rows
+	// and the checksum of their values, as "count:checksum".
+	SourceChecksum string
+	TargetChecksum string
+	// Reused is set if the range did not change since the previous run,
+	// and its rows were not compared again.
+	Reused          bool `json:",omitempty"`
+	ProcessedRows   int
+	MatchingRows    int
+	MismatchedRows  int
+	ExtraRowsSource int
+	ExtraRowsTarget int
+}
+
+// RepairStatement is a statement that fixes a difference on the target.
+type RepairStatement struct {
+	// Shards are the target shards the statement must be executed on.
+	// It's executed on all of them if it's empty.
+	Shards []string `json:",omitempty"`
+	Query  string
+}
+
+func (rs *RepairStatement) String() string {
+	if len(rs.Shards) == 0 {
+		return rs.Query
+	}
+	return fmt.Sprintf("%s /* shards: %s */", rs.Query, strings.Join(rs.Shards, ","))
+}
+
+// VDiffChunked reports differences between the sources and targets of a vreplication workflow,
+// comparing ranges of the tables in parallel, and optionally repairs them.
+func (wr *Wrangler) VDiffChunked(ctx context.Context, targetKeyspace, workflowName string, params *ChunkedVDiffParams) (map[string]*DiffReport, error) {
+	log.Infof("Starting chunked VDiff for %s.%s: %+v", targetKeyspace, workflowName, params)
+	if params.Chunks < 1 {
+		return nil, fmt.Errorf("the number of chunks must be at least 1")
+	}
+	if params.Parallelism < 1 {
+		return nil, fmt.Errorf("the parallelism must be at least 1")
+	}
+	switch params.Repair {
+	case "", VDiffRepairPrint, VDiffRepairApply:
+	default:
+		return nil, fmt.Errorf("invalid repair mode %s: must be %s or %s", params.Repair, VDiffRepairPrint, VDiffRepairApply)
+	}
+	df, err := wr.newVDiff(ctx, targetKeyspace, workflowName, params.SourceCell, params.TargetCell, params.TabletTypes, params.Tables)
+	if err != nil {
+		return nil, err
+	}
+	defer func(ctx context.Context) {
+		if err := df.restartTargets(ctx); err != nil {
+			wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", workflowName, err)
+		}
+	}(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	diffReports := make(map[string]*DiffReport)
+	for table, td := range df.differs {
+		if schema.IsInternalOperationTableName(table) {
+			continue
+		}
+		dr, err := df.diffTableInChunks(ctx, table, td, params)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "table %s", table)
+		}
+		dr.TableName = table
+		diffReports[table] = dr
+	}
+	wr.printDiffReports(diffReports, params.Format, params.Debug)
+	return diffReports, nil
+}
+
+// diffTableInChunks compares the ranges of a table in parallel, and repairs the differences if requested.
+func (df *vdiff) diffTableInChunks(ctx context.Context, table string, td *tableDiffer, params *ChunkedVDiffParams) (*DiffReport, error) {
+	sourceSelect, err := parseSelect(td.sourceExpression)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceSelect.GroupBy) != 0 {
+		// The rows of the source are aggregated: they can't be split or repaired.
+		if params.Repair != "" {
+			return nil, fmt.Errorf("the rows of the table are aggregated by the workflow and cannot be repaired")
+		}
+		if err := df.diffTable(ctx, df.ts.wr, table, td, params.FilteredReplicationWaitTime); err != nil {
+			return nil, err
+		}
+		rowsToCompare := int64(math.MaxInt64)
+		return td.diff(ctx, df.ts.wr, &rowsToCompare, params.Debug, params.OnlyPks)
+	}
+
+	if params.Repair != "" {
+		td.repairs, err = df.newVDiffRepairer(ctx, td, params.MaxRepairs)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { td.repairs = nil }()
+	}
+
+	previous := params.PreviousChunks[table]
+	chunks, err := df.buildChunks(ctx, td, params.Chunks, previous)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "buildChunks")
+	}
+	log.Infof("Comparing table %s in %d chunks", table, len(chunks))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// syncMu makes the ranges synchronize the targets one at a time.
+	var syncMu sync.Mutex
+	sem := sync2.NewSemaphore(params.Parallelism, 0)
+	reports := make([]*DiffReport, len(chunks))
+	allErrors := &concurrency.AllErrorRecorder{}
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		var previousChunk *DiffChunk
+		if len(previous) == len(chunks) {
+			previousChunk = previous[i]
+		}
+		wg.Add(1)
+		go func(i int, chunk, previousChunk *DiffChunk) {
+			defer wg.Done()
+			if !sem.AcquireContext(ctx) {
+				return
+			}
+			defer sem.Release()
+			dr, err := df.diffChunk(ctx, table, td, chunk, previousChunk, params, &syncMu)
+			if err != nil {
+				allErrors.RecordError(err)
+				cancel()
+				return
+			}
+			reports[i] = dr
+		}(i, chunk, previousChunk)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	dr := &DiffReport{Chunks: chunks}
+	for _, chunkReport := range reports {
+		mergeDiffReport(dr, chunkReport)
+	}
+	if td.repairs == nil {
+		return dr, nil
+	}
+	if params.Repair == VDiffRepairPrint {
+		dr.Repairs = td.repairs.statements
+		return dr, nil
+	}
+	dr.Repairs, err = df.repairTable(ctx, table, td, td.repairs.pks, params)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "repair")
+	}
+	return dr, nil
+}
+
+// diffChunk compares the checksums of the rows of a range, and the rows if they differ.
+func (df *vdiff) diffChunk(ctx context.Context, table string, td *tableDiffer, chunk, previous *DiffChunk, params *ChunkedVDiffParams, syncMu *sync.Mutex) (*DiffReport, error) {
+	// The streams of the range stop when it's done.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sourceFilter, targetFilter, err := td.chunkFilters(chunk)
+	if err != nil {
+		return nil, err
+	}
+	sourceQuery, targetQuery, err := td.checksumQueries(sourceFilter, targetFilter)
+	if err != nil {
+		return nil, err
+	}
+	cdf := df.cloneStreamers()
+	syncMu.Lock()
+	err = cdf.diffTable(ctx, df.ts.wr, table, &tableDiffer{sourceExpression: sourceQuery, targetExpression: targetQuery}, params.FilteredReplicationWaitTime)
+	syncMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	count, sourceChecksum, err := readChecksum(cdf.sources)
+	if err != nil {
+		return nil, err
+	}
+	_, targetChecksum, err := readChecksum(cdf.targets)
+	if err != nil {
+		return nil, err
+	}
+	chunk.SourceChecksum, chunk.TargetChecksum = sourceChecksum, targetChecksum
+	if sourceChecksum == targetChecksum {
+		chunk.ProcessedRows, chunk.MatchingRows = int(count), int(count)
+		return chunk.report(), nil
+	}
+	if previous != nil && previous.SourceChecksum == sourceChecksum && previous.TargetChecksum == targetChecksum && td.repairs == nil {
+		chunk.Reused = true
+		chunk.ProcessedRows, chunk.MatchingRows, chunk.MismatchedRows = previous.ProcessedRows, previous.MatchingRows, previous.MismatchedRows
+		chunk.ExtraRowsSource, chunk.ExtraRowsTarget = previous.ExtraRowsSource, previous.ExtraRowsTarget
+		return chunk.report(), nil
+	}
+
+	cdf = df.cloneStreamers()
+	ctd, err := td.withFilter(cdf, sourceFilter, targetFilter)
+	if err != nil {
+		return nil, err
+	}
+	syncMu.Lock()
+	err = cdf.diffTable(ctx, df.ts.wr, table, ctd, params.FilteredReplicationWaitTime)
+	syncMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	rowsToCompare := int64(math.MaxInt64)
+	dr, err := ctd.diff(ctx, df.ts.wr, &rowsToCompare, params.Debug, params.OnlyPks)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "diff")
+	}
+	chunk.ProcessedRows, chunk.MatchingRows, chunk.MismatchedRows = dr.ProcessedRows, dr.MatchingRows, dr.MismatchedRows
+	chunk.ExtraRowsSource, chunk.ExtraRowsTarget = dr.ExtraRowsSource, dr.ExtraRowsTarget
+	return dr, nil
+}
+
+func (chunk *DiffChunk) report() *DiffReport {
+	return &DiffReport{
+		ProcessedRows:   chunk.ProcessedRows,
+		MatchingRows:    chunk.MatchingRows,
+		MismatchedRows:  chunk.MismatchedRows,
+		ExtraRowsSource: chunk.ExtraRowsSource,
+		ExtraRowsTarget: chunk.ExtraRowsTarget,
+	}
+}
+
+func mergeDiffReport(dr, chunkReport *DiffReport) {
+	dr.ProcessedRows += chunkReport.ProcessedRows
+	dr.MatchingRows += chunkReport.MatchingRows
+	dr.MismatchedRows += chunkReport.MismatchedRows
+	dr.ExtraRowsSource += chunkReport.ExtraRowsSource
+	dr.ExtraRowsTarget += chunkReport.ExtraRowsTarget
+	for _, rd := range chunkReport.ExtraRowsSourceSample {
+		if len(dr.ExtraRowsSourceSample) < maxDiffSampleRows {
+			dr.ExtraRowsSourceSample = append(dr.ExtraRowsSourceSample, rd)
+		}
+	}
+	for _, rd := range chunkReport.ExtraRowsTargetSample {
+		if len(dr.ExtraRowsTargetSample) < maxDiffSampleRows {
+			dr.ExtraRowsTargetSample = append(dr.ExtraRowsTargetSample, rd)
+		}
+	}
+	for _, dm := range chunkReport.MismatchedRowsSample {
+		if len(dr.MismatchedRowsSample) < maxDiffSampleRows {
+			dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, dm)
+		}
+	}
+}
+
+// cloneStreamers returns a copy of the vdiff with its own streamers,
+// so that several ranges can be streamed at the same time.
+func (df *vdiff) cloneStreamers() *vdiff {
+	clone := *df
+	clone.sources = make(map[string]*shardStreamer, len(df.sources))
+	for shard, source := range df.sources {
+		clone.sources[shard] = &shardStreamer{master: source.master, tablet: source.tablet}
+	}
+	clone.targets = make(map[string]*shardStreamer, len(df.targets))
+	for shard, target := range df.targets {
+		clone.targets[shard] = &shardStreamer{master: target.master, tablet: target.tablet}
+	}
+	return &clone
+}
+
+// buildChunks splits the table into ranges of its first primary key column,
+// or reuses the ranges of the previous run.
+func (df *vdiff) buildChunks(ctx context.Context, td *tableDiffer, chunks int, previous []*DiffChunk) ([]*DiffChunk, error) {
+	if len(previous) != 0 {
+		result := make([]*DiffChunk, 0, len(previous))
+		for _, chunk := range previous {
+			result = append(result, &DiffChunk{Start: chunk.Start, End: chunk.End})
+		}
+		return result, nil
+	}
+	var table *tabletmanagerdatapb.TableDefinition
+	for _, def := range df.schema.TableDefinitions {
+		if def.Name == td.targetTable {
+			table = def
+		}
+	}
+	if chunks == 1 || table == nil || len(table.PrimaryKeyColumns) == 0 {
+		return []*DiffChunk{{}}, nil
+	}
+	pk := table.PrimaryKeyColumns[0]
+	for _, field := range table.Fields {
+		if strings.EqualFold(field.Name, pk) && !sqltypes.IsIntegral(field.Type) {
+			return []*DiffChunk{{}}, nil
+		}
+	}
+	min, max, ok, err := df.pkRange(ctx, td.targetTable, pk)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return []*DiffChunk{{}}, nil
+	}
+	return splitPKRange(min, max, chunks), nil
+}
+
+// pkRange returns the smallest and the largest values of the column in the targets.
+// ok is false if the targets are empty.
+func (df *vdiff) pkRange(ctx context.Context, table, column string) (min, max int64, ok bool, err error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	col := sqlparser.NewColIdent(column)
+	buf.Myprintf("select min(%v), max(%v) from %v", col, col, sqlparser.NewTableIdent(table))
+	query := buf.String()
+
+	var mu sync.Mutex
+	err = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+		participant := &shardStreamer{
+			master: target.master,
+			tablet: target.tablet,
+			result: make(chan *sqltypes.Result, 1),
+		}
+		go df.streamOne(ctx, df.ts.targetKeyspace, shard, participant, query, make(chan string, 1))
+		for result := range participant.result {
+			for _, row := range result.Rows {
+				if row[0].IsNull() {
+					continue
+				}
+				lo, err := evalengine.ToInt64(row[0])
+				if err != nil {
+					return err
+				}
+				hi, err := evalengine.ToInt64(row[1])
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				if !ok || lo < min {
+					min = lo
+				}
+				if !ok || hi > max {
+					max = hi
+				}
+				ok = true
+				mu.Unlock()
+			}
+		}
+		return participant.err
+	})
+	return min, max, ok, err
+}
+
+// splitPKRange splits [min, max] into at most n ranges of the same size.
+// The first and the last ranges are open, so that they include the rows
+// outside of [min, max].
+func splitPKRange(min, max int64, n int) []*DiffChunk {
+	// The size of the range doesn't always fit in an int64.
+	step := (uint64(max)-uint64(min))/uint64(n) + 1
+	var chunks []*DiffChunk
+	var start *int64
+	for i := 1; i < n; i++ {
+		if uint64(i)*step > uint64(max)-uint64(min) {
+			break
+		}
+		end := int64(uint64(min) + uint64(i)*step)
+		chunks = append(chunks, &DiffChunk{Start: start, End: &end})
+		start = &end
+	}
+	return append(chunks, &DiffChunk{Start: start})
+}
+
+// readChecksum combines the checksums streamed by the participants.
+func readChecksum(participants map[string]*shardStreamer) (int64, string, error) {
+	var count int64
+	var checksum uint64
+	for _, participant := range participants {
+		for result := range participant.result {
+			for _, row := range result.Rows {
+				c, err := evalengine.ToInt64(row[0])
+				if err != nil {
+					return 0, "", err
+				}
+				crc, err := evalengine.ToUint64(row[1])
+				if err != nil {
+					return 0, "", err
+				}
+				// Sums and xors can be combined across shards.
+				count += c
+				checksum ^= crc
+			}
+		}
+		if participant.err != nil {
+			return 0, "", participant.err
+		}
+	}
+	return count, fmt.Sprintf("%d:%d", count, checksum), nil
+}
+
+func parseSelect(query string) (*sqlparser.Select, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	return sel, nil
+}
+
+// pkExprs returns the expressions of the primary key columns in the source and target queries.
+func (td *tableDiffer) pkExprs() (sourceExprs, targetExprs []sqlparser.Expr, err error) {
+	sourceSelect, err := parseSelect(td.sourceExpression)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetSelect, err := parseSelect(td.targetExpression)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, i := range td.pkCols {
+		sourceExprs = append(sourceExprs, sourceSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr)
+		targetExprs = append(targetExprs, targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr)
+	}
+	return sourceExprs, targetExprs, nil
+}
+
+// chunkFilters returns the conditions that select the rows of the range in the source and the target.
+// They are nil if the range is not bounded.
+func (td *tableDiffer) chunkFilters(chunk *DiffChunk) (sourceFilter, targetFilter sqlparser.Expr, err error) {
+	if chunk.Start == nil && chunk.End == nil {
+		return nil, nil, nil
+	}
+	sourceExprs, targetExprs, err := td.pkExprs()
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := func(expr sqlparser.Expr) sqlparser.Expr {
+		var conds []sqlparser.Expr
+		if chunk.Start != nil {
+			conds = append(conds, &sqlparser.ComparisonExpr{
+				Operator: sqlparser.GreaterEqualOp,
+				Left:     expr,
+				Right:    sqlparser.NewIntLiteral(strconv.FormatInt(*chunk.Start, 10)),
+			})
+		}
+		if chunk.End != nil {
+			conds = append(conds, &sqlparser.ComparisonExpr{
+				Operator: sqlparser.LessThanOp,
+				Left:     expr,
+				Right:    sqlparser.NewIntLiteral(strconv.FormatInt(*chunk.End, 10)),
+			})
+		}
+		return sqlparser.AndExpressions(conds...)
+	}
+	return filter(sourceExprs[0]), filter(targetExprs[0]), nil
+}
+
+// pkFilters returns the conditions that select the rows with the primary keys in the source and the target.
+func (td *tableDiffer) pkFilters(pks [][]sqltypes.Value) (sourceFilter, targetFilter sqlparser.Expr, err error) {
+	sourceExprs, targetExprs, err := td.pkExprs()
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := func(exprs []sqlparser.Expr) sqlparser.Expr {
+		var result sqlparser.Expr
+		for _, pk := range pks {
+			var conds []sqlparser.Expr
+			for i, expr := range exprs {
+				conds = append(conds, &sqlparser.ComparisonExpr{
+					Operator: sqlparser.EqualOp,
+					Left:     expr,
+					Right:    valueLiteral(pk[i]),
+				})
+			}
+			cond := sqlparser.AndExpressions(conds...)
+			if result == nil {
+				result = cond
+				continue
+			}
+			result = &sqlparser.OrExpr{Left: result, Right: cond}
+		}
+		return result
+	}
+	return filter(sourceExprs), filter(targetExprs), nil
+}
+
+func valueLiteral(v sqltypes.Value) sqlparser.Expr {
+	switch {
+	case v.IsIntegral():
+		return sqlparser.NewIntLiteral(v.ToString())
+	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+		return sqlparser.NewFloatLiteral(v.ToString())
+	default:
+		return sqlparser.NewStrLiteral(v.ToString())
+	}
+}
+
+// withFilter returns a copy of the tableDiffer that compares the rows that match the filters,
+// with the streamers of df.
+func (td *tableDiffer) withFilter(df *vdiff, sourceFilter, targetFilter sqlparser.Expr) (*tableDiffer, error) {
+	sourceSelect, err := parseSelect(td.sourceExpression)
+	if err != nil {
+		return nil, err
+	}
+	targetSelect, err := parseSelect(td.targetExpression)
+	if err != nil {
+		return nil, err
+	}
+	if sourceFilter != nil {
+		sourceSelect.AddWhere(sourceFilter)
+		targetSelect.AddWhere(targetFilter)
+	}
+	ftd := *td
+	ftd.sourceExpression = sqlparser.String(sourceSelect)
+	ftd.targetExpression = sqlparser.String(targetSelect)
+	ftd.sourcePrimitive = newMergeSorter(df.sources, td.comparePKs)
+	ftd.targetPrimitive = newMergeSorter(df.targets, td.comparePKs)
+	return &ftd, nil
+}
+
+// checksumQueries returns the queries that count the rows that match the filters,
+// and compute the checksum of their values.
+func (td *tableDiffer) checksumQueries(sourceFilter, targetFilter sqlparser.Expr) (string, string, error) {
+	sourceSelect, err := parseSelect(td.sourceExpression)
+	if err != nil {
+		return "", "", err
+	}
+	targetSelect, err := parseSelect(td.targetExpression)
+	if err != nil {
+		return "", "", err
+	}
+	if sourceFilter != nil {
+		sourceSelect.AddWhere(sourceFilter)
+		targetSelect.AddWhere(targetFilter)
+	}
+	return checksumQuery(sourceSelect, len(td.compareCols)), checksumQuery(targetSelect, len(td.compareCols)), nil
+}
+
+// checksumQuery uses the first numCols columns of sel, which excludes the weight strings.
+// The values are prefixed with their length, so that a separator in a value can't
+// shift to the next one, e.g. ('a#', 'b') and ('a', '#b'). The isnull() flags
+// distinguish nulls, which concat_ws skips, from empty values.
+func checksumQuery(sel *sqlparser.Select, numCols int) string {
+	var cols, nulls []string
+	for _, selExpr := range sel.SelectExprs[:numCols] {
+		col := sqlparser.String(selExpr.(*sqlparser.AliasedExpr).Expr)
+		cols = append(cols, fmt.Sprintf("concat(length(%s), ':', %s)", col, col))
+		nulls = append(nulls, fmt.Sprintf("isnull(%s)", col))
+	}
+	return fmt.Sprintf("select count(*), bit_xor(crc32(concat_ws('#', %s, %s))) from %s%s",
+		strings.Join(cols, ", "), strings.Join(nulls, ", "), sqlparser.String(sqlparser.TableExprs(sel.From)), sqlparser.String(sel.Where))
+}
+
+//-----------------------------------------------------------------
+// vdiffRepairer
+
+// vdiffRepairer collects the differences of a table found by the ranges,
+// and the statements that fix them. It's shared by the ranges.
+type vdiffRepairer struct {
+	table   string
+	columns []sqlparser.ColIdent
+	pkCols  []int
+	// route returns the target shards of a row.
+	route   func(row []sqltypes.Value) ([]string, error)
+	maxRows int
+
+	mu         sync.Mutex
+	statements []*RepairStatement
+	// pks are the primary keys of the rows that differ.
+	pks [][]sqltypes.Value
+}
+
+func (df *vdiff) newVDiffRepairer(ctx context.Context, td *tableDiffer, maxRows int) (*vdiffRepairer, error) {
+	targetSelect, err := parseSelect(td.targetExpression)
+	if err != nil {
+		return nil, err
+	}
+	vr := &vdiffRepairer{
+		table:   td.targetTable,
+		pkCols:  td.pkCols,
+		maxRows: maxRows,
+	}
+	for _, selExpr := range targetSelect.SelectExprs[:len(td.compareCols)] {
+		vr.columns = append(vr.columns, selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name)
+	}
+	vr.route, err = df.repairRouter(ctx, td.targetTable, vr.columns)
+	if err != nil {
+		return nil, err
+	}
+	return vr, nil
+}
+
+// repairRouter returns the function that finds the target shard of a row
+// with the primary vindex of the table.
+func (df *vdiff) repairRouter(ctx context.Context, table string, columns []sqlparser.ColIdent) (func([]sqltypes.Value) ([]string, error), error) {
+	if len(df.targets) == 1 {
+		for shard := range df.targets {
+			return func([]sqltypes.Value) ([]string, error) { return []string{shard}, nil }, nil
+		}
+	}
+	vs, err := df.ts.wr.ts.GetVSchema(ctx, df.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	ks, err := vindexes.BuildKeyspaceSchema(vs, df.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	t := ks.Tables[table]
+	if t == nil || len(t.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("table %s has no primary vindex in keyspace %s", table, df.targetKeyspace)
+	}
+	cv := t.ColumnVindexes[0]
+	if cv.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("the primary vindex %s of table %s needs to look up the keyspace ids, which is not supported by the repair", cv.Name, table)
+	}
+	var cols []int
+	for _, vcol := range cv.Columns {
+		found := false
+		for i, col := range columns {
+			if col.Equal(vcol) {
+				cols = append(cols, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("vindex column %v of table %s is not compared", vcol, table)
+		}
+	}
+	return func(row []sqltypes.Value) ([]string, error) {
+		vals := make([]sqltypes.Value, 0, len(cols))
+		for _, i := range cols {
+			vals = append(vals, row[i])
+		}
+		destinations, err := vindexes.Map(cv.Vindex, nil, [][]sqltypes.Value{vals})
+		if err != nil {
+			return nil, err
+		}
+		ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, fmt.Errorf("cannot map the row to a single keyspace id: %v", destinations[0])
+		}
+		for shard, target := range df.ts.targets {
+			if key.KeyRangeContains(target.GetShard().KeyRange, ksid) {
+				return []string{shard}, nil
+			}
+		}
+		return nil, fmt.Errorf("no target shard for keyspace id %v", ksid)
+	}, nil
+}
+
+func (vr *vdiffRepairer) add(row []sqltypes.Value, statement *RepairStatement) error {
+	vr.mu.Lock()
+	defer vr.mu.Unlock()
+	if len(vr.pks) >= vr.maxRows {
+		return fmt.Errorf("more than %d rows of table %s differ, which is too many to repair", vr.maxRows, vr.table)
+	}
+	pk := make([]sqltypes.Value, 0, len(vr.pkCols))
+	for _, i := range vr.pkCols {
+		pk = append(pk, row[i])
+	}
+	vr.pks = append(vr.pks, pk)
+	vr.statements = append(vr.statements, statement)
+	return nil
+}
+
+// extraSource inserts a row that is missing in the target.
+func (vr *vdiffRepairer) extraSource(row []sqltypes.Value) error {
+	if vr == nil {
+		return nil
+	}
+	shards, err := vr.route(row)
+	if err != nil {
+		return err
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert into %v(", sqlparser.NewTableIdent(vr.table))
+	for i, col := range vr.columns {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", col)
+	}
+	buf.Myprintf(") values (")
+	for i := range vr.columns {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		row[i].EncodeSQL(buf)
+	}
+	buf.Myprintf(")")
+	return vr.add(row, &RepairStatement{Shards: shards, Query: buf.String()})
+}
+
+// extraTarget deletes a row that is missing in the source.
+func (vr *vdiffRepairer) extraTarget(row []sqltypes.Value) error {
+	if vr == nil {
+		return nil
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v", sqlparser.NewTableIdent(vr.table))
+	vr.formatPKWhere(buf, row)
+	return vr.add(row, &RepairStatement{Query: buf.String()})
+}
+
+// mismatch updates the target row with the values of the source row.
+func (vr *vdiffRepairer) mismatch(row []sqltypes.Value) error {
+	if vr == nil {
+		return nil
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("update %v set ", sqlparser.NewTableIdent(vr.table))
+	first := true
+	for i, col := range vr.columns {
+		if vr.isPK(i) {
+			continue
+		}
+		if !first {
+			buf.Myprintf(", ")
+		}
+		first = false
+		buf.Myprintf("%v = ", col)
+		row[i].EncodeSQL(buf)
+	}
+	vr.formatPKWhere(buf, row)
+	return vr.add(row, &RepairStatement{Query: buf.String()})
+}
+
+func (vr *vdiffRepairer) extraSourceFunc() func([]sqltypes.Value) error {
+	if vr == nil {
+		return nil
+	}
+	return vr.extraSource
+}
+
+func (vr *vdiffRepairer) extraTargetFunc() func([]sqltypes.Value) error {
+	if vr == nil {
+		return nil
+	}
+	return vr.extraTarget
+}
+
+func (vr *vdiffRepairer) isPK(col int) bool {
+	for _, i := range vr.pkCols {
+		if i == col {
+			return true
+		}
+	}
+	return false
+}
+
+func (vr *vdiffRepairer) formatPKWhere(buf *sqlparser.TrackedBuffer, row []sqltypes.Value) {
+	buf.Myprintf(" where ")
+	for i, col := range vr.pkCols {
+		if i != 0 {
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v = ", vr.columns[col])
+		row[col].EncodeSQL(buf)
+	}
+}
+
+// repairTable compares the rows with the primary keys again, and fixes the
+// ones that still differ while the workflow is stopped, so that it can't
+// overwrite them with older values. It returns the executed statements.
+func (df *vdiff) repairTable(ctx context.Context, table string, td *tableDiffer, pks [][]sqltypes.Value, params *ChunkedVDiffParams) ([]*RepairStatement, error) {
+	var repairs []*RepairStatement
+	for len(pks) != 0 {
+		batch := pks
+		if len(batch) > vdiffRepairBatchSize {
+			batch = batch[:vdiffRepairBatchSize]
+		}
+		pks = pks[len(batch):]
+
+		sourceFilter, targetFilter, err := td.pkFilters(batch)
+		if err != nil {
+			return nil, err
+		}
+		cdf := df.cloneStreamers()
+		ctd, err := td.withFilter(cdf, sourceFilter, targetFilter)
+		if err != nil {
+			return nil, err
+		}
+		ctd.repairs = &vdiffRepairer{
+			table:   td.repairs.table,
+			columns: td.repairs.columns,
+			pkCols:  td.repairs.pkCols,
+			route:   td.repairs.route,
+			maxRows: len(batch),
+		}
+		if err := cdf.repairBatch(ctx, table, ctd, params.FilteredReplicationWaitTime); err != nil {
+			return nil, err
+		}
+		repairs = append(repairs, ctd.repairs.statements...)
+	}
+	return repairs, nil
+}
+
+// repairBatch synchronizes the targets with the sources like diffTable, compares the rows,
+// and executes the statements that fix them before it restarts the workflow.
+func (df *vdiff) repairBatch(ctx context.Context, table string, td *tableDiffer, filteredReplicationWaitTime time.Duration) (err error) {
+	wr := df.ts.wr
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, df.targetKeyspace, "vdiff repair")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+	defer func() {
+		if err := df.restartTargets(ctx); err != nil {
+			log.Errorf("Error restarting targets for workflow %s in keyspace %s: %v", df.workflow, df.targetKeyspace, err)
+		}
+	}()
+
+	if err := df.stopTargets(ctx); err != nil {
+		return vterrors.Wrap(err, "stopTargets")
+	}
+	if err := df.startQueryStreams(ctx, df.ts.sourceKeyspace, df.sources, td.sourceExpression, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(sources)")
+	}
+	if err := df.syncTargets(ctx, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "syncTargets")
+	}
+	if err := df.startQueryStreams(ctx, df.ts.targetKeyspace, df.targets, td.targetExpression, filteredReplicationWaitTime); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(targets)")
+	}
+	rowsToCompare := int64(math.MaxInt64)
+	if _, err := td.diff(ctx, wr, &rowsToCompare, false, false); err != nil {
+		return vterrors.Wrap(err, "diff")
+	}
+	for _, statement := range td.repairs.statements {
+		shards := statement.Shards
+		if len(shards) == 0 {
+			for shard := range df.targets {
+				shards = append(shards, shard)
+			}
+		}
+		for _, shard := range shards {
+			log.Infof("Repairing table %s on shard %s: %s", table, shard, statement.Query)
+			if _, err := wr.tmc.ExecuteFetchAsApp(ctx, df.targets[shard].master.Tablet, false, []byte(statement.Query), 0); err != nil {
+				return vterrors.Wrapf(err, "shard %s: %s", shard, statement.Query)
+			}
+		}
+	}
+	return nil
+}
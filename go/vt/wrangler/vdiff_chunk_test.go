@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestSplitPKRange(t *testing.T) {
+	bounds := func(chunks []*DiffChunk) [][2]interface{} {
+		var result [][2]interface{}
+		for _, chunk := range chunks {
+			var b [2]interface{}
+			if chunk.Start != nil {
+				b[0] = *chunk.Start
+			}
+			if chunk.End != nil {
+				b[1] = *chunk.End
+			}
+			result = append(result, b)
+		}
+		return result
+	}
+	assert.Equal(t, [][2]interface{}{{nil, int64(26)}, {int64(26), int64(51)}, {int64(51), int64(76)}, {int64(76), nil}}, bounds(splitPKRange(1, 100, 4)))
+	// There are fewer values than chunks.
+	assert.Equal(t, [][2]interface{}{{nil, int64(6)}, {int64(6), nil}}, bounds(splitPKRange(5, 6, 4)))
+	assert.Equal(t, [][2]interface{}{{nil, nil}}, bounds(splitPKRange(5, 5, 4)))
+	// The range doesn't fit in an int64.
+	assert.Equal(t, [][2]interface{}{{nil, int64(0)}, {int64(0), nil}}, bounds(splitPKRange(math.MinInt64, math.MaxInt64, 2)))
+}
+
+// checksumInput evaluates the argument of crc32 in a checksum query for a
+// row of the columns c1 and c2, like MySQL does.
+func checksumInput(t *testing.T, query string, row []sqltypes.Value) string {
+	stmt, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	var eval func(expr sqlparser.Expr) (string, bool)
+	eval = func(expr sqlparser.Expr) (string, bool) {
+		switch expr := expr.(type) {
+		case *sqlparser.ColName:
+			v := row[map[string]int{"c1": 0, "c2": 1}[expr.Name.Lowered()]]
+			return v.ToString(), v.IsNull()
+		case *sqlparser.Literal:
+			return expr.Val, false
+		case *sqlparser.FuncExpr:
+			var args []string
+			var nulls []bool
+			for _, arg := range expr.Exprs {
+				v, null := eval(arg.(*sqlparser.AliasedExpr).Expr)
+				args = append(args, v)
+				nulls = append(nulls, null)
+			}
+			switch expr.Name.Lowered() {
+			case "concat_ws":
+				var values []string
+				for i := 1; i < len(args); i++ {
+					if !nulls[i] {
+						values = append(values, args[i])
+					}
+				}
+				return strings.Join(values, args[0]), false
+			case "concat":
+				for _, null := range nulls {
+					if null {
+						return "", true
+					}
+				}
+				return strings.Join(args, ""), false
+			case "length":
+				return fmt.Sprint(len(args[0])), nulls[0]
+			case "isnull":
+				if nulls[0] {
+					return "1", false
+				}
+				return "0", false
+			}
+		}
+		require.FailNow(t, "unexpected expression", sqlparser.String(expr))
+		return "", false
+	}
+	var input string
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if fn, ok := node.(*sqlparser.FuncExpr); ok && fn.Name.EqualString("crc32") {
+			input, _ = eval(fn.Exprs[0].(*sqlparser.AliasedExpr).Expr)
+			return false, nil
+		}
+		return true, nil
+	}, stmt)
+	return input
+}
+
+func TestChecksumQuery(t *testing.T) {
+	sel, err := parseSelect("select c1, c2, weight_string(c1) from t1")
+	require.NoError(t, err)
+	query := checksumQuery(sel, 2)
+	assert.Equal(t, "select count(*), bit_xor(crc32(concat_ws('#', concat(length(c1), ':', c1), concat(length(c2), ':', c2), isnull(c1), isnull(c2)))) from t1", query)
+
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewVarChar("a#"), sqltypes.NewVarChar("b")},
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarChar("#b")},
+		{sqltypes.NewVarChar("a"), sqltypes.NULL},
+		{sqltypes.NewVarChar("a"), sqltypes.NewVarChar("")},
+		{sqltypes.NULL, sqltypes.NewVarChar("a")},
+	}
+	inputs := make(map[string]int)
+	for i, row := range rows {
+		input := checksumInput(t, query, row)
+		if j, ok := inputs[input]; ok {
+			assert.Failf(t, "checksum collision", "rows %v and %v checksum %q", rows[j], row, input)
+		}
+		inputs[input] = i
+	}
+}
+
+func newTestChunkedVDiffEnv() *testVDiffEnv {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	checksumFields := sqltypes.MakeTestFields("count(*)|crc", "int64|uint64")
+
+	env.tablets[201].setResults("select min(c1), max(c1) from t1", vdiffTargetMasterPosition,
+		sqltypes.MakeTestStreamingResults(sqltypes.MakeTestFields("min|max", "int64|int64"), "1|4"))
+
+	// The range [, 3) matches.
+	env.tablets[101].setResults("select count(*), bit_xor(crc32(concat_ws('#', concat(length(c1), ':', c1), concat(length(c2), ':', c2), isnull(c1), isnull(c2)))) from t1 where c1 < 3", vdiffSourceGtid,
+		sqltypes.MakeTestStreamingResults(checksumFields, "2|100"))
+	env.tablets[201].setResults("select count(*), bit_xor(crc32(concat_ws('#', concat(length(c1), ':', c1), concat(length(c2), ':', c2), isnull(c1), isnull(c2)))) from t1 where c1 < 3", vdiffTargetMasterPosition,
+		sqltypes.MakeTestStreamingResults(checksumFields, "2|100"))
+
+	// The range [3, ) doesn't.
+	env.tablets[101].setResults("select count(*), bit_xor(crc32(concat_ws('#', concat(length(c1), ':', c1), concat(length(c2), ':', c2), isnull(c1), isnull(c2)))) from t1 where c1 >= 3", vdiffSourceGtid,
+		sqltypes.MakeTestStreamingResults(checksumFields, "2|200"))
+	env.tablets[201].setResults("select count(*), bit_xor(crc32(concat_ws('#', concat(length(c1), ':', c1), concat(length(c2), ':', c2), isnull(c1), isnull(c2)))) from t1 where c1 >= 3", vdiffTargetMasterPosition,
+		sqltypes.MakeTestStreamingResults(checksumFields, "3|201"))
+	env.tablets[101].setResults("select c1, c2 from t1 where c1 >= 3 order by c1 asc", vdiffSourceGtid,
+		sqltypes.MakeTestStreamingResults(fields, "3|1", "4|5"))
+	env.tablets[201].setResults("select c1, c2 from t1 where c1 >= 3 order by c1 asc", vdiffTargetMasterPosition,
+		sqltypes.MakeTestStreamingResults(fields, "3|1", "4|6", "5|7"))
+	return env
+}
+
+func TestVDiffChunked(t *testing.T) {
+	env := newTestChunkedVDiffEnv()
+	defer env.close()
+
+	params := &ChunkedVDiffParams{
+		SourceCell:                  env.cell,
+		TargetCell:                  env.cell,
+		TabletTypes:                 "replica",
+		FilteredReplicationWaitTime: 30 * time.Second,
+		Chunks:                      2,
+		Parallelism:                 2,
+		Repair:                      VDiffRepairPrint,
+		MaxRepairs:                  10,
+	}
+	drs, err := env.wr.VDiffChunked(context.Background(), "target", env.workflow, params)
+	require.NoError(t, err)
+	dr := drs["t1"]
+	assert.Equal(t, 5, dr.ProcessedRows)
+	assert.Equal(t, 3, dr.MatchingRows)
+	assert.Equal(t, 1, dr.MismatchedRows)
+	assert.Equal(t, 1, dr.ExtraRowsTarget)
+
+	three := int64(3)
+	assert.Equal(t, []*DiffChunk{{
+		End:            &three,
+		SourceChecksum: "2:100",
+		TargetChecksum: "2:100",
+		ProcessedRows:  2,
+		MatchingRows:   2,
+	}, {
+		Start:           &three,
+		SourceChecksum:  "2:200",
+		TargetChecksum:  "3:201",
+		ProcessedRows:   3,
+		MatchingRows:    1,
+		MismatchedRows:  1,
+		ExtraRowsTarget: 1,
+	}}, dr.Chunks)
+	assert.Equal(t, []*RepairStatement{
+		{Query: "update t1 set c2 = 5 where c1 = 4"},
+		{Query: "delete from t1 where c1 = 5"},
+	}, dr.Repairs)
+
+	// Nothing changed: the ranges are reused, and the rows aren't compared again.
+	delete(env.tablets[101].queries, "select c1, c2 from t1 where c1 >= 3 order by c1 asc")
+	params.Repair = ""
+	params.PreviousChunks = map[string][]*DiffChunk{"t1": dr.Chunks}
+	drs, err = env.wr.VDiffChunked(context.Background(), "target", env.workflow, params)
+	require.NoError(t, err)
+	dr = drs["t1"]
+	assert.Equal(t, 5, dr.ProcessedRows)
+	assert.Equal(t, 1, dr.MismatchedRows)
+	assert.Equal(t, 1, dr.ExtraRowsTarget)
+	assert.False(t, dr.Chunks[0].Reused)
+	assert.True(t, dr.Chunks[1].Reused)
+}
+
+func TestVDiffChunkedRepairApply(t *testing.T) {
+	env := newTestChunkedVDiffEnv()
+	defer env.close()
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	// Only the row 4 still differs when the workflow is stopped.
+	env.tablets[101].setResults("select c1, c2 from t1 where c1 = 4 or c1 = 5 order by c1 asc", vdiffSourceGtid,
+		sqltypes.MakeTestStreamingResults(fields, "4|5", "5|7"))
+	env.tablets[201].setResults("select c1, c2 from t1 where c1 = 4 or c1 = 5 order by c1 asc", vdiffTargetMasterPosition,
+		sqltypes.MakeTestStreamingResults(fields, "4|6", "5|7"))
+
+	drs, err := env.wr.VDiffChunked(context.Background(), "target", env.workflow, &ChunkedVDiffParams{
+		SourceCell:                  env.cell,
+		TargetCell:                  env.cell,
+		TabletTypes:                 "replica",
+		FilteredReplicationWaitTime: 30 * time.Second,
+		Chunks:                      2,
+		Parallelism:                 1,
+		Repair:                      VDiffRepairApply,
+		MaxRepairs:                  10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*RepairStatement{{Query: "update t1 set c2 = 5 where c1 = 4"}}, drs["t1"].Repairs)
+	assert.Equal(t, map[int][]string{200: {"update t1 set c2 = 5 where c1 = 4"}}, env.tmc.appQueries)
+
+	_, err = env.wr.VDiffChunked(context.Background(), "target", env.workflow, &ChunkedVDiffParams{
+		SourceCell:                  env.cell,
+		TargetCell:                  env.cell,
+		TabletTypes:                 "replica",
+		FilteredReplicationWaitTime: 30 * time.Second,
+		Chunks:                      2,
+		Parallelism:                 1,
+		Repair:                      VDiffRepairApply,
+		MaxRepairs:                  1,
+	})
+	assert.Contains(t, err.Error(), "more than 1 rows of table t1 differ, which is too many to repair")
+}
@@ -58,6 +58,9 @@ type testVDiffEnv struct {
 	tabletType topodatapb.TabletType
 	tmc        *testVDiffTMClient
 
+	// tabletProtocol is restored by close.
+	tabletProtocol string
+
 	mu      sync.Mutex
 	tablets map[int]*testVDiffTablet
 }
@@ -80,14 +83,16 @@ func init() {
 // testVDiffEnv
 
 func newTestVDiffEnv(sourceShards, targetShards []string, query string, positions map[string]string) *testVDiffEnv {
+	tabletProtocol := flag.Lookup("tablet_protocol").Value.String()
 	flag.Set("tablet_protocol", "VDiffTest")
 	env := &testVDiffEnv{
-		workflow:   "vdiffTest",
-		tablets:    make(map[int]*testVDiffTablet),
-		topoServ:   memorytopo.NewServer("cell"),
-		cell:       "cell",
-		tabletType: topodatapb.TabletType_REPLICA,
-		tmc:        newTestVDiffTMClient(),
+		tabletProtocol: tabletProtocol,
+		workflow:       "vdiffTest",
+		tablets:        make(map[int]*testVDiffTablet),
+		topoServ:       memorytopo.NewServer("cell"),
+		cell:           "cell",
+		tabletType:     topodatapb.TabletType_REPLICA,
+		tmc:            newTestVDiffTMClient(),
 	}
 	env.wr = New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
 
@@ -178,6 +183,7 @@ func (env *testVDiffEnv) close() {
 		env.topoServ.DeleteTablet(context.Background(), t.tablet.Alias)
 	}
 	env.tablets = nil
+	flag.Set("tablet_protocol", env.tabletProtocol)
 }
 
 func (env *testVDiffEnv) addTablet(id int, keyspace, shard string, tabletType topodatapb.TabletType) *testVDiffTablet {
@@ -279,14 +285,19 @@ type testVDiffTMClient struct {
 	waitpos   map[int]string
 	vrpos     map[int]string
 	pos       map[int]string
+
+	mu sync.Mutex
+	// appQueries are the queries executed by ExecuteFetchAsApp on each tablet.
+	appQueries map[int][]string
 }
 
 func newTestVDiffTMClient() *testVDiffTMClient {
 	return &testVDiffTMClient{
-		vrQueries: make(map[int]map[string]*querypb.QueryResult),
-		waitpos:   make(map[int]string),
-		vrpos:     make(map[int]string),
-		pos:       make(map[int]string),
+		vrQueries:  make(map[int]map[string]*querypb.QueryResult),
+		waitpos:    make(map[int]string),
+		vrpos:      make(map[int]string),
+		pos:        make(map[int]string),
+		appQueries: make(map[int][]string),
 	}
 }
 
@@ -311,6 +322,13 @@ func (tmc *testVDiffTMClient) VReplicationExec(ctx context.Context, tablet *topo
 	return result, nil
 }
 
+func (tmc *testVDiffTMClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.appQueries[int(tablet.Alias.Uid)] = append(tmc.appQueries[int(tablet.Alias.Uid)], string(query))
+	return &querypb.QueryResult{RowsAffected: 1}, nil
+}
+
 func (tmc *testVDiffTMClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
 	select {
 	case <-ctx.Done():